@@ -0,0 +1,184 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package charset
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"golang.org/x/text/encoding"
+	"golang.org/x/text/encoding/htmlindex"
+	"golang.org/x/text/encoding/ianaindex"
+	"golang.org/x/text/encoding/unicode"
+)
+
+// Byte order marks recognized at the start of a message.
+var (
+	bomUTF8    = []byte{0xEF, 0xBB, 0xBF}
+	bomUTF16LE = []byte{0xFF, 0xFE}
+	bomUTF16BE = []byte{0xFE, 0xFF}
+)
+
+// DecodeMessage turns the raw bytes of a commit message file into the UTF-8 text the
+// rules expect. A byte order mark selects UTF-8 or UTF-16 and is removed, and UTF-16
+// without one is recognized by its zero bytes. Other text that is not valid UTF-8 is
+// decoded with the fallback encoding, such as "windows-1252" or "shift_jis", and kept
+// as it is when fallback is empty. Line endings are normalized to "\n" in every case.
+func DecodeMessage(data []byte, fallback string) (string, error) {
+	text, err := decode(data, fallback)
+	if err != nil {
+		return "", err
+	}
+
+	return NormalizeLineEndings(text), nil
+}
+
+// NormalizeLineEndings replaces Windows "\r\n" and old Mac "\r" line endings with "\n".
+func NormalizeLineEndings(text string) string {
+	if !strings.Contains(text, "\r") {
+		return text
+	}
+
+	return strings.ReplaceAll(strings.ReplaceAll(text, "\r\n", "\n"), "\r", "\n")
+}
+
+// DecodeCommitMessage turns the message of a commit object into UTF-8 text, as git log
+// does, transcoding it from the encoding named by the encoding header of the commit.
+// Names are looked up as iconv knows them, such as "ISO-8859-1", "Shift_JIS" or
+// "CP1252"; an empty name stands for UTF-8. A message that cannot be decoded, because
+// the encoding is unknown or the message holds bytes that are not valid in it, is
+// returned as it is stored together with the error. Line endings are normalized to
+// "\n" in every case.
+func DecodeCommitMessage(message, encodingName string) (string, error) {
+	if encodingName == "" {
+		return NormalizeLineEndings(message), nil
+	}
+
+	enc, err := commitEncoding(encodingName)
+	if err != nil {
+		return NormalizeLineEndings(message), err
+	}
+
+	if enc == unicode.UTF8 {
+		return NormalizeLineEndings(message), nil
+	}
+
+	decoded, err := decodeWith(enc, []byte(message))
+	if err == nil && strings.ContainsRune(decoded, utf8.RuneError) && !strings.Contains(message, string(utf8.RuneError)) {
+		err = fmt.Errorf("message holds bytes that are not valid %s", encodingName)
+	}
+
+	if err != nil {
+		return NormalizeLineEndings(message), err
+	}
+
+	return NormalizeLineEndings(decoded), nil
+}
+
+// ValidateFallback reports an error when name is not an encoding DecodeMessage knows.
+func ValidateFallback(name string) error {
+	if name == "" {
+		return nil
+	}
+
+	_, err := fallbackEncoding(name)
+
+	return err
+}
+
+// decode returns data as UTF-8 text without a byte order mark.
+func decode(data []byte, fallback string) (string, error) {
+	switch {
+	case bytes.HasPrefix(data, bomUTF8):
+		return string(data[len(bomUTF8):]), nil
+	case bytes.HasPrefix(data, bomUTF16LE), bytes.HasPrefix(data, bomUTF16BE):
+		return decodeWith(unicode.UTF16(unicode.BigEndian, unicode.ExpectBOM), data)
+	}
+
+	if endianness, ok := detectUTF16(data); ok {
+		return decodeWith(unicode.UTF16(endianness, unicode.IgnoreBOM), data)
+	}
+
+	if utf8.Valid(data) || fallback == "" {
+		return string(data), nil
+	}
+
+	fallbackEnc, err := fallbackEncoding(fallback)
+	if err != nil {
+		return "", err
+	}
+
+	return decodeWith(fallbackEnc, data)
+}
+
+// detectUTF16 recognizes UTF-16 without a byte order mark by the zero high bytes of the
+// characters of Latin scripts, which make up commit messages even in other languages.
+func detectUTF16(data []byte) (unicode.Endianness, bool) {
+	if len(data) < 2 || len(data)%2 != 0 {
+		return unicode.BigEndian, false
+	}
+
+	var evenZeros, oddZeros int
+
+	for index, value := range data {
+		if value != 0 {
+			continue
+		}
+
+		if index%2 == 0 {
+			evenZeros++
+		} else {
+			oddZeros++
+		}
+	}
+
+	units := len(data) / 2
+
+	switch {
+	case oddZeros*2 > units && evenZeros == 0:
+		return unicode.LittleEndian, true
+	case evenZeros*2 > units && oddZeros == 0:
+		return unicode.BigEndian, true
+	default:
+		return unicode.BigEndian, false
+	}
+}
+
+// fallbackEncoding looks up an encoding by its WHATWG name or label.
+func fallbackEncoding(name string) (encoding.Encoding, error) {
+	enc, err := htmlindex.Get(name)
+	if err != nil {
+		return nil, fmt.Errorf("unknown message encoding '%s'", name)
+	}
+
+	return enc, nil
+}
+
+// commitEncoding looks up the encoding of an encoding header by its IANA name or alias,
+// which are the names iconv and git use, and then by its WHATWG label, which adds
+// spellings such as "cp1252", "sjis" and "utf8".
+func commitEncoding(name string) (encoding.Encoding, error) {
+	if enc, err := ianaindex.IANA.Encoding(name); err == nil && enc != nil {
+		return enc, nil
+	}
+
+	if enc, err := htmlindex.Get(name); err == nil {
+		return enc, nil
+	}
+
+	return nil, fmt.Errorf("unknown encoding '%s'", name)
+}
+
+// decodeWith decodes data with enc.
+func decodeWith(enc encoding.Encoding, data []byte) (string, error) {
+	decoded, err := enc.NewDecoder().Bytes(data)
+	if err != nil {
+		return "", fmt.Errorf("failed to decode message: %w", err)
+	}
+
+	return string(decoded), nil
+}
@@ -0,0 +1,174 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package charset_test
+
+import (
+	"testing"
+
+	"github.com/itiquette/gommitlint/internal/adapters/charset"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecodeMessage(t *testing.T) {
+	tests := []struct {
+		name     string
+		data     []byte
+		fallback string
+		expected string
+		wantErr  string
+	}{
+		{
+			name:     "plain utf-8",
+			data:     []byte("Add feature\n\nBody text\n"),
+			expected: "Add feature\n\nBody text\n",
+		},
+		{
+			name:     "crlf line endings",
+			data:     []byte("Add feature\r\n\r\nBody text\r\n"),
+			expected: "Add feature\n\nBody text\n",
+		},
+		{
+			name:     "lone carriage returns",
+			data:     []byte("Add feature\r\rBody text"),
+			expected: "Add feature\n\nBody text",
+		},
+		{
+			name:     "utf-8 byte order mark",
+			data:     append([]byte{0xEF, 0xBB, 0xBF}, "Lägg till\r\n"...),
+			expected: "Lägg till\n",
+		},
+		{
+			name:     "utf-16 little endian with byte order mark",
+			data:     []byte{0xFF, 0xFE, 'F', 0, 'i', 0, 'x', 0, '\r', 0, '\n', 0},
+			expected: "Fix\n",
+		},
+		{
+			name:     "utf-16 big endian with byte order mark",
+			data:     []byte{0xFE, 0xFF, 0, 'F', 0, 0xE4, 0, 'x'},
+			expected: "Fäx",
+		},
+		{
+			name:     "utf-16 little endian without byte order mark",
+			data:     []byte{'F', 0, 'i', 0, 'x', 0},
+			expected: "Fix",
+		},
+		{
+			name:     "latin-1 kept without fallback",
+			data:     []byte("L\xe4gg till"),
+			expected: "L\xe4gg till",
+		},
+		{
+			name:     "latin-1 decoded with fallback",
+			data:     []byte("L\xe4gg till\r\n"),
+			fallback: "windows-1252",
+			expected: "Lägg till\n",
+		},
+		{
+			name:     "valid utf-8 ignores fallback",
+			data:     []byte("Lägg till"),
+			fallback: "windows-1252",
+			expected: "Lägg till",
+		},
+		{
+			name:     "unknown fallback",
+			data:     []byte("L\xe4gg till"),
+			fallback: "klingon",
+			wantErr:  "unknown message encoding 'klingon'",
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			message, err := charset.DecodeMessage(testCase.data, testCase.fallback)
+			if testCase.wantErr != "" {
+				require.ErrorContains(t, err, testCase.wantErr)
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, testCase.expected, message)
+		})
+	}
+}
+
+func TestValidateFallback(t *testing.T) {
+	require.NoError(t, charset.ValidateFallback(""))
+	require.NoError(t, charset.ValidateFallback("shift_jis"))
+	require.Error(t, charset.ValidateFallback("klingon"))
+}
+
+func TestDecodeCommitMessage(t *testing.T) {
+	tests := []struct {
+		name     string
+		message  string
+		encoding string
+		expected string
+		wantErr  string
+	}{
+		{
+			name:     "no encoding header",
+			message:  "Lägg till\r\n",
+			expected: "Lägg till\n",
+		},
+		{
+			name:     "declared utf-8",
+			message:  "Lägg till",
+			encoding: "UTF-8",
+			expected: "Lägg till",
+		},
+		{
+			name:     "iso-8859-1",
+			message:  "L\xe4gg till\n\nF\xf6rklaring",
+			encoding: "ISO-8859-1",
+			expected: "Lägg till\n\nFörklaring",
+		},
+		{
+			name:     "iconv alias",
+			message:  "L\xe4gg till",
+			encoding: "latin1",
+			expected: "Lägg till",
+		},
+		{
+			name:     "windows code page",
+			message:  "\x93Quoted\x94 fix",
+			encoding: "CP1252",
+			expected: "“Quoted” fix",
+		},
+		{
+			name:     "shift_jis",
+			message:  "\x83\x8d\x83O\x83C\x83\x93",
+			encoding: "Shift_JIS",
+			expected: "ログイン",
+		},
+		{
+			name:     "unknown encoding",
+			message:  "L\xe4gg till",
+			encoding: "klingon",
+			expected: "L\xe4gg till",
+			wantErr:  "unknown encoding 'klingon'",
+		},
+		{
+			name:     "bytes not valid in the encoding",
+			message:  "Fix \xff\xff",
+			encoding: "Shift_JIS",
+			expected: "Fix \xff\xff",
+			wantErr:  "message holds bytes that are not valid Shift_JIS",
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			message, err := charset.DecodeCommitMessage(testCase.message, testCase.encoding)
+			if testCase.wantErr != "" {
+				require.ErrorContains(t, err, testCase.wantErr)
+			} else {
+				require.NoError(t, err)
+			}
+
+			require.Equal(t, testCase.expected, message)
+		})
+	}
+}
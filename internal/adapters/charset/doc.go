@@ -0,0 +1,20 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+/*
+Package charset normalizes commit message input before the rules see it.
+
+Message files written on Windows often carry a byte order mark, UTF-16 from Notepad
+or CRLF line endings, none of which the rules expect. DecodeMessage turns such input
+into plain UTF-8 with "\n" line endings:
+
+	message, err := charset.DecodeMessage(content, cfg.Message.FallbackEncoding)
+
+Files that are neither UTF-8 nor UTF-16 are decoded with message.fallback_encoding,
+a WHATWG encoding name such as "windows-1252". Without one their bytes are kept, and
+the rules report them as invalid UTF-8. Messages read from git are transcoded from the
+encoding their encoding header names with DecodeCommitMessage, and messages from
+GitHub only have their line endings normalized with NormalizeLineEndings.
+*/
+package charset
@@ -0,0 +1,24 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+/*
+Package cla looks up whether contributors have signed the contributor license
+agreement of a project.
+
+It backs the cla rule, configured in the cla section:
+
+  - cla.contributors_file lists signed contributors by email, "Name <email>" or
+    a glob such as *@example.com for everyone at a company with a corporate CLA
+  - cla.url is a CLA service asked about contributors missing from the file
+  - signed contributors answered by the service are kept in a cache file for
+    cla.cache_ttl; contributors who have not signed are asked about again, so
+    that they pass as soon as they sign
+
+The service is sent a GET request for every email, with the token in
+CLA_API_TOKEN as a bearer token when set. It answers 200 with {"signed": true}
+or {"signed": false}, or 404 for contributors it does not know. After the first
+failed request the registry stops asking for the rest of the run and answers
+from the cache, however old.
+*/
+package cla
@@ -0,0 +1,265 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package cla
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/itiquette/gommitlint/internal/adapters/ticketcache"
+	"github.com/itiquette/gommitlint/internal/domain"
+	"github.com/itiquette/gommitlint/internal/domain/config"
+)
+
+// defaultTimeout bounds requests when cla.timeout is not a valid duration.
+const defaultTimeout = 5 * time.Second
+
+// Registry answers whether contributors have signed, from a contributors file and a
+// CLA service.
+type Registry struct {
+	contributorsFile string
+	serviceURL       string
+	token            string
+	httpClient       *http.Client
+	cache            *ticketcache.Cache
+
+	mutex        sync.Mutex
+	contributors []string // Lower case emails and globs, loaded on first use
+	loadErr      error
+	answers      map[string]bool
+	failure      error
+}
+
+// NewRegistry creates a registry for the contributors listed in contributorsFile and
+// the CLA service at serviceURL, either of which may be empty.
+func NewRegistry(contributorsFile, serviceURL, token string, timeout time.Duration) *Registry {
+	return &Registry{
+		contributorsFile: contributorsFile,
+		serviceURL:       serviceURL,
+		token:            token,
+		httpClient:       &http.Client{Timeout: timeout},
+		answers:          make(map[string]bool),
+	}
+}
+
+// NewRegistryFromConfig creates a registry for the cla configuration, with the token of
+// CLA_API_TOKEN and a cache in the user cache directory. It returns nil when neither a
+// contributors file nor a service is configured.
+func NewRegistryFromConfig(cfg config.CLAConfig, lookup func(string) (string, bool)) *Registry {
+	if cfg.ContributorsFile == "" && cfg.URL == "" {
+		return nil
+	}
+
+	timeout, err := time.ParseDuration(cfg.Timeout)
+	if err != nil || timeout <= 0 {
+		timeout = defaultTimeout
+	}
+
+	token, _ := lookup("CLA_API_TOKEN")
+
+	registry := NewRegistry(cfg.ContributorsFile, cfg.URL, token, timeout)
+
+	if ttl, err := time.ParseDuration(cfg.CacheTTL); err == nil && ttl > 0 && cfg.URL != "" {
+		if dir, err := os.UserCacheDir(); err == nil {
+			registry = registry.WithCache(filepath.Join(dir, "gommitlint", "cla"), ttl)
+		}
+	}
+
+	return registry
+}
+
+// WithCache returns the registry keeping the contributors the service answered as
+// signed in dir for ttl.
+func (r *Registry) WithCache(dir string, ttl time.Duration) *Registry {
+	r.cache = ticketcache.New(dir, r.serviceURL, ttl)
+
+	return r
+}
+
+// HasSigned reports whether identity has signed. The contributors file is consulted
+// first; the service is only asked about emails the file does not list.
+func (r *Registry) HasSigned(identity domain.Identity) (bool, error) {
+	email := strings.ToLower(identity.Email())
+	if email == "" {
+		return false, nil
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if signed, found := r.answers[email]; found {
+		return signed, nil
+	}
+
+	if r.contributorsFile != "" {
+		listed, err := r.listed(email)
+		if err != nil {
+			return false, err
+		}
+
+		if listed || r.serviceURL == "" {
+			r.answers[email] = listed
+
+			return listed, nil
+		}
+	}
+
+	return r.askService(email)
+}
+
+// listed reports whether the contributors file lists email.
+func (r *Registry) listed(email string) (bool, error) {
+	if r.contributors == nil && r.loadErr == nil {
+		r.contributors, r.loadErr = readContributors(r.contributorsFile)
+	}
+
+	if r.loadErr != nil {
+		return false, r.loadErr
+	}
+
+	for _, contributor := range r.contributors {
+		if matched, _ := path.Match(contributor, email); matched {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// askService looks up email in the service, or in the cache once a request has failed.
+func (r *Registry) askService(email string) (bool, error) {
+	entry, cached := r.cache.Get(email)
+	if cached && (r.cache.Fresh(entry) || r.failure != nil) {
+		r.answers[email] = entry.Ticket.Exists
+
+		return entry.Ticket.Exists, nil
+	}
+
+	if r.failure != nil {
+		return false, r.failure
+	}
+
+	signed, err := r.get(context.Background(), email)
+	if err != nil {
+		r.failure = err
+
+		if cached {
+			return entry.Ticket.Exists, nil
+		}
+
+		return false, err
+	}
+
+	r.answers[email] = signed
+
+	// Contributors who have not signed may sign any time, so only signatures are kept
+	if signed {
+		r.cache.Put(email, ticketcache.Entry{Ticket: domain.Ticket{Key: email, Exists: true}, Fetched: time.Now()})
+	}
+
+	return signed, nil
+}
+
+// apiAnswer is the answer of the service about one email.
+type apiAnswer struct {
+	Signed bool `json:"signed"`
+}
+
+// get asks the service whether email has signed.
+func (r *Registry) get(ctx context.Context, email string) (bool, error) {
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, serviceRequestURL(r.serviceURL, email), nil)
+	if err != nil {
+		return false, err
+	}
+
+	request.Header.Set("Accept", "application/json")
+
+	if r.token != "" {
+		request.Header.Set("Authorization", "Bearer "+r.token)
+	}
+
+	response, err := r.httpClient.Do(request)
+	if err != nil {
+		return false, err
+	}
+	defer response.Body.Close()
+
+	body, err := io.ReadAll(response.Body)
+	if err != nil {
+		return false, fmt.Errorf("read response: %w", err)
+	}
+
+	switch response.StatusCode {
+	case http.StatusOK:
+		var answer apiAnswer
+		if err := json.Unmarshal(body, &answer); err != nil {
+			return false, fmt.Errorf("decode response: %w", err)
+		}
+
+		return answer.Signed, nil
+	case http.StatusNotFound:
+		return false, nil
+	}
+
+	return false, fmt.Errorf("CLA service returned %s", response.Status)
+}
+
+// serviceRequestURL puts email in place of {email} in serviceURL, or adds it as the
+// email query parameter when there is no placeholder.
+func serviceRequestURL(serviceURL, email string) string {
+	if strings.Contains(serviceURL, "{email}") {
+		return strings.ReplaceAll(serviceURL, "{email}", url.QueryEscape(email))
+	}
+
+	separator := "?"
+	if strings.Contains(serviceURL, "?") {
+		separator = "&"
+	}
+
+	return serviceURL + separator + "email=" + url.QueryEscape(email)
+}
+
+// readContributors reads the contributors file: one email, "Name <email>" or email glob
+// per line, with blank lines and lines starting with # ignored.
+func readContributors(file string) ([]string, error) {
+	handle, err := os.Open(file)
+	if err != nil {
+		return nil, fmt.Errorf("read contributors file: %w", err)
+	}
+	defer handle.Close()
+
+	contributors := []string{}
+	scanner := bufio.NewScanner(handle)
+
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		if strings.Contains(line, "<") {
+			line = domain.NewIdentityFromString(line).Email()
+		}
+
+		contributors = append(contributors, strings.ToLower(line))
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("read contributors file: %w", err)
+	}
+
+	return contributors, nil
+}
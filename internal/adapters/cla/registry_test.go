@@ -0,0 +1,147 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package cla_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/itiquette/gommitlint/internal/adapters/cla"
+	"github.com/itiquette/gommitlint/internal/domain"
+)
+
+func TestRegistry_ContributorsFile(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "CONTRIBUTORS")
+	require.NoError(t, os.WriteFile(file, []byte("# Individual CLAs\nJane Doe <Jane@Example.com>\nann@example.org\n\n# Corporate CLA\n*@corp.example.com\n"), 0o600))
+
+	registry := cla.NewRegistry(file, "", "", time.Second)
+
+	for email, expected := range map[string]bool{
+		"jane@example.com":      true,
+		"ann@example.org":       true,
+		"dev@corp.example.com":  true,
+		"john@example.com":      false,
+		"dev@corp.example.com.": false,
+	} {
+		signed, err := registry.HasSigned(domain.NewIdentity("", email))
+		require.NoError(t, err)
+		require.Equal(t, expected, signed, email)
+	}
+
+	_, err := cla.NewRegistry(filepath.Join(t.TempDir(), "missing"), "", "", time.Second).HasSigned(domain.NewIdentity("", "jane@example.com"))
+	require.ErrorContains(t, err, "read contributors file")
+}
+
+func TestRegistry_Service(t *testing.T) {
+	requests := 0
+
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		requests++
+
+		require.Equal(t, "Bearer token", request.Header.Get("Authorization"))
+
+		switch request.URL.Path {
+		case "/signatures/jane@example.com":
+			fmt.Fprint(writer, `{"signed": true}`)
+		case "/signatures/john@example.com":
+			fmt.Fprint(writer, `{"signed": false}`)
+		default:
+			http.NotFound(writer, request)
+		}
+	}))
+	defer server.Close()
+
+	file := filepath.Join(t.TempDir(), "CONTRIBUTORS")
+	require.NoError(t, os.WriteFile(file, []byte("ann@example.org\n"), 0o600))
+
+	registry := cla.NewRegistry(file, server.URL+"/signatures/{email}", "token", time.Second)
+
+	for email, expected := range map[string]bool{
+		"ann@example.org":  true,
+		"jane@example.com": true,
+		"john@example.com": false,
+		"new@example.com":  false,
+	} {
+		signed, err := registry.HasSigned(domain.NewIdentity("", email))
+		require.NoError(t, err)
+		require.Equal(t, expected, signed, email)
+	}
+
+	// Listed contributors are not asked about, and answers are reused within the run
+	_, err := registry.HasSigned(domain.NewIdentity("", "JANE@example.com"))
+	require.NoError(t, err)
+	require.Equal(t, 3, requests)
+}
+
+func TestRegistry_Cache(t *testing.T) {
+	requests := 0
+
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		requests++
+
+		fmt.Fprintf(writer, `{"signed": %v}`, request.URL.Query().Get("email") == "jane@example.com")
+	}))
+	defer server.Close()
+
+	dir := t.TempDir()
+
+	for range 2 {
+		registry := cla.NewRegistry("", server.URL, "", time.Second).WithCache(dir, time.Hour)
+
+		signed, err := registry.HasSigned(domain.NewIdentity("", "jane@example.com"))
+		require.NoError(t, err)
+		require.True(t, signed)
+
+		signed, err = registry.HasSigned(domain.NewIdentity("", "john@example.com"))
+		require.NoError(t, err)
+		require.False(t, signed)
+	}
+
+	// Only the signature is cached; john@example.com may have signed since the first run
+	require.Equal(t, 3, requests)
+}
+
+func TestRegistry_Offline(t *testing.T) {
+	failing := false
+	requests := 0
+
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, _ *http.Request) {
+		requests++
+
+		if failing {
+			http.Error(writer, "maintenance", http.StatusServiceUnavailable)
+
+			return
+		}
+
+		fmt.Fprint(writer, `{"signed": true}`)
+	}))
+	defer server.Close()
+
+	dir := t.TempDir()
+
+	_, err := cla.NewRegistry("", server.URL, "", time.Second).WithCache(dir, time.Hour).HasSigned(domain.NewIdentity("", "jane@example.com"))
+	require.NoError(t, err)
+
+	failing = true
+	registry := cla.NewRegistry("", server.URL, "", time.Second).WithCache(dir, time.Nanosecond)
+
+	// The stale signature is used when the service fails
+	signed, err := registry.HasSigned(domain.NewIdentity("", "jane@example.com"))
+	require.NoError(t, err)
+	require.True(t, signed)
+
+	// After the failure the service is not asked again in the same run
+	_, err = registry.HasSigned(domain.NewIdentity("", "john@example.com"))
+	require.ErrorContains(t, err, "CLA service returned 503")
+	require.Equal(t, 2, requests)
+}
@@ -0,0 +1,58 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/itiquette/gommitlint/internal/domain"
+	"github.com/itiquette/gommitlint/internal/domain/config"
+)
+
+// AmendedCommit returns the commit git commit --amend would create from head: head with
+// message, or with its own message when message is empty. The result is a commit being
+// written, without a hash or a signature, as git signs the new commit when it creates it.
+// It keeps the author, parents and dates of head, which an amend keeps as well.
+func AmendedCommit(head domain.Commit, message string) domain.Commit {
+	amended := head
+	amended.Hash = ""
+	amended.Signature = ""
+	amended.SignedPayload = ""
+
+	if message = strings.TrimSpace(message); message != "" {
+		parsed := domain.ParseCommitMessage(message)
+		amended.Message = parsed.Message
+		amended.Subject = parsed.Subject
+		amended.Body = parsed.Body
+		amended.Trailers = parsed.Trailers
+		amended.Gitmoji = parsed.Gitmoji
+	}
+
+	return amended
+}
+
+// ValidateStagedAmend validates the commit amending HEAD would create, with message or
+// the message of HEAD, together with the repository rules. Like a message being written,
+// the commit has no hash, so path conditions see the staged files and rules measuring
+// the changes of a commit skip it.
+func ValidateStagedAmend(ctx context.Context, message string, commitRules []domain.CommitRule,
+	repoRules []domain.RepositoryRule, repo domain.Repository, cfg config.Config, logger domain.Logger) (domain.Report, error) {
+	logger.Debug("Validating the amend of HEAD", "new_message", message != "")
+
+	head, err := repo.GetCommit(ctx, "HEAD")
+	if err != nil {
+		return domain.Report{}, fmt.Errorf("failed to get the commit to amend: %w", err)
+	}
+
+	amended := AmendedCommit(head, message)
+	if strings.TrimSpace(amended.FullMessage()) == "" {
+		return domain.Report{}, errors.New("empty commit message")
+	}
+
+	return ValidateSingleCommit(amended, commitRules, repoRules, repo, cfg)
+}
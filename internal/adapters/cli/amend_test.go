@@ -0,0 +1,56 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package cli
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/itiquette/gommitlint/internal/domain"
+	"github.com/itiquette/gommitlint/internal/domain/config"
+	"github.com/itiquette/gommitlint/internal/domain/rules"
+)
+
+func TestAmendedCommit(t *testing.T) {
+	head := domain.NewCommit("a1b2c3", "add parser.\n\nParses the input.", "Dev", "dev@example.com", "2025-01-01", "-----BEGIN SSH SIGNATURE-----", false)
+	head.ParentHashes = []string{"f0e1d2"}
+
+	kept := AmendedCommit(head, "")
+	require.Empty(t, kept.Hash, "the amended commit is not written yet")
+	require.Empty(t, kept.Signature, "git signs the amended commit when it creates it")
+	require.Equal(t, head.Message, kept.Message)
+	require.Equal(t, head.ParentHashes, kept.ParentHashes)
+	require.Equal(t, head.AuthorEmail, kept.AuthorEmail)
+
+	reworded := AmendedCommit(head, "Add parser\n\nParses the input of the command.\n\nRefs: PROJ-1\n")
+	require.Equal(t, "Add parser", reworded.Subject)
+	require.Equal(t, "Add parser\n\nParses the input of the command.\n\nRefs: PROJ-1", reworded.Message)
+	require.Equal(t, []domain.Trailer{{Key: "Refs", Value: "PROJ-1"}}, reworded.Trailers)
+	require.Equal(t, head.Author, reworded.Author)
+}
+
+func TestValidateStagedAmend(t *testing.T) {
+	repo := &mockRepository{commits: map[string]domain.Commit{
+		"HEAD": domain.NewCommit("a1b2c3", "feat: add parser.", "Dev", "dev@example.com", "", "", false),
+	}}
+
+	cfg := config.NewDefault()
+	commitRules := domain.SelectCommitRules(rules.CreateCommitRules(cfg, RuleServices()), []string{"Subject"}, nil)
+
+	report, err := ValidateStagedAmend(context.Background(), "", commitRules, nil, repo, cfg, &mockLogger{})
+	require.NoError(t, err)
+	require.False(t, report.Summary.AllPassed, "the message of HEAD is validated when none is given")
+
+	report, err = ValidateStagedAmend(context.Background(), "feat: add parser", commitRules, nil, repo, cfg, &mockLogger{})
+	require.NoError(t, err)
+	require.True(t, report.Summary.AllPassed)
+	require.Len(t, report.Commits, 1)
+	require.Empty(t, report.Commits[0].Commit.Hash)
+
+	_, err = ValidateStagedAmend(context.Background(), "feat: add parser", commitRules, nil, &mockRepository{}, cfg, &mockLogger{})
+	require.ErrorContains(t, err, "failed to get the commit to amend")
+}
@@ -0,0 +1,193 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package commands
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	cliAdapter "github.com/itiquette/gommitlint/internal/adapters/cli"
+	"github.com/itiquette/gommitlint/internal/adapters/output"
+	"github.com/itiquette/gommitlint/internal/adapters/signing"
+	"github.com/itiquette/gommitlint/internal/domain"
+	configTypes "github.com/itiquette/gommitlint/internal/domain/config"
+	"github.com/urfave/cli/v3"
+)
+
+// NewAuditCommand creates the audit command and its subcommands.
+func NewAuditCommand() *cli.Command {
+	return &cli.Command{
+		Name:  "audit",
+		Usage: "Produce compliance reports over the repository history",
+		Commands: []*cli.Command{
+			newAuditSignaturesCommand(),
+		},
+	}
+}
+
+// newAuditSignaturesCommand creates the audit signatures subcommand.
+func newAuditSignaturesCommand() *cli.Command {
+	return &cli.Command{
+		Name:  "signatures",
+		Usage: "Report the signature status of every commit in a range",
+		Description: `Produces a signed-history report for the commits in a range. For each commit it
+records whether the commit is signed, the fingerprint of the signing key, the outcome of
+verifying the signature against the trusted keys in signature.key_directory, and whether
+the signer identity matches the committer (resolved through .mailmap).
+
+The report is evidence, not a gate: the command succeeds whatever the signatures are.
+Use the root --format flag to pick text, json, csv or markdown.
+
+Examples:
+  # Show the signature status of a release
+  gommitlint audit signatures --range v1.0.0..v1.1.0
+
+  # Write CSV evidence for a compliance audit
+  gommitlint --format csv audit signatures --range v1.0.0..HEAD --report-file audit.csv`,
+
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:     "range",
+				Usage:    "commit `RANGE` to audit (from..to)",
+				Required: true,
+			},
+			&cli.StringFlag{
+				Name:  "key-dir",
+				Usage: "`DIR` with trusted public keys (default: signature.key_directory and key_directories)",
+			},
+			&cli.StringFlag{
+				Name:  "report-file",
+				Usage: "write the report to `FILE` instead of stdout",
+			},
+		},
+
+		Action: func(ctx context.Context, cmd *cli.Command) error {
+			return ExecuteAuditSignatures(ctx, cmd)
+		},
+	}
+}
+
+// ExecuteAuditSignatures builds the signature audit of the requested range and writes it
+// in the format selected by the root --format flag.
+func ExecuteAuditSignatures(ctx context.Context, cmd *cli.Command) error {
+	format := cmd.Root().String("format")
+	if format == "" {
+		format = "text"
+	}
+
+	if !slices.Contains(output.SupportedAuditFormats(), format) {
+		return fmt.Errorf("unsupported audit format '%s', supported formats: %s",
+			format, strings.Join(output.SupportedAuditFormats(), ", "))
+	}
+
+	target, err := cliAdapter.NewValidationTarget("", "", cmd.String("range"), "", 0)
+	if err != nil {
+		return err
+	}
+
+	cfgResult, err := LoadConfigFromCommand(cmd.Root())
+	if err != nil {
+		return fmt.Errorf("failed to load configuration: %w", err)
+	}
+
+	cfg := cfgResult.Config
+
+	validator := cliAdapter.NewSecurityValidator()
+
+	validatedRepoPath, err := validator.ValidateRepoPath(getRepoPath(cmd))
+	if err != nil {
+		return fmt.Errorf("invalid repository path: %w", err)
+	}
+
+	keyDirs := trustedKeyDirectories(cmd.String("key-dir"), cfg.Signature, validatedRepoPath)
+	if len(keyDirs) == 0 {
+		return errors.New("no trusted keys configured, set signature.key_directory, ssh_agent or known_signers, or pass --key-dir")
+	}
+
+	repo, err := openRepository(ctx, cmd, validatedRepoPath)
+	if err != nil {
+		return fmt.Errorf("failed to open repository: %w", err)
+	}
+
+	commits, err := repo.GetCommitRange(ctx, target.Source, target.Target)
+	if err != nil {
+		return fmt.Errorf("failed to get commit range: %w", err)
+	}
+
+	verifier := signing.NewVerificationAdapterFromConfig(cfg.Signature)
+	audit := domain.SignatureAudit{
+		Range:       cmd.String("range"),
+		GeneratedAt: time.Now().UTC(),
+		Entries:     make([]domain.SignatureAuditEntry, 0, len(commits)),
+	}
+
+	for _, commit := range commits {
+		if err := ctx.Err(); err != nil {
+			return fmt.Errorf("signature audit stopped: %w", err)
+		}
+
+		result := domain.VerificationResult{}
+		if commit.IsSigned() {
+			result = domain.VerifyWithKeyDirectories(keyDirs, func(keyDir string) domain.VerificationResult {
+				return verifier.VerifyCommit(ctx, commit, keyDir)
+			})
+		}
+
+		audit.Entries = append(audit.Entries, domain.NewSignatureAuditEntry(commit, result))
+	}
+
+	report, err := output.FormatSignatureAudit(format, audit,
+		output.AuditOptions{LinkTemplate: linkTemplate(ctx, cmd, cfg, repo)})
+	if err != nil {
+		return err
+	}
+
+	reportFile := cmd.String("report-file")
+	if reportFile == "" {
+		_, err = fmt.Fprint(cmd.Writer, report)
+
+		return err
+	}
+
+	validatedPath, err := validator.ValidateOutputFilePath(reportFile)
+	if err != nil {
+		return err
+	}
+
+	if err := os.WriteFile(validatedPath, []byte(report), 0o600); err != nil {
+		return fmt.Errorf("failed to write report file: %w", err)
+	}
+
+	return nil
+}
+
+// trustedKeyDirectories returns the directories of trusted keys: keyDir when given on the
+// command line, otherwise those configured in signature, relative to repoPath. With only
+// the ssh-agent or known signers trusted it is a single empty directory.
+func trustedKeyDirectories(keyDir string, signature configTypes.SignatureConfig, repoPath string) []string {
+	if keyDir != "" {
+		return []string{keyDir}
+	}
+
+	keyDirs := signature.TrustedKeyDirectories()
+	for index, dir := range keyDirs {
+		if !filepath.IsAbs(dir) {
+			keyDirs[index] = filepath.Join(repoPath, dir)
+		}
+	}
+
+	// The keys of the ssh-agent and known signers are searched with every directory
+	if len(keyDirs) == 0 && signature.HasTrustedKeys() {
+		return []string{""}
+	}
+
+	return keyDirs
+}
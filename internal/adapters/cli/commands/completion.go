@@ -0,0 +1,177 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package commands
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"slices"
+	"sort"
+	"strings"
+
+	cliAdapter "github.com/itiquette/gommitlint/internal/adapters/cli"
+	"github.com/itiquette/gommitlint/internal/adapters/git"
+	"github.com/itiquette/gommitlint/internal/adapters/output"
+	"github.com/itiquette/gommitlint/internal/domain/rules"
+	"github.com/urfave/cli/v3"
+)
+
+// completionFlag is appended to the command line by the shell completion scripts.
+const completionFlag = "--generate-shell-completion"
+
+// flagValueCompleters list the values a flag can take, keyed by flag name.
+var flagValueCompleters = map[string]func(ctx context.Context, cmd *cli.Command) []string{
+	"rule-help":   completeRuleNames,
+	"format":      completeFormats,
+	"base-branch": completeBranches,
+	"profile":     completeProfiles,
+	"git-backend": completeGitBackends,
+}
+
+// EnableValueCompletion makes the shell completion of cmd and its subcommands complete
+// the values of flags as well as commands and flag names.
+func EnableValueCompletion(cmd *cli.Command) {
+	if cmd.ShellComplete == nil {
+		cmd.ShellComplete = CompleteFlagValues
+	}
+
+	for _, subcommand := range cmd.Commands {
+		EnableValueCompletion(subcommand)
+	}
+}
+
+// CompleteFlagValues completes the value of the flag being typed when its values are
+// known, and falls back to completing commands and flag names.
+func CompleteFlagValues(ctx context.Context, cmd *cli.Command) {
+	completions, found := flagValueCompletions(ctx, cmd, completionArgs(os.Args))
+	if !found {
+		cli.DefaultCompleteWithFlags(ctx, cmd)
+
+		return
+	}
+
+	for _, completion := range completions {
+		fmt.Fprintln(cmd.Root().Writer, completion)
+	}
+}
+
+// completionArgs returns the command line arguments without the completion flag.
+func completionArgs(args []string) []string {
+	if len(args) > 0 && args[len(args)-1] == completionFlag {
+		return args[:len(args)-1]
+	}
+
+	return args
+}
+
+// flagValueCompletions returns the values for the flag ending args, either "--flag"
+// awaiting its value or "--flag=partial" when the shell completes the word as a whole.
+func flagValueCompletions(ctx context.Context, cmd *cli.Command, args []string) ([]string, bool) {
+	if len(args) == 0 {
+		return nil, false
+	}
+
+	last := args[len(args)-1]
+	if !strings.HasPrefix(last, "-") {
+		return nil, false
+	}
+
+	name, _, assigned := strings.Cut(strings.TrimLeft(last, "-"), "=")
+
+	completer, known := flagValueCompleters[name]
+	if !known || !hasFlag(cmd, name) {
+		return nil, false
+	}
+
+	values := completer(ctx, cmd)
+	if !assigned {
+		return values, true
+	}
+
+	completions := make([]string, 0, len(values))
+	for _, value := range values {
+		completions = append(completions, "--"+name+"="+value)
+	}
+
+	return completions, true
+}
+
+// hasFlag reports whether cmd or one of its ancestors defines the flag name.
+func hasFlag(cmd *cli.Command, name string) bool {
+	for _, command := range cmd.Lineage() {
+		for _, flag := range command.Flags {
+			if slices.Contains(flag.Names(), name) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// completeRuleNames lists the rule names of the rule registry.
+func completeRuleNames(_ context.Context, _ *cli.Command) []string {
+	return rules.AvailableRuleNames()
+}
+
+// completeGitBackends lists the values of --git-backend.
+func completeGitBackends(_ context.Context, _ *cli.Command) []string {
+	return GitBackends
+}
+
+// completeFormats lists the output formats of the command being completed.
+func completeFormats(_ context.Context, cmd *cli.Command) []string {
+	formats := output.SupportedFormats()
+	if cmd.Name == "signatures" {
+		formats = output.SupportedAuditFormats()
+	}
+
+	sort.Strings(formats)
+
+	return formats
+}
+
+// completeBranches lists the local branches of the repository.
+func completeBranches(ctx context.Context, cmd *cli.Command) []string {
+	repoPath, err := cliAdapter.NewSecurityValidator().ValidateRepoPath(getRepoPath(cmd))
+	if err != nil {
+		return nil
+	}
+
+	repo, err := git.NewRepository(repoPath)
+	if err != nil {
+		return nil
+	}
+
+	branches, err := repo.LocalBranches(ctx)
+	if err != nil {
+		return nil
+	}
+
+	return branches
+}
+
+// completeProfiles lists the profiles of the configuration.
+func completeProfiles(_ context.Context, cmd *cli.Command) []string {
+	// The partly typed profile is not one to load; completion ends the run anyway
+	if err := cmd.Root().Set("profile", ""); err != nil {
+		return nil
+	}
+
+	cfgResult, err := LoadConfigFromCommand(cmd.Root())
+	if err != nil {
+		return nil
+	}
+
+	profiles := make([]string, 0, len(cfgResult.Config.Profiles))
+	for name := range cfgResult.Config.Profiles {
+		profiles = append(profiles, name)
+	}
+
+	sort.Strings(profiles)
+
+	return profiles
+}
@@ -0,0 +1,109 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package commands
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/urfave/cli/v3"
+)
+
+func TestCompleteFlagValues(t *testing.T) {
+	repoPath := initHookTestRepo(t)
+
+	commit := exec.Command("git", "-c", "user.name=Test", "-c", "user.email=test@example.com",
+		"commit", "--allow-empty", "--quiet", "-m", "Initial commit")
+	commit.Dir = repoPath
+	require.NoError(t, commit.Run())
+
+	branch := exec.Command("git", "branch", "release/1.0")
+	branch.Dir = repoPath
+	require.NoError(t, branch.Run())
+
+	require.NoError(t, os.WriteFile(filepath.Join(repoPath, ".gommitlint.yaml"),
+		[]byte("gommitlint:\n  profiles:\n    wip: {}\n    release: {}\n"), 0o600))
+
+	tests := []struct {
+		name     string
+		args     []string
+		expected []string
+	}{
+		{
+			name:     "rule names",
+			args:     []string{"validate", "--rule-help"},
+			expected: []string{"branchahead", "branchticket", "breakingchange"},
+		},
+		{
+			name:     "rule names completed as one word",
+			args:     []string{"validate", "--rule-help=sub"},
+			expected: []string{"--rule-help=branchahead", "--rule-help=branchticket"},
+		},
+		{
+			name:     "local branches",
+			args:     []string{"validate", "--base-branch"},
+			expected: []string{"master", "release/1.0"},
+		},
+		{
+			name:     "report formats",
+			args:     []string{"--format"},
+			expected: []string{"csv", "gerrit", "github", "gitlab", "html", "json", "patchwork", "text", "tsv"},
+		},
+		{
+			name:     "audit formats",
+			args:     []string{"audit", "signatures", "--format"},
+			expected: []string{"csv", "json", "markdown", "text"},
+		},
+		{
+			name:     "configured profiles",
+			args:     []string{"--profile"},
+			expected: []string{"release", "wip"},
+		},
+		{
+			name:     "flag names without known values",
+			args:     []string{"validate", "--count"},
+			expected: []string{"help"},
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			args := append([]string{"gommitlint", "--repo-path", repoPath}, testCase.args...)
+			args = append(args, completionFlag)
+
+			originalArgs := os.Args
+			os.Args = args
+
+			t.Cleanup(func() { os.Args = originalArgs })
+
+			var output strings.Builder
+
+			app := &cli.Command{
+				Name:                  "gommitlint",
+				EnableShellCompletion: true,
+				Flags: []cli.Flag{
+					&cli.StringFlag{Name: "repo-path"},
+					&cli.StringFlag{Name: "gommitconfig"},
+					&cli.BoolFlag{Name: "ignore-config"},
+					&cli.StringFlag{Name: "profile"},
+					&cli.StringFlag{Name: "format", Value: "text"},
+				},
+				Commands: []*cli.Command{NewValidateCommand(), NewAuditCommand()},
+				Writer:   &output,
+			}
+			EnableValueCompletion(app)
+
+			require.NoError(t, app.Run(context.Background(), args))
+
+			completions := strings.Fields(output.String())
+			require.Equal(t, testCase.expected, completions[:min(len(testCase.expected), len(completions))])
+		})
+	}
+}
@@ -8,9 +8,13 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"os"
 	"sort"
+	"strings"
 
+	"github.com/itiquette/gommitlint/internal/adapters/charset"
+	cliAdapter "github.com/itiquette/gommitlint/internal/adapters/cli"
 	"github.com/itiquette/gommitlint/internal/adapters/config"
 	"github.com/itiquette/gommitlint/internal/domain"
 	configTypes "github.com/itiquette/gommitlint/internal/domain/config"
@@ -37,7 +41,10 @@ Examples:
   gommitlint config show --format=yaml
   
   # Show configuration in JSON format
-  gommitlint config show --format=json`,
+  gommitlint config show --format=json
+
+  # Check the configuration file for mistakes
+  gommitlint config validate`,
 
 		Commands: []*cli.Command{
 			{
@@ -77,6 +84,57 @@ Examples:
 					return ExecuteConfigInit(ctx, cmd)
 				},
 			},
+			{
+				Name:  "effective",
+				Usage: "Show merged configuration with value sources",
+				Description: `Prints the fully merged configuration and annotates every value
+with the layer it came from:
+
+  default - built-in default
+  file    - configuration file (repository or global), with its path
+  env     - GOMMITLINT_* environment variable, with its name
+  flag    - command-line flag, with its name
+
+Later layers take precedence: defaults, then the configuration file,
+then environment variables, then flags.
+
+Output is YAML with source comments, or JSON with --format=json.
+
+Examples:
+  # Find out why a setting has its current value
+  gommitlint config effective
+
+  # Machine-readable output
+  gommitlint --format=json config effective`,
+
+				Action: func(ctx context.Context, cmd *cli.Command) error {
+					return ExecuteConfigEffective(ctx, cmd)
+				},
+			},
+			{
+				Name:  "validate",
+				Usage: "Check configuration for errors",
+				Description: `Loads the effective configuration and reports problems in it:
+
+  - unknown keys, with suggestions for likely typos
+  - values of the wrong type
+  - unknown rule names
+  - rules that are both enabled and disabled
+  - invalid values, including those set through environment variables
+
+Exits with a non-zero status when any problem is found, so it can be used in CI.
+
+Examples:
+  # Validate the discovered configuration file
+  gommitlint config validate
+
+  # Validate a specific file
+  gommitlint --gommitconfig=ci/gommitlint.yaml config validate`,
+
+				Action: func(ctx context.Context, cmd *cli.Command) error {
+					return ExecuteConfigValidate(ctx, cmd)
+				},
+			},
 		},
 	}
 }
@@ -94,8 +152,8 @@ func ExecuteConfigShow(_ context.Context, cmd *cli.Command) error {
 
 	// Build effective configuration
 	cfg := cfgResult.Config
-	commitRules := rules.CreateCommitRules(cfg)
-	repoRules := rules.CreateRepositoryRules(cfg)
+	commitRules := rules.CreateCommitRules(cfg, cliAdapter.RuleServices())
+	repoRules := rules.CreateRepositoryRules(cfg, cliAdapter.RuleServices())
 	effectiveConfig := BuildEffectiveConfig(cfg, commitRules, repoRules, cfgResult.Source)
 
 	// Format and output
@@ -134,6 +192,223 @@ func ExecuteConfigInit(_ context.Context, _ *cli.Command) error {
 	return nil
 }
 
+// ExecuteConfigEffective handles the config effective subcommand.
+func ExecuteConfigEffective(_ context.Context, cmd *cli.Command) error {
+	root := cmd.Root()
+
+	cfgResult, err := LoadConfigFromCommand(root)
+	if err != nil {
+		return fmt.Errorf("failed to load configuration: %w", err)
+	}
+
+	cfg := cfgResult.Config
+
+	// --ignore-config uses built-in defaults only, so nothing else can contribute
+	lookup := config.LookupFunc(os.LookupEnv)
+	if root.Bool("ignore-config") {
+		lookup = func(string) (string, bool) { return "", false }
+	}
+
+	provenance := config.ResolveProvenance(cfg, cfgResult.Files, cfgResult.Profile, lookup)
+
+	// The output format is taken from --format when given explicitly
+	format := root.String("format")
+	if root.IsSet("format") {
+		cfg.Output = format
+		provenance = provenance.WithFlag("output", "--format")
+	}
+
+	if format == "json" {
+		err = printEffectiveJSON(cfg, provenance, os.Stdout)
+	} else {
+		err = printEffectiveYAML(cfg, provenance, os.Stdout)
+	}
+
+	if err != nil {
+		return fmt.Errorf("failed to print configuration: %w", err)
+	}
+
+	return nil
+}
+
+// printEffectiveJSON prints the configuration together with the source of every value.
+func printEffectiveJSON(cfg configTypes.Config, provenance config.Provenance, output io.Writer) error {
+	result := struct {
+		Config  configTypes.Config `json:"config"`
+		Sources config.Provenance  `json:"sources"`
+	}{
+		Config:  cfg,
+		Sources: provenance,
+	}
+
+	encoder := json.NewEncoder(output)
+	encoder.SetIndent("", "  ")
+
+	return encoder.Encode(result)
+}
+
+// printEffectiveYAML prints the configuration as YAML with a source comment on every value.
+func printEffectiveYAML(cfg configTypes.Config, provenance config.Provenance, output io.Writer) error {
+	var document yaml.Node
+	if err := document.Encode(map[string]interface{}{"gommitlint": cfg}); err != nil {
+		return fmt.Errorf("failed to encode config as YAML: %w", err)
+	}
+
+	root := &document
+	if root.Kind == yaml.DocumentNode && len(root.Content) > 0 {
+		root = root.Content[0]
+	}
+
+	if root.Kind == yaml.MappingNode && len(root.Content) == 2 {
+		annotateSources(root.Content[1], "", provenance)
+	}
+
+	fmt.Fprintln(output, "# Effective gommitlint configuration")
+	fmt.Fprintln(output, "# Each value is annotated with its source: default, file, env or flag")
+	fmt.Fprintln(output, "")
+
+	encoder := yaml.NewEncoder(output)
+	encoder.SetIndent(2)
+	defer encoder.Close()
+
+	return encoder.Encode(&document)
+}
+
+// annotateSources adds source comments to the values of a YAML mapping node.
+func annotateSources(node *yaml.Node, prefix string, provenance config.Provenance) {
+	if node.Kind != yaml.MappingNode {
+		return
+	}
+
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		keyNode := node.Content[i]
+		valueNode := node.Content[i+1]
+
+		path := keyNode.Value
+		if prefix != "" {
+			path = prefix + "." + path
+		}
+
+		if valueNode.Kind == yaml.MappingNode {
+			annotateSources(valueNode, path, provenance)
+
+			continue
+		}
+
+		source, ok := provenance[path]
+		if !ok {
+			continue
+		}
+
+		if valueNode.Kind == yaml.SequenceNode && len(valueNode.Content) > 0 {
+			keyNode.LineComment = source.String()
+		} else {
+			valueNode.LineComment = source.String()
+		}
+	}
+}
+
+// ExecuteConfigValidate handles the config validate subcommand.
+func ExecuteConfigValidate(_ context.Context, cmd *cli.Command) error {
+	cfgResult, loadErr := LoadConfigFromCommand(cmd.Root())
+
+	var issues []config.Issue
+
+	if len(cfgResult.Files) > 0 {
+		knownRules := rules.AvailableRuleNames()
+		for _, pluginCfg := range cfgResult.Config.Plugins {
+			knownRules = append(knownRules, domain.CleanRuleName(pluginCfg.Name))
+		}
+
+		for _, customRule := range cfgResult.Config.CustomRules {
+			knownRules = append(knownRules, domain.CleanRuleName(customRule.Name))
+		}
+
+		for _, configPath := range cfgResult.Files {
+			lintIssues, err := config.LintFile(configPath, knownRules)
+			if err != nil {
+				lintIssues = append(lintIssues, config.Issue{Message: err.Error()})
+			}
+
+			// Issues name their file when fragments contribute to the configuration
+			if len(cfgResult.Files) > 1 {
+				for i := range lintIssues {
+					lintIssues[i].File = configPath
+				}
+			}
+
+			issues = append(issues, lintIssues...)
+		}
+	}
+
+	if loadErr != nil {
+		issues = append(issues, config.Issue{Message: loadErr.Error()})
+	} else if err := charset.ValidateFallback(cfgResult.Config.Message.FallbackEncoding); err != nil {
+		issues = append(issues, config.Issue{Key: "message.fallback_encoding", Message: err.Error()})
+	}
+
+	var err error
+
+	if cmd.Root().String("format") == "json" {
+		err = printConfigIssuesJSON(cfgResult.Source, issues, os.Stdout)
+	} else {
+		err = printConfigIssuesText(cfgResult.Source, issues, os.Stdout)
+	}
+
+	if err != nil {
+		return fmt.Errorf("failed to print validation result: %w", err)
+	}
+
+	if len(issues) > 0 {
+		os.Exit(1)
+	}
+
+	return nil
+}
+
+// printConfigIssuesText prints configuration issues in human-readable form.
+func printConfigIssuesText(source string, issues []config.Issue, output io.Writer) error {
+	fmt.Fprintf(output, "Configuration Source: %s\n", source)
+
+	if len(issues) == 0 {
+		_, err := fmt.Fprintln(output, "Configuration is valid")
+
+		return err
+	}
+
+	fmt.Fprintf(output, "Found %d problem(s):\n", len(issues))
+
+	for _, issue := range issues {
+		if _, err := fmt.Fprintf(output, "  - %s\n", issue); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// printConfigIssuesJSON prints configuration issues as JSON.
+func printConfigIssuesJSON(source string, issues []config.Issue, output io.Writer) error {
+	if issues == nil {
+		issues = []config.Issue{}
+	}
+
+	result := struct {
+		Source string         `json:"source"`
+		Valid  bool           `json:"valid"`
+		Issues []config.Issue `json:"issues"`
+	}{
+		Source: source,
+		Valid:  len(issues) == 0,
+		Issues: issues,
+	}
+
+	encoder := json.NewEncoder(output)
+	encoder.SetIndent("", "  ")
+
+	return encoder.Encode(result)
+}
+
 // EffectiveConfig represents the resolved configuration with enabled rules.
 type EffectiveConfig struct {
 	Config       configTypes.Config `json:"config"`
@@ -209,6 +484,17 @@ func printConfigText(effectiveConfig EffectiveConfig, output *os.File) (int, err
 
 	// Show configuration source
 	fmt.Fprintf(output, "Configuration Source: %s\n", effectiveConfig.ConfigSource)
+
+	if len(cfg.Profiles) > 0 {
+		profiles := make([]string, 0, len(cfg.Profiles))
+		for name := range cfg.Profiles {
+			profiles = append(profiles, name)
+		}
+
+		sort.Strings(profiles)
+		fmt.Fprintf(output, "Available Profiles: %s\n", strings.Join(profiles, ", "))
+	}
+
 	fmt.Fprintln(output)
 
 	// Sort rules for consistent output
@@ -241,22 +527,84 @@ func printConfigText(effectiveConfig EffectiveConfig, output *os.File) (int, err
 		fmt.Fprintln(output, "  Explicitly Disabled: (none)")
 	}
 
+	if len(cfg.Rules.Order) > 0 {
+		fmt.Fprintf(output, "  Order: %v\n", cfg.Rules.Order)
+	}
+
+	if len(cfg.Rules.Blocking) > 0 {
+		fmt.Fprintf(output, "  Blocking: %v\n", cfg.Rules.Blocking)
+	}
+
+	if len(cfg.Rules.Warnings) > 0 {
+		fmt.Fprintf(output, "  Warnings: %v\n", cfg.Rules.Warnings)
+	}
+
+	fmt.Fprintf(output, "  Related Failures: %s\n", cfg.Rules.RelatedFailures)
+
+	conditionRules := make([]string, 0, len(cfg.Rules.Conditions))
+	for rule := range cfg.Rules.Conditions {
+		conditionRules = append(conditionRules, rule)
+	}
+
+	sort.Strings(conditionRules)
+
+	for _, rule := range conditionRules {
+		fmt.Fprintf(output, "  Condition %s: %s\n", rule, formatRuleCondition(cfg.Rules.Conditions[rule]))
+	}
+
 	fmt.Fprintln(output)
 
 	// Message Configuration
 	fmt.Fprintln(output, "Message Configuration:")
 	fmt.Fprintf(output, "  Subject Max Length: %d\n", cfg.Message.Subject.MaxLength)
+	fmt.Fprintf(output, "  Subject Length Mode: %s\n", cfg.Message.Subject.LengthMode)
 	fmt.Fprintf(output, "  Subject Case: %s\n", cfg.Message.Subject.Case)
 	fmt.Fprintf(output, "  Require Imperative: %t\n", cfg.Message.Subject.RequireImperative)
+	fmt.Fprintf(output, "  Imperative Mode: %s\n", cfg.Message.Subject.Imperative.Mode)
+
+	if len(cfg.Message.Subject.Imperative.Verbs) > 0 {
+		fmt.Fprintf(output, "  Imperative Verbs: %v\n", cfg.Message.Subject.Imperative.Verbs)
+	}
+
+	fmt.Fprintf(output, "  Imperative Language: %s\n", cfg.Message.Subject.Imperative.Language)
+	fmt.Fprintf(output, "  Imperative Skip Non-ASCII: %t\n", cfg.Message.Subject.Imperative.SkipNonASCII)
+
+	if len(cfg.Message.Subject.Imperative.CustomExceptions) > 0 {
+		fmt.Fprintf(output, "  Imperative Custom Exceptions: %v\n", cfg.Message.Subject.Imperative.CustomExceptions)
+	}
+
+	if len(cfg.Message.Subject.Imperative.CustomNonVerbs) > 0 {
+		fmt.Fprintf(output, "  Imperative Custom Non-Verbs: %v\n", cfg.Message.Subject.Imperative.CustomNonVerbs)
+	}
 
 	if len(cfg.Message.Subject.ForbidEndings) > 0 {
 		fmt.Fprintf(output, "  Forbid Endings: %v\n", cfg.Message.Subject.ForbidEndings)
 	}
 
+	if len(cfg.Message.Subject.ExcludedPrefixes) > 0 {
+		fmt.Fprintf(output, "  Excluded Prefixes: %v\n", cfg.Message.Subject.ExcludedPrefixes)
+	}
+
 	fmt.Fprintf(output, "  Body Required: %t\n", cfg.Message.Body.Required)
 	fmt.Fprintf(output, "  Body Min Length: %d\n", cfg.Message.Body.MinLength)
 	fmt.Fprintf(output, "  Allow Signoff Only: %t\n", cfg.Message.Body.AllowSignoffOnly)
 	fmt.Fprintf(output, "  Min Signoff Count: %d\n", cfg.Message.Body.MinSignoffCount)
+	fmt.Fprintf(output, "  Signoff Match Author: %t\n", cfg.Message.Body.SignoffMatchAuthor)
+	fmt.Fprintf(output, "  Signoff Allow Committer: %t\n", cfg.Message.Body.SignoffAllowCommitter)
+	fmt.Fprintf(output, "  Body Min Words: %d\n", cfg.Message.Body.MinWords)
+	fmt.Fprintf(output, "  Body Min Paragraphs: %d\n", cfg.Message.Body.MinParagraphs)
+
+	if len(cfg.Message.Body.MinParagraphsTypes) > 0 {
+		fmt.Fprintf(output, "  Body Min Paragraphs Types: %v\n", cfg.Message.Body.MinParagraphsTypes)
+	}
+
+	fmt.Fprintf(output, "  Forbid Repeated Subject: %t\n", cfg.Message.Body.ForbidRepeatedSubject)
+
+	if cfg.Message.FallbackEncoding != "" {
+		fmt.Fprintf(output, "  Fallback Encoding: %s\n", cfg.Message.FallbackEncoding)
+	}
+
+	fmt.Fprintf(output, "  Parser: %s\n", cfg.Message.Parser)
 	fmt.Fprintln(output)
 
 	// Conventional Commit Configuration
@@ -273,6 +621,32 @@ func printConfigText(effectiveConfig EffectiveConfig, output *os.File) (int, err
 		fmt.Fprintf(output, "  Allowed Scopes: %v\n", cfg.Conventional.Scopes)
 	}
 
+	if cfg.Conventional.ScopeCase != "" {
+		fmt.Fprintf(output, "  Scope Case: %s\n", cfg.Conventional.ScopeCase)
+	}
+
+	if cfg.Conventional.ScopeCharacters != "" {
+		fmt.Fprintf(output, "  Scope Characters: %s\n", cfg.Conventional.ScopeCharacters)
+	}
+
+	fmt.Fprintf(output, "  Max Scope Length: %d\n", cfg.Conventional.MaxScopeLength)
+	fmt.Fprintf(output, "  Max Scopes: %d\n", cfg.Conventional.MaxScopes)
+
+	if len(cfg.Conventional.TypeAliases) > 0 {
+		aliases := make([]string, 0, len(cfg.Conventional.TypeAliases))
+		for alias := range cfg.Conventional.TypeAliases {
+			aliases = append(aliases, alias)
+		}
+
+		sort.Strings(aliases)
+
+		for _, alias := range aliases {
+			fmt.Fprintf(output, "  Type Alias %s: %s\n", alias, cfg.Conventional.TypeAliases[alias])
+		}
+
+		fmt.Fprintf(output, "  Alias Mode: %s\n", cfg.Conventional.AliasMode)
+	}
+
 	fmt.Fprintln(output)
 
 	// Signature Configuration
@@ -284,10 +658,27 @@ func printConfigText(effectiveConfig EffectiveConfig, output *os.File) (int, err
 		fmt.Fprintf(output, "  Key Directory: %s\n", cfg.Signature.KeyDirectory)
 	}
 
+	if len(cfg.Signature.KeyDirectories) > 0 {
+		fmt.Fprintf(output, "  Key Directories: %v\n", cfg.Signature.KeyDirectories)
+	}
+
+	if cfg.Signature.SSHAgent {
+		fmt.Fprintln(output, "  SSH Agent: true")
+	}
+
+	if cfg.Signature.KnownSigners != "" {
+		fmt.Fprintf(output, "  Known Signers: %s\n", cfg.Signature.KnownSigners)
+	}
+
 	if len(cfg.Signature.AllowedSigners) > 0 {
 		fmt.Fprintf(output, "  Allowed Signers: %v\n", cfg.Signature.AllowedSigners)
 	}
 
+	fmt.Fprintf(output, "  Minimum RSA Bits: %d\n", cfg.Signature.MinRSABits)
+	fmt.Fprintf(output, "  Minimum EC Bits: %d\n", cfg.Signature.MinECBits)
+	fmt.Fprintf(output, "  Allowed Algorithms: %v\n", cfg.Signature.AllowedAlgorithms)
+	fmt.Fprintf(output, "  Allow SHA-1: %t\n", cfg.Signature.AllowSHA1)
+
 	fmt.Fprintln(output)
 
 	// Identity Configuration
@@ -299,11 +690,17 @@ func printConfigText(effectiveConfig EffectiveConfig, output *os.File) (int, err
 		fmt.Fprintln(output, "  Allowed Authors: (any)")
 	}
 
+	for _, scope := range cfg.Identity.KeyScopes {
+		fmt.Fprintf(output, "  Key Scope %s: keys in %s for authors %v, paths %v\n",
+			scope.Name, scope.Directory, scope.Authors, scope.Paths)
+	}
+
 	fmt.Fprintln(output)
 
 	// Repository Configuration
 	fmt.Fprintln(output, "Repository Configuration:")
 	fmt.Fprintf(output, "  Max Commits Ahead: %d\n", cfg.Repo.MaxCommitsAhead)
+	fmt.Fprintf(output, "  Max Commits Behind: %d\n", cfg.Repo.MaxCommitsBehind)
 	fmt.Fprintf(output, "  Reference Branch: %s\n", cfg.Repo.ReferenceBranch)
 	fmt.Fprintf(output, "  Allow Merge Commits: %t\n", cfg.Repo.AllowMergeCommits)
 	fmt.Fprintln(output)
@@ -321,6 +718,223 @@ func printConfigText(effectiveConfig EffectiveConfig, output *os.File) (int, err
 		fmt.Fprintf(output, "  Ignore Ticket Patterns: %v\n", cfg.Jira.IgnoreTicketPatterns)
 	}
 
+	fmt.Fprintf(output, "  Online: %t\n", cfg.Jira.Online.Enabled)
+
+	if cfg.Jira.Online.Enabled {
+		fmt.Fprintf(output, "  Online URL: %s\n", cfg.Jira.Online.URL)
+		fmt.Fprintf(output, "  Online Timeout: %s\n", cfg.Jira.Online.Timeout)
+		fmt.Fprintf(output, "  Online Cache TTL: %s\n", cfg.Jira.Online.CacheTTL)
+
+		if cfg.Jira.Online.Sprint != "" {
+			fmt.Fprintf(output, "  Online Sprint: %s (%s)\n", cfg.Jira.Online.Sprint, cfg.Jira.Online.SprintField)
+		}
+	}
+
+	fmt.Fprintln(output)
+
+	// Trailers Configuration
+	fmt.Fprintln(output, "Trailers Configuration:")
+	fmt.Fprintf(output, "  Allowed: %v\n", cfg.Trailers.Allowed)
+	fmt.Fprintf(output, "  Unique: %v\n", cfg.Trailers.Unique)
+
+	if len(cfg.Trailers.Order) > 0 {
+		fmt.Fprintf(output, "  Order: %v\n", cfg.Trailers.Order)
+	}
+
+	requiredTypes := make([]string, 0, len(cfg.Trailers.RequiredByType))
+	for commitType := range cfg.Trailers.RequiredByType {
+		requiredTypes = append(requiredTypes, commitType)
+	}
+
+	sort.Strings(requiredTypes)
+
+	for _, commitType := range requiredTypes {
+		fmt.Fprintf(output, "  Required for %s: %v\n", commitType, cfg.Trailers.RequiredByType[commitType])
+	}
+
+	patternKeys := make([]string, 0, len(cfg.Trailers.Patterns))
+	for key := range cfg.Trailers.Patterns {
+		patternKeys = append(patternKeys, key)
+	}
+
+	sort.Strings(patternKeys)
+
+	for _, key := range patternKeys {
+		fmt.Fprintf(output, "  Pattern of %s: %s\n", key, cfg.Trailers.Patterns[key])
+	}
+
+	fmt.Fprintln(output)
+
+	// Characters Configuration
+	fmt.Fprintln(output, "Characters Configuration:")
+	fmt.Fprintf(output, "  Allow Control: %v\n", cfg.Characters.AllowControl)
+	fmt.Fprintf(output, "  Allow Zero Width: %v\n", cfg.Characters.AllowZeroWidth)
+	fmt.Fprintf(output, "  Allow Bidi: %v\n", cfg.Characters.AllowBidi)
+	fmt.Fprintf(output, "  ASCII Only: %v\n", cfg.Characters.ASCIIOnly)
+
+	if len(cfg.Characters.AllowedScripts) > 0 {
+		fmt.Fprintf(output, "  Allowed Scripts: %v\n", cfg.Characters.AllowedScripts)
+	}
+
+	fmt.Fprintln(output)
+
+	// Issues Configuration
+	fmt.Fprintln(output, "Issues Configuration:")
+	fmt.Fprintf(output, "  Repository: %s\n", cfg.Issues.Repository)
+	fmt.Fprintf(output, "  Require Open: %v\n", cfg.Issues.RequireOpen)
+	fmt.Fprintf(output, "  Timeout: %s\n", cfg.Issues.Timeout)
+	fmt.Fprintf(output, "  Cache TTL: %s\n", cfg.Issues.CacheTTL)
+	fmt.Fprintln(output)
+
+	// Branch Ticket Configuration
+	fmt.Fprintln(output, "Branch Ticket Configuration:")
+	fmt.Fprintf(output, "  Pattern: %s\n", cfg.BranchTicket.Pattern)
+	fmt.Fprintf(output, "  Require Reference: %v\n", cfg.BranchTicket.RequireReference)
+	fmt.Fprintln(output)
+
+	// Subject Pattern Configuration
+	fmt.Fprintln(output, "Subject Pattern Configuration:")
+	fmt.Fprintf(output, "  Pattern: %s\n", cfg.SubjectPattern.Pattern)
+
+	if cfg.SubjectPattern.Message != "" {
+		fmt.Fprintf(output, "  Message: %s\n", cfg.SubjectPattern.Message)
+	}
+
+	patternGroups := make([]string, 0, len(cfg.SubjectPattern.Groups))
+	for group := range cfg.SubjectPattern.Groups {
+		patternGroups = append(patternGroups, group)
+	}
+
+	sort.Strings(patternGroups)
+
+	for _, group := range patternGroups {
+		fmt.Fprintf(output, "  Group %s: %s\n", group, cfg.SubjectPattern.Groups[group])
+	}
+
+	fmt.Fprintln(output)
+
+	// Merge Subject Configuration
+	fmt.Fprintln(output, "Merge Subject Configuration:")
+
+	for _, pattern := range cfg.MergeSubject.Patterns {
+		fmt.Fprintf(output, "  Pattern: %s\n", pattern)
+	}
+
+	fmt.Fprintln(output)
+
+	// Breaking Change Configuration
+	fmt.Fprintln(output, "Breaking Change Configuration:")
+	fmt.Fprintf(output, "  Min Body Length: %d\n", cfg.BreakingChange.MinBodyLength)
+	fmt.Fprintf(output, "  Require Migration: %v\n", cfg.BreakingChange.RequireMigration)
+
+	if len(cfg.BreakingChange.Branches) > 0 {
+		fmt.Fprintf(output, "  Branches: %v\n", cfg.BreakingChange.Branches)
+	}
+
+	if len(cfg.BreakingChange.RequiredTrailers) > 0 {
+		fmt.Fprintf(output, "  Required Trailers: %v\n", cfg.BreakingChange.RequiredTrailers)
+	}
+
+	fmt.Fprintln(output)
+
+	// Revert Configuration
+	fmt.Fprintln(output, "Revert Configuration:")
+	fmt.Fprintf(output, "  Format: %s\n", cfg.Revert.Format)
+
+	if len(cfg.Revert.ExemptRules) > 0 {
+		fmt.Fprintf(output, "  Exempt Rules: %v\n", cfg.Revert.ExemptRules)
+	}
+
+	fmt.Fprintln(output)
+
+	// Fixup Configuration
+	if len(cfg.Fixup.ProtectedBranches) > 0 {
+		fmt.Fprintln(output, "Fixup Configuration:")
+		fmt.Fprintf(output, "  Protected Branches: %v\n", cfg.Fixup.ProtectedBranches)
+		fmt.Fprintln(output)
+	}
+
+	// Force Push Configuration
+	if cfg.ForcePush.Upstream != "" || len(cfg.ForcePush.Branches) > 0 {
+		fmt.Fprintln(output, "Force Push Configuration:")
+
+		if cfg.ForcePush.Upstream != "" {
+			fmt.Fprintf(output, "  Upstream: %s\n", cfg.ForcePush.Upstream)
+		}
+
+		if len(cfg.ForcePush.Branches) > 0 {
+			fmt.Fprintf(output, "  Branches: %v\n", cfg.ForcePush.Branches)
+		}
+
+		fmt.Fprintln(output)
+	}
+
+	// Commit Size Configuration
+	fmt.Fprintln(output, "Commit Size Configuration:")
+	fmt.Fprintf(output, "  Max Lines: %d\n", cfg.CommitSize.MaxLines)
+
+	if cfg.CommitSize.MaxFiles > 0 {
+		fmt.Fprintf(output, "  Max Files: %d\n", cfg.CommitSize.MaxFiles)
+	}
+
+	fmt.Fprintln(output)
+
+	// CLA Configuration
+	fmt.Fprintln(output, "CLA Configuration:")
+	fmt.Fprintf(output, "  Contributors File: %s\n", cfg.CLA.ContributorsFile)
+	fmt.Fprintf(output, "  URL: %s\n", cfg.CLA.URL)
+
+	if cfg.CLA.SignURL != "" {
+		fmt.Fprintf(output, "  Sign URL: %s\n", cfg.CLA.SignURL)
+	}
+
+	fmt.Fprintf(output, "  Offline: %s\n", cfg.CLA.Offline)
+	fmt.Fprintf(output, "  Timeout: %s\n", cfg.CLA.Timeout)
+	fmt.Fprintf(output, "  Cache TTL: %s\n", cfg.CLA.CacheTTL)
+	fmt.Fprintln(output)
+
+	// Links Configuration
+	fmt.Fprintln(output, "Links Configuration:")
+	fmt.Fprintf(output, "  Require HTTPS: %v\n", cfg.Links.RequireHTTPS)
+	fmt.Fprintf(output, "  Forbid: %v\n", cfg.Links.Forbid)
+
+	if len(cfg.Links.AllowedDomains) > 0 {
+		fmt.Fprintf(output, "  Allowed Domains: %v\n", cfg.Links.AllowedDomains)
+	}
+
+	fmt.Fprintln(output)
+
+	// Placeholders Configuration
+	if len(cfg.Placeholders.Patterns) > 0 {
+		fmt.Fprintln(output, "Placeholders Configuration:")
+		fmt.Fprintf(output, "  Patterns: %v\n", cfg.Placeholders.Patterns)
+		fmt.Fprintln(output)
+	}
+
+	// Language Configuration
+	fmt.Fprintln(output, "Language Configuration:")
+	fmt.Fprintf(output, "  Languages: %v\n", cfg.Language.Languages)
+	fmt.Fprintf(output, "  Min Length: %d\n", cfg.Language.MinLength)
+	fmt.Fprintln(output)
+
+	// Review Configuration
+	if len(cfg.Review.Branches) > 0 {
+		fmt.Fprintln(output, "Review Configuration:")
+
+		for _, branch := range cfg.Review.Branches {
+			fmt.Fprintf(output, "  - %s: Reviewed-by >= %d, Acked-by >= %d\n", branch.Branch, branch.MinReviewedBy, branch.MinAckedBy)
+		}
+
+		if len(cfg.Review.Reviewers) > 0 {
+			fmt.Fprintf(output, "  Reviewers: %v\n", cfg.Review.Reviewers)
+		}
+
+		fmt.Fprintln(output)
+	}
+
+	// Change-Id Configuration
+	fmt.Fprintln(output, "Change-Id Configuration:")
+	fmt.Fprintf(output, "  Mode: %s\n", cfg.ChangeID.Mode)
 	fmt.Fprintln(output)
 
 	// Spell Configuration
@@ -333,9 +947,27 @@ func printConfigText(effectiveConfig EffectiveConfig, output *os.File) (int, err
 
 	fmt.Fprintln(output)
 
+	// Plugin Configuration
+	if len(cfg.Plugins) > 0 {
+		fmt.Fprintln(output, "Plugins:")
+
+		for _, pluginCfg := range cfg.Plugins {
+			commandLine := strings.Join(append([]string{pluginCfg.Command}, pluginCfg.Args...), " ")
+			fmt.Fprintf(output, "  - %s: %s\n", pluginCfg.Name, commandLine)
+		}
+
+		fmt.Fprintln(output)
+	}
+
 	// Output Configuration
 	fmt.Fprintln(output, "Output Configuration:")
 	fmt.Fprintf(output, "  Format: %s\n", cfg.Output)
+	fmt.Fprintf(output, "  Theme: %s\n", cfg.Display.Theme)
+	fmt.Fprintf(output, "  ASCII: %t\n", cfg.Display.ASCII)
+
+	if cfg.Display.LinkTemplate != "" {
+		fmt.Fprintf(output, "  Link Template: %s\n", cfg.Display.LinkTemplate)
+	}
 	fmt.Fprintln(output)
 
 	if _, err := fmt.Fprintln(output, "Use --format=json for machine-readable output"); err != nil {
@@ -382,3 +1014,30 @@ func printConfigInitYAML(cfg configTypes.Config, output *os.File) error {
 
 	return nil
 }
+
+// formatRuleCondition describes the criteria of a rule condition on one line.
+func formatRuleCondition(condition configTypes.RuleCondition) string {
+	var criteria []string
+
+	if len(condition.Branches) > 0 {
+		criteria = append(criteria, "branches "+strings.Join(condition.Branches, ", "))
+	}
+
+	if len(condition.Types) > 0 {
+		criteria = append(criteria, "types "+strings.Join(condition.Types, ", "))
+	}
+
+	if len(condition.Authors) > 0 {
+		criteria = append(criteria, "authors "+strings.Join(condition.Authors, ", "))
+	}
+
+	if len(condition.Paths) > 0 {
+		criteria = append(criteria, "paths "+strings.Join(condition.Paths, ", "))
+	}
+
+	if len(criteria) == 0 {
+		return "(always)"
+	}
+
+	return strings.Join(criteria, "; ")
+}
@@ -5,6 +5,7 @@
 package commands
 
 import (
+	"bytes"
 	"encoding/json"
 	"os"
 	"strings"
@@ -23,7 +24,7 @@ func TestNewConfigCommand(t *testing.T) {
 	require.Equal(t, "config", cmd.Name)
 	require.Equal(t, "Configuration operations", cmd.Usage)
 	require.NotEmpty(t, cmd.Description)
-	require.Len(t, cmd.Commands, 2)
+	require.Len(t, cmd.Commands, 4)
 
 	// Check subcommands
 	showCmd := cmd.Commands[0]
@@ -35,6 +36,55 @@ func TestNewConfigCommand(t *testing.T) {
 	require.Equal(t, "init", initCmd.Name)
 	require.Equal(t, "Generate complete configuration file template", initCmd.Usage)
 	require.NotNil(t, initCmd.Action)
+
+	effectiveCmd := cmd.Commands[2]
+	require.Equal(t, "effective", effectiveCmd.Name)
+	require.NotNil(t, effectiveCmd.Action)
+
+	validateCmd := cmd.Commands[3]
+	require.Equal(t, "validate", validateCmd.Name)
+	require.Equal(t, "Check configuration for errors", validateCmd.Usage)
+	require.NotNil(t, validateCmd.Action)
+}
+
+func TestPrintEffectiveYAML(t *testing.T) {
+	cfg := config.NewConfigWithDefaults()
+	provenance := config.Provenance{
+		"message.subject.max_length": {Layer: config.SourceFile, Detail: ".gommitlint.yaml"},
+		"conventional.types":         {Layer: config.SourceEnv, Detail: "GOMMITLINT_CONVENTIONAL_TYPES"},
+		"output":                     {Layer: config.SourceFlag, Detail: "--format"},
+	}
+
+	var buf bytes.Buffer
+
+	err := printEffectiveYAML(cfg, provenance, &buf)
+	require.NoError(t, err)
+
+	output := buf.String()
+	require.Contains(t, output, "max_length: 72 # file .gommitlint.yaml")
+	require.Contains(t, output, "types: # env GOMMITLINT_CONVENTIONAL_TYPES")
+	require.Contains(t, output, "output: text # flag --format")
+
+	// The annotated output is still a loadable configuration
+	var parsed map[string]configTypes.Config
+	require.NoError(t, yaml.Unmarshal(buf.Bytes(), &parsed))
+	require.Equal(t, cfg.Message.Subject.MaxLength, parsed["gommitlint"].Message.Subject.MaxLength)
+}
+
+func TestPrintConfigIssuesText(t *testing.T) {
+	var buf bytes.Buffer
+
+	err := printConfigIssuesText(".gommitlint.yaml", nil, &buf)
+	require.NoError(t, err)
+	require.Contains(t, buf.String(), "Configuration is valid")
+
+	buf.Reset()
+
+	issues := []config.Issue{{Key: "outptu", Message: "unknown key", Suggestion: "output"}}
+	err = printConfigIssuesText(".gommitlint.yaml", issues, &buf)
+	require.NoError(t, err)
+	require.Contains(t, buf.String(), "Found 1 problem(s)")
+	require.Contains(t, buf.String(), `outptu: unknown key (did you mean "output"?)`)
 }
 
 func TestBuildEffectiveConfig(t *testing.T) {
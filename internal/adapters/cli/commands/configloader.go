@@ -19,8 +19,11 @@ import (
 
 // ConfigResult holds both the config and information about where it came from.
 type ConfigResult struct {
-	Config configTypes.Config
-	Source string
+	Config  configTypes.Config
+	Source  string
+	Path    string   // Path of the config file, empty when only defaults are used
+	Files   []string // Files the configuration was loaded from, fragments and included files first
+	Profile string   // Selected profile, empty when none is
 }
 
 // LoadConfigFromCommand loads configuration based on command flags.
@@ -28,11 +31,16 @@ func LoadConfigFromCommand(cmd *cli.Command) (ConfigResult, error) {
 	configPath := cmd.String("gommitconfig")
 	ignoreConfig := cmd.Bool("ignore-config")
 	repoPath := cmd.Root().String("repo-path")
+	profile := cmd.String("profile")
 
 	if configPath != "" && ignoreConfig {
 		return ConfigResult{}, errors.New("cannot specify both --gommitconfig and --ignore-config flags")
 	}
 
+	if profile != "" && ignoreConfig {
+		return ConfigResult{}, errors.New("cannot specify both --profile and --ignore-config flags")
+	}
+
 	if ignoreConfig {
 		// Load only defaults, no file config
 		cfg := config.LoadDefaultConfig()
@@ -51,11 +59,14 @@ func LoadConfigFromCommand(cmd *cli.Command) (ConfigResult, error) {
 			return ConfigResult{}, err
 		}
 
-		cfg, err := config.LoadConfigFromPath(configPath)
+		cfg, err := config.LoadProfileConfigFromPath(configPath, profile)
 
 		return ConfigResult{
-			Config: cfg,
-			Source: configPath + " (--gommitconfig)",
+			Config:  cfg,
+			Source:  withProfileSource(configPath+" (--gommitconfig)", profile),
+			Path:    configPath,
+			Files:   loadedFiles([]string{configPath}),
+			Profile: profile,
 		}, err
 	}
 
@@ -71,16 +82,11 @@ func LoadConfigFromCommand(cmd *cli.Command) (ConfigResult, error) {
 		}
 	}
 
-	// Use validated repo-path for config discovery
-	cfg, err := config.LoadConfigWithRepoPath(validatedRepoPath)
-	if err != nil {
-		return ConfigResult{}, err
-	}
-
-	// Determine the source by checking which config file was actually loaded
+	// Determine the source by checking which config file will be loaded
 	source := "defaults"
 
-	if foundConfigFile := findExistingConfigFileInRepo(validatedRepoPath); foundConfigFile != "" {
+	foundConfigFile := findExistingConfigFileInRepo(validatedRepoPath)
+	if foundConfigFile != "" {
 		if validatedRepoPath != "" {
 			source = foundConfigFile + " (--repo-path)"
 		} else {
@@ -88,12 +94,52 @@ func LoadConfigFromCommand(cmd *cli.Command) (ConfigResult, error) {
 		}
 	}
 
+	// Fragments are merged below the configuration file
+	fragments := config.Fragments(validatedRepoPath)
+	if len(fragments) > 0 {
+		source = fmt.Sprintf("%s + %s", source, strings.Join(fragments, ", "))
+	}
+
+	source = withProfileSource(source, profile)
+
+	// Use validated repo-path for config discovery
+	files := config.ConfigFiles(validatedRepoPath)
+
+	cfg, err := config.LoadProfileConfigFromPaths(files, profile)
+	if err != nil {
+		return ConfigResult{Source: source, Path: foundConfigFile, Files: loadedFiles(files), Profile: profile}, err
+	}
+
 	return ConfigResult{
-		Config: cfg,
-		Source: source,
+		Config:  cfg,
+		Source:  source,
+		Path:    foundConfigFile,
+		Files:   loadedFiles(files),
+		Profile: profile,
 	}, nil
 }
 
+// loadedFiles returns files with the files they include, in the order they are loaded.
+// When the includes cannot be resolved, loading reports why and files are returned as
+// they are.
+func loadedFiles(files []string) []string {
+	withIncludes, err := config.WithIncludes(files)
+	if err != nil {
+		return files
+	}
+
+	return withIncludes
+}
+
+// withProfileSource appends the selected profile to a configuration source description.
+func withProfileSource(source, profile string) string {
+	if profile == "" {
+		return source
+	}
+
+	return fmt.Sprintf("%s, profile %s", source, profile)
+}
+
 // findExistingConfigFile finds the first existing config file using the same logic as the config loader.
 func findExistingConfigFile() string {
 	return findExistingConfigFileInRepo("")
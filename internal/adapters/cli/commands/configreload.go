@@ -0,0 +1,87 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package commands
+
+import (
+	"context"
+	"strings"
+
+	cliAdapter "github.com/itiquette/gommitlint/internal/adapters/cli"
+	"github.com/itiquette/gommitlint/internal/adapters/config"
+	logadapter "github.com/itiquette/gommitlint/internal/adapters/logging"
+	"github.com/urfave/cli/v3"
+)
+
+// configFilePaths returns the files and fragment directories LoadConfigFromCommand may
+// load the configuration from: the --gommitconfig file, or every file searched for, so
+// that creating a configuration file is noticed as well. It is empty with --ignore-config.
+func configFilePaths(cmd *cli.Command) []string {
+	root := cmd.Root()
+
+	if root.Bool("ignore-config") {
+		return nil
+	}
+
+	if configPath := root.String("gommitconfig"); configPath != "" {
+		return []string{configPath}
+	}
+
+	repoPath := root.String("repo-path")
+
+	return append(config.SearchPaths(repoPath), config.FragmentDirs(repoPath)...)
+}
+
+// reloadConfigOnChange reloads the configuration whenever one of its files changes,
+// until ctx is done, and passes each configuration that differs from the previous one
+// to apply. Reloads are logged with the keys that changed; a configuration that fails
+// to load or parse is logged and the previous one kept.
+func reloadConfigOnChange(ctx context.Context, cmd *cli.Command, loaded ConfigResult, apply func(ConfigResult) error) {
+	logger := logadapter.ComponentLogger(ctx, logadapter.ComponentConfig)
+
+	paths := configFilePaths(cmd)
+	if len(paths) == 0 {
+		return
+	}
+
+	current := loaded
+
+	err := cliAdapter.WatchFiles(ctx, paths, cliAdapter.DefaultConfigDebounce, func() {
+		reloaded, err := LoadConfigFromCommand(cmd.Root())
+		if err != nil {
+			logger.Error("Keeping previous configuration", "source", reloaded.Source, "error", err.Error())
+
+			return
+		}
+
+		// The loader skips files it cannot parse, as one being saved may be
+		for _, configPath := range reloaded.Files {
+			if _, err := config.LintFile(configPath, nil); err != nil {
+				logger.Error("Keeping previous configuration", "source", reloaded.Source, "error", err.Error())
+
+				return
+			}
+		}
+
+		changed := config.ChangedKeys(current.Config, reloaded.Config)
+		if len(changed) == 0 {
+			logger.Debug("Configuration files changed without effect", "source", reloaded.Source)
+
+			return
+		}
+
+		if err := apply(reloaded); err != nil {
+			logger.Error("Keeping previous configuration", "source", reloaded.Source, "error", err.Error())
+
+			return
+		}
+
+		current = reloaded
+
+		logger.Info("Reloaded configuration", "source", reloaded.Source, "changed", strings.Join(changed, ", "))
+	})
+	if err != nil {
+		logger.Error("Stopped watching configuration", "error", err.Error())
+	}
+}
@@ -0,0 +1,310 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package commands
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"sync/atomic"
+	"syscall"
+	"time"
+
+	cliAdapter "github.com/itiquette/gommitlint/internal/adapters/cli"
+	"github.com/itiquette/gommitlint/internal/adapters/i18n"
+	"github.com/itiquette/gommitlint/internal/adapters/output"
+	"github.com/itiquette/gommitlint/internal/adapters/plugin"
+	"github.com/itiquette/gommitlint/internal/domain"
+	configTypes "github.com/itiquette/gommitlint/internal/domain/config"
+	"github.com/itiquette/gommitlint/internal/domain/rules"
+	"github.com/urfave/cli/v3"
+)
+
+// NewDaemonCommand creates the daemon subcommand.
+func NewDaemonCommand() *cli.Command {
+	return &cli.Command{
+		Name:  "daemon",
+		Usage: "Validate new commits as they are created, without hooks",
+		Description: `Watches the branches of a repository and validates every commit added to
+them, by committing, amending, rebasing, merging or fetching into a local branch.
+Violations are logged and, optionally, passed to a command or shown as a desktop
+notification. Commits are never rejected; use it where hooks cannot be required.
+
+The command given with --exec runs through the shell with the report on stdin and
+GOMMITLINT_BRANCH, GOMMITLINT_COMMIT, GOMMITLINT_SUBJECT and
+GOMMITLINT_FAILED_RULES (comma-separated) in its environment.
+
+Changes to the configuration files are picked up without a restart and logged with
+the keys that changed; commits found afterwards are validated with the new
+configuration.
+
+Examples:
+  # Log violations in the current repository
+  gommitlint daemon --repo .
+
+  # Also show a desktop notification
+  gommitlint daemon --repo ~/src/project --notify
+
+  # Post violations to a chat webhook
+  gommitlint daemon --exec 'curl -s --data-binary @- https://chat.example.com/hook'`,
+
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:  "repo",
+				Usage: "watch the repository at `PATH` (default: --repo-path or the current directory)",
+			},
+			&cli.StringFlag{
+				Name:  "exec",
+				Usage: "run `COMMAND` through the shell for each commit that fails validation",
+			},
+			&cli.BoolFlag{
+				Name:  "notify",
+				Usage: "show a desktop notification for each commit that fails validation",
+			},
+			&cli.DurationFlag{
+				Name:  "debounce",
+				Value: cliAdapter.DefaultRefDebounce,
+				Usage: "how long ref changes must settle before new commits are validated",
+			},
+			&cli.StringFlag{
+				Name:  "locale",
+				Usage: "translate output to `LOCALE` (e.g., sv; default: i18n.locale or LANG)",
+			},
+		},
+
+		Action: func(ctx context.Context, cmd *cli.Command) error {
+			return ExecuteDaemon(ctx, cmd)
+		},
+	}
+}
+
+// ExecuteDaemon validates the commits added to the branches of the repository until
+// interrupted.
+func ExecuteDaemon(ctx context.Context, cmd *cli.Command) error {
+	cfgResult, err := LoadConfigFromCommand(cmd.Root())
+	if err != nil {
+		return fmt.Errorf("failed to load configuration: %w", err)
+	}
+
+	repoPath := cmd.String("repo")
+	if repoPath == "" {
+		repoPath = getRepoPath(cmd)
+	}
+
+	repoPath, err = cliAdapter.NewSecurityValidator().ValidateRepoPath(repoPath)
+	if err != nil {
+		return fmt.Errorf("invalid repository path: %w", err)
+	}
+
+	gitDir, err := cliAdapter.ResolveGitDir(repoPath)
+	if err != nil {
+		return err
+	}
+
+	repo, err := openRepository(ctx, cmd, repoPath)
+	if err != nil {
+		return fmt.Errorf("failed to open repository: %w", err)
+	}
+
+	format := cmd.Root().String("format")
+	if !output.IsValidFormat(format) {
+		return fmt.Errorf("unsupported format '%s', supported formats: %v", format, output.SupportedFormats())
+	}
+
+	notifier, err := cliAdapter.NewNotifier(cmd.String("exec"), cmd.Bool("notify"))
+	if err != nil {
+		return err
+	}
+
+	initial, err := newDaemonConfig(cmd, cfgResult.Config, repoPath)
+	if err != nil {
+		return err
+	}
+
+	writer := cmd.Root().Writer
+	if writer == nil {
+		writer = os.Stdout
+	}
+
+	options := cliAdapter.NewOutputOptions(writer).
+		WithFormat(format).
+		WithColor(cmd.Root().String("color"))
+
+	options, err = withDisplay(options, cmd, cfgResult.Config)
+	if err != nil {
+		return err
+	}
+
+	ctx, stop := signal.NotifyContext(ctx, os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	tracker, err := cliAdapter.NewRefTracker(ctx, repo)
+	if err != nil {
+		return fmt.Errorf("failed to read branches: %w", err)
+	}
+
+	daemon := commitDaemon{
+		tracker:  tracker,
+		notifier: notifier,
+		repo:     repo,
+		config:   &atomic.Pointer[daemonConfig]{},
+		options:  options,
+	}
+	daemon.config.Store(initial)
+
+	// Commits found after a reload are validated with the new configuration
+	go reloadConfigOnChange(ctx, cmd, cfgResult, func(reloaded ConfigResult) error {
+		next, err := newDaemonConfig(cmd, reloaded.Config, repoPath)
+		if err != nil {
+			return err
+		}
+
+		daemon.config.Store(next)
+
+		return nil
+	})
+
+	if format == "text" {
+		fmt.Fprintf(writer, "Watching %s for new commits (Ctrl+C to stop)\n", repoPath)
+	}
+
+	return cliAdapter.WatchRefs(ctx, gitDir, cmd.Duration("debounce"), func() error {
+		daemon.onRefsChanged(ctx)
+
+		return nil
+	})
+}
+
+// daemonConfig is a configuration of the daemon together with the rules and
+// translations created from it, which are replaced together when it is reloaded.
+type daemonConfig struct {
+	cfg         configTypes.Config
+	catalog     i18n.Catalog
+	commitRules []domain.CommitRule
+	repoRules   []domain.RepositoryRule
+}
+
+// newDaemonConfig creates the rules and loads the translations for cfg.
+func newDaemonConfig(cmd *cli.Command, cfg configTypes.Config, repoPath string) (*daemonConfig, error) {
+	catalog, err := loadCatalog(cmd, cfg, repoPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load translations: %w", err)
+	}
+
+	return &daemonConfig{
+		cfg:         cfg,
+		catalog:     catalog,
+		commitRules: append(rules.CreateCommitRules(cfg, cliAdapter.RuleServices()), plugin.CreateRules(cfg, repoPath)...),
+		repoRules:   rules.CreateRepositoryRules(cfg, cliAdapter.RuleServices()),
+	}, nil
+}
+
+// validate validates commit and translates the report.
+func (c *daemonConfig) validate(commit domain.Commit, repo domain.Repository) domain.Report {
+	report := cliAdapter.ValidateNewCommit(commit, c.commitRules, c.repoRules, repo, c.cfg)
+
+	return c.catalog.TranslateReport(report, c.cfg.Messages)
+}
+
+// commitDaemon validates and reports the commits added to branches.
+type commitDaemon struct {
+	tracker  *cliAdapter.RefTracker
+	notifier cliAdapter.Notifier
+	repo     domain.Repository
+	config   *atomic.Pointer[daemonConfig]
+	options  cliAdapter.OutputOptions
+}
+
+// onRefsChanged validates the commits added since the last change. Failures to read
+// commits or to notify are logged, so that the daemon keeps running.
+func (d commitDaemon) onRefsChanged(ctx context.Context) {
+	added, err := d.tracker.NewCommits(ctx)
+	if err != nil {
+		d.errorf("%v", err)
+	}
+
+	for _, branchCommit := range added {
+		// Merge commits are validated only by the rules for merges, as in validate
+		if branchCommit.Commit.IsMergeCommit && !domain.ValidatesMergeCommits(d.config.Load().commitRules) {
+			continue
+		}
+
+		d.check(ctx, branchCommit)
+	}
+}
+
+// check validates one commit, logs the result and notifies about violations.
+func (d commitDaemon) check(ctx context.Context, branchCommit cliAdapter.BranchCommit) {
+	commit := branchCommit.Commit
+	current := d.config.Load()
+	report := current.validate(commit, d.repo)
+	options := d.options.WithTexts(current.catalog.Text)
+
+	if report.Summary.AllPassed {
+		d.logf("%s %s %s: passed\n", time.Now().Format(time.TimeOnly), branchCommit.Branch, commitLabel(commit))
+
+		return
+	}
+
+	d.logf("%s %s %s: failed\n", time.Now().Format(time.TimeOnly), branchCommit.Branch, commitLabel(commit))
+
+	if err := options.WriteReport(report); err != nil {
+		d.errorf("failed to write report: %v", err)
+	}
+
+	var rendered bytes.Buffer
+
+	textOptions := cliAdapter.NewOutputOptions(&rendered).WithColor("never").WithTexts(options.Texts)
+	if err := textOptions.WriteReport(report); err != nil {
+		d.errorf("failed to render report: %v", err)
+	}
+
+	violation := cliAdapter.Violation{
+		Branch:  branchCommit.Branch,
+		Hash:    commit.Hash,
+		Subject: commit.Subject,
+		Rules:   failedRuleNames(report),
+		Report:  rendered.String(),
+	}
+
+	if err := d.notifier.Notify(ctx, violation); err != nil {
+		d.errorf("%v", err)
+	}
+}
+
+// logf writes a status line; status lines are only meaningful next to the
+// human-readable report.
+func (d commitDaemon) logf(format string, args ...any) {
+	if d.options.Format == "text" {
+		fmt.Fprintf(d.options.Writer, format, args...)
+	}
+}
+
+// errorf reports a failure that does not stop the daemon.
+func (d commitDaemon) errorf(format string, args ...any) {
+	fmt.Fprintf(os.Stderr, "error: "+format+"\n", args...)
+}
+
+// commitLabel returns the abbreviated hash and subject of a commit.
+func commitLabel(commit domain.Commit) string {
+	return domain.ShortHash(commit.Hash) + " " + commit.Subject
+}
+
+// failedRuleNames returns the names of the rules that failed in report.
+func failedRuleNames(report domain.Report) []string {
+	var names []string
+
+	for _, commitReport := range report.Commits {
+		for _, ruleResult := range commitReport.RuleResults {
+			if ruleResult.Status == domain.StatusFailed {
+				names = append(names, ruleResult.Name)
+			}
+		}
+	}
+
+	return names
+}
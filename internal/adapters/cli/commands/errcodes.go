@@ -0,0 +1,128 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package commands
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/itiquette/gommitlint/internal/domain"
+	"github.com/itiquette/gommitlint/internal/domain/errcodes"
+	"github.com/itiquette/gommitlint/internal/domain/rules"
+	"github.com/urfave/cli/v3"
+)
+
+// NewErrorsCommand creates the errors command and its subcommands.
+func NewErrorsCommand() *cli.Command {
+	return &cli.Command{
+		Name:  "errors",
+		Usage: "Inspect the error codes of validation failures",
+		Commands: []*cli.Command{
+			newErrorsListCommand(),
+		},
+	}
+}
+
+// newErrorsListCommand creates the errors list subcommand.
+func newErrorsListCommand() *cli.Command {
+	return &cli.Command{
+		Name:  "list",
+		Usage: "List every error code with its category, description and the rules reporting it",
+		Description: `Lists the error codes failures carry in the code field of JSON, CSV and other
+reports. Unlike messages, which are translated and may be reworded, codes are stable:
+within an enumeration version a code is never removed, renamed or given another
+meaning. JSON reports name the version in errorCodesVersion.
+
+Plugins may report codes of their own, which are not listed.
+
+Examples:
+  # Show the codes as a table
+  gommitlint errors list
+
+  # The same as JSON, for scripts
+  gommitlint --format json errors list`,
+
+		Action: func(_ context.Context, cmd *cli.Command) error {
+			return ExecuteErrorsList(cmd)
+		},
+	}
+}
+
+// errorCodeList is the JSON document of the errors list.
+type errorCodeList struct {
+	Version string           `json:"version"`
+	Codes   []errorCodeEntry `json:"codes"`
+}
+
+// errorCodeEntry is one code in the errors list.
+type errorCodeEntry struct {
+	errcodes.Code
+
+	Rules []string `json:"rules"`
+}
+
+// ExecuteErrorsList lists the error codes.
+func ExecuteErrorsList(cmd *cli.Command) error {
+	entries := listErrorCodes(rules.AllRuleMetadata())
+
+	if cmd.Root().String("format") == "json" {
+		encoder := json.NewEncoder(cmd.Writer)
+		encoder.SetIndent("", "  ")
+
+		return encoder.Encode(errorCodeList{Version: errcodes.Version, Codes: entries})
+	}
+
+	writeErrorCodeList(cmd.Writer, entries)
+
+	return nil
+}
+
+// listErrorCodes returns the error codes, grouped by category, each with the IDs of the
+// built-in rules documenting it in metadata.
+func listErrorCodes(metadata []domain.RuleMetadata) []errorCodeEntry {
+	reportedBy := make(map[domain.ValidationErrorCode][]string)
+
+	for _, rule := range metadata {
+		for _, code := range rule.ErrorCodes {
+			reportedBy[code] = append(reportedBy[code], rule.ID)
+		}
+	}
+
+	codes := errcodes.All()
+	entries := make([]errorCodeEntry, 0, len(codes))
+
+	for _, code := range codes {
+		ruleIDs := append([]string{}, reportedBy[code.Code]...)
+		sort.Strings(ruleIDs)
+
+		entries = append(entries, errorCodeEntry{Code: code, Rules: ruleIDs})
+	}
+
+	return entries
+}
+
+// writeErrorCodeList writes the error codes as an aligned table.
+func writeErrorCodeList(writer io.Writer, entries []errorCodeEntry) {
+	table := tabwriter.NewWriter(writer, 0, 0, 2, ' ', 0)
+
+	fmt.Fprintf(table, "Error codes, version %s\n\n", errcodes.Version)
+	fmt.Fprintln(table, "CODE\tCATEGORY\tRULES\tDESCRIPTION")
+
+	for _, entry := range entries {
+		ruleIDs := "-"
+		if len(entry.Rules) > 0 {
+			ruleIDs = strings.Join(entry.Rules, ",")
+		}
+
+		fmt.Fprintf(table, "%s\t%s\t%s\t%s\n", entry.Code.Code, entry.Category, ruleIDs, entry.Description)
+	}
+
+	_ = table.Flush()
+}
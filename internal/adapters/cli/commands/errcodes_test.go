@@ -0,0 +1,41 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package commands
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/itiquette/gommitlint/internal/domain"
+	"github.com/itiquette/gommitlint/internal/domain/errcodes"
+)
+
+func TestListErrorCodes(t *testing.T) {
+	metadata := []domain.RuleMetadata{
+		{ID: "subject", ErrorCodes: []domain.ValidationErrorCode{domain.ErrSubjectTooLong, domain.ErrInvalidFormat}},
+		{ID: "imperative", ErrorCodes: []domain.ValidationErrorCode{domain.ErrInvalidFormat}},
+	}
+
+	entries := listErrorCodes(metadata)
+	require.Len(t, entries, len(errcodes.All()))
+
+	byCode := make(map[domain.ValidationErrorCode]errorCodeEntry)
+	for _, entry := range entries {
+		byCode[entry.Code.Code] = entry
+	}
+
+	require.Equal(t, []string{"subject"}, byCode[domain.ErrSubjectTooLong].Rules)
+	require.Equal(t, []string{"imperative", "subject"}, byCode[domain.ErrInvalidFormat].Rules)
+	require.Empty(t, byCode[domain.ErrWrongLanguage].Rules)
+
+	var buffer bytes.Buffer
+
+	writeErrorCodeList(&buffer, entries)
+	require.Contains(t, buffer.String(), "Error codes, version "+errcodes.Version)
+	require.Regexp(t, `(?m)^subject_too_long +subject +subject +The subject is longer`, buffer.String())
+	require.Regexp(t, `(?m)^wrong_language +language +- +`, buffer.String())
+}
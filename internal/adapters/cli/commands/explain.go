@@ -0,0 +1,76 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package commands
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/itiquette/gommitlint/internal/adapters/output"
+	"github.com/itiquette/gommitlint/internal/domain/rules"
+	"github.com/urfave/cli/v3"
+)
+
+// NewExplainCommand creates the explain subcommand.
+func NewExplainCommand() *cli.Command {
+	return &cli.Command{
+		Name:      "explain",
+		Usage:     "Explain what a rule checks",
+		ArgsUsage: "[RULE]",
+		Description: `Prints the full documentation of a rule: what it checks, every error code it can
+report, the configuration keys affecting it, and example messages it accepts or rejects.
+The rule is given by its configuration name or its name in reports. Without a rule, the
+known rules are listed.
+
+Examples:
+  # Explain the subject rule
+  gommitlint explain subject
+
+  # Explain a rule as JSON
+  gommitlint --format json explain SignOff`,
+
+		Action: func(_ context.Context, cmd *cli.Command) error {
+			return ExecuteExplain(cmd)
+		},
+	}
+}
+
+// ExecuteExplain writes the documentation of the rule named by the command argument.
+func ExecuteExplain(cmd *cli.Command) error {
+	if cmd.Args().Len() == 0 {
+		fmt.Fprintln(cmd.Writer, "Rules:")
+		fmt.Fprint(cmd.Writer, output.RuleIndexText(rules.AllRuleMetadata()))
+		fmt.Fprintln(cmd.Writer, "\nRun 'gommitlint explain <rule>' for the documentation of a rule.")
+
+		return nil
+	}
+
+	if cmd.Args().Len() > 1 {
+		return fmt.Errorf("expected one rule, got %d", cmd.Args().Len())
+	}
+
+	name := cmd.Args().First()
+
+	metadata, found := rules.FindRuleMetadata(name)
+	if !found {
+		return fmt.Errorf("unknown rule '%s', known rules: %s", name, strings.Join(rules.AvailableRuleNames(), ", "))
+	}
+
+	if cmd.Root().String("format") == "json" {
+		explanation, err := output.RuleExplanationJSON(metadata)
+		if err != nil {
+			return err
+		}
+
+		_, err = fmt.Fprint(cmd.Writer, explanation)
+
+		return err
+	}
+
+	_, err := fmt.Fprint(cmd.Writer, output.RuleExplanationText(metadata))
+
+	return err
+}
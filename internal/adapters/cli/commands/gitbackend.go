@@ -0,0 +1,122 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package commands
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	cliAdapter "github.com/itiquette/gommitlint/internal/adapters/cli"
+	"github.com/itiquette/gommitlint/internal/adapters/git"
+	"github.com/itiquette/gommitlint/internal/adapters/gitexec"
+	logadapter "github.com/itiquette/gommitlint/internal/adapters/logging"
+	"github.com/itiquette/gommitlint/internal/domain"
+	"github.com/urfave/cli/v3"
+)
+
+// Git backends selectable with --git-backend.
+const (
+	GitBackendAuto  = "auto"
+	GitBackendGoGit = "go-git"
+	GitBackendExec  = "exec"
+)
+
+// GitBackends lists the values --git-backend accepts.
+var GitBackends = []string{GitBackendAuto, GitBackendGoGit, GitBackendExec}
+
+// gitRepository is the part of a repository adapter the commands use. Both git
+// backends implement it.
+type gitRepository interface {
+	domain.Repository
+	BranchTips(ctx context.Context) (map[string]string, error)
+}
+
+// openRepository opens the repository at path with the backend selected by --git-backend.
+// The auto backend uses go-git and falls back to the git binary when go-git fails.
+func openRepository(ctx context.Context, cmd *cli.Command, path string) (gitRepository, error) {
+	backend := cmd.Root().String("git-backend")
+
+	switch backend {
+	case GitBackendGoGit:
+		repo, err := git.NewRepository(path)
+		if err != nil {
+			return nil, err
+		}
+
+		return repo, nil
+	case GitBackendExec:
+		repo, err := gitexec.NewRepository(path)
+		if err != nil {
+			return nil, err
+		}
+
+		return repo, nil
+	case GitBackendAuto, "":
+		return openWithFallback(ctx, path)
+	default:
+		return nil, fmt.Errorf("invalid git backend '%s', valid values are: %v", backend, GitBackends)
+	}
+}
+
+// openLocalRepository opens the repository at repoPath after validating the path, and
+// returns the validated path with it.
+func openLocalRepository(ctx context.Context, cmd *cli.Command, validator *cliAdapter.SecurityValidator,
+	repoPath string) (string, domain.Repository, error) {
+	validatedPath, err := validator.ValidateRepoPath(repoPath)
+	if err != nil {
+		return "", nil, fmt.Errorf("invalid repository path: %w", err)
+	}
+
+	repo, err := openRepository(ctx, cmd, validatedPath)
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to open repository: %w", err)
+	}
+
+	return validatedPath, repo, nil
+}
+
+// openWithFallback opens the repository at path with go-git, and with the git binary
+// when go-git fails to open it or to read its HEAD commit while git can. go-git opens
+// some repositories it cannot read, such as those using the SHA-256 object format.
+func openWithFallback(ctx context.Context, path string) (gitRepository, error) {
+	repo, err := git.NewRepository(path)
+	if err == nil {
+		if _, err = repo.GetCommit(ctx, "HEAD"); err == nil {
+			return repo, nil
+		}
+	}
+
+	fallback, execErr := gitexec.NewRepository(path)
+	if execErr != nil {
+		if repo != nil {
+			return repo, nil
+		}
+
+		return nil, errors.Join(err, execErr)
+	}
+
+	// Without commits neither backend reads HEAD
+	if repo != nil {
+		if _, execErr := fallback.GetCommit(ctx, "HEAD"); execErr != nil {
+			return repo, nil
+		}
+	}
+
+	logadapter.ComponentLogger(ctx, logadapter.ComponentCLI).
+		Debug("Using the git binary, go-git cannot read the repository", "error", err.Error())
+
+	return fallback, nil
+}
+
+// ValidateGitBackend checks a --git-backend value.
+func ValidateGitBackend(backend string) error {
+	if !slices.Contains(GitBackends, backend) {
+		return fmt.Errorf("invalid git backend '%s', valid values are: %v", backend, GitBackends)
+	}
+
+	return nil
+}
@@ -0,0 +1,182 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package commands
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/itiquette/gommitlint/internal/adapters/git"
+	"github.com/itiquette/gommitlint/internal/adapters/signing"
+)
+
+// HookManager identifies what owns the Git hooks of a repository.
+type HookManager string
+
+// Hook managers recognized by install-hook.
+const (
+	HookManagerNone      HookManager = ""
+	HookManagerHusky     HookManager = "husky"
+	HookManagerLefthook  HookManager = "lefthook"
+	HookManagerPreCommit HookManager = "pre-commit"
+	HookManagerHooksPath HookManager = "core.hooksPath"
+)
+
+// chainedHookSuffix is appended to an existing hook kept by install-hook --chain.
+const chainedHookSuffix = ".chained"
+
+// lefthookConfigFiles are the configuration files lefthook reads.
+var lefthookConfigFiles = []string{
+	"lefthook.yml", ".lefthook.yml", "lefthook.yaml", ".lefthook.yaml",
+	"lefthook.toml", ".lefthook.toml", "lefthook.json", ".lefthook.json",
+}
+
+// HookSetup describes how hooks are run in a repository.
+type HookSetup struct {
+	Manager HookManager
+	Marker  string // File or setting the manager was detected by
+	// HooksDir is the directory git runs hooks from when core.hooksPath points
+	// inside the repository; empty means the default .git/hooks directory.
+	HooksDir string
+}
+
+// Managed reports whether hooks are owned by a tool that gommitlint should not write into.
+func (s HookSetup) Managed() bool {
+	return s.Manager != HookManagerNone && s.HooksDir == ""
+}
+
+// DetectHookSetup inspects the repository for hook managers and core.hooksPath.
+// repoPath must be a validated repository root.
+func DetectHookSetup(repoPath string) (HookSetup, error) {
+	hooksPath, err := git.HooksPath(repoPath)
+	if err != nil {
+		return HookSetup{}, fmt.Errorf("could not read core.hooksPath: %w", err)
+	}
+
+	if strings.Contains(filepath.ToSlash(hooksPath), ".husky") || fileExists(filepath.Join(repoPath, ".husky")) {
+		return HookSetup{Manager: HookManagerHusky, Marker: ".husky"}, nil
+	}
+
+	for _, name := range lefthookConfigFiles {
+		if fileExists(filepath.Join(repoPath, name)) {
+			return HookSetup{Manager: HookManagerLefthook, Marker: name}, nil
+		}
+	}
+
+	if fileExists(filepath.Join(repoPath, ".pre-commit-config.yaml")) {
+		return HookSetup{Manager: HookManagerPreCommit, Marker: ".pre-commit-config.yaml"}, nil
+	}
+
+	if hooksPath == "" {
+		return HookSetup{}, nil
+	}
+
+	setup := HookSetup{Manager: HookManagerHooksPath, Marker: hooksPath}
+
+	// Hooks directories shared through the repository can be written to;
+	// directories outside it, such as a global hooks path, serve other repositories too
+	hooksDir := expandHome(hooksPath)
+	if !filepath.IsAbs(hooksDir) {
+		hooksDir = filepath.Join(repoPath, hooksDir)
+	}
+
+	if within, err := signing.IsWithinDirectory(hooksDir, repoPath); err == nil && within {
+		setup.HooksDir = filepath.Clean(hooksDir)
+	}
+
+	return setup, nil
+}
+
+// HookSnippet returns instructions for running gommitlint from a managed hook setup.
+func HookSnippet(setup HookSetup, hookType string) string {
+	command := hookCommand(hookType)
+
+	switch setup.Manager {
+	case HookManagerHusky:
+		return fmt.Sprintf("Add to .husky/%s:\n\n  %s\n", hookType, command)
+	case HookManagerLefthook:
+		return fmt.Sprintf("Add to %s:\n\n"+
+			"  %s:\n"+
+			"    commands:\n"+
+			"      gommitlint:\n"+
+			"        run: %s\n%s", setup.Marker, hookType, strings.ReplaceAll(command, `"$1"`, "{1}"), lefthookOptions(hookType))
+	case HookManagerPreCommit:
+		return fmt.Sprintf("Add to .pre-commit-config.yaml:\n\n"+
+			"  repos:\n"+
+			"    - repo: local\n"+
+			"      hooks:\n"+
+			"        - id: gommitlint\n"+
+			"          name: Validate commit message\n"+
+			"          entry: %s\n"+
+			"          language: system\n"+
+			"          stages: [%s]\n\n"+
+			"then run: pre-commit install --hook-type %s\n", preCommitEntry(hookType), hookType, hookType)
+	case HookManagerHooksPath:
+		return fmt.Sprintf("Hooks are read from %s (core.hooksPath), outside this repository.\n"+
+			"Add to %s:\n\n  %s\n",
+			setup.Marker, filepath.Join(setup.Marker, hookType), command)
+	case HookManagerNone:
+	}
+
+	return ""
+}
+
+// hookCommand returns the gommitlint invocation for a hook managed by another tool.
+func hookCommand(hookType string) string {
+	switch hookType {
+	case "pre-push":
+		return `gommitlint validate --pre-push="$1"`
+	case "prepare-commit-msg":
+		return `gommitlint validate --message-file="$1" || true`
+	default:
+		return `gommitlint validate --message-file="$1"`
+	}
+}
+
+// lefthookOptions returns extra lefthook command options a hook type needs.
+func lefthookOptions(hookType string) string {
+	if hookType == "pre-push" {
+		// The pushed ref updates arrive on stdin
+		return "        use_stdin: true\n"
+	}
+
+	return ""
+}
+
+// preCommitEntry returns the entry of a pre-commit framework hook, which receives
+// the message file as argument and the pushed range in environment variables.
+func preCommitEntry(hookType string) string {
+	switch hookType {
+	case "pre-push":
+		return `sh -c 'gommitlint validate --range="$PRE_COMMIT_FROM_REF..$PRE_COMMIT_TO_REF"'`
+	case "prepare-commit-msg":
+		return `sh -c 'gommitlint validate --message-file="$1" || true' --`
+	default:
+		return "gommitlint validate --message-file"
+	}
+}
+
+// expandHome replaces a leading ~/ with the user's home directory, as git does for core.hooksPath.
+func expandHome(path string) string {
+	if !strings.HasPrefix(path, "~/") {
+		return path
+	}
+
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return path
+	}
+
+	return filepath.Join(home, path[2:])
+}
+
+// fileExists reports whether path exists.
+func fileExists(path string) bool {
+	_, err := os.Stat(path)
+
+	return err == nil
+}
@@ -0,0 +1,275 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package commands
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/urfave/cli/v3"
+)
+
+// initHookTestRepo creates a git repository isolated from the user's global git config.
+func initHookTestRepo(t *testing.T) string {
+	t.Helper()
+
+	t.Setenv("HOME", t.TempDir())
+	t.Setenv("XDG_CONFIG_HOME", "")
+
+	repoPath := t.TempDir()
+
+	cmd := exec.Command("git", "init", "--quiet")
+	cmd.Dir = repoPath
+	require.NoError(t, cmd.Run())
+
+	canonical, err := filepath.EvalSymlinks(repoPath)
+	require.NoError(t, err)
+
+	return canonical
+}
+
+func gitConfig(t *testing.T, repoPath string, args ...string) {
+	t.Helper()
+
+	cmd := exec.Command("git", append([]string{"config"}, args...)...)
+	cmd.Dir = repoPath
+	require.NoError(t, cmd.Run())
+}
+
+func TestDetectHookSetup(t *testing.T) {
+	tests := []struct {
+		name     string
+		setup    func(t *testing.T, repoPath string)
+		manager  HookManager
+		marker   string
+		hooksDir string
+		managed  bool
+	}{
+		{
+			name:  "plain repository",
+			setup: func(*testing.T, string) {},
+		},
+		{
+			name: "husky directory",
+			setup: func(t *testing.T, repoPath string) {
+				require.NoError(t, os.Mkdir(filepath.Join(repoPath, ".husky"), 0755))
+			},
+			manager: HookManagerHusky,
+			marker:  ".husky",
+			managed: true,
+		},
+		{
+			name: "husky hooks path",
+			setup: func(t *testing.T, repoPath string) {
+				gitConfig(t, repoPath, "core.hooksPath", ".husky/_")
+			},
+			manager: HookManagerHusky,
+			marker:  ".husky",
+			managed: true,
+		},
+		{
+			name: "lefthook",
+			setup: func(t *testing.T, repoPath string) {
+				require.NoError(t, os.WriteFile(filepath.Join(repoPath, "lefthook.yml"), nil, 0600))
+			},
+			manager: HookManagerLefthook,
+			marker:  "lefthook.yml",
+			managed: true,
+		},
+		{
+			name: "pre-commit framework",
+			setup: func(t *testing.T, repoPath string) {
+				require.NoError(t, os.WriteFile(filepath.Join(repoPath, ".pre-commit-config.yaml"), nil, 0600))
+			},
+			manager: HookManagerPreCommit,
+			marker:  ".pre-commit-config.yaml",
+			managed: true,
+		},
+		{
+			name: "hooks path inside repository",
+			setup: func(t *testing.T, repoPath string) {
+				gitConfig(t, repoPath, "core.hooksPath", "githooks")
+			},
+			manager:  HookManagerHooksPath,
+			marker:   "githooks",
+			hooksDir: "githooks",
+		},
+		{
+			name: "global hooks path",
+			setup: func(t *testing.T, _ string) {
+				home := os.Getenv("HOME")
+				require.NoError(t, os.WriteFile(filepath.Join(home, ".gitconfig"), []byte("[core]\n\thooksPath = ~/.githooks\n"), 0600))
+			},
+			manager: HookManagerHooksPath,
+			marker:  "~/.githooks",
+			managed: true,
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			repoPath := initHookTestRepo(t)
+			testCase.setup(t, repoPath)
+
+			setup, err := DetectHookSetup(repoPath)
+			require.NoError(t, err)
+			require.Equal(t, testCase.manager, setup.Manager)
+			require.Equal(t, testCase.marker, setup.Marker)
+			require.Equal(t, testCase.managed, setup.Managed())
+
+			if testCase.hooksDir != "" {
+				require.Equal(t, filepath.Join(repoPath, testCase.hooksDir), setup.HooksDir)
+			} else {
+				require.Empty(t, setup.HooksDir)
+			}
+
+			if setup.Managed() {
+				require.Contains(t, HookSnippet(setup, "commit-msg"), "gommitlint validate --message-file")
+			}
+		})
+	}
+}
+
+func TestInstallHook_Chain(t *testing.T) {
+	repoPath := initHookTestRepo(t)
+	hookPath := filepath.Join(repoPath, ".git", "hooks", "commit-msg")
+
+	require.NoError(t, os.MkdirAll(filepath.Dir(hookPath), 0755))
+	require.NoError(t, os.WriteFile(hookPath, []byte("#!/bin/sh\necho existing\n"), 0700))
+
+	params := NewHookInstallationParameters(false, repoPath)
+
+	_, err := installHook(params)
+	require.ErrorContains(t, err, "--chain")
+
+	params.Chain = true
+
+	chained, err := installHook(params)
+	require.NoError(t, err)
+	require.Equal(t, hookPath+chainedHookSuffix, chained)
+
+	content, err := os.ReadFile(chained)
+	require.NoError(t, err)
+	require.Equal(t, "#!/bin/sh\necho existing\n", string(content))
+
+	content, err = os.ReadFile(hookPath)
+	require.NoError(t, err)
+	require.Contains(t, string(content), `"$0.chained" "$@"`)
+
+	// Reinstalling keeps the chained hook
+	chained, err = installHook(params)
+	require.NoError(t, err)
+	require.Empty(t, chained)
+	require.FileExists(t, hookPath+chainedHookSuffix)
+
+	// Removal puts the original hook back
+	app := &cli.Command{}
+	require.NoError(t, removeHook(app, repoPath, true))
+
+	content, err = os.ReadFile(hookPath)
+	require.NoError(t, err)
+	require.Equal(t, "#!/bin/sh\necho existing\n", string(content))
+	require.NoFileExists(t, hookPath+chainedHookSuffix)
+}
+
+func TestInstallHook_HooksPath(t *testing.T) {
+	repoPath := initHookTestRepo(t)
+	gitConfig(t, repoPath, "core.hooksPath", "githooks")
+
+	setup, err := DetectHookSetup(repoPath)
+	require.NoError(t, err)
+
+	params := NewHookInstallationParameters(false, repoPath)
+	params.HooksDir = setup.HooksDir
+
+	_, err = installHook(params)
+	require.NoError(t, err)
+	require.FileExists(t, filepath.Join(repoPath, "githooks", "commit-msg"))
+	require.NoFileExists(t, filepath.Join(repoPath, ".git", "hooks", "commit-msg"))
+}
+
+// runHookCommand runs an install-hook or remove-hook subcommand with the given arguments.
+func runHookCommand(t *testing.T, command *cli.Command, args ...string) (string, error) {
+	t.Helper()
+
+	var output strings.Builder
+
+	command.Writer = &output
+	app := &cli.Command{
+		Name:     "gommitlint",
+		Flags:    []cli.Flag{&cli.StringFlag{Name: "repo-path"}},
+		Commands: []*cli.Command{command},
+		Writer:   &output,
+	}
+
+	err := app.Run(context.Background(), append([]string{"gommitlint"}, args...))
+
+	return output.String(), err
+}
+
+func TestInstallHook_AllWorktrees(t *testing.T) {
+	repoPath := initHookTestRepo(t)
+
+	commit := exec.Command("git", "-c", "user.name=Test", "-c", "user.email=test@example.com",
+		"commit", "--allow-empty", "--quiet", "-m", "Initial commit")
+	commit.Dir = repoPath
+	require.NoError(t, commit.Run())
+
+	linkedPath := filepath.Join(t.TempDir(), "linked")
+	worktree := exec.Command("git", "worktree", "add", "--quiet", linkedPath)
+	worktree.Dir = repoPath
+	require.NoError(t, worktree.Run())
+
+	hookPath := filepath.Join(repoPath, ".git", "hooks", "pre-push")
+
+	_, err := runHookCommand(t, NewInstallHookCommand(),
+		"--repo-path", linkedPath, "install-hook", "--all-worktrees", "--hook-type", "pre-push")
+	require.NoError(t, err)
+
+	content, err := os.ReadFile(hookPath)
+	require.NoError(t, err)
+	require.Contains(t, string(content), "gommitlint pre-push hook")
+
+	_, err = runHookCommand(t, NewRemoveHookCommand(),
+		"--repo-path", linkedPath, "remove-hook", "--all-worktrees", "--hook-type", "pre-push")
+	require.NoError(t, err)
+	require.NoFileExists(t, hookPath)
+}
+
+func TestInstallHook_Global(t *testing.T) {
+	repoPath := initHookTestRepo(t)
+	home := os.Getenv("HOME")
+	t.Setenv("XDG_CONFIG_HOME", filepath.Join(home, ".config"))
+
+	output, err := runHookCommand(t, NewInstallHookCommand(), "--repo-path", repoPath, "install-hook", "--global")
+	require.NoError(t, err)
+	require.Contains(t, output, "Set global core.hooksPath")
+
+	hooksDir := filepath.Join(home, ".config", "gommitlint", "hooks")
+	require.FileExists(t, filepath.Join(hooksDir, "commit-msg"))
+	require.NoFileExists(t, filepath.Join(repoPath, ".git", "hooks", "commit-msg"))
+
+	config, err := os.ReadFile(filepath.Join(home, ".gitconfig"))
+	require.NoError(t, err)
+	require.Contains(t, string(config), hooksDir)
+
+	// A second hook type goes into the configured directory without touching the setting
+	output, err = runHookCommand(t, NewInstallHookCommand(), "install-hook", "--global", "--hook-type", "prepare-commit-msg")
+	require.NoError(t, err)
+	require.NotContains(t, output, "Set global core.hooksPath")
+	require.FileExists(t, filepath.Join(hooksDir, "prepare-commit-msg"))
+
+	_, err = runHookCommand(t, NewInstallHookCommand(), "install-hook", "--global", "--all-worktrees")
+	require.ErrorContains(t, err, "cannot be combined")
+
+	_, err = runHookCommand(t, NewRemoveHookCommand(), "remove-hook", "--global")
+	require.NoError(t, err)
+	require.NoFileExists(t, filepath.Join(hooksDir, "commit-msg"))
+}
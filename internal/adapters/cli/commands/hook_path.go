@@ -5,7 +5,10 @@
 package commands
 
 import (
+	"fmt"
+
 	cliAdapter "github.com/itiquette/gommitlint/internal/adapters/cli"
+	"github.com/itiquette/gommitlint/internal/adapters/signing"
 )
 
 // FindHookPath determines the Git hook file path for the given repository and hook type.
@@ -27,3 +30,12 @@ func EnsureHooksDirectory(repoPath string, validator cliAdapter.PathValidator) e
 
 	return validator.EnsureHooksDirectory(repoPath)
 }
+
+// hooksDirPath returns the path of a hook in a hooks directory configured with core.hooksPath.
+func hooksDirPath(hooksDir, hookType string) (string, error) {
+	if !signing.IsValidGitHookType(hookType) {
+		return "", fmt.Errorf("invalid hook type: %s", hookType)
+	}
+
+	return signing.SafeJoin(hooksDir, hookType)
+}
@@ -6,26 +6,53 @@ package commands
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os"
+	"path/filepath"
+	"slices"
+	"strings"
 
 	cliAdapter "github.com/itiquette/gommitlint/internal/adapters/cli"
+	"github.com/itiquette/gommitlint/internal/adapters/git"
 	logadapter "github.com/itiquette/gommitlint/internal/adapters/logging"
 	"github.com/itiquette/gommitlint/internal/adapters/signing"
 	"github.com/urfave/cli/v3"
 )
 
+// Hook types install-hook can generate a script for.
+var supportedHookTypes = []string{"commit-msg", "pre-push", "prepare-commit-msg"}
+
 // NewInstallHookCommand creates the install-hook subcommand.
 func NewInstallHookCommand() *cli.Command {
 	return &cli.Command{
 		Name:  "install-hook",
-		Usage: "Install Git commit-msg hook for validation",
-		Description: `Installs a Git commit-msg hook to automatically validate commit messages.
+		Usage: "Install Git hook for commit validation",
+		Description: `Installs a Git hook to automatically validate commit messages.
+
+When the repository uses husky, lefthook or the pre-commit framework, the snippet
+to add to that tool's configuration is printed instead. A core.hooksPath inside
+the repository is installed into; one outside it gets a snippet.
+
+Hook types:
+  commit-msg          Reject commits whose message fails validation (default)
+  pre-push            Validate the commits being pushed
+  prepare-commit-msg  Report problems in messages given with -m or reused with
+                      --amend before the editor opens, without blocking
 
 Examples:
   # Install commit-msg hook in the current repository
   gommitlint install-hook
 
+  # Keep an existing hook and run it before gommitlint
+  gommitlint install-hook --chain
+
+  # Validate commits before they are pushed
+  gommitlint install-hook --hook-type=pre-push
+
+  # Install for every repository of the current user
+  gommitlint install-hook --global
+
   # Install commit-msg hook with force
   gommitlint install-hook --force`,
 
@@ -35,6 +62,27 @@ Examples:
 				Aliases: []string{"f"},
 				Usage:   "overwrite existing hook if it exists",
 			},
+			&cli.BoolFlag{
+				Name:  "chain",
+				Usage: "keep an existing hook and run it before gommitlint",
+			},
+			&cli.BoolFlag{
+				Name:  "ignore-manager",
+				Usage: "install into .git/hooks even when a hook manager is detected",
+			},
+			&cli.StringFlag{
+				Name:  "hook-type",
+				Value: "commit-msg",
+				Usage: "`TYPE` of hook to install: commit-msg, pre-push or prepare-commit-msg",
+			},
+			&cli.BoolFlag{
+				Name:  "global",
+				Usage: "install into the user-level core.hooksPath, setting it if needed",
+			},
+			&cli.BoolFlag{
+				Name:  "all-worktrees",
+				Usage: "install into the hooks directory shared by all worktrees, also from a linked worktree",
+			},
 		},
 
 		Action: func(ctx context.Context, cmd *cli.Command) error {
@@ -45,51 +93,191 @@ Examples:
 
 // ExecuteInstallHook orchestrates the hook installation process.
 func ExecuteInstallHook(ctx context.Context, cmd *cli.Command) error {
-	// Get flags
-	force := cmd.Bool("force")
-	repoPath := getRepoPath(cmd)
-
 	// Create logger from context
-	zerologLogger := logadapter.GetLogger(ctx)
-	logger := logadapter.NewDomainLogger(zerologLogger)
+	logger := logadapter.ComponentLogger(ctx, logadapter.ComponentHooks)
+
+	params, setGlobal, err := resolveInstallTarget(cmd)
+	if err != nil {
+		logger.Error("Hook installation failed", "error", err)
+
+		return err
+	}
+
+	if !cmd.Bool("global") && !cmd.Bool("ignore-manager") {
+		// Cooperate with hook managers instead of writing hooks they own
+		setup, err := DetectHookSetup(params.RepoPath)
+		if err != nil {
+			return err
+		}
+
+		if setup.Managed() {
+			fmt.Fprintf(cmd.Writer, "Hooks in this repository are managed by %s (%s); no hook was written.\n\n", setup.Manager, setup.Marker)
+			fmt.Fprint(cmd.Writer, HookSnippet(setup, params.HookType))
+
+			return nil
+		}
+
+		// A core.hooksPath is used by every worktree
+		if setup.HooksDir != "" {
+			params.HooksDir = setup.HooksDir
+		}
+	}
 
 	// Install the hook
-	if err := installHook(force, repoPath); err != nil {
+	chained, err := installHook(params)
+	if err != nil {
 		logger.Error("Hook installation failed", "error", err)
 
 		return err
 	}
 
-	fmt.Fprintln(cmd.Writer, "✅ Git commit-msg hook installed successfully!")
+	if setGlobal {
+		if err := git.SetGlobalHooksPath(ctx, params.HooksDir); err != nil {
+			return err
+		}
+
+		fmt.Fprintf(cmd.Writer, "Set global core.hooksPath to %s; hooks in .git/hooks of your repositories no longer run.\n", params.HooksDir)
+	}
+
+	fmt.Fprintf(cmd.Writer, "✅ Git %s hook installed successfully!\n", params.HookType)
+
+	if chained != "" {
+		fmt.Fprintf(cmd.Writer, "The existing hook was kept as %s and runs before gommitlint.\n", chained)
+	}
 
 	return nil
 }
 
-// installHook installs a Git commit-msg hook in the specified repository.
-func installHook(force bool, repoPath string) error {
-	// Validate and normalize the repository path using signing utilities
-	validatedPath, err := signing.ValidateGitRepoPath(repoPath)
+// resolveInstallTarget builds installation parameters from the command flags.
+// It reports whether the global core.hooksPath must be set after installing.
+func resolveInstallTarget(cmd *cli.Command) (HookInstallationParameters, bool, error) {
+	hookType := cmd.String("hook-type")
+	if err := validateHookType(hookType); err != nil {
+		return HookInstallationParameters{}, false, err
+	}
+
+	params := NewHookInstallationParameters(cmd.Bool("force"), "")
+	params.Chain = cmd.Bool("chain")
+	params.HookType = hookType
+
+	if !cmd.Bool("global") {
+		repoPath, hooksDir, err := resolveHooksDir(getRepoPath(cmd), cmd.Bool("all-worktrees"))
+		if err != nil {
+			return HookInstallationParameters{}, false, err
+		}
+
+		params.RepoPath = repoPath
+		params.HooksDir = hooksDir
+
+		return params, false, nil
+	}
+
+	if cmd.Bool("all-worktrees") {
+		return HookInstallationParameters{}, false, errors.New("--global and --all-worktrees cannot be combined")
+	}
+
+	hooksDir, configured, err := globalHooksDir()
 	if err != nil {
-		return fmt.Errorf("invalid repository path: %w", err)
+		return HookInstallationParameters{}, false, err
 	}
 
-	// Create parameters with defaults
-	params := NewHookInstallationParameters(force, validatedPath)
+	params.HooksDir = hooksDir
+
+	return params, !configured, nil
+}
+
+// resolveHooksDir returns the repository root and, for allWorktrees, the hooks
+// directory shared by its worktrees. An empty hooks directory means the default one.
+func resolveHooksDir(repoPath string, allWorktrees bool) (string, string, error) {
+	if !allWorktrees {
+		// Validate and normalize the repository path using signing utilities
+		validatedPath, err := signing.ValidateGitRepoPath(repoPath)
+		if err != nil {
+			return "", "", fmt.Errorf("invalid repository path: %w", err)
+		}
+
+		return validatedPath, "", nil
+	}
+
+	absPath, err := filepath.Abs(repoPath)
+	if err != nil {
+		return "", "", fmt.Errorf("invalid repository path: %w", err)
+	}
+
+	worktrees, err := git.ListWorktrees(absPath)
+	if err != nil {
+		return "", "", fmt.Errorf("invalid repository path: %w", err)
+	}
+
+	// Hook managers are configured in the main worktree
+	root := absPath
+	if len(worktrees.Roots) > 0 {
+		root = worktrees.Roots[0]
+	}
+
+	return root, filepath.Join(worktrees.CommonDir, "hooks"), nil
+}
 
+// validateHookType checks that install-hook can generate a hook of the given type.
+func validateHookType(hookType string) error {
+	if !slices.Contains(supportedHookTypes, hookType) {
+		return fmt.Errorf("unsupported hook type %q (supported: %s)", hookType, strings.Join(supportedHookTypes, ", "))
+	}
+
+	return nil
+}
+
+// globalHooksDir returns the user-level hooks directory and whether core.hooksPath
+// already points to it. Without a global core.hooksPath a gommitlint directory in
+// the user configuration directory is used.
+func globalHooksDir() (string, bool, error) {
+	configured, err := git.GlobalHooksPath()
+	if err != nil {
+		return "", false, err
+	}
+
+	if configured != "" {
+		return expandHome(configured), true, nil
+	}
+
+	configDir, err := os.UserConfigDir()
+	if err != nil {
+		return "", false, fmt.Errorf("cannot locate user configuration directory: %w", err)
+	}
+
+	return filepath.Join(configDir, "gommitlint", "hooks"), false, nil
+}
+
+// installHook installs a Git hook as described by params.
+// It returns the path an existing hook was moved to when chaining, or empty.
+func installHook(params HookInstallationParameters) (string, error) {
 	// Ensure hooks directory exists
-	if err := EnsureHooksDirectory(params.RepoPath, params.PathValidator); err != nil {
-		return err
+	if params.HooksDir == "" {
+		if err := EnsureHooksDirectory(params.RepoPath, params.PathValidator); err != nil {
+			return "", err
+		}
+	} else if err := os.MkdirAll(params.HooksDir, 0755); err != nil {
+		return "", fmt.Errorf("could not create hooks directory: %w", err)
 	}
 
 	// Check if we can install the hook
 	if err := params.CanInstallHook(); err != nil {
-		return err
+		return "", err
 	}
 
 	// Get the hook path
-	hookPath, err := FindHookPath(params.RepoPath, params.HookType, params.PathValidator)
+	hookPath, err := params.HookPath()
 	if err != nil {
-		return err
+		return "", err
+	}
+
+	chained := ""
+
+	if params.Chain {
+		chained, err = chainExistingHook(hookPath)
+		if err != nil {
+			return "", err
+		}
 	}
 
 	// Get the hook content
@@ -97,23 +285,59 @@ func installHook(force bool, repoPath string) error {
 
 	// Write the hook file using our secure file writing function
 	if err := signing.SafeWriteFile(hookPath, []byte(hookContent), 0700); err != nil {
-		return fmt.Errorf("could not write hook file: %w", err)
+		return "", fmt.Errorf("could not write hook file: %w", err)
 	}
 
-	return nil
+	return chained, nil
+}
+
+// chainExistingHook moves a hook not installed by gommitlint aside so the gommitlint
+// hook can run it. It returns the new path, or empty if there was nothing to chain.
+func chainExistingHook(hookPath string) (string, error) {
+	content, err := os.ReadFile(hookPath)
+	if os.IsNotExist(err) {
+		return "", nil
+	}
+
+	if err != nil {
+		return "", fmt.Errorf("could not read existing hook: %w", err)
+	}
+
+	// Reinstalling over our own hook keeps whatever it already chains
+	if isGommitlintHookContent(content) {
+		return "", nil
+	}
+
+	chainedPath := hookPath + chainedHookSuffix
+	if fileExists(chainedPath) {
+		return "", fmt.Errorf("cannot chain hook: %s already exists", chainedPath)
+	}
+
+	if err := os.Rename(hookPath, chainedPath); err != nil {
+		return "", fmt.Errorf("could not keep existing hook: %w", err)
+	}
+
+	return chainedPath, nil
+}
+
+// isGommitlintHookContent reports whether hook content was generated by install-hook.
+func isGommitlintHookContent(content []byte) bool {
+	return strings.Contains(string(content), "Generated by gommitlint install-hook")
 }
 
 // HookInstallationParameters contains all parameters needed for hook installation.
 type HookInstallationParameters struct {
 	Force         bool
+	Chain         bool // Keep an existing hook and run it first
 	RepoPath      string
 	HookType      string
+	HooksDir      string // Hooks directory from core.hooksPath; empty for .git/hooks
 	PathValidator cliAdapter.PathValidator
 }
 
 // NewHookInstallationParameters creates HookInstallationParameters with defaults.
 func NewHookInstallationParameters(force bool, repoPath string) HookInstallationParameters {
-	// Default to commit-msg hook type (currently the only supported type)
+	// Default to commit-msg hook type
 	return HookInstallationParameters{
 		Force:         force,
 		RepoPath:      repoPath,
@@ -124,13 +348,28 @@ func NewHookInstallationParameters(force bool, repoPath string) HookInstallation
 
 // GetHookContent returns the content for the hook based on its type.
 func (p HookInstallationParameters) GetHookContent() string {
-	// Currently, only commit-msg hooks are supported
-	return generateCommitMsgHook()
+	switch p.HookType {
+	case "pre-push":
+		return generatePrePushHook()
+	case "prepare-commit-msg":
+		return generatePrepareCommitMsgHook()
+	default:
+		return generateCommitMsgHook()
+	}
+}
+
+// HookPath returns the path of the hook file to install.
+func (p HookInstallationParameters) HookPath() (string, error) {
+	if p.HooksDir != "" {
+		return hooksDirPath(p.HooksDir, p.HookType)
+	}
+
+	return FindHookPath(p.RepoPath, p.HookType, p.PathValidator)
 }
 
 // CanInstallHook checks if a hook can be installed based on parameters.
 func (p HookInstallationParameters) CanInstallHook() error {
-	hookPath, err := FindHookPath(p.RepoPath, p.HookType, p.PathValidator)
+	hookPath, err := p.HookPath()
 	if err != nil {
 		return err
 	}
@@ -141,8 +380,8 @@ func (p HookInstallationParameters) CanInstallHook() error {
 		// File exists
 		file.Close()
 
-		if !p.Force {
-			return fmt.Errorf("hook already exists at %s (use --force to overwrite)", hookPath)
+		if !p.Force && !p.Chain {
+			return fmt.Errorf("hook already exists at %s (use --force to overwrite, or --chain to keep it and run it first)", hookPath)
 		}
 	} else if !os.IsNotExist(err) {
 		// Some other error occurred
@@ -177,6 +416,11 @@ set -e
 # Get the commit message file path
 COMMIT_MSG_FILE=$1
 
+# Run the hook kept by install-hook --chain first
+if [ -x "$0.chained" ]; then
+    "$0.chained" "$@"
+fi
+
 # Check if gommitlint is available
 if ! command -v gommitlint >/dev/null 2>&1; then
     echo "Error: gommitlint not found in PATH" >&2
@@ -213,3 +457,102 @@ else
 fi
 `
 }
+
+// hookScriptPreamble returns the shared start of generated hook scripts: the header,
+// running a hook kept by --chain with chainInput on stdin when given, and the check
+// that gommitlint is installed.
+func hookScriptPreamble(hookType, purpose, chainInput string) string {
+	chain := `"$0.chained" "$@"`
+	if chainInput != "" {
+		chain = `printf '%s\n' "` + chainInput + `" | ` + chain
+	}
+
+	return `#!/bin/sh
+#
+# gommitlint ` + hookType + ` hook for ` + purpose + `.
+# Generated by gommitlint install-hook command.
+#
+# Environment variables:
+#   GOMMITLINT_DEBUG=1  Enable debug output
+#   NO_COLOR=1          Disable colored output
+#
+
+set -e
+` + readHookInput(chainInput) + `
+# Run the hook kept by install-hook --chain first
+if [ -x "$0.chained" ]; then
+    ` + chain + `
+fi
+
+# Check if gommitlint is available
+if ! command -v gommitlint >/dev/null 2>&1; then
+    echo "Error: gommitlint not found in PATH" >&2
+    echo "Please ensure gommitlint is installed and in your PATH" >&2
+    echo "See: https://github.com/itiquette/gommitlint#installation" >&2
+    exit 1
+fi
+
+# Build command flags
+FLAGS=""
+
+if [ -n "$NO_COLOR" ] || [ ! -t 2 ]; then
+    FLAGS="$FLAGS --color=never"
+fi
+
+if [ -n "$GOMMITLINT_DEBUG" ]; then
+    FLAGS="$FLAGS --debug"
+fi
+`
+}
+
+// readHookInput returns script lines saving the hook's stdin in the variable named
+// by input, so both a chained hook and gommitlint can read it.
+func readHookInput(input string) string {
+	if input == "" {
+		return ""
+	}
+
+	return `
+# Keep the input git passes on stdin for the chained hook and gommitlint
+` + strings.TrimPrefix(input, "$") + `=$(cat)
+`
+}
+
+// generatePrepareCommitMsgHook generates content for the prepare-commit-msg hook.
+// Messages given with -m or reused with -c/--amend are checked before the editor
+// opens; the hook only reports problems, the commit-msg hook is what rejects them.
+func generatePrepareCommitMsgHook() string {
+	return hookScriptPreamble("prepare-commit-msg", "early commit message feedback", "") + `
+COMMIT_MSG_FILE=$1
+COMMIT_SOURCE=$2
+
+case "$COMMIT_SOURCE" in
+    message|commit)
+        gommitlint validate --message-file="$COMMIT_MSG_FILE" $FLAGS >&2 || true
+        ;;
+esac
+
+exit 0
+`
+}
+
+// generatePrePushHook generates content for the pre-push hook.
+// gommitlint reads the pushed ref updates from stdin and validates the commits
+// the remote does not have yet.
+func generatePrePushHook() string {
+	return hookScriptPreamble("pre-push", "validating pushed commit messages", "$PUSH_UPDATES") + `
+REMOTE=$1
+
+if printf '%s\n' "$PUSH_UPDATES" | gommitlint validate --pre-push="$REMOTE" $FLAGS; then
+    exit 0
+else
+    echo "" >&2
+    echo "Push rejected due to commit message validation errors." >&2
+    echo "" >&2
+    echo "Options:" >&2
+    echo "  - Reword the commits above with 'git rebase -i' and push again" >&2
+    echo "  - Use 'git push --no-verify' to bypass (not recommended)" >&2
+    exit 1
+fi
+`
+}
@@ -193,3 +193,38 @@ func (m *mockPathValidator) EnsureHooksDirectory(_ string) error {
 
 // Ensure mock implements the interface.
 var _ cliAdapter.PathValidator = (*mockPathValidator)(nil)
+
+func TestHookInstallationParameters_GetHookContentByType(t *testing.T) {
+	tests := []struct {
+		hookType string
+		contains []string
+	}{
+		{
+			hookType: "commit-msg",
+			contains: []string{"gommitlint validate --message-file="},
+		},
+		{
+			hookType: "prepare-commit-msg",
+			contains: []string{"gommitlint prepare-commit-msg hook", `message|commit)`, "|| true", "exit 0"},
+		},
+		{
+			hookType: "pre-push",
+			contains: []string{"gommitlint pre-push hook", "PUSH_UPDATES=$(cat)", `--pre-push="$REMOTE"`},
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.hookType, func(t *testing.T) {
+			params := NewHookInstallationParameters(false, "")
+			params.HookType = testCase.hookType
+
+			content := params.GetHookContent()
+			require.Contains(t, content, "Generated by gommitlint install-hook")
+			require.Contains(t, content, `"$0.chained" "$@"`)
+
+			for _, expected := range testCase.contains {
+				require.Contains(t, content, expected)
+			}
+		})
+	}
+}
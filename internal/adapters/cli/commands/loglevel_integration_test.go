@@ -86,7 +86,7 @@ func TestLogLevelFlagIntegration(t *testing.T) {
 			logLevel := app.String("log-level")
 
 			// This should not panic or error for any log level
-			resultCtx := logadapter.InitLogger(ctx, output, debug, logLevel)
+			resultCtx := logadapter.InitLogger(ctx, logadapter.Options{Format: output, Level: logLevel, Debug: debug})
 
 			// Verify context is returned properly
 			require.NotNil(t, resultCtx, testCase.description)
@@ -117,7 +117,7 @@ func TestLogLevelFlagDefault(t *testing.T) {
 	require.Equal(t, "info", logLevel, "default log level should be info")
 
 	// Should work with default value
-	resultCtx := logadapter.InitLogger(ctx, output, debug, logLevel)
+	resultCtx := logadapter.InitLogger(ctx, logadapter.Options{Format: output, Level: logLevel, Debug: debug})
 	require.NotNil(t, resultCtx, "should work with default log level")
 
 	logger := logadapter.GetLogger(resultCtx)
@@ -182,7 +182,7 @@ func TestLogLevelFlagWithOtherFlags(t *testing.T) {
 			logLevel := app.String("log-level")
 
 			// Should work with all flag combinations
-			resultCtx := logadapter.InitLogger(ctx, output, debug, logLevel)
+			resultCtx := logadapter.InitLogger(ctx, logadapter.Options{Format: output, Level: logLevel, Debug: debug})
 			require.NotNil(t, resultCtx, testCase.description)
 
 			logger := logadapter.GetLogger(resultCtx)
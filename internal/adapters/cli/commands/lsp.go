@@ -0,0 +1,133 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package commands
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+
+	cliAdapter "github.com/itiquette/gommitlint/internal/adapters/cli"
+	"github.com/itiquette/gommitlint/internal/adapters/i18n"
+	"github.com/itiquette/gommitlint/internal/adapters/lsp"
+	"github.com/itiquette/gommitlint/internal/adapters/plugin"
+	"github.com/itiquette/gommitlint/internal/domain"
+	configTypes "github.com/itiquette/gommitlint/internal/domain/config"
+	"github.com/itiquette/gommitlint/internal/domain/rules"
+	"github.com/urfave/cli/v3"
+)
+
+// NewLSPCommand creates the lsp subcommand.
+func NewLSPCommand() *cli.Command {
+	return &cli.Command{
+		Name:  "lsp",
+		Usage: "Run a language server that reports commit message diagnostics",
+		Description: `Starts a Language Server Protocol server on stdin/stdout that validates
+gitcommit buffers, such as .git/COMMIT_EDITMSG, as you type and publishes rule
+failures as diagnostics. Configure your editor to start it for gitcommit files.
+Changes to the configuration files apply to the following edits without a restart.
+
+Examples:
+  # Started by the editor
+  gommitlint lsp --stdio`,
+
+		Flags: []cli.Flag{
+			&cli.BoolFlag{
+				Name:  "stdio",
+				Usage: "communicate over stdin/stdout (the default and only transport)",
+			},
+			&cli.StringFlag{
+				Name:  "locale",
+				Usage: "translate diagnostics to `LOCALE` (e.g., sv; default: i18n.locale or LANG)",
+			},
+		},
+
+		Action: func(ctx context.Context, cmd *cli.Command) error {
+			return ExecuteLSP(ctx, cmd)
+		},
+	}
+}
+
+// ExecuteLSP serves diagnostics until the editor shuts the server down.
+func ExecuteLSP(ctx context.Context, cmd *cli.Command) error {
+	cfgResult, err := LoadConfigFromCommand(cmd.Root())
+	if err != nil {
+		return fmt.Errorf("failed to load configuration: %w", err)
+	}
+
+	repoPath, err := filepath.Abs(getRepoPath(cmd))
+	if err != nil {
+		return fmt.Errorf("invalid repository path: %w", err)
+	}
+
+	initial, err := newLSPConfig(cmd, cfgResult.Config, repoPath)
+	if err != nil {
+		return err
+	}
+
+	var current atomic.Pointer[lspConfig]
+
+	current.Store(initial)
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	// Buffers are validated with the new configuration from their next change on
+	go reloadConfigOnChange(ctx, cmd, cfgResult, func(reloaded ConfigResult) error {
+		next, err := newLSPConfig(cmd, reloaded.Config, repoPath)
+		if err != nil {
+			return err
+		}
+
+		current.Store(next)
+
+		return nil
+	})
+
+	validate := func(message string) ([]domain.ValidationError, error) {
+		return current.Load().validate(message)
+	}
+
+	return lsp.NewServer(validate, cmd.Root().Version).Run(ctx, os.Stdin, os.Stdout)
+}
+
+// lspConfig is a configuration of the language server together with the rules and
+// translations created from it.
+type lspConfig struct {
+	cfg         configTypes.Config
+	catalog     i18n.Catalog
+	commitRules []domain.CommitRule
+}
+
+// newLSPConfig creates the rules and loads the translations for cfg.
+func newLSPConfig(cmd *cli.Command, cfg configTypes.Config, repoPath string) (*lspConfig, error) {
+	catalog, err := loadCatalog(cmd, cfg, repoPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load translations: %w", err)
+	}
+
+	return &lspConfig{
+		cfg:         cfg,
+		catalog:     catalog,
+		commitRules: append(rules.CreateCommitRules(cfg, cliAdapter.RuleServices()), plugin.CreateRules(cfg, repoPath)...),
+	}, nil
+}
+
+// validate validates message and translates the failures.
+func (c *lspConfig) validate(message string) ([]domain.ValidationError, error) {
+	result, err := domain.ValidateMessage(message, c.commitRules, c.cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	errs := make([]domain.ValidationError, 0, len(result.Errors))
+	for _, validationErr := range result.Errors {
+		errs = append(errs, c.catalog.TranslateError(validationErr, c.cfg.Messages))
+	}
+
+	return errs, nil
+}
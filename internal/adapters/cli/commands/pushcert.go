@@ -0,0 +1,79 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package commands
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	cliAdapter "github.com/itiquette/gommitlint/internal/adapters/cli"
+	"github.com/itiquette/gommitlint/internal/adapters/gitexec"
+	"github.com/itiquette/gommitlint/internal/adapters/signing"
+	"github.com/itiquette/gommitlint/internal/domain"
+	configTypes "github.com/itiquette/gommitlint/internal/domain/config"
+)
+
+// openReceivingRepository opens the repository receiving a push with the git binary.
+// Until the pre-receive hook accepts the push git keeps its objects in a quarantine
+// directory named in the environment, which go-git does not read. Servers mostly keep
+// bare repositories, without the .git directory the path validation looks for, so git
+// checks the path instead.
+func openReceivingRepository(repoPath string) (string, domain.Repository, error) {
+	absolutePath, err := filepath.Abs(repoPath)
+	if err != nil {
+		return "", nil, fmt.Errorf("invalid repository path: %w", err)
+	}
+
+	repo, err := gitexec.NewRepository(absolutePath)
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to open repository: %w", err)
+	}
+
+	return absolutePath, repo, nil
+}
+
+// checkPushCertificate adds the result of verifying the push certificate of a signed
+// push to report. git names the certificate blob in GIT_PUSH_CERT and how it judged the
+// nonce in GIT_PUSH_CERT_NONCE_STATUS. Unsigned pushes are reported only when required.
+func checkPushCertificate(ctx context.Context, report domain.Report, required bool, cfg configTypes.Config,
+	repo domain.Repository, repoPath string) (domain.Report, error) {
+	blob := os.Getenv("GIT_PUSH_CERT")
+	if blob == "" {
+		if !required {
+			return report, nil
+		}
+
+		errs := domain.ValidatePushCertificate(domain.PushCertificate{}, domain.VerificationResult{}, nil)
+
+		return report.WithRepositoryResult(domain.NewPushCertificateReport(domain.PushCertificate{}, domain.VerificationResult{}, errs)), nil
+	}
+
+	if err := cliAdapter.NewSecurityValidator().ValidateGitReference(blob); err != nil {
+		return report, fmt.Errorf("invalid GIT_PUSH_CERT: %w", err)
+	}
+
+	resolver, ok := repo.(domain.PushCertificateResolver)
+	if !ok {
+		return report, errors.New("repository cannot read push certificates")
+	}
+
+	cert, err := resolver.GetPushCertificate(ctx, blob)
+	if err != nil {
+		return report, err
+	}
+
+	cert.NonceStatus = os.Getenv("GIT_PUSH_CERT_NONCE_STATUS")
+
+	verifier := signing.NewVerificationAdapterFromConfig(cfg.Signature)
+	result := domain.VerifyWithKeyDirectories(trustedKeyDirectories("", cfg.Signature, repoPath), func(keyDir string) domain.VerificationResult {
+		return verifier.VerifyPushCertificate(ctx, cert, keyDir)
+	})
+	errs := domain.ValidatePushCertificate(cert, result, cfg.Signature.AllowedSigners)
+
+	return report.WithRepositoryResult(domain.NewPushCertificateReport(cert, result, errs)), nil
+}
@@ -13,8 +13,8 @@ import (
 	"strings"
 
 	cliAdapter "github.com/itiquette/gommitlint/internal/adapters/cli"
+	"github.com/itiquette/gommitlint/internal/adapters/git"
 	logadapter "github.com/itiquette/gommitlint/internal/adapters/logging"
-	"github.com/itiquette/gommitlint/internal/adapters/signing"
 	"github.com/urfave/cli/v3"
 )
 
@@ -30,7 +30,10 @@ Examples:
   gommitlint remove-hook
   
   # Remove hook without confirmation prompt
-  gommitlint remove-hook --yes`,
+  gommitlint remove-hook --yes
+
+  # Remove a pre-push hook installed with --global
+  gommitlint remove-hook --hook-type=pre-push --global`,
 
 		Flags: []cli.Flag{
 			&cli.BoolFlag{
@@ -38,6 +41,19 @@ Examples:
 				Aliases: []string{"y"},
 				Usage:   "skip confirmation prompt",
 			},
+			&cli.StringFlag{
+				Name:  "hook-type",
+				Value: "commit-msg",
+				Usage: "`TYPE` of hook to remove: commit-msg, pre-push or prepare-commit-msg",
+			},
+			&cli.BoolFlag{
+				Name:  "global",
+				Usage: "remove the hook from the user-level core.hooksPath",
+			},
+			&cli.BoolFlag{
+				Name:  "all-worktrees",
+				Usage: "remove the hook from the hooks directory shared by all worktrees",
+			},
 		},
 
 		Action: func(ctx context.Context, cmd *cli.Command) error {
@@ -53,8 +69,7 @@ func ExecuteRemoveHook(ctx context.Context, cmd *cli.Command) error {
 	repoPath := getRepoPath(cmd)
 
 	// Create logger from context
-	zerologLogger := logadapter.GetLogger(ctx)
-	logger := logadapter.NewDomainLogger(zerologLogger)
+	logger := logadapter.ComponentLogger(ctx, logadapter.ComponentHooks)
 
 	// Remove the hook
 	if err := removeHook(cmd, repoPath, skipConfirm); err != nil {
@@ -70,15 +85,11 @@ func ExecuteRemoveHook(ctx context.Context, cmd *cli.Command) error {
 
 // removeHook removes a Git hook from the specified repository.
 func removeHook(cmd *cli.Command, repoPath string, skipConfirm bool) error {
-	// Validate and normalize the repository path using signing utilities
-	validatedPath, err := signing.ValidateGitRepoPath(repoPath)
+	params, err := resolveRemovalTarget(cmd, repoPath, skipConfirm)
 	if err != nil {
-		return fmt.Errorf("invalid repository path: %w", err)
+		return err
 	}
 
-	// Create parameters with defaults
-	params := NewHookRemovalParameters(cmd, validatedPath, skipConfirm)
-
 	// Verify the hook exists
 	if err := params.VerifyHookExists(); err != nil {
 		return err
@@ -103,13 +114,79 @@ func removeHook(cmd *cli.Command, repoPath string, skipConfirm bool) error {
 	}
 
 	// Get hook path (pure function)
-	hookPath, err := FindHookPath(params.RepoPath, params.HookType, params.PathValidator)
+	hookPath, err := params.HookPath()
 	if err != nil {
 		return err
 	}
 
 	// Remove the hook file (side effect isolated)
-	return RemoveHookFile(hookPath)
+	if err := RemoveHookFile(hookPath); err != nil {
+		return err
+	}
+
+	return restoreChainedHook(hookPath)
+}
+
+// resolveRemovalTarget builds removal parameters for the hook selected by the command flags.
+func resolveRemovalTarget(cmd *cli.Command, repoPath string, skipConfirm bool) (HookRemovalParameters, error) {
+	params := NewHookRemovalParameters(cmd, "", skipConfirm)
+
+	if hookType := cmd.String("hook-type"); hookType != "" {
+		if err := validateHookType(hookType); err != nil {
+			return HookRemovalParameters{}, err
+		}
+
+		params.HookType = hookType
+	}
+
+	if cmd.Bool("global") {
+		hooksPath, err := git.GlobalHooksPath()
+		if err != nil {
+			return HookRemovalParameters{}, err
+		}
+
+		if hooksPath == "" {
+			return HookRemovalParameters{}, errors.New("no global core.hooksPath is set")
+		}
+
+		params.HooksDir = expandHome(hooksPath)
+
+		return params, nil
+	}
+
+	root, hooksDir, err := resolveHooksDir(repoPath, cmd.Bool("all-worktrees"))
+	if err != nil {
+		return HookRemovalParameters{}, err
+	}
+
+	params.RepoPath = root
+	params.HooksDir = hooksDir
+
+	// Hooks are read from core.hooksPath when it points inside the repository
+	setup, err := DetectHookSetup(root)
+	if err != nil {
+		return HookRemovalParameters{}, err
+	}
+
+	if setup.HooksDir != "" {
+		params.HooksDir = setup.HooksDir
+	}
+
+	return params, nil
+}
+
+// restoreChainedHook moves a hook kept by install-hook --chain back in place.
+func restoreChainedHook(hookPath string) error {
+	chainedPath := hookPath + chainedHookSuffix
+	if !fileExists(chainedPath) {
+		return nil
+	}
+
+	if err := os.Rename(chainedPath, hookPath); err != nil {
+		return fmt.Errorf("could not restore chained hook: %w", err)
+	}
+
+	return nil
 }
 
 // HookRemovalParameters contains all parameters needed for hook removal.
@@ -117,6 +194,7 @@ type HookRemovalParameters struct {
 	RepoPath      string
 	SkipConfirm   bool
 	HookType      string
+	HooksDir      string // Hooks directory from core.hooksPath; empty for .git/hooks
 	Output        io.Writer
 	Input         io.Reader
 	PathValidator cliAdapter.PathValidator
@@ -127,16 +205,25 @@ func NewHookRemovalParameters(cmd *cli.Command, repoPath string, skipConfirm boo
 	return HookRemovalParameters{
 		RepoPath:      repoPath,
 		SkipConfirm:   skipConfirm,
-		HookType:      "commit-msg", // Currently the only supported type
+		HookType:      "commit-msg",
 		Output:        cmd.Writer,
 		Input:         cmd.Reader,
 		PathValidator: cliAdapter.DefaultPathValidator(),
 	}
 }
 
+// HookPath returns the path of the hook file to remove.
+func (p HookRemovalParameters) HookPath() (string, error) {
+	if p.HooksDir != "" {
+		return hooksDirPath(p.HooksDir, p.HookType)
+	}
+
+	return FindHookPath(p.RepoPath, p.HookType, p.PathValidator)
+}
+
 // VerifyHookExists checks if the hook file exists.
 func (p HookRemovalParameters) VerifyHookExists() error {
-	hookPath, err := FindHookPath(p.RepoPath, p.HookType, p.PathValidator)
+	hookPath, err := p.HookPath()
 	if err != nil {
 		return err
 	}
@@ -158,7 +245,7 @@ func (p HookRemovalParameters) VerifyHookExists() error {
 
 // IsGommitlintHook checks if the hook was installed by gommitlint.
 func (p HookRemovalParameters) IsGommitlintHook() (bool, error) {
-	hookPath, err := FindHookPath(p.RepoPath, p.HookType, p.PathValidator)
+	hookPath, err := p.HookPath()
 	if err != nil {
 		return false, err
 	}
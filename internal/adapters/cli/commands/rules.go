@@ -0,0 +1,286 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package commands
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+
+	cliAdapter "github.com/itiquette/gommitlint/internal/adapters/cli"
+	"github.com/itiquette/gommitlint/internal/domain"
+	configTypes "github.com/itiquette/gommitlint/internal/domain/config"
+	"github.com/itiquette/gommitlint/internal/domain/rules"
+	"github.com/urfave/cli/v3"
+)
+
+// Rule statuses in the rules list.
+const (
+	ruleStatusEnabled     = "enabled"
+	ruleStatusConditional = "conditional"
+	ruleStatusDisabled    = "disabled"
+)
+
+// NewRulesCommand creates the rules command and its subcommands.
+func NewRulesCommand() *cli.Command {
+	return &cli.Command{
+		Name:  "rules",
+		Usage: "Inspect the validation rules",
+		Commands: []*cli.Command{
+			newRulesListCommand(),
+		},
+	}
+}
+
+// newRulesListCommand creates the rules list subcommand.
+func newRulesListCommand() *cli.Command {
+	return &cli.Command{
+		Name:  "list",
+		Usage: "List every rule and whether it runs under the effective configuration",
+		Description: `Lists the built-in rules, custom rules and plugins with their status under the
+effective configuration, their severity and the values of the settings affecting them.
+
+A rule is enabled when it runs on every commit, conditional when rules.conditions
+restricts it to some commits, and disabled when it does not run.
+
+Examples:
+  # Show what runs with the configuration of the current repository
+  gommitlint rules list
+
+  # The same as JSON, for scripts
+  gommitlint --format json rules list`,
+
+		Action: func(_ context.Context, cmd *cli.Command) error {
+			return ExecuteRulesList(cmd)
+		},
+	}
+}
+
+// ruleListEntry is one rule in the rules list.
+type ruleListEntry struct {
+	Rule     string            `json:"rule"`
+	Name     string            `json:"name"`
+	Source   string            `json:"source"`
+	Category string            `json:"category,omitempty"`
+	Status   string            `json:"status"`
+	Severity string            `json:"severity"`
+	Summary  string            `json:"summary,omitempty"`
+	Settings []ruleListSetting `json:"settings"`
+}
+
+// ruleListSetting is the effective value of a setting affecting a rule.
+type ruleListSetting struct {
+	Key   string      `json:"key"`
+	Value interface{} `json:"value"`
+}
+
+// ExecuteRulesList lists the rules with their status under the effective configuration.
+func ExecuteRulesList(cmd *cli.Command) error {
+	cfgResult, err := LoadConfigFromCommand(cmd.Root())
+	if err != nil {
+		return fmt.Errorf("failed to load configuration: %w", err)
+	}
+
+	entries, err := listRules(cfgResult.Config)
+	if err != nil {
+		return err
+	}
+
+	if cmd.Root().String("format") == "json" {
+		encoder := json.NewEncoder(cmd.Writer)
+		encoder.SetIndent("", "  ")
+
+		return encoder.Encode(entries)
+	}
+
+	writeRulesList(cmd.Writer, entries)
+
+	return nil
+}
+
+// listRules builds the rules list for cfg: the built-in rules in name order, followed by
+// the custom rules and plugins in configuration order.
+func listRules(cfg configTypes.Config) ([]ruleListEntry, error) {
+	values, err := configValues(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	statuses := make(map[string]string)
+
+	for _, rule := range rules.CreateCommitRules(cfg, cliAdapter.RuleServices()) {
+		_, conditional := rule.(domain.ConditionalCommitRule)
+		statuses[rule.Name()] = ruleStatus(conditional)
+	}
+
+	for _, rule := range rules.CreateRepositoryRules(cfg, cliAdapter.RuleServices()) {
+		_, conditional := rule.(domain.ConditionalRepositoryRule)
+		statuses[rule.Name()] = ruleStatus(conditional)
+	}
+
+	entries := make([]ruleListEntry, 0, len(statuses)+len(cfg.Plugins))
+
+	for _, metadata := range rules.AllRuleMetadata() {
+		entry := ruleListEntry{
+			Rule:     metadata.ID,
+			Name:     metadata.Name,
+			Source:   "builtin",
+			Category: metadata.Category,
+			Status:   ruleStatusDisabled,
+			Severity: string(metadata.Severity),
+			Summary:  metadata.Summary,
+			Settings: make([]ruleListSetting, 0, len(metadata.ConfigKeys)),
+		}
+
+		if status, found := statuses[metadata.Name]; found {
+			entry.Status = status
+		}
+
+		for _, key := range metadata.ConfigKeys {
+			entry.Settings = append(entry.Settings, ruleListSetting{Key: key, Value: lookupConfigValue(values, key)})
+		}
+
+		entries = append(entries, entry)
+	}
+
+	for _, custom := range cfg.CustomRules {
+		severity := custom.Severity
+		if severity == "" {
+			severity = string(domain.SeverityError)
+		}
+
+		entry := ruleListEntry{
+			Rule:     domain.CleanRuleName(custom.Name),
+			Name:     custom.Name,
+			Source:   "custom",
+			Status:   ruleStatusDisabled,
+			Severity: severity,
+			Summary:  custom.Message,
+			Settings: []ruleListSetting{
+				{Key: "pattern", Value: custom.Pattern},
+				{Key: "target", Value: custom.Target},
+				{Key: "forbid", Value: custom.Forbid},
+			},
+		}
+
+		if status, found := statuses[custom.Name]; found {
+			entry.Status = status
+		}
+
+		entries = append(entries, entry)
+	}
+
+	for _, plugin := range cfg.Plugins {
+		entry := ruleListEntry{
+			Rule:     domain.CleanRuleName(plugin.Name),
+			Name:     plugin.Name,
+			Source:   "plugin",
+			Status:   ruleStatusDisabled,
+			Severity: string(domain.SeverityError),
+			Settings: []ruleListSetting{},
+		}
+
+		if rules.IsRuleActive(plugin.Name, cfg.Rules.Enabled, cfg.Rules.Disabled) {
+			_, conditional := cfg.Rules.Conditions[plugin.Name]
+			entry.Status = ruleStatus(conditional)
+		}
+
+		if plugin.Wasm != "" {
+			entry.Settings = append(entry.Settings, ruleListSetting{Key: "wasm", Value: plugin.Wasm})
+		} else {
+			entry.Settings = append(entry.Settings, ruleListSetting{Key: "command", Value: plugin.Command})
+		}
+
+		entries = append(entries, entry)
+	}
+
+	return entries, nil
+}
+
+// ruleStatus returns the status of a rule that runs.
+func ruleStatus(conditional bool) string {
+	if conditional {
+		return ruleStatusConditional
+	}
+
+	return ruleStatusEnabled
+}
+
+// configValues returns the configuration as nested maps keyed like the configuration file.
+func configValues(cfg configTypes.Config) (map[string]interface{}, error) {
+	data, err := json.Marshal(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode configuration: %w", err)
+	}
+
+	var values map[string]interface{}
+	if err := json.Unmarshal(data, &values); err != nil {
+		return nil, fmt.Errorf("failed to decode configuration: %w", err)
+	}
+
+	return values, nil
+}
+
+// lookupConfigValue returns the value at a dotted configuration key such as
+// "message.subject.max_length", or nil when there is none.
+func lookupConfigValue(values map[string]interface{}, key string) interface{} {
+	var current interface{} = values
+
+	for _, part := range strings.Split(key, ".") {
+		section, ok := current.(map[string]interface{})
+		if !ok {
+			return nil
+		}
+
+		current = section[part]
+	}
+
+	return current
+}
+
+// writeRulesList writes the rules as an aligned table.
+func writeRulesList(writer io.Writer, entries []ruleListEntry) {
+	table := tabwriter.NewWriter(writer, 0, 0, 2, ' ', 0)
+
+	fmt.Fprintln(table, "RULE\tSTATUS\tSEVERITY\tSOURCE\tSETTINGS")
+
+	for _, entry := range entries {
+		settings := make([]string, 0, len(entry.Settings))
+		for _, setting := range entry.Settings {
+			settings = append(settings, setting.Key+"="+formatSettingValue(setting.Value))
+		}
+
+		fmt.Fprintf(table, "%s\t%s\t%s\t%s\t%s\n", entry.Rule, entry.Status, entry.Severity, entry.Source,
+			strings.Join(settings, " "))
+	}
+
+	_ = table.Flush()
+}
+
+// formatSettingValue formats a configuration value compactly, lists as [a,b].
+func formatSettingValue(value interface{}) string {
+	switch typed := value.(type) {
+	case nil:
+		return "-"
+	case string:
+		if typed == "" {
+			return `""`
+		}
+
+		return typed
+	case []interface{}, map[string]interface{}:
+		data, err := json.Marshal(typed)
+		if err != nil {
+			return fmt.Sprint(typed)
+		}
+
+		return string(data)
+	default:
+		return fmt.Sprint(typed)
+	}
+}
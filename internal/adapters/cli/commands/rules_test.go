@@ -0,0 +1,83 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package commands
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	configTypes "github.com/itiquette/gommitlint/internal/domain/config"
+)
+
+func TestListRules(t *testing.T) {
+	cfg := configTypes.NewDefault()
+	cfg.Message.Subject.MaxLength = 50
+	cfg.Rules.Enabled = []string{"review"}
+	cfg.Rules.Disabled = []string{"spell", "ticket"}
+	cfg.Rules.Conditions = map[string]configTypes.RuleCondition{"review": {Branches: []string{"main"}}}
+	cfg.CustomRules = []configTypes.CustomRuleConfig{
+		{Name: "NoWIP", Pattern: "WIP", Forbid: true, Severity: "warning"},
+		{Name: "Ticket", Pattern: "#[0-9]+"},
+	}
+	cfg.Plugins = []configTypes.PluginConfig{{Name: "Lint", Command: "./lint.sh"}}
+
+	entries, err := listRules(cfg)
+	require.NoError(t, err)
+
+	byRule := make(map[string]ruleListEntry)
+	for _, entry := range entries {
+		byRule[entry.Rule] = entry
+	}
+
+	tests := []struct {
+		rule     string
+		status   string
+		severity string
+		source   string
+	}{
+		{rule: "subject", status: ruleStatusEnabled, severity: "error", source: "builtin"},
+		{rule: "spell", status: ruleStatusDisabled, severity: "error", source: "builtin"},
+		{rule: "review", status: ruleStatusConditional, severity: "error", source: "builtin"},
+		{rule: "commitbody", status: ruleStatusDisabled, severity: "error", source: "builtin"},
+		{rule: "branchahead", status: ruleStatusEnabled, severity: "error", source: "builtin"},
+		{rule: "nowip", status: ruleStatusEnabled, severity: "warning", source: "custom"},
+		{rule: "ticket", status: ruleStatusDisabled, severity: "error", source: "custom"},
+		{rule: "lint", status: ruleStatusEnabled, severity: "error", source: "plugin"},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.rule, func(t *testing.T) {
+			entry, found := byRule[testCase.rule]
+			require.True(t, found)
+			require.Equal(t, testCase.status, entry.Status)
+			require.Equal(t, testCase.severity, entry.Severity)
+			require.Equal(t, testCase.source, entry.Source)
+		})
+	}
+
+	require.Contains(t, byRule["subject"].Settings, ruleListSetting{Key: "message.subject.max_length", Value: 50.0})
+	require.Equal(t, "nowip", entries[len(entries)-3].Rule, "custom rules and plugins follow the built-in rules")
+}
+
+func TestWriteRulesList(t *testing.T) {
+	var buffer bytes.Buffer
+
+	writeRulesList(&buffer, []ruleListEntry{
+		{
+			Rule: "subject", Status: ruleStatusEnabled, Severity: "error", Source: "builtin",
+			Settings: []ruleListSetting{
+				{Key: "message.subject.max_length", Value: 72.0},
+				{Key: "message.subject.forbid_endings", Value: []interface{}{".", "!"}},
+				{Key: "message.subject.case", Value: ""},
+			},
+		},
+	})
+
+	require.Equal(t, "RULE     STATUS   SEVERITY  SOURCE   SETTINGS\n"+
+		`subject  enabled  error     builtin  message.subject.max_length=72 message.subject.forbid_endings=[".","!"] message.subject.case=""`+"\n",
+		buffer.String())
+}
@@ -0,0 +1,135 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package commands
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/itiquette/gommitlint/internal/adapters/charset"
+	cliAdapter "github.com/itiquette/gommitlint/internal/adapters/cli"
+	"github.com/itiquette/gommitlint/internal/adapters/output"
+	"github.com/itiquette/gommitlint/internal/adapters/plugin"
+	"github.com/itiquette/gommitlint/internal/domain"
+	"github.com/itiquette/gommitlint/internal/domain/rules"
+	"github.com/urfave/cli/v3"
+)
+
+// NewScoreCommand creates the score subcommand.
+func NewScoreCommand() *cli.Command {
+	return &cli.Command{
+		Name:  "score",
+		Usage: "Score the quality of a commit message",
+		Description: `Validates a commit message with the configured rules and grades it from 0 to 100
+instead of passing or failing it. Every violation costs points by its severity, 20 for an
+error and 5 for a warning, and the violations are listed as improvements ranked by the
+points fixing them would gain.
+
+The command always exits with 0, so scores can be collected for dashboards without
+blocking anything.
+
+Examples:
+  # Score a commit message
+  gommitlint score --message-file=msg.txt
+
+  # Score as JSON, for dashboards
+  gommitlint --format json score --message-file=msg.txt`,
+
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:     "message-file",
+				Usage:    "commit message `FILE` to score",
+				Required: true,
+			},
+			&cli.StringFlag{
+				Name:  "locale",
+				Usage: "translate output to `LOCALE` (e.g., sv; default: i18n.locale or LANG)",
+			},
+		},
+
+		Action: func(ctx context.Context, cmd *cli.Command) error {
+			return ExecuteScore(ctx, cmd)
+		},
+	}
+}
+
+// ExecuteScore scores the message file given by the command flags.
+func ExecuteScore(ctx context.Context, cmd *cli.Command) error {
+	securityValidator := cliAdapter.NewSecurityValidator()
+
+	messageFile, err := securityValidator.ValidateMessageFilePath(cmd.String("message-file"))
+	if err != nil {
+		return err
+	}
+
+	content, err := os.ReadFile(messageFile)
+	if err != nil {
+		return fmt.Errorf("failed to read message file: %w", err)
+	}
+
+	cfgResult, err := LoadConfigFromCommand(cmd.Root())
+	if err != nil {
+		return fmt.Errorf("failed to load configuration: %w", err)
+	}
+
+	cfg := cfgResult.Config
+
+	message, err := charset.DecodeMessage(content, cfg.Message.FallbackEncoding)
+	if err != nil {
+		return err
+	}
+
+	repoPath, err := filepath.Abs(getRepoPath(cmd))
+	if err != nil {
+		return fmt.Errorf("invalid repository path: %w", err)
+	}
+
+	// Messages can be scored outside a repository; branch conditions then never match
+	var repo domain.Repository
+
+	if validatedRepoPath, err := securityValidator.ValidateRepoPath(repoPath); err == nil {
+		if gitRepo, err := openRepository(ctx, cmd, validatedRepoPath); err == nil {
+			repo = gitRepo
+		}
+	}
+
+	commitRules := append(rules.CreateCommitRules(cfg, cliAdapter.RuleServices()), plugin.CreateRules(cfg, repoPath)...)
+	applicable, _ := domain.ApplicableRules(domain.ParseCommitMessage(strings.TrimSpace(message)), commitRules, nil, repo)
+
+	result, err := domain.ValidateMessageInRepository(message, commitRules, repo, cfg)
+	if err != nil {
+		return fmt.Errorf("failed to validate message: %w", err)
+	}
+
+	catalog, err := loadCatalog(cmd, cfg, repoPath)
+	if err != nil {
+		return fmt.Errorf("failed to load translations: %w", err)
+	}
+
+	errors := make([]domain.ValidationError, 0, len(result.Errors))
+	for _, validationError := range result.Errors {
+		errors = append(errors, catalog.TranslateError(validationError, cfg.Messages))
+	}
+
+	score := domain.NewMessageScore(errors, len(applicable))
+
+	if cmd.Root().String("format") == "json" {
+		formatted, err := output.MessageScoreJSON(score)
+		if err != nil {
+			return err
+		}
+
+		_, err = fmt.Fprint(cmd.Writer, formatted)
+
+		return err
+	}
+
+	_, err = fmt.Fprint(cmd.Writer, output.MessageScoreText(score))
+
+	return err
+}
@@ -6,18 +6,34 @@ package commands
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os"
+	"path/filepath"
+	"slices"
 
 	cliAdapter "github.com/itiquette/gommitlint/internal/adapters/cli"
-	"github.com/itiquette/gommitlint/internal/adapters/git"
+	"github.com/itiquette/gommitlint/internal/adapters/config"
+	"github.com/itiquette/gommitlint/internal/adapters/github"
+	"github.com/itiquette/gommitlint/internal/adapters/i18n"
 	logadapter "github.com/itiquette/gommitlint/internal/adapters/logging"
+	"github.com/itiquette/gommitlint/internal/adapters/mbox"
 	"github.com/itiquette/gommitlint/internal/adapters/output"
+	"github.com/itiquette/gommitlint/internal/adapters/patchwork"
+	"github.com/itiquette/gommitlint/internal/adapters/plugin"
+	"github.com/itiquette/gommitlint/internal/adapters/profiling"
+	"github.com/itiquette/gommitlint/internal/adapters/resultcache"
+	"github.com/itiquette/gommitlint/internal/adapters/tracing"
+	"github.com/itiquette/gommitlint/internal/adapters/tui"
+	"github.com/itiquette/gommitlint/internal/domain"
 	configTypes "github.com/itiquette/gommitlint/internal/domain/config"
 	"github.com/itiquette/gommitlint/internal/domain/rules"
 	"github.com/urfave/cli/v3"
 )
 
+// profileTop is how many of the slowest rules and commits --profile-rules prints.
+const profileTop = 10
+
 // NewValidateCommand creates the validate subcommand.
 func NewValidateCommand() *cli.Command {
 	return &cli.Command{
@@ -37,9 +53,58 @@ Examples:
   
   # Validate a range of commits
   gommitlint validate --range=main..feature
+
+  # Validate the commits of a pull request, skipping those merged in from main
+  gommitlint validate --base-branch=main --first-parent
   
   # Validate last 5 commits
-  gommitlint validate --count=5`,
+  gommitlint validate --count=5
+
+  # Validate the commits of a push from a pre-push hook
+  gommitlint validate --pre-push="$1"
+
+  # Validate the commits of a push on the server, from a pre-receive hook
+  gommitlint validate --pre-receive --require-signed-push
+
+  # Check the commit an amend would create before rewriting HEAD
+  gommitlint validate --staged-amend -m "fix(parser): handle empty input"
+
+  # Validate the commits of a GitHub pull request, without a local clone
+  gommitlint validate --github-pr=itiquette/gommitlint#123
+
+  # Validate a patch series before applying it with git am
+  gommitlint validate --patch=series.mbox
+
+  # Validate a series of a Patchwork instance and print a check for each patch
+  gommitlint validate --patchwork-series=https://patchwork.example.org/api/series/123/ --format=patchwork
+
+  # Also validate commits pulled in by submodule updates
+  gommitlint validate --base-branch=main --recurse-submodules
+
+  # Adopt the rules gradually, failing only on kinds of failures main does not have yet
+  gommitlint validate --base-branch=main --ratchet
+
+  # Report the cherry-picks of a release branch once each
+  gommitlint validate --range=v1.0.0..release/1.x --group-duplicates
+
+  # Review failures of a long range interactively
+  gommitlint validate --base-branch=main --interactive
+
+  # Print only the counts, for scheduled jobs and status badges
+  gommitlint validate --count=50 --summary
+
+  # Find the rules that make a long audit slow
+  gommitlint validate --count=2000 --summary --profile-rules
+
+  # Debug one rule over a long range, whatever the configuration enables
+  gommitlint validate --range=v1.0.0..HEAD --rules=subject
+
+  # Leave out a rule for this run only
+  gommitlint validate --base-branch=main --skip-rules=spell,jirareference
+
+  # Annotate text output in GitHub Actions
+  gommitlint validate --emit-problem-matcher > "$RUNNER_TEMP/gommitlint.json"
+  echo "::add-matcher::$RUNNER_TEMP/gommitlint.json"`,
 
 		Flags: []cli.Flag{
 			// Validation Target flags (choose one)
@@ -72,6 +137,88 @@ Examples:
 				Usage:    "validate commits in `BRANCH`..HEAD",
 				Category: "Validation Target (choose one)",
 			},
+			&cli.StringFlag{
+				Name:     "pre-push",
+				Usage:    "validate commits pushed to `REMOTE`, reading the pre-push hook ref updates from stdin",
+				Category: "Validation Target (choose one)",
+			},
+			&cli.BoolFlag{
+				Name:     "pre-receive",
+				Usage:    "validate the commits a repository receives, reading the pre-receive hook ref updates from stdin",
+				Category: "Validation Target (choose one)",
+			},
+			&cli.BoolFlag{
+				Name:     "staged-amend",
+				Usage:    "validate the commit git commit --amend would create from HEAD and the staged changes",
+				Category: "Validation Target (choose one)",
+			},
+			&cli.StringFlag{
+				Name:     "github-pr",
+				Usage:    "validate the commits of GitHub pull request `OWNER/REPO#NUMBER` (token from GITHUB_TOKEN or GH_TOKEN)",
+				Category: "Validation Target (choose one)",
+			},
+			&cli.StringFlag{
+				Name:     "patch",
+				Usage:    "validate the patches of mbox `FILE` written by git format-patch (- for stdin)",
+				Category: "Validation Target (choose one)",
+			},
+			&cli.StringFlag{
+				Name:     "patchwork-series",
+				Usage:    "validate the patches of the Patchwork series at `URL`",
+				Category: "Validation Target (choose one)",
+			},
+			&cli.BoolFlag{
+				Name:     "merge-base",
+				Usage:    "start --range at the merge base of its ends (always on for --base-branch)",
+				Category: "Range Options",
+			},
+			&cli.BoolFlag{
+				Name:     "first-parent",
+				Usage:    "follow only the first parent of merge commits, as in a pull request",
+				Category: "Range Options",
+			},
+			&cli.BoolFlag{
+				Name:     "ratchet",
+				Usage:    "fail only on kinds of failures not found in the recent history of the --base-branch or --range start",
+				Category: "Range Options",
+			},
+			&cli.IntFlag{
+				Name:     "ratchet-window",
+				Value:    cliAdapter.DefaultRatchetWindow,
+				Usage:    "how many commits of the base history --ratchet validates",
+				Category: "Range Options",
+			},
+			&cli.BoolFlag{
+				Name:     "require-signed-push",
+				Usage:    "fail --pre-receive pushes without a push certificate from git push --signed",
+				Category: "Push Options",
+			},
+			&cli.StringFlag{
+				Name:     "amend-message",
+				Aliases:  []string{"m"},
+				Usage:    "validate --staged-amend with `MESSAGE`, as given to git commit --amend -m, instead of the message of HEAD",
+				Category: "Amend Options",
+			},
+			&cli.BoolFlag{
+				Name:  "no-cache",
+				Usage: "do not reuse or store rule results when validating --message-file",
+			},
+			&cli.BoolFlag{
+				Name:  "recurse-submodules",
+				Usage: "also validate submodule commits referenced by submodule updates",
+			},
+
+			// Rule selection flags
+			&cli.StringSliceFlag{
+				Name:     "rules",
+				Usage:    "run only `RULES`, comma-separated, including rules the configuration disables",
+				Category: "Rule Selection",
+			},
+			&cli.StringSliceFlag{
+				Name:     "skip-rules",
+				Usage:    "do not run `RULES`, comma-separated",
+				Category: "Rule Selection",
+			},
 
 			// Output flags
 			&cli.BoolFlag{
@@ -90,6 +237,37 @@ Examples:
 				Usage:    "write results to `FILE`",
 				Category: "Output Options",
 			},
+			&cli.BoolFlag{
+				Name:     "interactive",
+				Aliases:  []string{"i"},
+				Usage:    "review failures in an interactive terminal screen",
+				Category: "Output Options",
+			},
+			&cli.BoolFlag{
+				Name:     "summary",
+				Usage:    "print only counts of checked, passed and failed commits and failures per rule",
+				Category: "Output Options",
+			},
+			&cli.BoolFlag{
+				Name:     "group-duplicates",
+				Usage:    "report commits with the same message and results, such as cherry-picks, once with all their hashes",
+				Category: "Output Options",
+			},
+			&cli.BoolFlag{
+				Name:     "emit-problem-matcher",
+				Usage:    "print a GitHub Actions problem matcher for the text output and exit",
+				Category: "Output Options",
+			},
+			&cli.BoolFlag{
+				Name:     "profile-rules",
+				Usage:    "time every rule for every commit and print the slowest rules and commits to stderr",
+				Category: "Output Options",
+			},
+			&cli.StringFlag{
+				Name:     "locale",
+				Usage:    "translate output to `LOCALE` (e.g., sv; default: i18n.locale or LANG)",
+				Category: "Output Options",
+			},
 		},
 
 		Action: func(ctx context.Context, cmd *cli.Command) error {
@@ -100,6 +278,12 @@ Examples:
 
 // ExecuteValidation orchestrates the validation process.
 func ExecuteValidation(ctx context.Context, cmd *cli.Command) error {
+	if cmd.Bool("emit-problem-matcher") {
+		_, err := fmt.Fprint(cmd.Root().Writer, output.GitHubProblemMatcher())
+
+		return err
+	}
+
 	// Create security validator
 	securityValidator := cliAdapter.NewSecurityValidator()
 
@@ -111,9 +295,12 @@ func ExecuteValidation(ctx context.Context, cmd *cli.Command) error {
 
 	cfg := cfgResult.Config
 
+	// Rules chosen with --rules run even when the configuration disables them
+	onlyRules, skipRules := cmd.StringSlice("rules"), cmd.StringSlice("skip-rules")
+	cfg.Rules.Enabled = slices.Concat(cfg.Rules.Enabled, onlyRules)
+
 	// Create logger from context
-	zerologLogger := logadapter.GetLogger(ctx)
-	logger := logadapter.NewDomainLogger(zerologLogger)
+	logger := logadapter.ComponentLogger(ctx, logadapter.ComponentValidation)
 
 	// Create validation target from CLI flags with security validation
 	target, err := createValidationTarget(cmd, securityValidator)
@@ -135,19 +322,158 @@ func ExecuteValidation(ctx context.Context, cmd *cli.Command) error {
 	// Create Git repository with secure path validation
 	repoPath := getRepoPath(cmd)
 
-	validatedRepoPath, err := securityValidator.ValidateRepoPath(repoPath)
+	var (
+		repo              domain.Repository
+		validatedRepoPath string
+	)
+
+	switch {
+	case target.IsPullRequest():
+		// Pull requests are fetched from GitHub and need no local clone
+		validatedRepoPath, err = filepath.Abs(repoPath)
+		if err != nil {
+			return fmt.Errorf("invalid repository path: %w", err)
+		}
+
+		repo, target, err = openPullRequest(ctx, target)
+		if err != nil {
+			return fmt.Errorf("failed to open pull request: %w", err)
+		}
+	case target.IsPatch():
+		// Patches carry their commits and need no repository either
+		validatedRepoPath, err = filepath.Abs(repoPath)
+		if err != nil {
+			return fmt.Errorf("invalid repository path: %w", err)
+		}
+
+		repo, target, err = openPatchSeries(target)
+		if err != nil {
+			return fmt.Errorf("failed to read patches: %w", err)
+		}
+	case target.IsPatchwork():
+		// Series are fetched from their Patchwork instance
+		validatedRepoPath, err = filepath.Abs(repoPath)
+		if err != nil {
+			return fmt.Errorf("invalid repository path: %w", err)
+		}
+
+		repo, target, err = openPatchworkSeries(ctx, target)
+		if err != nil {
+			return fmt.Errorf("failed to open series: %w", err)
+		}
+	case target.IsPreReceive():
+		validatedRepoPath, repo, err = openReceivingRepository(repoPath)
+		if err != nil {
+			return err
+		}
+	default:
+		validatedRepoPath, repo, err = openLocalRepository(ctx, cmd, securityValidator, repoPath)
+
+		switch {
+		case err == nil:
+		case target.IsMessageFile():
+			// Messages are validated without a repository; the rules needing one are skipped
+			logger.Debug("Validating the message without a repository", "error", err.Error())
+
+			validatedRepoPath, err = filepath.Abs(repoPath)
+			if err != nil {
+				return fmt.Errorf("invalid repository path: %w", err)
+			}
+		default:
+			return fmt.Errorf("%w (only --message-file works without a repository)", err)
+		}
+	}
+
+	ctx, span := tracing.Start(ctx, "validate")
+	defer span.End()
+
+	var profiler *profiling.Profiler
+	if cmd.Bool("profile-rules") {
+		profiler = profiling.New()
+	}
+
+	// Create rules from configuration, including external plugin rules
+	createCommitRules := func(c configTypes.Config) []domain.CommitRule {
+		c.Rules.Enabled = slices.Concat(c.Rules.Enabled, onlyRules)
+
+		created := append(rules.CreateCommitRules(c, cliAdapter.RuleServices()), plugin.CreateRules(c, validatedRepoPath)...)
+		created = tracing.CommitRules(ctx, domain.SelectCommitRules(created, onlyRules, skipRules))
+		if profiler != nil {
+			created = profiler.CommitRules(created)
+		}
+
+		return created
+	}
+
+	commitRules := append(rules.CreateCommitRules(cfg, cliAdapter.RuleServices()), plugin.CreateRules(cfg, validatedRepoPath)...)
+	repoRules := rules.CreateRepositoryRules(cfg, cliAdapter.RuleServices())
+
+	if err := checkRuleNames(onlyRules, skipRules, commitRules, repoRules); err != nil {
+		return err
+	}
+
+	commitRules = domain.SelectCommitRules(commitRules, onlyRules, skipRules)
+	repoRules = domain.SelectRepositoryRules(repoRules, onlyRules, skipRules)
+
+	// Hooks validate the same message repeatedly, as in rebase and amend loops
+	if target.Type == "message" && !cmd.Bool("no-cache") {
+		if cache := messageResultCache(cmd, cfg, logger); cache != nil {
+			commitRules = cache.CommitRules(commitRules)
+		}
+	}
+
+	commitRules = tracing.CommitRules(ctx, commitRules)
+	repoRules = tracing.RepositoryRules(ctx, repoRules)
+
+	if profiler != nil {
+		commitRules = profiler.CommitRules(commitRules)
+		repoRules = profiler.RepositoryRules(repoRules)
+	}
+
+	// Translate failures and text output
+	catalog, err := loadCatalog(cmd, cfg, validatedRepoPath)
 	if err != nil {
-		return fmt.Errorf("invalid repository path: %w", err)
+		return fmt.Errorf("failed to load translations: %w", err)
 	}
 
-	repo, err := git.NewRepository(validatedRepoPath)
+	outputOptions = outputOptions.WithTexts(catalog.Text)
+
+	outputOptions, err = withDisplay(outputOptions, cmd, cfg)
 	if err != nil {
-		return fmt.Errorf("failed to open repository: %w", err)
+		return err
 	}
 
-	// Create rules from configuration
-	commitRules := rules.CreateCommitRules(cfg)
-	repoRules := rules.CreateRepositoryRules(cfg)
+	outputOptions = outputOptions.WithLinkTemplate(linkTemplate(ctx, cmd, cfg, repo))
+
+	// Submodule updates are recorded as commits are validated, as ranges may keep none
+	var submodules *cliAdapter.SubmoduleRecorder
+
+	if cmd.Bool("recurse-submodules") && target.Type != "message" {
+		resolver, ok := repo.(domain.SubmoduleResolver)
+		if !ok {
+			return errors.New("--recurse-submodules is not supported for pull requests, patches or with --git-backend=exec")
+		}
+
+		submodules = cliAdapter.NewSubmoduleRecorder(resolver, logger)
+	}
+
+	// Ranges are written commit by commit as they are validated, so that their reports
+	// are never held, unless the whole report is needed before writing it
+	interactive := cmd.Bool("interactive")
+	stream, streaming := outputOptions.CommitStream()
+	streaming = streaming && !interactive && !cmd.Bool("ratchet") && !cmd.Bool("group-duplicates")
+
+	if streaming || (submodules != nil && target.SummaryOnly) {
+		target = target.WithCommitSink(func(commitReport domain.CommitReport) {
+			if submodules != nil {
+				submodules.Record(ctx, commitReport)
+			}
+
+			if streaming {
+				stream.WriteCommit(catalog.TranslateCommitReport(commitReport, cfg.Messages))
+			}
+		})
+	}
 
 	// Execute validation
 	report, err := cliAdapter.ValidateTarget(ctx, target, commitRules, repoRules, repo, cfg, logger)
@@ -155,20 +481,166 @@ func ExecuteValidation(ctx context.Context, cmd *cli.Command) error {
 		return fmt.Errorf("validation failed: %w", err)
 	}
 
-	// Write output
-	err = outputOptions.WriteReport(report)
-	if err != nil {
-		return fmt.Errorf("failed to write report: %w", err)
+	if repo == nil {
+		report = report.WithoutRepository(repoRules)
+	}
+
+	// Signed pushes carry a certificate naming the pusher and the ref updates
+	if target.IsPreReceive() {
+		report, err = checkPushCertificate(ctx, report, cmd.Bool("require-signed-push"), cfg, repo, validatedRepoPath)
+		if err != nil {
+			return fmt.Errorf("push certificate check failed: %w", err)
+		}
+	}
+
+	// Failures of the kinds the base history already has are reported as warnings
+	if cmd.Bool("ratchet") {
+		baseline, err := cliAdapter.RatchetBaseline(ctx, target.Source, int(cmd.Int("ratchet-window")),
+			commitRules, repoRules, repo, cfg, logger)
+		if err != nil {
+			return fmt.Errorf("ratchet failed: %w", err)
+		}
+
+		report = domain.RatchetReport(report, baseline, target.Source)
+	}
+
+	// Validate commits brought in by submodule updates
+	if submodules != nil {
+		configFor := submoduleConfigResolver(cmd.Root(), validatedRepoPath, cfg)
+
+		report, err = cliAdapter.ValidateSubmodules(ctx, report, submodules, configFor, createCommitRules)
+		if err != nil {
+			return fmt.Errorf("submodule validation failed: %w", err)
+		}
+	}
+
+	report = catalog.TranslateReport(report, cfg.Messages)
+
+	// Write output; in interactive mode only when a report file is requested
+	if !interactive || cmd.String("report-file") != "" {
+		_, renderSpan := tracing.Start(ctx, "render report")
+
+		if streaming {
+			err = stream.Close(report)
+		} else {
+			// The interactive review keeps every commit, to act on each of them
+			written := report
+			if cmd.Bool("group-duplicates") {
+				written = written.WithDuplicatesGrouped()
+			}
+
+			err = outputOptions.WriteReport(written)
+		}
+
+		renderSpan.End()
+
+		if err != nil {
+			return fmt.Errorf("failed to write report: %w", err)
+		}
+	}
+
+	// The profile goes to stderr, so that it does not mix with reports in other formats
+	if profiler != nil {
+		if err := profiler.WriteReport(os.Stderr, profileTop); err != nil {
+			return fmt.Errorf("failed to write rule profile: %w", err)
+		}
+	}
+
+	if interactive {
+		if err := tui.Run(report, os.Stdin, os.Stdout); err != nil {
+			return fmt.Errorf("interactive review failed: %w", err)
+		}
 	}
 
 	// Return non-zero exit code if validation failed
 	if !report.Summary.AllPassed {
+		// Exiting skips the shutdown of the tracer provider
+		span.End()
+		tracing.Flush(ctx)
 		os.Exit(1)
 	}
 
 	return nil
 }
 
+// checkRuleNames returns an error for a name in --rules or --skip-rules that is neither
+// a known rule nor one of the rules created for the run, such as a custom rule.
+func checkRuleNames(onlyRules, skipRules []string, commitRules []domain.CommitRule, repoRules []domain.RepositoryRule) error {
+	known := rules.AvailableRuleNames()
+
+	for _, name := range slices.Concat(onlyRules, skipRules) {
+		if slices.Contains(known, domain.CleanRuleName(name)) ||
+			len(domain.SelectCommitRules(commitRules, []string{name}, nil)) > 0 ||
+			len(domain.SelectRepositoryRules(repoRules, []string{name}, nil)) > 0 {
+			continue
+		}
+
+		return fmt.Errorf("unknown rule %q in --rules or --skip-rules; gommitlint rules lists the rules", name)
+	}
+
+	return nil
+}
+
+// openPullRequest fetches the pull request of target through the GitHub API and returns
+// it as a repository together with the range of its commits.
+func openPullRequest(ctx context.Context, target cliAdapter.ValidationTarget) (domain.Repository, cliAdapter.ValidationTarget, error) {
+	pullRequest, err := github.ParsePullRequest(target.Source)
+	if err != nil {
+		return nil, target, err
+	}
+
+	repo, err := github.NewClientFromEnv(os.LookupEnv).OpenPullRequest(ctx, pullRequest)
+	if err != nil {
+		return nil, target, err
+	}
+
+	return repo, cliAdapter.ValidationTarget{Type: "range", Source: repo.BaseHash(), Target: repo.HeadHash()}, nil
+}
+
+// openPatchSeries parses the mbox file of target and returns its patches as a
+// repository together with the range of the series.
+func openPatchSeries(target cliAdapter.ValidationTarget) (domain.Repository, cliAdapter.ValidationTarget, error) {
+	repo, err := mbox.Open(target.Source)
+	if err != nil {
+		return nil, target, err
+	}
+
+	return repo, cliAdapter.ValidationTarget{Type: "range", Source: repo.BaseHash(), Target: repo.HeadHash()}, nil
+}
+
+// openPatchworkSeries fetches the series of target from its Patchwork instance and
+// returns its patches as a repository together with the range of the series.
+func openPatchworkSeries(ctx context.Context, target cliAdapter.ValidationTarget) (domain.Repository, cliAdapter.ValidationTarget, error) {
+	series, err := patchwork.ParseSeries(target.Source)
+	if err != nil {
+		return nil, target, err
+	}
+
+	repo, err := patchwork.NewClient().OpenSeries(ctx, series)
+	if err != nil {
+		return nil, target, err
+	}
+
+	return repo, cliAdapter.ValidationTarget{Type: "range", Source: repo.BaseHash(), Target: repo.HeadHash()}, nil
+}
+
+// loadCatalog loads the translations for the locale chosen by the --locale flag,
+// the i18n.locale setting or the LANG environment. A relative translation directory
+// is resolved from the repository root.
+func loadCatalog(cmd *cli.Command, cfg configTypes.Config, repoPath string) (i18n.Catalog, error) {
+	locale := cmd.String("locale")
+	if locale == "" {
+		locale = cfg.I18n.Locale
+	}
+
+	directory := cfg.I18n.Directory
+	if directory != "" && !filepath.IsAbs(directory) {
+		directory = filepath.Join(repoPath, directory)
+	}
+
+	return i18n.Load(i18n.ResolveLocale(locale, os.LookupEnv), directory)
+}
+
 // createValidationTarget creates a ValidationTarget from CLI flags with security validation.
 func createValidationTarget(cmd *cli.Command, validator *cliAdapter.SecurityValidator) (cliAdapter.ValidationTarget, error) {
 	messageFile := cmd.String("message-file")
@@ -177,6 +649,54 @@ func createValidationTarget(cmd *cli.Command, validator *cliAdapter.SecurityVali
 	baseBranch := cmd.String("base-branch")
 	commitCount := cmd.Int("count")
 
+	// The ratchet compares with the history of the start of the range
+	if cmd.Bool("ratchet") && commitRange == "" && baseBranch == "" {
+		return cliAdapter.ValidationTarget{}, errors.New("--ratchet needs --base-branch or --range")
+	}
+
+	if cmd.Bool("require-signed-push") && !cmd.Bool("pre-receive") {
+		return cliAdapter.ValidationTarget{}, errors.New("--require-signed-push needs --pre-receive")
+	}
+
+	// Pushed commits are given on stdin by the pre-push hook
+	if cmd.IsSet("pre-push") {
+		return cliAdapter.NewPushTarget(cmd.String("pre-push"))
+	}
+
+	if cmd.Bool("pre-receive") {
+		return cliAdapter.NewPreReceiveTarget(), nil
+	}
+
+	if cmd.IsSet("amend-message") && !cmd.Bool("staged-amend") {
+		return cliAdapter.ValidationTarget{}, errors.New("--amend-message needs --staged-amend")
+	}
+
+	if cmd.Bool("staged-amend") {
+		return cliAdapter.NewStagedAmendTarget(cmd.String("amend-message"))
+	}
+
+	if cmd.IsSet("github-pr") {
+		return cliAdapter.NewPullRequestTarget(cmd.String("github-pr"))
+	}
+
+	if cmd.IsSet("patchwork-series") {
+		return cliAdapter.NewPatchworkTarget(cmd.String("patchwork-series"))
+	}
+
+	if cmd.IsSet("patch") {
+		patchFile := cmd.String("patch")
+		if patchFile != "-" {
+			validatedPath, err := validator.ValidateMessageFilePath(patchFile)
+			if err != nil {
+				return cliAdapter.ValidationTarget{}, err
+			}
+
+			patchFile = validatedPath
+		}
+
+		return cliAdapter.NewPatchTarget(patchFile)
+	}
+
 	// Validate message file path if provided
 	if messageFile != "" {
 		validatedPath, err := validator.ValidateMessageFilePath(messageFile)
@@ -208,7 +728,19 @@ func createValidationTarget(cmd *cli.Command, validator *cliAdapter.SecurityVali
 		}
 	}
 
-	return cliAdapter.NewValidationTarget(messageFile, gitRef, commitRange, baseBranch, commitCount)
+	target, err := cliAdapter.NewValidationTarget(messageFile, gitRef, commitRange, baseBranch, commitCount)
+	if err != nil {
+		return cliAdapter.ValidationTarget{}, err
+	}
+
+	target, err = target.WithRangeOptions(cmd.Bool("merge-base"), cmd.Bool("first-parent"))
+	if err != nil {
+		return cliAdapter.ValidationTarget{}, err
+	}
+
+	// Only the counts are printed, unless the interactive review or the ratchet needs
+	// every commit
+	return target.WithSummaryOnly(cmd.Bool("summary") && !cmd.Bool("interactive") && !cmd.Bool("ratchet")), nil
 }
 
 // createOutputOptions creates OutputOptions from CLI flags with security validation.
@@ -249,7 +781,8 @@ func createOutputOptions(cmd *cli.Command, validator *cliAdapter.SecurityValidat
 	// Create base options
 	options := cliAdapter.NewOutputOptions(writer).
 		WithFormat(format).
-		WithColor(color)
+		WithColor(color).
+		WithSummary(cmd.Bool("summary"))
 
 	// Handle verbose flags (command-specific)
 	verboseLevel := countVerboseFlags(cmd)
@@ -269,6 +802,97 @@ func createOutputOptions(cmd *cli.Command, validator *cliAdapter.SecurityValidat
 	return options, nil
 }
 
+// withDisplay sets the color theme and symbols of text output from the --theme and
+// --ascii flags, falling back to the display section of cfg.
+func withDisplay(options cliAdapter.OutputOptions, cmd *cli.Command, cfg configTypes.Config) (cliAdapter.OutputOptions, error) {
+	theme := cmd.Root().String("theme")
+	if theme == "" {
+		theme = cfg.Display.Theme
+	}
+
+	if !output.IsValidTheme(theme) {
+		return cliAdapter.OutputOptions{}, fmt.Errorf("unsupported theme '%s', supported themes: %v", theme, output.Themes())
+	}
+
+	return options.WithTheme(theme).WithASCII(cmd.Root().Bool("ascii") || cfg.Display.ASCII), nil
+}
+
+// linkTemplate returns the template of commit links from the --link-template flag,
+// falling back to display.link_template. The auto template is derived from the origin
+// remote of repo, and is empty when there is none or its URL is a local path.
+func linkTemplate(ctx context.Context, cmd *cli.Command, cfg configTypes.Config, repo domain.Repository) string {
+	template := cmd.Root().String("link-template")
+	if template == "" {
+		template = cfg.Display.LinkTemplate
+	}
+
+	if template != domain.AutoLinkTemplate {
+		return template
+	}
+
+	resolver, ok := repo.(domain.RemoteURLResolver)
+	if !ok {
+		return ""
+	}
+
+	remoteURL, err := resolver.GetRemoteURL(ctx, "origin")
+	if err != nil {
+		return ""
+	}
+
+	return domain.CommitLinkTemplate(remoteURL)
+}
+
+// submoduleConfigResolver returns a resolver that loads a submodule's own configuration file
+// when present, falling back to the parent configuration.
+// Explicit --gommitconfig or --ignore-config always apply to submodules as well.
+func submoduleConfigResolver(root *cli.Command, repoPath string, parent configTypes.Config) cliAdapter.SubmoduleConfigFunc {
+	return func(path string) (configTypes.Config, error) {
+		if root.String("gommitconfig") != "" || root.Bool("ignore-config") {
+			return parent, nil
+		}
+
+		submoduleDir := filepath.Join(repoPath, path)
+		for _, name := range []string{".gommitlint.yaml", ".gommitlint.yml", ".gommitlint.toml"} {
+			configPath := filepath.Join(submoduleDir, name)
+			if _, err := os.Stat(configPath); err == nil {
+				return config.LoadConfigFromPath(configPath)
+			}
+		}
+
+		return parent, nil
+	}
+}
+
+// messageResultCache returns the cache of message validation results in the user cache
+// directory, or nil when there is none. Results are tied to the running binary, so that
+// rebuilding gommitlint invalidates them as well as changing the configuration.
+func messageResultCache(cmd *cli.Command, cfg configTypes.Config, logger domain.Logger) *resultcache.Cache {
+	cacheDir, err := os.UserCacheDir()
+	if err != nil {
+		logger.Debug("Not caching results", "error", err.Error())
+
+		return nil
+	}
+
+	build := cmd.Root().Version
+
+	if executable, err := os.Executable(); err == nil {
+		if info, err := os.Stat(executable); err == nil {
+			build += fmt.Sprintf("\x00%d\x00%d", info.Size(), info.ModTime().UnixNano())
+		}
+	}
+
+	cache, err := resultcache.New(filepath.Join(cacheDir, "gommitlint", "results"), cfg, build)
+	if err != nil {
+		logger.Debug("Not caching results", "error", err.Error())
+
+		return nil
+	}
+
+	return cache
+}
+
 // getRepoPath gets the repository path from CLI flags or defaults to current directory.
 func getRepoPath(cmd *cli.Command) string {
 	repoPath := cmd.Root().String("repo-path")
@@ -0,0 +1,218 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package commands
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	cliAdapter "github.com/itiquette/gommitlint/internal/adapters/cli"
+	"github.com/itiquette/gommitlint/internal/adapters/git"
+	"github.com/itiquette/gommitlint/internal/adapters/signing"
+	"github.com/itiquette/gommitlint/internal/domain"
+	"github.com/urfave/cli/v3"
+)
+
+// NewVerifyTagCommand creates the verify-tag subcommand.
+func NewVerifyTagCommand() *cli.Command {
+	return &cli.Command{
+		Name:      "verify-tag",
+		Usage:     "Verify the signatures of annotated tags",
+		ArgsUsage: "[TAG|PATTERN|RANGE]...",
+		Description: `Verifies the GPG or SSH signature of each selected tag against the trusted keys in
+signature.key_directory, and checks the signer against signature.allowed_signers when set.
+Lightweight and unsigned tags fail verification.
+
+Without arguments every tag is verified. An argument is a tag name, a glob such as "v1.*",
+or a commit range "from..to" selecting the tags of the commits in the range.
+
+Examples:
+  # Verify a release tag
+  gommitlint verify-tag v1.2.0
+
+  # Verify every 1.x release with keys from a directory
+  gommitlint verify-tag --key-dir ./keys "v1.*"
+
+  # Verify the tags created since the last release
+  gommitlint verify-tag v1.2.0..HEAD`,
+
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:  "key-dir",
+				Usage: "`DIR` with trusted public keys (default: signature.key_directory and key_directories)",
+			},
+		},
+
+		Action: func(ctx context.Context, cmd *cli.Command) error {
+			passed, err := ExecuteVerifyTag(ctx, cmd)
+			if err != nil {
+				return err
+			}
+
+			if !passed {
+				os.Exit(1)
+			}
+
+			return nil
+		},
+	}
+}
+
+// tagVerification is the outcome of verifying one tag.
+type tagVerification struct {
+	Tag      string                 `json:"tag"`
+	Hash     string                 `json:"hash,omitempty"`
+	Verified bool                   `json:"verified"`
+	Signer   string                 `json:"signer,omitempty"`
+	Type     string                 `json:"signature_type,omitempty"`
+	Errors   []tagVerificationError `json:"errors,omitempty"`
+}
+
+// tagVerificationError is a verification failure in the JSON output.
+type tagVerificationError struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+// ExecuteVerifyTag verifies the tags selected by the command arguments and writes the
+// outcome. It reports whether every tag verified.
+func ExecuteVerifyTag(ctx context.Context, cmd *cli.Command) (bool, error) {
+	cfgResult, err := LoadConfigFromCommand(cmd.Root())
+	if err != nil {
+		return false, fmt.Errorf("failed to load configuration: %w", err)
+	}
+
+	cfg := cfgResult.Config
+
+	validatedRepoPath, err := cliAdapter.NewSecurityValidator().ValidateRepoPath(getRepoPath(cmd))
+	if err != nil {
+		return false, fmt.Errorf("invalid repository path: %w", err)
+	}
+
+	keyDirs := trustedKeyDirectories(cmd.String("key-dir"), cfg.Signature, validatedRepoPath)
+	if len(keyDirs) == 0 {
+		return false, errors.New("no trusted keys configured, set signature.key_directory, ssh_agent or known_signers, or pass --key-dir")
+	}
+
+	repo, err := git.NewRepository(validatedRepoPath)
+	if err != nil {
+		return false, fmt.Errorf("failed to open repository: %w", err)
+	}
+
+	tags, err := selectTags(ctx, repo, cmd.Args().Slice())
+	if err != nil {
+		return false, err
+	}
+
+	if len(tags) == 0 {
+		return false, errors.New("no tags to verify")
+	}
+
+	verifier := signing.NewVerificationAdapterFromConfig(cfg.Signature)
+	results := make([]tagVerification, 0, len(tags))
+	passed := true
+
+	for _, tag := range tags {
+		if err := ctx.Err(); err != nil {
+			return false, fmt.Errorf("tag verification stopped: %w", err)
+		}
+
+		result := tagVerification{Tag: tag.Name, Hash: tag.Hash}
+
+		verification := domain.VerificationResult{}
+		if tag.IsAnnotated() && tag.Signature != "" {
+			verification = domain.VerifyWithKeyDirectories(keyDirs, func(keyDir string) domain.VerificationResult {
+				return verifier.VerifyTag(ctx, tag, keyDir)
+			})
+			result.Type = string(verification.Signature().Type())
+		}
+
+		for _, validationErr := range domain.ValidateTagSignature(tag, verification, cfg.Signature.AllowedSigners) {
+			result.Errors = append(result.Errors, tagVerificationError{Code: validationErr.Code, Message: validationErr.Message})
+		}
+
+		result.Verified = len(result.Errors) == 0
+		if result.Verified {
+			result.Signer = verification.Identity().String()
+		}
+
+		passed = passed && result.Verified
+		results = append(results, result)
+	}
+
+	if cmd.Root().String("format") == "json" {
+		return passed, writeTagVerificationsJSON(cmd.Writer, results)
+	}
+
+	writeTagVerifications(cmd.Writer, results)
+
+	return passed, nil
+}
+
+// selectTags collects the tags matching any of the selectors, all tags when there are none.
+func selectTags(ctx context.Context, repo domain.TagResolver, selectors []string) ([]domain.Tag, error) {
+	if len(selectors) == 0 {
+		selectors = []string{""}
+	}
+
+	var tags []domain.Tag
+
+	seen := make(map[string]bool)
+
+	for _, selector := range selectors {
+		selected, err := repo.GetTags(ctx, selector)
+		if err != nil {
+			return nil, fmt.Errorf("failed to select tags: %w", err)
+		}
+
+		for _, tag := range selected {
+			if !seen[tag.Name] {
+				seen[tag.Name] = true
+				tags = append(tags, tag)
+			}
+		}
+	}
+
+	return tags, nil
+}
+
+// writeTagVerifications writes one line per tag followed by its failures.
+func writeTagVerifications(writer io.Writer, results []tagVerification) {
+	failed := 0
+
+	for _, result := range results {
+		if result.Verified {
+			fmt.Fprintf(writer, "✓ %s: signed by %s (%s)\n", result.Tag, result.Signer, result.Type)
+
+			continue
+		}
+
+		failed++
+
+		fmt.Fprintf(writer, "✗ %s\n", result.Tag)
+
+		for _, verificationErr := range result.Errors {
+			fmt.Fprintf(writer, "    %s (%s)\n", verificationErr.Message, verificationErr.Code)
+		}
+	}
+
+	if failed == 0 {
+		fmt.Fprintf(writer, "\nAll %d tags verified\n", len(results))
+	} else {
+		fmt.Fprintf(writer, "\n%d of %d tags failed verification\n", failed, len(results))
+	}
+}
+
+// writeTagVerificationsJSON writes the results as a JSON array.
+func writeTagVerificationsJSON(writer io.Writer, results []tagVerification) error {
+	encoder := json.NewEncoder(writer)
+	encoder.SetIndent("", "  ")
+
+	return encoder.Encode(results)
+}
@@ -0,0 +1,98 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package commands
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	cliAdapter "github.com/itiquette/gommitlint/internal/adapters/cli"
+	"github.com/itiquette/gommitlint/internal/adapters/git"
+	"github.com/itiquette/gommitlint/internal/domain"
+	"github.com/urfave/cli/v3"
+)
+
+// NewWaiveCommand creates the waive subcommand.
+func NewWaiveCommand() *cli.Command {
+	return &cli.Command{
+		Name:      "waive",
+		Usage:     "Waive a rule for a commit",
+		ArgsUsage: "<commit>",
+		Description: `Records a waiver in the git note of a commit (refs/notes/gommitlint).
+Failures of a waived rule are still reported for the commit, but no longer fail validation.
+
+Notes are not pushed or fetched by default; share them with:
+  git push origin refs/notes/gommitlint
+  git fetch origin refs/notes/gommitlint:refs/notes/gommitlint
+
+Examples:
+  # Waive the subject rule for a commit imported from another project
+  gommitlint waive 1a2b3c4 --rule Subject --reason "Imported from upstream"
+
+  # Record who approved the waiver
+  gommitlint waive HEAD~2 --rule Signature --reason "Signed out of band" --approver "Jane Doe <jane@example.com>"`,
+
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:     "rule",
+				Usage:    "`RULE` to waive, as named in validation reports",
+				Required: true,
+			},
+			&cli.StringFlag{
+				Name:     "reason",
+				Usage:    "`REASON` for the waiver",
+				Required: true,
+			},
+			&cli.StringFlag{
+				Name:  "approver",
+				Usage: "`IDENTITY` approving the waiver (default: git user.name and user.email)",
+			},
+		},
+
+		Action: func(ctx context.Context, cmd *cli.Command) error {
+			return ExecuteWaive(ctx, cmd)
+		},
+	}
+}
+
+// ExecuteWaive records the waiver requested by the command flags.
+func ExecuteWaive(ctx context.Context, cmd *cli.Command) error {
+	if cmd.Args().Len() != 1 {
+		return errors.New("waive takes exactly one commit")
+	}
+
+	ref := cmd.Args().First()
+
+	securityValidator := cliAdapter.NewSecurityValidator()
+	if err := securityValidator.ValidateGitReference(ref); err != nil {
+		return fmt.Errorf("invalid commit: %w", err)
+	}
+
+	validatedRepoPath, err := securityValidator.ValidateRepoPath(getRepoPath(cmd))
+	if err != nil {
+		return fmt.Errorf("invalid repository path: %w", err)
+	}
+
+	repo, err := git.NewRepository(validatedRepoPath)
+	if err != nil {
+		return fmt.Errorf("failed to open repository: %w", err)
+	}
+
+	approver := cmd.String("approver")
+	if approver == "" {
+		approver = repo.UserIdentity().String()
+	}
+
+	waiver := domain.Waiver{Rule: cmd.String("rule"), Reason: cmd.String("reason"), Approver: approver}
+
+	if err := repo.AddWaiver(ctx, ref, waiver); err != nil {
+		return fmt.Errorf("failed to record waiver: %w", err)
+	}
+
+	fmt.Fprintf(cmd.Writer, "Waived %s for %s (approved by %s)\n", waiver.Rule, ref, waiver.Approver)
+
+	return nil
+}
@@ -0,0 +1,214 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package commands
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"syscall"
+	"time"
+
+	"github.com/itiquette/gommitlint/internal/adapters/charset"
+	cliAdapter "github.com/itiquette/gommitlint/internal/adapters/cli"
+	"github.com/itiquette/gommitlint/internal/adapters/output"
+	"github.com/itiquette/gommitlint/internal/adapters/plugin"
+	"github.com/itiquette/gommitlint/internal/domain"
+	"github.com/itiquette/gommitlint/internal/domain/rules"
+	"github.com/urfave/cli/v3"
+	"golang.org/x/term"
+)
+
+// clearTerminal moves the cursor home and clears the screen.
+const clearTerminal = "\033[H\033[2J"
+
+// NewWatchCommand creates the watch subcommand.
+func NewWatchCommand() *cli.Command {
+	return &cli.Command{
+		Name:  "watch",
+		Usage: "Re-validate a commit message file whenever it changes",
+		Description: `Watches a commit message file and prints live validation feedback each
+time it is saved. Run it in a second terminal while editing the message.
+
+Examples:
+  # Watch the message of the commit being written
+  gommitlint watch
+
+  # Watch a specific file with verbose output
+  gommitlint watch --message-file=.git/COMMIT_EDITMSG -v`,
+
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:    "message-file",
+				Aliases: []string{"f"},
+				Usage:   "watch commit message in `FILE` (default: .git/COMMIT_EDITMSG of the repository)",
+			},
+			&cli.DurationFlag{
+				Name:  "interval",
+				Value: cliAdapter.DefaultWatchInterval,
+				Usage: "how often to check the file for changes",
+			},
+			&cli.BoolFlag{
+				Name:    "verbose",
+				Aliases: []string{"v"},
+				Usage:   "verbose output (-v for verbose, -vv for extra verbose)",
+			},
+			&cli.StringFlag{
+				Name:  "locale",
+				Usage: "translate output to `LOCALE` (e.g., sv; default: i18n.locale or LANG)",
+			},
+		},
+
+		Action: func(ctx context.Context, cmd *cli.Command) error {
+			return ExecuteWatch(ctx, cmd)
+		},
+	}
+}
+
+// ExecuteWatch validates the watched message file on every change until interrupted.
+func ExecuteWatch(ctx context.Context, cmd *cli.Command) error {
+	securityValidator := cliAdapter.NewSecurityValidator()
+
+	// Load configuration once; restart watch to pick up configuration changes
+	cfgResult, err := LoadConfigFromCommand(cmd.Root())
+	if err != nil {
+		return fmt.Errorf("failed to load configuration: %w", err)
+	}
+
+	cfg := cfgResult.Config
+
+	repoPath, err := filepath.Abs(getRepoPath(cmd))
+	if err != nil {
+		return fmt.Errorf("invalid repository path: %w", err)
+	}
+
+	messageFile := cmd.String("message-file")
+	if messageFile == "" {
+		validatedRepoPath, err := securityValidator.ValidateRepoPath(repoPath)
+		if err != nil {
+			return fmt.Errorf("cannot locate default message file: %w", err)
+		}
+
+		repoPath = validatedRepoPath
+		messageFile = filepath.Join(repoPath, ".git", "COMMIT_EDITMSG")
+	}
+
+	messageFile, err = securityValidator.ValidateWatchFilePath(messageFile)
+	if err != nil {
+		return err
+	}
+
+	format := cmd.Root().String("format")
+	if !output.IsValidFormat(format) {
+		return fmt.Errorf("unsupported format '%s', supported formats: %v", format, output.SupportedFormats())
+	}
+
+	catalog, err := loadCatalog(cmd, cfg, repoPath)
+	if err != nil {
+		return fmt.Errorf("failed to load translations: %w", err)
+	}
+
+	writer := cmd.Root().Writer
+	if writer == nil {
+		writer = os.Stdout
+	}
+
+	outputOptions := cliAdapter.NewOutputOptions(writer).
+		WithFormat(format).
+		WithColor(cmd.Root().String("color")).
+		WithTexts(catalog.Text)
+
+	outputOptions, err = withDisplay(outputOptions, cmd, cfg)
+	if err != nil {
+		return err
+	}
+
+	if verboseLevel := countVerboseFlags(cmd); verboseLevel > 0 && !cmd.Root().Bool("quiet") {
+		outputOptions = outputOptions.WithVerboseLevel(verboseLevel)
+	}
+
+	commitRules := append(rules.CreateCommitRules(cfg, cliAdapter.RuleServices()), plugin.CreateRules(cfg, repoPath)...)
+
+	// Rule conditions on the branch and staged files need the repository
+	var repo domain.Repository
+	if gitRepo, err := openRepository(ctx, cmd, repoPath); err == nil {
+		repo = gitRepo
+	}
+
+	ctx, stop := signal.NotifyContext(ctx, os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	watcher := messageWatcher{
+		file:        messageFile,
+		options:     outputOptions,
+		clearScreen: format == "text" && isTerminal(writer),
+		validate: func(content string) (domain.Report, error) {
+			message, err := charset.DecodeMessage([]byte(content), cfg.Message.FallbackEncoding)
+			if err != nil {
+				return domain.Report{}, err
+			}
+
+			report, err := cliAdapter.ValidateMessageContent(message, commitRules, repo, cfg)
+			if err != nil {
+				return domain.Report{}, err
+			}
+
+			return catalog.TranslateReport(report, cfg.Messages), nil
+		},
+	}
+
+	return cliAdapter.WatchMessageFile(ctx, messageFile, cmd.Duration("interval"), watcher.onChange)
+}
+
+// messageWatcher renders validation feedback for each change of a watched message file.
+type messageWatcher struct {
+	file        string
+	options     cliAdapter.OutputOptions
+	clearScreen bool
+	validate    func(message string) (domain.Report, error)
+}
+
+// onChange validates the new message and writes the report.
+func (w messageWatcher) onChange(message string, exists bool) error {
+	writer := w.options.Writer
+
+	if w.clearScreen {
+		fmt.Fprint(writer, clearTerminal)
+	}
+
+	// Status lines are only meaningful next to the human-readable report
+	if w.options.Format == "text" {
+		fmt.Fprintf(writer, "Watching %s (Ctrl+C to stop) - %s\n\n", w.file, time.Now().Format(time.TimeOnly))
+	}
+
+	if !exists {
+		if w.options.Format == "text" {
+			fmt.Fprintln(writer, "Waiting for the message file to be written...")
+		}
+
+		return nil
+	}
+
+	report, err := w.validate(message)
+	if err != nil {
+		return fmt.Errorf("validation failed: %w", err)
+	}
+
+	if err := w.options.WriteReport(report); err != nil {
+		return fmt.Errorf("failed to write report: %w", err)
+	}
+
+	return nil
+}
+
+// isTerminal reports whether writer is an interactive terminal.
+func isTerminal(writer io.Writer) bool {
+	file, ok := writer.(*os.File)
+
+	return ok && term.IsTerminal(int(file.Fd()))
+}
@@ -0,0 +1,93 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package cli
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+)
+
+// DefaultConfigDebounce is how long changes to configuration files must settle before
+// they are reloaded, so that an editor saving in several steps causes one reload.
+const DefaultConfigDebounce = 250 * time.Millisecond
+
+// WatchFiles calls onChange whenever one of paths, or a file directly in one of them
+// that is a directory, is created, written, renamed or removed, after the changes have
+// settled for debounce, until ctx is done. The directories holding the files are watched
+// rather than the files, so that files replaced by editors, and files and directories
+// that do not exist yet, are followed.
+func WatchFiles(ctx context.Context, paths []string, debounce time.Duration, onChange func()) error {
+	if debounce <= 0 {
+		debounce = DefaultConfigDebounce
+	}
+
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return fmt.Errorf("failed to watch files: %w", err)
+	}
+	defer watcher.Close()
+
+	watched := make(map[string]bool, len(paths))
+
+	for _, path := range paths {
+		absolute, err := filepath.Abs(path)
+		if err != nil {
+			return fmt.Errorf("failed to watch %s: %w", path, err)
+		}
+
+		watched[absolute] = true
+
+		for _, dir := range []string{filepath.Dir(absolute), absolute} {
+			if err := watchDir(watcher, dir); err != nil {
+				return err
+			}
+		}
+	}
+
+	timer := time.NewTimer(debounce)
+	timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case err := <-watcher.Errors:
+			return fmt.Errorf("failed to watch files: %w", err)
+		case event := <-watcher.Events:
+			if event.Op == fsnotify.Chmod || (!watched[event.Name] && !watched[filepath.Dir(event.Name)]) {
+				continue
+			}
+
+			// A watched directory created later is watched for the files added to it
+			if watched[event.Name] && event.Has(fsnotify.Create) {
+				if err := watchDir(watcher, event.Name); err != nil {
+					return err
+				}
+			}
+
+			timer.Reset(debounce)
+		case <-timer.C:
+			onChange()
+		}
+	}
+}
+
+// watchDir adds path to watcher when it is an existing directory.
+func watchDir(watcher *fsnotify.Watcher, path string) error {
+	if info, err := os.Stat(path); err != nil || !info.IsDir() {
+		return nil
+	}
+
+	if err := watcher.Add(path); err != nil {
+		return fmt.Errorf("failed to watch %s: %w", path, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,99 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package cli
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWatchFiles(t *testing.T) {
+	dir := t.TempDir()
+	configPath := filepath.Join(dir, ".gommitlint.yaml")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	changes := make(chan struct{}, 10)
+	done := make(chan error, 1)
+
+	go func() {
+		done <- WatchFiles(ctx, []string{configPath}, 50*time.Millisecond, func() {
+			changes <- struct{}{}
+		})
+	}()
+
+	waitForChange := func(reason string) {
+		t.Helper()
+
+		select {
+		case <-changes:
+		case <-ctx.Done():
+			t.Fatalf("no change noticed: %s", reason)
+		}
+	}
+
+	// Give the watcher time to start; writes before that are not noticed
+	time.Sleep(100 * time.Millisecond)
+
+	// Files next to the watched one are ignored
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "notes.txt"), []byte("notes"), 0o600))
+
+	require.NoError(t, os.WriteFile(configPath, []byte("gommitlint: {}\n"), 0o600))
+	waitForChange("file created")
+
+	// Editors often save by renaming a new file over the old one
+	temporary := filepath.Join(dir, ".gommitlint.yaml.tmp")
+	require.NoError(t, os.WriteFile(temporary, []byte("gommitlint:\n  spell:\n    locale: en_GB\n"), 0o600))
+	require.NoError(t, os.Rename(temporary, configPath))
+	waitForChange("file replaced")
+
+	cancel()
+	require.NoError(t, <-done)
+	require.Empty(t, changes, "each settled change is reported once")
+}
+
+func TestWatchFiles_Directory(t *testing.T) {
+	fragmentDir := filepath.Join(t.TempDir(), ".gommitlint.d")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	changes := make(chan struct{}, 10)
+	done := make(chan error, 1)
+
+	go func() {
+		done <- WatchFiles(ctx, []string{fragmentDir}, 50*time.Millisecond, func() {
+			changes <- struct{}{}
+		})
+	}()
+
+	waitForChange := func(reason string) {
+		t.Helper()
+
+		select {
+		case <-changes:
+		case <-ctx.Done():
+			t.Fatalf("no change noticed: %s", reason)
+		}
+	}
+
+	time.Sleep(100 * time.Millisecond)
+
+	// The directory is watched once it is created
+	require.NoError(t, os.Mkdir(fragmentDir, 0o755))
+	waitForChange("directory created")
+
+	require.NoError(t, os.WriteFile(filepath.Join(fragmentDir, "10-team.yaml"), []byte("gommitlint: {}\n"), 0o600))
+	waitForChange("fragment added")
+
+	cancel()
+	require.NoError(t, <-done)
+}
@@ -0,0 +1,335 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+
+	"github.com/itiquette/gommitlint/internal/domain"
+	"github.com/itiquette/gommitlint/internal/domain/config"
+)
+
+// DefaultRefDebounce is how long the daemon waits for ref changes to settle, so that a
+// rebase rewriting many refs is examined once.
+const DefaultRefDebounce = 200 * time.Millisecond
+
+// ResolveGitDir returns the directory holding the refs of the repository at repoPath.
+// For a linked worktree, whose .git is a file, that is the directory of the main
+// repository the worktree shares its branches with.
+func ResolveGitDir(repoPath string) (string, error) {
+	gitDir := filepath.Join(repoPath, ".git")
+
+	info, err := os.Stat(gitDir)
+	if err != nil {
+		return "", fmt.Errorf("not a git repository: %w", err)
+	}
+
+	if !info.IsDir() {
+		content, err := os.ReadFile(gitDir)
+		if err != nil {
+			return "", fmt.Errorf("read .git file: %w", err)
+		}
+
+		target, found := strings.CutPrefix(strings.TrimSpace(string(content)), "gitdir:")
+		if !found {
+			return "", fmt.Errorf("invalid .git file: %s", gitDir)
+		}
+
+		gitDir = resolvePath(repoPath, strings.TrimSpace(target))
+	}
+
+	if common, err := os.ReadFile(filepath.Join(gitDir, "commondir")); err == nil {
+		gitDir = resolvePath(gitDir, strings.TrimSpace(string(common)))
+	}
+
+	return gitDir, nil
+}
+
+// resolvePath returns path, resolved against base when it is relative.
+func resolvePath(base, path string) string {
+	if filepath.IsAbs(path) {
+		return filepath.Clean(path)
+	}
+
+	return filepath.Join(base, path)
+}
+
+// WatchRefs calls onChange once when it starts and then whenever the local branches
+// in gitDir may have changed, until ctx is done. Changes are noticed through file
+// system notifications on refs/heads and packed-refs and are reported after they have
+// settled for debounce. An error returned by onChange stops watching and is returned.
+func WatchRefs(ctx context.Context, gitDir string, debounce time.Duration, onChange func() error) error {
+	if debounce <= 0 {
+		debounce = DefaultRefDebounce
+	}
+
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return fmt.Errorf("failed to watch refs: %w", err)
+	}
+	defer watcher.Close()
+
+	headsDir := filepath.Join(gitDir, "refs", "heads")
+
+	// Packed refs are rewritten in gitDir; loose refs may sit in nested directories
+	if err := watcher.Add(gitDir); err != nil {
+		return fmt.Errorf("failed to watch %s: %w", gitDir, err)
+	}
+
+	if err := watchTree(watcher, headsDir); err != nil {
+		return err
+	}
+
+	if err := onChange(); err != nil {
+		return err
+	}
+
+	timer := time.NewTimer(debounce)
+	timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case err := <-watcher.Errors:
+			return fmt.Errorf("failed to watch refs: %w", err)
+		case event := <-watcher.Events:
+			if !isRefEvent(event, gitDir, headsDir) {
+				continue
+			}
+
+			// Branches with a slash in their name create directories to watch
+			if event.Has(fsnotify.Create) {
+				if info, err := os.Stat(event.Name); err == nil && info.IsDir() {
+					if err := watchTree(watcher, event.Name); err != nil {
+						return err
+					}
+				}
+			}
+
+			timer.Reset(debounce)
+		case <-timer.C:
+			if err := onChange(); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// watchTree adds dir and the directories below it to watcher.
+func watchTree(watcher *fsnotify.Watcher, dir string) error {
+	err := filepath.WalkDir(dir, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if entry.IsDir() {
+			return watcher.Add(path)
+		}
+
+		return nil
+	})
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("failed to watch %s: %w", dir, err)
+	}
+
+	return nil
+}
+
+// isRefEvent reports whether event may have changed a local branch. Lock files are
+// skipped, since git renames them to the ref once it is written.
+func isRefEvent(event fsnotify.Event, gitDir, headsDir string) bool {
+	if strings.HasSuffix(event.Name, ".lock") || event.Op == fsnotify.Chmod {
+		return false
+	}
+
+	return event.Name == filepath.Join(gitDir, "packed-refs") || strings.HasPrefix(event.Name, headsDir)
+}
+
+// BranchRepository is the part of a repository the daemon follows branches through.
+type BranchRepository interface {
+	// BranchTips returns the commit hash of each local branch by short branch name.
+	BranchTips(ctx context.Context) (map[string]string, error)
+	// GetCommit returns the commit ref points to.
+	GetCommit(ctx context.Context, ref string) (domain.Commit, error)
+	// GetCommitRange returns the commits reachable from toRef but not from fromRef.
+	GetCommitRange(ctx context.Context, fromRef, toRef string) ([]domain.Commit, error)
+}
+
+// BranchCommit is a commit that was added to a branch.
+type BranchCommit struct {
+	Branch string
+	Commit domain.Commit
+}
+
+// RefTracker finds the commits added to local branches since it last looked.
+type RefTracker struct {
+	repo BranchRepository
+	tips map[string]string
+	seen map[string]bool
+}
+
+// NewRefTracker creates a tracker that reports the commits added to the branches of
+// repo after the branch tips it finds now.
+func NewRefTracker(ctx context.Context, repo BranchRepository) (*RefTracker, error) {
+	tips, err := repo.BranchTips(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &RefTracker{repo: repo, tips: tips, seen: make(map[string]bool)}, nil
+}
+
+// NewCommits returns the commits added to each branch since the previous call, oldest
+// first. A commit is returned once, even when several branches gain it. The commits of
+// a new branch are those no other branch had before. Branches whose commits cannot be
+// read are reported in the error, together with the commits of the other branches.
+func (t *RefTracker) NewCommits(ctx context.Context) ([]BranchCommit, error) {
+	tips, err := t.repo.BranchTips(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	branches := make([]string, 0, len(tips))
+	for branch := range tips {
+		branches = append(branches, branch)
+	}
+
+	sort.Strings(branches)
+
+	var (
+		added    []BranchCommit
+		failures []error
+	)
+
+	for _, branch := range branches {
+		tip := tips[branch]
+		if tip == t.tips[branch] {
+			continue
+		}
+
+		commits, err := t.addedCommits(ctx, branch, tip)
+		if err != nil {
+			failures = append(failures, fmt.Errorf("find new commits of %s: %w", branch, err))
+
+			continue
+		}
+
+		for _, commit := range parentsFirst(commits) {
+			if !t.seen[commit.Hash] {
+				t.seen[commit.Hash] = true
+				added = append(added, BranchCommit{Branch: branch, Commit: commit})
+			}
+		}
+	}
+
+	t.tips = tips
+
+	return added, errors.Join(failures...)
+}
+
+// addedCommits returns the commits reachable from tip that the previous tip of branch,
+// or for a new branch every previous tip, could not reach. The first branch of a new
+// repository adds only its tip.
+func (t *RefTracker) addedCommits(ctx context.Context, branch, tip string) ([]domain.Commit, error) {
+	if previous, found := t.tips[branch]; found {
+		return t.repo.GetCommitRange(ctx, previous, tip)
+	}
+
+	if len(t.tips) == 0 {
+		commit, err := t.repo.GetCommit(ctx, tip)
+		if err != nil {
+			return nil, err
+		}
+
+		return []domain.Commit{commit}, nil
+	}
+
+	var fewest []domain.Commit
+
+	for _, previous := range t.tips {
+		commits, err := t.repo.GetCommitRange(ctx, previous, tip)
+		if err != nil {
+			return nil, err
+		}
+
+		if fewest == nil || len(commits) < len(fewest) {
+			fewest = commits
+		}
+
+		if len(fewest) == 0 {
+			return nil, nil
+		}
+	}
+
+	return fewest, nil
+}
+
+// parentsFirst orders commits by date with every commit after its parents, which
+// commits made within the same second need.
+func parentsFirst(commits []domain.Commit) []domain.Commit {
+	sort.SliceStable(commits, func(i, j int) bool {
+		return commits[i].CommitDate < commits[j].CommitDate
+	})
+
+	byHash := make(map[string]domain.Commit, len(commits))
+	for _, commit := range commits {
+		byHash[commit.Hash] = commit
+	}
+
+	ordered := make([]domain.Commit, 0, len(commits))
+	placed := make(map[string]bool, len(commits))
+
+	var place func(commit domain.Commit)
+
+	place = func(commit domain.Commit) {
+		if placed[commit.Hash] {
+			return
+		}
+
+		placed[commit.Hash] = true
+
+		for _, parent := range commit.ParentHashes {
+			if parentCommit, found := byHash[parent]; found {
+				place(parentCommit)
+			}
+		}
+
+		ordered = append(ordered, commit)
+	}
+
+	for _, commit := range commits {
+		place(commit)
+	}
+
+	return ordered
+}
+
+// ValidateNewCommit validates a commit the daemon found. Unlike ValidateSingleCommit it
+// leaves out the repository-level pass, which would repeat for every commit.
+func ValidateNewCommit(commit domain.Commit, commitRules []domain.CommitRule, repoRules []domain.RepositoryRule,
+	repo domain.Repository, cfg config.Config) domain.Report {
+	var result domain.ValidationResult
+	if commit.IsMergeCommit {
+		result = domain.ValidateMergeCommit(commit, commitRules, repo, cfg)
+	} else {
+		result = domain.ValidateCommit(commit, commitRules, repoRules, repo, cfg)
+	}
+
+	report := domain.BuildReport([]domain.ValidationResult{result}, nil, commitRules, repoRules, reportOptions(cfg))
+	report.Repository = domain.RepositoryReport{}
+
+	return report
+}
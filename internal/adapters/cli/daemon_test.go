@@ -0,0 +1,172 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package cli
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/itiquette/gommitlint/internal/adapters/git"
+)
+
+// runGit runs a git command in dir with a fixed identity.
+func runGit(t *testing.T, dir string, args ...string) {
+	t.Helper()
+
+	cmd := exec.Command("git", append([]string{"-c", "user.name=Test", "-c", "user.email=test@example.com",
+		"-c", "commit.gpgsign=false"}, args...)...)
+	cmd.Dir = dir
+
+	output, err := cmd.CombinedOutput()
+	require.NoError(t, err, string(output))
+}
+
+// initDaemonRepo creates a repository with one commit on master.
+func initDaemonRepo(t *testing.T) string {
+	t.Helper()
+
+	dir := t.TempDir()
+	runGit(t, dir, "init", "--quiet", "--initial-branch=master")
+	runGit(t, dir, "commit", "--allow-empty", "--quiet", "-m", "Initial commit")
+
+	return dir
+}
+
+func TestRefTracker_NewCommits(t *testing.T) {
+	dir := initDaemonRepo(t)
+
+	repo, err := git.NewRepository(dir)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+
+	tracker, err := NewRefTracker(ctx, repo)
+	require.NoError(t, err)
+
+	subjects := func() []string {
+		t.Helper()
+
+		added, err := tracker.NewCommits(ctx)
+		require.NoError(t, err)
+
+		var result []string
+		for _, branchCommit := range added {
+			result = append(result, branchCommit.Branch+": "+branchCommit.Commit.Subject)
+		}
+
+		return result
+	}
+
+	require.Empty(t, subjects())
+
+	runGit(t, dir, "commit", "--allow-empty", "--quiet", "-m", "Add parser")
+	runGit(t, dir, "commit", "--allow-empty", "--quiet", "-m", "Add lexer")
+	require.Equal(t, []string{"master: Add parser", "master: Add lexer"}, subjects())
+
+	runGit(t, dir, "commit", "--amend", "--allow-empty", "--quiet", "-m", "Add the lexer")
+	require.Equal(t, []string{"master: Add the lexer"}, subjects())
+
+	// A new branch adds only the commits no branch had before
+	runGit(t, dir, "checkout", "--quiet", "-b", "feature/docs")
+	require.Empty(t, subjects())
+
+	runGit(t, dir, "commit", "--allow-empty", "--quiet", "-m", "Document parser")
+	require.Equal(t, []string{"feature/docs: Document parser"}, subjects())
+
+	// Commits are reported once, also when another branch gains them
+	runGit(t, dir, "checkout", "--quiet", "master")
+	runGit(t, dir, "merge", "--quiet", "--ff-only", "feature/docs")
+	require.Empty(t, subjects())
+
+	runGit(t, dir, "reset", "--quiet", "--hard", "HEAD~1")
+	require.Empty(t, subjects())
+}
+
+func TestWatchRefs(t *testing.T) {
+	dir := initDaemonRepo(t)
+
+	gitDir, err := ResolveGitDir(dir)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	calls := 0
+
+	err = WatchRefs(ctx, gitDir, 10*time.Millisecond, func() error {
+		calls++
+
+		switch calls {
+		case 1:
+			runGit(t, dir, "commit", "--allow-empty", "--quiet", "-m", "Add parser")
+		case 2:
+			// Branches in directories are watched once the directory exists
+			runGit(t, dir, "branch", "feature/parser")
+		case 3:
+			runGit(t, dir, "checkout", "--quiet", "feature/parser")
+			runGit(t, dir, "commit", "--allow-empty", "--quiet", "-m", "Add lexer")
+		default:
+			cancel()
+		}
+
+		return nil
+	})
+	require.NoError(t, err)
+	require.ErrorIs(t, ctx.Err(), context.Canceled, "watch should stop when cancelled, not time out")
+	require.Equal(t, 4, calls)
+}
+
+func TestResolveGitDir_Worktree(t *testing.T) {
+	dir := initDaemonRepo(t)
+	worktree := filepath.Join(t.TempDir(), "worktree")
+
+	runGit(t, dir, "worktree", "add", "--quiet", worktree)
+
+	gitDir, err := ResolveGitDir(worktree)
+	require.NoError(t, err)
+
+	expected, err := filepath.EvalSymlinks(filepath.Join(dir, ".git"))
+	require.NoError(t, err)
+
+	actual, err := filepath.EvalSymlinks(gitDir)
+	require.NoError(t, err)
+	require.Equal(t, expected, actual)
+}
+
+func TestNotifier_Exec(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("the notification command uses a POSIX shell")
+	}
+
+	outputPath := filepath.Join(t.TempDir(), "notification")
+
+	notifier, err := NewNotifier(`printf '%s %s %s\n' "$GOMMITLINT_BRANCH" "$GOMMITLINT_COMMIT" "$GOMMITLINT_FAILED_RULES" > "`+
+		outputPath+`"; cat >> "`+outputPath+`"`, false)
+	require.NoError(t, err)
+
+	err = notifier.Notify(context.Background(), Violation{
+		Branch:  "main",
+		Hash:    "abc1234",
+		Subject: "added parser",
+		Rules:   []string{"Subject", "ConventionalCommit"},
+		Report:  "✗ Subject: ...\n",
+	})
+	require.NoError(t, err)
+
+	content, err := os.ReadFile(outputPath)
+	require.NoError(t, err)
+	require.Equal(t, "main abc1234 Subject,ConventionalCommit\n✗ Subject: ...\n", string(content))
+
+	notifier, err = NewNotifier("exit 3", false)
+	require.NoError(t, err)
+	require.ErrorContains(t, notifier.Notify(context.Background(), Violation{}), "notification command failed")
+}
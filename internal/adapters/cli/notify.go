@@ -0,0 +1,143 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package cli
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+	"time"
+
+	"github.com/itiquette/gommitlint/internal/domain"
+)
+
+// notifyTimeout bounds a notification command, so a hanging one does not stop the daemon.
+const notifyTimeout = 30 * time.Second
+
+// Violation describes a commit that failed validation, for notifications.
+type Violation struct {
+	Branch  string
+	Hash    string
+	Subject string
+	Rules   []string // Names of the failed rules
+	Report  string   // Rendered validation report
+}
+
+// Notifier tells the developer about violations found by the daemon.
+type Notifier struct {
+	command string
+	desktop bool
+}
+
+// NewNotifier creates a notifier running command through the shell, when it is not
+// empty, and showing a desktop notification, when desktop is set.
+func NewNotifier(command string, desktop bool) (Notifier, error) {
+	if desktop {
+		if _, err := desktopCommand("", ""); err != nil {
+			return Notifier{}, err
+		}
+	}
+
+	return Notifier{command: command, desktop: desktop}, nil
+}
+
+// Notify runs the notification command with the violation in its environment and the
+// report on stdin, and shows the desktop notification.
+func (n Notifier) Notify(ctx context.Context, violation Violation) error {
+	var failures []error
+
+	if n.command != "" {
+		env := append(os.Environ(),
+			"GOMMITLINT_BRANCH="+violation.Branch,
+			"GOMMITLINT_COMMIT="+violation.Hash,
+			"GOMMITLINT_SUBJECT="+violation.Subject,
+			"GOMMITLINT_FAILED_RULES="+strings.Join(violation.Rules, ","),
+		)
+
+		if err := runNotifyCommand(ctx, shellCommand(n.command), env, violation.Report); err != nil {
+			failures = append(failures, fmt.Errorf("notification command failed: %w", err))
+		}
+	}
+
+	if n.desktop {
+		title := fmt.Sprintf("gommitlint: %s on %s", domain.ShortHash(violation.Hash), violation.Branch)
+		body := fmt.Sprintf("%s\nFailed: %s", violation.Subject, strings.Join(violation.Rules, ", "))
+
+		args, err := desktopCommand(title, body)
+		if err == nil {
+			err = runNotifyCommand(ctx, args, nil, "")
+		}
+
+		if err != nil {
+			failures = append(failures, fmt.Errorf("desktop notification failed: %w", err))
+		}
+	}
+
+	return errors.Join(failures...)
+}
+
+// shellCommand returns the arguments running command through the system shell.
+func shellCommand(command string) []string {
+	if runtime.GOOS == "windows" {
+		return []string{"cmd", "/C", command}
+	}
+
+	return []string{"sh", "-c", command}
+}
+
+// desktopCommand returns the arguments of the platform command showing a desktop
+// notification with title and body.
+func desktopCommand(title, body string) ([]string, error) {
+	switch runtime.GOOS {
+	case "linux", "freebsd", "openbsd", "netbsd":
+		if _, err := exec.LookPath("notify-send"); err != nil {
+			return nil, errors.New("desktop notifications need notify-send (libnotify)")
+		}
+
+		return []string{"notify-send", "--app-name=gommitlint", title, body}, nil
+	case "darwin":
+		script := fmt.Sprintf("display notification %s with title %s", appleScriptString(body), appleScriptString(title))
+
+		return []string{"osascript", "-e", script}, nil
+	default:
+		return nil, fmt.Errorf("desktop notifications are not supported on %s; use --exec instead", runtime.GOOS)
+	}
+}
+
+// appleScriptString quotes text as an AppleScript string literal.
+func appleScriptString(text string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(text) + `"`
+}
+
+// runNotifyCommand runs args with env, or the daemon's environment when env is nil,
+// writing input to its stdin.
+func runNotifyCommand(ctx context.Context, args []string, env []string, input string) error {
+	ctx, cancel := context.WithTimeout(ctx, notifyTimeout)
+	defer cancel()
+
+	cmd := exec.CommandContext(ctx, args[0], args[1:]...)
+	cmd.Env = env
+	cmd.Stdin = strings.NewReader(input)
+	cmd.WaitDelay = time.Second // Do not wait on children that keep the output pipes open
+
+	var stderr bytes.Buffer
+
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		if message := strings.TrimSpace(stderr.String()); message != "" {
+			return fmt.Errorf("%w: %s", err, message)
+		}
+
+		return err
+	}
+
+	return nil
+}
@@ -14,18 +14,24 @@ import (
 
 	"github.com/itiquette/gommitlint/internal/adapters/output"
 	"github.com/itiquette/gommitlint/internal/domain"
+	"github.com/itiquette/gommitlint/internal/domain/rules"
 )
 
 // OutputOptions represents how validation results should be formatted and displayed.
 // This is a focused value type with single responsibility for output concerns.
 type OutputOptions struct {
-	Format       string    // "text", "json", "github", "gitlab"
-	Verbose      bool      // Show detailed validation results
-	VerboseLevel int       // Verbose level (0=quiet, 1=verbose, 2=extra verbose)
-	ShowHelp     bool      // Show help text and error codes
-	RuleHelp     string    // Show detailed help for a specific rule
-	Color        string    // When to colorize: "auto", "always", "never"
-	Writer       io.Writer // Where to write output
+	Format       string            // "text", "json", "github", "gitlab", "html", "csv", "tsv", "patchwork", "gerrit"
+	Verbose      bool              // Show detailed validation results
+	VerboseLevel int               // Verbose level (0=quiet, 1=verbose, 2=extra verbose)
+	ShowHelp     bool              // Show help text and error codes
+	RuleHelp     string            // Show detailed help for a specific rule
+	Color        string            // When to colorize: "auto", "always", "never"
+	Theme        string            // Color theme of text output: "default", "high-contrast", "monochrome"
+	ASCII        bool              // ASCII symbols instead of unicode ones in text output
+	Texts        map[string]string // Translated text output, keyed by text identifier
+	Summary      bool              // Print only aggregate counts
+	LinkTemplate string            // URL of a commit with {hash}, linking commit hashes in text and HTML output
+	Writer       io.Writer         // Where to write output
 }
 
 // NewOutputOptions creates OutputOptions with sensible defaults.
@@ -75,6 +81,27 @@ func (o OutputOptions) WithShowHelp(showHelp bool) OutputOptions {
 	return o
 }
 
+// WithTexts returns a new OutputOptions with translated text output.
+func (o OutputOptions) WithTexts(texts map[string]string) OutputOptions {
+	o.Texts = texts
+
+	return o
+}
+
+// WithLinkTemplate returns a new OutputOptions linking commit hashes with linkTemplate.
+func (o OutputOptions) WithLinkTemplate(linkTemplate string) OutputOptions {
+	o.LinkTemplate = linkTemplate
+
+	return o
+}
+
+// WithSummary returns a new OutputOptions printing only aggregate counts.
+func (o OutputOptions) WithSummary(summary bool) OutputOptions {
+	o.Summary = summary
+
+	return o
+}
+
 // WithRuleHelp returns a new OutputOptions with rule help.
 func (o OutputOptions) WithRuleHelp(ruleHelp string) OutputOptions {
 	o.RuleHelp = ruleHelp
@@ -88,31 +115,12 @@ func (o OutputOptions) ValidateRuleHelp() error {
 		return nil // Empty is valid
 	}
 
-	validRules := getValidRuleNames()
-	ruleName := strings.TrimSpace(o.RuleHelp)
-
-	// Check exact match first
-	for _, valid := range validRules {
-		if valid == ruleName {
-			return nil
-		}
-	}
-
-	// Check case-insensitive match
-	ruleNameLower := strings.ToLower(ruleName)
-	for _, valid := range validRules {
-		if strings.ToLower(valid) == ruleNameLower {
-			return nil
-		}
-	}
-
-	// Show only factory keys in error message (user-friendly)
-	userFriendlyRules := []string{
-		"subject", "conventional", "commitbody", "jirareference",
-		"signoff", "signature", "identity", "spell", "branchahead",
+	if _, found := rules.FindRule(o.RuleHelp); found {
+		return nil
 	}
 
-	return fmt.Errorf("unknown rule '%s'. Valid rules: %s", o.RuleHelp, strings.Join(userFriendlyRules, ", "))
+	// Show only configuration names in the error message (user-friendly)
+	return fmt.Errorf("unknown rule '%s'. Valid rules: %s", o.RuleHelp, strings.Join(rules.AvailableRuleNames(), ", "))
 }
 
 // GetNormalizedRuleHelp returns the rule name normalized for comparison with actual rule names.
@@ -121,69 +129,17 @@ func (o OutputOptions) GetNormalizedRuleHelp() string {
 		return ""
 	}
 
-	// Map factory keys to actual rule names
-	factoryToActual := map[string]string{
-		"subject":       "Subject",
-		"conventional":  "ConventionalCommit",
-		"commitbody":    "CommitBody",
-		"jirareference": "JiraReference",
-		"signoff":       "SignOff",
-		"signature":     "Signature",
-		"identity":      "SignedIdentity",
-		"spell":         "Spell",
-		"branchahead":   "BranchAhead",
-	}
-
 	ruleName := strings.TrimSpace(o.RuleHelp)
-	ruleNameLower := strings.ToLower(ruleName)
 
-	// If it's a factory key, return the actual rule name
-	if actual, exists := factoryToActual[ruleNameLower]; exists {
-		return actual
-	}
-
-	// If it's already an actual rule name, return as-is
-	actualRules := []string{
-		"Subject", "ConventionalCommit", "CommitBody", "JiraReference",
-		"SignOff", "Signature", "SignedIdentity", "Spell", "BranchAhead",
-	}
-
-	for _, actual := range actualRules {
-		if strings.EqualFold(actual, ruleName) {
-			return actual
-		}
+	// Configuration names and report names both map to the name in reports
+	if entry, found := rules.FindRule(ruleName); found {
+		return entry.Name
 	}
 
 	// Return original if no match found
 	return ruleName
 }
 
-// getValidRuleNames returns all valid rule names (both factory keys and actual rule names).
-func getValidRuleNames() []string {
-	return []string{
-		// Factory keys (for convenience)
-		"subject",
-		"conventional",
-		"commitbody",
-		"jirareference",
-		"signoff",
-		"signature",
-		"identity",
-		"spell",
-		"branchahead",
-		// Actual rule Name() return values
-		"Subject",
-		"ConventionalCommit",
-		"CommitBody",
-		"JiraReference",
-		"SignOff",
-		"Signature",
-		"SignedIdentity",
-		"Spell",
-		"BranchAhead",
-	}
-}
-
 // WithColor returns a new OutputOptions with the specified color setting.
 func (o OutputOptions) WithColor(color string) OutputOptions {
 	o.Color = color
@@ -191,6 +147,20 @@ func (o OutputOptions) WithColor(color string) OutputOptions {
 	return o
 }
 
+// WithTheme returns a new OutputOptions with the specified color theme.
+func (o OutputOptions) WithTheme(theme string) OutputOptions {
+	o.Theme = theme
+
+	return o
+}
+
+// WithASCII returns a new OutputOptions with ASCII symbols enabled/disabled.
+func (o OutputOptions) WithASCII(ascii bool) OutputOptions {
+	o.ASCII = ascii
+
+	return o
+}
+
 // ShouldShowHelp returns true if help should be shown for all rules.
 func (o OutputOptions) ShouldShowHelp() bool {
 	return o.ShowHelp
@@ -248,6 +218,14 @@ func isTerminal(w io.Writer) bool {
 
 // FormatReport formats a domain report using the specified options (pure function).
 func (o OutputOptions) FormatReport(report domain.Report) string {
+	if o.Summary {
+		if o.Format == "json" {
+			return output.SummaryJSON(report)
+		}
+
+		return output.SummaryText(report, output.TextOptions{Texts: o.Texts})
+	}
+
 	switch o.Format {
 	case "json":
 		return output.JSON(report)
@@ -255,19 +233,46 @@ func (o OutputOptions) FormatReport(report domain.Report) string {
 		return output.GitHub(report)
 	case "gitlab":
 		return output.GitLab(report)
+	case "html":
+		return output.HTMLWithOptions(report, output.HTMLOptions{LinkTemplate: o.LinkTemplate})
+	case "csv":
+		return output.CSV(report)
+	case "tsv":
+		return output.TSV(report)
+	case "patchwork":
+		return output.Patchwork(report)
+	case "gerrit":
+		return output.Gerrit(report)
 	case "text":
 		fallthrough
 	default:
-		textOptions := output.TextOptions{
-			Verbose:      o.Verbose,
-			VerboseLevel: o.VerboseLevel,
-			ShowHelp:     o.ShouldShowHelp(),
-			ShowRuleHelp: o.ShowRuleHelp(),
-			RuleHelpName: o.GetNormalizedRuleHelp(),
-			UseColor:     o.ShouldUseColor(),
-		}
+		return output.Text(report, o.textOptions())
+	}
+}
+
+// CommitStream returns a stream writing reports to the configured writer commit by
+// commit, and false for the formats, and --summary, that only write whole reports.
+func (o OutputOptions) CommitStream() (output.CommitStream, bool) {
+	if o.Summary {
+		return nil, false
+	}
+
+	return output.NewCommitStream(o.Format, o.Writer, o.textOptions())
+}
 
-		return output.Text(report, textOptions)
+// textOptions returns the options of the text format.
+func (o OutputOptions) textOptions() output.TextOptions {
+	return output.TextOptions{
+		Verbose:      o.Verbose,
+		VerboseLevel: o.VerboseLevel,
+		ShowHelp:     o.ShouldShowHelp(),
+		ShowRuleHelp: o.ShowRuleHelp(),
+		RuleHelpName: o.GetNormalizedRuleHelp(),
+		UseColor:     o.ShouldUseColor(),
+		Theme:        o.Theme,
+		ASCII:        o.ASCII,
+		Texts:        o.Texts,
+		LinkTemplate: o.LinkTemplate,
 	}
 }
 
@@ -184,6 +184,12 @@ func TestOutputOptions_ValidateRuleHelp(t *testing.T) {
 			expectError: false,
 			description: "should handle case insensitive matching",
 		},
+		{
+			name:        "repository rule",
+			ruleHelp:    "branchticket",
+			expectError: false,
+			description: "should accept every registered rule",
+		},
 		{
 			name:        "invalid rule name",
 			ruleHelp:    "invalidrule",
@@ -252,6 +258,18 @@ func TestOutputOptions_GetNormalizedRuleHelp(t *testing.T) {
 			expectedRule: "Subject",
 			description:  "should handle case insensitive actual rule names",
 		},
+		{
+			name:         "factory key - identity",
+			ruleHelp:     "identity",
+			expectedRule: "Identity",
+			description:  "should normalize identity to the name the rule reports",
+		},
+		{
+			name:         "repository rule",
+			ruleHelp:     "linearhistory",
+			expectedRule: "LinearHistory",
+			description:  "should normalize every registered rule",
+		},
 		{
 			name:         "unknown rule",
 			ruleHelp:     "unknown",
@@ -585,6 +603,12 @@ func TestOutputOptions_FormatReport(t *testing.T) {
 			expectedType: "gitlab",
 			description:  "should format for GitLab",
 		},
+		{
+			name:         "html format",
+			format:       "html",
+			expectedType: "html",
+			description:  "should format as an HTML page",
+		},
 		{
 			name:         "text format",
 			format:       "text",
@@ -639,6 +663,8 @@ func TestOutputOptions_FormatReport(t *testing.T) {
 			switch testCase.expectedType {
 			case "json":
 				require.True(t, strings.Contains(result, "{") && strings.Contains(result, "}"), "should contain JSON structure")
+			case "html":
+				require.True(t, strings.HasPrefix(result, "<!DOCTYPE html>"), "should be an HTML page")
 			case "text":
 				// Text format should contain some recognizable content
 				require.NotEmpty(t, result, "should have text content")
@@ -647,6 +673,20 @@ func TestOutputOptions_FormatReport(t *testing.T) {
 	}
 }
 
+func TestOutputOptions_FormatReportSummary(t *testing.T) {
+	report := domain.Report{
+		Summary: domain.ReportSummary{TotalCommits: 3, PassedCommits: 2, FailedCommits: 1, FailedRules: map[string]int{"Subject": 1}},
+		Commits: []domain.CommitReport{{Commit: domain.Commit{Hash: "abc123", Subject: "Test commit."}}},
+	}
+
+	text := NewOutputOptions(&bytes.Buffer{}).WithSummary(true).FormatReport(report)
+	require.Equal(t, "3 commits checked: 2 passed, 1 failed; failures: Subject 1\n", text)
+
+	jsonSummary := NewOutputOptions(&bytes.Buffer{}).WithFormat("json").WithSummary(true).FormatReport(report)
+	require.Contains(t, jsonSummary, `"failedCommits": 1`)
+	require.NotContains(t, jsonSummary, "commitResults")
+}
+
 func TestOutputOptions_WriteReport(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -730,22 +770,3 @@ func TestOutputOptions_ToReportOptions(t *testing.T) {
 		})
 	}
 }
-
-func TestGetValidRuleNames(t *testing.T) {
-	t.Run("returns all valid rule names", func(t *testing.T) {
-		rules := getValidRuleNames()
-
-		// Should contain factory keys
-		require.Contains(t, rules, "subject")
-		require.Contains(t, rules, "conventional")
-		require.Contains(t, rules, "commitbody")
-
-		// Should contain actual rule names
-		require.Contains(t, rules, "Subject")
-		require.Contains(t, rules, "ConventionalCommit")
-		require.Contains(t, rules, "CommitBody")
-
-		// Should have reasonable number of rules
-		require.Greater(t, len(rules), 10, "should have multiple rules")
-	})
-}
@@ -0,0 +1,99 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package cli
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/itiquette/gommitlint/internal/domain"
+	"github.com/itiquette/gommitlint/internal/domain/config"
+)
+
+// zeroHash is the object name git uses for a missing side of a push update.
+const zeroHash = "0000000000000000000000000000000000000000"
+
+// ParsePushUpdates parses the ref update lines git passes to the pre-push hook on stdin:
+// <local ref> <local hash> <remote ref> <remote hash>. All-zero hashes become empty.
+func ParsePushUpdates(input io.Reader) ([]domain.PushUpdate, error) {
+	var updates []domain.PushUpdate
+
+	scanner := bufio.NewScanner(input)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+
+		fields := strings.Fields(line)
+		if len(fields) != 4 {
+			return nil, fmt.Errorf("invalid pre-push input line: %q", line)
+		}
+
+		for _, hash := range []string{fields[1], fields[3]} {
+			if err := validateGitReference(hash); err != nil {
+				return nil, fmt.Errorf("invalid pre-push input line: %w", err)
+			}
+		}
+
+		updates = append(updates, domain.PushUpdate{
+			LocalRef:   fields[0],
+			LocalHash:  strings.TrimPrefix(fields[1], zeroHash),
+			RemoteRef:  fields[2],
+			RemoteHash: strings.TrimPrefix(fields[3], zeroHash),
+		})
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read pre-push input: %w", err)
+	}
+
+	return updates, nil
+}
+
+// ValidatePush validates the commits each push update in input sends to remote.
+// Commits pushed to several refs are validated once.
+func ValidatePush(ctx context.Context, remote string, input io.Reader, commitRules []domain.CommitRule,
+	repoRules []domain.RepositoryRule, repo domain.Repository, cfg config.Config, logger domain.Logger) (domain.Report, error) {
+	resolver, ok := repo.(domain.PushResolver)
+	if !ok {
+		return domain.Report{}, errors.New("repository does not support push validation")
+	}
+
+	updates, err := ParsePushUpdates(input)
+	if err != nil {
+		return domain.Report{}, err
+	}
+
+	var commits []domain.Commit
+
+	seen := make(map[string]bool)
+
+	for _, update := range updates {
+		if err := ctx.Err(); err != nil {
+			return domain.Report{}, err
+		}
+
+		logger.Debug("Validating push update", "remote", remote, "local_ref", update.LocalRef, "remote_ref", update.RemoteRef)
+
+		outgoing, err := resolver.GetOutgoingCommits(ctx, remote, update)
+		if err != nil {
+			return domain.Report{}, fmt.Errorf("failed to get commits pushed to %s: %w", update.RemoteRef, err)
+		}
+
+		for _, commit := range outgoing {
+			if !seen[commit.Hash] {
+				seen[commit.Hash] = true
+				commits = append(commits, commit)
+			}
+		}
+	}
+
+	return ValidateMultipleCommits(commits, commitRules, repoRules, repo, cfg)
+}
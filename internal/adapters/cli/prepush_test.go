@@ -0,0 +1,116 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package cli
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/itiquette/gommitlint/internal/domain"
+	"github.com/itiquette/gommitlint/internal/domain/config"
+	"github.com/itiquette/gommitlint/internal/domain/rules"
+)
+
+const (
+	localHash  = "1111111111111111111111111111111111111111"
+	remoteHash = "2222222222222222222222222222222222222222"
+)
+
+func TestParsePushUpdates(t *testing.T) {
+	tests := []struct {
+		name          string
+		input         string
+		expected      []domain.PushUpdate
+		expectedError string
+	}{
+		{
+			name:  "update of existing ref",
+			input: "refs/heads/main " + localHash + " refs/heads/main " + remoteHash + "\n",
+			expected: []domain.PushUpdate{
+				{LocalRef: "refs/heads/main", LocalHash: localHash, RemoteRef: "refs/heads/main", RemoteHash: remoteHash},
+			},
+		},
+		{
+			name:  "new ref and deletion",
+			input: "refs/heads/feature " + localHash + " refs/heads/feature " + zeroHash + "\n(delete) " + zeroHash + " refs/heads/old " + remoteHash + "\n",
+			expected: []domain.PushUpdate{
+				{LocalRef: "refs/heads/feature", LocalHash: localHash, RemoteRef: "refs/heads/feature"},
+				{LocalRef: "(delete)", RemoteRef: "refs/heads/old", RemoteHash: remoteHash},
+			},
+		},
+		{
+			name:  "empty input",
+			input: "\n",
+		},
+		{
+			name:          "missing fields",
+			input:         "refs/heads/main " + localHash + "\n",
+			expectedError: "invalid pre-push input line",
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			updates, err := ParsePushUpdates(strings.NewReader(testCase.input))
+			if testCase.expectedError != "" {
+				require.ErrorContains(t, err, testCase.expectedError)
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, testCase.expected, updates)
+
+			for _, update := range updates {
+				require.Equal(t, update.LocalRef == "(delete)", update.IsDeletion())
+			}
+		})
+	}
+}
+
+func TestValidatePush(t *testing.T) {
+	good := domain.Commit{Hash: "a1", Subject: "Add parser", Message: "Add parser"}
+	bad := domain.Commit{Hash: "b2", Subject: "add parser.", Message: "add parser."}
+
+	resolver := &mockPushResolver{
+		mockRepository: &mockRepository{},
+		commits: map[string][]domain.Commit{
+			"refs/heads/main":    {good, bad},
+			"refs/heads/feature": {good},
+		},
+	}
+
+	cfg := config.Config{Rules: config.RulesConfig{Enabled: []string{"Subject"}}}
+	commitRules := rules.CreateCommitRules(cfg, RuleServices())
+
+	input := "refs/heads/main " + localHash + " refs/heads/main " + remoteHash + "\n" +
+		"refs/heads/feature " + localHash + " refs/heads/feature " + zeroHash + "\n"
+
+	report, err := ValidatePush(context.Background(), "origin", strings.NewReader(input), commitRules, nil, resolver, cfg, &mockLogger{})
+	require.NoError(t, err)
+	require.Equal(t, "origin", resolver.remote)
+	require.Len(t, report.Commits, 2, "commits pushed to several refs are validated once")
+	require.False(t, report.Summary.AllPassed)
+
+	// A repository that cannot resolve pushes is rejected
+	_, err = ValidatePush(context.Background(), "origin", strings.NewReader(input), commitRules, nil, &mockRepository{}, cfg, &mockLogger{})
+	require.Error(t, err)
+}
+
+type mockPushResolver struct {
+	*mockRepository
+
+	commits map[string][]domain.Commit
+	remote  string
+}
+
+func (m *mockPushResolver) GetOutgoingCommits(_ context.Context, remote string, update domain.PushUpdate) ([]domain.Commit, error) {
+	m.remote = remote
+
+	return m.commits[update.RemoteRef], nil
+}
@@ -0,0 +1,97 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package cli
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/itiquette/gommitlint/internal/domain"
+	"github.com/itiquette/gommitlint/internal/domain/config"
+)
+
+// ParseReceiveUpdates parses the ref update lines git passes to the pre-receive hook on
+// stdin: <old hash> <new hash> <ref>. All-zero hashes become empty, so that created refs
+// are new refs and deleted refs deletions, as in a push.
+func ParseReceiveUpdates(input io.Reader) ([]domain.PushUpdate, error) {
+	var updates []domain.PushUpdate
+
+	scanner := bufio.NewScanner(input)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+
+		fields := strings.Fields(line)
+		if len(fields) != 3 {
+			return nil, fmt.Errorf("invalid pre-receive input line: %q", line)
+		}
+
+		for _, hash := range fields[:2] {
+			if err := validateGitReference(hash); err != nil {
+				return nil, fmt.Errorf("invalid pre-receive input line: %w", err)
+			}
+		}
+
+		updates = append(updates, domain.PushUpdate{
+			LocalRef:   fields[2],
+			LocalHash:  strings.TrimPrefix(fields[1], zeroHash),
+			RemoteRef:  fields[2],
+			RemoteHash: strings.TrimPrefix(fields[0], zeroHash),
+		})
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read pre-receive input: %w", err)
+	}
+
+	return updates, nil
+}
+
+// ValidateReceive validates the commits each ref update in input brings into the
+// repository. Commits received for several refs are validated once.
+func ValidateReceive(ctx context.Context, input io.Reader, commitRules []domain.CommitRule,
+	repoRules []domain.RepositoryRule, repo domain.Repository, cfg config.Config, logger domain.Logger) (domain.Report, error) {
+	resolver, ok := repo.(domain.ReceiveResolver)
+	if !ok {
+		return domain.Report{}, errors.New("repository does not support pre-receive validation")
+	}
+
+	updates, err := ParseReceiveUpdates(input)
+	if err != nil {
+		return domain.Report{}, err
+	}
+
+	var commits []domain.Commit
+
+	seen := make(map[string]bool)
+
+	for _, update := range updates {
+		if err := ctx.Err(); err != nil {
+			return domain.Report{}, err
+		}
+
+		logger.Debug("Validating received ref update", "ref", update.RemoteRef)
+
+		received, err := resolver.GetReceivedCommits(ctx, update)
+		if err != nil {
+			return domain.Report{}, fmt.Errorf("failed to get commits pushed to %s: %w", update.RemoteRef, err)
+		}
+
+		for _, commit := range received {
+			if !seen[commit.Hash] {
+				seen[commit.Hash] = true
+				commits = append(commits, commit)
+			}
+		}
+	}
+
+	return ValidateMultipleCommits(commits, commitRules, repoRules, repo, cfg)
+}
@@ -0,0 +1,98 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package cli
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/itiquette/gommitlint/internal/domain"
+	"github.com/itiquette/gommitlint/internal/domain/config"
+	"github.com/itiquette/gommitlint/internal/domain/rules"
+)
+
+func TestParseReceiveUpdates(t *testing.T) {
+	tests := []struct {
+		name          string
+		input         string
+		expected      []domain.PushUpdate
+		expectedError string
+	}{
+		{
+			name:  "update of existing ref",
+			input: remoteHash + " " + localHash + " refs/heads/main\n",
+			expected: []domain.PushUpdate{
+				{LocalRef: "refs/heads/main", LocalHash: localHash, RemoteRef: "refs/heads/main", RemoteHash: remoteHash},
+			},
+		},
+		{
+			name:  "new ref and deletion",
+			input: zeroHash + " " + localHash + " refs/heads/feature\n" + remoteHash + " " + zeroHash + " refs/heads/old\n",
+			expected: []domain.PushUpdate{
+				{LocalRef: "refs/heads/feature", LocalHash: localHash, RemoteRef: "refs/heads/feature"},
+				{LocalRef: "refs/heads/old", RemoteRef: "refs/heads/old", RemoteHash: remoteHash},
+			},
+		},
+		{
+			name:          "pre-push input",
+			input:         "refs/heads/main " + localHash + " refs/heads/main " + remoteHash + "\n",
+			expectedError: "invalid pre-receive input line",
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			updates, err := ParseReceiveUpdates(strings.NewReader(testCase.input))
+			if testCase.expectedError != "" {
+				require.ErrorContains(t, err, testCase.expectedError)
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, testCase.expected, updates)
+		})
+	}
+}
+
+func TestValidateReceive(t *testing.T) {
+	good := domain.Commit{Hash: "a1", Subject: "Add parser", Message: "Add parser"}
+	bad := domain.Commit{Hash: "b2", Subject: "add parser.", Message: "add parser."}
+
+	resolver := &mockReceiveResolver{
+		mockRepository: &mockRepository{},
+		commits: map[string][]domain.Commit{
+			"refs/heads/main":    {good, bad},
+			"refs/heads/feature": {good},
+		},
+	}
+
+	cfg := config.Config{Rules: config.RulesConfig{Enabled: []string{"Subject"}}}
+	commitRules := rules.CreateCommitRules(cfg, RuleServices())
+
+	input := remoteHash + " " + localHash + " refs/heads/main\n" + zeroHash + " " + localHash + " refs/heads/feature\n"
+
+	report, err := ValidateReceive(context.Background(), strings.NewReader(input), commitRules, nil, resolver, cfg, &mockLogger{})
+	require.NoError(t, err)
+	require.Len(t, report.Commits, 2, "commits received for several refs are validated once")
+	require.False(t, report.Summary.AllPassed)
+
+	// A repository that cannot resolve received commits is rejected
+	_, err = ValidateReceive(context.Background(), strings.NewReader(input), commitRules, nil, &mockRepository{}, cfg, &mockLogger{})
+	require.Error(t, err)
+}
+
+type mockReceiveResolver struct {
+	*mockRepository
+
+	commits map[string][]domain.Commit
+}
+
+func (m *mockReceiveResolver) GetReceivedCommits(_ context.Context, update domain.PushUpdate) ([]domain.Commit, error) {
+	return m.commits[update.RemoteRef], nil
+}
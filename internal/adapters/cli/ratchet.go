@@ -0,0 +1,60 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package cli
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/itiquette/gommitlint/internal/domain"
+	"github.com/itiquette/gommitlint/internal/domain/config"
+)
+
+// DefaultRatchetWindow is how many commits of the history of the base branch --ratchet
+// validates to find the failures that already exist there.
+const DefaultRatchetWindow = 100
+
+// RatchetBaseline validates the last window commits of the history of base, following
+// first parents, and returns the classes of their failures. In histories shorter than
+// window, all commits but the root commit are validated.
+func RatchetBaseline(ctx context.Context, base string, window int, commitRules []domain.CommitRule,
+	repoRules []domain.RepositoryRule, repo domain.Repository, cfg config.Config, logger domain.Logger) (map[domain.FailureClass]bool, error) {
+	depth, err := historyDepth(ctx, repo, base, window)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the history of %s: %w", base, err)
+	}
+
+	target := ValidationTarget{Type: "commit", Source: base}
+	if depth > 0 {
+		target = ValidationTarget{Type: "range", Source: fmt.Sprintf("%s~%d", base, depth), Target: base, FirstParent: true}
+	}
+
+	logger.Debug("Validating the ratchet baseline", "base", base, "commits", depth)
+
+	report, err := validateTarget(ctx, target, commitRules, repoRules, repo, cfg, logger)
+	if err != nil {
+		return nil, fmt.Errorf("failed to validate the history of %s: %w", base, err)
+	}
+
+	return domain.FailureClasses(report), nil
+}
+
+// historyDepth returns how many first-parent ancestors of ref, up to window, can be
+// resolved, found by a binary search over ref~n.
+func historyDepth(ctx context.Context, repo domain.Repository, ref string, window int) (int, error) {
+	if _, err := repo.GetCommit(ctx, ref); err != nil {
+		return 0, err
+	}
+
+	// The smallest n whose ref~n does not resolve, less one
+	missing := sort.Search(window, func(n int) bool {
+		_, err := repo.GetCommit(ctx, fmt.Sprintf("%s~%d", ref, n+1))
+
+		return err != nil
+	})
+
+	return missing, nil
+}
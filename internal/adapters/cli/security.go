@@ -97,6 +97,33 @@ func (s *SecurityValidator) ValidateMessageFilePath(filePath string) (string, er
 	return absPath, nil
 }
 
+// ValidateWatchFilePath securely validates a message file path to watch.
+// Unlike ValidateMessageFilePath the file need not exist yet, as editors and git
+// create it when a commit message is written.
+func (s *SecurityValidator) ValidateWatchFilePath(filePath string) (string, error) {
+	if filePath == "" {
+		return "", errors.New("message file path cannot be empty")
+	}
+
+	// Validate against common injection patterns
+	if err := s.validatePathSecurity(filePath); err != nil {
+		return "", fmt.Errorf("invalid message file path: %w", err)
+	}
+
+	absPath, err := filepath.Abs(filepath.Clean(filePath))
+	if err != nil {
+		return "", fmt.Errorf("cannot resolve absolute path: %w", err)
+	}
+
+	// The containing directory must exist for the file to ever appear
+	info, err := os.Stat(filepath.Dir(absPath))
+	if err != nil || !info.IsDir() {
+		return "", fmt.Errorf("message file directory does not exist: %s", filepath.Dir(filePath))
+	}
+
+	return absPath, nil
+}
+
 // ValidateGitReference securely validates git references (commit hashes, branch names, etc).
 func (s *SecurityValidator) ValidateGitReference(ref string) error {
 	if ref == "" {
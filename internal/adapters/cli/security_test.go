@@ -203,6 +203,34 @@ func TestSecurityValidator_ValidateMessageFilePath(t *testing.T) {
 	}
 }
 
+func TestSecurityValidator_ValidateWatchFilePath(t *testing.T) {
+	validator := NewSecurityValidator()
+	tempDir := t.TempDir()
+
+	tests := []struct {
+		name        string
+		filePath    string
+		expectError bool
+	}{
+		{name: "empty path", filePath: "", expectError: true},
+		{name: "file not created yet", filePath: filepath.Join(tempDir, "COMMIT_EDITMSG"), expectError: false},
+		{name: "missing directory", filePath: filepath.Join(tempDir, "missing", "COMMIT_EDITMSG"), expectError: true},
+		{name: "path traversal attack", filePath: "../../../etc/passwd", expectError: true},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			_, err := validator.ValidateWatchFilePath(testCase.filePath)
+
+			if testCase.expectError {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
 func TestSecurityValidator_ValidateGitReference(t *testing.T) {
 	validator := NewSecurityValidator()
 
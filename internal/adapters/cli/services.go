@@ -0,0 +1,73 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package cli
+
+import (
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/itiquette/gommitlint/internal/adapters/cla"
+	"github.com/itiquette/gommitlint/internal/adapters/github"
+	"github.com/itiquette/gommitlint/internal/adapters/jira"
+	"github.com/itiquette/gommitlint/internal/adapters/signing"
+	"github.com/itiquette/gommitlint/internal/domain"
+	"github.com/itiquette/gommitlint/internal/domain/config"
+	"github.com/itiquette/gommitlint/internal/domain/rules"
+)
+
+// RuleServices returns the clients of the external services that rules consult, with
+// credentials and caches taken from the environment.
+func RuleServices() rules.Services {
+	return rules.Services{
+		JiraTracker: func(cfg config.JiraOnlineConfig) rules.TicketTracker {
+			return jira.NewClientFromConfig(cfg, os.LookupEnv)
+		},
+		IssueTracker: newIssueTracker,
+		CLARegistry:  newCLARegistry,
+		SignatureVerifier: func(cfg config.SignatureConfig) domain.SignatureVerifier {
+			return signing.NewVerificationAdapterFromConfig(cfg)
+		},
+	}
+}
+
+// newCLARegistry returns the registry of the configured contributors file and CLA
+// service, and nil when neither is configured.
+func newCLARegistry(cfg config.CLAConfig) rules.CLARegistry {
+	if registry := cla.NewRegistryFromConfig(cfg, os.LookupEnv); registry != nil {
+		return registry
+	}
+
+	return nil
+}
+
+// newIssueTracker returns a tracker for the configured repository, or for
+// GITHUB_REPOSITORY in GitHub Actions, and nil when neither is known.
+func newIssueTracker(cfg config.IssuesConfig) rules.TicketTracker {
+	repository := cfg.Repository
+	if repository == "" {
+		repository = os.Getenv("GITHUB_REPOSITORY")
+	}
+
+	timeout, err := time.ParseDuration(cfg.Timeout)
+	if err != nil || timeout <= 0 {
+		timeout = 5 * time.Second
+	}
+
+	client := github.NewClientFromEnv(os.LookupEnv).WithTimeout(timeout)
+
+	tracker, err := github.NewIssueTracker(client, repository)
+	if err != nil {
+		return nil
+	}
+
+	if ttl, err := time.ParseDuration(cfg.CacheTTL); err == nil && ttl > 0 {
+		if dir, err := os.UserCacheDir(); err == nil {
+			tracker = tracker.WithCache(filepath.Join(dir, "gommitlint", "github"), ttl)
+		}
+	}
+
+	return tracker
+}
@@ -0,0 +1,32 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package cli
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/itiquette/gommitlint/internal/domain/config"
+)
+
+func TestRuleServices_IssueTracker(t *testing.T) {
+	t.Setenv("GITHUB_REPOSITORY", "")
+
+	services := RuleServices()
+
+	require.Nil(t, services.IssueTracker(config.IssuesConfig{}), "no repository is known")
+	require.NotNil(t, services.IssueTracker(config.IssuesConfig{Repository: "octo/app", CacheTTL: "0s"}))
+
+	t.Setenv("GITHUB_REPOSITORY", "octo/app")
+	require.NotNil(t, services.IssueTracker(config.IssuesConfig{}), "GitHub Actions name the repository")
+}
+
+func TestRuleServices_CLARegistry(t *testing.T) {
+	services := RuleServices()
+
+	require.Nil(t, services.CLARegistry(config.CLAConfig{}), "nothing to look contributors up in")
+	require.NotNil(t, services.CLARegistry(config.CLAConfig{ContributorsFile: "CONTRIBUTORS"}))
+}
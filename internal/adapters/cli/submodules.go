@@ -0,0 +1,152 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/itiquette/gommitlint/internal/domain"
+	"github.com/itiquette/gommitlint/internal/domain/config"
+)
+
+// SubmoduleConfigFunc resolves the configuration to apply to the submodule at path.
+type SubmoduleConfigFunc func(path string) (config.Config, error)
+
+// SubmoduleRulesFunc creates the commit rules for a resolved submodule configuration.
+type SubmoduleRulesFunc func(cfg config.Config) []domain.CommitRule
+
+// SubmoduleRecorder records the submodule updates of commits, both those whose reports
+// are streamed instead of kept and those left in the report, for ValidateSubmodules.
+type SubmoduleRecorder struct {
+	resolver domain.SubmoduleResolver
+	logger   domain.Logger
+	updates  []domain.SubmoduleUpdate
+	err      error
+}
+
+// NewSubmoduleRecorder creates a recorder reading submodule updates from resolver.
+func NewSubmoduleRecorder(resolver domain.SubmoduleResolver, logger domain.Logger) *SubmoduleRecorder {
+	return &SubmoduleRecorder{resolver: resolver, logger: logger}
+}
+
+// Record records the submodule updates of the commit of commitReport. The updates of
+// commits whose trees are missing from a partial clone are logged and skipped; after
+// any other error, which ValidateSubmodules returns, nothing more is recorded.
+func (r *SubmoduleRecorder) Record(ctx context.Context, commitReport domain.CommitReport) {
+	if commitReport.Commit.Hash == "" || r.err != nil {
+		return
+	}
+
+	updates, err := r.resolver.GetSubmoduleUpdates(ctx, commitReport.Commit.Hash)
+	if errors.Is(err, domain.ErrMissingObjects) {
+		r.logger.Info("Skipping submodule updates missing from partial clone", "commit", commitReport.Commit.Hash, "error", err.Error())
+
+		return
+	}
+
+	if err != nil {
+		r.err = fmt.Errorf("failed to get submodule updates: %w", err)
+
+		return
+	}
+
+	r.updates = append(r.updates, updates...)
+}
+
+// ValidateSubmodules validates the submodule commits referenced by the submodule updates
+// recorded by recorder and in the commits of report, and merges the results into it.
+// Submodules that cannot be opened are logged and skipped.
+func ValidateSubmodules(ctx context.Context, report domain.Report, recorder *SubmoduleRecorder,
+	configFor SubmoduleConfigFunc, rulesFor SubmoduleRulesFunc) (domain.Report, error) {
+	for _, commitReport := range report.Commits {
+		recorder.Record(ctx, commitReport)
+	}
+
+	if recorder.err != nil {
+		return domain.Report{}, recorder.err
+	}
+
+	var submoduleReports []domain.Report
+
+	seen := make(map[string]bool)
+
+	for _, update := range recorder.updates {
+		recorder.logger.Debug("Validating submodule update", "path", update.Path, "old", update.OldHash, "new", update.NewHash)
+
+		commits, err := fetchSubmoduleCommits(ctx, recorder.resolver, update)
+		if err != nil {
+			recorder.logger.Info("Skipping submodule", "path", update.Path, "error", err.Error())
+
+			continue
+		}
+
+		commits = unseenCommits(commits, update.Path, seen)
+		if len(commits) == 0 {
+			continue
+		}
+
+		subCfg, err := configFor(update.Path)
+		if err != nil {
+			return domain.Report{}, fmt.Errorf("failed to load configuration for submodule %s: %w", update.Path, err)
+		}
+
+		commitRules := rulesFor(subCfg)
+		results := make([]domain.ValidationResult, 0, len(commits))
+		for _, commit := range commits {
+			results = append(results, validateUnlessMerge(commit, commitRules, nil, nil, subCfg))
+		}
+
+		subReport := domain.BuildReport(results, nil, commitRules, nil, reportOptions(subCfg))
+
+		submoduleReports = append(submoduleReports, subReport.WithSubmodule(update.Path))
+	}
+
+	return domain.MergeReports(report, submoduleReports...), nil
+}
+
+// fetchSubmoduleCommits returns the commits introduced by a submodule update.
+// For newly added submodules only the referenced commit is returned.
+func fetchSubmoduleCommits(ctx context.Context, resolver domain.SubmoduleResolver, update domain.SubmoduleUpdate) ([]domain.Commit, error) {
+	subRepo, err := resolver.OpenSubmodule(ctx, update.Path)
+	if err != nil {
+		return nil, err
+	}
+
+	if update.IsAddition() {
+		commit, err := subRepo.GetCommit(ctx, update.NewHash)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get submodule commit: %w", err)
+		}
+
+		return []domain.Commit{commit}, nil
+	}
+
+	commits, err := subRepo.GetCommitRange(ctx, update.OldHash, update.NewHash)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get submodule commit range: %w", err)
+	}
+
+	return commits, nil
+}
+
+// unseenCommits filters out commits already validated for the submodule and marks the rest as seen.
+func unseenCommits(commits []domain.Commit, path string, seen map[string]bool) []domain.Commit {
+	result := make([]domain.Commit, 0, len(commits))
+
+	for _, commit := range commits {
+		key := path + "@" + commit.Hash
+		if seen[key] {
+			continue
+		}
+
+		seen[key] = true
+
+		result = append(result, commit)
+	}
+
+	return result
+}
@@ -0,0 +1,158 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package cli
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/itiquette/gommitlint/internal/domain"
+	"github.com/itiquette/gommitlint/internal/domain/config"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateSubmodules(t *testing.T) {
+	submoduleRepo := &mockRepository{
+		commits: map[string]domain.Commit{
+			"new-lib": {Hash: "new-lib", Subject: "Add lib"},
+		},
+		commitRanges: map[string][]domain.Commit{
+			"old-lib..new-lib": {
+				{Hash: "lib-1", Subject: "First lib change"},
+				{Hash: "lib-2", Subject: "Second lib change"},
+				{Hash: "lib-merge", Subject: "Merge branch", IsMergeCommit: true},
+			},
+		},
+	}
+
+	tests := []struct {
+		name            string
+		updates         map[string][]domain.SubmoduleUpdate
+		openErr         error
+		expectedTotal   int
+		expectedModules []string
+	}{
+		{
+			name:            "no submodule updates",
+			updates:         map[string][]domain.SubmoduleUpdate{},
+			expectedTotal:   1,
+			expectedModules: []string{""},
+		},
+		{
+			name: "submodule bump validates range",
+			updates: map[string][]domain.SubmoduleUpdate{
+				"parent": {{Path: "lib", OldHash: "old-lib", NewHash: "new-lib"}},
+			},
+			expectedTotal:   3,
+			expectedModules: []string{"", "lib", "lib", "lib"},
+		},
+		{
+			name: "submodule addition validates pointer commit",
+			updates: map[string][]domain.SubmoduleUpdate{
+				"parent": {{Path: "lib", NewHash: "new-lib"}},
+			},
+			expectedTotal:   2,
+			expectedModules: []string{"", "lib"},
+		},
+		{
+			name: "unavailable submodule is skipped",
+			updates: map[string][]domain.SubmoduleUpdate{
+				"parent": {{Path: "lib", OldHash: "old-lib", NewHash: "new-lib"}},
+			},
+			openErr:         errors.New("not initialized"),
+			expectedTotal:   1,
+			expectedModules: []string{""},
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			resolver := &mockSubmoduleResolver{
+				updates: testCase.updates,
+				repo:    submoduleRepo,
+				openErr: testCase.openErr,
+			}
+
+			base := domain.BuildReport(
+				[]domain.ValidationResult{{Commit: domain.Commit{Hash: "parent", Subject: "Bump lib"}}},
+				nil, nil, nil, domain.ReportOptions{})
+
+			var configPaths []string
+
+			configFor := func(path string) (config.Config, error) {
+				configPaths = append(configPaths, path)
+
+				return config.NewDefault(), nil
+			}
+			rulesFor := func(_ config.Config) []domain.CommitRule {
+				return []domain.CommitRule{&mockCommitRule{name: "Subject"}}
+			}
+
+			report, err := ValidateSubmodules(context.Background(), base, NewSubmoduleRecorder(resolver, &mockLogger{}), configFor, rulesFor)
+			require.NoError(t, err)
+			require.Equal(t, testCase.expectedTotal, report.Summary.TotalCommits)
+			require.True(t, report.Summary.AllPassed)
+
+			modules := make([]string, len(report.Commits))
+			for i, commitReport := range report.Commits {
+				modules[i] = commitReport.Submodule
+			}
+
+			require.Equal(t, testCase.expectedModules, modules)
+
+			if testCase.expectedTotal > 1 {
+				require.Equal(t, []string{"lib"}, configPaths)
+			}
+		})
+	}
+}
+
+func TestValidateSubmodules_StreamedSummary(t *testing.T) {
+	ctx := context.Background()
+	resolver := &mockSubmoduleResolver{
+		updates: map[string][]domain.SubmoduleUpdate{"c1": {{Path: "lib", NewHash: "new-lib"}}},
+		repo:    &mockRepository{commits: map[string]domain.Commit{"new-lib": {Hash: "new-lib", Subject: "Add lib"}}},
+	}
+	repo := &generatingRepository{mockRepository: &mockRepository{}, count: 3}
+	cfg := config.NewDefault()
+	commitRules := []domain.CommitRule{&mockCommitRule{name: "Subject"}}
+	recorder := NewSubmoduleRecorder(resolver, &mockLogger{})
+
+	// --summary with --recurse-submodules: the range keeps no commits, so the submodule
+	// updates are recorded as the commits stream by
+	target := ValidationTarget{Type: "range", Source: "main", Target: "HEAD"}.
+		WithSummaryOnly(true).
+		WithCommitSink(func(commitReport domain.CommitReport) { recorder.Record(ctx, commitReport) })
+
+	report, err := executeRangeValidation(ctx, target, commitRules, nil, repo, cfg, &mockLogger{})
+	require.NoError(t, err)
+	require.Empty(t, report.Commits)
+
+	report, err = ValidateSubmodules(ctx, report, recorder, func(string) (config.Config, error) { return cfg, nil },
+		func(config.Config) []domain.CommitRule { return commitRules })
+	require.NoError(t, err)
+	require.Equal(t, 4, report.Summary.TotalCommits, "the submodule commit is validated too")
+	require.Len(t, report.Commits, 1)
+	require.Equal(t, "lib", report.Commits[0].Submodule)
+}
+
+type mockSubmoduleResolver struct {
+	updates map[string][]domain.SubmoduleUpdate
+	repo    domain.Repository
+	openErr error
+}
+
+func (m *mockSubmoduleResolver) GetSubmoduleUpdates(_ context.Context, ref string) ([]domain.SubmoduleUpdate, error) {
+	return m.updates[ref], nil
+}
+
+func (m *mockSubmoduleResolver) OpenSubmodule(_ context.Context, _ string) (domain.Repository, error) {
+	if m.openErr != nil {
+		return nil, m.openErr
+	}
+
+	return m.repo, nil
+}
@@ -6,11 +6,14 @@ package cli
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"os"
 	"strconv"
+	"time"
 
+	"github.com/itiquette/gommitlint/internal/adapters/charset"
 	"github.com/itiquette/gommitlint/internal/domain"
 	"github.com/itiquette/gommitlint/internal/domain/config"
 )
@@ -20,32 +23,118 @@ func ValidateTarget(ctx context.Context, target ValidationTarget, commitRules []
 	repoRules []domain.RepositoryRule, repo domain.Repository, cfg config.Config, logger domain.Logger) (domain.Report, error) {
 	logger.Debug("Starting validation", "target_type", target.Type)
 
+	start := time.Now()
+
+	report, err := validateTarget(ctx, target, commitRules, repoRules, repo, cfg, logger)
+	if err != nil {
+		return report, err
+	}
+
+	report.Repository = describeRepository(ctx, report.Repository, target, repo)
+
+	logReport(logger, report)
+	logger.Debug("Finished validation", "target_type", target.Type, "commits", report.Summary.TotalCommits,
+		domain.LogFieldDurationMs, time.Since(start).Milliseconds())
+
+	return report, nil
+}
+
+// validateTarget validates target by its type.
+func validateTarget(ctx context.Context, target ValidationTarget, commitRules []domain.CommitRule,
+	repoRules []domain.RepositoryRule, repo domain.Repository, cfg config.Config, logger domain.Logger) (domain.Report, error) {
 	switch target.Type {
 	case "message":
-		return executeMessageValidation(target.Source, commitRules, cfg, logger)
+		return executeMessageValidation(target.Source, commitRules, repo, cfg, logger)
 	case "commit":
 		return executeCommitValidation(ctx, target.Source, commitRules, repoRules, repo, cfg, logger)
 	case "range":
-		return executeRangeValidation(ctx, target.Source, target.Target, commitRules, repoRules, repo, cfg, logger)
+		return executeRangeValidation(ctx, target, commitRules, repoRules, repo, cfg, logger)
 	case "count":
-		return executeCountValidation(ctx, target.Source, commitRules, repoRules, repo, cfg, logger)
+		return executeCountValidation(ctx, target, commitRules, repoRules, repo, cfg, logger)
+	case "push":
+		return ValidatePush(ctx, target.Source, os.Stdin, commitRules, repoRules, repo, cfg, logger)
+	case "pre-receive":
+		return ValidateReceive(ctx, os.Stdin, commitRules, repoRules, repo, cfg, logger)
+	case "staged-amend":
+		return ValidateStagedAmend(ctx, target.Source, commitRules, repoRules, repo, cfg, logger)
 	default:
 		return domain.Report{}, fmt.Errorf("unknown validation target type: %s", target.Type)
 	}
 }
 
+// describeRepository adds the name and branch of repo and the commits validated for
+// target to the repository report. Names the repository cannot resolve are left empty.
+func describeRepository(ctx context.Context, repoReport domain.RepositoryReport, target ValidationTarget,
+	repo domain.Repository) domain.RepositoryReport {
+	if resolver, ok := repo.(domain.RepositoryNameResolver); ok {
+		if name, err := resolver.GetRepositoryName(ctx); err == nil {
+			repoReport.Name = name
+		}
+	}
+
+	if resolver, ok := repo.(domain.CurrentBranchResolver); ok {
+		if branch, err := resolver.GetCurrentBranch(ctx); err == nil {
+			repoReport.Branch = branch
+		}
+	}
+
+	repoReport.Range = targetRange(target)
+
+	return repoReport
+}
+
+// targetRange describes the commits validated for target as git would write them.
+func targetRange(target ValidationTarget) string {
+	switch target.Type {
+	case "commit":
+		return target.Source
+	case "range":
+		// main..HEAD lists the same commits as the merge base of main and HEAD does
+		return target.Source + ".." + target.Target
+	case "count":
+		count, err := parseCommitCount(target.Source)
+		if err != nil || count == 1 {
+			return "HEAD"
+		}
+
+		return fmt.Sprintf("HEAD~%d..HEAD", count-1)
+	default:
+		return ""
+	}
+}
+
+// logReport logs the outcome of every commit and failed rule of report at debug level.
+// These lines repeat for every commit, which is what --log-sample thins out.
+func logReport(logger domain.Logger, report domain.Report) {
+	for _, commitReport := range report.Commits {
+		logCommitReport(logger, commitReport)
+	}
+}
+
+// logCommitReport logs the outcome of a commit and its failed rules at debug level.
+func logCommitReport(logger domain.Logger, commitReport domain.CommitReport) {
+	logger.Debug("Validated commit", domain.LogFieldCommit, commitReport.Commit.Hash, "passed", commitReport.Passed)
+
+	for _, ruleReport := range commitReport.RuleResults {
+		if ruleReport.Status == domain.StatusFailed {
+			logger.Debug("Rule failed", domain.LogFieldCommit, commitReport.Commit.Hash, domain.LogFieldRule, ruleReport.Name)
+		}
+	}
+}
+
 // executeMessageValidation handles message file validation.
-func executeMessageValidation(filePath string, rules []domain.CommitRule, cfg config.Config, logger domain.Logger) (domain.Report, error) {
+func executeMessageValidation(filePath string, rules []domain.CommitRule, repo domain.Repository, cfg config.Config,
+	logger domain.Logger) (domain.Report, error) {
 	logger.Debug("Validating message from file", "path", filePath)
 
 	// Read file
-	message, err := readMessageFile(filePath)
+	message, err := readMessageFile(filePath, cfg.Message.FallbackEncoding)
 	if err != nil {
 		return domain.Report{}, err
 	}
 
 	// Validate message
-	return ValidateMessageContent(message, rules, cfg)
+	return ValidateMessageContent(message, rules, repo, cfg)
 }
 
 // executeCommitValidation handles single commit validation.
@@ -55,7 +144,7 @@ func executeCommitValidation(ctx context.Context, ref string, commitRules []doma
 	case <-ctx.Done():
 		return domain.Report{}, ctx.Err()
 	default:
-		logger.Debug("Validating commit", "ref", ref)
+		logger.Debug("Validating commit", domain.LogFieldCommit, ref)
 	}
 
 	// Fetch commit from repository
@@ -69,17 +158,22 @@ func executeCommitValidation(ctx context.Context, ref string, commitRules []doma
 }
 
 // executeRangeValidation handles commit range validation.
-func executeRangeValidation(ctx context.Context, fromRef, toRef string, commitRules []domain.CommitRule,
+func executeRangeValidation(ctx context.Context, target ValidationTarget, commitRules []domain.CommitRule,
 	repoRules []domain.RepositoryRule, repo domain.Repository, cfg config.Config, logger domain.Logger) (domain.Report, error) {
 	select {
 	case <-ctx.Done():
 		return domain.Report{}, ctx.Err()
 	default:
-		logger.Debug("Validating commit range", "from", fromRef, "to", toRef)
+		logger.Debug("Validating commit range", "from", target.Source, "to", target.Target,
+			"merge_base", target.MergeBase, "first_parent", target.FirstParent)
+	}
+
+	if streamer, ok := repo.(domain.CommitStreamer); ok && !target.FirstParent {
+		return streamRangeValidation(ctx, target, streamer, commitRules, repoRules, repo, cfg, logger)
 	}
 
 	// Fetch commits from repository
-	commits, err := repo.GetCommitRange(ctx, fromRef, toRef)
+	commits, err := getRangeCommits(ctx, target, repo, logger)
 	if err != nil {
 		return domain.Report{}, fmt.Errorf("failed to get commit range: %w", err)
 	}
@@ -88,18 +182,112 @@ func executeRangeValidation(ctx context.Context, fromRef, toRef string, commitRu
 	return ValidateMultipleCommits(commits, commitRules, repoRules, repo, cfg)
 }
 
+// streamRangeValidation validates each commit of a range target as the repository reads
+// it, passing its report to target.CommitSink, or keeping it unless target.SummaryOnly
+// asks for the counts alone. The commits themselves are kept only when a range rule
+// needs all of them at once.
+func streamRangeValidation(ctx context.Context, target ValidationTarget, streamer domain.CommitStreamer,
+	commitRules []domain.CommitRule, repoRules []domain.RepositoryRule, repo domain.Repository, cfg config.Config,
+	logger domain.Logger) (domain.Report, error) {
+	fromRef, err := rangeStart(ctx, target, repo, logger)
+	if err != nil {
+		return domain.Report{}, fmt.Errorf("failed to get commit range: %w", err)
+	}
+
+	options := reportOptions(cfg)
+	options.SummaryOnly = target.SummaryOnly
+	builder := domain.NewReportBuilder(commitRules, repoRules, options)
+	keepCommits := domain.HasRangeRules(repoRules, repo)
+
+	stream := streamer.StreamCommitRange
+
+	// Reports list commits oldest first, so that is the order to write them in as they come
+	if target.CommitSink != nil {
+		if oldestFirst, ok := streamer.(domain.OldestFirstCommitStreamer); ok {
+			stream = oldestFirst.StreamCommitRangeOldestFirst
+		}
+
+		builder.StreamTo(func(commitReport domain.CommitReport) {
+			logCommitReport(logger, commitReport)
+			target.CommitSink(commitReport)
+		})
+	}
+
+	var commits []domain.Commit
+
+	err = stream(ctx, fromRef, target.Target, func(commit domain.Commit) error {
+		if keepCommits {
+			commits = append(commits, commit)
+		}
+
+		builder.Add(validateUnlessMerge(commit, commitRules, repoRules, repo, cfg))
+
+		return nil
+	})
+	if err != nil {
+		return domain.Report{}, fmt.Errorf("failed to get commit range: %w", err)
+	}
+
+	repoErrors := domain.ValidateRepository(repoRules, repo, cfg)
+	repoErrors = append(repoErrors, domain.ValidateRange(commits, repoRules, repo, cfg)...)
+
+	return builder.Build(repoErrors), nil
+}
+
+// getRangeCommits fetches the commits of a range target, resolving the merge base
+// and walking first parents only when the target asks for it.
+func getRangeCommits(ctx context.Context, target ValidationTarget, repo domain.Repository, logger domain.Logger) ([]domain.Commit, error) {
+	fromRef, err := rangeStart(ctx, target, repo, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	if !target.FirstParent {
+		return repo.GetCommitRange(ctx, fromRef, target.Target)
+	}
+
+	resolver, ok := repo.(domain.HistoryResolver)
+	if !ok {
+		return nil, errors.New("repository does not support merge-base or first-parent ranges")
+	}
+
+	return resolver.GetFirstParentRange(ctx, fromRef, target.Target)
+}
+
+// rangeStart returns the ref a range target starts from, which is the merge base of its
+// source and target when the target asks for it.
+func rangeStart(ctx context.Context, target ValidationTarget, repo domain.Repository, logger domain.Logger) (string, error) {
+	if !target.MergeBase {
+		return target.Source, nil
+	}
+
+	resolver, ok := repo.(domain.HistoryResolver)
+	if !ok {
+		return "", errors.New("repository does not support merge-base or first-parent ranges")
+	}
+
+	mergeBase, err := resolver.GetMergeBase(ctx, target.Source, target.Target)
+	if err != nil {
+		return "", err
+	}
+
+	logger.Debug("Resolved merge base", "base", target.Source, "merge_base", mergeBase)
+
+	return mergeBase, nil
+}
+
 // executeCountValidation handles commit count validation.
-func executeCountValidation(ctx context.Context, countStr string, commitRules []domain.CommitRule,
+func executeCountValidation(ctx context.Context, target ValidationTarget, commitRules []domain.CommitRule,
 	repoRules []domain.RepositoryRule, repo domain.Repository, cfg config.Config, logger domain.Logger) (domain.Report, error) {
 	select {
 	case <-ctx.Done():
 		return domain.Report{}, ctx.Err()
 	default:
-		logger.Debug("Validating commit count", "count", countStr)
+		logger.Debug("Validating commit count", "count", target.Source)
 	}
 
 	// Parse count
-	count, err := parseCommitCount(countStr)
+	count, err := parseCommitCount(target.Source)
 	if err != nil {
 		return domain.Report{}, err
 	}
@@ -110,53 +298,84 @@ func executeCountValidation(ctx context.Context, countStr string, commitRules []
 	}
 
 	// Multiple commits - delegate to range validation
-	fromRef := fmt.Sprintf("HEAD~%d", count-1)
+	rangeTarget := ValidationTarget{
+		Type:        "range",
+		Source:      fmt.Sprintf("HEAD~%d", count-1),
+		Target:      "HEAD",
+		FirstParent: target.FirstParent,
+		SummaryOnly: target.SummaryOnly,
+		CommitSink:  target.CommitSink,
+	}
 
-	return executeRangeValidation(ctx, fromRef, "HEAD", commitRules, repoRules, repo, cfg, logger)
+	return executeRangeValidation(ctx, rangeTarget, commitRules, repoRules, repo, cfg, logger)
 }
 
-// ValidateMessageContent validates a message string.
-func ValidateMessageContent(message string, rules []domain.CommitRule, cfg config.Config) (domain.Report, error) {
-	result, err := domain.ValidateMessage(message, rules, cfg)
+// ValidateMessageContent validates a message string being written in repo, which may be nil.
+func ValidateMessageContent(message string, rules []domain.CommitRule, repo domain.Repository, cfg config.Config) (domain.Report, error) {
+	result, err := domain.ValidateMessageInRepository(message, rules, repo, cfg)
 	if err != nil {
 		return domain.Report{}, fmt.Errorf("failed to validate message: %w", err)
 	}
 
-	return domain.BuildReport([]domain.ValidationResult{result}, nil, rules, nil, domain.ReportOptions{}), nil
+	return domain.BuildReport([]domain.ValidationResult{result}, nil, rules, nil, reportOptions(cfg)), nil
 }
 
 // ValidateSingleCommit validates one commit.
 func ValidateSingleCommit(commit domain.Commit, commitRules []domain.CommitRule, repoRules []domain.RepositoryRule,
 	repo domain.Repository, cfg config.Config) (domain.Report, error) {
-	// Always skip merge commits
-	if commit.IsMergeCommit {
-		emptyResult := domain.ValidationResult{Commit: commit, Errors: nil}
+	// Merge commits are skipped unless a rule validates them
+	if commit.IsMergeCommit && !domain.ValidatesMergeCommits(commitRules) {
+		skipped := domain.SkipCommit(commit, domain.SkipReasonMergeCommit)
 
-		return domain.BuildReport([]domain.ValidationResult{emptyResult}, nil, commitRules, repoRules, domain.ReportOptions{}), nil
+		return domain.BuildReport([]domain.ValidationResult{skipped}, nil, commitRules, repoRules, reportOptions(cfg)), nil
 	}
 
 	// Validate using domain functions
-	validationResult := domain.ValidateCommit(commit, commitRules, repoRules, repo, cfg)
+	validationResult := validateUnlessMerge(commit, commitRules, repoRules, repo, cfg)
 	repoErrors := domain.ValidateRepository(repoRules, repo, cfg)
 
-	return domain.BuildReport([]domain.ValidationResult{validationResult}, repoErrors, commitRules, repoRules, domain.ReportOptions{}), nil
+	return domain.BuildReport([]domain.ValidationResult{validationResult}, repoErrors, commitRules, repoRules, reportOptions(cfg)), nil
 }
 
 // ValidateMultipleCommits validates multiple commits.
 func ValidateMultipleCommits(commits []domain.Commit, commitRules []domain.CommitRule, repoRules []domain.RepositoryRule,
 	repo domain.Repository, cfg config.Config) (domain.Report, error) {
-	// Always filter out merge commits
-	filteredCommits := domain.FilterMergeCommits(commits)
+	validationResults := make([]domain.ValidationResult, 0, len(commits))
+	for _, commit := range commits {
+		validationResults = append(validationResults, validateUnlessMerge(commit, commitRules, repoRules, repo, cfg))
+	}
 
-	// Validate using domain functions
-	validationResults := domain.ValidateCommits(filteredCommits, commitRules, repoRules, repo, cfg)
 	repoErrors := domain.ValidateRepository(repoRules, repo, cfg)
+	repoErrors = append(repoErrors, domain.ValidateRange(commits, repoRules, repo, cfg)...)
+
+	return domain.BuildReport(validationResults, repoErrors, commitRules, repoRules, reportOptions(cfg)), nil
+}
+
+// validateUnlessMerge validates commit. Merge commits are validated only by the rules
+// for merge commits, and skipped when none of them is active.
+func validateUnlessMerge(commit domain.Commit, commitRules []domain.CommitRule, repoRules []domain.RepositoryRule,
+	repo domain.Repository, cfg config.Config) domain.ValidationResult {
+	if commit.IsMergeCommit {
+		if domain.ValidatesMergeCommits(commitRules) {
+			return domain.ValidateMergeCommit(commit, commitRules, repo, cfg)
+		}
 
-	return domain.BuildReport(validationResults, repoErrors, commitRules, repoRules, domain.ReportOptions{}), nil
+		return domain.SkipCommit(commit, domain.SkipReasonMergeCommit)
+	}
+
+	return domain.ValidateCommit(commit, commitRules, repoRules, repo, cfg)
+}
+
+// reportOptions returns the options for building reports of validations with cfg.
+func reportOptions(cfg config.Config) domain.ReportOptions {
+	return domain.ReportOptions{
+		Deduplicate: cfg.Rules.RelatedFailures != "verbose",
+		TypeAliases: cfg.Conventional.TypeAliases,
+	}
 }
 
-// readMessageFile reads message from file or stdin.
-func readMessageFile(filePath string) (string, error) {
+// readMessageFile reads message from file or stdin, decoded with charset.DecodeMessage.
+func readMessageFile(filePath, fallbackEncoding string) (string, error) {
 	// Handle stdin case
 	if filePath == "-" {
 		message, err := io.ReadAll(os.Stdin)
@@ -164,7 +383,7 @@ func readMessageFile(filePath string) (string, error) {
 			return "", fmt.Errorf("failed to read from stdin: %w", err)
 		}
 
-		return string(message), nil
+		return charset.DecodeMessage(message, fallbackEncoding)
 	}
 
 	// Handle regular file case
@@ -173,7 +392,7 @@ func readMessageFile(filePath string) (string, error) {
 		return "", fmt.Errorf("failed to read message file: %w", err)
 	}
 
-	return string(message), nil
+	return charset.DecodeMessage(message, fallbackEncoding)
 }
 
 // parseCommitCount parses commit count string.
@@ -9,11 +9,13 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"slices"
 	"testing"
 	"time"
 
 	"github.com/itiquette/gommitlint/internal/domain"
 	"github.com/itiquette/gommitlint/internal/domain/config"
+	"github.com/itiquette/gommitlint/internal/domain/rules"
 	"github.com/stretchr/testify/require"
 )
 
@@ -180,7 +182,7 @@ func TestExecuteMessageValidation(t *testing.T) {
 			cfg := config.Config{}
 			logger := &mockLogger{}
 
-			report, err := executeMessageValidation(filePath, rules, cfg, logger)
+			report, err := executeMessageValidation(filePath, rules, nil, cfg, logger)
 
 			if testCase.expectError {
 				require.Error(t, err, testCase.description)
@@ -297,7 +299,7 @@ func TestExecuteRangeValidation(t *testing.T) {
 			cfg := config.Config{}
 			logger := &mockLogger{}
 
-			report, err := executeRangeValidation(ctx, testCase.fromRef, testCase.toRef, commitRules, repoRules, repo, cfg, logger)
+			report, err := executeRangeValidation(ctx, ValidationTarget{Type: "range", Source: testCase.fromRef, Target: testCase.toRef}, commitRules, repoRules, repo, cfg, logger)
 
 			if testCase.expectError {
 				require.Error(t, err, testCase.description)
@@ -309,6 +311,153 @@ func TestExecuteRangeValidation(t *testing.T) {
 	}
 }
 
+func TestStreamRangeValidation(t *testing.T) {
+	ctx := context.Background()
+	commits := []domain.Commit{
+		{Hash: "c3", Subject: "Merge branch 'topic'", IsMergeCommit: true, ParentHashes: []string{"c2", "c1"}},
+		{Hash: "c2", Subject: "fix: handle empty password", CommitDate: "2025-03-02T10:00:00Z"},
+		{Hash: "c1", Subject: "Add login page", CommitDate: "2025-03-01T10:00:00Z"},
+	}
+	repo := &streamingRepository{
+		mockRepository: &mockRepository{commitRanges: map[string][]domain.Commit{}},
+		streamed:       map[string][]domain.Commit{"main..HEAD": commits},
+	}
+	cfg := config.NewDefault()
+	commitRules := []domain.CommitRule{rules.NewConventionalCommitRule(cfg)}
+	target := ValidationTarget{Type: "range", Source: "main", Target: "HEAD"}
+
+	report, err := executeRangeValidation(ctx, target, commitRules, nil, repo, cfg, &mockLogger{})
+	require.NoError(t, err, "the range is streamed instead of fetched")
+	require.Equal(t, 2, report.Summary.TotalCommits, "merge commits are skipped")
+	require.Equal(t, 1, report.Summary.SkippedCommits)
+	require.Equal(t, 1, report.Summary.FailedCommits)
+	require.Len(t, report.Commits, 3)
+	require.Equal(t, domain.SkipReasonMergeCommit, report.Commits[0].SkipReason, "commits are reported oldest first")
+	require.Equal(t, "c1", report.Commits[1].Commit.Hash)
+
+	summary, err := executeRangeValidation(ctx, target.WithSummaryOnly(true), commitRules, nil, repo, cfg, &mockLogger{})
+	require.NoError(t, err)
+	require.Equal(t, report.Summary, summary.Summary)
+	require.Empty(t, summary.Commits, "only the counts are kept")
+
+	repoRules := []domain.RepositoryRule{rules.NewLinearHistoryRule(cfg)}
+	summary, err = executeRangeValidation(ctx, target.WithSummaryOnly(true), commitRules, repoRules, repo, cfg, &mockLogger{})
+	require.NoError(t, err)
+	require.Equal(t, 1, summary.Summary.FailedRules["LinearHistory"], "range rules still see all commits, merges included")
+
+	var sunk []string
+
+	sinking := target.WithCommitSink(func(commitReport domain.CommitReport) {
+		sunk = append(sunk, commitReport.Commit.Hash)
+	})
+
+	streamed, err := executeRangeValidation(ctx, sinking, commitRules, nil, repo, cfg, &mockLogger{})
+	require.NoError(t, err)
+	require.Equal(t, report.Summary, streamed.Summary)
+	require.Empty(t, streamed.Commits, "the commits went to the sink")
+	require.Equal(t, []string{"c1", "c2", "c3"}, sunk, "commits are streamed oldest first")
+
+	_, err = executeRangeValidation(ctx, ValidationTarget{Type: "range", Source: "v1", Target: "HEAD"}, commitRules, nil, repo, cfg, &mockLogger{})
+	require.ErrorContains(t, err, "failed to get commit range")
+}
+
+func TestStreamRangeValidation_Bounded(t *testing.T) {
+	ctx := context.Background()
+	repo := &generatingRepository{mockRepository: &mockRepository{}, count: 500}
+	cfg := config.NewDefault()
+	commitRules := []domain.CommitRule{rules.NewConventionalCommitRule(cfg)}
+	target := ValidationTarget{Type: "range", Source: "main", Target: "HEAD"}
+
+	// Without a sink each report is kept for the formats written whole
+	report, err := executeRangeValidation(ctx, target, commitRules, nil, repo, cfg, &mockLogger{})
+	require.NoError(t, err)
+	require.Equal(t, 500, report.Summary.TotalCommits)
+	require.Len(t, report.Commits, 500)
+
+	summary, err := executeRangeValidation(ctx, target.WithSummaryOnly(true), commitRules, nil, repo, cfg, &mockLogger{})
+	require.NoError(t, err)
+	require.Equal(t, report.Summary, summary.Summary)
+	require.Empty(t, summary.Commits, "nothing grows with the range")
+
+	written := 0
+
+	streamed, err := executeRangeValidation(ctx, target.WithCommitSink(func(domain.CommitReport) { written++ }),
+		commitRules, nil, repo, cfg, &mockLogger{})
+	require.NoError(t, err)
+	require.Equal(t, report.Summary, streamed.Summary)
+	require.Empty(t, streamed.Commits, "nothing grows with the range")
+	require.Equal(t, 500, written)
+
+	counted, err := executeCountValidation(ctx, ValidationTarget{Type: "count", Source: "500"}.WithCommitSink(func(domain.CommitReport) {}),
+		commitRules, nil, repo, cfg, &mockLogger{})
+	require.NoError(t, err)
+	require.Empty(t, counted.Commits, "counts stream as ranges do")
+}
+
+func TestGetRangeCommits(t *testing.T) {
+	merged := domain.Commit{Hash: "merged", Subject: "Merged from main"}
+	feature := domain.Commit{Hash: "feature", Subject: "Feature commit"}
+
+	repo := &historyRepository{
+		mockRepository: &mockRepository{
+			commitRanges: map[string][]domain.Commit{
+				"main..HEAD": {feature},
+				"base..HEAD": {feature, merged},
+			},
+		},
+		mergeBases:   map[string]string{"main...HEAD": "base"},
+		firstParents: map[string][]domain.Commit{"base..HEAD": {feature}},
+	}
+
+	tests := []struct {
+		name        string
+		target      ValidationTarget
+		repo        domain.Repository
+		expected    []domain.Commit
+		expectError string
+	}{
+		{
+			name:     "plain range",
+			target:   ValidationTarget{Type: "range", Source: "main", Target: "HEAD"},
+			repo:     repo,
+			expected: []domain.Commit{feature},
+		},
+		{
+			name:     "merge base",
+			target:   ValidationTarget{Type: "range", Source: "main", Target: "HEAD", MergeBase: true},
+			repo:     repo,
+			expected: []domain.Commit{feature, merged},
+		},
+		{
+			name:     "merge base and first parent",
+			target:   ValidationTarget{Type: "range", Source: "main", Target: "HEAD", MergeBase: true, FirstParent: true},
+			repo:     repo,
+			expected: []domain.Commit{feature},
+		},
+		{
+			name:        "repository without history support",
+			target:      ValidationTarget{Type: "range", Source: "main", Target: "HEAD", FirstParent: true},
+			repo:        repo.mockRepository,
+			expectError: "repository does not support merge-base or first-parent ranges",
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			commits, err := getRangeCommits(context.Background(), testCase.target, testCase.repo, &mockLogger{})
+
+			if testCase.expectError != "" {
+				require.EqualError(t, err, testCase.expectError)
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, testCase.expected, commits)
+		})
+	}
+}
+
 func TestExecuteCountValidation(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -381,7 +530,7 @@ func TestExecuteCountValidation(t *testing.T) {
 			cfg := config.Config{}
 			logger := &mockLogger{}
 
-			report, err := executeCountValidation(ctx, testCase.countStr, commitRules, repoRules, repo, cfg, logger)
+			report, err := executeCountValidation(ctx, ValidationTarget{Type: "count", Source: testCase.countStr}, commitRules, repoRules, repo, cfg, logger)
 
 			if testCase.expectError {
 				require.Error(t, err, testCase.description)
@@ -421,7 +570,7 @@ func TestValidateMessageContent(t *testing.T) {
 		t.Run(testCase.name, func(t *testing.T) {
 			cfg := config.Config{}
 
-			report, err := ValidateMessageContent(testCase.message, testCase.rules, cfg)
+			report, err := ValidateMessageContent(testCase.message, testCase.rules, nil, cfg)
 
 			if testCase.expectError {
 				require.Error(t, err, testCase.description)
@@ -477,13 +626,38 @@ func TestValidateSingleCommit(t *testing.T) {
 			require.Len(t, report.Commits, 1, "should have one commit report")
 
 			if testCase.expectSkip {
-				// For merge commits, should have empty errors (skipped)
+				// Merge commits are reported as skipped, with no rule run
 				require.True(t, report.Commits[0].Passed, "merge commit should be skipped")
+				require.Equal(t, domain.SkipReasonMergeCommit, report.Commits[0].SkipReason)
+				require.Equal(t, domain.StatusSkipped, report.Commits[0].RuleResults[0].Status)
+				require.Equal(t, 0, report.Summary.TotalCommits)
+				require.Equal(t, 1, report.Summary.SkippedCommits)
 			}
 		})
 	}
 }
 
+func TestValidateSingleCommit_MergeRule(t *testing.T) {
+	commit := domain.Commit{Hash: "def456", Subject: "Merge branch 'feature'", IsMergeCommit: true}
+	commitRules := []domain.CommitRule{&mockCommitRule{name: "Subject"}, &mockCommitRule{name: "MergeSubject"}}
+
+	report, err := ValidateSingleCommit(commit, commitRules, nil, &mockRepository{}, config.Config{})
+	require.NoError(t, err)
+	require.Len(t, report.Commits, 1)
+	require.Empty(t, report.Commits[0].SkipReason, "merge commits are validated by the merge rules")
+	require.Equal(t, 1, report.Summary.TotalCommits)
+
+	statuses := make(map[string]domain.ValidationStatus)
+	for _, ruleResult := range report.Commits[0].RuleResults {
+		statuses[ruleResult.Name] = ruleResult.Status
+	}
+
+	require.Equal(t, map[string]domain.ValidationStatus{
+		"Subject":      domain.StatusNotApplicable,
+		"MergeSubject": domain.StatusPassed,
+	}, statuses)
+}
+
 func TestValidateMultipleCommits(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -572,7 +746,7 @@ func TestReadMessageFile(t *testing.T) {
 			filePath, cleanup := testCase.setupFile()
 			defer cleanup()
 
-			message, err := readMessageFile(filePath)
+			message, err := readMessageFile(filePath, "")
 
 			if testCase.expectError {
 				require.Error(t, err, testCase.description)
@@ -646,6 +820,51 @@ func TestParseCommitCount(t *testing.T) {
 	}
 }
 
+func TestValidateTarget_RepositoryReport(t *testing.T) {
+	repo := &namedRepository{
+		mockRepository: &mockRepository{
+			commits: map[string]domain.Commit{"HEAD": {Hash: "abc123", Subject: "Test commit"}},
+			commitRanges: map[string][]domain.Commit{
+				"main..HEAD":   {{Hash: "abc123", Subject: "Test commit"}},
+				"HEAD~2..HEAD": {{Hash: "abc123", Subject: "Test commit"}},
+			},
+		},
+		name:   "gommitlint",
+		branch: "feature/login",
+	}
+
+	tests := []struct {
+		target        ValidationTarget
+		expectedRange string
+	}{
+		{target: ValidationTarget{Type: "commit", Source: "HEAD"}, expectedRange: "HEAD"},
+		{target: ValidationTarget{Type: "range", Source: "main", Target: "HEAD"}, expectedRange: "main..HEAD"},
+		{target: ValidationTarget{Type: "count", Source: "3"}, expectedRange: "HEAD~2..HEAD"},
+		{target: ValidationTarget{Type: "count", Source: "1"}, expectedRange: "HEAD"},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.target.Type+" "+testCase.target.Source, func(t *testing.T) {
+			repoRules := []domain.RepositoryRule{&mockRepoRule{name: "BranchAhead"}}
+
+			report, err := ValidateTarget(context.Background(), testCase.target, nil, repoRules, repo, config.Config{}, &mockLogger{})
+			require.NoError(t, err)
+			require.Equal(t, "gommitlint", report.Repository.Name)
+			require.Equal(t, "feature/login", report.Repository.Branch)
+			require.Equal(t, testCase.expectedRange, report.Repository.Range)
+			require.Len(t, report.Repository.RuleResults, 1)
+		})
+	}
+
+	// Repositories that cannot name themselves still report the range
+	report, err := ValidateTarget(context.Background(), ValidationTarget{Type: "commit", Source: "HEAD"}, nil, nil,
+		repo.mockRepository, config.Config{}, &mockLogger{})
+	require.NoError(t, err)
+	require.Empty(t, report.Repository.Name)
+	require.Empty(t, report.Repository.Branch)
+	require.Equal(t, "HEAD", report.Repository.Range)
+}
+
 func TestValidateTarget_ContextCancellation(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -761,6 +980,105 @@ func (m *mockRepository) GetCommitsAheadCount(_ context.Context, _ string) (int,
 	return 0, nil
 }
 
+// historyRepository adds merge-base and first-parent lookups to mockRepository.
+type historyRepository struct {
+	*mockRepository
+
+	mergeBases   map[string]string
+	firstParents map[string][]domain.Commit
+}
+
+func (m *historyRepository) GetMergeBase(_ context.Context, ref, other string) (string, error) {
+	if base, exists := m.mergeBases[ref+"..."+other]; exists {
+		return base, nil
+	}
+
+	return "", domain.New("repository", "merge_base_not_found", "no merge base: "+ref+"..."+other)
+}
+
+func (m *historyRepository) GetFirstParentRange(_ context.Context, fromRef, toRef string) ([]domain.Commit, error) {
+	return m.firstParents[fromRef+".."+toRef], nil
+}
+
+// streamingRepository streams commit ranges from streamed instead of returning them
+// from GetCommitRange.
+type streamingRepository struct {
+	*mockRepository
+
+	streamed map[string][]domain.Commit
+}
+
+func (m *streamingRepository) StreamCommitRange(_ context.Context, fromRef, toRef string, visit func(domain.Commit) error) error {
+	commits, exists := m.streamed[fromRef+".."+toRef]
+	if !exists {
+		return domain.New("repository", "range_not_found", "range not found: "+fromRef+".."+toRef)
+	}
+
+	for _, commit := range commits {
+		if err := visit(commit); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *streamingRepository) StreamCommitRangeOldestFirst(ctx context.Context, fromRef, toRef string, visit func(domain.Commit) error) error {
+	var commits []domain.Commit
+
+	err := m.StreamCommitRange(ctx, fromRef, toRef, func(commit domain.Commit) error {
+		commits = append(commits, commit)
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, commit := range slices.Backward(commits) {
+		if err := visit(commit); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// generatingRepository streams count commits made up as they are read, so that no
+// range is held in memory by the repository itself.
+type generatingRepository struct {
+	*mockRepository
+
+	count int
+}
+
+func (m *generatingRepository) StreamCommitRange(_ context.Context, _, _ string, visit func(domain.Commit) error) error {
+	for i := range m.count {
+		commit := domain.Commit{Hash: fmt.Sprintf("c%d", i), Subject: fmt.Sprintf("fix: handle case %d", i)}
+		if err := visit(commit); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// namedRepository adds the repository name and current branch to mockRepository.
+type namedRepository struct {
+	*mockRepository
+
+	name   string
+	branch string
+}
+
+func (m *namedRepository) GetRepositoryName(_ context.Context) (string, error) {
+	return m.name, nil
+}
+
+func (m *namedRepository) GetCurrentBranch(_ context.Context) (string, error) {
+	return m.branch, nil
+}
+
 type mockLogger struct{}
 
 func (m *mockLogger) Log(_ string, _ string, _ ...interface{}) {}
@@ -772,4 +1090,7 @@ func (m *mockLogger) Error(_ string, _ ...interface{})         {}
 var _ domain.CommitRule = (*mockCommitRule)(nil)
 var _ domain.RepositoryRule = (*mockRepoRule)(nil)
 var _ domain.Repository = (*mockRepository)(nil)
+var _ domain.HistoryResolver = (*historyRepository)(nil)
+var _ domain.RepositoryNameResolver = (*namedRepository)(nil)
+var _ domain.CurrentBranchResolver = (*namedRepository)(nil)
 var _ domain.Logger = (*mockLogger)(nil)
@@ -11,14 +11,23 @@ import (
 	"regexp"
 	"strconv"
 	"strings"
+
+	"github.com/itiquette/gommitlint/internal/domain"
 )
 
 // ValidationTarget represents what should be validated.
 // This is a focused value type with single responsibility.
 type ValidationTarget struct {
-	Type   string // "message", "commit", "range", "count"
-	Source string // file path, commit ref, or count
-	Target string // end ref for ranges, empty otherwise
+	Type        string // "message", "commit", "range", "count", "push", "pre-receive", "staged-amend", "pull-request", "patch", "patchwork"
+	Source      string // file path, commit ref, count, remote, pull request, patch file, series URL, or amend message; empty for pre-receive
+	Target      string // end ref for ranges, empty otherwise
+	MergeBase   bool   // start ranges at the merge base of Source and Target
+	FirstParent bool   // follow only the first parent of merges in ranges
+	SummaryOnly bool   // report only the counts of ranges, not every commit
+
+	// CommitSink receives the report of each commit of a streamed range as it is
+	// validated, instead of the report; nil keeps the commits in the report
+	CommitSink func(domain.CommitReport)
 }
 
 // NewValidationTarget creates a ValidationTarget from CLI parameters.
@@ -40,11 +49,12 @@ func NewValidationTarget(messageFile, gitReference, commitRange, baseBranch stri
 	}
 
 	if baseBranch != "" {
-		// 2. Base branch comparison (convenience wrapper: <base-branch>..HEAD)
+		// 2. Base branch comparison (convenience wrapper: merge-base(<base-branch>, HEAD)..HEAD)
 		return ValidationTarget{
-			Type:   "range",
-			Source: baseBranch,
-			Target: "HEAD",
+			Type:      "range",
+			Source:    baseBranch,
+			Target:    "HEAD",
+			MergeBase: true,
 		}, nil
 	}
 
@@ -88,6 +98,110 @@ func NewValidationTarget(messageFile, gitReference, commitRange, baseBranch stri
 	}, nil
 }
 
+// NewPushTarget creates a ValidationTarget for the commits pushed to remote,
+// with the ref updates read from stdin as passed to the pre-push hook.
+func NewPushTarget(remote string) (ValidationTarget, error) {
+	if err := validateParameterLength("Remote", remote, MaxPathLength); err != nil {
+		return ValidationTarget{}, err
+	}
+
+	if strings.Contains(remote, "\x00") {
+		return ValidationTarget{}, errors.New("remote contains null bytes")
+	}
+
+	return ValidationTarget{Type: "push", Source: remote}, nil
+}
+
+// NewPreReceiveTarget creates a ValidationTarget for the commits a repository receives,
+// with the ref updates read from stdin as passed to the pre-receive hook.
+func NewPreReceiveTarget() ValidationTarget {
+	return ValidationTarget{Type: "pre-receive"}
+}
+
+// NewStagedAmendTarget creates a ValidationTarget for the commit git commit --amend would
+// create from HEAD and the staged changes, with message or, when it is empty, the message
+// of HEAD.
+func NewStagedAmendTarget(message string) (ValidationTarget, error) {
+	if strings.Contains(message, "\x00") {
+		return ValidationTarget{}, errors.New("amend message contains null bytes")
+	}
+
+	return ValidationTarget{Type: "staged-amend", Source: message}, nil
+}
+
+// WithRangeOptions returns a copy of the target that starts a range at the merge base
+// of its ends and follows only first parents, as a pull request diff is defined.
+// The options apply to range and count targets only.
+func (t ValidationTarget) WithRangeOptions(mergeBase, firstParent bool) (ValidationTarget, error) {
+	if mergeBase && !t.IsRange() {
+		return ValidationTarget{}, errors.New("--merge-base requires --range or --base-branch")
+	}
+
+	if firstParent && !t.IsRange() && !t.IsCount() {
+		return ValidationTarget{}, errors.New("--first-parent requires --range, --base-branch or --count")
+	}
+
+	t.MergeBase = t.MergeBase || mergeBase
+	t.FirstParent = firstParent
+
+	return t, nil
+}
+
+// WithSummaryOnly returns a new target whose range validation keeps only the counts of
+// the report, as --summary prints nothing else.
+func (t ValidationTarget) WithSummaryOnly(summaryOnly bool) ValidationTarget {
+	t.SummaryOnly = summaryOnly
+
+	return t
+}
+
+// WithCommitSink returns a new target whose range validation passes the report of each
+// commit to sink as it is validated, oldest first where the repository can stream so,
+// instead of keeping it. Commits validated otherwise stay in the report.
+func (t ValidationTarget) WithCommitSink(sink func(domain.CommitReport)) ValidationTarget {
+	t.CommitSink = sink
+
+	return t
+}
+
+// NewPullRequestTarget creates a ValidationTarget for the commits of a hosted pull request,
+// given as owner/repo#number.
+func NewPullRequestTarget(pullRequest string) (ValidationTarget, error) {
+	if err := validateParameterLength("Pull request", pullRequest, MaxRefLength); err != nil {
+		return ValidationTarget{}, err
+	}
+
+	if strings.Contains(pullRequest, "\x00") {
+		return ValidationTarget{}, errors.New("pull request contains null bytes")
+	}
+
+	return ValidationTarget{Type: "pull-request", Source: pullRequest}, nil
+}
+
+// NewPatchTarget creates a ValidationTarget for the patches of an mbox file, as written
+// by git format-patch, or of standard input for "-".
+func NewPatchTarget(path string) (ValidationTarget, error) {
+	if err := validateFilePath(path); err != nil {
+		return ValidationTarget{}, fmt.Errorf("invalid patch file: %w", err)
+	}
+
+	return ValidationTarget{Type: "patch", Source: path}, nil
+}
+
+// NewPatchworkTarget creates a ValidationTarget for the patches of a series on a
+// Patchwork instance, given as the URL of the series.
+func NewPatchworkTarget(series string) (ValidationTarget, error) {
+	if err := validateParameterLength("Series", series, MaxPathLength); err != nil {
+		return ValidationTarget{}, err
+	}
+
+	if strings.Contains(series, "\x00") {
+		return ValidationTarget{}, errors.New("series contains null bytes")
+	}
+
+	return ValidationTarget{Type: "patchwork", Source: series}, nil
+}
+
 // validateInputs validates all inputs.
 func validateInputs(messageFile, gitReference, commitRange, baseBranch string, commitCount int) error {
 	if err := validateFilePath(messageFile); err != nil {
@@ -151,6 +265,36 @@ func (t ValidationTarget) IsCount() bool {
 	return t.Type == "count"
 }
 
+// IsPush returns true if target is the commits of a push.
+func (t ValidationTarget) IsPush() bool {
+	return t.Type == "push"
+}
+
+// IsPreReceive returns true if target is the commits a repository receives in a push.
+func (t ValidationTarget) IsPreReceive() bool {
+	return t.Type == "pre-receive"
+}
+
+// IsStagedAmend returns true if target is the commit amending HEAD would create.
+func (t ValidationTarget) IsStagedAmend() bool {
+	return t.Type == "staged-amend"
+}
+
+// IsPullRequest returns true if target is the commits of a hosted pull request.
+func (t ValidationTarget) IsPullRequest() bool {
+	return t.Type == "pull-request"
+}
+
+// IsPatch returns true if target is the patches of an mbox file.
+func (t ValidationTarget) IsPatch() bool {
+	return t.Type == "patch"
+}
+
+// IsPatchwork returns true if target is the patches of a Patchwork series.
+func (t ValidationTarget) IsPatchwork() bool {
+	return t.Type == "patchwork"
+}
+
 // Input validation constraints.
 const (
 	// MaxPathLength is the maximum allowed length for file paths.
@@ -742,3 +742,79 @@ func TestValidationTarget_EdgeCases(t *testing.T) {
 		})
 	}
 }
+
+func TestValidationTarget_WithRangeOptions(t *testing.T) {
+	baseBranch, err := NewValidationTarget("", "", "", "main", 1)
+	require.NoError(t, err)
+	require.True(t, baseBranch.MergeBase, "base branch ranges start at the merge base")
+
+	commitRange, err := NewValidationTarget("", "", "main..feature", "", 1)
+	require.NoError(t, err)
+	require.False(t, commitRange.MergeBase)
+
+	count, err := NewValidationTarget("", "", "", "", 3)
+	require.NoError(t, err)
+
+	commit, err := NewValidationTarget("", "HEAD", "", "", 1)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name        string
+		target      ValidationTarget
+		mergeBase   bool
+		firstParent bool
+		expected    ValidationTarget
+		expectError string
+	}{
+		{
+			name:     "no options",
+			target:   commit,
+			expected: commit,
+		},
+		{
+			name:        "base branch keeps merge base",
+			target:      baseBranch,
+			firstParent: true,
+			expected:    ValidationTarget{Type: "range", Source: "main", Target: "HEAD", MergeBase: true, FirstParent: true},
+		},
+		{
+			name:      "range with merge base",
+			target:    commitRange,
+			mergeBase: true,
+			expected:  ValidationTarget{Type: "range", Source: "main", Target: "feature", MergeBase: true},
+		},
+		{
+			name:        "count with first parent",
+			target:      count,
+			firstParent: true,
+			expected:    ValidationTarget{Type: "count", Source: "3", FirstParent: true},
+		},
+		{
+			name:        "merge base needs a range",
+			target:      count,
+			mergeBase:   true,
+			expectError: "--merge-base requires --range or --base-branch",
+		},
+		{
+			name:        "first parent needs several commits",
+			target:      commit,
+			firstParent: true,
+			expectError: "--first-parent requires --range, --base-branch or --count",
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			target, err := testCase.target.WithRangeOptions(testCase.mergeBase, testCase.firstParent)
+
+			if testCase.expectError != "" {
+				require.EqualError(t, err, testCase.expectError)
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, testCase.expected, target)
+		})
+	}
+}
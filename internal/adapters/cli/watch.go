@@ -0,0 +1,68 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+)
+
+// DefaultWatchInterval is how often a watched message file is checked for changes.
+const DefaultWatchInterval = 250 * time.Millisecond
+
+// MessageChangeFunc is called with the new content of a watched message file.
+// exists is false when the file was removed or has not been created yet.
+type MessageChangeFunc func(message string, exists bool) error
+
+// WatchMessageFile polls filePath every interval and calls onChange with its content
+// when it first is read and whenever it changes, until ctx is done.
+// Polling compares file content rather than modification times, so editors that
+// save several times within the file system's timestamp resolution are not missed.
+// An error returned by onChange stops watching and is returned.
+func WatchMessageFile(ctx context.Context, filePath string, interval time.Duration, onChange MessageChangeFunc) error {
+	if interval <= 0 {
+		interval = DefaultWatchInterval
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	first := true
+
+	var (
+		lastMessage string
+		lastExists  bool
+	)
+
+	for {
+		content, err := os.ReadFile(filePath)
+		exists := err == nil
+
+		if err != nil && !errors.Is(err, os.ErrNotExist) {
+			return fmt.Errorf("failed to read message file: %w", err)
+		}
+
+		message := string(content)
+
+		if first || exists != lastExists || message != lastMessage {
+			if err := onChange(message, exists); err != nil {
+				return err
+			}
+
+			first = false
+			lastMessage = message
+			lastExists = exists
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
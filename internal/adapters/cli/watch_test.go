@@ -0,0 +1,71 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package cli
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWatchMessageFile(t *testing.T) {
+	filePath := filepath.Join(t.TempDir(), "COMMIT_EDITMSG")
+
+	type change struct {
+		message string
+		exists  bool
+	}
+
+	// Each step edits the file after the previous change was seen
+	steps := []func(){
+		func() { require.NoError(t, os.WriteFile(filePath, []byte("Add parser"), 0600)) },
+		func() { require.NoError(t, os.WriteFile(filePath, []byte("feat: add parser"), 0600)) },
+		func() { require.NoError(t, os.Remove(filePath)) },
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	var changes []change
+
+	err := WatchMessageFile(ctx, filePath, time.Millisecond, func(message string, exists bool) error {
+		changes = append(changes, change{message: message, exists: exists})
+
+		if len(changes) > len(steps) {
+			cancel()
+
+			return nil
+		}
+
+		steps[len(changes)-1]()
+
+		return nil
+	})
+	require.NoError(t, err)
+	require.ErrorIs(t, ctx.Err(), context.Canceled, "watch should stop when cancelled, not time out")
+	require.Equal(t, []change{
+		{message: "", exists: false},
+		{message: "Add parser", exists: true},
+		{message: "feat: add parser", exists: true},
+		{message: "", exists: false},
+	}, changes)
+}
+
+func TestWatchMessageFile_CallbackError(t *testing.T) {
+	filePath := filepath.Join(t.TempDir(), "COMMIT_EDITMSG")
+	require.NoError(t, os.WriteFile(filePath, []byte("Add parser"), 0600))
+
+	stop := errors.New("stop")
+
+	err := WatchMessageFile(context.Background(), filePath, time.Millisecond, func(string, bool) error {
+		return stop
+	})
+	require.ErrorIs(t, err, stop)
+}
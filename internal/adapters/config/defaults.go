@@ -14,9 +14,23 @@ func NewConfigWithDefaults() config.Config {
 
 	// Apply default disabled rules for this application
 	cfg.Rules.Disabled = []string{
-		"jirareference", // JIRAReference rule is disabled by default as it's organization-specific
-		"commitbody",    // CommitBody rule is disabled by default as not all projects require detailed bodies
-		"spell",         // Spell checking disabled by default (requires additional setup)
+		"jirareference",  // JIRAReference rule is disabled by default as it's organization-specific
+		"commitbody",     // CommitBody rule is disabled by default as not all projects require detailed bodies
+		"spell",          // Spell checking disabled by default (requires additional setup)
+		"trailers",       // Trailers rule is disabled by default as trailer conventions differ between projects
+		"review",         // Review rule is disabled by default as it needs protected branches configured
+		"characters",     // Characters rule is disabled by default as some projects write in other scripts
+		"links",          // Links rule is disabled by default as link policies differ between projects
+		"issuereference", // IssueReference rule is disabled by default as it calls the GitHub API
+		"branchticket",   // BranchTicket rule is disabled by default as branch naming conventions differ between teams
+		"subjectpattern", // SubjectPattern rule is disabled by default as it needs a pattern
+		"breakingchange", // BreakingChange rule is disabled by default as release policies differ between projects
+		"revert",         // Revert rule is disabled by default as revert conventions differ between projects
+		"fixup",          // Fixup rule is disabled by default as some teams push fixup commits for review
+		"forcepush",      // ForcePush rule is disabled by default as rewriting feature branches is common
+		"commitsize",     // CommitSize rule is disabled by default as generated and vendored changes are large
+		"cla",            // CLA rule is disabled by default as it needs a contributors file or CLA service
+		"changeid",       // ChangeId rule is disabled by default as only Gerrit projects use Change-Id trailers
 	}
 
 	return cfg
@@ -21,7 +21,7 @@ func TestConfigWithDefaults_Creation(t *testing.T) {
 	require.Equal(t, 72, cfg.Message.Subject.MaxLength)
 
 	// Verify application-specific defaults
-	expectedDisabled := []string{"jirareference", "commitbody", "spell"}
+	expectedDisabled := []string{"jirareference", "commitbody", "spell", "trailers", "review", "characters", "links", "issuereference", "branchticket", "subjectpattern", "breakingchange", "revert", "fixup", "forcepush", "commitsize", "cla", "changeid"}
 	require.Equal(t, expectedDisabled, cfg.Rules.Disabled)
 }
 
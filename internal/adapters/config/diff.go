@@ -0,0 +1,62 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package config
+
+import (
+	"reflect"
+	"sort"
+	"strings"
+
+	configTypes "github.com/itiquette/gommitlint/internal/domain/config"
+)
+
+// ChangedKeys returns the dotted configuration keys, such as message.subject.max_length,
+// whose values differ between previous and current, sorted. Lists are compared as a
+// whole and maps, such as rules.conditions, by key.
+func ChangedKeys(previous, current configTypes.Config) []string {
+	var changed []string
+
+	diffValues(reflect.ValueOf(previous), reflect.ValueOf(current), "", &changed)
+	sort.Strings(changed)
+
+	return changed
+}
+
+// diffValues adds the keys at or below key whose values differ between previous and current.
+func diffValues(previous, current reflect.Value, key string, changed *[]string) {
+	switch {
+	case previous.Kind() == reflect.Struct:
+		valueType := previous.Type()
+
+		for i := range valueType.NumField() {
+			structField := valueType.Field(i)
+			if !structField.IsExported() {
+				continue
+			}
+
+			name := strings.Split(structField.Tag.Get("yaml"), ",")[0]
+			diffValues(previous.Field(i), current.Field(i), joinKey(key, name), changed)
+		}
+	case previous.Kind() == reflect.Map && previous.Type().Key().Kind() == reflect.String:
+		names := make(map[string]reflect.Value)
+		for _, mapKey := range append(previous.MapKeys(), current.MapKeys()...) {
+			names[mapKey.String()] = mapKey
+		}
+
+		for name, mapKey := range names {
+			previousValue, currentValue := previous.MapIndex(mapKey), current.MapIndex(mapKey)
+
+			if !previousValue.IsValid() || !currentValue.IsValid() {
+				*changed = append(*changed, joinKey(key, name))
+
+				continue
+			}
+
+			diffValues(previousValue, currentValue, joinKey(key, name), changed)
+		}
+	case !reflect.DeepEqual(previous.Interface(), current.Interface()):
+		*changed = append(*changed, key)
+	}
+}
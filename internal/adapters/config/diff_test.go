@@ -0,0 +1,39 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	configTypes "github.com/itiquette/gommitlint/internal/domain/config"
+)
+
+func TestChangedKeys(t *testing.T) {
+	previous := LoadDefaultConfig()
+	previous.Rules.Conditions = map[string]configTypes.RuleCondition{
+		"signature": {Branches: []string{"main"}},
+	}
+
+	require.Empty(t, ChangedKeys(previous, previous))
+
+	current := previous
+	current.Message.Subject.MaxLength = previous.Message.Subject.MaxLength + 10
+	current.Rules.Enabled = append([]string{"spell"}, previous.Rules.Enabled...)
+	current.Rules.Conditions = map[string]configTypes.RuleCondition{
+		"signature": {Branches: []string{"main", "release/*"}},
+		"review":    {Branches: []string{"main"}},
+	}
+	current.Plugins = []configTypes.PluginConfig{{Name: "ticket-lookup", Command: "lookup"}}
+
+	require.Equal(t, []string{
+		"message.subject.max_length",
+		"plugins",
+		"rules.conditions.review",
+		"rules.conditions.signature.branches",
+		"rules.enabled",
+	}, ChangedKeys(previous, current))
+}
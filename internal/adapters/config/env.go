@@ -0,0 +1,176 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package config
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	configTypes "github.com/itiquette/gommitlint/internal/domain/config"
+)
+
+// EnvPrefix is the prefix shared by all configuration environment variables.
+const EnvPrefix = "GOMMITLINT"
+
+// EnvVariable describes an environment variable mapped to a configuration key.
+type EnvVariable struct {
+	Name string // Environment variable name, e.g. GOMMITLINT_MESSAGE_SUBJECT_MAXLENGTH
+	Key  string // Configuration key, e.g. message.subject.max_length
+	Type string // Value type: string, int, bool or list
+}
+
+// LookupFunc looks up an environment variable, matching the signature of os.LookupEnv.
+type LookupFunc func(key string) (string, bool)
+
+// EnvVariables returns every supported environment variable.
+// The mapping is derived from the configuration structure, so new
+// configuration keys are available as environment variables automatically.
+func EnvVariables() []EnvVariable {
+	var variables []EnvVariable
+
+	cfg := configTypes.Config{}
+	_ = walkConfigFields(reflect.ValueOf(&cfg).Elem(), EnvPrefix, "", func(_ reflect.Value, variable EnvVariable) error {
+		variables = append(variables, variable)
+
+		return nil
+	})
+
+	return variables
+}
+
+// ApplyEnvOverrides returns a copy of cfg with values from GOMMITLINT_* environment variables applied.
+// Environment values replace file values entirely, including false, zero and empty list values.
+// List values are comma separated.
+func ApplyEnvOverrides(cfg configTypes.Config, lookup LookupFunc) (configTypes.Config, error) {
+	result := cfg
+	rulesChanged := false
+
+	err := walkConfigFields(reflect.ValueOf(&result).Elem(), EnvPrefix, "", func(field reflect.Value, variable EnvVariable) error {
+		value, ok := lookup(variable.Name)
+		if !ok {
+			return nil
+		}
+
+		if err := setFieldFromEnv(field, value); err != nil {
+			return fmt.Errorf("invalid value for %s: %w", variable.Name, err)
+		}
+
+		if strings.HasPrefix(variable.Key, "rules.") {
+			rulesChanged = true
+		}
+
+		return nil
+	})
+	if err != nil {
+		return configTypes.Config{}, err
+	}
+
+	if rulesChanged {
+		result = applyRulePriority(result)
+	}
+
+	return result, nil
+}
+
+// walkConfigFields visits every leaf field of a configuration struct in declaration order.
+func walkConfigFields(value reflect.Value, namePrefix, keyPrefix string, visit func(reflect.Value, EnvVariable) error) error {
+	valueType := value.Type()
+
+	for i := range valueType.NumField() {
+		structField := valueType.Field(i)
+		if !structField.IsExported() {
+			continue
+		}
+
+		name := namePrefix + "_" + strings.ToUpper(structField.Name)
+
+		key := strings.Split(structField.Tag.Get("yaml"), ",")[0]
+		if keyPrefix != "" {
+			key = keyPrefix + "." + key
+		}
+
+		field := value.Field(i)
+
+		if field.Kind() == reflect.Struct {
+			if err := walkConfigFields(field, name, key, visit); err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		fieldType := envValueType(field)
+		if fieldType == "" {
+			continue
+		}
+
+		if err := visit(field, EnvVariable{Name: name, Key: key, Type: fieldType}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// envValueType returns the documented value type of a field, or empty if unsupported.
+func envValueType(field reflect.Value) string {
+	switch field.Kind() {
+	case reflect.String:
+		return "string"
+	case reflect.Int:
+		return "int"
+	case reflect.Bool:
+		return "bool"
+	case reflect.Slice:
+		if field.Type().Elem().Kind() == reflect.String {
+			return "list"
+		}
+	}
+
+	return ""
+}
+
+// setFieldFromEnv parses value according to the field kind and assigns it.
+func setFieldFromEnv(field reflect.Value, value string) error {
+	switch field.Kind() {
+	case reflect.String:
+		field.SetString(value)
+	case reflect.Int:
+		parsed, err := strconv.Atoi(strings.TrimSpace(value))
+		if err != nil {
+			return fmt.Errorf("expected integer: %w", err)
+		}
+
+		field.SetInt(int64(parsed))
+	case reflect.Bool:
+		parsed, err := strconv.ParseBool(strings.TrimSpace(value))
+		if err != nil {
+			return fmt.Errorf("expected boolean: %w", err)
+		}
+
+		field.SetBool(parsed)
+	case reflect.Slice:
+		field.Set(reflect.ValueOf(splitEnvList(value)))
+	default:
+		return fmt.Errorf("unsupported field type %s", field.Kind())
+	}
+
+	return nil
+}
+
+// splitEnvList splits a comma separated list, trimming whitespace and dropping empty items.
+func splitEnvList(value string) []string {
+	items := []string{}
+
+	for _, item := range strings.Split(value, ",") {
+		if trimmed := strings.TrimSpace(item); trimmed != "" {
+			items = append(items, trimmed)
+		}
+	}
+
+	return items
+}
@@ -0,0 +1,157 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	configTypes "github.com/itiquette/gommitlint/internal/domain/config"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEnvVariables(t *testing.T) {
+	variables := EnvVariables()
+
+	byName := make(map[string]EnvVariable, len(variables))
+	for _, variable := range variables {
+		byName[variable.Name] = variable
+	}
+
+	tests := []struct {
+		name        string
+		expectedKey string
+		expectedTyp string
+	}{
+		{name: "GOMMITLINT_MESSAGE_SUBJECT_MAXLENGTH", expectedKey: "message.subject.max_length", expectedTyp: "int"},
+		{name: "GOMMITLINT_MESSAGE_BODY_ALLOWSIGNOFFONLY", expectedKey: "message.body.allow_signoff_only", expectedTyp: "bool"},
+		{name: "GOMMITLINT_CONVENTIONAL_TYPES", expectedKey: "conventional.types", expectedTyp: "list"},
+		{name: "GOMMITLINT_SPELL_LOCALE", expectedKey: "spell.locale", expectedTyp: "string"},
+		{name: "GOMMITLINT_OUTPUT", expectedKey: "output", expectedTyp: "string"},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			variable, ok := byName[testCase.name]
+			require.True(t, ok, "variable should be derived from config")
+			require.Equal(t, testCase.expectedKey, variable.Key)
+			require.Equal(t, testCase.expectedTyp, variable.Type)
+		})
+	}
+}
+
+func TestApplyEnvOverrides(t *testing.T) {
+	tests := []struct {
+		name        string
+		env         map[string]string
+		expectError string
+		check       func(t *testing.T, cfg configTypes.Config)
+	}{
+		{
+			name: "no variables keeps config",
+			env:  map[string]string{},
+			check: func(t *testing.T, cfg configTypes.Config) {
+				t.Helper()
+				require.Equal(t, NewConfigWithDefaults(), cfg)
+			},
+		},
+		{
+			name: "scalar values",
+			env: map[string]string{
+				"GOMMITLINT_MESSAGE_SUBJECT_MAXLENGTH":         "72",
+				"GOMMITLINT_MESSAGE_SUBJECT_CASE":              "upper",
+				"GOMMITLINT_MESSAGE_SUBJECT_REQUIREIMPERATIVE": "false",
+			},
+			check: func(t *testing.T, cfg configTypes.Config) {
+				t.Helper()
+				require.Equal(t, 72, cfg.Message.Subject.MaxLength)
+				require.Equal(t, "upper", cfg.Message.Subject.Case)
+				require.False(t, cfg.Message.Subject.RequireImperative)
+			},
+		},
+		{
+			name: "list values are comma separated",
+			env: map[string]string{
+				"GOMMITLINT_CONVENTIONAL_TYPES":     "feat, fix ,,docs",
+				"GOMMITLINT_JIRA_PROJECTPREFIXES":   "",
+				"GOMMITLINT_SIGNATURE_KEYDIRECTORY": "/keys",
+			},
+			check: func(t *testing.T, cfg configTypes.Config) {
+				t.Helper()
+				require.Equal(t, []string{"feat", "fix", "docs"}, cfg.Conventional.Types)
+				require.Empty(t, cfg.Jira.ProjectPrefixes)
+				require.Equal(t, "/keys", cfg.Signature.KeyDirectory)
+			},
+		},
+		{
+			name: "disabled rules take priority",
+			env: map[string]string{
+				"GOMMITLINT_RULES_ENABLED":  "spell,commitbody",
+				"GOMMITLINT_RULES_DISABLED": "spell",
+			},
+			check: func(t *testing.T, cfg configTypes.Config) {
+				t.Helper()
+				require.Equal(t, []string{"commitbody"}, cfg.Rules.Enabled)
+			},
+		},
+		{
+			name:        "invalid integer",
+			env:         map[string]string{"GOMMITLINT_MESSAGE_SUBJECT_MAXLENGTH": "long"},
+			expectError: "GOMMITLINT_MESSAGE_SUBJECT_MAXLENGTH",
+		},
+		{
+			name:        "invalid boolean",
+			env:         map[string]string{"GOMMITLINT_SIGNATURE_REQUIRED": "maybe"},
+			expectError: "GOMMITLINT_SIGNATURE_REQUIRED",
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			lookup := func(key string) (string, bool) {
+				value, ok := testCase.env[key]
+
+				return value, ok
+			}
+
+			cfg, err := ApplyEnvOverrides(NewConfigWithDefaults(), lookup)
+			if testCase.expectError != "" {
+				require.ErrorContains(t, err, testCase.expectError)
+
+				return
+			}
+
+			require.NoError(t, err)
+			testCase.check(t, cfg)
+		})
+	}
+}
+
+func TestLoadConfigFromPath_EnvironmentPrecedence(t *testing.T) {
+	tmpDir := t.TempDir()
+	configFile := filepath.Join(tmpDir, "test.yaml")
+	configContent := `gommitlint:
+  output: json
+  message:
+    subject:
+      max_length: 60
+      require_imperative: true`
+	require.NoError(t, os.WriteFile(configFile, []byte(configContent), 0600))
+
+	t.Setenv("GOMMITLINT_MESSAGE_SUBJECT_MAXLENGTH", "72")
+	t.Setenv("GOMMITLINT_MESSAGE_SUBJECT_REQUIREIMPERATIVE", "false")
+
+	cfg, err := LoadConfigFromPath(configFile)
+	require.NoError(t, err)
+	require.Equal(t, "json", cfg.Output)
+	require.Equal(t, 72, cfg.Message.Subject.MaxLength)
+	require.False(t, cfg.Message.Subject.RequireImperative)
+
+	t.Setenv("GOMMITLINT_OUTPUT", "unknown")
+
+	_, err = LoadConfigFromPath(configFile)
+	require.Error(t, err)
+}
@@ -0,0 +1,115 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package config
+
+import (
+	"fmt"
+	"path/filepath"
+	"slices"
+	"strings"
+)
+
+// includeKey lists, in the gommitlint section of a configuration file, the files loaded
+// before it, such as the shared settings of an organization.
+const includeKey = "include"
+
+// WithIncludes returns configPaths with the files each of them includes placed before
+// it, lowest precedence first, so that a file overrides the settings it includes.
+// Included files may include others. Paths are resolved from the directory of the
+// including file. A file included several times is loaded at its first place only.
+// Files that don't exist are left as they are, as loading skips them; an included
+// file that doesn't exist or a file including itself is an error.
+func WithIncludes(configPaths []string) ([]string, error) {
+	var result []string
+
+	for _, configPath := range configPaths {
+		var err error
+
+		result, err = appendWithIncludes(result, configPath, nil)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+// appendWithIncludes appends the files configPath includes and then configPath itself
+// to files, unless they are there already. including holds the files whose includes are
+// being resolved, to detect cycles.
+func appendWithIncludes(files []string, configPath string, including []string) ([]string, error) {
+	if slices.Contains(including, configPath) {
+		return nil, fmt.Errorf("configuration file %s includes itself through %s", configPath, strings.Join(including, " -> "))
+	}
+
+	if configPath == "" || slices.Contains(files, configPath) || !fileExists(configPath) {
+		return appendOnce(files, configPath), nil
+	}
+
+	includes, err := fileIncludes(configPath)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, include := range includes {
+		if !fileExists(include) {
+			return nil, fmt.Errorf("configuration file %s included by %s not found", include, configPath)
+		}
+
+		files, err = appendWithIncludes(files, include, append(slices.Clone(including), configPath))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return appendOnce(files, configPath), nil
+}
+
+// fileIncludes returns the files configPath includes, resolved from its directory.
+// A file that cannot be parsed includes nothing; loading skips it.
+func fileIncludes(configPath string) ([]string, error) {
+	raw, err := readRawConfig(configPath)
+	if err != nil {
+		return nil, nil //nolint:nilerr // Unparsable files are skipped when loading
+	}
+
+	root, _ := raw[rootKey].(map[string]interface{})
+
+	value, found := root[includeKey]
+	if !found {
+		return nil, nil
+	}
+
+	items, ok := value.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("%s: %s must be a list of files", configPath, includeKey)
+	}
+
+	includes := make([]string, 0, len(items))
+
+	for _, item := range items {
+		include, ok := item.(string)
+		if !ok || include == "" {
+			return nil, fmt.Errorf("%s: %s must be a list of files", configPath, includeKey)
+		}
+
+		if !filepath.IsAbs(include) {
+			include = filepath.Join(filepath.Dir(configPath), include)
+		}
+
+		includes = append(includes, filepath.Clean(include))
+	}
+
+	return includes, nil
+}
+
+// appendOnce appends configPath to files unless it is empty or there already.
+func appendOnce(files []string, configPath string) []string {
+	if configPath == "" || slices.Contains(files, configPath) {
+		return files
+	}
+
+	return append(files, configPath)
+}
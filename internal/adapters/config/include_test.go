@@ -0,0 +1,116 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// writeConfigFiles writes files, keyed by path relative to a temporary directory, and
+// returns the directory.
+func writeConfigFiles(t *testing.T, files map[string]string) string {
+	t.Helper()
+
+	dir := t.TempDir()
+
+	for name, content := range files {
+		path := filepath.Join(dir, name)
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+		require.NoError(t, os.WriteFile(path, []byte(content), 0600))
+	}
+
+	return dir
+}
+
+func TestWithIncludes(t *testing.T) {
+	dir := writeConfigFiles(t, map[string]string{
+		"shared/base.yaml":      "gommitlint:\n  output: json\n",
+		"shared/team.yaml":      "gommitlint:\n  include: [base.yaml]\n",
+		"repo/.gommitlint.yaml": "gommitlint:\n  include: [../shared/team.yaml, ../shared/base.yaml]\n",
+	})
+
+	files, err := WithIncludes([]string{filepath.Join(dir, "repo", ".gommitlint.yaml"), filepath.Join(dir, "missing.yaml")})
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		filepath.Join(dir, "shared", "base.yaml"),
+		filepath.Join(dir, "shared", "team.yaml"),
+		filepath.Join(dir, "repo", ".gommitlint.yaml"),
+		filepath.Join(dir, "missing.yaml"),
+	}, files, "includes come first, each file once; files that don't exist are kept for loading to skip")
+
+	files, err = WithIncludes(files)
+	require.NoError(t, err)
+	require.Len(t, files, 4, "expanding again changes nothing")
+}
+
+func TestWithIncludes_Errors(t *testing.T) {
+	dir := writeConfigFiles(t, map[string]string{
+		"a.yaml":       "gommitlint:\n  include: [b.yaml]\n",
+		"b.yaml":       "gommitlint:\n  include: [a.yaml]\n",
+		"missing.yaml": "gommitlint:\n  include: [nowhere.yaml]\n",
+		"scalar.yaml":  "gommitlint:\n  include: b.yaml\n",
+	})
+
+	_, err := WithIncludes([]string{filepath.Join(dir, "a.yaml")})
+	require.ErrorContains(t, err, "includes itself")
+
+	_, err = WithIncludes([]string{filepath.Join(dir, "missing.yaml")})
+	require.ErrorContains(t, err, "nowhere.yaml included by")
+
+	_, err = WithIncludes([]string{filepath.Join(dir, "scalar.yaml")})
+	require.ErrorContains(t, err, "include must be a list of files")
+}
+
+func TestLoadConfigFromPath_Includes(t *testing.T) {
+	dir := writeConfigFiles(t, map[string]string{
+		"shared.toml": "[gommitlint.message.subject]\nmax_length = 60\ncase = \"upper\"\n",
+		".gommitlint.yaml": `gommitlint:
+  include: [shared.toml]
+  message:
+    subject:
+      max_length: 72`,
+	})
+
+	cfg, err := LoadConfigFromPath(filepath.Join(dir, ".gommitlint.yaml"))
+	require.NoError(t, err)
+	require.Equal(t, 72, cfg.Message.Subject.MaxLength, "the including file overrides what it includes")
+	require.Equal(t, "upper", cfg.Message.Subject.Case)
+
+	_, err = LoadConfigFromPath(filepath.Join(writeConfigFiles(t, map[string]string{
+		".gommitlint.yaml": "gommitlint:\n  include: [nowhere.yaml]\n",
+	}), ".gommitlint.yaml"))
+	require.ErrorContains(t, err, "not found")
+}
+
+func TestLoadConfigFromPath_Anchors(t *testing.T) {
+	dir := writeConfigFiles(t, map[string]string{
+		".gommitlint.yaml": `x-strict-subject: &strict-subject
+  max_length: 50
+  case: lower
+gommitlint:
+  message:
+    subject: *strict-subject
+  profiles:
+    release:
+      message:
+        subject:
+          <<: *strict-subject
+          max_length: 72`,
+	})
+
+	cfg, err := LoadConfigFromPath(filepath.Join(dir, ".gommitlint.yaml"))
+	require.NoError(t, err)
+	require.Equal(t, 50, cfg.Message.Subject.MaxLength)
+	require.Equal(t, "lower", cfg.Message.Subject.Case)
+
+	cfg, err = LoadProfileConfigFromPath(filepath.Join(dir, ".gommitlint.yaml"), "release")
+	require.NoError(t, err)
+	require.Equal(t, 72, cfg.Message.Subject.MaxLength)
+	require.Equal(t, "lower", cfg.Message.Subject.Case)
+}
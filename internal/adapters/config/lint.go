@@ -0,0 +1,520 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package config
+
+import (
+	"fmt"
+	"maps"
+	"os"
+	"path/filepath"
+	"reflect"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/itiquette/gommitlint/internal/domain"
+	configTypes "github.com/itiquette/gommitlint/internal/domain/config"
+	"github.com/knadh/koanf/parsers/toml"
+	"github.com/knadh/koanf/parsers/yaml"
+)
+
+// rootKey is the top-level key wrapping all gommitlint settings in a configuration file.
+const rootKey = "gommitlint"
+
+// anchorKeyPrefix starts the top-level keys that hold YAML anchors rather than settings.
+const anchorKeyPrefix = "x-"
+
+// Issue describes a problem found while linting a configuration file.
+type Issue struct {
+	File       string `json:"file,omitempty"` // Set when the configuration comes from several files
+	Key        string `json:"key"`
+	Message    string `json:"message"`
+	Suggestion string `json:"suggestion,omitempty"`
+}
+
+// String formats the issue for display.
+func (i Issue) String() string {
+	message := i.Message
+	if i.Key != "" {
+		message = i.Key + ": " + message
+	}
+
+	if i.Suggestion != "" {
+		message += fmt.Sprintf(" (did you mean %q?)", i.Suggestion)
+	}
+
+	if i.File != "" {
+		message = i.File + ": " + message
+	}
+
+	return message
+}
+
+// LintFile checks a configuration file for unknown keys, values of the wrong type
+// and conflicting rule settings. Rule names are checked against knownRules when given.
+// An error is returned only if the file cannot be read or parsed.
+func LintFile(configPath string, knownRules []string) ([]Issue, error) {
+	raw, err := readRawConfig(configPath)
+	if err != nil {
+		return nil, err
+	}
+
+	return lintRaw(raw, knownRules), nil
+}
+
+// readRawConfig parses a configuration file into an untyped document,
+// choosing the parser by file extension like LoadFileConfig does.
+func readRawConfig(configPath string) (map[string]interface{}, error) {
+	content, err := os.ReadFile(configPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read config file: %w", err)
+	}
+
+	var raw map[string]interface{}
+
+	switch strings.ToLower(filepath.Ext(configPath)) {
+	case ".toml":
+		raw, err = toml.Parser().Unmarshal(content)
+	default:
+		raw, err = yaml.Parser().Unmarshal(content)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse config file: %w", err)
+	}
+
+	return raw, nil
+}
+
+// lintRaw lints a parsed configuration document.
+func lintRaw(raw map[string]interface{}, knownRules []string) []Issue {
+	var issues []Issue
+
+	root, hasRoot := raw[rootKey]
+
+	for _, key := range sortedKeys(raw) {
+		// Keys named x-... hold the YAML anchors the configuration refers to
+		if key != rootKey && !strings.HasPrefix(key, anchorKeyPrefix) {
+			issues = append(issues, unknownKeyIssue(key, key, []string{rootKey}))
+		}
+	}
+
+	if !hasRoot {
+		return append(issues, Issue{Message: "missing top-level '" + rootKey + "' key"})
+	}
+
+	section, ok := root.(map[string]interface{})
+	if !ok {
+		return append(issues, Issue{Key: rootKey, Message: "expected a section"})
+	}
+
+	section, issues = lintIncludeAndShorthand(section, issues)
+
+	issues = append(issues, lintSection(section, reflect.TypeOf(configTypes.Config{}), "")...)
+	issues = append(issues, lintRules(section, knownRules)...)
+	issues = append(issues, lintRevert(section, knownRules)...)
+
+	return issues
+}
+
+// lintIncludeAndShorthand checks the include list of the root section and expands the
+// rules shorthand of the root section and of each profile, returning the section to lint
+// as written in full.
+func lintIncludeAndShorthand(section map[string]interface{}, issues []Issue) (map[string]interface{}, []Issue) {
+	section = maps.Clone(section)
+
+	if value, found := section[includeKey]; found {
+		if message := checkValueType(value, reflect.TypeOf([]string{})); message != "" {
+			issues = append(issues, Issue{Key: includeKey, Message: message})
+		}
+
+		delete(section, includeKey)
+	}
+
+	if rulesSection, ok := section["rules"].(map[string]interface{}); ok {
+		var shorthandIssues []Issue
+
+		section["rules"], shorthandIssues = expandRuleShorthand(rulesSection)
+		issues = append(issues, shorthandIssues...)
+	}
+
+	profiles, ok := section["profiles"].(map[string]interface{})
+	if !ok {
+		return section, issues
+	}
+
+	profiles = maps.Clone(profiles)
+
+	for _, name := range sortedKeys(profiles) {
+		profile, ok := profiles[name].(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		rulesSection, ok := profile["rules"].(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		expanded, shorthandIssues := expandRuleShorthand(rulesSection)
+		for _, issue := range shorthandIssues {
+			issue.Key = joinKey("profiles."+name, issue.Key)
+			issues = append(issues, issue)
+		}
+
+		profile = maps.Clone(profile)
+		profile["rules"] = expanded
+		profiles[name] = profile
+	}
+
+	section["profiles"] = profiles
+
+	return section, issues
+}
+
+// lintSection checks the keys and values of a section against the struct type describing it.
+func lintSection(section map[string]interface{}, sectionType reflect.Type, prefix string) []Issue {
+	fields := make(map[string]reflect.Type, sectionType.NumField())
+	names := make([]string, 0, sectionType.NumField())
+
+	for i := range sectionType.NumField() {
+		field := sectionType.Field(i)
+
+		name := strings.Split(field.Tag.Get("yaml"), ",")[0]
+		if name == "" || name == "-" {
+			continue
+		}
+
+		fields[name] = field.Type
+		names = append(names, name)
+	}
+
+	var issues []Issue
+
+	for _, key := range sortedKeys(section) {
+		path := joinKey(prefix, key)
+
+		fieldType, known := fields[key]
+		if !known {
+			issues = append(issues, unknownKeyIssue(path, key, names))
+
+			continue
+		}
+
+		value := section[key]
+
+		if fieldType.Kind() == reflect.Struct {
+			subsection, ok := value.(map[string]interface{})
+			if !ok {
+				issues = append(issues, Issue{Key: path, Message: "expected a section"})
+
+				continue
+			}
+
+			issues = append(issues, lintSection(subsection, fieldType, path)...)
+
+			continue
+		}
+
+		if fieldType.Kind() == reflect.Slice && fieldType.Elem().Kind() == reflect.Struct {
+			issues = append(issues, lintSectionList(value, fieldType.Elem(), path)...)
+
+			continue
+		}
+
+		// Profiles hold raw values laid out like the configuration itself
+		if fieldType.Kind() == reflect.Map && fieldType.Elem() == reflect.TypeOf(configTypes.ProfileConfig{}) {
+			issues = append(issues, lintSectionMap(value, reflect.TypeOf(configTypes.Config{}), path)...)
+
+			continue
+		}
+
+		if fieldType.Kind() == reflect.Map && fieldType.Elem().Kind() == reflect.Struct {
+			issues = append(issues, lintSectionMap(value, fieldType.Elem(), path)...)
+
+			continue
+		}
+
+		if message := checkValueType(value, fieldType); message != "" {
+			issues = append(issues, Issue{Key: path, Message: message})
+		}
+	}
+
+	return issues
+}
+
+// lintSectionList checks a list whose items are sections, such as plugins.
+func lintSectionList(value interface{}, itemType reflect.Type, path string) []Issue {
+	items, ok := value.([]interface{})
+	if !ok {
+		return []Issue{{Key: path, Message: fmt.Sprintf("expected a list, got %s", describeValue(value))}}
+	}
+
+	var issues []Issue
+
+	for i, item := range items {
+		itemPath := fmt.Sprintf("%s[%d]", path, i)
+
+		section, ok := item.(map[string]interface{})
+		if !ok {
+			issues = append(issues, Issue{Key: itemPath, Message: "expected a section"})
+
+			continue
+		}
+
+		issues = append(issues, lintSection(section, itemType, itemPath)...)
+	}
+
+	return issues
+}
+
+// lintSectionMap checks a section whose values are sections keyed by name, such as messages.
+func lintSectionMap(value interface{}, itemType reflect.Type, path string) []Issue {
+	items, ok := value.(map[string]interface{})
+	if !ok {
+		return []Issue{{Key: path, Message: "expected a section"}}
+	}
+
+	var issues []Issue
+
+	for _, key := range sortedKeys(items) {
+		itemPath := joinKey(path, key)
+
+		section, ok := items[key].(map[string]interface{})
+		if !ok {
+			issues = append(issues, Issue{Key: itemPath, Message: "expected a section"})
+
+			continue
+		}
+
+		issues = append(issues, lintSection(section, itemType, itemPath)...)
+	}
+
+	return issues
+}
+
+// checkValueType returns a message describing a type mismatch, or empty if value fits the type.
+func checkValueType(value interface{}, fieldType reflect.Type) string {
+	switch fieldType.Kind() {
+	case reflect.Int:
+		switch typed := value.(type) {
+		case int, int64, uint64:
+			return ""
+		case float64:
+			if typed == float64(int64(typed)) {
+				return ""
+			}
+		case string:
+			if _, err := strconv.Atoi(typed); err == nil {
+				return ""
+			}
+		}
+
+		return fmt.Sprintf("expected an integer, got %s", describeValue(value))
+	case reflect.Bool:
+		switch typed := value.(type) {
+		case bool:
+			return ""
+		case string:
+			if _, err := strconv.ParseBool(typed); err == nil {
+				return ""
+			}
+		}
+
+		return fmt.Sprintf("expected a boolean, got %s", describeValue(value))
+	case reflect.String:
+		if _, ok := value.(string); ok {
+			return ""
+		}
+
+		return fmt.Sprintf("expected a string, got %s", describeValue(value))
+	case reflect.Slice:
+		items, ok := value.([]interface{})
+		if !ok {
+			return fmt.Sprintf("expected a list, got %s", describeValue(value))
+		}
+
+		for _, item := range items {
+			if _, ok := item.(string); !ok {
+				return fmt.Sprintf("expected a list of strings, got item %s", describeValue(item))
+			}
+		}
+
+		return ""
+	default:
+		return ""
+	}
+}
+
+// lintRules checks rule names and reports rules that are both enabled and disabled.
+func lintRules(section map[string]interface{}, knownRules []string) []Issue {
+	rulesSection, ok := section["rules"].(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	enabled := stringItems(rulesSection["enabled"])
+	disabled := stringItems(rulesSection["disabled"])
+
+	var issues []Issue
+
+	if len(knownRules) > 0 {
+		issues = append(issues, unknownRuleIssues("rules.enabled", enabled, knownRules)...)
+		issues = append(issues, unknownRuleIssues("rules.disabled", disabled, knownRules)...)
+
+		// Order, blocking and conditions may also name plugins and custom rules
+		declaredRules := append(slices.Clone(knownRules), declaredRuleNames(section)...)
+		issues = append(issues, unknownRuleIssues("rules.order", stringItems(rulesSection["order"]), declaredRules)...)
+		issues = append(issues, unknownRuleIssues("rules.blocking", stringItems(rulesSection["blocking"]), declaredRules)...)
+		issues = append(issues, unknownRuleIssues("rules.warnings", stringItems(rulesSection["warnings"]), declaredRules)...)
+
+		if conditions, ok := rulesSection["conditions"].(map[string]interface{}); ok {
+			names := make([]string, 0, len(conditions))
+			for name := range conditions {
+				names = append(names, name)
+			}
+
+			sort.Strings(names)
+
+			issues = append(issues, unknownRuleIssues("rules.conditions", names, declaredRules)...)
+		}
+	}
+
+	disabledSet := make(map[string]bool, len(disabled))
+	for _, name := range disabled {
+		disabledSet[strings.ToLower(strings.TrimSpace(name))] = true
+	}
+
+	for _, name := range enabled {
+		if disabledSet[strings.ToLower(strings.TrimSpace(name))] {
+			issues = append(issues, Issue{
+				Key:     "rules",
+				Message: fmt.Sprintf("rule %q is both enabled and disabled; disabled takes precedence", name),
+			})
+		}
+	}
+
+	return issues
+}
+
+// lintRevert checks the names of the rules reverts are exempt from, which may also name
+// plugins and custom rules.
+func lintRevert(section map[string]interface{}, knownRules []string) []Issue {
+	revertSection, ok := section["revert"].(map[string]interface{})
+	if !ok || len(knownRules) == 0 {
+		return nil
+	}
+
+	declaredRules := append(slices.Clone(knownRules), declaredRuleNames(section)...)
+
+	return unknownRuleIssues("revert.exempt_rules", stringItems(revertSection["exempt_rules"]), declaredRules)
+}
+
+// declaredRuleNames returns the lowercased names of the plugins and custom rules in a section.
+func declaredRuleNames(section map[string]interface{}) []string {
+	var names []string
+
+	for _, key := range []string{"plugins", "custom_rules"} {
+		items, _ := section[key].([]interface{})
+
+		for _, item := range items {
+			if declared, ok := item.(map[string]interface{}); ok {
+				if name, ok := declared["name"].(string); ok {
+					names = append(names, strings.ToLower(strings.TrimSpace(name)))
+				}
+			}
+		}
+	}
+
+	return names
+}
+
+// unknownRuleIssues reports rule names that are not in knownRules.
+func unknownRuleIssues(key string, names, knownRules []string) []Issue {
+	known := make(map[string]bool, len(knownRules))
+	for _, rule := range knownRules {
+		known[rule] = true
+	}
+
+	var issues []Issue
+
+	for _, name := range names {
+		normalized := strings.ToLower(strings.TrimSpace(name))
+		if known[normalized] {
+			continue
+		}
+
+		issues = append(issues, Issue{
+			Key:        key,
+			Message:    fmt.Sprintf("unknown rule %q", name),
+			Suggestion: domain.ClosestMatch(normalized, knownRules),
+		})
+	}
+
+	return issues
+}
+
+// unknownKeyIssue creates an issue for an unknown key with a suggestion from candidates.
+func unknownKeyIssue(path, key string, candidates []string) Issue {
+	return Issue{
+		Key:        path,
+		Message:    "unknown key",
+		Suggestion: domain.ClosestMatch(key, candidates),
+	}
+}
+
+// stringItems returns the string items of a list value.
+func stringItems(value interface{}) []string {
+	items, ok := value.([]interface{})
+	if !ok {
+		return nil
+	}
+
+	result := make([]string, 0, len(items))
+
+	for _, item := range items {
+		if text, ok := item.(string); ok {
+			result = append(result, text)
+		}
+	}
+
+	return result
+}
+
+// describeValue returns a short description of a parsed value for messages.
+func describeValue(value interface{}) string {
+	switch value.(type) {
+	case map[string]interface{}:
+		return "a section"
+	case []interface{}:
+		return "a list"
+	case string:
+		return fmt.Sprintf("string %q", value)
+	default:
+		return fmt.Sprintf("%v", value)
+	}
+}
+
+// sortedKeys returns the keys of a map in sorted order for stable reporting.
+func sortedKeys(values map[string]interface{}) []string {
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
+
+// joinKey joins a key to its parent path.
+func joinKey(prefix, key string) string {
+	if prefix == "" {
+		return key
+	}
+
+	return prefix + "." + key
+}
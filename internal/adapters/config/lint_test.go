@@ -0,0 +1,221 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLintFile(t *testing.T) {
+	knownRules := []string{"commitbody", "spell", "subject"}
+
+	tests := []struct {
+		name     string
+		file     string
+		content  string
+		expected []Issue
+	}{
+		{
+			name: "valid yaml",
+			file: ".gommitlint.yaml",
+			content: `gommitlint:
+  message:
+    subject:
+      max_length: 60
+      forbid_endings: ["."]
+  rules:
+    enabled: [commitbody]`,
+			expected: nil,
+		},
+		{
+			name: "unknown keys with suggestions",
+			file: ".gommitlint.yaml",
+			content: `gommitlint:
+  mesage:
+    subject:
+      max_length: 60
+  spell:
+    locale: en_GB
+    ignore_wrds: [foo]`,
+			expected: []Issue{
+				{Key: "mesage", Message: "unknown key", Suggestion: "message"},
+				{Key: "spell.ignore_wrds", Message: "unknown key", Suggestion: "ignore_words"},
+			},
+		},
+		{
+			name: "unknown key without close match",
+			file: ".gommitlint.yaml",
+			content: `gommitlint:
+  completely_different: true`,
+			expected: []Issue{
+				{Key: "completely_different", Message: "unknown key"},
+			},
+		},
+		{
+			name: "type errors",
+			file: ".gommitlint.yaml",
+			content: `gommitlint:
+  message:
+    subject:
+      max_length: long
+      require_imperative: sometimes
+    body: true
+  conventional:
+    types: [feat, 1]`,
+			expected: []Issue{
+				{Key: "conventional.types", Message: "expected a list of strings, got item 1"},
+				{Key: "message.body", Message: "expected a section"},
+				{Key: "message.subject.max_length", Message: `expected an integer, got string "long"`},
+				{Key: "message.subject.require_imperative", Message: `expected a boolean, got string "sometimes"`},
+			},
+		},
+		{
+			name: "rule conflicts and unknown rules",
+			file: ".gommitlint.yaml",
+			content: `gommitlint:
+  rules:
+    enabled: [Spell, subjet]
+    disabled: [spell]
+    order: [subject, spel]
+    blocking: [subjet]`,
+			expected: []Issue{
+				{Key: "rules.enabled", Message: `unknown rule "subjet"`, Suggestion: "subject"},
+				{Key: "rules.order", Message: `unknown rule "spel"`, Suggestion: "spell"},
+				{Key: "rules.blocking", Message: `unknown rule "subjet"`, Suggestion: "subject"},
+				{Key: "rules", Message: `rule "Spell" is both enabled and disabled; disabled takes precedence`},
+			},
+		},
+		{
+			name: "rule conditions",
+			file: ".gommitlint.yaml",
+			content: `gommitlint:
+  custom_rules:
+    - name: NoWip
+      pattern: wip
+  rules:
+    conditions:
+      spell:
+        branches: [main]
+      nowip:
+        types: [feat]
+      subjet:
+        paths: ["docs/**"]
+        brnches: [main]`,
+			expected: []Issue{
+				{Key: "rules.conditions.subjet.brnches", Message: "unknown key", Suggestion: "branches"},
+				{Key: "rules.conditions", Message: `unknown rule "subjet"`, Suggestion: "subject"},
+			},
+		},
+		{
+			name: "plugin entries",
+			file: ".gommitlint.yaml",
+			content: `gommitlint:
+  plugins:
+    - name: NoWip
+      command: ./scripts/nowip
+      args: [--strict]
+    - name: Other
+      comand: ./scripts/other
+    - just-a-string`,
+			expected: []Issue{
+				{Key: "plugins[1].comand", Message: "unknown key", Suggestion: "command"},
+				{Key: "plugins[2]", Message: "expected a section"},
+			},
+		},
+		{
+			name: "message templates",
+			file: ".gommitlint.yaml",
+			content: `gommitlint:
+  messages:
+    subject:
+      message: "{{.Message}}"
+      hepl: "See the guide"
+    signoff: "not a section"`,
+			expected: []Issue{
+				{Key: "messages.signoff", Message: "expected a section"},
+				{Key: "messages.subject.hepl", Message: "unknown key", Suggestion: "help"},
+			},
+		},
+		{
+			name: "missing root key",
+			file: ".gommitlint.yaml",
+			content: `gommitlnt:
+  output: json`,
+			expected: []Issue{
+				{Key: "gommitlnt", Message: "unknown key", Suggestion: "gommitlint"},
+				{Message: "missing top-level 'gommitlint' key"},
+			},
+		},
+		{
+			name: "anchors, includes and rules shorthand",
+			file: ".gommitlint.yaml",
+			content: `x-subject: &subject
+  max_length: 60
+gommitlint:
+  include: [../shared/gommitlint.yaml]
+  message:
+    subject: *subject
+  rules:
+    subject: error
+    spell: warning
+    commitbody: off
+    order: [subject]
+  profiles:
+    ci:
+      rules:
+        spell: on`,
+			expected: []Issue{
+				{Key: "profiles.ci.rules.spell", Message: "unknown rule level string \"on\", expected error, warning or off"},
+			},
+		},
+		{
+			name: "invalid include and shorthand rule names",
+			file: ".gommitlint.yaml",
+			content: `gommitlint:
+  include: ../shared/gommitlint.yaml
+  rules:
+    subjct: error
+    warnings: [spel]`,
+			expected: []Issue{
+				{Key: "include", Message: "expected a list, got string \"../shared/gommitlint.yaml\""},
+				{Key: "rules.enabled", Message: "unknown rule \"subjct\"", Suggestion: "subject"},
+				{Key: "rules.warnings", Message: "unknown rule \"spel\"", Suggestion: "spell"},
+			},
+		},
+		{
+			name: "toml file",
+			file: ".gommitlint.toml",
+			content: `[gommitlint.message.subject]
+max_length = 60
+max_lenght = 50`,
+			expected: []Issue{
+				{Key: "message.subject.max_lenght", Message: "unknown key", Suggestion: "max_length"},
+			},
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			configPath := filepath.Join(t.TempDir(), testCase.file)
+			require.NoError(t, os.WriteFile(configPath, []byte(testCase.content), 0600))
+
+			issues, err := LintFile(configPath, knownRules)
+			require.NoError(t, err)
+			require.Equal(t, testCase.expected, issues)
+		})
+	}
+}
+
+func TestLintFile_ParseError(t *testing.T) {
+	configPath := filepath.Join(t.TempDir(), ".gommitlint.yaml")
+	require.NoError(t, os.WriteFile(configPath, []byte("gommitlint: [unclosed"), 0600))
+
+	_, err := LintFile(configPath, nil)
+	require.Error(t, err)
+}
@@ -8,6 +8,8 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"reflect"
+	"slices"
 	"strings"
 
 	configTypes "github.com/itiquette/gommitlint/internal/domain/config"
@@ -26,50 +28,128 @@ func getConfigSearchPaths() []string {
 // getConfigSearchPathsForRepo returns config search paths for a specific repository directory.
 // If repoPath is empty, searches in current directory. Otherwise searches in repository directory.
 func getConfigSearchPathsForRepo(repoPath string) []string {
-	var paths []string
-
-	// Determine base directory for config files with security validation
-	baseDir := "."
-
-	if repoPath != "" {
-		// Validate the repo path for security (but allow non-git directories for config search)
-		cleanPath := filepath.Clean(repoPath)
-		absPath, err := filepath.Abs(cleanPath)
-
-		if err != nil || strings.Contains(cleanPath, "..") {
-			// Fall back to current directory if path is suspicious
-			baseDir = "."
-		} else {
-			baseDir = absPath
-		}
-	}
+	baseDir := configBaseDir(repoPath)
 
 	// Add local config files (in repository or current directory)
-	paths = []string{
+	paths := []string{
 		filepath.Join(baseDir, ".gommitlint.yaml"),
 		filepath.Join(baseDir, ".gommitlint.yml"),
 		filepath.Join(baseDir, ".gommitlint.toml"),
 	}
 
 	// Add XDG config paths if XDG_CONFIG_HOME is set and directory exists
-	if xdgConfigHome := os.Getenv("XDG_CONFIG_HOME"); xdgConfigHome != "" {
-		// Validate XDG_CONFIG_HOME path for security
-		cleanXDG := filepath.Clean(xdgConfigHome)
-		if filepath.IsAbs(cleanXDG) && !strings.Contains(cleanXDG, "..") {
-			gommitlintDir := filepath.Join(cleanXDG, "gommitlint")
-			if _, err := os.Stat(gommitlintDir); err == nil {
-				paths = append(paths,
-					filepath.Join(gommitlintDir, "config.yaml"),
-					filepath.Join(gommitlintDir, "config.yml"),
-					filepath.Join(gommitlintDir, "config.toml"),
-				)
-			}
+	if gommitlintDir := xdgConfigDir(); gommitlintDir != "" {
+		if _, err := os.Stat(gommitlintDir); err == nil {
+			paths = append(paths,
+				filepath.Join(gommitlintDir, "config.yaml"),
+				filepath.Join(gommitlintDir, "config.yml"),
+				filepath.Join(gommitlintDir, "config.toml"),
+			)
 		}
 	}
 
 	return paths
 }
 
+// configBaseDir returns the directory local configuration is looked for in: repoPath,
+// or the current directory when it is empty or suspicious.
+func configBaseDir(repoPath string) string {
+	if repoPath == "" {
+		return "."
+	}
+
+	// Validate the repo path for security (but allow non-git directories for config search)
+	cleanPath := filepath.Clean(repoPath)
+	absPath, err := filepath.Abs(cleanPath)
+
+	if err != nil || strings.Contains(cleanPath, "..") {
+		return "."
+	}
+
+	return absPath
+}
+
+// xdgConfigDir returns the gommitlint directory below XDG_CONFIG_HOME, or empty when the
+// variable is unset or not a safe absolute path.
+func xdgConfigDir() string {
+	xdgConfigHome := os.Getenv("XDG_CONFIG_HOME")
+	if xdgConfigHome == "" {
+		return ""
+	}
+
+	cleanXDG := filepath.Clean(xdgConfigHome)
+	if !filepath.IsAbs(cleanXDG) || strings.Contains(cleanXDG, "..") {
+		return ""
+	}
+
+	return filepath.Join(cleanXDG, "gommitlint")
+}
+
+// FragmentDirs returns the directories whose configuration fragments are merged for
+// repoPath, or the current directory when it is empty, lowest precedence first: the
+// global config.d below XDG_CONFIG_HOME, then .gommitlint.d of the repository.
+func FragmentDirs(repoPath string) []string {
+	var dirs []string
+
+	if gommitlintDir := xdgConfigDir(); gommitlintDir != "" {
+		dirs = append(dirs, filepath.Join(gommitlintDir, "config.d"))
+	}
+
+	return append(dirs, filepath.Join(configBaseDir(repoPath), ".gommitlint.d"))
+}
+
+// Fragments returns the configuration fragments merged for repoPath: the YAML and TOML
+// files of FragmentDirs, each directory in lexical order of file names.
+func Fragments(repoPath string) []string {
+	var fragments []string
+
+	for _, dir := range FragmentDirs(repoPath) {
+		entries, err := os.ReadDir(dir)
+		if err != nil {
+			continue
+		}
+
+		// Entries are sorted by file name
+		for _, entry := range entries {
+			if entry.IsDir() || !isConfigFile(entry.Name()) {
+				continue
+			}
+
+			fragments = append(fragments, filepath.Join(dir, entry.Name()))
+		}
+	}
+
+	return fragments
+}
+
+// isConfigFile reports whether name is a configuration file by its extension.
+func isConfigFile(name string) bool {
+	switch strings.ToLower(filepath.Ext(name)) {
+	case ".yaml", ".yml", ".toml":
+		return !strings.HasPrefix(name, ".")
+	default:
+		return false
+	}
+}
+
+// ConfigFiles returns the files the configuration for repoPath is loaded from, lowest
+// precedence first: the fragments, then the first configuration file found.
+func ConfigFiles(repoPath string) []string {
+	files := Fragments(repoPath)
+
+	if configPath := findFirstExistingConfigFileInRepo(repoPath); configPath != "" {
+		files = append(files, configPath)
+	}
+
+	return files
+}
+
+// SearchPaths returns the configuration files looked for in repoPath, or in the current
+// directory when it is empty, the first existing one being loaded.
+func SearchPaths(repoPath string) []string {
+	return getConfigSearchPathsForRepo(repoPath)
+}
+
 // LoadConfig loads configuration from multiple sources with later configs taking precedence.
 func LoadConfig() (configTypes.Config, error) {
 	return LoadConfigWithRepoPath("")
@@ -78,18 +158,49 @@ func LoadConfig() (configTypes.Config, error) {
 // LoadConfigWithRepoPath loads configuration with repository path for config file discovery.
 // If repoPath is provided, searches for config files in that directory first.
 func LoadConfigWithRepoPath(repoPath string) (configTypes.Config, error) {
-	return MergeConfigs(
-		LoadDefaultConfig(),
-		LoadFileConfig(findFirstExistingConfigFileInRepo(repoPath)),
-	)
+	return LoadProfileConfigWithRepoPath(repoPath, "")
+}
+
+// LoadProfileConfigWithRepoPath loads configuration like LoadConfigWithRepoPath with the
+// settings of the named profile applied over the file configuration.
+func LoadProfileConfigWithRepoPath(repoPath, profile string) (configTypes.Config, error) {
+	return LoadProfileConfigFromPaths(ConfigFiles(repoPath), profile)
 }
 
 // LoadConfigFromPath loads configuration from a specific path using functional composition.
 func LoadConfigFromPath(configPath string) (configTypes.Config, error) {
-	return MergeConfigs(
-		LoadDefaultConfig(),
-		LoadFileConfig(configPath),
-	)
+	return LoadProfileConfigFromPath(configPath, "")
+}
+
+// LoadProfileConfigFromPath loads configuration from a specific path with the settings of
+// the named profile applied. An empty profile loads the file as it is.
+func LoadProfileConfigFromPath(configPath, profile string) (configTypes.Config, error) {
+	return LoadProfileConfigFromPaths([]string{configPath}, profile)
+}
+
+// LoadProfileConfigFromPaths loads configuration from several files, such as those of
+// ConfigFiles, with keys set by later files replacing those set by earlier ones.
+func LoadProfileConfigFromPaths(configPaths []string, profile string) (configTypes.Config, error) {
+	koanfConfig, err := loadFilesKoanf(configPaths, profile)
+	if err != nil {
+		return configTypes.Config{}, err
+	}
+
+	return loadLayeredConfig(koanfConfig)
+}
+
+// loadLayeredConfig layers defaults, the loaded file configuration and environment
+// overrides, with later layers taking precedence, and validates the result.
+func loadLayeredConfig(koanfConfig *koanf.Koanf) (configTypes.Config, error) {
+	fileConfig := unmarshalFileConfig(koanfConfig)
+	cfg := applyFileBooleans(mergeConfig(LoadDefaultConfig(), fileConfig), koanfConfig)
+
+	cfg, err := ApplyEnvOverrides(cfg, os.LookupEnv)
+	if err != nil {
+		return configTypes.Config{}, err
+	}
+
+	return MergeConfigs(cfg)
 }
 
 // LoadDefaultConfig returns the default configuration with application-specific defaults.
@@ -101,52 +212,167 @@ func LoadDefaultConfig() configTypes.Config {
 // Supports both YAML and TOML formats based on file extension.
 // Returns empty config if file doesn't exist or can't be loaded.
 func LoadFileConfig(configPath string) configTypes.Config {
-	if configPath == "" {
-		return configTypes.Config{} // Empty config
+	cfg, _ := LoadFileProfileConfig(configPath, "")
+
+	return cfg
+}
+
+// LoadFileProfileConfig loads configuration from a file with the settings of the named
+// profile merged over the top-level ones; only the keys the profile sets are replaced.
+// Like LoadFileConfig it returns an empty config if the file can't be loaded, but it
+// fails when a profile is requested that the file does not define.
+func LoadFileProfileConfig(configPath, profile string) (configTypes.Config, error) {
+	return LoadFilesProfileConfig([]string{configPath}, profile)
+}
+
+// LoadFilesProfileConfig loads configuration like LoadFileProfileConfig from several
+// files merged key by key, later files taking precedence; lists are replaced as a whole.
+// Files that don't exist or can't be loaded are skipped. The files each of them includes
+// are loaded before it. The profile may be defined in any of the files.
+func LoadFilesProfileConfig(configPaths []string, profile string) (configTypes.Config, error) {
+	koanfConfig, err := loadFilesKoanf(configPaths, profile)
+	if err != nil {
+		return configTypes.Config{}, err
 	}
 
-	// Check if file exists
-	if _, err := os.Stat(configPath); err != nil {
-		return configTypes.Config{} // Empty config
+	return unmarshalFileConfig(koanfConfig), nil
+}
+
+// loadFilesKoanf loads the files like LoadFilesProfileConfig and returns their merged
+// keys, none when no file could be loaded.
+func loadFilesKoanf(configPaths []string, profile string) (*koanf.Koanf, error) {
+	configPaths, err := WithIncludes(configPaths)
+	if err != nil {
+		return nil, err
 	}
 
-	// Create koanf instance
 	koanfConfig := koanf.New(".")
+	found, loaded := false, false
 
-	// Determine parser based on file extension
-	var parser koanf.Parser
+	for _, configPath := range configPaths {
+		if configPath == "" || !fileExists(configPath) {
+			continue
+		}
 
-	var tagName string
+		found = true
 
-	ext := strings.ToLower(filepath.Ext(configPath))
-	switch ext {
-	case ".toml":
-		parser = toml.Parser()
-		tagName = "toml"
-	case ".yaml", ".yml":
-		parser = yaml.Parser()
-		tagName = "yaml"
-	default:
-		// Default to YAML for unknown extensions
-		parser = yaml.Parser()
-		tagName = "yaml"
+		// Load configuration using the parser for the file extension
+		if err := koanfConfig.Load(file.Provider(configPath), configParser(configPath)); err != nil {
+			continue
+		}
+
+		loaded = true
 	}
 
-	// Load configuration using appropriate parser
-	if err := koanfConfig.Load(file.Provider(configPath), parser); err != nil {
-		return configTypes.Config{} // Empty config on error
+	if !loaded {
+		if profile != "" && !found {
+			return nil, fmt.Errorf("profile %q requested but no configuration file was found", profile)
+		}
+
+		return koanf.New("."), nil
+	}
+
+	if profile != "" {
+		if err := applyProfile(koanfConfig, profile); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := expandLoadedRuleShorthand(koanfConfig); err != nil {
+		return nil, err
 	}
 
-	// Parse into config struct
+	return koanfConfig, nil
+}
+
+// unmarshalFileConfig parses the loaded keys into a config struct, leaving the keys no
+// file sets at their zero values. Returns empty config if the keys can't be parsed.
+func unmarshalFileConfig(koanfConfig *koanf.Koanf) configTypes.Config {
+	// Parse into config struct; keys are named alike in YAML and TOML
 	var cfg configTypes.Config
-	if err := koanfConfig.UnmarshalWithConf("gommitlint", &cfg, koanf.UnmarshalConf{Tag: tagName}); err != nil {
+	if err := koanfConfig.UnmarshalWithConf("gommitlint", &cfg, koanf.UnmarshalConf{Tag: "yaml"}); err != nil {
 		return configTypes.Config{} // Empty config on error
 	}
 
 	// Apply rule priority logic
-	cfg = applyRulePriority(cfg)
+	return applyRulePriority(cfg)
+}
 
-	return cfg
+// applyFileBooleans returns a copy of cfg with every boolean a configuration file or the
+// selected profile sets taken from koanfConfig. mergeConfig can't tell a false a file
+// sets from one it leaves unset, so without this a file could not turn off a boolean
+// that defaults to true.
+func applyFileBooleans(cfg configTypes.Config, koanfConfig *koanf.Koanf) configTypes.Config {
+	result := cfg
+
+	_ = walkConfigFields(reflect.ValueOf(&result).Elem(), EnvPrefix, "", func(field reflect.Value, variable EnvVariable) error {
+		key := "gommitlint." + variable.Key
+		if field.Kind() == reflect.Bool && koanfConfig.Exists(key) {
+			field.SetBool(koanfConfig.Bool(key))
+		}
+
+		return nil
+	})
+
+	return result
+}
+
+// configParser returns the parser for a configuration file based on its extension,
+// defaulting to YAML for unknown extensions.
+func configParser(configPath string) koanf.Parser {
+	if strings.ToLower(filepath.Ext(configPath)) == ".toml" {
+		return toml.Parser()
+	}
+
+	return yaml.Parser()
+}
+
+// applyProfile merges the settings of a profile over the top-level configuration.
+func applyProfile(koanfConfig *koanf.Koanf, profile string) error {
+	profiles := koanfConfig.MapKeys("gommitlint.profiles")
+
+	if strings.Contains(profile, ".") || !slices.Contains(profiles, profile) {
+		if len(profiles) == 0 {
+			return fmt.Errorf("unknown profile %q: the configuration defines no profiles", profile)
+		}
+
+		return fmt.Errorf("unknown profile %q, defined profiles: %s", profile, strings.Join(profiles, ", "))
+	}
+
+	if err := koanfConfig.MergeAt(koanfConfig.Cut("gommitlint.profiles."+profile), "gommitlint"); err != nil {
+		return fmt.Errorf("apply profile %q: %w", profile, err)
+	}
+
+	return nil
+}
+
+// expandLoadedRuleShorthand replaces the rules section of the merged configuration with
+// its expansion, so that rule levels set by any file or the profile are applied.
+func expandLoadedRuleShorthand(koanfConfig *koanf.Koanf) error {
+	section, ok := koanfConfig.Get("gommitlint.rules").(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	expanded, issues := expandRuleShorthand(section)
+	if len(issues) > 0 {
+		return fmt.Errorf("invalid rules configuration: %s", issues[0])
+	}
+
+	koanfConfig.Delete("gommitlint.rules")
+
+	if err := koanfConfig.Set("gommitlint.rules", expanded); err != nil {
+		return fmt.Errorf("expand rules shorthand: %w", err)
+	}
+
+	return nil
+}
+
+// fileExists returns true if a file exists at path.
+func fileExists(path string) bool {
+	_, err := os.Stat(path)
+
+	return err == nil
 }
 
 // MergeConfigs merges multiple configurations with later configs taking precedence.
@@ -185,20 +411,56 @@ func mergeConfig(base, overlay configTypes.Config) configTypes.Config {
 		result.Message.Subject.MaxLength = overlay.Message.Subject.MaxLength
 	}
 
+	if overlay.Message.Subject.LengthMode != "" {
+		result.Message.Subject.LengthMode = overlay.Message.Subject.LengthMode
+	}
+
 	if overlay.Message.Subject.Case != "" {
 		result.Message.Subject.Case = overlay.Message.Subject.Case
 	}
 
+	if overlay.Message.Subject.CaseLocale != "" {
+		result.Message.Subject.CaseLocale = overlay.Message.Subject.CaseLocale
+	}
+
 	// Note: RequireImperative is a bool, so we need to check if it's explicitly set
 	// For bool fields, we merge if the overlay has a different value than the default
 	if overlay.Message.Subject.RequireImperative != base.Message.Subject.RequireImperative {
 		result.Message.Subject.RequireImperative = overlay.Message.Subject.RequireImperative
 	}
 
+	if overlay.Message.Subject.Imperative.Mode != "" {
+		result.Message.Subject.Imperative.Mode = overlay.Message.Subject.Imperative.Mode
+	}
+
+	if len(overlay.Message.Subject.Imperative.Verbs) > 0 {
+		result.Message.Subject.Imperative.Verbs = overlay.Message.Subject.Imperative.Verbs
+	}
+
+	if overlay.Message.Subject.Imperative.Language != "" {
+		result.Message.Subject.Imperative.Language = overlay.Message.Subject.Imperative.Language
+	}
+
+	if overlay.Message.Subject.Imperative.SkipNonASCII != base.Message.Subject.Imperative.SkipNonASCII {
+		result.Message.Subject.Imperative.SkipNonASCII = overlay.Message.Subject.Imperative.SkipNonASCII
+	}
+
+	if len(overlay.Message.Subject.Imperative.CustomExceptions) > 0 {
+		result.Message.Subject.Imperative.CustomExceptions = overlay.Message.Subject.Imperative.CustomExceptions
+	}
+
+	if len(overlay.Message.Subject.Imperative.CustomNonVerbs) > 0 {
+		result.Message.Subject.Imperative.CustomNonVerbs = overlay.Message.Subject.Imperative.CustomNonVerbs
+	}
+
 	if len(overlay.Message.Subject.ForbidEndings) > 0 {
 		result.Message.Subject.ForbidEndings = overlay.Message.Subject.ForbidEndings
 	}
 
+	if len(overlay.Message.Subject.ExcludedPrefixes) > 0 {
+		result.Message.Subject.ExcludedPrefixes = overlay.Message.Subject.ExcludedPrefixes
+	}
+
 	// Merge body config
 	if overlay.Message.Body.Required != base.Message.Body.Required {
 		result.Message.Body.Required = overlay.Message.Body.Required
@@ -216,6 +478,38 @@ func mergeConfig(base, overlay configTypes.Config) configTypes.Config {
 		result.Message.Body.MinSignoffCount = overlay.Message.Body.MinSignoffCount
 	}
 
+	if overlay.Message.Body.SignoffMatchAuthor != base.Message.Body.SignoffMatchAuthor {
+		result.Message.Body.SignoffMatchAuthor = overlay.Message.Body.SignoffMatchAuthor
+	}
+
+	if overlay.Message.Body.SignoffAllowCommitter != base.Message.Body.SignoffAllowCommitter {
+		result.Message.Body.SignoffAllowCommitter = overlay.Message.Body.SignoffAllowCommitter
+	}
+
+	if overlay.Message.Body.MinWords != 0 {
+		result.Message.Body.MinWords = overlay.Message.Body.MinWords
+	}
+
+	if overlay.Message.Body.MinParagraphs != 0 {
+		result.Message.Body.MinParagraphs = overlay.Message.Body.MinParagraphs
+	}
+
+	if len(overlay.Message.Body.MinParagraphsTypes) > 0 {
+		result.Message.Body.MinParagraphsTypes = overlay.Message.Body.MinParagraphsTypes
+	}
+
+	if overlay.Message.Body.ForbidRepeatedSubject != base.Message.Body.ForbidRepeatedSubject {
+		result.Message.Body.ForbidRepeatedSubject = overlay.Message.Body.ForbidRepeatedSubject
+	}
+
+	if overlay.Message.FallbackEncoding != "" {
+		result.Message.FallbackEncoding = overlay.Message.FallbackEncoding
+	}
+
+	if overlay.Message.Parser != "" {
+		result.Message.Parser = overlay.Message.Parser
+	}
+
 	// Merge conventional config
 	if len(overlay.Conventional.Types) > 0 {
 		result.Conventional.Types = overlay.Conventional.Types
@@ -225,6 +519,30 @@ func mergeConfig(base, overlay configTypes.Config) configTypes.Config {
 		result.Conventional.Scopes = overlay.Conventional.Scopes
 	}
 
+	if overlay.Conventional.ScopeCase != "" {
+		result.Conventional.ScopeCase = overlay.Conventional.ScopeCase
+	}
+
+	if overlay.Conventional.MaxScopeLength != 0 {
+		result.Conventional.MaxScopeLength = overlay.Conventional.MaxScopeLength
+	}
+
+	if overlay.Conventional.ScopeCharacters != "" {
+		result.Conventional.ScopeCharacters = overlay.Conventional.ScopeCharacters
+	}
+
+	if overlay.Conventional.MaxScopes != 0 {
+		result.Conventional.MaxScopes = overlay.Conventional.MaxScopes
+	}
+
+	if len(overlay.Conventional.TypeAliases) > 0 {
+		result.Conventional.TypeAliases = overlay.Conventional.TypeAliases
+	}
+
+	if overlay.Conventional.AliasMode != "" {
+		result.Conventional.AliasMode = overlay.Conventional.AliasMode
+	}
+
 	// Merge repo config
 	if overlay.Repo.ReferenceBranch != "" {
 		result.Repo.ReferenceBranch = overlay.Repo.ReferenceBranch
@@ -234,6 +552,10 @@ func mergeConfig(base, overlay configTypes.Config) configTypes.Config {
 		result.Repo.MaxCommitsAhead = overlay.Repo.MaxCommitsAhead
 	}
 
+	if overlay.Repo.MaxCommitsBehind != 0 {
+		result.Repo.MaxCommitsBehind = overlay.Repo.MaxCommitsBehind
+	}
+
 	// Merge rules config - always override if present
 	if len(overlay.Rules.Enabled) > 0 {
 		result.Rules.Enabled = overlay.Rules.Enabled
@@ -243,6 +565,227 @@ func mergeConfig(base, overlay configTypes.Config) configTypes.Config {
 		result.Rules.Disabled = overlay.Rules.Disabled
 	}
 
+	if len(overlay.Rules.Order) > 0 {
+		result.Rules.Order = overlay.Rules.Order
+	}
+
+	if len(overlay.Rules.Blocking) > 0 {
+		result.Rules.Blocking = overlay.Rules.Blocking
+	}
+
+	if len(overlay.Rules.Warnings) > 0 {
+		result.Rules.Warnings = overlay.Rules.Warnings
+	}
+
+	if overlay.Rules.RelatedFailures != "" {
+		result.Rules.RelatedFailures = overlay.Rules.RelatedFailures
+	}
+
+	if len(overlay.Rules.Conditions) > 0 {
+		result.Rules.Conditions = overlay.Rules.Conditions
+	}
+
+	// Merge characters config
+	if overlay.Characters.AllowControl != base.Characters.AllowControl {
+		result.Characters.AllowControl = overlay.Characters.AllowControl
+	}
+
+	if overlay.Characters.AllowZeroWidth != base.Characters.AllowZeroWidth {
+		result.Characters.AllowZeroWidth = overlay.Characters.AllowZeroWidth
+	}
+
+	if overlay.Characters.AllowBidi != base.Characters.AllowBidi {
+		result.Characters.AllowBidi = overlay.Characters.AllowBidi
+	}
+
+	if overlay.Characters.ASCIIOnly != base.Characters.ASCIIOnly {
+		result.Characters.ASCIIOnly = overlay.Characters.ASCIIOnly
+	}
+
+	if len(overlay.Characters.AllowedScripts) > 0 {
+		result.Characters.AllowedScripts = overlay.Characters.AllowedScripts
+	}
+
+	// Merge placeholders config
+	if len(overlay.Placeholders.Patterns) > 0 {
+		result.Placeholders.Patterns = overlay.Placeholders.Patterns
+	}
+
+	// Merge language config
+	if len(overlay.Language.Languages) > 0 {
+		result.Language.Languages = overlay.Language.Languages
+	}
+
+	if overlay.Language.MinLength != 0 {
+		result.Language.MinLength = overlay.Language.MinLength
+	}
+
+	// Merge links config
+	if overlay.Links.RequireHTTPS != base.Links.RequireHTTPS {
+		result.Links.RequireHTTPS = overlay.Links.RequireHTTPS
+	}
+
+	if len(overlay.Links.AllowedDomains) > 0 {
+		result.Links.AllowedDomains = overlay.Links.AllowedDomains
+	}
+
+	if overlay.Links.Forbid != base.Links.Forbid {
+		result.Links.Forbid = overlay.Links.Forbid
+	}
+
+	// Merge issues config
+	if overlay.Issues.Repository != "" {
+		result.Issues.Repository = overlay.Issues.Repository
+	}
+
+	if overlay.Issues.RequireOpen != base.Issues.RequireOpen {
+		result.Issues.RequireOpen = overlay.Issues.RequireOpen
+	}
+
+	if overlay.Issues.Timeout != "" {
+		result.Issues.Timeout = overlay.Issues.Timeout
+	}
+
+	if overlay.Issues.CacheTTL != "" {
+		result.Issues.CacheTTL = overlay.Issues.CacheTTL
+	}
+
+	// Merge branch ticket config
+	if overlay.BranchTicket.Pattern != "" {
+		result.BranchTicket.Pattern = overlay.BranchTicket.Pattern
+	}
+
+	if overlay.BranchTicket.RequireReference != base.BranchTicket.RequireReference {
+		result.BranchTicket.RequireReference = overlay.BranchTicket.RequireReference
+	}
+
+	// Merge subject pattern config
+	if overlay.SubjectPattern.Pattern != "" {
+		result.SubjectPattern.Pattern = overlay.SubjectPattern.Pattern
+	}
+
+	if overlay.SubjectPattern.Message != "" {
+		result.SubjectPattern.Message = overlay.SubjectPattern.Message
+	}
+
+	if len(overlay.SubjectPattern.Groups) > 0 {
+		result.SubjectPattern.Groups = overlay.SubjectPattern.Groups
+	}
+
+	// Merge merge subject config
+	if len(overlay.MergeSubject.Patterns) > 0 {
+		result.MergeSubject.Patterns = overlay.MergeSubject.Patterns
+	}
+
+	// Merge breaking change config
+	if overlay.BreakingChange.MinBodyLength != 0 {
+		result.BreakingChange.MinBodyLength = overlay.BreakingChange.MinBodyLength
+	}
+
+	if overlay.BreakingChange.RequireMigration != base.BreakingChange.RequireMigration {
+		result.BreakingChange.RequireMigration = overlay.BreakingChange.RequireMigration
+	}
+
+	if len(overlay.BreakingChange.Branches) > 0 {
+		result.BreakingChange.Branches = overlay.BreakingChange.Branches
+	}
+
+	if len(overlay.BreakingChange.RequiredTrailers) > 0 {
+		result.BreakingChange.RequiredTrailers = overlay.BreakingChange.RequiredTrailers
+	}
+
+	// Merge revert config
+	if overlay.Revert.Format != "" {
+		result.Revert.Format = overlay.Revert.Format
+	}
+
+	if len(overlay.Revert.ExemptRules) > 0 {
+		result.Revert.ExemptRules = overlay.Revert.ExemptRules
+	}
+
+	// Merge fixup config
+	if len(overlay.Fixup.ProtectedBranches) > 0 {
+		result.Fixup.ProtectedBranches = overlay.Fixup.ProtectedBranches
+	}
+
+	// Merge force push config
+	if overlay.ForcePush.Upstream != "" {
+		result.ForcePush.Upstream = overlay.ForcePush.Upstream
+	}
+
+	if len(overlay.ForcePush.Branches) > 0 {
+		result.ForcePush.Branches = overlay.ForcePush.Branches
+	}
+
+	// Merge commit size config
+	if overlay.CommitSize.MaxLines != 0 {
+		result.CommitSize.MaxLines = overlay.CommitSize.MaxLines
+	}
+
+	if overlay.CommitSize.MaxFiles != 0 {
+		result.CommitSize.MaxFiles = overlay.CommitSize.MaxFiles
+	}
+
+	// Merge CLA config
+	if overlay.CLA.ContributorsFile != "" {
+		result.CLA.ContributorsFile = overlay.CLA.ContributorsFile
+	}
+
+	if overlay.CLA.URL != "" {
+		result.CLA.URL = overlay.CLA.URL
+	}
+
+	if overlay.CLA.SignURL != "" {
+		result.CLA.SignURL = overlay.CLA.SignURL
+	}
+
+	if overlay.CLA.Offline != "" {
+		result.CLA.Offline = overlay.CLA.Offline
+	}
+
+	if overlay.CLA.Timeout != "" {
+		result.CLA.Timeout = overlay.CLA.Timeout
+	}
+
+	if overlay.CLA.CacheTTL != "" {
+		result.CLA.CacheTTL = overlay.CLA.CacheTTL
+	}
+
+	// Merge trailers config
+	if len(overlay.Trailers.Allowed) > 0 {
+		result.Trailers.Allowed = overlay.Trailers.Allowed
+	}
+
+	if len(overlay.Trailers.Unique) > 0 {
+		result.Trailers.Unique = overlay.Trailers.Unique
+	}
+
+	if len(overlay.Trailers.Order) > 0 {
+		result.Trailers.Order = overlay.Trailers.Order
+	}
+
+	if len(overlay.Trailers.RequiredByType) > 0 {
+		result.Trailers.RequiredByType = overlay.Trailers.RequiredByType
+	}
+
+	if len(overlay.Trailers.Patterns) > 0 {
+		result.Trailers.Patterns = overlay.Trailers.Patterns
+	}
+
+	// Merge review config
+	if len(overlay.Review.Branches) > 0 {
+		result.Review.Branches = overlay.Review.Branches
+	}
+
+	if len(overlay.Review.Reviewers) > 0 {
+		result.Review.Reviewers = overlay.Review.Reviewers
+	}
+
+	// Merge Change-Id config
+	if overlay.ChangeID.Mode != "" {
+		result.ChangeID.Mode = overlay.ChangeID.Mode
+	}
+
 	// Merge Jira config
 	if len(overlay.Jira.ProjectPrefixes) > 0 {
 		result.Jira.ProjectPrefixes = overlay.Jira.ProjectPrefixes
@@ -252,6 +795,30 @@ func mergeConfig(base, overlay configTypes.Config) configTypes.Config {
 		result.Jira.IgnoreTicketPatterns = overlay.Jira.IgnoreTicketPatterns
 	}
 
+	if overlay.Jira.Online.Enabled != base.Jira.Online.Enabled {
+		result.Jira.Online.Enabled = overlay.Jira.Online.Enabled
+	}
+
+	if overlay.Jira.Online.URL != "" {
+		result.Jira.Online.URL = overlay.Jira.Online.URL
+	}
+
+	if overlay.Jira.Online.Sprint != "" {
+		result.Jira.Online.Sprint = overlay.Jira.Online.Sprint
+	}
+
+	if overlay.Jira.Online.SprintField != "" {
+		result.Jira.Online.SprintField = overlay.Jira.Online.SprintField
+	}
+
+	if overlay.Jira.Online.Timeout != "" {
+		result.Jira.Online.Timeout = overlay.Jira.Online.Timeout
+	}
+
+	if overlay.Jira.Online.CacheTTL != "" {
+		result.Jira.Online.CacheTTL = overlay.Jira.Online.CacheTTL
+	}
+
 	// Merge Spell config
 	if len(overlay.Spell.IgnoreWords) > 0 {
 		result.Spell.IgnoreWords = overlay.Spell.IgnoreWords
@@ -266,6 +833,10 @@ func mergeConfig(base, overlay configTypes.Config) configTypes.Config {
 		result.Signature.KeyDirectory = overlay.Signature.KeyDirectory
 	}
 
+	if len(overlay.Signature.KeyDirectories) > 0 {
+		result.Signature.KeyDirectories = overlay.Signature.KeyDirectories
+	}
+
 	if len(overlay.Signature.AllowedSigners) > 0 {
 		result.Signature.AllowedSigners = overlay.Signature.AllowedSigners
 	}
@@ -278,11 +849,81 @@ func mergeConfig(base, overlay configTypes.Config) configTypes.Config {
 		result.Signature.VerifyFormat = overlay.Signature.VerifyFormat
 	}
 
+	if overlay.Signature.MinRSABits != 0 {
+		result.Signature.MinRSABits = overlay.Signature.MinRSABits
+	}
+
+	if overlay.Signature.MinECBits != 0 {
+		result.Signature.MinECBits = overlay.Signature.MinECBits
+	}
+
+	if len(overlay.Signature.AllowedAlgorithms) > 0 {
+		result.Signature.AllowedAlgorithms = overlay.Signature.AllowedAlgorithms
+	}
+
+	if overlay.Signature.AllowSHA1 != result.Signature.AllowSHA1 {
+		result.Signature.AllowSHA1 = overlay.Signature.AllowSHA1
+	}
+
+	if overlay.Signature.SSHAgent != result.Signature.SSHAgent {
+		result.Signature.SSHAgent = overlay.Signature.SSHAgent
+	}
+
+	if overlay.Signature.KnownSigners != "" {
+		result.Signature.KnownSigners = overlay.Signature.KnownSigners
+	}
+
 	// Merge Identity config
 	if len(overlay.Identity.AllowedAuthors) > 0 {
 		result.Identity.AllowedAuthors = overlay.Identity.AllowedAuthors
 	}
 
+	if len(overlay.Identity.KeyScopes) > 0 {
+		result.Identity.KeyScopes = overlay.Identity.KeyScopes
+	}
+
+	// Merge plugins - replace the whole list if present
+	if len(overlay.Plugins) > 0 {
+		result.Plugins = overlay.Plugins
+	}
+
+	// Merge custom rules - replace the whole list if present
+	if len(overlay.CustomRules) > 0 {
+		result.CustomRules = overlay.CustomRules
+	}
+
+	// Merge message templates
+	if len(overlay.Messages) > 0 {
+		result.Messages = overlay.Messages
+	}
+
+	// Merge profile definitions, kept for listing them
+	if len(overlay.Profiles) > 0 {
+		result.Profiles = overlay.Profiles
+	}
+
+	// Merge i18n config
+	if overlay.I18n.Locale != "" {
+		result.I18n.Locale = overlay.I18n.Locale
+	}
+
+	if overlay.I18n.Directory != "" {
+		result.I18n.Directory = overlay.I18n.Directory
+	}
+
+	// Merge display config
+	if overlay.Display.Theme != "" {
+		result.Display.Theme = overlay.Display.Theme
+	}
+
+	if overlay.Display.ASCII {
+		result.Display.ASCII = true
+	}
+
+	if overlay.Display.LinkTemplate != "" {
+		result.Display.LinkTemplate = overlay.Display.LinkTemplate
+	}
+
 	return result
 }
 
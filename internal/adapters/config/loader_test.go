@@ -10,6 +10,7 @@ import (
 	"strings"
 	"testing"
 
+	configTypes "github.com/itiquette/gommitlint/internal/domain/config"
 	"github.com/stretchr/testify/require"
 )
 
@@ -265,6 +266,182 @@ func TestLoadConfigFromPath(t *testing.T) {
 		require.NotNil(t, cfg)
 		require.Equal(t, "json", cfg.Output)
 	})
+
+	t.Run("loads custom rules", func(t *testing.T) {
+		tmpDir := t.TempDir()
+		configFile := filepath.Join(tmpDir, ".gommitlint.yaml")
+
+		configContent := `gommitlint:
+  custom_rules:
+    - name: NoWIP
+      pattern: "(?i)wip"
+      forbid: true
+      severity: warning
+`
+		err := os.WriteFile(configFile, []byte(configContent), 0600)
+		require.NoError(t, err)
+
+		cfg, err := LoadConfigFromPath(configFile)
+		require.NoError(t, err)
+		require.Len(t, cfg.CustomRules, 1)
+		require.Equal(t, "NoWIP", cfg.CustomRules[0].Name)
+		require.True(t, cfg.CustomRules[0].Forbid)
+		require.Equal(t, "warning", cfg.CustomRules[0].Severity)
+	})
+
+	t.Run("rejects invalid custom rule pattern", func(t *testing.T) {
+		tmpDir := t.TempDir()
+		configFile := filepath.Join(tmpDir, ".gommitlint.yaml")
+
+		configContent := `gommitlint:
+  custom_rules:
+    - name: Broken
+      pattern: "("
+`
+		err := os.WriteFile(configFile, []byte(configContent), 0600)
+		require.NoError(t, err)
+
+		_, err = LoadConfigFromPath(configFile)
+		require.ErrorContains(t, err, "custom rule 1 has an invalid pattern")
+	})
+
+	t.Run("loads key strength policy", func(t *testing.T) {
+		configFile := filepath.Join(t.TempDir(), ".gommitlint.yaml")
+
+		configContent := `gommitlint:
+  signature:
+    min_rsa_bits: 3072
+    allowed_algorithms: ["ed25519"]
+    allow_sha1: true
+`
+		require.NoError(t, os.WriteFile(configFile, []byte(configContent), 0600))
+
+		cfg, err := LoadConfigFromPath(configFile)
+		require.NoError(t, err)
+		require.Equal(t, 3072, cfg.Signature.MinRSABits)
+		require.Equal(t, 256, cfg.Signature.MinECBits)
+		require.Equal(t, []string{"ed25519"}, cfg.Signature.AllowedAlgorithms)
+		require.True(t, cfg.Signature.AllowSHA1)
+	})
+
+	t.Run("rejects unknown key algorithm", func(t *testing.T) {
+		configFile := filepath.Join(t.TempDir(), ".gommitlint.yaml")
+
+		configContent := `gommitlint:
+  signature:
+    allowed_algorithms: ["rsa", "elgamal"]
+`
+		require.NoError(t, os.WriteFile(configFile, []byte(configContent), 0600))
+
+		_, err := LoadConfigFromPath(configFile)
+		require.ErrorContains(t, err, `unknown algorithm "elgamal"`)
+	})
+
+	t.Run("loads key directories and key scopes", func(t *testing.T) {
+		configFile := filepath.Join(t.TempDir(), ".gommitlint.yaml")
+
+		configContent := `gommitlint:
+  signature:
+    key_directory: keys
+    key_directories: [keys/contractors]
+  identity:
+    key_scopes:
+      - name: payments
+        directory: keys/payments
+        paths: ["payments/**"]
+`
+		require.NoError(t, os.WriteFile(configFile, []byte(configContent), 0600))
+
+		cfg, err := LoadConfigFromPath(configFile)
+		require.NoError(t, err)
+		require.Equal(t, []string{"keys", "keys/contractors"}, cfg.Signature.TrustedKeyDirectories())
+		require.Equal(t, []configTypes.KeyScopeConfig{
+			{Name: "payments", Directory: "keys/payments", Paths: []string{"payments/**"}},
+		}, cfg.Identity.KeyScopes)
+	})
+
+	t.Run("rejects key scope without authors or paths", func(t *testing.T) {
+		configFile := filepath.Join(t.TempDir(), ".gommitlint.yaml")
+
+		configContent := `gommitlint:
+  identity:
+    key_scopes:
+      - name: payments
+        directory: keys/payments
+`
+		require.NoError(t, os.WriteFile(configFile, []byte(configContent), 0600))
+
+		_, err := LoadConfigFromPath(configFile)
+		require.ErrorContains(t, err, "identity key scope 1 must")
+	})
+}
+
+func TestLoadProfileConfigFromPath(t *testing.T) {
+	configFile := filepath.Join(t.TempDir(), ".gommitlint.yaml")
+	configContent := `gommitlint:
+  message:
+    subject:
+      max_length: 60
+      case: upper
+  repo:
+    allow_merge_commits: false
+  profiles:
+    release:
+      message:
+        subject:
+          max_length: 50
+      rules:
+        enabled: [jirareference]
+    hotfix:
+      output: json
+`
+	require.NoError(t, os.WriteFile(configFile, []byte(configContent), 0600))
+
+	cfg, err := LoadProfileConfigFromPath(configFile, "")
+	require.NoError(t, err)
+	require.Equal(t, 60, cfg.Message.Subject.MaxLength)
+	require.Len(t, cfg.Profiles, 2)
+
+	// Only the keys the profile sets replace the top-level settings
+	cfg, err = LoadProfileConfigFromPath(configFile, "release")
+	require.NoError(t, err)
+	require.Equal(t, 50, cfg.Message.Subject.MaxLength)
+	require.Equal(t, "upper", cfg.Message.Subject.Case)
+	require.False(t, cfg.Repo.AllowMergeCommits)
+	require.Contains(t, cfg.Rules.Enabled, "jirareference")
+
+	_, err = LoadProfileConfigFromPath(configFile, "staging")
+	require.ErrorContains(t, err, `unknown profile "staging", defined profiles: hotfix, release`)
+
+	_, err = LoadProfileConfigFromPath(filepath.Join(t.TempDir(), "missing.yaml"), "release")
+	require.ErrorContains(t, err, "no configuration file was found")
+}
+
+func TestLoadProfileConfigFromPath_DisablesDefaultTrueBoolean(t *testing.T) {
+	configFile := filepath.Join(t.TempDir(), ".gommitlint.yaml")
+	configContent := `gommitlint:
+  repo:
+    allow_merge_commits: true
+  profiles:
+    strict:
+      message:
+        subject:
+          max_length: 33
+      repo:
+        allow_merge_commits: false
+`
+	require.NoError(t, os.WriteFile(configFile, []byte(configContent), 0600))
+
+	require.True(t, LoadDefaultConfig().Repo.AllowMergeCommits)
+
+	cfg, err := LoadProfileConfigFromPath(configFile, "")
+	require.NoError(t, err)
+	require.True(t, cfg.Repo.AllowMergeCommits)
+
+	cfg, err = LoadProfileConfigFromPath(configFile, "strict")
+	require.NoError(t, err)
+	require.Equal(t, 33, cfg.Message.Subject.MaxLength)
+	require.False(t, cfg.Repo.AllowMergeCommits)
 }
 
 // TestApplyRulePriority tests rule priority logic.
@@ -321,91 +498,23 @@ func TestApplyRulePriority(t *testing.T) {
 	}
 }
 
-// TestEnvironmentVariablesIgnored verifies that environment variables no longer affect configuration.
-func TestEnvironmentVariablesIgnored(t *testing.T) {
-	// Save original environment variables
-	originalVars := map[string]string{
-		"GOMMITLINT_OUTPUT":                    os.Getenv("GOMMITLINT_OUTPUT"),
-		"GOMMITLINT_SUBJECT_MAX_LENGTH":        os.Getenv("GOMMITLINT_SUBJECT_MAX_LENGTH"),
-		"GOMMITLINT_CONVENTIONAL_TYPES":        os.Getenv("GOMMITLINT_CONVENTIONAL_TYPES"),
-		"GOMMITLINT_REPO_REFERENCE_BRANCH":     os.Getenv("GOMMITLINT_REPO_REFERENCE_BRANCH"),
-		"GOMMITLINT_SIGNING_REQUIRE_SIGNATURE": os.Getenv("GOMMITLINT_SIGNING_REQUIRE_SIGNATURE"),
-		"GOMMITLINT_RULES_ENABLED":             os.Getenv("GOMMITLINT_RULES_ENABLED"),
-		"GOMMITLINT_RULES_DISABLED":            os.Getenv("GOMMITLINT_RULES_DISABLED"),
-	}
-
-	// Restore environment variables after test
-	defer func() {
-		for key, value := range originalVars {
-			if value != "" {
-				os.Setenv(key, value)
-			} else {
-				os.Unsetenv(key)
-			}
-		}
-	}()
-
-	t.Run("environment variables are ignored", func(t *testing.T) {
-		// Set environment variables that would have affected config in the past
-		os.Setenv("GOMMITLINT_OUTPUT", "should-be-ignored")
-		os.Setenv("GOMMITLINT_SUBJECT_MAX_LENGTH", "999")
-		os.Setenv("GOMMITLINT_CONVENTIONAL_TYPES", "ignored,values")
-		os.Setenv("GOMMITLINT_REPO_REFERENCE_BRANCH", "ignored-branch")
-		os.Setenv("GOMMITLINT_SIGNING_REQUIRE_SIGNATURE", "true")
-		os.Setenv("GOMMITLINT_RULES_ENABLED", "ignored-rule")
-		os.Setenv("GOMMITLINT_RULES_DISABLED", "another-ignored-rule")
-
-		// Load default config
-		cfg := LoadDefaultConfig()
-
-		// Environment variables should not affect the config
-		require.NotEqual(t, "should-be-ignored", cfg.Output)
-		require.NotEqual(t, 999, cfg.Message.Subject.MaxLength)
-		require.NotContains(t, cfg.Conventional.Types, "ignored")
-		require.NotEqual(t, "ignored-branch", cfg.Repo.ReferenceBranch)
-		require.NotContains(t, cfg.Rules.Enabled, "ignored-rule")
-		require.NotContains(t, cfg.Rules.Disabled, "another-ignored-rule")
-	})
-
-	t.Run("LoadConfig ignores environment variables", func(t *testing.T) {
-		// Set environment variables
-		os.Setenv("GOMMITLINT_OUTPUT", "env-output")
-		os.Setenv("GOMMITLINT_RULES_ENABLED", "env-rule")
-
-		// Load config (should only use defaults and file config, not env)
-		cfg, err := LoadConfig()
-		require.NoError(t, err)
-
-		// Environment variables should not affect the result
-		require.NotEqual(t, "env-output", cfg.Output)
-		require.NotContains(t, cfg.Rules.Enabled, "env-rule")
-	})
-
-	t.Run("LoadConfigFromPath ignores environment variables", func(t *testing.T) {
-		// Create a config file
-		tmpDir := t.TempDir()
-		configFile := filepath.Join(tmpDir, "test.yaml")
-		configContent := `gommitlint:
-  output: json
-  rules:
-    enabled:
-      - file-rule`
-		err := os.WriteFile(configFile, []byte(configContent), 0600)
-		require.NoError(t, err)
-
-		// Set conflicting environment variables
-		os.Setenv("GOMMITLINT_OUTPUT", "env-output")
-		os.Setenv("GOMMITLINT_RULES_ENABLED", "env-rule")
-
-		// Load config from file
-		cfg, err := LoadConfigFromPath(configFile)
-		require.NoError(t, err)
-
-		// Should use file config, not environment variables
-		require.Equal(t, "json", cfg.Output)
-		require.Contains(t, cfg.Rules.Enabled, "file-rule")
-		require.NotContains(t, cfg.Rules.Enabled, "env-rule")
-	})
+// TestLoadDefaultConfigIgnoresEnvironment verifies that environment variables do not affect the defaults.
+func TestLoadDefaultConfigIgnoresEnvironment(t *testing.T) {
+	t.Setenv("GOMMITLINT_OUTPUT", "json")
+	t.Setenv("GOMMITLINT_MESSAGE_SUBJECT_MAXLENGTH", "999")
+	t.Setenv("GOMMITLINT_CONVENTIONAL_TYPES", "ignored,values")
+	t.Setenv("GOMMITLINT_REPO_REFERENCEBRANCH", "ignored-branch")
+	t.Setenv("GOMMITLINT_RULES_ENABLED", "ignored-rule")
+	t.Setenv("GOMMITLINT_RULES_DISABLED", "another-ignored-rule")
+
+	cfg := LoadDefaultConfig()
+
+	require.NotEqual(t, "json", cfg.Output)
+	require.NotEqual(t, 999, cfg.Message.Subject.MaxLength)
+	require.NotContains(t, cfg.Conventional.Types, "ignored")
+	require.NotEqual(t, "ignored-branch", cfg.Repo.ReferenceBranch)
+	require.NotContains(t, cfg.Rules.Enabled, "ignored-rule")
+	require.NotContains(t, cfg.Rules.Disabled, "another-ignored-rule")
 }
 
 // TestLoadConfigWithRepoPath tests config loading with repository path.
@@ -468,6 +577,53 @@ func TestLoadConfigWithRepoPath(t *testing.T) {
 	}
 }
 
+// TestLoadConfigWithRepoPath_Fragments tests merging .gommitlint.d fragments.
+func TestLoadConfigWithRepoPath_Fragments(t *testing.T) {
+	xdgDir := t.TempDir()
+	t.Setenv("XDG_CONFIG_HOME", xdgDir)
+
+	repoDir := t.TempDir()
+
+	files := map[string]string{
+		filepath.Join(xdgDir, "gommitlint", "config.d", "00-org.yaml"): `gommitlint:
+  output: json
+  message:
+    subject:
+      max_length: 60`,
+		filepath.Join(repoDir, ".gommitlint.d", "10-team.yaml"): `gommitlint:
+  message:
+    subject:
+      max_length: 50
+      forbid_endings: ["!"]`,
+		filepath.Join(repoDir, ".gommitlint.d", "20-component.toml"): `[gommitlint.message.subject]
+max_length = 40`,
+		filepath.Join(repoDir, ".gommitlint.d", "notes.txt"): `gommitlint: [ignored`,
+		filepath.Join(repoDir, ".gommitlint.yaml"): `gommitlint:
+  message:
+    subject:
+      forbid_endings: ["."]`,
+	}
+
+	for path, content := range files {
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+		require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	}
+
+	require.Equal(t, []string{
+		filepath.Join(xdgDir, "gommitlint", "config.d", "00-org.yaml"),
+		filepath.Join(repoDir, ".gommitlint.d", "10-team.yaml"),
+		filepath.Join(repoDir, ".gommitlint.d", "20-component.toml"),
+		filepath.Join(repoDir, ".gommitlint.yaml"),
+	}, ConfigFiles(repoDir))
+
+	cfg, err := LoadConfigWithRepoPath(repoDir)
+	require.NoError(t, err)
+	require.Equal(t, "json", cfg.Output, "keys set by earlier fragments only are kept")
+	require.Equal(t, 40, cfg.Message.Subject.MaxLength, "later fragments take precedence")
+	require.Equal(t, []string{"."}, cfg.Message.Subject.ForbidEndings, "the configuration file takes precedence over fragments")
+	require.Equal(t, LoadDefaultConfig().Message.Subject.Case, cfg.Message.Subject.Case, "keys no layer sets keep their defaults")
+}
+
 // TestGetConfigSearchPathsForRepo tests repository-specific config search paths.
 func TestGetConfigSearchPathsForRepo(t *testing.T) {
 	// Save original XDG_CONFIG_HOME
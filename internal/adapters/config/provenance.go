@@ -0,0 +1,174 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package config
+
+import (
+	"maps"
+	"reflect"
+
+	configTypes "github.com/itiquette/gommitlint/internal/domain/config"
+)
+
+// Configuration layers reported as value sources.
+const (
+	SourceDefault = "default"
+	SourceFile    = "file"
+	SourceProfile = "profile"
+	SourceEnv     = "env"
+	SourceFlag    = "flag"
+)
+
+// ValueSource describes where an effective configuration value came from.
+type ValueSource struct {
+	Layer  string `json:"layer"`            // One of default, file, profile, env or flag
+	Detail string `json:"detail,omitempty"` // File path, variable name or flag name
+}
+
+// String formats the source for display, e.g. "env GOMMITLINT_OUTPUT".
+func (s ValueSource) String() string {
+	if s.Detail == "" {
+		return s.Layer
+	}
+
+	return s.Layer + " " + s.Detail
+}
+
+// Provenance maps configuration keys, such as message.subject.max_length, to their source.
+type Provenance map[string]ValueSource
+
+// WithFlag returns a copy of the provenance with key attributed to the command-line flag.
+func (p Provenance) WithFlag(key, flag string) Provenance {
+	result := make(Provenance, len(p))
+	for k, v := range p {
+		result[k] = v
+	}
+
+	result[key] = ValueSource{Layer: SourceFlag, Detail: flag}
+
+	return result
+}
+
+// ResolveProvenance determines which layer supplied each value of the effective configuration.
+// configPaths are the loaded configuration files, lowest precedence first, and profile the
+// selected profile, empty when none was.
+// A file value is only attributed to the file that set it last, and only if it survived
+// merging into the effective configuration.
+func ResolveProvenance(effective configTypes.Config, configPaths []string, profile string, lookup LookupFunc) Provenance {
+	effectiveValues := FlattenConfig(effective)
+	fileConfig, _ := LoadFilesProfileConfig(configPaths, profile)
+	fileValues := FlattenConfig(fileConfig)
+	fileKeys := fileKeySources(configPaths, "")
+	profileKeys := fileKeySources(configPaths, profile)
+
+	provenance := make(Provenance, len(effectiveValues))
+
+	for _, variable := range EnvVariables() {
+		if _, ok := lookup(variable.Name); ok {
+			provenance[variable.Key] = ValueSource{Layer: SourceEnv, Detail: variable.Name}
+
+			continue
+		}
+
+		survived := reflect.DeepEqual(effectiveValues[variable.Key], fileValues[variable.Key])
+
+		if profile != "" && profileKeys[variable.Key] != "" && survived {
+			provenance[variable.Key] = ValueSource{Layer: SourceProfile, Detail: profile}
+
+			continue
+		}
+
+		if configPath := fileKeys[variable.Key]; configPath != "" && survived {
+			provenance[variable.Key] = ValueSource{Layer: SourceFile, Detail: configPath}
+
+			continue
+		}
+
+		provenance[variable.Key] = ValueSource{Layer: SourceDefault}
+	}
+
+	return provenance
+}
+
+// FlattenConfig returns the leaf values of a configuration keyed by dotted configuration key.
+func FlattenConfig(cfg configTypes.Config) map[string]interface{} {
+	values := make(map[string]interface{})
+
+	_ = walkConfigFields(reflect.ValueOf(&cfg).Elem(), EnvPrefix, "", func(field reflect.Value, variable EnvVariable) error {
+		values[variable.Key] = field.Interface()
+
+		return nil
+	})
+
+	return values
+}
+
+// fileKeySources returns the last of configPaths setting each dotted key, relative to the
+// gommitlint root, or to the named profile when profile is not empty. Included files
+// count as setting the keys they set.
+func fileKeySources(configPaths []string, profile string) map[string]string {
+	sources := make(map[string]string)
+
+	if withIncludes, err := WithIncludes(configPaths); err == nil {
+		configPaths = withIncludes
+	}
+
+	for _, configPath := range configPaths {
+		for key := range fileKeySet(configPath, profile) {
+			sources[key] = configPath
+		}
+	}
+
+	return sources
+}
+
+// fileKeySet returns the dotted keys set in a configuration file, relative to the gommitlint root,
+// or those set by the named profile when profile is not empty.
+func fileKeySet(configPath, profile string) map[string]bool {
+	keys := make(map[string]bool)
+
+	if configPath == "" {
+		return keys
+	}
+
+	raw, err := readRawConfig(configPath)
+	if err != nil {
+		return keys
+	}
+
+	root, _ := raw[rootKey].(map[string]interface{})
+
+	if profile != "" {
+		profiles, _ := root["profiles"].(map[string]interface{})
+		root, _ = profiles[profile].(map[string]interface{})
+	}
+
+	if rulesSection, ok := root["rules"].(map[string]interface{}); ok {
+		root = maps.Clone(root)
+		root["rules"], _ = expandRuleShorthand(rulesSection)
+	}
+
+	delete(root, includeKey)
+
+	if root != nil {
+		collectKeys(root, "", keys)
+	}
+
+	return keys
+}
+
+// collectKeys adds the dotted paths of all leaf values in section to keys.
+func collectKeys(section map[string]interface{}, prefix string, keys map[string]bool) {
+	for key, value := range section {
+		path := joinKey(prefix, key)
+
+		if subsection, ok := value.(map[string]interface{}); ok {
+			collectKeys(subsection, path, keys)
+
+			continue
+		}
+
+		keys[path] = true
+	}
+}
@@ -0,0 +1,116 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolveProvenance(t *testing.T) {
+	configPath := filepath.Join(t.TempDir(), ".gommitlint.yaml")
+	configContent := `gommitlint:
+  message:
+    subject:
+      max_length: 60
+  spell:
+    locale: en_GB`
+	require.NoError(t, os.WriteFile(configPath, []byte(configContent), 0600))
+
+	env := map[string]string{"GOMMITLINT_SPELL_LOCALE": "en_US"}
+	lookup := func(key string) (string, bool) {
+		value, ok := env[key]
+
+		return value, ok
+	}
+
+	cfg := mergeConfig(LoadDefaultConfig(), LoadFileConfig(configPath))
+	cfg, err := ApplyEnvOverrides(cfg, lookup)
+	require.NoError(t, err)
+
+	provenance := ResolveProvenance(cfg, []string{configPath}, "", lookup)
+
+	tests := []struct {
+		key      string
+		expected ValueSource
+	}{
+		{key: "message.subject.max_length", expected: ValueSource{Layer: SourceFile, Detail: configPath}},
+		{key: "spell.locale", expected: ValueSource{Layer: SourceEnv, Detail: "GOMMITLINT_SPELL_LOCALE"}},
+		{key: "message.subject.case", expected: ValueSource{Layer: SourceDefault}},
+		{key: "output", expected: ValueSource{Layer: SourceDefault}},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.key, func(t *testing.T) {
+			require.Equal(t, testCase.expected, provenance[testCase.key])
+		})
+	}
+
+	require.Len(t, provenance, len(EnvVariables()), "every key should have a source")
+
+	withFlag := provenance.WithFlag("output", "--format")
+	require.Equal(t, "flag --format", withFlag["output"].String())
+	require.Equal(t, SourceDefault, provenance["output"].Layer, "original provenance is unchanged")
+}
+
+func TestResolveProvenance_Profile(t *testing.T) {
+	configPath := filepath.Join(t.TempDir(), ".gommitlint.yaml")
+	configContent := `gommitlint:
+  message:
+    subject:
+      max_length: 60
+      case: upper
+  profiles:
+    release:
+      message:
+        subject:
+          max_length: 50`
+	require.NoError(t, os.WriteFile(configPath, []byte(configContent), 0600))
+
+	lookup := func(string) (string, bool) { return "", false }
+
+	cfg, err := LoadProfileConfigFromPath(configPath, "release")
+	require.NoError(t, err)
+
+	provenance := ResolveProvenance(cfg, []string{configPath}, "release", lookup)
+	require.Equal(t, ValueSource{Layer: SourceProfile, Detail: "release"}, provenance["message.subject.max_length"])
+	require.Equal(t, ValueSource{Layer: SourceFile, Detail: configPath}, provenance["message.subject.case"])
+}
+
+func TestResolveProvenance_Fragments(t *testing.T) {
+	dir := t.TempDir()
+	basePath := filepath.Join(dir, "00-base.yaml")
+	teamPath := filepath.Join(dir, "10-team.yaml")
+
+	require.NoError(t, os.WriteFile(basePath, []byte(`gommitlint:
+  message:
+    subject:
+      max_length: 60
+      case: upper`), 0600))
+	require.NoError(t, os.WriteFile(teamPath, []byte(`gommitlint:
+  message:
+    subject:
+      max_length: 50`), 0600))
+
+	lookup := func(string) (string, bool) { return "", false }
+
+	cfg, err := LoadProfileConfigFromPaths([]string{basePath, teamPath}, "")
+	require.NoError(t, err)
+
+	provenance := ResolveProvenance(cfg, []string{basePath, teamPath}, "", lookup)
+	require.Equal(t, ValueSource{Layer: SourceFile, Detail: teamPath}, provenance["message.subject.max_length"])
+	require.Equal(t, ValueSource{Layer: SourceFile, Detail: basePath}, provenance["message.subject.case"])
+}
+
+func TestFlattenConfig(t *testing.T) {
+	values := FlattenConfig(NewConfigWithDefaults())
+
+	require.Equal(t, 72, values["message.subject.max_length"])
+	require.Equal(t, "text", values["output"])
+	require.Contains(t, values, "rules.enabled")
+}
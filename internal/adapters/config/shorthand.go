@@ -0,0 +1,140 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package config
+
+import (
+	"fmt"
+	"maps"
+	"reflect"
+	"slices"
+	"strings"
+
+	configTypes "github.com/itiquette/gommitlint/internal/domain/config"
+)
+
+// Rule levels of the rules shorthand, in which a rules section maps rule names to a level
+// instead of listing them: rules: {subject: error, jira: off, spell: warning}.
+const (
+	ruleLevelError   = "error"   // Enabled, failures fail validation
+	ruleLevelWarning = "warning" // Enabled, failures are reported as warnings
+	ruleLevelOff     = "off"     // Disabled
+)
+
+// rulesSettings holds the keys of the rules section, which the shorthand cannot name.
+var rulesSettings = sectionKeys(reflect.TypeOf(configTypes.RulesConfig{}))
+
+// expandRuleShorthand returns a copy of a rules section with the rules set by level moved
+// into the enabled, disabled and warnings lists. A level replaces whatever the lists of
+// the section say about the rule. true and false stand for error and off. Rules set to an
+// unknown level are left out and reported.
+func expandRuleShorthand(section map[string]interface{}) (map[string]interface{}, []Issue) {
+	expanded := make(map[string]interface{}, len(section))
+	levels := make(map[string]string)
+
+	var issues []Issue
+
+	for _, key := range sortedKeys(section) {
+		value := section[key]
+
+		if slices.Contains(rulesSettings, key) {
+			expanded[key] = value
+
+			continue
+		}
+
+		level, ok := ruleLevel(value)
+		if !ok {
+			if _, isSection := value.(map[string]interface{}); isSection {
+				expanded[key] = value // Left to lint as an unknown key
+
+				continue
+			}
+
+			issues = append(issues, Issue{
+				Key:     joinKey("rules", key),
+				Message: fmt.Sprintf("unknown rule level %s, expected error, warning or off", describeValue(value)),
+			})
+
+			continue
+		}
+
+		levels[strings.ToLower(strings.TrimSpace(key))] = level
+	}
+
+	if len(levels) == 0 {
+		return expanded, issues
+	}
+
+	lists := map[string][]string{
+		"enabled":  withoutRules(stringItems(section["enabled"]), levels),
+		"disabled": withoutRules(stringItems(section["disabled"]), levels),
+		"warnings": withoutRules(stringItems(section["warnings"]), levels),
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(levels)) {
+		switch levels[name] {
+		case ruleLevelError:
+			lists["enabled"] = append(lists["enabled"], name)
+		case ruleLevelWarning:
+			lists["enabled"] = append(lists["enabled"], name)
+			lists["warnings"] = append(lists["warnings"], name)
+		case ruleLevelOff:
+			lists["disabled"] = append(lists["disabled"], name)
+		}
+	}
+
+	for key, names := range lists {
+		items := make([]interface{}, 0, len(names))
+		for _, name := range names {
+			items = append(items, name)
+		}
+
+		expanded[key] = items
+	}
+
+	return expanded, issues
+}
+
+// ruleLevel returns the level a shorthand value sets.
+func ruleLevel(value interface{}) (string, bool) {
+	switch typed := value.(type) {
+	case bool:
+		if typed {
+			return ruleLevelError, true
+		}
+
+		return ruleLevelOff, true
+	case string:
+		level := strings.ToLower(strings.TrimSpace(typed))
+		if level == ruleLevelError || level == ruleLevelWarning || level == ruleLevelOff {
+			return level, true
+		}
+	}
+
+	return "", false
+}
+
+// withoutRules returns names without the rules set by level.
+func withoutRules(names []string, levels map[string]string) []string {
+	return slices.DeleteFunc(names, func(name string) bool {
+		_, set := levels[strings.ToLower(strings.TrimSpace(name))]
+
+		return set
+	})
+}
+
+// sectionKeys returns the yaml keys of the fields of a section type.
+func sectionKeys(sectionType reflect.Type) []string {
+	keys := make([]string, 0, sectionType.NumField())
+
+	for i := range sectionType.NumField() {
+		name := strings.Split(sectionType.Field(i).Tag.Get("yaml"), ",")[0]
+		if name != "" && name != "-" {
+			keys = append(keys, name)
+		}
+	}
+
+	return keys
+}
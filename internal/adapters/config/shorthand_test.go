@@ -0,0 +1,73 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package config
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestExpandRuleShorthand(t *testing.T) {
+	expanded, issues := expandRuleShorthand(map[string]interface{}{
+		"enabled":          []interface{}{"commitbody", "spell"},
+		"disabled":         []interface{}{"jira"},
+		"related_failures": "verbose",
+		"Subject":          "Error",
+		"jira":             "warning",
+		"spell":            false,
+		"signoff":          true,
+		"identity":         "loud",
+	})
+
+	require.Equal(t, []Issue{{Key: "rules.identity", Message: "unknown rule level string \"loud\", expected error, warning or off"}}, issues)
+	require.Equal(t, map[string]interface{}{
+		"enabled":          []interface{}{"commitbody", "jira", "signoff", "subject"},
+		"disabled":         []interface{}{"spell"},
+		"warnings":         []interface{}{"jira"},
+		"related_failures": "verbose",
+	}, expanded, "levels replace what the lists say about a rule")
+
+	unchanged, issues := expandRuleShorthand(map[string]interface{}{"enabled": []interface{}{"spell"}})
+	require.Empty(t, issues)
+	require.Equal(t, map[string]interface{}{"enabled": []interface{}{"spell"}}, unchanged)
+}
+
+func TestLoadConfigFromPath_RuleShorthand(t *testing.T) {
+	dir := writeConfigFiles(t, map[string]string{
+		"shared.yaml": `gommitlint:
+  rules:
+    spell: error
+    jira: error`,
+		".gommitlint.yaml": `gommitlint:
+  include: [shared.yaml]
+  rules:
+    subject: error
+    jira: off
+    spell: warning
+  profiles:
+    quiet:
+      rules:
+        spell: off`,
+	})
+
+	cfg, err := LoadConfigFromPath(filepath.Join(dir, ".gommitlint.yaml"))
+	require.NoError(t, err)
+	require.Equal(t, []string{"spell", "subject"}, cfg.Rules.Enabled)
+	require.Equal(t, []string{"jira"}, cfg.Rules.Disabled)
+	require.Equal(t, []string{"spell"}, cfg.Rules.Warnings)
+
+	cfg, err = LoadProfileConfigFromPath(filepath.Join(dir, ".gommitlint.yaml"), "quiet")
+	require.NoError(t, err)
+	require.Equal(t, []string{"subject"}, cfg.Rules.Enabled)
+	require.Equal(t, []string{"jira", "spell"}, cfg.Rules.Disabled)
+	require.Empty(t, cfg.Rules.Warnings)
+
+	_, err = LoadConfigFromPath(filepath.Join(writeConfigFiles(t, map[string]string{
+		".gommitlint.yaml": "gommitlint:\n  rules:\n    spell: loud\n",
+	}), ".gommitlint.yaml"))
+	require.ErrorContains(t, err, "rules.spell: unknown rule level")
+}
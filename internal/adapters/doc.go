@@ -7,12 +7,27 @@ Package adapters contains the infrastructure adapters that implement domain inte
 
 Following functional hexagonal architecture principles, this package contains:
 
+  - charset: Commit message decoding and line ending adapter (secondary/driven adapter)
+  - cla: Contributor license agreement lookup adapter (secondary/driven adapter)
   - cli: Command-line interface adapter (primary/driving adapter)
   - config: Configuration loading adapter (secondary/driven adapter)
   - git: Git repository adapter (secondary/driven adapter)
+  - gitexec: Git repository adapter running the git binary (secondary/driven adapter)
+  - github: GitHub pull request and issue adapter (secondary/driven adapter)
+  - i18n: Translation catalog adapter (secondary/driven adapter)
+  - jira: JIRA ticket lookup adapter (secondary/driven adapter)
   - logging: Logging adapter (secondary/driven adapter)
+  - mbox: Patch series adapter for git format-patch files (secondary/driven adapter)
+  - lsp: Language server adapter for editor diagnostics (primary/driving adapter)
   - output: Output formatting adapter (secondary/driven adapter)
+  - patchwork: Patchwork series adapter (secondary/driven adapter)
+  - plugin: External executable rule adapter (secondary/driven adapter)
+  - profiling: Rule timing adapter for --profile-rules (secondary/driven adapter)
+  - resultcache: Cache files of rule results for commit messages (secondary/driven adapter)
   - signing: Cryptographic verification adapter (secondary/driven adapter)
+  - ticketcache: Cache file of looked up tickets (secondary/driven adapter)
+  - tracing: OpenTelemetry tracing adapter (secondary/driven adapter)
+  - tui: Interactive terminal review adapter (primary/driving adapter)
 
 Adapters use value semantics and pure functions to translate between the external
 world and the core domain. Each adapter implements domain interfaces while keeping
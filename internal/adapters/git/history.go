@@ -0,0 +1,112 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package git
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/itiquette/gommitlint/internal/adapters/tracing"
+	"github.com/itiquette/gommitlint/internal/domain"
+)
+
+// GetMergeBase returns the hash of the best common ancestor of two refs.
+// When there are several, as after criss-cross merges, the first one found is returned.
+func (r *Repository) GetMergeBase(ctx context.Context, ref, other string) (string, error) {
+	_, span := tracing.Start(ctx, "git GetMergeBase")
+	defer span.End()
+
+	commit, err := r.commitObject(ref)
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve '%s': %w", ref, err)
+	}
+
+	otherCommit, err := r.commitObject(other)
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve '%s': %w", other, err)
+	}
+
+	if err := ctx.Err(); err != nil {
+		return "", err
+	}
+
+	bases, err := commit.MergeBase(otherCommit)
+	if err != nil {
+		return "", fmt.Errorf("find merge base: %w", err)
+	}
+
+	if len(bases) == 0 {
+		return "", fmt.Errorf("'%s' and '%s' have no common ancestor", ref, other)
+	}
+
+	return bases[0].Hash.String(), nil
+}
+
+// GetFirstParentRange returns the commits on the first-parent chain of to that are
+// not reachable from from, newest first. Commits brought in by the second parent of
+// a merge are left out, as in git log --first-parent from..to.
+func (r *Repository) GetFirstParentRange(ctx context.Context, from, to string) ([]domain.Commit, error) {
+	_, span := tracing.Start(ctx, "git GetFirstParentRange")
+	defer span.End()
+
+	fromCommit, err := r.commitObject(from)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve 'from' reference: %w", err)
+	}
+
+	commit, err := r.commitObject(to)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve 'to' reference: %w", err)
+	}
+
+	known := make(map[plumbing.Hash]bool)
+	if err := r.collectReachableCommits(ctx, fromCommit.Hash, known); err != nil {
+		return nil, fmt.Errorf("collect commits reachable from 'from': %w", err)
+	}
+
+	var commits []domain.Commit
+
+	for !known[commit.Hash] {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		commits = append(commits, r.convertCommit(commit))
+
+		if commit.NumParents() == 0 {
+			break
+		}
+
+		commit, err = commit.Parent(0)
+		if err != nil {
+			return nil, fmt.Errorf("get first parent: %w", err)
+		}
+	}
+
+	return commits, nil
+}
+
+// commitObject resolves a reference, hash or revision such as HEAD~2 to its commit,
+// peeling annotated tags.
+func (r *Repository) commitObject(ref string) (*object.Commit, error) {
+	hash, err := r.resolveReference(ref)
+	if err != nil {
+		resolved, revErr := r.repo.ResolveRevision(plumbing.Revision(ref))
+		if revErr != nil {
+			return nil, errors.Join(err, revErr)
+		}
+
+		hash = *resolved
+	}
+
+	if tag, err := r.repo.TagObject(hash); err == nil {
+		return tag.Commit()
+	}
+
+	return r.repo.CommitObject(hash)
+}
@@ -0,0 +1,139 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package git
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	gogit "github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/config"
+)
+
+// HooksPath returns the core.hooksPath setting for the repository at repoPath,
+// preferring the repository configuration over the global one.
+// An empty string means hooks are read from the default hooks directory.
+func HooksPath(repoPath string) (string, error) {
+	repo, err := gogit.PlainOpen(repoPath)
+	if err != nil {
+		return "", fmt.Errorf("open repository: %w", err)
+	}
+
+	local, err := repo.Config()
+	if err != nil {
+		return "", fmt.Errorf("read repository config: %w", err)
+	}
+
+	if hooksPath := local.Raw.Section("core").Option("hooksPath"); hooksPath != "" {
+		return hooksPath, nil
+	}
+
+	return GlobalHooksPath()
+}
+
+// Worktrees describes the git directory shared by the worktrees of a repository.
+type Worktrees struct {
+	CommonDir string   // Git directory whose hooks all worktrees run
+	Roots     []string // Working tree roots, main worktree first when there is one
+}
+
+// ListWorktrees finds the common git directory and all worktrees of the repository
+// containing the working tree at path, which may be the main or a linked worktree.
+func ListWorktrees(path string) (Worktrees, error) {
+	gitDir, err := resolveGitDir(path)
+	if err != nil {
+		return Worktrees{}, err
+	}
+
+	// Linked worktrees point to the shared directory with a commondir file
+	commonDir := gitDir
+
+	if content, err := os.ReadFile(filepath.Join(gitDir, "commondir")); err == nil {
+		commonDir = strings.TrimSpace(string(content))
+		if !filepath.IsAbs(commonDir) {
+			commonDir = filepath.Join(gitDir, commonDir)
+		}
+	}
+
+	commonDir = filepath.Clean(commonDir)
+	worktrees := Worktrees{CommonDir: commonDir}
+
+	if filepath.Base(commonDir) == ".git" {
+		worktrees.Roots = append(worktrees.Roots, filepath.Dir(commonDir))
+	}
+
+	entries, err := os.ReadDir(filepath.Join(commonDir, "worktrees"))
+	if err != nil && !os.IsNotExist(err) {
+		return Worktrees{}, fmt.Errorf("list worktrees: %w", err)
+	}
+
+	for _, entry := range entries {
+		// The gitdir file holds the path of the worktree's .git file
+		content, err := os.ReadFile(filepath.Join(commonDir, "worktrees", entry.Name(), "gitdir"))
+		if err != nil {
+			continue
+		}
+
+		worktrees.Roots = append(worktrees.Roots, filepath.Dir(strings.TrimSpace(string(content))))
+	}
+
+	return worktrees, nil
+}
+
+// resolveGitDir returns the git directory of the working tree at path,
+// following the gitfile used by linked worktrees and submodules.
+func resolveGitDir(path string) (string, error) {
+	dotGit := filepath.Join(path, ".git")
+
+	info, err := os.Stat(dotGit)
+	if err != nil {
+		return "", fmt.Errorf("not a git working tree: %w", err)
+	}
+
+	if info.IsDir() {
+		return dotGit, nil
+	}
+
+	content, err := os.ReadFile(dotGit)
+	if err != nil {
+		return "", fmt.Errorf("read gitfile: %w", err)
+	}
+
+	gitDir, found := strings.CutPrefix(strings.TrimSpace(string(content)), "gitdir: ")
+	if !found {
+		return "", fmt.Errorf("invalid gitfile: %s", dotGit)
+	}
+
+	if !filepath.IsAbs(gitDir) {
+		gitDir = filepath.Join(path, gitDir)
+	}
+
+	return filepath.Clean(gitDir), nil
+}
+
+// SetGlobalHooksPath sets core.hooksPath in the user's global git configuration.
+// The git command is used so the user's configuration file keeps its comments and layout.
+func SetGlobalHooksPath(ctx context.Context, hooksDir string) error {
+	output, err := exec.CommandContext(ctx, "git", "config", "--global", "core.hooksPath", hooksDir).CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("set global core.hooksPath: %w: %s", err, strings.TrimSpace(string(output)))
+	}
+
+	return nil
+}
+
+// GlobalHooksPath returns core.hooksPath from the user's global git configuration.
+func GlobalHooksPath() (string, error) {
+	global, err := config.LoadConfig(config.GlobalScope)
+	if err != nil {
+		return "", fmt.Errorf("read global config: %w", err)
+	}
+
+	return global.Raw.Section("core").Option("hooksPath"), nil
+}
@@ -0,0 +1,42 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package git
+
+import (
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestListWorktrees(t *testing.T) {
+	repoPath, err := filepath.EvalSymlinks(t.TempDir())
+	require.NoError(t, err)
+
+	run := func(args ...string) {
+		cmd := exec.Command("git", args...)
+		cmd.Dir = repoPath
+		require.NoError(t, cmd.Run(), "git %v", args)
+	}
+
+	run("init", "--quiet")
+	run("-c", "user.name=Test", "-c", "user.email=test@example.com", "commit", "--allow-empty", "--quiet", "-m", "Initial commit")
+
+	linkedPath := filepath.Join(repoPath, "..", filepath.Base(repoPath)+"-linked")
+	linkedPath = filepath.Clean(linkedPath)
+	run("worktree", "add", "--quiet", linkedPath)
+	t.Cleanup(func() { run("worktree", "remove", "--force", linkedPath) })
+
+	for _, path := range []string{repoPath, linkedPath} {
+		worktrees, err := ListWorktrees(path)
+		require.NoError(t, err)
+		require.Equal(t, filepath.Join(repoPath, ".git"), worktrees.CommonDir)
+		require.Equal(t, []string{repoPath, linkedPath}, worktrees.Roots)
+	}
+
+	_, err = ListWorktrees(t.TempDir())
+	require.Error(t, err)
+}
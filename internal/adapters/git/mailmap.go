@@ -0,0 +1,56 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package git
+
+import (
+	"io"
+
+	gogit "github.com/go-git/go-git/v5"
+	"github.com/itiquette/gommitlint/internal/domain"
+)
+
+// maxMailmapSize limits how much of a .mailmap file is read.
+const maxMailmapSize = 1 << 20
+
+// loadMailmap reads the .mailmap of the repository from the working tree, or from
+// HEAD in bare repositories. A missing or unreadable file gives an empty mailmap.
+func loadMailmap(repo *gogit.Repository) domain.Mailmap {
+	if worktree, err := repo.Worktree(); err == nil {
+		file, err := worktree.Filesystem.Open(".mailmap")
+		if err != nil {
+			return domain.Mailmap{}
+		}
+		defer file.Close()
+
+		content, err := io.ReadAll(io.LimitReader(file, maxMailmapSize))
+		if err != nil {
+			return domain.Mailmap{}
+		}
+
+		return domain.ParseMailmap(string(content))
+	}
+
+	head, err := repo.Head()
+	if err != nil {
+		return domain.Mailmap{}
+	}
+
+	commit, err := repo.CommitObject(head.Hash())
+	if err != nil {
+		return domain.Mailmap{}
+	}
+
+	file, err := commit.File(".mailmap")
+	if err != nil || file.Size > maxMailmapSize {
+		return domain.Mailmap{}
+	}
+
+	content, err := file.Contents()
+	if err != nil {
+		return domain.Mailmap{}
+	}
+
+	return domain.ParseMailmap(content)
+}
@@ -0,0 +1,294 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package git
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	gitconfig "github.com/go-git/go-git/v5/config"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/filemode"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/itiquette/gommitlint/internal/domain"
+)
+
+// WaiverNotesRef is the notes ref waivers are recorded in, readable with
+// git notes --ref=gommitlint show <commit>.
+const WaiverNotesRef = "refs/notes/gommitlint"
+
+// GetWaivers returns the waivers recorded in the waiver note of a commit.
+func (r *Repository) GetWaivers(_ context.Context, ref string) ([]domain.Waiver, error) {
+	commit, err := r.commitObject(ref)
+	if err != nil {
+		return nil, fmt.Errorf("get commit: %w", err)
+	}
+
+	tree, _, err := r.notesTree()
+	if err != nil || tree == nil {
+		return nil, err
+	}
+
+	note, _, err := readNote(tree, commit.Hash.String(), "")
+	if err != nil {
+		return nil, err
+	}
+
+	return domain.ParseWaivers(note), nil
+}
+
+// AddWaiver appends a waiver to the waiver note of a commit, committing the change
+// to the notes ref the way git notes append does.
+func (r *Repository) AddWaiver(_ context.Context, ref string, waiver domain.Waiver) error {
+	if err := waiver.Validate(); err != nil {
+		return err
+	}
+
+	commit, err := r.commitObject(ref)
+	if err != nil {
+		return fmt.Errorf("get commit: %w", err)
+	}
+
+	tree, parent, err := r.notesTree()
+	if err != nil {
+		return err
+	}
+
+	note, path := "", commit.Hash.String()
+
+	if tree != nil {
+		note, path, err = readNote(tree, commit.Hash.String(), "")
+		if err != nil {
+			return err
+		}
+
+		if path == "" {
+			path = commit.Hash.String()
+		}
+	}
+
+	if note != "" {
+		note = strings.TrimRight(note, "\n") + "\n\n"
+	}
+
+	blobHash, err := r.storeBlob(note + waiver.String())
+	if err != nil {
+		return err
+	}
+
+	treeHash, err := r.storeTree(tree, strings.Split(path, "/"), blobHash)
+	if err != nil {
+		return err
+	}
+
+	identity := r.UserIdentity()
+	signature := object.Signature{Name: identity.Name(), Email: identity.Email(), When: time.Now()}
+
+	if signature.Name == "" {
+		signature.Name = "gommitlint"
+	}
+
+	notesCommit := &object.Commit{
+		Author:    signature,
+		Committer: signature,
+		Message:   "Notes added by 'gommitlint waive'\n",
+		TreeHash:  treeHash,
+	}
+
+	var oldRef *plumbing.Reference
+
+	if parent != nil {
+		notesCommit.ParentHashes = []plumbing.Hash{parent.Hash}
+		oldRef = plumbing.NewHashReference(WaiverNotesRef, parent.Hash)
+	}
+
+	encoded := r.repo.Storer.NewEncodedObject()
+	if err := notesCommit.Encode(encoded); err != nil {
+		return fmt.Errorf("encode notes commit: %w", err)
+	}
+
+	notesHash, err := r.repo.Storer.SetEncodedObject(encoded)
+	if err != nil {
+		return fmt.Errorf("store notes commit: %w", err)
+	}
+
+	// Fail rather than drop a waiver added concurrently
+	if err := r.repo.Storer.CheckAndSetReference(plumbing.NewHashReference(WaiverNotesRef, notesHash), oldRef); err != nil {
+		return fmt.Errorf("update %s: %w", WaiverNotesRef, err)
+	}
+
+	return nil
+}
+
+// UserIdentity returns the user.name and user.email configured for the repository,
+// falling back to the global configuration.
+func (r *Repository) UserIdentity() domain.Identity {
+	cfg, err := r.repo.ConfigScoped(gitconfig.GlobalScope)
+	if err != nil {
+		return domain.Identity{}
+	}
+
+	return domain.NewIdentity(cfg.User.Name, cfg.User.Email)
+}
+
+// notesTree returns the tree and commit of the waiver notes ref, both nil when no
+// waiver has been recorded yet.
+func (r *Repository) notesTree() (*object.Tree, *object.Commit, error) {
+	ref, err := r.repo.Reference(WaiverNotesRef, true)
+	if errors.Is(err, plumbing.ErrReferenceNotFound) {
+		return nil, nil, nil
+	}
+
+	if err != nil {
+		return nil, nil, fmt.Errorf("read %s: %w", WaiverNotesRef, err)
+	}
+
+	commit, err := r.repo.CommitObject(ref.Hash())
+	if err != nil {
+		return nil, nil, fmt.Errorf("read %s: %w", WaiverNotesRef, err)
+	}
+
+	tree, err := commit.Tree()
+	if err != nil {
+		return nil, nil, fmt.Errorf("read %s: %w", WaiverNotesRef, err)
+	}
+
+	return tree, commit, nil
+}
+
+// readNote finds the note of the object name in a notes tree, which git may split
+// into fan-out directories such as ab/cdef... once it holds many notes. It returns
+// the note and its path, both empty when the object has no note.
+func readNote(tree *object.Tree, name, prefix string) (string, string, error) {
+	for _, entry := range tree.Entries {
+		switch {
+		case entry.Mode.IsFile() && entry.Name == name:
+			file, err := tree.TreeEntryFile(&entry)
+			if err != nil {
+				return "", "", fmt.Errorf("read note: %w", err)
+			}
+
+			content, err := readBlob(file)
+			if err != nil {
+				return "", "", err
+			}
+
+			return content, prefix + entry.Name, nil
+		case entry.Mode == filemode.Dir && len(entry.Name) < len(name) && strings.HasPrefix(name, entry.Name):
+			subtree, err := tree.Tree(entry.Name)
+			if err != nil {
+				return "", "", fmt.Errorf("read notes tree: %w", err)
+			}
+
+			return readNote(subtree, name[len(entry.Name):], prefix+entry.Name+"/")
+		}
+	}
+
+	return "", "", nil
+}
+
+// readBlob returns the content of a file of a notes tree.
+func readBlob(file *object.File) (string, error) {
+	reader, err := file.Reader()
+	if err != nil {
+		return "", fmt.Errorf("read note: %w", err)
+	}
+	defer reader.Close()
+
+	content, err := io.ReadAll(reader)
+	if err != nil {
+		return "", fmt.Errorf("read note: %w", err)
+	}
+
+	return string(content), nil
+}
+
+// storeBlob writes content to the object database.
+func (r *Repository) storeBlob(content string) (plumbing.Hash, error) {
+	encoded := r.repo.Storer.NewEncodedObject()
+	encoded.SetType(plumbing.BlobObject)
+
+	writer, err := encoded.Writer()
+	if err != nil {
+		return plumbing.ZeroHash, fmt.Errorf("write note: %w", err)
+	}
+
+	if _, err := writer.Write([]byte(content)); err != nil {
+		return plumbing.ZeroHash, fmt.Errorf("write note: %w", err)
+	}
+
+	if err := writer.Close(); err != nil {
+		return plumbing.ZeroHash, fmt.Errorf("write note: %w", err)
+	}
+
+	return r.repo.Storer.SetEncodedObject(encoded)
+}
+
+// storeTree writes a copy of tree, which may be nil, with the file at path set to
+// blob, and returns the hash of the new tree.
+func (r *Repository) storeTree(tree *object.Tree, path []string, blob plumbing.Hash) (plumbing.Hash, error) {
+	var entries []object.TreeEntry
+	if tree != nil {
+		entries = append(entries, tree.Entries...)
+	}
+
+	entry := object.TreeEntry{Name: path[0], Mode: filemode.Regular, Hash: blob}
+
+	if len(path) > 1 {
+		var subtree *object.Tree
+
+		if tree != nil {
+			subtree, _ = tree.Tree(path[0])
+		}
+
+		subtreeHash, err := r.storeTree(subtree, path[1:], blob)
+		if err != nil {
+			return plumbing.ZeroHash, err
+		}
+
+		entry = object.TreeEntry{Name: path[0], Mode: filemode.Dir, Hash: subtreeHash}
+	}
+
+	entries = replaceEntry(entries, entry)
+
+	// Git orders tree entries by name, comparing directories as if they ended in a slash
+	sort.Slice(entries, func(i, j int) bool {
+		return treeSortName(entries[i]) < treeSortName(entries[j])
+	})
+
+	encoded := r.repo.Storer.NewEncodedObject()
+	if err := (&object.Tree{Entries: entries}).Encode(encoded); err != nil {
+		return plumbing.ZeroHash, fmt.Errorf("encode notes tree: %w", err)
+	}
+
+	return r.repo.Storer.SetEncodedObject(encoded)
+}
+
+// replaceEntry replaces the entry with the same name, or appends it.
+func replaceEntry(entries []object.TreeEntry, entry object.TreeEntry) []object.TreeEntry {
+	for i := range entries {
+		if entries[i].Name == entry.Name {
+			entries[i] = entry
+
+			return entries
+		}
+	}
+
+	return append(entries, entry)
+}
+
+// treeSortName returns the name git sorts a tree entry by.
+func treeSortName(entry object.TreeEntry) string {
+	if entry.Mode == filemode.Dir {
+		return entry.Name + "/"
+	}
+
+	return entry.Name
+}
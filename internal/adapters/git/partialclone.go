@@ -0,0 +1,46 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package git
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/itiquette/gommitlint/internal/domain"
+)
+
+// isPartialClone reports whether the repository was cloned with a filter, so that git
+// fetches missing objects from a promisor remote on demand. Clones record it with
+// remote.<name>.promisor, older git versions with extensions.partialclone.
+func (r *Repository) isPartialClone() bool {
+	cfg, err := r.repo.Config()
+	if err != nil {
+		return false
+	}
+
+	if cfg.Raw.Section("extensions").Option("partialclone") != "" {
+		return true
+	}
+
+	for _, remote := range cfg.Raw.Section("remote").Subsections {
+		if strings.EqualFold(remote.Option("promisor"), "true") {
+			return true
+		}
+	}
+
+	return false
+}
+
+// missingObjectError wraps err with domain.ErrMissingObjects when it reports an object
+// a partial clone has not fetched, so that callers can tell it from a corrupt repository.
+func (r *Repository) missingObjectError(err error) error {
+	if errors.Is(err, plumbing.ErrObjectNotFound) && r.isPartialClone() {
+		return fmt.Errorf("%w: %w", domain.ErrMissingObjects, err)
+	}
+
+	return err
+}
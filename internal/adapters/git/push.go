@@ -0,0 +1,115 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package git
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/itiquette/gommitlint/internal/adapters/tracing"
+	"github.com/itiquette/gommitlint/internal/domain"
+)
+
+// GetOutgoingCommits returns the commits of a push update that the remote does not have.
+// Updates of an existing remote ref send the commits between the remote and local hash.
+// For new refs, or when the remote hash has not been fetched, commits reachable from
+// the remote-tracking branches of remote are treated as already on the remote.
+func (r *Repository) GetOutgoingCommits(ctx context.Context, remote string, update domain.PushUpdate) ([]domain.Commit, error) {
+	_, span := tracing.Start(ctx, "git GetOutgoingCommits")
+	defer span.End()
+
+	if update.IsDeletion() {
+		return nil, nil
+	}
+
+	if !update.IsNewRef() {
+		if _, err := r.repo.CommitObject(plumbing.NewHash(update.RemoteHash)); err == nil {
+			return r.GetCommitRange(ctx, update.RemoteHash, update.LocalHash)
+		}
+	}
+
+	localHash := plumbing.NewHash(update.LocalHash)
+	if _, err := r.repo.CommitObject(localHash); err != nil {
+		return nil, fmt.Errorf("get pushed commit: %w", err)
+	}
+
+	known := make(map[plumbing.Hash]bool)
+
+	for _, hash := range r.remoteTrackingHashes(remote) {
+		if err := r.collectReachableCommits(ctx, hash, known); err != nil {
+			return nil, fmt.Errorf("collect commits of remote %s: %w", remote, err)
+		}
+	}
+
+	outgoing := make(map[plumbing.Hash]bool)
+	if err := r.collectUnknownCommits(ctx, localHash, known, outgoing); err != nil {
+		return nil, fmt.Errorf("collect outgoing commits: %w", err)
+	}
+
+	commits := make([]domain.Commit, 0, len(outgoing))
+
+	for hash := range outgoing {
+		commit, err := r.repo.CommitObject(hash)
+		if err != nil {
+			return nil, fmt.Errorf("get commit object: %w", err)
+		}
+
+		commits = append(commits, r.convertCommit(commit))
+	}
+
+	return commits, nil
+}
+
+// remoteTrackingHashes returns the commits the remote-tracking branches of remote point to.
+// A remote given as URL has no tracking branches.
+func (r *Repository) remoteTrackingHashes(remote string) []plumbing.Hash {
+	refs, err := r.repo.References()
+	if err != nil {
+		return nil
+	}
+
+	prefix := "refs/remotes/" + remote + "/"
+
+	var hashes []plumbing.Hash
+
+	_ = refs.ForEach(func(ref *plumbing.Reference) error {
+		if ref.Type() == plumbing.HashReference && strings.HasPrefix(ref.Name().String(), prefix) {
+			hashes = append(hashes, ref.Hash())
+		}
+
+		return nil
+	})
+
+	return hashes
+}
+
+// collectUnknownCommits collects the commits reachable from hash that are not in known,
+// stopping at known commits and at the deadline of ctx.
+func (r *Repository) collectUnknownCommits(ctx context.Context, hash plumbing.Hash, known, collected map[plumbing.Hash]bool) error {
+	if known[hash] || collected[hash] {
+		return nil
+	}
+
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	collected[hash] = true
+
+	commit, err := r.repo.CommitObject(hash)
+	if err != nil {
+		return err
+	}
+
+	for _, parentHash := range commit.ParentHashes {
+		if err := r.collectUnknownCommits(ctx, parentHash, known, collected); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -5,21 +5,50 @@
 package git
 
 import (
+	"container/heap"
 	"context"
 	"errors"
 	"fmt"
+	"io"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
+	"time"
 
 	gogit "github.com/go-git/go-git/v5"
 	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/filemode"
 	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/itiquette/gommitlint/internal/adapters/charset"
+	"github.com/itiquette/gommitlint/internal/adapters/tracing"
 	"github.com/itiquette/gommitlint/internal/domain"
 )
 
 // Repository implements the CommitRepository port.
 type Repository struct {
-	repo *gogit.Repository
+	repo    *gogit.Repository
+	mailmap domain.Mailmap
 }
 
+// Ensure Repository implements the domain interfaces it is used through.
+var (
+	_ domain.Repository             = (*Repository)(nil)
+	_ domain.SubmoduleResolver      = (*Repository)(nil)
+	_ domain.PushResolver           = (*Repository)(nil)
+	_ domain.BranchResolver         = (*Repository)(nil)
+	_ domain.CurrentBranchResolver  = (*Repository)(nil)
+	_ domain.RepositoryNameResolver = (*Repository)(nil)
+	_ domain.PathResolver           = (*Repository)(nil)
+	_ domain.HistoryResolver        = (*Repository)(nil)
+	_ domain.WaiverResolver         = (*Repository)(nil)
+	_ domain.TagResolver            = (*Repository)(nil)
+	_ domain.UpstreamResolver       = (*Repository)(nil)
+	_ domain.BehindCounter          = (*Repository)(nil)
+	_ domain.DiffStatResolver       = (*Repository)(nil)
+	_ domain.RemoteURLResolver      = (*Repository)(nil)
+)
+
 // NewRepository opens a git repository at the given path.
 func NewRepository(path string) (*Repository, error) {
 	repo, err := gogit.PlainOpen(path)
@@ -27,19 +56,16 @@ func NewRepository(path string) (*Repository, error) {
 		return nil, fmt.Errorf("open repository: %w", err)
 	}
 
-	return &Repository{repo: repo}, nil
+	return &Repository{repo: repo, mailmap: loadMailmap(repo)}, nil
 }
 
 // GetCommit retrieves a single commit by hash or reference.
-func (r *Repository) GetCommit(_ context.Context, ref string) (domain.Commit, error) {
-	// Try to resolve as a reference first (handles HEAD, branch names, etc.)
-	hash, err := r.resolveReference(ref)
-	if err != nil {
-		// If reference resolution fails, try as a direct hash
-		hash = plumbing.NewHash(ref)
-	}
+func (r *Repository) GetCommit(ctx context.Context, ref string) (domain.Commit, error) {
+	_, span := tracing.Start(ctx, "git GetCommit")
+	defer span.End()
 
-	commit, err := r.repo.CommitObject(hash)
+	// References, full and abbreviated hashes and revisions such as HEAD~2
+	commit, err := r.commitObject(ref)
 	if err != nil {
 		return domain.Commit{}, fmt.Errorf("get commit: %w", err)
 	}
@@ -78,72 +104,236 @@ func (r *Repository) resolveReference(ref string) (plumbing.Hash, error) {
 }
 
 // GetCommitRange retrieves commits in a range (from..to).
-// Returns all commits reachable from 'to' but not reachable from 'from'.
-func (r *Repository) GetCommitRange(_ context.Context, fromRef, toRef string) ([]domain.Commit, error) {
-	// Resolve references to hashes
-	fromHash, err := r.resolveReference(fromRef)
-	if err != nil {
-		// If reference resolution fails, try as a direct hash
-		fromHash = plumbing.NewHash(fromRef)
-	}
+// Returns all commits reachable from 'to' but not reachable from 'from', newest first.
+func (r *Repository) GetCommitRange(ctx context.Context, fromRef, toRef string) ([]domain.Commit, error) {
+	ctx, span := tracing.Start(ctx, "git GetCommitRange")
+	defer span.End()
 
-	toHash, err := r.resolveReference(toRef)
+	var commits []domain.Commit
+
+	err := r.StreamCommitRange(ctx, fromRef, toRef, func(commit domain.Commit) error {
+		commits = append(commits, commit)
+
+		return nil
+	})
 	if err != nil {
-		// If reference resolution fails, try as a direct hash
-		toHash = plumbing.NewHash(toRef)
+		return nil, err
 	}
 
-	// Validate that both commits exist
-	_, err = r.repo.CommitObject(fromHash)
+	return commits, nil
+}
+
+// StreamCommitRange calls visit with each commit in a range (from..to) in the order of
+// git rev-list --date-order, converting the commit objects one at a time. Only the
+// hashes, parents and dates of the reachable commits are kept.
+func (r *Repository) StreamCommitRange(ctx context.Context, fromRef, toRef string, visit func(domain.Commit) error) error {
+	return r.streamRange(ctx, fromRef, toRef, false, visit)
+}
+
+// StreamCommitRangeOldestFirst calls visit with each commit in a range (from..to) oldest
+// first, the reverse of StreamCommitRange.
+func (r *Repository) StreamCommitRangeOldestFirst(ctx context.Context, fromRef, toRef string, visit func(domain.Commit) error) error {
+	return r.streamRange(ctx, fromRef, toRef, true, visit)
+}
+
+// streamRange visits the commits of the range from..to in date order, or in its reverse.
+func (r *Repository) streamRange(ctx context.Context, fromRef, toRef string, reverse bool, visit func(domain.Commit) error) error {
+	// Resolve references to commits, peeling annotated tags
+	fromCommit, err := r.commitObject(fromRef)
 	if err != nil {
-		return nil, fmt.Errorf("failed to resolve 'from' reference: %w", err)
+		return fmt.Errorf("failed to resolve 'from' reference: %w", err)
 	}
 
-	_, err = r.repo.CommitObject(toHash)
+	toCommit, err := r.commitObject(toRef)
 	if err != nil {
-		return nil, fmt.Errorf("failed to resolve 'to' reference: %w", err)
+		return fmt.Errorf("failed to resolve 'to' reference: %w", err)
 	}
 
+	fromHash, toHash := fromCommit.Hash, toCommit.Hash
+
 	// Get all commits reachable from 'to'
 	reachableFromTo := make(map[plumbing.Hash]bool)
 
-	err = r.collectReachableCommits(toHash, reachableFromTo)
+	err = r.collectReachableCommits(ctx, toHash, reachableFromTo)
 	if err != nil {
-		return nil, fmt.Errorf("collect commits reachable from 'to': %w", err)
+		return fmt.Errorf("collect commits reachable from 'to': %w", err)
 	}
 
 	// Get all commits reachable from 'from'
 	reachableFromFrom := make(map[plumbing.Hash]bool)
 
-	err = r.collectReachableCommits(fromHash, reachableFromFrom)
+	err = r.collectReachableCommits(ctx, fromHash, reachableFromFrom)
 	if err != nil {
-		return nil, fmt.Errorf("collect commits reachable from 'from': %w", err)
+		return fmt.Errorf("collect commits reachable from 'from': %w", err)
 	}
 
-	// Find commits in range: reachable from 'to' but not from 'from'
-	var commits []domain.Commit
+	// Visit commits in range: reachable from 'to' but not from 'from'
+	inRange := make(map[plumbing.Hash]bool)
 
 	for hash := range reachableFromTo {
 		if !reachableFromFrom[hash] {
-			commit, err := r.repo.CommitObject(hash)
-			if err != nil {
-				return nil, fmt.Errorf("get commit object: %w", err)
+			inRange[hash] = true
+		}
+	}
+
+	ordered, err := r.dateOrder(ctx, inRange)
+	if err != nil {
+		return err
+	}
+
+	if reverse {
+		slices.Reverse(ordered)
+	}
+
+	for _, hash := range ordered {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		commit, err := r.repo.CommitObject(hash)
+		if err != nil {
+			return fmt.Errorf("get commit object: %w", err)
+		}
+
+		if err := visit(r.convertCommit(commit)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// rangeCommit is what ordering a range keeps of each of its commits.
+type rangeCommit struct {
+	parents  []plumbing.Hash
+	children int
+	when     time.Time
+}
+
+// dateOrder returns the hashes of inRange newest first, as git rev-list --date-order
+// lists them: no commit before all of its children, and otherwise by commit date.
+// Commits of equal date keep the order in which they became ready.
+func (r *Repository) dateOrder(ctx context.Context, inRange map[plumbing.Hash]bool) ([]plumbing.Hash, error) {
+	commits := make(map[plumbing.Hash]*rangeCommit, len(inRange))
+
+	for hash := range inRange {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		commit, err := r.repo.CommitObject(hash)
+		if err != nil {
+			return nil, fmt.Errorf("get commit object: %w", err)
+		}
+
+		commits[hash] = &rangeCommit{parents: commit.ParentHashes, when: commit.Committer.When}
+	}
+
+	for _, commit := range commits {
+		for _, parent := range commit.parents {
+			if parentCommit, found := commits[parent]; found {
+				parentCommit.children++
+			}
+		}
+	}
+
+	queue := &commitQueue{}
+
+	// Sorted by hash, so that tips of equal date are listed alike on every run
+	tips := make([]plumbing.Hash, 0, 1)
+
+	for hash, commit := range commits {
+		if commit.children == 0 {
+			tips = append(tips, hash)
+		}
+	}
+
+	sort.Slice(tips, func(i, j int) bool { return tips[i].String() < tips[j].String() })
+
+	for _, hash := range tips {
+		queue.push(hash, commits[hash].when)
+	}
+
+	ordered := make([]plumbing.Hash, 0, len(commits))
+
+	for queue.Len() > 0 {
+		hash := queue.pop()
+		ordered = append(ordered, hash)
+
+		for _, parent := range commits[hash].parents {
+			parentCommit, found := commits[parent]
+			if !found {
+				continue
 			}
 
-			commits = append(commits, r.convertCommit(commit))
+			parentCommit.children--
+			if parentCommit.children == 0 {
+				queue.push(parent, parentCommit.when)
+			}
 		}
 	}
 
-	return commits, nil
+	return ordered, nil
+}
+
+// commitQueue orders the commits ready to be listed newest first, and commits of equal
+// date in the order they were pushed.
+type commitQueue struct {
+	items []queuedCommit
+	count int
+}
+
+// queuedCommit is a commit waiting in a commitQueue.
+type queuedCommit struct {
+	hash  plumbing.Hash
+	when  time.Time
+	order int
+}
+
+func (q *commitQueue) Len() int { return len(q.items) }
+
+func (q *commitQueue) Less(i, j int) bool {
+	if !q.items[i].when.Equal(q.items[j].when) {
+		return q.items[i].when.After(q.items[j].when)
+	}
+
+	return q.items[i].order < q.items[j].order
+}
+
+func (q *commitQueue) Swap(i, j int) { q.items[i], q.items[j] = q.items[j], q.items[i] }
+
+func (q *commitQueue) Push(item any) { q.items = append(q.items, item.(queuedCommit)) }
+
+func (q *commitQueue) Pop() any {
+	last := q.items[len(q.items)-1]
+	q.items = q.items[:len(q.items)-1]
+
+	return last
+}
+
+// push queues the commit with hash and date when.
+func (q *commitQueue) push(hash plumbing.Hash, when time.Time) {
+	heap.Push(q, queuedCommit{hash: hash, when: when, order: q.count})
+	q.count++
+}
+
+// pop removes and returns the newest queued commit.
+func (q *commitQueue) pop() plumbing.Hash {
+	return heap.Pop(q).(queuedCommit).hash
 }
 
 // collectReachableCommits recursively collects all commits reachable from the given hash.
-func (r *Repository) collectReachableCommits(hash plumbing.Hash, reachable map[plumbing.Hash]bool) error {
+// The walk stops with the error of ctx once its deadline has passed.
+func (r *Repository) collectReachableCommits(ctx context.Context, hash plumbing.Hash, reachable map[plumbing.Hash]bool) error {
 	// Avoid cycles
 	if reachable[hash] {
 		return nil
 	}
 
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
 	reachable[hash] = true
 
 	commit, err := r.repo.CommitObject(hash)
@@ -153,7 +343,7 @@ func (r *Repository) collectReachableCommits(hash plumbing.Hash, reachable map[p
 
 	// Recursively collect from all parents
 	for _, parentHash := range commit.ParentHashes {
-		err = r.collectReachableCommits(parentHash, reachable)
+		err = r.collectReachableCommits(ctx, parentHash, reachable)
 		if err != nil {
 			return err
 		}
@@ -163,7 +353,10 @@ func (r *Repository) collectReachableCommits(hash plumbing.Hash, reachable map[p
 }
 
 // GetHeadCommits retrieves the latest N commits from HEAD.
-func (r *Repository) GetHeadCommits(_ context.Context, count int) ([]domain.Commit, error) {
+func (r *Repository) GetHeadCommits(ctx context.Context, count int) ([]domain.Commit, error) {
+	_, span := tracing.Start(ctx, "git GetHeadCommits")
+	defer span.End()
+
 	ref, err := r.repo.Head()
 	if err != nil {
 		return nil, fmt.Errorf("get HEAD: %w", err)
@@ -182,6 +375,10 @@ func (r *Repository) GetHeadCommits(_ context.Context, count int) ([]domain.Comm
 			return object.ErrCanceled
 		}
 
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
 		commits = append(commits, r.convertCommit(c))
 		collected++
 
@@ -196,33 +393,16 @@ func (r *Repository) GetHeadCommits(_ context.Context, count int) ([]domain.Comm
 }
 
 // GetCommitsAheadCount returns how many commits the current branch is ahead of the reference.
-func (r *Repository) GetCommitsAheadCount(_ context.Context, referenceBranch string) (int, error) {
+func (r *Repository) GetCommitsAheadCount(ctx context.Context, referenceBranch string) (int, error) {
+	_, span := tracing.Start(ctx, "git GetCommitsAheadCount")
+	defer span.End()
+
 	head, err := r.repo.Head()
 	if err != nil {
 		return 0, fmt.Errorf("get HEAD: %w", err)
 	}
 
-	// Try different reference formats to find the target branch
-	refFormats := []string{
-		"refs/remotes/origin/" + referenceBranch, // Remote branch
-		"refs/heads/" + referenceBranch,          // Local branch
-		"refs/remotes/" + referenceBranch,        // Legacy format
-	}
-
-	var refHash plumbing.Hash
-
-	found := false
-
-	for _, refName := range refFormats {
-		refCommit, err := r.repo.Reference(plumbing.ReferenceName(refName), true)
-		if err == nil {
-			refHash = refCommit.Hash()
-			found = true
-
-			break
-		}
-	}
-
+	refHash, found := r.referenceBranchHash(referenceBranch)
 	if !found {
 		// Reference doesn't exist, return 0 (not ahead)
 		return 0, nil
@@ -242,6 +422,10 @@ func (r *Repository) GetCommitsAheadCount(_ context.Context, referenceBranch str
 			return errors.New("found reference") // Stop iteration
 		}
 
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
 		count++
 
 		return nil
@@ -254,15 +438,405 @@ func (r *Repository) GetCommitsAheadCount(_ context.Context, referenceBranch str
 	return count, nil
 }
 
+// GetCommitsBehindCount returns how many commits of the reference branch the current
+// branch does not contain.
+func (r *Repository) GetCommitsBehindCount(ctx context.Context, referenceBranch string) (int, error) {
+	_, span := tracing.Start(ctx, "git GetCommitsBehindCount")
+	defer span.End()
+
+	head, err := r.repo.Head()
+	if err != nil {
+		return 0, fmt.Errorf("get HEAD: %w", err)
+	}
+
+	refHash, found := r.referenceBranchHash(referenceBranch)
+	if !found {
+		// Reference doesn't exist, return 0 (not behind)
+		return 0, nil
+	}
+
+	inHead := make(map[plumbing.Hash]bool)
+	if err := r.collectReachableCommits(ctx, head.Hash(), inHead); err != nil {
+		return 0, fmt.Errorf("collect commits reachable from HEAD: %w", err)
+	}
+
+	behind := make(map[plumbing.Hash]bool)
+	if err := r.collectUnknownCommits(ctx, refHash, inHead, behind); err != nil {
+		return 0, fmt.Errorf("count commits: %w", err)
+	}
+
+	return len(behind), nil
+}
+
+// referenceBranchHash finds the commit of the reference branch of the branch checks,
+// preferring the remote branch on origin over the local branch.
+func (r *Repository) referenceBranchHash(referenceBranch string) (plumbing.Hash, bool) {
+	// Try different reference formats to find the target branch
+	refFormats := []string{
+		"refs/remotes/origin/" + referenceBranch, // Remote branch
+		"refs/heads/" + referenceBranch,          // Local branch
+		"refs/remotes/" + referenceBranch,        // Legacy format
+	}
+
+	for _, refName := range refFormats {
+		refCommit, err := r.repo.Reference(plumbing.ReferenceName(refName), true)
+		if err == nil {
+			return refCommit.Hash(), true
+		}
+	}
+
+	return plumbing.ZeroHash, false
+}
+
+// GetTargetBranch returns the branch the checked out branch merges into according to
+// its branch.<name>.merge setting, falling back to the checked out branch.
+func (r *Repository) GetTargetBranch(_ context.Context) (string, error) {
+	head, err := r.repo.Head()
+	if err != nil {
+		return "", fmt.Errorf("get HEAD: %w", err)
+	}
+
+	if !head.Name().IsBranch() {
+		return "", nil
+	}
+
+	branch := head.Name().Short()
+
+	cfg, err := r.repo.Config()
+	if err != nil {
+		return "", fmt.Errorf("read repository config: %w", err)
+	}
+
+	if upstream, exists := cfg.Branches[branch]; exists && upstream.Merge.IsBranch() {
+		return upstream.Merge.Short(), nil
+	}
+
+	return branch, nil
+}
+
+// GetCurrentBranch returns the short name of the checked out branch, or an empty name
+// when HEAD is detached.
+func (r *Repository) GetCurrentBranch(_ context.Context) (string, error) {
+	head, err := r.repo.Head()
+	if err != nil {
+		return "", fmt.Errorf("get HEAD: %w", err)
+	}
+
+	if !head.Name().IsBranch() {
+		return "", nil
+	}
+
+	return head.Name().Short(), nil
+}
+
+// GetRepositoryName returns the name of the work tree directory.
+func (r *Repository) GetRepositoryName(_ context.Context) (string, error) {
+	worktree, err := r.repo.Worktree()
+	if err != nil {
+		return "", fmt.Errorf("get work tree: %w", err)
+	}
+
+	root, err := filepath.Abs(worktree.Filesystem.Root())
+	if err != nil {
+		return "", fmt.Errorf("get work tree: %w", err)
+	}
+
+	return filepath.Base(root), nil
+}
+
+// GetRemoteURL returns the first URL configured for remote.
+func (r *Repository) GetRemoteURL(_ context.Context, remote string) (string, error) {
+	found, err := r.repo.Remote(remote)
+	if err != nil {
+		return "", fmt.Errorf("get remote %s: %w", remote, err)
+	}
+
+	urls := found.Config().URLs
+	if len(urls) == 0 {
+		return "", fmt.Errorf("remote %s has no URL", remote)
+	}
+
+	return urls[0], nil
+}
+
+// LocalBranches returns the short names of the local branches in name order.
+func (r *Repository) LocalBranches(_ context.Context) ([]string, error) {
+	refs, err := r.repo.Branches()
+	if err != nil {
+		return nil, fmt.Errorf("list branches: %w", err)
+	}
+
+	var branches []string
+
+	err = refs.ForEach(func(ref *plumbing.Reference) error {
+		branches = append(branches, ref.Name().Short())
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list branches: %w", err)
+	}
+
+	sort.Strings(branches)
+
+	return branches, nil
+}
+
+// BranchTips returns the commit hash of each local branch by short branch name.
+func (r *Repository) BranchTips(_ context.Context) (map[string]string, error) {
+	refs, err := r.repo.Branches()
+	if err != nil {
+		return nil, fmt.Errorf("list branches: %w", err)
+	}
+
+	tips := make(map[string]string)
+
+	err = refs.ForEach(func(ref *plumbing.Reference) error {
+		tips[ref.Name().Short()] = ref.Hash().String()
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list branches: %w", err)
+	}
+
+	return tips, nil
+}
+
+// GetSubmoduleUpdates returns the submodule pointer changes introduced by a commit
+// compared to its first parent. Removed submodules are not reported.
+func (r *Repository) GetSubmoduleUpdates(ctx context.Context, ref string) ([]domain.SubmoduleUpdate, error) {
+	_, span := tracing.Start(ctx, "git GetSubmoduleUpdates")
+	defer span.End()
+
+	changes, err := r.firstParentChanges(ref)
+	if err != nil {
+		return nil, err
+	}
+
+	var updates []domain.SubmoduleUpdate
+
+	for _, change := range changes {
+		if change.To.TreeEntry.Mode != filemode.Submodule {
+			continue
+		}
+
+		update := domain.SubmoduleUpdate{
+			Path:    change.To.Name,
+			NewHash: change.To.TreeEntry.Hash.String(),
+		}
+
+		if change.From.TreeEntry.Mode == filemode.Submodule {
+			update.OldHash = change.From.TreeEntry.Hash.String()
+		}
+
+		updates = append(updates, update)
+	}
+
+	return updates, nil
+}
+
+// GetChangedPaths returns the paths a commit adds, modifies or removes compared to its first parent.
+func (r *Repository) GetChangedPaths(_ context.Context, ref string) ([]string, error) {
+	changes, err := r.firstParentChanges(ref)
+	if err != nil {
+		return nil, err
+	}
+
+	paths := make([]string, 0, len(changes))
+
+	for _, change := range changes {
+		// Removed files only have a source side
+		if change.To.Name != "" {
+			paths = append(paths, change.To.Name)
+		} else {
+			paths = append(paths, change.From.Name)
+		}
+	}
+
+	return paths, nil
+}
+
+// GetDiffStat returns the diffstat of a commit compared to its first parent.
+func (r *Repository) GetDiffStat(ctx context.Context, ref string) (domain.DiffStat, error) {
+	ctx, span := tracing.Start(ctx, "git GetDiffStat")
+	defer span.End()
+
+	changes, err := r.firstParentChanges(ref)
+	if err != nil {
+		return domain.DiffStat{}, err
+	}
+
+	patch, err := changes.PatchContext(ctx)
+	if err != nil {
+		return domain.DiffStat{}, fmt.Errorf("diff files: %w", r.missingObjectError(err))
+	}
+
+	stat := domain.DiffStat{Files: len(changes)}
+
+	for _, file := range patch.Stats() {
+		stat.Insertions += file.Addition
+		stat.Deletions += file.Deletion
+	}
+
+	return stat, nil
+}
+
+// GetStagedPaths returns the paths with changes in the index, as a commit-msg hook sees them.
+func (r *Repository) GetStagedPaths(_ context.Context) ([]string, error) {
+	worktree, err := r.repo.Worktree()
+	if err != nil {
+		return nil, fmt.Errorf("get worktree: %w", err)
+	}
+
+	status, err := worktree.Status()
+	if err != nil {
+		return nil, fmt.Errorf("get status: %w", r.missingObjectError(err))
+	}
+
+	var paths []string
+
+	for path, fileStatus := range status {
+		if fileStatus.Staging != gogit.Unmodified && fileStatus.Staging != gogit.Untracked {
+			paths = append(paths, path)
+		}
+	}
+
+	sort.Strings(paths)
+
+	return paths, nil
+}
+
+// firstParentChanges returns the tree changes of a commit compared to its first parent,
+// or to an empty tree for a root commit. Trees a partial clone lacks are reported with
+// domain.ErrMissingObjects; they are never fetched.
+func (r *Repository) firstParentChanges(ref string) (object.Changes, error) {
+	hash, err := r.resolveReference(ref)
+	if err != nil {
+		// If reference resolution fails, try as a direct hash
+		hash = plumbing.NewHash(ref)
+	}
+
+	commit, err := r.repo.CommitObject(hash)
+	if err != nil {
+		return nil, fmt.Errorf("get commit: %w", err)
+	}
+
+	tree, err := commit.Tree()
+	if err != nil {
+		return nil, fmt.Errorf("get commit tree: %w", r.missingObjectError(err))
+	}
+
+	var parentTree *object.Tree
+
+	if commit.NumParents() > 0 {
+		parent, err := commit.Parent(0)
+		if err != nil {
+			return nil, fmt.Errorf("get parent commit: %w", err)
+		}
+
+		parentTree, err = parent.Tree()
+		if err != nil {
+			return nil, fmt.Errorf("get parent tree: %w", r.missingObjectError(err))
+		}
+	}
+
+	changes, err := object.DiffTree(parentTree, tree)
+	if err != nil {
+		return nil, fmt.Errorf("diff trees: %w", r.missingObjectError(err))
+	}
+
+	return changes, nil
+}
+
+// OpenSubmodule opens the repository of an initialized submodule by its path.
+func (r *Repository) OpenSubmodule(_ context.Context, path string) (domain.Repository, error) {
+	worktree, err := r.repo.Worktree()
+	if err != nil {
+		return nil, fmt.Errorf("get worktree: %w", err)
+	}
+
+	submodules, err := worktree.Submodules()
+	if err != nil {
+		return nil, fmt.Errorf("list submodules: %w", err)
+	}
+
+	for _, submodule := range submodules {
+		if submodule.Config().Path != path {
+			continue
+		}
+
+		subRepo, err := submodule.Repository()
+		if err != nil {
+			return nil, fmt.Errorf("open submodule %s: %w", path, err)
+		}
+
+		return &Repository{repo: subRepo, mailmap: loadMailmap(subRepo)}, nil
+	}
+
+	return nil, fmt.Errorf("submodule not found: %s", path)
+}
+
 // convertCommit converts go-git commit to domain commit.
 func (r *Repository) convertCommit(commit *object.Commit) domain.Commit {
-	return domain.NewCommit(
+	// go-git reports commits without an encoding header as UTF-8
+	encoding := string(commit.Encoding)
+	if strings.EqualFold(encoding, "UTF-8") {
+		encoding = ""
+	}
+
+	message, decodeErr := charset.DecodeCommitMessage(commit.Message, encoding)
+
+	converted := domain.NewCommit(
 		commit.Hash.String(),
-		commit.Message,
+		message,
 		commit.Author.Name,
 		commit.Author.Email,
 		commit.Author.When.Format("2006-01-02T15:04:05Z"),
 		commit.PGPSignature,
 		len(commit.ParentHashes) > 1,
 	)
+	converted.Committer = commit.Committer.Name
+	converted.CommitterEmail = commit.Committer.Email
+	converted.Mailmap = r.mailmap
+	converted.Encoding = encoding
+
+	if decodeErr != nil {
+		converted.EncodingError = decodeErr.Error()
+	}
+
+	for _, parent := range commit.ParentHashes {
+		converted.ParentHashes = append(converted.ParentHashes, parent.String())
+	}
+
+	if commit.PGPSignature != "" {
+		// The signed data is only needed, and only encoded, for signed commits
+		if payload, err := encodeWithoutSignature(commit.EncodeWithoutSignature); err == nil {
+			converted.SignedPayload = payload
+		}
+	}
+
+	return converted
+}
+
+// encodeWithoutSignature returns a commit or tag object without its signature, the data
+// git signs, given the object's EncodeWithoutSignature method.
+func encodeWithoutSignature(encode func(plumbing.EncodedObject) error) (string, error) {
+	encoded := &plumbing.MemoryObject{}
+	if err := encode(encoded); err != nil {
+		return "", err
+	}
+
+	reader, err := encoded.Reader()
+	if err != nil {
+		return "", err
+	}
+	defer reader.Close()
+
+	payload, err := io.ReadAll(reader)
+	if err != nil {
+		return "", err
+	}
+
+	return string(payload), nil
 }
@@ -8,10 +8,16 @@ import (
 	"context"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/ProtonMail/go-crypto/openpgp"
+	"github.com/ProtonMail/go-crypto/openpgp/packet"
 	gogit "github.com/go-git/go-git/v5"
+	gitconfig "github.com/go-git/go-git/v5/config"
 	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/filemode"
 	"github.com/go-git/go-git/v5/plumbing/object"
 	"github.com/stretchr/testify/require"
 
@@ -230,4 +236,711 @@ func TestGetCommitRangeWithMergeCommits(t *testing.T) {
 	}
 
 	require.True(t, foundMerge, "Merge commit should be included in range")
+
+	// Children come before their parents, and parents of equal date in parent order
+	subjects := make([]string, 0, len(commits))
+	for _, commit := range commits {
+		subjects = append(subjects, commit.Subject)
+	}
+
+	require.Equal(t, []string{"Post-merge commit", "Merge commit", "Main branch commit", "Feature branch commit"}, subjects)
+}
+
+// TestGetSubmoduleUpdates tests detection of submodule pointer changes in commits.
+func TestGetSubmoduleUpdates(t *testing.T) {
+	oldPointer := plumbing.NewHash("1111111111111111111111111111111111111111")
+	newPointer := plumbing.NewHash("2222222222222222222222222222222222222222")
+
+	tmpDir := t.TempDir()
+
+	repo, err := gogit.PlainInit(tmpDir, false)
+	require.NoError(t, err)
+
+	// A adds the submodule, B bumps it, C only changes a regular file
+	hashA := createTreeCommit(t, repo, map[string]plumbing.Hash{"lib": oldPointer}, nil)
+	hashB := createTreeCommit(t, repo, map[string]plumbing.Hash{"lib": newPointer}, []plumbing.Hash{hashA})
+	hashC := createTreeCommit(t, repo, map[string]plumbing.Hash{"lib": newPointer, "README.md": plumbing.ZeroHash}, []plumbing.Hash{hashB})
+
+	adapter, err := git.NewRepository(tmpDir)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		ref      string
+		expected []domain.SubmoduleUpdate
+	}{
+		{
+			name:     "Submodule added",
+			ref:      hashA.String(),
+			expected: []domain.SubmoduleUpdate{{Path: "lib", NewHash: oldPointer.String()}},
+		},
+		{
+			name:     "Submodule bumped",
+			ref:      hashB.String(),
+			expected: []domain.SubmoduleUpdate{{Path: "lib", OldHash: oldPointer.String(), NewHash: newPointer.String()}},
+		},
+		{
+			name:     "No submodule change",
+			ref:      hashC.String(),
+			expected: nil,
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			updates, err := adapter.GetSubmoduleUpdates(context.Background(), testCase.ref)
+			require.NoError(t, err)
+			require.Equal(t, testCase.expected, updates)
+		})
+	}
+}
+
+// createTreeCommit creates a commit whose tree holds the given entries.
+// A zero hash creates an empty regular file, any other hash a submodule entry.
+func createTreeCommit(t *testing.T, repo *gogit.Repository, entries map[string]plumbing.Hash, parents []plumbing.Hash) plumbing.Hash {
+	t.Helper()
+
+	tree := &object.Tree{}
+
+	for _, name := range []string{"README.md", "lib"} {
+		hash, ok := entries[name]
+		if !ok {
+			continue
+		}
+
+		if hash.IsZero() {
+			blob := repo.Storer.NewEncodedObject()
+			blob.SetType(plumbing.BlobObject)
+
+			blobHash, err := repo.Storer.SetEncodedObject(blob)
+			require.NoError(t, err)
+
+			tree.Entries = append(tree.Entries, object.TreeEntry{Name: name, Mode: filemode.Regular, Hash: blobHash})
+
+			continue
+		}
+
+		tree.Entries = append(tree.Entries, object.TreeEntry{Name: name, Mode: filemode.Submodule, Hash: hash})
+	}
+
+	treeObject := repo.Storer.NewEncodedObject()
+	require.NoError(t, tree.Encode(treeObject))
+
+	treeHash, err := repo.Storer.SetEncodedObject(treeObject)
+	require.NoError(t, err)
+
+	signature := object.Signature{Name: "Test User", Email: "test@example.com", When: time.Now()}
+	commit := &object.Commit{
+		Author:       signature,
+		Committer:    signature,
+		Message:      "Update submodule",
+		TreeHash:     treeHash,
+		ParentHashes: parents,
+	}
+
+	commitObject := repo.Storer.NewEncodedObject()
+	require.NoError(t, commit.Encode(commitObject))
+
+	commitHash, err := repo.Storer.SetEncodedObject(commitObject)
+	require.NoError(t, err)
+
+	return commitHash
+}
+
+// TestGetOutgoingCommits tests finding the commits a push sends.
+func TestGetOutgoingCommits(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	repo, err := gogit.PlainInit(tmpDir, false)
+	require.NoError(t, err)
+
+	// History:
+	//   A -> B (origin/main) -> C -> D (main)
+	//         \-> E (feature)
+	hashA := createCommit(t, repo, "Initial commit", nil)
+	hashB := createCommit(t, repo, "Pushed commit", []plumbing.Hash{hashA})
+	hashC := createCommit(t, repo, "Local commit 1", []plumbing.Hash{hashB})
+	hashD := createCommit(t, repo, "Local commit 2", []plumbing.Hash{hashC})
+	hashE := createCommit(t, repo, "Feature commit", []plumbing.Hash{hashB})
+
+	require.NoError(t, repo.Storer.SetReference(plumbing.NewHashReference("refs/remotes/origin/main", hashB)))
+
+	adapter, err := git.NewRepository(tmpDir)
+	require.NoError(t, err)
+
+	unfetched := "3333333333333333333333333333333333333333"
+
+	tests := []struct {
+		name     string
+		remote   string
+		update   domain.PushUpdate
+		expected []string
+	}{
+		{
+			name:     "existing remote ref",
+			remote:   "origin",
+			update:   domain.PushUpdate{LocalHash: hashD.String(), RemoteHash: hashB.String()},
+			expected: []string{"Local commit 1", "Local commit 2"},
+		},
+		{
+			name:     "new remote ref",
+			remote:   "origin",
+			update:   domain.PushUpdate{LocalHash: hashE.String()},
+			expected: []string{"Feature commit"},
+		},
+		{
+			name:     "remote hash not fetched",
+			remote:   "origin",
+			update:   domain.PushUpdate{LocalHash: hashD.String(), RemoteHash: unfetched},
+			expected: []string{"Local commit 1", "Local commit 2"},
+		},
+		{
+			name:     "remote without tracking branches",
+			remote:   "https://example.com/repo.git",
+			update:   domain.PushUpdate{LocalHash: hashC.String()},
+			expected: []string{"Initial commit", "Pushed commit", "Local commit 1"},
+		},
+		{
+			name:   "deletion",
+			remote: "origin",
+			update: domain.PushUpdate{RemoteHash: hashB.String()},
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			commits, err := adapter.GetOutgoingCommits(context.Background(), testCase.remote, testCase.update)
+			require.NoError(t, err)
+
+			subjects := make([]string, 0, len(commits))
+			for _, commit := range commits {
+				subjects = append(subjects, commit.Subject)
+			}
+
+			require.ElementsMatch(t, testCase.expected, subjects)
+		})
+	}
+}
+
+// TestGetCommitIdentities tests that commits carry the committer and the repository mailmap.
+func TestGetCommitIdentities(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	repo, err := gogit.PlainInit(tmpDir, false)
+	require.NoError(t, err)
+
+	require.NoError(t, os.WriteFile(filepath.Join(tmpDir, ".mailmap"), []byte("Canonical User <canonical@example.com> <test@example.com>\n"), 0600))
+
+	hash := createCommit(t, repo, "Initial commit", nil)
+
+	adapter, err := git.NewRepository(tmpDir)
+	require.NoError(t, err)
+
+	commit, err := adapter.GetCommit(context.Background(), hash.String())
+	require.NoError(t, err)
+	require.Equal(t, "Test User", commit.Committer)
+	require.Equal(t, "test@example.com", commit.CommitterEmail)
+
+	canonical := commit.Mailmap.Resolve(domain.NewIdentity(commit.Author, commit.AuthorEmail))
+	require.Equal(t, "Canonical User <canonical@example.com>", canonical.String())
+}
+
+func TestGetTargetBranch(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	repo, err := gogit.PlainInit(tmpDir, false)
+	require.NoError(t, err)
+
+	hash := createCommit(t, repo, "Initial commit", nil)
+
+	adapter, err := git.NewRepository(tmpDir)
+	require.NoError(t, err)
+
+	branch, err := adapter.GetTargetBranch(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, "master", branch)
+
+	// The upstream branch is the target when one is configured
+	require.NoError(t, repo.CreateBranch(&gitconfig.Branch{
+		Name:   "master",
+		Remote: "origin",
+		Merge:  plumbing.NewBranchReferenceName("release/1.0"),
+	}))
+
+	branch, err = adapter.GetTargetBranch(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, "release/1.0", branch)
+
+	worktree, err := repo.Worktree()
+	require.NoError(t, err)
+	require.NoError(t, worktree.Checkout(&gogit.CheckoutOptions{Hash: hash}))
+
+	branch, err = adapter.GetTargetBranch(context.Background())
+	require.NoError(t, err)
+	require.Empty(t, branch)
+}
+
+func TestGetUpstreamDivergence(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	repo, err := gogit.PlainInit(tmpDir, false)
+	require.NoError(t, err)
+
+	// History:
+	//   A -> B -> C (origin/main)
+	//         \-> D (master)
+	hashA := createCommit(t, repo, "Initial commit", nil)
+	hashB := createCommit(t, repo, "Pushed commit", []plumbing.Hash{hashA})
+	hashC := createCommit(t, repo, "Published commit", []plumbing.Hash{hashB})
+	createCommit(t, repo, "Local commit", []plumbing.Hash{hashB})
+
+	require.NoError(t, repo.Storer.SetReference(plumbing.NewHashReference("refs/remotes/origin/main", hashC)))
+
+	adapter, err := git.NewRepository(tmpDir)
+	require.NoError(t, err)
+
+	// Without an upstream there is nothing to compare with
+	divergence, err := adapter.GetUpstreamDivergence(context.Background(), "")
+	require.NoError(t, err)
+	require.Empty(t, divergence.Upstream)
+	require.False(t, divergence.RequiresForcePush())
+
+	require.NoError(t, repo.CreateBranch(&gitconfig.Branch{
+		Name:   "master",
+		Remote: "origin",
+		Merge:  plumbing.NewBranchReferenceName("main"),
+	}))
+
+	for _, upstream := range []string{"", "origin/main"} {
+		divergence, err := adapter.GetUpstreamDivergence(context.Background(), upstream)
+		require.NoError(t, err)
+		require.Equal(t, "origin/main", divergence.Upstream)
+		require.True(t, divergence.RequiresForcePush())
+		require.Len(t, divergence.Ahead, 1)
+		require.Equal(t, "Local commit", divergence.Ahead[0].Subject)
+		require.Len(t, divergence.Behind, 1)
+		require.Equal(t, "Published commit", divergence.Behind[0].Subject)
+	}
+
+	_, err = adapter.GetUpstreamDivergence(context.Background(), "origin/missing")
+	require.Error(t, err)
+}
+
+func TestGetCommitsBehindCount(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	repo, err := gogit.PlainInit(tmpDir, false)
+	require.NoError(t, err)
+
+	// History:
+	//   A -> B -> C -> D (origin/main)
+	//         \-> E (master)
+	hashA := createCommit(t, repo, "Initial commit", nil)
+	hashB := createCommit(t, repo, "Base commit", []plumbing.Hash{hashA})
+	hashC := createCommit(t, repo, "Main commit 1", []plumbing.Hash{hashB})
+	hashD := createCommit(t, repo, "Main commit 2", []plumbing.Hash{hashC})
+	createCommit(t, repo, "Feature commit", []plumbing.Hash{hashB})
+
+	require.NoError(t, repo.Storer.SetReference(plumbing.NewHashReference("refs/remotes/origin/main", hashD)))
+
+	adapter, err := git.NewRepository(tmpDir)
+	require.NoError(t, err)
+
+	behind, err := adapter.GetCommitsBehindCount(context.Background(), "main")
+	require.NoError(t, err)
+	require.Equal(t, 2, behind)
+
+	// A missing reference branch is nothing to be behind
+	behind, err = adapter.GetCommitsBehindCount(context.Background(), "develop")
+	require.NoError(t, err)
+	require.Zero(t, behind)
+}
+
+func TestGetChangedPathsInPartialClone(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	repo, err := gogit.PlainInit(tmpDir, false)
+	require.NoError(t, err)
+
+	hash := createCommit(t, repo, "Initial commit", nil)
+
+	commit, err := repo.CommitObject(hash)
+	require.NoError(t, err)
+
+	// Drop the tree of the commit, as a clone with --filter=tree:0 never fetched it
+	treeHash := commit.TreeHash.String()
+	require.NoError(t, os.Remove(filepath.Join(tmpDir, ".git", "objects", treeHash[:2], treeHash[2:])))
+
+	adapter, err := git.NewRepository(tmpDir)
+	require.NoError(t, err)
+
+	_, err = adapter.GetChangedPaths(context.Background(), hash.String())
+	require.Error(t, err)
+	require.NotErrorIs(t, err, domain.ErrMissingObjects, "only partial clones may lack objects")
+
+	configFile, err := os.OpenFile(filepath.Join(tmpDir, ".git", "config"), os.O_APPEND|os.O_WRONLY, 0)
+	require.NoError(t, err)
+
+	_, err = configFile.WriteString("[remote \"origin\"]\n\turl = https://example.com/repo.git\n\tpromisor = true\n")
+	require.NoError(t, err)
+	require.NoError(t, configFile.Close())
+
+	adapter, err = git.NewRepository(tmpDir)
+	require.NoError(t, err)
+
+	_, err = adapter.GetChangedPaths(context.Background(), hash.String())
+	require.ErrorIs(t, err, domain.ErrMissingObjects)
+}
+
+func TestGetCurrentBranch(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	repo, err := gogit.PlainInit(tmpDir, false)
+	require.NoError(t, err)
+
+	hash := createCommit(t, repo, "Initial commit", nil)
+
+	// The upstream branch is not the current branch
+	require.NoError(t, repo.CreateBranch(&gitconfig.Branch{
+		Name:   "master",
+		Remote: "origin",
+		Merge:  plumbing.NewBranchReferenceName("main"),
+	}))
+
+	adapter, err := git.NewRepository(tmpDir)
+	require.NoError(t, err)
+
+	branch, err := adapter.GetCurrentBranch(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, "master", branch)
+
+	worktree, err := repo.Worktree()
+	require.NoError(t, err)
+	require.NoError(t, worktree.Checkout(&gogit.CheckoutOptions{Hash: hash}))
+
+	branch, err = adapter.GetCurrentBranch(context.Background())
+	require.NoError(t, err)
+	require.Empty(t, branch)
+}
+
+func TestGetRepositoryName(t *testing.T) {
+	tmpDir := filepath.Join(t.TempDir(), "gommitlint")
+
+	_, err := gogit.PlainInit(tmpDir, false)
+	require.NoError(t, err)
+
+	adapter, err := git.NewRepository(tmpDir)
+	require.NoError(t, err)
+
+	name, err := adapter.GetRepositoryName(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, "gommitlint", name)
+}
+
+func TestGetRemoteURL(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	repo, err := gogit.PlainInit(tmpDir, false)
+	require.NoError(t, err)
+
+	_, err = repo.CreateRemote(&gitconfig.RemoteConfig{Name: "origin", URLs: []string{"git@github.com:org/repo.git"}})
+	require.NoError(t, err)
+
+	adapter, err := git.NewRepository(tmpDir)
+	require.NoError(t, err)
+
+	remoteURL, err := adapter.GetRemoteURL(context.Background(), "origin")
+	require.NoError(t, err)
+	require.Equal(t, "git@github.com:org/repo.git", remoteURL)
+
+	_, err = adapter.GetRemoteURL(context.Background(), "upstream")
+	require.Error(t, err)
+}
+
+func TestLocalBranches(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	repo, err := gogit.PlainInit(tmpDir, false)
+	require.NoError(t, err)
+
+	hash := createCommit(t, repo, "Initial commit", nil)
+
+	for _, name := range []string{"release/1.0", "feature"} {
+		require.NoError(t, repo.Storer.SetReference(plumbing.NewHashReference(plumbing.NewBranchReferenceName(name), hash)))
+	}
+
+	// Remote branches are not local
+	require.NoError(t, repo.Storer.SetReference(plumbing.NewHashReference(plumbing.NewRemoteReferenceName("origin", "main"), hash)))
+
+	adapter, err := git.NewRepository(tmpDir)
+	require.NoError(t, err)
+
+	branches, err := adapter.LocalBranches(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, []string{"feature", "master", "release/1.0"}, branches)
+}
+
+func TestGetChangedPaths(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	repo, err := gogit.PlainInit(tmpDir, false)
+	require.NoError(t, err)
+
+	first := createCommit(t, repo, "first", nil)
+
+	worktree, err := repo.Worktree()
+	require.NoError(t, err)
+
+	_, err = worktree.Remove("first.txt")
+	require.NoError(t, err)
+
+	second := createCommit(t, repo, "second", []plumbing.Hash{first})
+
+	adapter, err := git.NewRepository(tmpDir)
+	require.NoError(t, err)
+
+	paths, err := adapter.GetChangedPaths(context.Background(), first.String())
+	require.NoError(t, err)
+	require.Equal(t, []string{"first.txt"}, paths)
+
+	paths, err = adapter.GetChangedPaths(context.Background(), second.String())
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{"first.txt", "second.txt"}, paths)
+
+	// Only files added to the index count as staged
+	require.NoError(t, os.WriteFile(filepath.Join(tmpDir, "staged.txt"), []byte("staged"), 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(tmpDir, "untracked.txt"), []byte("untracked"), 0600))
+
+	_, err = worktree.Add("staged.txt")
+	require.NoError(t, err)
+
+	paths, err = adapter.GetStagedPaths(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, []string{"staged.txt"}, paths)
+}
+
+func TestGetFirstParentRange(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	repo, err := gogit.PlainInit(tmpDir, false)
+	require.NoError(t, err)
+
+	// History with a topic branch merged into the feature branch:
+	//   A -> B -> C (main)
+	//    \-> D -> M -> E (feature)
+	//    \-> F --/
+	hashA := createCommit(t, repo, "Initial commit", nil)
+	hashB := createCommit(t, repo, "Main commit 1", []plumbing.Hash{hashA})
+	hashC := createCommit(t, repo, "Main commit 2", []plumbing.Hash{hashB})
+	hashD := createCommit(t, repo, "Feature commit", []plumbing.Hash{hashA})
+	hashF := createCommit(t, repo, "Topic commit", []plumbing.Hash{hashA})
+	hashM := createCommit(t, repo, "Merge topic", []plumbing.Hash{hashD, hashF})
+	hashE := createCommit(t, repo, "Post-merge commit", []plumbing.Hash{hashM})
+	unrelated := createRootCommit(t, repo, hashA)
+
+	require.NoError(t, repo.Storer.SetReference(plumbing.NewHashReference("refs/heads/main", hashC)))
+
+	adapter, err := git.NewRepository(tmpDir)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+
+	mergeBase, err := adapter.GetMergeBase(ctx, "main", hashE.String())
+	require.NoError(t, err)
+	require.Equal(t, hashA.String(), mergeBase)
+
+	_, err = adapter.GetMergeBase(ctx, unrelated.String(), "main")
+	require.ErrorContains(t, err, "have no common ancestor")
+
+	commits, err := adapter.GetFirstParentRange(ctx, mergeBase, hashE.String())
+	require.NoError(t, err)
+
+	subjects := make([]string, 0, len(commits))
+	for _, commit := range commits {
+		subjects = append(subjects, commit.Subject)
+	}
+
+	require.Equal(t, []string{"Post-merge commit", "Merge topic", "Feature commit"}, subjects)
+
+	// The full range also holds the commits brought in by the merge
+	commits, err = adapter.GetCommitRange(ctx, "main", hashE.String())
+	require.NoError(t, err)
+	require.Len(t, commits, 4)
+
+	// Revisions relative to a ref are resolved as well
+	commits, err = adapter.GetFirstParentRange(ctx, "main~1", "main")
+	require.NoError(t, err)
+	require.Len(t, commits, 1)
+	require.Equal(t, hashC.String(), commits[0].Hash)
+}
+
+func TestCommitWalksStopAtDeadline(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	repo, err := gogit.PlainInit(tmpDir, false)
+	require.NoError(t, err)
+
+	hashA := createCommit(t, repo, "Initial commit", nil)
+	hashB := createCommit(t, repo, "Second commit", []plumbing.Hash{hashA})
+
+	adapter, err := git.NewRepository(tmpDir)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Nanosecond)
+	defer cancel()
+
+	<-ctx.Done()
+
+	_, err = adapter.GetCommitRange(ctx, hashA.String(), hashB.String())
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+
+	_, err = adapter.GetFirstParentRange(ctx, hashA.String(), hashB.String())
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+
+	_, err = adapter.GetOutgoingCommits(ctx, "origin", domain.PushUpdate{
+		LocalRef: "refs/heads/main", LocalHash: hashB.String(),
+		RemoteRef: "refs/heads/main", RemoteHash: strings.Repeat("0", 40),
+	})
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+// createRootCommit stores a commit without parents reusing the tree of another commit.
+func createRootCommit(t *testing.T, repo *gogit.Repository, treeOf plumbing.Hash) plumbing.Hash {
+	t.Helper()
+
+	source, err := repo.CommitObject(treeOf)
+	require.NoError(t, err)
+
+	signature := object.Signature{Name: "Test User", Email: "test@example.com", When: time.Now()}
+	commit := &object.Commit{Author: signature, Committer: signature, Message: "Unrelated root", TreeHash: source.TreeHash}
+
+	encoded := repo.Storer.NewEncodedObject()
+	require.NoError(t, commit.Encode(encoded))
+
+	hash, err := repo.Storer.SetEncodedObject(encoded)
+	require.NoError(t, err)
+
+	return hash
+}
+
+func TestWaivers(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	repo, err := gogit.PlainInit(tmpDir, false)
+	require.NoError(t, err)
+
+	first := createCommit(t, repo, "first", nil)
+	second := createCommit(t, repo, "second", []plumbing.Hash{first})
+
+	adapter, err := git.NewRepository(tmpDir)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+
+	waivers, err := adapter.GetWaivers(ctx, "HEAD")
+	require.NoError(t, err)
+	require.Empty(t, waivers)
+
+	subject := domain.Waiver{Rule: "Subject", Reason: "Imported", Approver: "Jane <jane@example.com>"}
+	signature := domain.Waiver{Rule: "Signature", Reason: "Signed out of band", Approver: "Jane <jane@example.com>"}
+
+	require.NoError(t, adapter.AddWaiver(ctx, first.String(), subject))
+	require.NoError(t, adapter.AddWaiver(ctx, "HEAD", subject))
+	require.NoError(t, adapter.AddWaiver(ctx, first.String(), signature))
+	require.ErrorContains(t, adapter.AddWaiver(ctx, "HEAD", domain.Waiver{Rule: "Subject"}), "a waiver needs a reason")
+
+	waivers, err = adapter.GetWaivers(ctx, first.String())
+	require.NoError(t, err)
+	require.Equal(t, []domain.Waiver{subject, signature}, waivers)
+
+	waivers, err = adapter.GetWaivers(ctx, second.String())
+	require.NoError(t, err)
+	require.Equal(t, []domain.Waiver{subject}, waivers)
+
+	// Every waiver is a commit on the notes ref, like git notes append
+	notesRef, err := repo.Reference(git.WaiverNotesRef, true)
+	require.NoError(t, err)
+
+	notesLog, err := repo.Log(&gogit.LogOptions{From: notesRef.Hash()})
+	require.NoError(t, err)
+
+	count := 0
+	require.NoError(t, notesLog.ForEach(func(*object.Commit) error {
+		count++
+
+		return nil
+	}))
+	require.Equal(t, 3, count)
+}
+
+func TestGetTags(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	repo, err := gogit.PlainInit(tmpDir, false)
+	require.NoError(t, err)
+
+	first := createCommit(t, repo, "first", nil)
+	second := createCommit(t, repo, "second", []plumbing.Hash{first})
+	third := createCommit(t, repo, "third", []plumbing.Hash{second})
+
+	entity, err := openpgp.NewEntity("Releaser", "", "release@example.com", &packet.Config{Algorithm: packet.PubKeyAlgoEdDSA})
+	require.NoError(t, err)
+
+	tagger := &object.Signature{Name: "Releaser", Email: "release@example.com", When: time.Now()}
+
+	_, err = repo.CreateTag("v1.0.0", first, &gogit.CreateTagOptions{Tagger: tagger, Message: "Release 1.0.0", SignKey: entity})
+	require.NoError(t, err)
+	_, err = repo.CreateTag("v1.0.1", second, nil)
+	require.NoError(t, err)
+	_, err = repo.CreateTag("v1.1.0", third, &gogit.CreateTagOptions{Tagger: tagger, Message: "Release 1.1.0"})
+	require.NoError(t, err)
+
+	adapter, err := git.NewRepository(tmpDir)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+
+	tags, err := adapter.GetTags(ctx, "")
+	require.NoError(t, err)
+	require.Equal(t, []string{"v1.0.0", "v1.0.1", "v1.1.0"}, tagNames(tags))
+
+	signed := tags[0]
+	require.True(t, signed.IsAnnotated())
+	require.Equal(t, first.String(), signed.Target)
+	require.Equal(t, "release@example.com", signed.TaggerEmail)
+	require.Contains(t, signed.Signature, "-----BEGIN PGP SIGNATURE-----")
+	require.NotContains(t, signed.Payload, "PGP SIGNATURE")
+
+	// The payload is exactly what was signed
+	_, err = openpgp.CheckArmoredDetachedSignature(openpgp.EntityList{entity},
+		strings.NewReader(signed.Payload), strings.NewReader(signed.Signature), nil)
+	require.NoError(t, err)
+
+	require.False(t, tags[1].IsAnnotated())
+	require.Equal(t, second.String(), tags[1].Target)
+	require.Empty(t, tags[2].Signature)
+
+	tags, err = adapter.GetTags(ctx, "v1.0.*")
+	require.NoError(t, err)
+	require.Equal(t, []string{"v1.0.0", "v1.0.1"}, tagNames(tags))
+
+	tags, err = adapter.GetTags(ctx, "v1.0.0..HEAD")
+	require.NoError(t, err)
+	require.Equal(t, []string{"v1.0.1", "v1.1.0"}, tagNames(tags))
+
+	_, err = adapter.GetTags(ctx, "v2.0.0")
+	require.ErrorContains(t, err, "tag 'v2.0.0' not found")
+
+	tags, err = adapter.GetTags(ctx, "v2.*")
+	require.NoError(t, err)
+	require.Empty(t, tags)
+}
+
+func tagNames(tags []domain.Tag) []string {
+	names := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		names = append(names, tag.Name)
+	}
+
+	return names
 }
@@ -0,0 +1,151 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package git
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/itiquette/gommitlint/internal/adapters/tracing"
+	"github.com/itiquette/gommitlint/internal/domain"
+)
+
+// GetTags returns the tags matching selector, sorted by name. The selector is a tag
+// name, a glob such as "v1.*" or a commit range "from..to"; empty selects every tag.
+func (r *Repository) GetTags(ctx context.Context, selector string) ([]domain.Tag, error) {
+	_, span := tracing.Start(ctx, "git GetTags")
+	defer span.End()
+
+	match, err := r.tagMatcher(ctx, selector)
+	if err != nil {
+		return nil, err
+	}
+
+	refs, err := r.repo.Tags()
+	if err != nil {
+		return nil, fmt.Errorf("list tags: %w", err)
+	}
+
+	var tags []domain.Tag
+
+	err = refs.ForEach(func(ref *plumbing.Reference) error {
+		tag, commit, err := r.convertTag(ref)
+		if err != nil {
+			return err
+		}
+
+		if match(tag.Name, commit) {
+			tags = append(tags, tag)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(tags) == 0 && selector != "" && !strings.Contains(selector, "..") && !isTagGlob(selector) {
+		return nil, fmt.Errorf("tag '%s' not found", selector)
+	}
+
+	sort.Slice(tags, func(i, j int) bool {
+		return tags[i].Name < tags[j].Name
+	})
+
+	return tags, nil
+}
+
+// tagMatcher returns a predicate over a tag's name and the commit it points at.
+func (r *Repository) tagMatcher(ctx context.Context, selector string) (func(name string, commit plumbing.Hash) bool, error) {
+	if selector == "" {
+		return func(string, plumbing.Hash) bool { return true }, nil
+	}
+
+	from, to, isRange := strings.Cut(selector, "..")
+	if !isRange {
+		return func(name string, _ plumbing.Hash) bool {
+			matched, err := path.Match(selector, name)
+
+			return matched || (err != nil && selector == name)
+		}, nil
+	}
+
+	if from == "" || to == "" {
+		return nil, fmt.Errorf("invalid tag range '%s', expected 'from..to'", selector)
+	}
+
+	fromCommit, err := r.commitObject(from)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve 'from' reference: %w", err)
+	}
+
+	toCommit, err := r.commitObject(to)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve 'to' reference: %w", err)
+	}
+
+	excluded := make(map[plumbing.Hash]bool)
+	if err := r.collectReachableCommits(ctx, fromCommit.Hash, excluded); err != nil {
+		return nil, fmt.Errorf("collect commits reachable from 'from': %w", err)
+	}
+
+	included := make(map[plumbing.Hash]bool)
+	if err := r.collectReachableCommits(ctx, toCommit.Hash, included); err != nil {
+		return nil, fmt.Errorf("collect commits reachable from 'to': %w", err)
+	}
+
+	return func(_ string, commit plumbing.Hash) bool {
+		return included[commit] && !excluded[commit]
+	}, nil
+}
+
+// convertTag converts a tag reference to a domain tag, also returning the hash of the
+// commit it points at, which is zero for tags of other objects.
+func (r *Repository) convertTag(ref *plumbing.Reference) (domain.Tag, plumbing.Hash, error) {
+	tag := domain.Tag{
+		Name:   ref.Name().Short(),
+		Target: ref.Hash().String(),
+	}
+
+	tagObject, err := r.repo.TagObject(ref.Hash())
+	if errors.Is(err, plumbing.ErrObjectNotFound) {
+		// Lightweight tags point directly at the commit
+		return tag, ref.Hash(), nil
+	}
+
+	if err != nil {
+		return domain.Tag{}, plumbing.ZeroHash, fmt.Errorf("read tag '%s': %w", tag.Name, err)
+	}
+
+	payload, err := encodeWithoutSignature(tagObject.EncodeWithoutSignature)
+	if err != nil {
+		return domain.Tag{}, plumbing.ZeroHash, fmt.Errorf("encode tag '%s': %w", tag.Name, err)
+	}
+
+	tag.Hash = tagObject.Hash.String()
+	tag.Target = tagObject.Target.String()
+	tag.Tagger = tagObject.Tagger.Name
+	tag.TaggerEmail = tagObject.Tagger.Email
+	tag.Message = tagObject.Message
+	tag.Signature = tagObject.PGPSignature
+	tag.Payload = payload
+
+	commit := plumbing.ZeroHash
+	if tagged, err := tagObject.Commit(); err == nil {
+		commit = tagged.Hash
+	}
+
+	return tag, commit, nil
+}
+
+// isTagGlob reports whether selector contains glob metacharacters.
+func isTagGlob(selector string) bool {
+	return strings.ContainsAny(selector, "*?[")
+}
@@ -0,0 +1,129 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package git
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/itiquette/gommitlint/internal/adapters/tracing"
+	"github.com/itiquette/gommitlint/internal/domain"
+)
+
+// GetUpstreamDivergence compares HEAD with upstream, or with the remote tracking branch
+// of the checked out branch when upstream is empty.
+func (r *Repository) GetUpstreamDivergence(ctx context.Context, upstream string) (domain.UpstreamDivergence, error) {
+	_, span := tracing.Start(ctx, "git GetUpstreamDivergence")
+	defer span.End()
+
+	if upstream == "" {
+		tracking, err := r.trackingBranch()
+		if err != nil || tracking == "" {
+			return domain.UpstreamDivergence{}, err
+		}
+
+		upstream = tracking
+	}
+
+	head, err := r.commitObject("HEAD")
+	if err != nil {
+		return domain.UpstreamDivergence{}, fmt.Errorf("failed to resolve HEAD: %w", err)
+	}
+
+	upstreamCommit, err := r.commitObject(upstream)
+	if err != nil {
+		return domain.UpstreamDivergence{}, fmt.Errorf("failed to resolve '%s': %w", upstream, err)
+	}
+
+	inHead := make(map[plumbing.Hash]bool)
+	if err := r.collectReachableCommits(ctx, head.Hash, inHead); err != nil {
+		return domain.UpstreamDivergence{}, fmt.Errorf("collect commits reachable from HEAD: %w", err)
+	}
+
+	inUpstream := make(map[plumbing.Hash]bool)
+	if err := r.collectReachableCommits(ctx, upstreamCommit.Hash, inUpstream); err != nil {
+		return domain.UpstreamDivergence{}, fmt.Errorf("collect commits reachable from '%s': %w", upstream, err)
+	}
+
+	ahead, err := r.newestFirst(inHead, inUpstream)
+	if err != nil {
+		return domain.UpstreamDivergence{}, err
+	}
+
+	behind, err := r.newestFirst(inUpstream, inHead)
+	if err != nil {
+		return domain.UpstreamDivergence{}, err
+	}
+
+	return domain.UpstreamDivergence{Upstream: upstream, Ahead: ahead, Behind: behind}, nil
+}
+
+// trackingBranch returns the remote tracking branch of the checked out branch, such as
+// origin/main, from its branch.<name>.remote and branch.<name>.merge settings. The name
+// is empty when HEAD is detached or the branch has no upstream.
+func (r *Repository) trackingBranch() (string, error) {
+	head, err := r.repo.Head()
+	if err != nil {
+		return "", fmt.Errorf("get HEAD: %w", err)
+	}
+
+	if !head.Name().IsBranch() {
+		return "", nil
+	}
+
+	cfg, err := r.repo.Config()
+	if err != nil {
+		return "", fmt.Errorf("read repository config: %w", err)
+	}
+
+	branch, exists := cfg.Branches[head.Name().Short()]
+	if !exists || branch.Remote == "" || !branch.Merge.IsBranch() {
+		return "", nil
+	}
+
+	// A remote of "." tracks a local branch
+	if branch.Remote == "." {
+		return branch.Merge.Short(), nil
+	}
+
+	return branch.Remote + "/" + branch.Merge.Short(), nil
+}
+
+// newestFirst returns the commits of hashes that are not in excluded, newest first by
+// committer date.
+func (r *Repository) newestFirst(hashes, excluded map[plumbing.Hash]bool) ([]domain.Commit, error) {
+	var objects []*object.Commit
+
+	for hash := range hashes {
+		if excluded[hash] {
+			continue
+		}
+
+		commit, err := r.repo.CommitObject(hash)
+		if err != nil {
+			return nil, fmt.Errorf("get commit object: %w", err)
+		}
+
+		objects = append(objects, commit)
+	}
+
+	sort.Slice(objects, func(i, j int) bool {
+		if !objects[i].Committer.When.Equal(objects[j].Committer.When) {
+			return objects[i].Committer.When.After(objects[j].Committer.When)
+		}
+
+		return objects[i].Hash.String() < objects[j].Hash.String()
+	})
+
+	commits := make([]domain.Commit, 0, len(objects))
+	for _, commit := range objects {
+		commits = append(commits, r.convertCommit(commit))
+	}
+
+	return commits, nil
+}
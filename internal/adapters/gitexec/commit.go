@@ -0,0 +1,271 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package gitexec
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/itiquette/gommitlint/internal/adapters/charset"
+	"github.com/itiquette/gommitlint/internal/domain"
+)
+
+// readCommits reads the commit objects of hashes with one git cat-file --batch process
+// and converts them in the same order.
+func (r *Repository) readCommits(ctx context.Context, hashes []string) ([]domain.Commit, error) {
+	out, err := r.run(ctx, strings.NewReader(strings.Join(hashes, "\n")+"\n"), "cat-file", "--batch")
+	if err != nil {
+		return nil, fmt.Errorf("read commit objects: %w", err)
+	}
+
+	reader := bufio.NewReader(bytes.NewReader(out))
+	commits := make([]domain.Commit, 0, len(hashes))
+
+	for range hashes {
+		commit, err := r.readCommitObject(reader)
+		if err != nil {
+			return nil, fmt.Errorf("read commit objects: %w", err)
+		}
+
+		commits = append(commits, commit)
+	}
+
+	return commits, nil
+}
+
+// streamCommits runs a rev-list command piped into git cat-file --batch and calls visit
+// with each commit it lists, in its order, as cat-file prints them. Stopping early
+// kills both processes.
+func (r *Repository) streamCommits(ctx context.Context, visit func(domain.Commit) error, args ...string) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var listStderr, readStderr bytes.Buffer
+
+	list := r.command(ctx, args...)
+	list.Stderr = &listStderr
+
+	read := r.command(ctx, "cat-file", "--batch")
+	read.Stderr = &readStderr
+
+	hashes, err := list.StdoutPipe()
+	if err != nil {
+		return fmt.Errorf("list commits: %w", err)
+	}
+
+	read.Stdin = hashes
+
+	objects, err := read.StdoutPipe()
+	if err != nil {
+		return fmt.Errorf("read commit objects: %w", err)
+	}
+
+	if err := list.Start(); err != nil {
+		return fmt.Errorf("list commits: %w", err)
+	}
+
+	if err := read.Start(); err != nil {
+		cancel()
+		_ = list.Wait()
+
+		return fmt.Errorf("read commit objects: %w", err)
+	}
+
+	visitErr := r.visitCommitObjects(bufio.NewReader(objects), visit)
+	if visitErr != nil {
+		cancel()
+	}
+
+	readErr := read.Wait()
+	listErr := list.Wait()
+
+	switch {
+	case visitErr != nil:
+		return visitErr
+	case listErr != nil:
+		return fmt.Errorf("list commits: %w", commandError(ctx, args, &listStderr, listErr))
+	case readErr != nil:
+		return fmt.Errorf("read commit objects: %w", commandError(ctx, []string{"cat-file"}, &readStderr, readErr))
+	default:
+		return nil
+	}
+}
+
+// visitCommitObjects reads commit objects until the end of reader and calls visit with
+// each of them.
+func (r *Repository) visitCommitObjects(reader *bufio.Reader, visit func(domain.Commit) error) error {
+	for {
+		if _, err := reader.Peek(1); errors.Is(err, io.EOF) {
+			return nil
+		}
+
+		commit, err := r.readCommitObject(reader)
+		if err != nil {
+			return fmt.Errorf("read commit objects: %w", err)
+		}
+
+		if err := visit(commit); err != nil {
+			return err
+		}
+	}
+}
+
+// readCommitObject reads the next object of git cat-file --batch output, which must be
+// a commit, and converts it.
+func (r *Repository) readCommitObject(reader *bufio.Reader) (domain.Commit, error) {
+	// Each object is "<hash> <type> <size>\n<content>\n"
+	header, err := reader.ReadString('\n')
+	if err != nil {
+		return domain.Commit{}, err
+	}
+
+	fields := strings.Fields(header)
+	if len(fields) != 3 || fields[1] != "commit" {
+		return domain.Commit{}, fmt.Errorf("%s is not a commit", strings.TrimSpace(header))
+	}
+
+	size, err := strconv.Atoi(fields[2])
+	if err != nil {
+		return domain.Commit{}, fmt.Errorf("invalid size in %q", strings.TrimSpace(header))
+	}
+
+	content := make([]byte, size+1)
+	if _, err := io.ReadFull(reader, content); err != nil {
+		return domain.Commit{}, err
+	}
+
+	return r.parseCommit(fields[0], content[:size]), nil
+}
+
+// parseCommit converts a raw commit object to a domain commit, transcoding the message
+// to UTF-8 from the encoding its encoding header names.
+func (r *Repository) parseCommit(hash string, raw []byte) domain.Commit {
+	headers, message, _ := bytes.Cut(raw, []byte("\n\n"))
+
+	var (
+		author, committer identity
+		parents           []string
+		encoding          string
+		signature         strings.Builder
+		payload           strings.Builder
+		inSignature       bool
+	)
+
+	for _, line := range strings.SplitAfter(string(headers), "\n") {
+		// Continuation lines of multi-line headers start with a space
+		if strings.HasPrefix(line, " ") {
+			if inSignature {
+				signature.WriteString(strings.TrimLeft(line, " "))
+			} else {
+				payload.WriteString(line)
+			}
+
+			continue
+		}
+
+		name, value, _ := strings.Cut(strings.TrimSuffix(line, "\n"), " ")
+		inSignature = name == "gpgsig"
+
+		switch name {
+		case "gpgsig":
+			signature.WriteString(value + "\n")
+
+			continue
+		case "parent":
+			parents = append(parents, value)
+		case "author":
+			author = parseIdentity(value)
+		case "committer":
+			committer = parseIdentity(value)
+		case "encoding":
+			encoding = value
+		}
+
+		payload.WriteString(line)
+	}
+
+	// The last header line has lost its newline to the cut
+	if signature.Len() > 0 && !strings.HasSuffix(signature.String(), "\n") {
+		signature.WriteString("\n")
+	}
+
+	decoded, decodeErr := charset.DecodeCommitMessage(string(message), encoding)
+
+	commit := domain.NewCommit(
+		hash,
+		decoded,
+		author.name,
+		author.email,
+		author.when.Format("2006-01-02T15:04:05Z"),
+		signature.String(),
+		len(parents) > 1,
+	)
+	commit.Committer = committer.name
+	commit.CommitterEmail = committer.email
+	commit.ParentHashes = parents
+	commit.Mailmap = r.mailmap
+	commit.Encoding = encoding
+
+	if decodeErr != nil {
+		commit.EncodingError = decodeErr.Error()
+	}
+
+	if signature.Len() > 0 {
+		// The signed data is the object without its signature header
+		commit.SignedPayload = strings.TrimSuffix(payload.String(), "\n") + "\n\n" + string(message)
+	}
+
+	return commit
+}
+
+// identity is the author or committer of a commit.
+type identity struct {
+	name  string
+	email string
+	when  time.Time
+}
+
+// parseIdentity parses an author or committer header value such as
+// "Jane Doe <jane@example.com> 1700000000 +0100".
+func parseIdentity(value string) identity {
+	open := strings.LastIndex(value, "<")
+	closing := strings.LastIndex(value, ">")
+
+	if open < 0 || closing < open {
+		return identity{name: strings.TrimSpace(value)}
+	}
+
+	parsed := identity{
+		name:  strings.TrimSpace(value[:open]),
+		email: value[open+1 : closing],
+	}
+
+	fields := strings.Fields(value[closing+1:])
+	if len(fields) == 0 {
+		return parsed
+	}
+
+	seconds, err := strconv.ParseInt(fields[0], 10, 64)
+	if err != nil {
+		return parsed
+	}
+
+	parsed.when = time.Unix(seconds, 0).UTC()
+
+	if len(fields) > 1 {
+		if zone, err := time.Parse("-0700", fields[1]); err == nil {
+			parsed.when = parsed.when.In(zone.Location())
+		}
+	}
+
+	return parsed
+}
@@ -0,0 +1,23 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+/*
+Package gitexec provides a Git repository adapter that runs the system git binary.
+
+It implements the same domain interfaces as the go-git based adapter in package git,
+for repositories go-git cannot open or read, such as repositories using the SHA-256
+object format or extensions go-git does not know. It is selected with
+--git-backend=exec, and by default whenever go-git fails to open a repository.
+
+Commits are read as raw objects through a single git cat-file --batch process, so
+that signatures and signed payloads are the bytes git itself verifies:
+
+	rev-list   ->  commit hashes
+	cat-file   ->  raw commit objects  ->  domain.Commit
+
+Commands run with GIT_NO_LAZY_FETCH set, so that with git 2.44 and later partial
+clones do not fetch missing objects while commits are validated. Tags and submodules
+are only available with the go-git adapter.
+*/
+package gitexec
@@ -0,0 +1,104 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package gitexec
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/itiquette/gommitlint/internal/adapters/tracing"
+	"github.com/itiquette/gommitlint/internal/domain"
+)
+
+// GetMergeBase returns the hash of the best common ancestor of two refs.
+// When there are several, as after criss-cross merges, the first one git reports is returned.
+func (r *Repository) GetMergeBase(ctx context.Context, ref, other string) (string, error) {
+	ctx, span := tracing.Start(ctx, "git GetMergeBase")
+	defer span.End()
+
+	hash, err := r.resolveCommit(ctx, ref)
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve '%s': %w", ref, err)
+	}
+
+	otherHash, err := r.resolveCommit(ctx, other)
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve '%s': %w", other, err)
+	}
+
+	base, err := r.output(ctx, "merge-base", hash, otherHash)
+	if err != nil {
+		if exitCode(err) == 1 {
+			return "", fmt.Errorf("'%s' and '%s' have no common ancestor", ref, other)
+		}
+
+		return "", fmt.Errorf("find merge base: %w", err)
+	}
+
+	return base, nil
+}
+
+// GetFirstParentRange returns the commits on the first-parent chain of to that are
+// not reachable from from, newest first. Commits brought in by the second parent of
+// a merge are left out, as in git log --first-parent from..to.
+func (r *Repository) GetFirstParentRange(ctx context.Context, from, to string) ([]domain.Commit, error) {
+	ctx, span := tracing.Start(ctx, "git GetFirstParentRange")
+	defer span.End()
+
+	fromHash, err := r.resolveCommit(ctx, from)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve 'from' reference: %w", err)
+	}
+
+	toHash, err := r.resolveCommit(ctx, to)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve 'to' reference: %w", err)
+	}
+
+	return r.listCommits(ctx, "rev-list", "--first-parent", toHash, "^"+fromHash)
+}
+
+// GetUpstreamDivergence compares HEAD with upstream, or with the remote tracking branch
+// of the checked out branch when upstream is empty.
+func (r *Repository) GetUpstreamDivergence(ctx context.Context, upstream string) (domain.UpstreamDivergence, error) {
+	ctx, span := tracing.Start(ctx, "git GetUpstreamDivergence")
+	defer span.End()
+
+	if upstream == "" {
+		branch, err := r.GetCurrentBranch(ctx)
+		if err != nil || branch == "" {
+			return domain.UpstreamDivergence{}, err
+		}
+
+		tracking, err := r.output(ctx, "rev-parse", "--abbrev-ref", "--symbolic-full-name", branch+"@{upstream}")
+		if err != nil {
+			return domain.UpstreamDivergence{}, nil //nolint:nilerr // no upstream is not an error
+		}
+
+		upstream = tracking
+	}
+
+	head, err := r.resolveCommit(ctx, "HEAD")
+	if err != nil {
+		return domain.UpstreamDivergence{}, fmt.Errorf("failed to resolve HEAD: %w", err)
+	}
+
+	upstreamHash, err := r.resolveCommit(ctx, upstream)
+	if err != nil {
+		return domain.UpstreamDivergence{}, fmt.Errorf("failed to resolve '%s': %w", upstream, err)
+	}
+
+	ahead, err := r.listCommits(ctx, "rev-list", "--date-order", head, "^"+upstreamHash)
+	if err != nil {
+		return domain.UpstreamDivergence{}, err
+	}
+
+	behind, err := r.listCommits(ctx, "rev-list", "--date-order", upstreamHash, "^"+head)
+	if err != nil {
+		return domain.UpstreamDivergence{}, err
+	}
+
+	return domain.UpstreamDivergence{Upstream: upstream, Ahead: ahead, Behind: behind}, nil
+}
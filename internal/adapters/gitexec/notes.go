@@ -0,0 +1,53 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package gitexec
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/itiquette/gommitlint/internal/adapters/git"
+	"github.com/itiquette/gommitlint/internal/domain"
+)
+
+// GetWaivers returns the waivers recorded in the waiver note of a commit.
+func (r *Repository) GetWaivers(ctx context.Context, ref string) ([]domain.Waiver, error) {
+	hash, err := r.resolveCommit(ctx, ref)
+	if err != nil {
+		return nil, fmt.Errorf("get commit: %w", err)
+	}
+
+	note, err := r.output(ctx, "notes", "--ref="+git.WaiverNotesRef, "show", hash)
+	if err != nil {
+		if exitCode(err) == 1 {
+			// The commit has no note
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("read note: %w", err)
+	}
+
+	return domain.ParseWaivers(note), nil
+}
+
+// AddWaiver appends a waiver to the waiver note of a commit with git notes append.
+func (r *Repository) AddWaiver(ctx context.Context, ref string, waiver domain.Waiver) error {
+	if err := waiver.Validate(); err != nil {
+		return err
+	}
+
+	hash, err := r.resolveCommit(ctx, ref)
+	if err != nil {
+		return fmt.Errorf("get commit: %w", err)
+	}
+
+	_, err = r.run(ctx, strings.NewReader(waiver.String()), "notes", "--ref="+git.WaiverNotesRef, "append", "--file=-", hash)
+	if err != nil {
+		return fmt.Errorf("update %s: %w", git.WaiverNotesRef, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,623 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package gitexec
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/itiquette/gommitlint/internal/adapters/tracing"
+	"github.com/itiquette/gommitlint/internal/domain"
+)
+
+// maxMailmapSize limits how much of a .mailmap file is read.
+const maxMailmapSize = 1 << 20
+
+// Repository implements the CommitRepository port with the git binary.
+type Repository struct {
+	path    string
+	mailmap domain.Mailmap
+}
+
+// Ensure Repository implements the domain interfaces it is used through.
+var (
+	_ domain.Repository             = (*Repository)(nil)
+	_ domain.PushResolver           = (*Repository)(nil)
+	_ domain.BranchResolver         = (*Repository)(nil)
+	_ domain.CurrentBranchResolver  = (*Repository)(nil)
+	_ domain.RepositoryNameResolver = (*Repository)(nil)
+	_ domain.PathResolver           = (*Repository)(nil)
+	_ domain.HistoryResolver        = (*Repository)(nil)
+	_ domain.UpstreamResolver       = (*Repository)(nil)
+	_ domain.BehindCounter          = (*Repository)(nil)
+	_ domain.WaiverResolver         = (*Repository)(nil)
+	_ domain.DiffStatResolver       = (*Repository)(nil)
+	_ domain.RemoteURLResolver      = (*Repository)(nil)
+)
+
+// NewRepository opens the git repository at the given path. It fails when git is not
+// installed or path is not inside a repository.
+func NewRepository(path string) (*Repository, error) {
+	repo := &Repository{path: path}
+
+	if _, err := repo.output(context.Background(), "rev-parse", "--git-dir"); err != nil {
+		return nil, fmt.Errorf("open repository: %w", err)
+	}
+
+	repo.mailmap = repo.loadMailmap()
+
+	return repo, nil
+}
+
+// GetCommit retrieves a single commit by hash or reference.
+func (r *Repository) GetCommit(ctx context.Context, ref string) (domain.Commit, error) {
+	ctx, span := tracing.Start(ctx, "git GetCommit")
+	defer span.End()
+
+	hash, err := r.resolveCommit(ctx, ref)
+	if err != nil {
+		return domain.Commit{}, fmt.Errorf("get commit: %w", err)
+	}
+
+	commits, err := r.readCommits(ctx, []string{hash})
+	if err != nil {
+		return domain.Commit{}, fmt.Errorf("get commit: %w", err)
+	}
+
+	return commits[0], nil
+}
+
+// GetCommitRange retrieves commits in a range (from..to).
+// Returns all commits reachable from 'to' but not reachable from 'from'.
+func (r *Repository) GetCommitRange(ctx context.Context, fromRef, toRef string) ([]domain.Commit, error) {
+	ctx, span := tracing.Start(ctx, "git GetCommitRange")
+	defer span.End()
+
+	fromHash, err := r.resolveCommit(ctx, fromRef)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve 'from' reference: %w", err)
+	}
+
+	toHash, err := r.resolveCommit(ctx, toRef)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve 'to' reference: %w", err)
+	}
+
+	return r.listCommits(ctx, "rev-list", "--date-order", toHash, "^"+fromHash)
+}
+
+// StreamCommitRange calls visit with each commit in a range (from..to) while git lists
+// them, so that the range is never held in memory.
+func (r *Repository) StreamCommitRange(ctx context.Context, fromRef, toRef string, visit func(domain.Commit) error) error {
+	ctx, span := tracing.Start(ctx, "git StreamCommitRange")
+	defer span.End()
+
+	return r.streamRange(ctx, fromRef, toRef, visit, "--date-order")
+}
+
+// StreamCommitRangeOldestFirst calls visit with each commit in a range (from..to) oldest
+// first, the reverse of StreamCommitRange.
+func (r *Repository) StreamCommitRangeOldestFirst(ctx context.Context, fromRef, toRef string, visit func(domain.Commit) error) error {
+	ctx, span := tracing.Start(ctx, "git StreamCommitRangeOldestFirst")
+	defer span.End()
+
+	return r.streamRange(ctx, fromRef, toRef, visit, "--date-order", "--reverse")
+}
+
+// streamRange resolves the ends of the range from..to and streams its commits in the
+// order rev-list lists them with orderArgs.
+func (r *Repository) streamRange(ctx context.Context, fromRef, toRef string, visit func(domain.Commit) error, orderArgs ...string) error {
+	fromHash, err := r.resolveCommit(ctx, fromRef)
+	if err != nil {
+		return fmt.Errorf("failed to resolve 'from' reference: %w", err)
+	}
+
+	toHash, err := r.resolveCommit(ctx, toRef)
+	if err != nil {
+		return fmt.Errorf("failed to resolve 'to' reference: %w", err)
+	}
+
+	args := append(append([]string{"rev-list"}, orderArgs...), toHash, "^"+fromHash)
+
+	return r.streamCommits(ctx, visit, args...)
+}
+
+// GetHeadCommits retrieves the latest N commits from HEAD.
+func (r *Repository) GetHeadCommits(ctx context.Context, count int) ([]domain.Commit, error) {
+	ctx, span := tracing.Start(ctx, "git GetHeadCommits")
+	defer span.End()
+
+	if count <= 0 {
+		return []domain.Commit{}, nil
+	}
+
+	return r.listCommits(ctx, "rev-list", "--max-count="+strconv.Itoa(count), "HEAD")
+}
+
+// GetCommitsAheadCount returns how many commits the current branch is ahead of the reference.
+func (r *Repository) GetCommitsAheadCount(ctx context.Context, referenceBranch string) (int, error) {
+	ctx, span := tracing.Start(ctx, "git GetCommitsAheadCount")
+	defer span.End()
+
+	refHash, found := r.referenceBranchHash(ctx, referenceBranch)
+	if !found {
+		// Reference doesn't exist, return 0 (not ahead)
+		return 0, nil
+	}
+
+	return r.countCommits(ctx, "HEAD", "^"+refHash)
+}
+
+// GetCommitsBehindCount returns how many commits of the reference branch the current
+// branch does not contain.
+func (r *Repository) GetCommitsBehindCount(ctx context.Context, referenceBranch string) (int, error) {
+	ctx, span := tracing.Start(ctx, "git GetCommitsBehindCount")
+	defer span.End()
+
+	refHash, found := r.referenceBranchHash(ctx, referenceBranch)
+	if !found {
+		// Reference doesn't exist, return 0 (not behind)
+		return 0, nil
+	}
+
+	return r.countCommits(ctx, refHash, "^HEAD")
+}
+
+// referenceBranchHash finds the commit of the reference branch of the branch checks,
+// preferring the remote branch on origin over the local branch.
+func (r *Repository) referenceBranchHash(ctx context.Context, referenceBranch string) (string, bool) {
+	refFormats := []string{
+		"refs/remotes/origin/" + referenceBranch, // Remote branch
+		"refs/heads/" + referenceBranch,          // Local branch
+		"refs/remotes/" + referenceBranch,        // Legacy format
+	}
+
+	for _, refName := range refFormats {
+		if hash, err := r.output(ctx, "rev-parse", "--verify", "--quiet", refName+"^{commit}"); err == nil {
+			return hash, true
+		}
+	}
+
+	return "", false
+}
+
+// GetTargetBranch returns the branch the checked out branch merges into according to
+// its branch.<name>.merge setting, falling back to the checked out branch.
+func (r *Repository) GetTargetBranch(ctx context.Context) (string, error) {
+	branch, err := r.GetCurrentBranch(ctx)
+	if err != nil || branch == "" {
+		return "", err
+	}
+
+	merge := r.configValue(ctx, "branch."+branch+".merge")
+	if target, isBranch := strings.CutPrefix(merge, "refs/heads/"); isBranch {
+		return target, nil
+	}
+
+	return branch, nil
+}
+
+// GetCurrentBranch returns the short name of the checked out branch, or an empty name
+// when HEAD is detached.
+func (r *Repository) GetCurrentBranch(ctx context.Context) (string, error) {
+	head, err := r.output(ctx, "symbolic-ref", "--quiet", "HEAD")
+	if err != nil {
+		if exitCode(err) == 1 {
+			// HEAD is detached
+			return "", nil
+		}
+
+		return "", fmt.Errorf("get HEAD: %w", err)
+	}
+
+	if branch, isBranch := strings.CutPrefix(head, "refs/heads/"); isBranch {
+		return branch, nil
+	}
+
+	return "", nil
+}
+
+// GetRepositoryName returns the name of the work tree directory.
+func (r *Repository) GetRepositoryName(ctx context.Context) (string, error) {
+	topLevel, err := r.output(ctx, "rev-parse", "--show-toplevel")
+	if err != nil {
+		return "", fmt.Errorf("get work tree: %w", err)
+	}
+
+	return filepath.Base(topLevel), nil
+}
+
+// GetRemoteURL returns the fetch URL of remote, with the url.<base>.insteadOf rewrites
+// of the git configuration applied.
+func (r *Repository) GetRemoteURL(ctx context.Context, remote string) (string, error) {
+	remoteURL, err := r.output(ctx, "remote", "get-url", remote)
+	if err != nil {
+		return "", fmt.Errorf("get remote %s: %w", remote, err)
+	}
+
+	return remoteURL, nil
+}
+
+// BranchTips returns the commit hash of each local branch by short branch name.
+func (r *Repository) BranchTips(ctx context.Context) (map[string]string, error) {
+	out, err := r.output(ctx, "for-each-ref", "--format=%(refname:strip=2)%00%(objectname)", "refs/heads")
+	if err != nil {
+		return nil, fmt.Errorf("list branches: %w", err)
+	}
+
+	tips := make(map[string]string)
+
+	for _, line := range lines(out) {
+		if branch, hash, found := strings.Cut(line, "\x00"); found {
+			tips[branch] = hash
+		}
+	}
+
+	return tips, nil
+}
+
+// GetChangedPaths returns the paths a commit adds, modifies or removes compared to its first parent.
+func (r *Repository) GetChangedPaths(ctx context.Context, ref string) ([]string, error) {
+	out, err := r.firstParentDiff(ctx, ref, "--name-only")
+	if err != nil {
+		return nil, err
+	}
+
+	return paths(out), nil
+}
+
+// GetDiffStat returns the diffstat of a commit compared to its first parent.
+func (r *Repository) GetDiffStat(ctx context.Context, ref string) (domain.DiffStat, error) {
+	ctx, span := tracing.Start(ctx, "git GetDiffStat")
+	defer span.End()
+
+	out, err := r.firstParentDiff(ctx, ref, "--numstat")
+	if err != nil {
+		return domain.DiffStat{}, err
+	}
+
+	var stat domain.DiffStat
+
+	// Each file is "<insertions>\t<deletions>\t<path>", with "-" counts for binary files
+	for _, line := range paths(out) {
+		fields := strings.SplitN(line, "\t", 3)
+		if len(fields) != 3 {
+			continue
+		}
+
+		stat.Files++
+
+		if insertions, err := strconv.Atoi(fields[0]); err == nil {
+			stat.Insertions += insertions
+		}
+
+		if deletions, err := strconv.Atoi(fields[1]); err == nil {
+			stat.Deletions += deletions
+		}
+	}
+
+	return stat, nil
+}
+
+// firstParentDiff runs git diff-tree with format, such as --name-only, on a commit and
+// its first parent, or an empty tree for a root commit.
+func (r *Repository) firstParentDiff(ctx context.Context, ref, format string) (string, error) {
+	hash, err := r.resolveCommit(ctx, ref)
+	if err != nil {
+		return "", fmt.Errorf("get commit: %w", err)
+	}
+
+	args := []string{"diff-tree", "-r", "-z", "--no-renames", "--no-commit-id", format}
+
+	if _, err := r.output(ctx, "rev-parse", "--verify", "--quiet", hash+"^1"); err == nil {
+		args = append(args, hash+"^1", hash)
+	} else {
+		// Root commits are compared to an empty tree
+		args = append(args, "--root", hash)
+	}
+
+	out, err := r.output(ctx, args...)
+	if err != nil {
+		return "", fmt.Errorf("diff trees: %w", r.missingObjectError(ctx, err))
+	}
+
+	return out, nil
+}
+
+// GetStagedPaths returns the paths with changes in the index, as a commit-msg hook sees them.
+func (r *Repository) GetStagedPaths(ctx context.Context) ([]string, error) {
+	args := []string{"diff-index", "--cached", "-z", "--no-renames", "--name-only", "HEAD"}
+
+	if _, err := r.output(ctx, "rev-parse", "--verify", "--quiet", "HEAD"); err != nil {
+		// Before the first commit everything in the index is staged
+		args = []string{"ls-files", "-z", "--cached"}
+	}
+
+	out, err := r.output(ctx, args...)
+	if err != nil {
+		return nil, fmt.Errorf("get status: %w", r.missingObjectError(ctx, err))
+	}
+
+	staged := paths(out)
+	sort.Strings(staged)
+
+	return staged, nil
+}
+
+// GetOutgoingCommits returns the commits of a push update that the remote does not have.
+// Updates of an existing remote ref send the commits between the remote and local hash.
+// For new refs, or when the remote hash has not been fetched, commits reachable from
+// the remote-tracking branches of remote are treated as already on the remote.
+func (r *Repository) GetOutgoingCommits(ctx context.Context, remote string, update domain.PushUpdate) ([]domain.Commit, error) {
+	ctx, span := tracing.Start(ctx, "git GetOutgoingCommits")
+	defer span.End()
+
+	if update.IsDeletion() {
+		return nil, nil
+	}
+
+	if !update.IsNewRef() {
+		if _, err := r.resolveCommit(ctx, update.RemoteHash); err == nil {
+			return r.GetCommitRange(ctx, update.RemoteHash, update.LocalHash)
+		}
+	}
+
+	if _, err := r.resolveCommit(ctx, update.LocalHash); err != nil {
+		return nil, fmt.Errorf("get pushed commit: %w", err)
+	}
+
+	// A remote given as URL has no tracking branches
+	return r.listCommits(ctx, "rev-list", update.LocalHash, "--not", "--remotes="+remote)
+}
+
+// GetReceivedCommits returns the commits of a push update that no ref of the repository
+// reaches yet. In the pre-receive hook the refs still point where they did before the
+// push, and the pushed objects are read from the quarantine git names in the environment.
+func (r *Repository) GetReceivedCommits(ctx context.Context, update domain.PushUpdate) ([]domain.Commit, error) {
+	ctx, span := tracing.Start(ctx, "git GetReceivedCommits")
+	defer span.End()
+
+	if update.IsDeletion() {
+		return nil, nil
+	}
+
+	if _, err := r.resolveCommit(ctx, update.LocalHash); err != nil {
+		return nil, fmt.Errorf("get received commit: %w", err)
+	}
+
+	return r.listCommits(ctx, "rev-list", update.LocalHash, "--not", "--all")
+}
+
+// GetPushCertificate returns the push certificate git stored in the blob hash for the
+// pre-receive hook, which names it in GIT_PUSH_CERT.
+func (r *Repository) GetPushCertificate(ctx context.Context, hash string) (domain.PushCertificate, error) {
+	out, err := r.run(ctx, nil, "cat-file", "blob", hash)
+	if err != nil {
+		return domain.PushCertificate{}, fmt.Errorf("read push certificate: %w", err)
+	}
+
+	return domain.ParsePushCertificate(string(out)), nil
+}
+
+// resolveCommit resolves a reference, hash or revision such as HEAD~2 to the hash of its
+// commit, peeling annotated tags. Names only found on origin, such as main in a clone
+// without a local main branch, resolve to the remote branch.
+func (r *Repository) resolveCommit(ctx context.Context, ref string) (string, error) {
+	hash, err := r.output(ctx, "rev-parse", "--verify", "--quiet", "--end-of-options", ref+"^{commit}")
+	if err == nil {
+		return hash, nil
+	}
+
+	if hash, originErr := r.output(ctx, "rev-parse", "--verify", "--quiet", "refs/remotes/origin/"+ref+"^{commit}"); originErr == nil {
+		return hash, nil
+	}
+
+	return "", fmt.Errorf("reference not found: %s", ref)
+}
+
+// listCommits runs a rev-list command and reads the commits it lists, in its order.
+func (r *Repository) listCommits(ctx context.Context, args ...string) ([]domain.Commit, error) {
+	out, err := r.output(ctx, args...)
+	if err != nil {
+		return nil, fmt.Errorf("list commits: %w", err)
+	}
+
+	hashes := lines(out)
+	if len(hashes) == 0 {
+		return nil, nil
+	}
+
+	return r.readCommits(ctx, hashes)
+}
+
+// countCommits counts the commits reachable from the given revisions, which may exclude
+// commits with a leading ^.
+func (r *Repository) countCommits(ctx context.Context, revisions ...string) (int, error) {
+	out, err := r.output(ctx, append([]string{"rev-list", "--count"}, revisions...)...)
+	if err != nil {
+		return 0, fmt.Errorf("count commits: %w", err)
+	}
+
+	count, err := strconv.Atoi(out)
+	if err != nil {
+		return 0, fmt.Errorf("count commits: %w", err)
+	}
+
+	return count, nil
+}
+
+// configValue returns a value of the repository configuration, or an empty string when
+// it is not set.
+func (r *Repository) configValue(ctx context.Context, key string) string {
+	value, err := r.output(ctx, "config", "--get", key)
+	if err != nil {
+		return ""
+	}
+
+	return value
+}
+
+// isPartialClone reports whether the repository was cloned with a filter, so that git
+// fetches missing objects from a promisor remote on demand.
+func (r *Repository) isPartialClone(ctx context.Context) bool {
+	if r.configValue(ctx, "extensions.partialclone") != "" {
+		return true
+	}
+
+	out, err := r.output(ctx, "config", "--type=bool", "--get-regexp", `^remote\..*\.promisor$`)
+	if err != nil {
+		return false
+	}
+
+	for _, line := range lines(out) {
+		if strings.HasSuffix(line, " true") {
+			return true
+		}
+	}
+
+	return false
+}
+
+// missingObjectError wraps err with domain.ErrMissingObjects when the repository is a
+// partial clone, the likely reason git could not read an object of it.
+func (r *Repository) missingObjectError(ctx context.Context, err error) error {
+	if r.isPartialClone(ctx) {
+		return fmt.Errorf("%w: %w", domain.ErrMissingObjects, err)
+	}
+
+	return err
+}
+
+// loadMailmap reads the .mailmap of the repository from the working tree, or from HEAD
+// in bare repositories. A missing or unreadable file gives an empty mailmap.
+func (r *Repository) loadMailmap() domain.Mailmap {
+	ctx := context.Background()
+
+	if topLevel, err := r.output(ctx, "rev-parse", "--show-toplevel"); err == nil && topLevel != "" {
+		file, err := os.Open(filepath.Join(topLevel, ".mailmap"))
+		if err != nil {
+			return domain.Mailmap{}
+		}
+		defer file.Close()
+
+		content, err := io.ReadAll(io.LimitReader(file, maxMailmapSize))
+		if err != nil {
+			return domain.Mailmap{}
+		}
+
+		return domain.ParseMailmap(string(content))
+	}
+
+	size, err := r.output(ctx, "cat-file", "-s", "HEAD:.mailmap")
+	if err != nil {
+		return domain.Mailmap{}
+	}
+
+	if length, err := strconv.Atoi(size); err != nil || length > maxMailmapSize {
+		return domain.Mailmap{}
+	}
+
+	content, err := r.run(ctx, nil, "cat-file", "blob", "HEAD:.mailmap")
+	if err != nil {
+		return domain.Mailmap{}
+	}
+
+	return domain.ParseMailmap(string(content))
+}
+
+// output runs a git command in the repository and returns its output without the
+// trailing newline.
+func (r *Repository) output(ctx context.Context, args ...string) (string, error) {
+	out, err := r.run(ctx, nil, args...)
+	if err != nil {
+		return "", err
+	}
+
+	return strings.TrimSuffix(string(out), "\n"), nil
+}
+
+// run runs a git command in the repository with stdin as input. Failures report the
+// error message git printed.
+func (r *Repository) run(ctx context.Context, stdin io.Reader, args ...string) ([]byte, error) {
+	cmd := r.command(ctx, args...)
+	cmd.Stdin = stdin
+
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+
+	out, err := cmd.Output()
+	if err != nil {
+		return nil, commandError(ctx, args, &stderr, err)
+	}
+
+	return out, nil
+}
+
+// command prepares a git command in the repository.
+func (r *Repository) command(ctx context.Context, args ...string) *exec.Cmd {
+	cmd := exec.CommandContext(ctx, "git", append([]string{"-C", r.path}, args...)...)
+	cmd.Env = append(os.Environ(), "GIT_NO_LAZY_FETCH=1", "GIT_TERMINAL_PROMPT=0")
+
+	return cmd
+}
+
+// commandError describes the failure err of the git command args with the error message
+// git printed to stderr, or returns the error of ctx when it was cancelled.
+func commandError(ctx context.Context, args []string, stderr *bytes.Buffer, err error) error {
+	if ctxErr := ctx.Err(); ctxErr != nil {
+		return ctxErr
+	}
+
+	if message := strings.TrimSpace(stderr.String()); message != "" {
+		return fmt.Errorf("git %s: %s: %w", args[0], message, err)
+	}
+
+	return fmt.Errorf("git %s: %w", args[0], err)
+}
+
+// exitCode returns the exit code of a failed git command, or -1 when it did not run.
+func exitCode(err error) int {
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) {
+		return exitErr.ExitCode()
+	}
+
+	return -1
+}
+
+// lines splits command output into its non-empty lines.
+func lines(out string) []string {
+	var result []string
+
+	for _, line := range strings.Split(out, "\n") {
+		if line != "" {
+			result = append(result, line)
+		}
+	}
+
+	return result
+}
+
+// paths splits the NUL separated output of a -z command into paths.
+func paths(out string) []string {
+	result := []string{}
+
+	for _, path := range strings.Split(out, "\x00") {
+		if path != "" {
+			result = append(result, path)
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,470 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package gitexec_test
+
+import (
+	"context"
+	"errors"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/itiquette/gommitlint/internal/adapters/git"
+	"github.com/itiquette/gommitlint/internal/adapters/gitexec"
+	"github.com/itiquette/gommitlint/internal/domain"
+)
+
+// initRepository creates a repository with the git binary, skipping the test when git
+// is not installed.
+func initRepository(t *testing.T, args ...string) string {
+	t.Helper()
+
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git is not installed")
+	}
+
+	dir := t.TempDir()
+	runGit(t, dir, append([]string{"init", "--quiet", "--initial-branch=main"}, args...)...)
+
+	return dir
+}
+
+// runGit runs git in dir with a fixed identity and dates and returns its output.
+func runGit(t *testing.T, dir string, args ...string) string {
+	t.Helper()
+
+	return runGitInput(t, dir, "", args...)
+}
+
+// runGitInput runs git in dir with input on stdin.
+func runGitInput(t *testing.T, dir, input string, args ...string) string {
+	t.Helper()
+
+	cmd := exec.Command("git", append([]string{"-C", dir}, args...)...)
+	cmd.Stdin = strings.NewReader(input)
+	cmd.Env = append(os.Environ(),
+		"GIT_AUTHOR_NAME=Jane Doe", "GIT_AUTHOR_EMAIL=jane@example.com",
+		"GIT_COMMITTER_NAME=John Doe", "GIT_COMMITTER_EMAIL=john@example.com",
+		"GIT_AUTHOR_DATE=2025-03-01T10:00:00+01:00", "GIT_COMMITTER_DATE=2025-03-01T11:00:00+01:00",
+		"GIT_CONFIG_GLOBAL=/dev/null", "GIT_CONFIG_NOSYSTEM=1")
+
+	out, err := cmd.CombinedOutput()
+	require.NoError(t, err, string(out))
+
+	return strings.TrimSpace(string(out))
+}
+
+func commitFile(t *testing.T, dir, name, message string) string {
+	t.Helper()
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(message), 0o600))
+	runGit(t, dir, "add", name)
+	runGit(t, dir, "commit", "--quiet", "-m", message)
+
+	return runGit(t, dir, "rev-parse", "HEAD")
+}
+
+// TestCommitsMatchGoGit reads the same commits with both backends, including a signed
+// commit whose signature and signed payload must be the bytes git verifies.
+func TestCommitsMatchGoGit(t *testing.T) {
+	dir := initRepository(t)
+	base := commitFile(t, dir, "a.txt", "feat: add a\n\nWith a body.")
+	runGit(t, dir, "checkout", "--quiet", "-b", "feature", base)
+	commitFile(t, dir, "b.txt", "fix: add b")
+	runGit(t, dir, "checkout", "--quiet", "main")
+	commitFile(t, dir, "c.txt", "docs: add c")
+	runGit(t, dir, "merge", "--quiet", "--no-ff", "-m", "Merge branch 'feature'", "feature")
+
+	// A commit object with a signature header, as git commit -S writes it
+	tree := runGit(t, dir, "rev-parse", "HEAD^{tree}")
+	head := runGit(t, dir, "rev-parse", "HEAD")
+	signed := runGitInput(t, dir, "tree "+tree+"\nparent "+head+"\n"+
+		"author Jane Doe <jane@example.com> 1740819600 +0100\n"+
+		"committer John Doe <john@example.com> 1740823200 +0100\n"+
+		"gpgsig -----BEGIN PGP SIGNATURE-----\n \n iQEzBAABCAAdFiEE\n -----END PGP SIGNATURE-----\n"+
+		"\nfeat: signed commit\n", "hash-object", "-t", "commit", "-w", "--stdin")
+	runGit(t, dir, "update-ref", "refs/heads/main", signed)
+
+	goGitRepo, err := git.NewRepository(dir)
+	require.NoError(t, err)
+
+	execRepo, err := gitexec.NewRepository(dir)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+
+	expected, err := goGitRepo.GetCommitRange(ctx, base, "main")
+	require.NoError(t, err)
+
+	commits, err := execRepo.GetCommitRange(ctx, base, "main")
+	require.NoError(t, err)
+	require.Equal(t, expected, commits, "both backends list ranges in date order")
+	require.Len(t, commits, 4)
+
+	var goGitOldest, execOldest []domain.Commit
+
+	require.NoError(t, goGitRepo.StreamCommitRangeOldestFirst(ctx, base, "main", func(commit domain.Commit) error {
+		goGitOldest = append(goGitOldest, commit)
+
+		return nil
+	}))
+	require.NoError(t, execRepo.StreamCommitRangeOldestFirst(ctx, base, "main", func(commit domain.Commit) error {
+		execOldest = append(execOldest, commit)
+
+		return nil
+	}))
+	require.Equal(t, goGitOldest, execOldest, "both backends stream ranges oldest first alike")
+	require.Equal(t, expected[len(expected)-1], execOldest[0])
+
+	signedCommit, err := execRepo.GetCommit(ctx, "HEAD")
+	require.NoError(t, err)
+	require.Equal(t, signed, signedCommit.Hash)
+	require.Contains(t, signedCommit.Signature, "-----BEGIN PGP SIGNATURE-----\n\niQEzBAABCAAdFiEE\n")
+	require.NotContains(t, signedCommit.SignedPayload, "gpgsig")
+	require.Equal(t, "2025-03-01T10:00:00Z", signedCommit.CommitDate)
+
+	merge, err := execRepo.GetCommit(ctx, "HEAD~1")
+	require.NoError(t, err)
+	require.True(t, merge.IsMergeCommit)
+	require.Len(t, merge.ParentHashes, 2)
+
+	paths, err := execRepo.GetChangedPaths(ctx, "HEAD~1")
+	require.NoError(t, err)
+	require.Equal(t, []string{"b.txt"}, paths)
+
+	paths, err = execRepo.GetChangedPaths(ctx, base)
+	require.NoError(t, err)
+	require.Equal(t, []string{"a.txt"}, paths)
+
+	firstParent, err := execRepo.GetFirstParentRange(ctx, base, "HEAD~1")
+	require.NoError(t, err)
+	require.Len(t, firstParent, 2)
+	require.Equal(t, "Merge branch 'feature'", firstParent[0].Subject)
+	require.Equal(t, "docs: add c", firstParent[1].Subject)
+
+	mergeBase, err := execRepo.GetMergeBase(ctx, "main", "feature")
+	require.NoError(t, err)
+	require.Equal(t, runGit(t, dir, "rev-parse", "feature"), mergeBase)
+}
+
+// TestEncodingHeader reads commits with an encoding header with both backends, which
+// transcode their messages to UTF-8 or report why they cannot.
+func TestEncodingHeader(t *testing.T) {
+	dir := initRepository(t)
+	base := commitFile(t, dir, "a.txt", "feat: add a")
+	tree := runGit(t, dir, "rev-parse", "HEAD^{tree}")
+
+	// Each commit is newer than its parent, so that both backends list them alike
+	parent := base
+	for _, object := range []struct{ encoding, message, committed string }{
+		{"ISO-8859-1", "fix: r\xe4kna om\n\nF\xf6rklaring.\n", "1740823200"},
+		{"klingon", "fix: r\xe4kna om\n", "1740826800"},
+	} {
+		parent = runGitInput(t, dir, "tree "+tree+"\nparent "+parent+"\n"+
+			"author Jane Doe <jane@example.com> 1740819600 +0100\n"+
+			"committer John Doe <john@example.com> "+object.committed+" +0100\n"+
+			"encoding "+object.encoding+"\n\n"+object.message, "hash-object", "-t", "commit", "-w", "--stdin")
+	}
+
+	runGit(t, dir, "update-ref", "refs/heads/main", parent)
+
+	goGitRepo, err := git.NewRepository(dir)
+	require.NoError(t, err)
+
+	execRepo, err := gitexec.NewRepository(dir)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+
+	expected, err := goGitRepo.GetCommitRange(ctx, base, "main")
+	require.NoError(t, err)
+
+	commits, err := execRepo.GetCommitRange(ctx, base, "main")
+	require.NoError(t, err)
+	require.Equal(t, expected, commits)
+	require.Len(t, commits, 2)
+
+	unknown, latin1 := commits[0], commits[1]
+	require.Equal(t, "ISO-8859-1", latin1.Encoding)
+	require.Equal(t, "fix: räkna om", latin1.Subject)
+	require.Equal(t, "Förklaring.", latin1.Body)
+	require.Empty(t, latin1.EncodingError)
+
+	require.Equal(t, "klingon", unknown.Encoding)
+	require.Equal(t, "fix: r\xe4kna om", unknown.Subject, "the message is kept as stored")
+	require.Equal(t, "unknown encoding 'klingon'", unknown.EncodingError)
+}
+
+func TestStreamCommitRange(t *testing.T) {
+	dir := initRepository(t)
+	base := commitFile(t, dir, "a.txt", "feat: add a")
+	commitFile(t, dir, "b.txt", "fix: add b")
+	commitFile(t, dir, "c.txt", "docs: add c")
+
+	repo, err := gitexec.NewRepository(dir)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+
+	expected, err := repo.GetCommitRange(ctx, base, "HEAD")
+	require.NoError(t, err)
+
+	var streamed []domain.Commit
+
+	err = repo.StreamCommitRange(ctx, base, "HEAD", func(commit domain.Commit) error {
+		streamed = append(streamed, commit)
+
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, expected, streamed)
+
+	var oldestFirst []domain.Commit
+
+	err = repo.StreamCommitRangeOldestFirst(ctx, base, "HEAD", func(commit domain.Commit) error {
+		oldestFirst = append(oldestFirst, commit)
+
+		return nil
+	})
+	require.NoError(t, err)
+	slices.Reverse(oldestFirst)
+	require.Equal(t, expected, oldestFirst)
+
+	stop := errors.New("stop")
+	visited := 0
+
+	err = repo.StreamCommitRange(ctx, base, "HEAD", func(domain.Commit) error {
+		visited++
+
+		return stop
+	})
+	require.ErrorIs(t, err, stop, "an error of visit stops the stream")
+	require.Equal(t, 1, visited)
+
+	err = repo.StreamCommitRange(ctx, "missing", "HEAD", func(domain.Commit) error { return nil })
+	require.ErrorContains(t, err, "reference not found: missing")
+}
+
+func TestBranches(t *testing.T) {
+	dir := initRepository(t)
+	base := commitFile(t, dir, "a.txt", "feat: add a")
+	runGit(t, dir, "branch", "--quiet", "release")
+	runGit(t, dir, "checkout", "--quiet", "-b", "feature", "--track", "release")
+	commitFile(t, dir, "b.txt", "feat: add b")
+	commitFile(t, dir, "c.txt", "feat: add c")
+
+	repo, err := gitexec.NewRepository(dir)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+
+	current, err := repo.GetCurrentBranch(ctx)
+	require.NoError(t, err)
+	require.Equal(t, "feature", current)
+
+	name, err := repo.GetRepositoryName(ctx)
+	require.NoError(t, err)
+	require.Equal(t, filepath.Base(dir), name)
+
+	target, err := repo.GetTargetBranch(ctx)
+	require.NoError(t, err)
+	require.Equal(t, "release", target)
+
+	ahead, err := repo.GetCommitsAheadCount(ctx, "main")
+	require.NoError(t, err)
+	require.Equal(t, 2, ahead)
+
+	behind, err := repo.GetCommitsBehindCount(ctx, "feature")
+	require.NoError(t, err)
+	require.Equal(t, 0, behind)
+
+	missing, err := repo.GetCommitsAheadCount(ctx, "does-not-exist")
+	require.NoError(t, err)
+	require.Equal(t, 0, missing)
+
+	tips, err := repo.BranchTips(ctx)
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{"main": base, "release": base, "feature": runGit(t, dir, "rev-parse", "HEAD")}, tips)
+
+	divergence, err := repo.GetUpstreamDivergence(ctx, "")
+	require.NoError(t, err)
+	require.Equal(t, "release", divergence.Upstream)
+	require.Len(t, divergence.Ahead, 2)
+	require.Empty(t, divergence.Behind)
+
+	runGit(t, dir, "checkout", "--quiet", "--detach")
+
+	current, err = repo.GetCurrentBranch(ctx)
+	require.NoError(t, err)
+	require.Empty(t, current)
+}
+
+// TestPreReceive reads the commits and push certificate of a push as the pre-receive
+// hook sees them, before the pushed ref moves.
+func TestPreReceive(t *testing.T) {
+	dir := initRepository(t)
+	base := commitFile(t, dir, "a.txt", "feat: add a")
+	commitFile(t, dir, "b.txt", "feat: add b")
+	pushed := commitFile(t, dir, "c.txt", "feat: add c")
+	runGit(t, dir, "update-ref", "refs/heads/main", base)
+
+	repo, err := gitexec.NewRepository(dir)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+
+	commits, err := repo.GetReceivedCommits(ctx, domain.PushUpdate{LocalHash: pushed, RemoteHash: base, RemoteRef: "refs/heads/main"})
+	require.NoError(t, err)
+	require.Len(t, commits, 2)
+	require.Equal(t, "feat: add c", commits[0].Subject)
+	require.Equal(t, "feat: add b", commits[1].Subject)
+
+	// A new branch brings only the commits no existing ref reaches
+	commits, err = repo.GetReceivedCommits(ctx, domain.PushUpdate{LocalHash: pushed, RemoteRef: "refs/heads/feature"})
+	require.NoError(t, err)
+	require.Len(t, commits, 2)
+
+	commits, err = repo.GetReceivedCommits(ctx, domain.PushUpdate{RemoteHash: base, RemoteRef: "refs/heads/main"})
+	require.NoError(t, err)
+	require.Empty(t, commits)
+
+	certificate := "certificate version 0.1\npusher Jane Doe <jane@example.com> 1740823200 +0100\n\n" +
+		base + " " + pushed + " refs/heads/main\n-----BEGIN PGP SIGNATURE-----\n\n-----END PGP SIGNATURE-----\n"
+	blob := runGitInput(t, dir, certificate, "hash-object", "-w", "--stdin")
+
+	cert, err := repo.GetPushCertificate(ctx, blob)
+	require.NoError(t, err)
+	require.Equal(t, "Jane Doe <jane@example.com> 1740823200 +0100", cert.Pusher)
+	require.Equal(t, "-----BEGIN PGP SIGNATURE-----\n\n-----END PGP SIGNATURE-----\n", cert.Signature)
+}
+
+func TestGetStagedPaths(t *testing.T) {
+	dir := initRepository(t)
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "b.txt"), []byte("b"), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "a.txt"), []byte("a"), 0o600))
+	runGit(t, dir, "add", "b.txt", "a.txt")
+
+	repo, err := gitexec.NewRepository(dir)
+	require.NoError(t, err)
+
+	// Before the first commit
+	paths, err := repo.GetStagedPaths(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, []string{"a.txt", "b.txt"}, paths)
+
+	runGit(t, dir, "commit", "--quiet", "-m", "feat: add files")
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "a.txt"), []byte("changed"), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "c.txt"), []byte("unstaged"), 0o600))
+	runGit(t, dir, "add", "a.txt")
+
+	paths, err = repo.GetStagedPaths(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, []string{"a.txt"}, paths)
+}
+
+// TestDiffStatMatchesGoGit measures root, text and binary changes with both backends.
+func TestDiffStatMatchesGoGit(t *testing.T) {
+	dir := initRepository(t)
+	root := commitFile(t, dir, "a.txt", "feat: add a\n\nWith a body.")
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "a.txt"), []byte("feat: add a\n\nWith another body.\nAnd more.\n"), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "b.bin"), []byte{0, 1, 2, 0}, 0o600))
+	runGit(t, dir, "add", "a.txt", "b.bin")
+	runGit(t, dir, "commit", "--quiet", "-m", "feat: change a and add b")
+
+	goGitRepo, err := git.NewRepository(dir)
+	require.NoError(t, err)
+
+	execRepo, err := gitexec.NewRepository(dir)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+
+	for _, ref := range []string{root, "HEAD"} {
+		expected, err := goGitRepo.GetDiffStat(ctx, ref)
+		require.NoError(t, err)
+
+		stat, err := execRepo.GetDiffStat(ctx, ref)
+		require.NoError(t, err)
+		require.Equal(t, expected, stat, ref)
+	}
+
+	stat, err := execRepo.GetDiffStat(ctx, "HEAD")
+	require.NoError(t, err)
+	require.Equal(t, domain.DiffStat{Files: 2, Insertions: 2, Deletions: 1}, stat)
+}
+
+// TestSHA256Repository reads a repository go-git cannot read.
+func TestSHA256Repository(t *testing.T) {
+	dir := initRepository(t, "--object-format=sha256")
+	hash := commitFile(t, dir, "a.txt", "feat: add a")
+
+	repo, err := gitexec.NewRepository(dir)
+	require.NoError(t, err)
+
+	commits, err := repo.GetHeadCommits(context.Background(), 5)
+	require.NoError(t, err)
+	require.Len(t, commits, 1)
+	require.Len(t, commits[0].Hash, 64)
+	require.Equal(t, hash, commits[0].Hash)
+	require.Equal(t, "feat: add a", commits[0].Subject)
+	require.Equal(t, "Jane Doe", commits[0].Author)
+	require.Equal(t, "john@example.com", commits[0].CommitterEmail)
+}
+
+func TestNewRepositoryOutsideRepository(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git is not installed")
+	}
+
+	t.Setenv("GIT_CEILING_DIRECTORIES", os.TempDir())
+
+	_, err := gitexec.NewRepository(t.TempDir())
+	require.ErrorContains(t, err, "open repository")
+}
+
+func TestWaivers(t *testing.T) {
+	dir := initRepository(t)
+	hash := commitFile(t, dir, "a.txt", "feat: add a")
+
+	// Notes are committed with the identity of the user
+	for _, variable := range []string{"GIT_AUTHOR", "GIT_COMMITTER"} {
+		t.Setenv(variable+"_NAME", "John Doe")
+		t.Setenv(variable+"_EMAIL", "john@example.com")
+	}
+
+	repo, err := gitexec.NewRepository(dir)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+
+	waivers, err := repo.GetWaivers(ctx, "HEAD")
+	require.NoError(t, err)
+	require.Empty(t, waivers)
+
+	first := domain.Waiver{Rule: "subject", Reason: "Imported history", Approver: "Jane Doe <jane@example.com>"}
+	second := domain.Waiver{Rule: "signature", Reason: "Signed offline", Approver: "Jane Doe <jane@example.com>"}
+
+	require.NoError(t, repo.AddWaiver(ctx, "HEAD", first))
+	require.NoError(t, repo.AddWaiver(ctx, hash, second))
+
+	waivers, err = repo.GetWaivers(ctx, "HEAD")
+	require.NoError(t, err)
+	require.Equal(t, []domain.Waiver{first, second}, waivers)
+
+	// The go-git backend reads the same note
+	goGitRepo, err := git.NewRepository(dir)
+	require.NoError(t, err)
+
+	waivers, err = goGitRepo.GetWaivers(ctx, "HEAD")
+	require.NoError(t, err)
+	require.Equal(t, []domain.Waiver{first, second}, waivers)
+}
@@ -0,0 +1,239 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// DefaultAPIURL is the GitHub REST API endpoint used when GITHUB_API_URL is not set.
+	DefaultAPIURL = "https://api.github.com"
+
+	// requestTimeout bounds every API request.
+	requestTimeout = 30 * time.Second
+
+	// pageSize is the largest page the commit list endpoint returns.
+	pageSize = 100
+)
+
+// pullRequestPattern matches owner/repo#number references.
+var pullRequestPattern = regexp.MustCompile(`^([A-Za-z0-9][A-Za-z0-9-]*)/([A-Za-z0-9._-]+)#([0-9]+)$`)
+
+// PullRequest identifies a pull request of a GitHub repository.
+type PullRequest struct {
+	Owner  string
+	Repo   string
+	Number int
+}
+
+// String returns the pull request in owner/repo#number form.
+func (p PullRequest) String() string {
+	return fmt.Sprintf("%s/%s#%d", p.Owner, p.Repo, p.Number)
+}
+
+// ParsePullRequest parses a pull request reference in owner/repo#number form.
+func ParsePullRequest(reference string) (PullRequest, error) {
+	matches := pullRequestPattern.FindStringSubmatch(strings.TrimSpace(reference))
+	if matches == nil {
+		return PullRequest{}, fmt.Errorf("invalid pull request %q (expected format: owner/repo#123)", reference)
+	}
+
+	number, err := strconv.Atoi(matches[3])
+	if err != nil || number <= 0 {
+		return PullRequest{}, fmt.Errorf("invalid pull request number %q", matches[3])
+	}
+
+	return PullRequest{Owner: matches[1], Repo: matches[2], Number: number}, nil
+}
+
+// Client calls the GitHub REST API.
+type Client struct {
+	baseURL    string
+	token      string
+	httpClient *http.Client
+}
+
+// NewClient creates a client for the API at baseURL, authenticating with token when set.
+func NewClient(baseURL, token string) *Client {
+	return &Client{
+		baseURL:    strings.TrimSuffix(baseURL, "/"),
+		token:      token,
+		httpClient: &http.Client{Timeout: requestTimeout},
+	}
+}
+
+// WithTimeout returns the client bounding every request by timeout.
+func (c *Client) WithTimeout(timeout time.Duration) *Client {
+	c.httpClient = &http.Client{Timeout: timeout}
+
+	return c
+}
+
+// NewClientFromEnv creates a client configured by GITHUB_API_URL, GITHUB_TOKEN and GH_TOKEN.
+func NewClientFromEnv(lookup func(string) (string, bool)) *Client {
+	baseURL := DefaultAPIURL
+	if value, ok := lookup("GITHUB_API_URL"); ok && value != "" {
+		baseURL = value
+	}
+
+	var token string
+
+	for _, name := range []string{"GITHUB_TOKEN", "GH_TOKEN"} {
+		if value, ok := lookup(name); ok && value != "" {
+			token = value
+
+			break
+		}
+	}
+
+	return NewClient(baseURL, token)
+}
+
+// webURL returns the URL of the web pages of the GitHub instance the client calls, as
+// https://github.com for the public API and https://host for the /api/v3 of GitHub
+// Enterprise Server.
+func (c *Client) webURL() string {
+	if c.baseURL == DefaultAPIURL {
+		return "https://github.com"
+	}
+
+	return strings.TrimSuffix(c.baseURL, "/api/v3")
+}
+
+// apiPullRequest is the part of a pull request response the client uses.
+type apiPullRequest struct {
+	Base apiBranch `json:"base"`
+	Head apiBranch `json:"head"`
+}
+
+// apiBranch is one end of a pull request.
+type apiBranch struct {
+	Ref string `json:"ref"`
+	SHA string `json:"sha"`
+}
+
+// apiCommit is a commit as returned by the pull request commit list.
+type apiCommit struct {
+	SHA    string `json:"sha"`
+	Commit struct {
+		Message      string      `json:"message"`
+		Author       apiIdentity `json:"author"`
+		Committer    apiIdentity `json:"committer"`
+		Verification struct {
+			Signature string `json:"signature"`
+		} `json:"verification"`
+	} `json:"commit"`
+	Parents []struct {
+		SHA string `json:"sha"`
+	} `json:"parents"`
+	Files []struct {
+		Filename string `json:"filename"`
+	} `json:"files"`
+}
+
+// apiIdentity is the author or committer of a commit.
+type apiIdentity struct {
+	Name  string `json:"name"`
+	Email string `json:"email"`
+	Date  string `json:"date"`
+}
+
+// apiError is the error body of a failed request.
+type apiError struct {
+	Message string `json:"message"`
+}
+
+// OpenPullRequest fetches a pull request and its commits. GitHub lists at most
+// 250 commits of a pull request.
+func (c *Client) OpenPullRequest(ctx context.Context, pr PullRequest) (*Repository, error) {
+	base := fmt.Sprintf("/repos/%s/%s/pulls/%d", url.PathEscape(pr.Owner), url.PathEscape(pr.Repo), pr.Number)
+
+	var pull apiPullRequest
+	if err := c.get(ctx, base, &pull); err != nil {
+		return nil, fmt.Errorf("get pull request %s: %w", pr, err)
+	}
+
+	var commits []apiCommit
+
+	for page := 1; ; page++ {
+		var batch []apiCommit
+
+		path := fmt.Sprintf("%s/commits?per_page=%d&page=%d", base, pageSize, page)
+		if err := c.get(ctx, path, &batch); err != nil {
+			return nil, fmt.Errorf("list commits of pull request %s: %w", pr, err)
+		}
+
+		commits = append(commits, batch...)
+
+		if len(batch) < pageSize {
+			break
+		}
+	}
+
+	return newRepository(c, pr, pull, commits), nil
+}
+
+// getCommit fetches a single commit of the repository of pr, including its changed files.
+func (c *Client) getCommit(ctx context.Context, pr PullRequest, sha string) (apiCommit, error) {
+	var commit apiCommit
+
+	path := fmt.Sprintf("/repos/%s/%s/commits/%s", url.PathEscape(pr.Owner), url.PathEscape(pr.Repo), url.PathEscape(sha))
+	if err := c.get(ctx, path, &commit); err != nil {
+		return apiCommit{}, fmt.Errorf("get commit %s: %w", sha, err)
+	}
+
+	return commit, nil
+}
+
+// get requests path from the API and decodes the JSON response into target.
+func (c *Client) get(ctx context.Context, path string, target interface{}) error {
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, c.baseURL+path, nil)
+	if err != nil {
+		return err
+	}
+
+	request.Header.Set("Accept", "application/vnd.github+json")
+	request.Header.Set("X-GitHub-Api-Version", "2022-11-28")
+
+	if c.token != "" {
+		request.Header.Set("Authorization", "Bearer "+c.token)
+	}
+
+	response, err := c.httpClient.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	body, err := io.ReadAll(response.Body)
+	if err != nil {
+		return fmt.Errorf("read response: %w", err)
+	}
+
+	if response.StatusCode != http.StatusOK {
+		var failure apiError
+		if json.Unmarshal(body, &failure) == nil && failure.Message != "" {
+			return fmt.Errorf("GitHub API returned %s: %s", response.Status, failure.Message)
+		}
+
+		return fmt.Errorf("GitHub API returned %s", response.Status)
+	}
+
+	if err := json.Unmarshal(body, target); err != nil {
+		return fmt.Errorf("decode response: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,163 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package github_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/itiquette/gommitlint/internal/adapters/github"
+)
+
+func TestParsePullRequest(t *testing.T) {
+	tests := []struct {
+		name        string
+		reference   string
+		expected    github.PullRequest
+		expectError bool
+	}{
+		{
+			name:      "owner, repository and number",
+			reference: "itiquette/gommitlint#123",
+			expected:  github.PullRequest{Owner: "itiquette", Repo: "gommitlint", Number: 123},
+		},
+		{
+			name:      "repository with dots",
+			reference: " octo-org/site.github.io#7 ",
+			expected:  github.PullRequest{Owner: "octo-org", Repo: "site.github.io", Number: 7},
+		},
+		{name: "missing number", reference: "itiquette/gommitlint", expectError: true},
+		{name: "missing owner", reference: "gommitlint#1", expectError: true},
+		{name: "zero number", reference: "itiquette/gommitlint#0", expectError: true},
+		{name: "path traversal", reference: "../gommitlint#1", expectError: true},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			pr, err := github.ParsePullRequest(testCase.reference)
+
+			if testCase.expectError {
+				require.Error(t, err)
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, testCase.expected, pr)
+		})
+	}
+}
+
+func TestNewClientFromEnv(t *testing.T) {
+	var authorization string
+
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		authorization = request.Header.Get("Authorization")
+
+		http.NotFound(writer, request)
+	}))
+	defer server.Close()
+
+	env := map[string]string{"GITHUB_API_URL": server.URL, "GH_TOKEN": "secret"}
+	lookup := func(name string) (string, bool) {
+		value, ok := env[name]
+
+		return value, ok
+	}
+
+	_, err := github.NewClientFromEnv(lookup).OpenPullRequest(context.Background(), github.PullRequest{Owner: "o", Repo: "r", Number: 1})
+	require.ErrorContains(t, err, "GitHub API returned 404")
+	require.Equal(t, "Bearer secret", authorization)
+}
+
+func TestOpenPullRequest(t *testing.T) {
+	const pageSize = 100
+
+	commitJSON := func(index int, parents int) string {
+		parentList := make([]string, 0, parents)
+		for range parents {
+			parentList = append(parentList, `{"sha": "parent"}`)
+		}
+
+		return fmt.Sprintf(`{"sha": "%040d", "commit": {"message": "feat: change %d\n\nDetails.",
+			"author": {"name": "Dev", "email": "dev@example.com", "date": "2025-01-02T03:04:05Z"},
+			"committer": {"name": "GitHub", "email": "noreply@github.com"},
+			"verification": {"signature": "-----BEGIN PGP SIGNATURE-----"}},
+			"parents": [%s]}`, index, index, strings.Join(parentList, ","))
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/repos/itiquette/gommitlint/pulls/42", func(writer http.ResponseWriter, _ *http.Request) {
+		fmt.Fprint(writer, `{"base": {"ref": "main", "sha": "base"}, "head": {"ref": "feature", "sha": "`+fmt.Sprintf("%040d", pageSize+1)+`"}}`)
+	})
+	mux.HandleFunc("/repos/itiquette/gommitlint/pulls/42/commits", func(writer http.ResponseWriter, request *http.Request) {
+		// The first page is full, so the client asks for the next one
+		first, last := 1, pageSize
+		if request.URL.Query().Get("page") == "2" {
+			first, last = pageSize+1, pageSize+1
+		}
+
+		commits := make([]string, 0, pageSize)
+		for index := first; index <= last; index++ {
+			commits = append(commits, commitJSON(index, 1+index%2))
+		}
+
+		fmt.Fprint(writer, "["+strings.Join(commits, ",")+"]")
+	})
+	mux.HandleFunc("/repos/itiquette/gommitlint/commits/", func(writer http.ResponseWriter, _ *http.Request) {
+		fmt.Fprint(writer, `{"files": [{"filename": "docs/guide.md"}, {"filename": "main.go"}]}`)
+	})
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	ctx := context.Background()
+
+	repo, err := github.NewClient(server.URL, "").OpenPullRequest(ctx, github.PullRequest{Owner: "itiquette", Repo: "gommitlint", Number: 42})
+	require.NoError(t, err)
+	require.Equal(t, "base", repo.BaseHash())
+
+	commits, err := repo.GetCommitRange(ctx, repo.BaseHash(), repo.HeadHash())
+	require.NoError(t, err)
+	require.Len(t, commits, pageSize+1)
+
+	head := commits[0]
+	require.Equal(t, repo.HeadHash(), head.Hash)
+	require.Equal(t, "feat: change 101", head.Subject)
+	require.Equal(t, "Details.", head.Body)
+	require.Equal(t, "dev@example.com", head.AuthorEmail)
+	require.Equal(t, "noreply@github.com", head.CommitterEmail)
+	require.True(t, head.IsSigned())
+	require.False(t, commits[1].IsMergeCommit)
+	require.True(t, commits[2].IsMergeCommit)
+
+	_, err = repo.GetCommitRange(ctx, "develop", "HEAD")
+	require.ErrorContains(t, err, "only the range main..")
+
+	commit, err := repo.GetCommit(ctx, fmt.Sprintf("%040d", 1)[:12])
+	require.NoError(t, err)
+	require.Equal(t, "feat: change 1", commit.Subject)
+
+	_, err = repo.GetCommit(ctx, "unknown")
+	require.ErrorContains(t, err, "is not part of pull request itiquette/gommitlint#42")
+
+	ahead, err := repo.GetCommitsAheadCount(ctx, "main")
+	require.NoError(t, err)
+	require.Equal(t, pageSize+1, ahead)
+
+	branch, err := repo.GetTargetBranch(ctx)
+	require.NoError(t, err)
+	require.Equal(t, "main", branch)
+
+	paths, err := repo.GetChangedPaths(ctx, head.Hash)
+	require.NoError(t, err)
+	require.Equal(t, []string{"docs/guide.md", "main.go"}, paths)
+}
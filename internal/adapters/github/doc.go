@@ -0,0 +1,19 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+/*
+Package github provides a repository adapter backed by the GitHub REST API.
+
+It validates the commits of a pull request without a local clone:
+
+  - ParsePullRequest reads references such as owner/repo#123
+  - Client.OpenPullRequest fetches the pull request and its commit list
+  - Repository serves those commits through the domain repository interfaces
+
+The client reads the API URL from GITHUB_API_URL, defaulting to
+https://api.github.com, and a token from GITHUB_TOKEN or GH_TOKEN.
+Requests without a token are subject to the lower unauthenticated rate
+limit and only see public repositories.
+*/
+package github
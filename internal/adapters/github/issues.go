@@ -0,0 +1,214 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/itiquette/gommitlint/internal/adapters/ticketcache"
+	"github.com/itiquette/gommitlint/internal/domain"
+)
+
+// IssueTracker looks up the issues referenced by commit messages, as #123 in its
+// repository or as owner/repo#123 in another one.
+type IssueTracker struct {
+	client *Client
+	owner  string
+	repo   string
+	cache  *ticketcache.Cache
+
+	mutex   sync.Mutex
+	tickets map[string]domain.Ticket
+	failure error
+}
+
+// NewIssueTracker creates a tracker for the issues of repository, in owner/repo form.
+func NewIssueTracker(client *Client, repository string) (*IssueTracker, error) {
+	owner, repo, found := strings.Cut(strings.TrimSpace(repository), "/")
+	if !found || owner == "" || repo == "" || strings.Contains(repo, "/") {
+		return nil, fmt.Errorf("invalid repository %q (expected format: owner/repo)", repository)
+	}
+
+	return &IssueTracker{
+		client:  client,
+		owner:   owner,
+		repo:    repo,
+		tickets: make(map[string]domain.Ticket),
+	}, nil
+}
+
+// WithCache returns the tracker keeping looked up issues in dir for ttl. Issues older
+// than ttl are revalidated with conditional requests, which do not count against the
+// rate limit when the issue is unchanged.
+func (t *IssueTracker) WithCache(dir string, ttl time.Duration) *IssueTracker {
+	t.cache = ticketcache.New(dir, t.client.baseURL, ttl)
+
+	return t
+}
+
+// LookupTicket returns the issue of reference. An issue that does not exist is returned
+// with Exists false and no error. Once the rate limit is used up, issues are answered
+// from the cache, however old, until the run ends.
+func (t *IssueTracker) LookupTicket(reference string) (domain.Ticket, error) {
+	if strings.HasPrefix(reference, "#") {
+		reference = t.owner + "/" + t.repo + reference
+	}
+
+	issue, err := ParsePullRequest(reference)
+	if err != nil {
+		return domain.Ticket{}, err
+	}
+
+	key := issue.String()
+
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	if ticket, found := t.tickets[key]; found {
+		return ticket, nil
+	}
+
+	entry, cached := t.cache.Get(key)
+	if cached && (t.cache.Fresh(entry) || t.failure != nil) {
+		t.tickets[key] = entry.Ticket
+
+		return entry.Ticket, nil
+	}
+
+	if t.failure != nil {
+		return domain.Ticket{}, t.failure
+	}
+
+	response, err := t.client.getIssue(context.Background(), issue, entry.ETag)
+	if err != nil {
+		t.failure = err
+
+		if cached {
+			return entry.Ticket, nil
+		}
+
+		return domain.Ticket{}, err
+	}
+
+	if response.notModified {
+		response.ticket, response.etag = entry.Ticket, entry.ETag
+	}
+
+	// The next request would be refused, so the rest of the run uses the cache
+	t.failure = response.exhausted
+
+	t.tickets[key] = response.ticket
+	t.cache.Put(key, ticketcache.Entry{Ticket: response.ticket, Fetched: time.Now(), ETag: response.etag})
+
+	return response.ticket, nil
+}
+
+// apiIssue is the part of an issue response the tracker uses. Pull requests are
+// issues too and answer to the same numbers.
+type apiIssue struct {
+	State string `json:"state"`
+}
+
+// issueResponse is the answer to an issue request.
+type issueResponse struct {
+	ticket      domain.Ticket
+	etag        string
+	notModified bool
+	exhausted   error // Set when the request used up the rate limit
+}
+
+// getIssue fetches an issue, or only confirms it is unchanged when etag still matches.
+func (c *Client) getIssue(ctx context.Context, issue PullRequest, etag string) (issueResponse, error) {
+	path := fmt.Sprintf("/repos/%s/%s/issues/%d", url.PathEscape(issue.Owner), url.PathEscape(issue.Repo), issue.Number)
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, c.baseURL+path, nil)
+	if err != nil {
+		return issueResponse{}, err
+	}
+
+	request.Header.Set("Accept", "application/vnd.github+json")
+	request.Header.Set("X-GitHub-Api-Version", "2022-11-28")
+
+	if c.token != "" {
+		request.Header.Set("Authorization", "Bearer "+c.token)
+	}
+
+	if etag != "" {
+		request.Header.Set("If-None-Match", etag)
+	}
+
+	response, err := c.httpClient.Do(request)
+	if err != nil {
+		return issueResponse{}, err
+	}
+	defer response.Body.Close()
+
+	body, err := io.ReadAll(response.Body)
+	if err != nil {
+		return issueResponse{}, fmt.Errorf("read response: %w", err)
+	}
+
+	result := issueResponse{etag: response.Header.Get("ETag")}
+	if response.Header.Get("X-RateLimit-Remaining") == "0" {
+		result.exhausted = rateLimitError(response)
+	}
+
+	switch response.StatusCode {
+	case http.StatusOK:
+		var fetched apiIssue
+		if err := json.Unmarshal(body, &fetched); err != nil {
+			return issueResponse{}, fmt.Errorf("decode response: %w", err)
+		}
+
+		result.ticket = domain.Ticket{
+			Key:     issue.String(),
+			Exists:  true,
+			Project: issue.Owner + "/" + issue.Repo,
+			Status:  fetched.State,
+			Closed:  fetched.State == "closed",
+		}
+
+		return result, nil
+	case http.StatusNotModified:
+		result.notModified = true
+
+		return result, nil
+	case http.StatusNotFound, http.StatusGone:
+		result.ticket = domain.Ticket{Key: issue.String(), Project: issue.Owner + "/" + issue.Repo}
+
+		return result, nil
+	}
+
+	if result.exhausted != nil {
+		return issueResponse{}, result.exhausted
+	}
+
+	var failure apiError
+	if json.Unmarshal(body, &failure) == nil && failure.Message != "" {
+		return issueResponse{}, fmt.Errorf("GitHub API returned %s: %s", response.Status, failure.Message)
+	}
+
+	return issueResponse{}, fmt.Errorf("GitHub API returned %s", response.Status)
+}
+
+// rateLimitError describes a used up rate limit with the time it resets.
+func rateLimitError(response *http.Response) error {
+	reset, err := strconv.ParseInt(response.Header.Get("X-RateLimit-Reset"), 10, 64)
+	if err != nil {
+		return errors.New("GitHub API rate limit exceeded")
+	}
+
+	return fmt.Errorf("GitHub API rate limit exceeded until %s", time.Unix(reset, 0).Format(time.Kitchen))
+}
@@ -0,0 +1,145 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package github_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/itiquette/gommitlint/internal/adapters/github"
+	"github.com/itiquette/gommitlint/internal/domain"
+)
+
+func TestIssueTracker_LookupTicket(t *testing.T) {
+	requests := 0
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/repos/itiquette/gommitlint/issues/1", func(writer http.ResponseWriter, _ *http.Request) {
+		requests++
+
+		fmt.Fprint(writer, `{"number": 1, "state": "open"}`)
+	})
+	mux.HandleFunc("/repos/octo/tools/issues/7", func(writer http.ResponseWriter, _ *http.Request) {
+		requests++
+
+		fmt.Fprint(writer, `{"number": 7, "state": "closed", "pull_request": {}}`)
+	})
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	tracker, err := github.NewIssueTracker(github.NewClient(server.URL, "token"), "itiquette/gommitlint")
+	require.NoError(t, err)
+
+	ticket, err := tracker.LookupTicket("#1")
+	require.NoError(t, err)
+	require.Equal(t, domain.Ticket{
+		Key: "itiquette/gommitlint#1", Exists: true, Project: "itiquette/gommitlint", Status: "open",
+	}, ticket)
+
+	ticket, err = tracker.LookupTicket("octo/tools#7")
+	require.NoError(t, err)
+	require.True(t, ticket.Exists)
+	require.True(t, ticket.Closed)
+
+	ticket, err = tracker.LookupTicket("#2")
+	require.NoError(t, err)
+	require.False(t, ticket.Exists)
+	require.Equal(t, "itiquette/gommitlint", ticket.Project)
+
+	// The same issue written in full is not requested again
+	_, err = tracker.LookupTicket("itiquette/gommitlint#1")
+	require.NoError(t, err)
+	require.Equal(t, 2, requests)
+
+	_, err = github.NewIssueTracker(github.NewClient(server.URL, ""), "gommitlint")
+	require.Error(t, err)
+}
+
+func TestIssueTracker_ConditionalRequests(t *testing.T) {
+	var ifNoneMatch []string
+
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		ifNoneMatch = append(ifNoneMatch, request.Header.Get("If-None-Match"))
+
+		if request.Header.Get("If-None-Match") == `"v1"` {
+			writer.WriteHeader(http.StatusNotModified)
+
+			return
+		}
+
+		writer.Header().Set("ETag", `"v1"`)
+		fmt.Fprint(writer, `{"number": 1, "state": "open"}`)
+	}))
+	defer server.Close()
+
+	dir := t.TempDir()
+
+	for range 2 {
+		tracker, err := github.NewIssueTracker(github.NewClient(server.URL, ""), "itiquette/gommitlint")
+		require.NoError(t, err)
+
+		// Every entry is stale at once, so the second run revalidates it
+		ticket, err := tracker.WithCache(dir, time.Nanosecond).LookupTicket("#1")
+		require.NoError(t, err)
+		require.True(t, ticket.Exists)
+		require.Equal(t, "open", ticket.Status)
+	}
+
+	require.Equal(t, []string{"", `"v1"`}, ifNoneMatch)
+}
+
+func TestIssueTracker_RateLimit(t *testing.T) {
+	requests := 0
+
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		requests++
+
+		writer.Header().Set("X-RateLimit-Remaining", "0")
+		writer.Header().Set("X-RateLimit-Reset", "1750000000")
+
+		if request.URL.Path == "/repos/itiquette/gommitlint/issues/1" {
+			fmt.Fprint(writer, `{"number": 1, "state": "open"}`)
+
+			return
+		}
+
+		writer.WriteHeader(http.StatusForbidden)
+		fmt.Fprint(writer, `{"message": "API rate limit exceeded"}`)
+	}))
+	defer server.Close()
+
+	dir := t.TempDir()
+
+	tracker, err := github.NewIssueTracker(github.NewClient(server.URL, ""), "itiquette/gommitlint")
+	require.NoError(t, err)
+
+	tracker = tracker.WithCache(dir, time.Nanosecond)
+
+	_, err = tracker.LookupTicket("#1")
+	require.NoError(t, err)
+
+	// The limit is used up, so issue 2 is not requested
+	_, err = tracker.LookupTicket("#2")
+	require.ErrorContains(t, err, "GitHub API rate limit exceeded until")
+	require.Equal(t, 1, requests)
+
+	// A later run answers from the stale cache when the limit refuses the request
+	tracker, err = github.NewIssueTracker(github.NewClient(server.URL, ""), "itiquette/gommitlint")
+	require.NoError(t, err)
+
+	_, err = tracker.WithCache(dir, time.Nanosecond).LookupTicket("#3")
+	require.Error(t, err)
+
+	ticket, err := tracker.LookupTicket("#1")
+	require.NoError(t, err)
+	require.True(t, ticket.Exists)
+	require.Equal(t, 2, requests)
+}
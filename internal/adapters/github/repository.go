@@ -0,0 +1,175 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/itiquette/gommitlint/internal/adapters/charset"
+	"github.com/itiquette/gommitlint/internal/domain"
+)
+
+// Repository serves the commits of a pull request. Only the pull request range,
+// from its base to its head, is available.
+type Repository struct {
+	client  *Client
+	pr      PullRequest
+	baseRef string
+	baseSHA string
+	headRef string
+	headSHA string
+	commits []domain.Commit // Oldest first, as listed by GitHub
+}
+
+// Ensure Repository implements the domain interfaces it is used through.
+var (
+	_ domain.Repository             = (*Repository)(nil)
+	_ domain.BranchResolver         = (*Repository)(nil)
+	_ domain.CurrentBranchResolver  = (*Repository)(nil)
+	_ domain.RepositoryNameResolver = (*Repository)(nil)
+	_ domain.PathResolver           = (*Repository)(nil)
+	_ domain.RemoteURLResolver      = (*Repository)(nil)
+)
+
+// newRepository converts a fetched pull request and its commits.
+func newRepository(client *Client, pr PullRequest, pull apiPullRequest, commits []apiCommit) *Repository {
+	converted := make([]domain.Commit, 0, len(commits))
+
+	for _, commit := range commits {
+		converted = append(converted, convertCommit(commit))
+	}
+
+	return &Repository{
+		client:  client,
+		pr:      pr,
+		baseRef: pull.Base.Ref,
+		baseSHA: pull.Base.SHA,
+		headRef: pull.Head.Ref,
+		headSHA: pull.Head.SHA,
+		commits: converted,
+	}
+}
+
+// convertCommit converts a commit of the API to a domain commit.
+func convertCommit(commit apiCommit) domain.Commit {
+	converted := domain.NewCommit(
+		commit.SHA,
+		charset.NormalizeLineEndings(commit.Commit.Message),
+		commit.Commit.Author.Name,
+		commit.Commit.Author.Email,
+		commit.Commit.Author.Date,
+		commit.Commit.Verification.Signature,
+		len(commit.Parents) > 1,
+	)
+	converted.Committer = commit.Commit.Committer.Name
+	converted.CommitterEmail = commit.Commit.Committer.Email
+
+	for _, parent := range commit.Parents {
+		converted.ParentHashes = append(converted.ParentHashes, parent.SHA)
+	}
+
+	return converted
+}
+
+// BaseHash returns the commit the base branch pointed to when the pull request was fetched.
+func (r *Repository) BaseHash() string {
+	return r.baseSHA
+}
+
+// HeadHash returns the last commit of the pull request.
+func (r *Repository) HeadHash() string {
+	return r.headSHA
+}
+
+// GetCommit returns a commit of the pull request by hash, abbreviated hash or HEAD.
+func (r *Repository) GetCommit(_ context.Context, ref string) (domain.Commit, error) {
+	if ref == "HEAD" {
+		ref = r.headSHA
+	}
+
+	for _, commit := range r.commits {
+		if commit.Hash == ref || (len(ref) >= 7 && strings.HasPrefix(commit.Hash, ref)) {
+			return commit, nil
+		}
+	}
+
+	return domain.Commit{}, fmt.Errorf("commit %s is not part of pull request %s", ref, r.pr)
+}
+
+// GetCommitRange returns the commits of the pull request, newest first. The range
+// must run from the base of the pull request, by hash or branch name, to its head.
+func (r *Repository) GetCommitRange(_ context.Context, from, to string) ([]domain.Commit, error) {
+	if (from != r.baseSHA && from != r.baseRef) || (to != r.headSHA && to != "HEAD") {
+		return nil, fmt.Errorf("only the range %s..%s of pull request %s is available", r.baseRef, r.headSHA, r.pr)
+	}
+
+	return r.newestFirst(len(r.commits)), nil
+}
+
+// GetHeadCommits returns the latest count commits of the pull request.
+func (r *Repository) GetHeadCommits(_ context.Context, count int) ([]domain.Commit, error) {
+	return r.newestFirst(min(count, len(r.commits))), nil
+}
+
+// GetCommitsAheadCount returns the number of commits in the pull request, which are
+// the commits ahead of its base branch.
+func (r *Repository) GetCommitsAheadCount(_ context.Context, _ string) (int, error) {
+	return len(r.commits), nil
+}
+
+// GetTargetBranch returns the base branch of the pull request.
+func (r *Repository) GetTargetBranch(_ context.Context) (string, error) {
+	return r.baseRef, nil
+}
+
+// GetCurrentBranch returns the head branch of the pull request.
+func (r *Repository) GetCurrentBranch(_ context.Context) (string, error) {
+	return r.headRef, nil
+}
+
+// GetRepositoryName returns the repository of the pull request in owner/repo form.
+func (r *Repository) GetRepositoryName(_ context.Context) (string, error) {
+	return r.pr.Owner + "/" + r.pr.Repo, nil
+}
+
+// GetRemoteURL returns the web URL of the repository of the pull request, whichever
+// remote is asked for.
+func (r *Repository) GetRemoteURL(_ context.Context, _ string) (string, error) {
+	return r.client.webURL() + "/" + r.pr.Owner + "/" + r.pr.Repo, nil
+}
+
+// GetChangedPaths fetches the files changed by a commit of the pull request.
+func (r *Repository) GetChangedPaths(ctx context.Context, ref string) ([]string, error) {
+	commit, err := r.client.getCommit(ctx, r.pr, ref)
+	if err != nil {
+		return nil, err
+	}
+
+	paths := make([]string, 0, len(commit.Files))
+	for _, file := range commit.Files {
+		paths = append(paths, file.Filename)
+	}
+
+	return paths, nil
+}
+
+// GetStagedPaths is not available for a pull request, which has no index.
+func (r *Repository) GetStagedPaths(_ context.Context) ([]string, error) {
+	return nil, errors.New("a pull request has no staged files")
+}
+
+// newestFirst returns the last count commits, newest first.
+func (r *Repository) newestFirst(count int) []domain.Commit {
+	commits := make([]domain.Commit, 0, count)
+
+	for i := len(r.commits) - 1; i >= len(r.commits)-count; i-- {
+		commits = append(commits, r.commits[i])
+	}
+
+	return commits
+}
@@ -0,0 +1,220 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package i18n
+
+import (
+	"embed"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/itiquette/gommitlint/internal/domain"
+	"github.com/itiquette/gommitlint/internal/domain/config"
+	"gopkg.in/yaml.v3"
+)
+
+// DefaultLocale is the locale of the built-in text.
+const DefaultLocale = "en"
+
+//go:embed locales/*.yaml
+var embeddedLocales embed.FS
+
+// Catalog holds the translations of one locale.
+// The zero value translates nothing and leaves the built-in English text in place.
+type Catalog struct {
+	Locale string                                  `yaml:"-"`
+	Text   map[string]string                       `yaml:"text"`
+	Codes  map[string]config.MessageTemplateConfig `yaml:"codes"`
+}
+
+// LocaleLookup reads locale environment variables such as LANG.
+type LocaleLookup func(name string) (string, bool)
+
+// ResolveLocale returns the locale to use: the explicit locale from a flag or
+// configuration if set, otherwise the first of LC_ALL, LC_MESSAGES and LANG.
+// The result is normalized, so "sv_SE.UTF-8" becomes "sv_se".
+func ResolveLocale(explicit string, lookup LocaleLookup) string {
+	if explicit != "" {
+		return NormalizeLocale(explicit)
+	}
+
+	for _, name := range []string{"LC_ALL", "LC_MESSAGES", "LANG"} {
+		if value, ok := lookup(name); ok && value != "" {
+			return NormalizeLocale(value)
+		}
+	}
+
+	return DefaultLocale
+}
+
+// NormalizeLocale lowercases a locale and strips its encoding and modifier.
+// The POSIX locales C and POSIX map to English.
+func NormalizeLocale(locale string) string {
+	normalized := strings.ToLower(strings.TrimSpace(locale))
+
+	if index := strings.IndexAny(normalized, ".@"); index >= 0 {
+		normalized = normalized[:index]
+	}
+
+	normalized = strings.ReplaceAll(normalized, "-", "_")
+
+	if normalized == "" || normalized == "c" || normalized == "posix" {
+		return DefaultLocale
+	}
+
+	return normalized
+}
+
+// Load returns the catalog for locale, looking in directory before the embedded catalogs.
+// A regional locale such as sv_se falls back to its language, sv. Locales without a
+// catalog, including English, return an empty catalog so output stays in English.
+func Load(locale, directory string) (Catalog, error) {
+	for _, candidate := range localeCandidates(NormalizeLocale(locale)) {
+		content, err := readCatalog(candidate, directory)
+		if errors.Is(err, fs.ErrNotExist) {
+			continue
+		}
+
+		if err != nil {
+			return Catalog{}, err
+		}
+
+		var catalog Catalog
+		if err := yaml.Unmarshal(content, &catalog); err != nil {
+			return Catalog{}, fmt.Errorf("failed to parse %s translations: %w", candidate, err)
+		}
+
+		catalog.Locale = candidate
+
+		return catalog, nil
+	}
+
+	return Catalog{Locale: DefaultLocale}, nil
+}
+
+// AvailableLocales returns the locales with an embedded catalog, sorted alphabetically.
+func AvailableLocales() []string {
+	entries, err := embeddedLocales.ReadDir("locales")
+	if err != nil {
+		return nil
+	}
+
+	locales := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		locales = append(locales, strings.TrimSuffix(entry.Name(), ".yaml"))
+	}
+
+	sort.Strings(locales)
+
+	return locales
+}
+
+// localeCandidates returns the catalog names to try for a locale, most specific first.
+func localeCandidates(locale string) []string {
+	language, _, hasRegion := strings.Cut(locale, "_")
+	if !hasRegion {
+		return []string{locale}
+	}
+
+	return []string{locale, language}
+}
+
+// readCatalog reads the catalog file of a locale from directory, or from the embedded catalogs.
+func readCatalog(locale, directory string) ([]byte, error) {
+	if directory != "" {
+		content, err := os.ReadFile(filepath.Join(directory, locale+".yaml"))
+		if err == nil {
+			return content, nil
+		}
+
+		if !errors.Is(err, fs.ErrNotExist) {
+			return nil, fmt.Errorf("failed to read %s translations: %w", locale, err)
+		}
+	}
+
+	return embeddedLocales.ReadFile("locales/" + locale + ".yaml")
+}
+
+// TranslateReport returns a copy of the report with failure messages and help translated.
+// Text set by per-rule message templates in overrides is kept, as configuration takes
+// precedence over the catalog.
+func (c Catalog) TranslateReport(report domain.Report, overrides config.MessagesConfig) domain.Report {
+	if len(c.Codes) == 0 {
+		return report
+	}
+
+	commits := make([]domain.CommitReport, len(report.Commits))
+	for i, commitReport := range report.Commits {
+		commits[i] = c.TranslateCommitReport(commitReport, overrides)
+	}
+
+	report.Commits = commits
+	report.Repository.RuleResults = c.translateRuleReports(report.Repository.RuleResults, overrides)
+
+	return report
+}
+
+// TranslateCommitReport translates the report of one commit like TranslateReport, for
+// commits written before the rest of their report.
+func (c Catalog) TranslateCommitReport(commitReport domain.CommitReport, overrides config.MessagesConfig) domain.CommitReport {
+	if len(c.Codes) == 0 {
+		return commitReport
+	}
+
+	commitReport.RuleResults = c.translateRuleReports(commitReport.RuleResults, overrides)
+
+	return commitReport
+}
+
+// translateRuleReports translates the errors of rule reports and rebuilds their messages.
+func (c Catalog) translateRuleReports(ruleReports []domain.RuleReport, overrides config.MessagesConfig) []domain.RuleReport {
+	result := make([]domain.RuleReport, len(ruleReports))
+
+	for i, ruleReport := range ruleReports {
+		if len(ruleReport.Errors) == 0 {
+			result[i] = ruleReport
+
+			continue
+		}
+
+		errs := make([]domain.ValidationError, len(ruleReport.Errors))
+		messages := make([]string, len(ruleReport.Errors))
+
+		for j, err := range ruleReport.Errors {
+			errs[j] = c.TranslateError(err, overrides)
+			messages[j] = errs[j].Message
+		}
+
+		ruleReport.Errors = errs
+		ruleReport.Message = strings.Join(messages, "; ")
+		result[i] = ruleReport
+	}
+
+	return result
+}
+
+// TranslateError translates the message and help of a validation error by its code.
+func (c Catalog) TranslateError(err domain.ValidationError, overrides config.MessagesConfig) domain.ValidationError {
+	templateCfg, found := c.Codes[err.Code]
+	if !found {
+		return err
+	}
+
+	if override, hasOverride := domain.FindMessageTemplate(overrides, err.Rule); hasOverride {
+		if override.Message != "" {
+			templateCfg.Message = ""
+		}
+
+		if override.Help != "" {
+			templateCfg.Help = ""
+		}
+	}
+
+	return domain.RenderMessageTemplate(err, templateCfg)
+}
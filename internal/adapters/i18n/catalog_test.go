@@ -0,0 +1,153 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package i18n
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"text/template"
+
+	"github.com/itiquette/gommitlint/internal/domain"
+	"github.com/itiquette/gommitlint/internal/domain/config"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolveLocale(t *testing.T) {
+	tests := []struct {
+		name     string
+		explicit string
+		env      map[string]string
+		expected string
+	}{
+		{name: "explicit wins", explicit: "sv", env: map[string]string{"LANG": "de_DE.UTF-8"}, expected: "sv"},
+		{name: "LC_ALL before LANG", env: map[string]string{"LC_ALL": "sv_SE.UTF-8", "LANG": "de_DE"}, expected: "sv_se"},
+		{name: "LC_MESSAGES before LANG", env: map[string]string{"LC_MESSAGES": "sv_FI", "LANG": "de_DE"}, expected: "sv_fi"},
+		{name: "LANG", env: map[string]string{"LANG": "sv_SE.UTF-8@euro"}, expected: "sv_se"},
+		{name: "POSIX locale", env: map[string]string{"LANG": "C.UTF-8"}, expected: "en"},
+		{name: "nothing set", expected: "en"},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			lookup := func(name string) (string, bool) {
+				value, ok := testCase.env[name]
+
+				return value, ok
+			}
+
+			require.Equal(t, testCase.expected, ResolveLocale(testCase.explicit, lookup))
+		})
+	}
+}
+
+func TestLoad(t *testing.T) {
+	t.Run("embedded catalog", func(t *testing.T) {
+		catalog, err := Load("sv", "")
+		require.NoError(t, err)
+		require.Equal(t, "sv", catalog.Locale)
+		require.Equal(t, "ÄMNESRAD:", catalog.Text["subject"])
+		require.NotEmpty(t, catalog.Codes)
+	})
+
+	t.Run("regional locale falls back to language", func(t *testing.T) {
+		catalog, err := Load("sv_SE.UTF-8", "")
+		require.NoError(t, err)
+		require.Equal(t, "sv", catalog.Locale)
+	})
+
+	t.Run("English and unknown locales are empty", func(t *testing.T) {
+		for _, locale := range []string{"en", "en_US", "xx"} {
+			catalog, err := Load(locale, "")
+			require.NoError(t, err)
+			require.Empty(t, catalog.Text)
+			require.Empty(t, catalog.Codes)
+		}
+	})
+
+	t.Run("external directory takes precedence", func(t *testing.T) {
+		directory := t.TempDir()
+		require.NoError(t, os.WriteFile(filepath.Join(directory, "sv.yaml"), []byte("text:\n  subject: \"RUBRIK:\"\n"), 0600))
+		require.NoError(t, os.WriteFile(filepath.Join(directory, "de.yaml"), []byte("text:\n  subject: \"BETREFF:\"\n"), 0600))
+
+		catalog, err := Load("sv", directory)
+		require.NoError(t, err)
+		require.Equal(t, "RUBRIK:", catalog.Text["subject"])
+
+		catalog, err = Load("de_AT", directory)
+		require.NoError(t, err)
+		require.Equal(t, "de", catalog.Locale)
+		require.Equal(t, "BETREFF:", catalog.Text["subject"])
+	})
+
+	t.Run("invalid external catalog", func(t *testing.T) {
+		directory := t.TempDir()
+		require.NoError(t, os.WriteFile(filepath.Join(directory, "sv.yaml"), []byte("text: [unclosed"), 0600))
+
+		_, err := Load("sv", directory)
+		require.ErrorContains(t, err, "failed to parse sv translations")
+	})
+}
+
+func TestEmbeddedCatalogsParse(t *testing.T) {
+	require.Contains(t, AvailableLocales(), "sv")
+
+	for _, locale := range AvailableLocales() {
+		catalog, err := Load(locale, "")
+		require.NoError(t, err, locale)
+
+		for code, templates := range catalog.Codes {
+			_, err := template.New(code).Parse(templates.Message)
+			require.NoError(t, err, "%s: %s message", locale, code)
+
+			_, err = template.New(code).Parse(templates.Help)
+			require.NoError(t, err, "%s: %s help", locale, code)
+		}
+	}
+}
+
+func TestTranslateReport(t *testing.T) {
+	catalog := Catalog{
+		Locale: "sv",
+		Codes: map[string]config.MessageTemplateConfig{
+			"subject_too_long": {Message: "För lång: {{.Context.actual}}", Help: "Korta den"},
+			"missing_signoff":  {Message: "Sign-off saknas", Help: "Lägg till sign-off"},
+		},
+	}
+
+	subjectErr := domain.New("Subject", domain.ErrSubjectTooLong, "Subject too long").
+		WithContextMap(map[string]string{"actual": "80"}).
+		WithHelp("Shorten it")
+	signoffErr := domain.New("SignOff", domain.ErrMissingSignoff, "Missing required sign-off").
+		WithHelp("Add a sign-off")
+	otherErr := domain.New("Spell", domain.ErrSpelling, "Spelling error")
+
+	report := domain.Report{
+		Commits: []domain.CommitReport{{
+			RuleResults: []domain.RuleReport{
+				{Name: "Subject", Status: domain.StatusFailed, Errors: []domain.ValidationError{subjectErr}, Message: subjectErr.Message},
+				{Name: "SignOff", Status: domain.StatusFailed, Errors: []domain.ValidationError{signoffErr}, Message: signoffErr.Message},
+				{Name: "Spell", Status: domain.StatusFailed, Errors: []domain.ValidationError{otherErr}, Message: otherErr.Message},
+				{Name: "Signature", Status: domain.StatusPassed, Message: "Passed"},
+			},
+		}},
+	}
+
+	// A configured help template for SignOff keeps its help untranslated
+	overrides := config.MessagesConfig{"signoff": {Help: "See CONTRIBUTING.md"}}
+
+	translated := catalog.TranslateReport(report, overrides)
+	rules := translated.Commits[0].RuleResults
+
+	require.Equal(t, "För lång: 80", rules[0].Message)
+	require.Equal(t, "Korta den", rules[0].Errors[0].Help)
+	require.Equal(t, "Sign-off saknas", rules[1].Errors[0].Message)
+	require.Equal(t, "Add a sign-off", rules[1].Errors[0].Help)
+	require.Equal(t, "Spelling error", rules[2].Message)
+	require.Equal(t, "Passed", rules[3].Message)
+
+	// The original report is left untouched
+	require.Equal(t, "Subject too long", report.Commits[0].RuleResults[0].Errors[0].Message)
+}
@@ -0,0 +1,26 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+/*
+Package i18n provides translations of rule failures and text output.
+
+A catalog is a YAML file named after its locale, such as sv.yaml:
+
+	text:
+	  summary_all_passed: "SAMMANFATTNING: Alla %d commits klarade valideringen"
+	codes:
+	  subject_too_long:
+	    message: "Ämnesraden är för lång ({{.Context.actual}} tecken, {{.Context.expected}})"
+	    help: "Korta ämnesraden"
+
+Text entries are printf formats with the same verbs as the English text they replace.
+Code entries are text/template templates for the message and help of failures with
+that error code, with the same fields as per-rule message templates.
+
+Catalogs for shipped locales are embedded in the binary. A catalog with the same name
+in the configured translation directory takes precedence, which allows adding locales
+or adjusting shipped translations without rebuilding. English is the built-in text and
+needs no catalog.
+*/
+package i18n
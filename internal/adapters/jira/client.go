@@ -0,0 +1,252 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/itiquette/gommitlint/internal/adapters/ticketcache"
+	"github.com/itiquette/gommitlint/internal/domain"
+	"github.com/itiquette/gommitlint/internal/domain/config"
+)
+
+// defaultTimeout bounds a request when the configuration sets no valid timeout.
+const defaultTimeout = 5 * time.Second
+
+// serverSprintPattern finds the name in the sprints of JIRA Server, which are
+// serialized as "com.atlassian.greenhopper.service.sprint.Sprint@1f[id=1,...,name=Sprint 1,...]".
+var serverSprintPattern = regexp.MustCompile(`[\[,]name=([^,\]]*)`)
+
+// Client looks up tickets in the JIRA REST API.
+type Client struct {
+	baseURL     string
+	user        string
+	token       string
+	sprintField string
+	httpClient  *http.Client
+	cache       *ticketcache.Cache
+
+	mutex   sync.Mutex
+	tickets map[string]domain.Ticket
+	failure error
+}
+
+// NewClient creates a client for the JIRA instance at baseURL. With a user the token is
+// sent as basic authentication, otherwise as a bearer token.
+func NewClient(baseURL, user, token, sprintField string, timeout time.Duration) *Client {
+	return &Client{
+		baseURL:     strings.TrimSuffix(baseURL, "/"),
+		user:        user,
+		token:       token,
+		sprintField: sprintField,
+		httpClient:  &http.Client{Timeout: timeout},
+		tickets:     make(map[string]domain.Ticket),
+	}
+}
+
+// NewClientFromConfig creates a client for the online mode configuration, with the
+// credentials of JIRA_USER_EMAIL and JIRA_API_TOKEN and a cache in the user cache directory.
+func NewClientFromConfig(cfg config.JiraOnlineConfig, lookup func(string) (string, bool)) *Client {
+	timeout, err := time.ParseDuration(cfg.Timeout)
+	if err != nil || timeout <= 0 {
+		timeout = defaultTimeout
+	}
+
+	user, _ := lookup("JIRA_USER_EMAIL")
+	token, _ := lookup("JIRA_API_TOKEN")
+
+	client := NewClient(cfg.URL, user, token, cfg.SprintField, timeout)
+
+	if ttl, err := time.ParseDuration(cfg.CacheTTL); err == nil && ttl > 0 {
+		if dir, err := os.UserCacheDir(); err == nil {
+			client = client.WithCache(filepath.Join(dir, "gommitlint", "jira"), ttl)
+		}
+	}
+
+	return client
+}
+
+// WithCache returns the client keeping looked up tickets in dir for ttl.
+func (c *Client) WithCache(dir string, ttl time.Duration) *Client {
+	c.cache = ticketcache.New(dir, c.baseURL, ttl)
+
+	return c
+}
+
+// apiIssue is the part of an issue response the client uses.
+type apiIssue struct {
+	Key    string                     `json:"key"`
+	Fields map[string]json.RawMessage `json:"fields"`
+}
+
+// apiProject is the project field of an issue.
+type apiProject struct {
+	Key string `json:"key"`
+}
+
+// apiStatus is the status field of an issue.
+type apiStatus struct {
+	Name           string `json:"name"`
+	StatusCategory struct {
+		Key string `json:"key"`
+	} `json:"statusCategory"`
+}
+
+// apiError is the error body of a failed request.
+type apiError struct {
+	ErrorMessages []string `json:"errorMessages"`
+}
+
+// LookupTicket returns the ticket with key. A ticket the tracker does not know is
+// returned with Exists false and no error.
+func (c *Client) LookupTicket(key string) (domain.Ticket, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if ticket, found := c.tickets[key]; found {
+		return ticket, nil
+	}
+
+	// Once the tracker is unreachable every lookup would wait for the timeout again
+	if c.failure != nil {
+		return domain.Ticket{}, c.failure
+	}
+
+	if entry, found := c.cache.Get(key); found && c.cache.Fresh(entry) {
+		c.tickets[key] = entry.Ticket
+
+		return entry.Ticket, nil
+	}
+
+	ticket, err := c.fetch(key)
+	if err != nil {
+		c.failure = err
+
+		return domain.Ticket{}, err
+	}
+
+	c.tickets[key] = ticket
+	c.cache.Put(key, ticketcache.Entry{Ticket: ticket, Fetched: time.Now()})
+
+	return ticket, nil
+}
+
+// fetch requests the ticket with key from the API.
+func (c *Client) fetch(key string) (domain.Ticket, error) {
+	fields := "project,status,summary"
+	if c.sprintField != "" {
+		fields += "," + c.sprintField
+	}
+
+	path := fmt.Sprintf("/rest/api/2/issue/%s?fields=%s", url.PathEscape(key), url.QueryEscape(fields))
+
+	request, err := http.NewRequestWithContext(context.Background(), http.MethodGet, c.baseURL+path, nil)
+	if err != nil {
+		return domain.Ticket{}, err
+	}
+
+	request.Header.Set("Accept", "application/json")
+
+	switch {
+	case c.user != "":
+		request.SetBasicAuth(c.user, c.token)
+	case c.token != "":
+		request.Header.Set("Authorization", "Bearer "+c.token)
+	}
+
+	response, err := c.httpClient.Do(request)
+	if err != nil {
+		return domain.Ticket{}, err
+	}
+	defer response.Body.Close()
+
+	body, err := io.ReadAll(response.Body)
+	if err != nil {
+		return domain.Ticket{}, fmt.Errorf("read response: %w", err)
+	}
+
+	if response.StatusCode == http.StatusNotFound {
+		return domain.Ticket{Key: key, Exists: false}, nil
+	}
+
+	if response.StatusCode != http.StatusOK {
+		var failure apiError
+		if json.Unmarshal(body, &failure) == nil && len(failure.ErrorMessages) > 0 {
+			return domain.Ticket{}, fmt.Errorf("JIRA API returned %s: %s", response.Status, strings.Join(failure.ErrorMessages, "; "))
+		}
+
+		return domain.Ticket{}, fmt.Errorf("JIRA API returned %s", response.Status)
+	}
+
+	var issue apiIssue
+	if err := json.Unmarshal(body, &issue); err != nil {
+		return domain.Ticket{}, fmt.Errorf("decode response: %w", err)
+	}
+
+	return c.ticket(issue), nil
+}
+
+// ticket converts an issue response to a ticket.
+func (c *Client) ticket(issue apiIssue) domain.Ticket {
+	ticket := domain.Ticket{Key: issue.Key, Exists: true}
+
+	_ = json.Unmarshal(issue.Fields["summary"], &ticket.Title)
+
+	var project apiProject
+	if json.Unmarshal(issue.Fields["project"], &project) == nil {
+		ticket.Project = project.Key
+	}
+
+	var status apiStatus
+	if json.Unmarshal(issue.Fields["status"], &status) == nil {
+		ticket.Status = status.Name
+		ticket.Closed = status.StatusCategory.Key == "done"
+	}
+
+	ticket.Sprints = sprintNames(issue.Fields[c.sprintField])
+
+	return ticket
+}
+
+// sprintNames returns the names in a sprint field, which JIRA Cloud returns as objects
+// and JIRA Server as serialized strings.
+func sprintNames(field json.RawMessage) []string {
+	var values []json.RawMessage
+	if len(field) == 0 || json.Unmarshal(field, &values) != nil {
+		return nil
+	}
+
+	var names []string
+
+	for _, value := range values {
+		var sprint struct {
+			Name string `json:"name"`
+		}
+
+		var serialized string
+
+		switch {
+		case json.Unmarshal(value, &sprint) == nil && sprint.Name != "":
+			names = append(names, sprint.Name)
+		case json.Unmarshal(value, &serialized) == nil:
+			if match := serverSprintPattern.FindStringSubmatch(serialized); match != nil {
+				names = append(names, match[1])
+			}
+		}
+	}
+
+	return names
+}
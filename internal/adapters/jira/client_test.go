@@ -0,0 +1,158 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package jira_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/itiquette/gommitlint/internal/adapters/jira"
+	"github.com/itiquette/gommitlint/internal/domain"
+	"github.com/itiquette/gommitlint/internal/domain/config"
+)
+
+func TestLookupTicket(t *testing.T) {
+	var (
+		authorization string
+		requests      int
+	)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/rest/api/2/issue/PROJ-1", func(writer http.ResponseWriter, request *http.Request) {
+		authorization = request.Header.Get("Authorization")
+		requests++
+
+		require.Equal(t, "project,status,summary,customfield_10020", request.URL.Query().Get("fields"))
+		fmt.Fprint(writer, `{"key": "PROJ-1", "fields": {
+			"project": {"key": "PROJ"},
+			"summary": "Add login page",
+			"status": {"name": "Done", "statusCategory": {"key": "done"}},
+			"customfield_10020": [{"id": 7, "name": "Sprint 7", "state": "active"}]}}`)
+	})
+	mux.HandleFunc("/rest/api/2/issue/PROJ-2", func(writer http.ResponseWriter, _ *http.Request) {
+		requests++
+
+		fmt.Fprint(writer, `{"key": "OPS-9", "fields": {
+			"project": {"key": "OPS"},
+			"status": {"name": "In Progress", "statusCategory": {"key": "indeterminate"}},
+			"customfield_10020": ["com.atlassian.greenhopper.service.sprint.Sprint@1f[id=3,rapidViewId=1,state=CLOSED,name=Sprint 3,startDate=2025-01-01]"]}}`)
+	})
+	mux.HandleFunc("/rest/api/2/issue/PROJ-3", func(writer http.ResponseWriter, _ *http.Request) {
+		requests++
+
+		writer.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(writer, `{"errorMessages": ["Issue does not exist or you do not have permission to see it."]}`)
+	})
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	env := map[string]string{"JIRA_USER_EMAIL": "dev@example.com", "JIRA_API_TOKEN": "secret"}
+	lookup := func(name string) (string, bool) {
+		value, ok := env[name]
+
+		return value, ok
+	}
+
+	cfg := config.NewDefault().Jira.Online
+	cfg.URL = server.URL + "/"
+	cfg.CacheTTL = "0s"
+	client := jira.NewClientFromConfig(cfg, lookup)
+
+	ticket, err := client.LookupTicket("PROJ-1")
+	require.NoError(t, err)
+	require.Equal(t, domain.Ticket{
+		Key: "PROJ-1", Exists: true, Project: "PROJ", Title: "Add login page", Status: "Done", Closed: true, Sprints: []string{"Sprint 7"},
+	}, ticket)
+	require.Equal(t, "Basic ZGV2QGV4YW1wbGUuY29tOnNlY3JldA==", authorization)
+
+	ticket, err = client.LookupTicket("PROJ-2")
+	require.NoError(t, err)
+	require.Equal(t, domain.Ticket{
+		Key: "OPS-9", Exists: true, Project: "OPS", Status: "In Progress", Sprints: []string{"Sprint 3"},
+	}, ticket)
+
+	ticket, err = client.LookupTicket("PROJ-3")
+	require.NoError(t, err)
+	require.False(t, ticket.Exists)
+
+	// Tickets are looked up once per run
+	_, err = client.LookupTicket("PROJ-1")
+	require.NoError(t, err)
+	require.Equal(t, 3, requests)
+}
+
+func TestLookupTicket_FailureStopsLookups(t *testing.T) {
+	requests := 0
+
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, _ *http.Request) {
+		requests++
+
+		writer.WriteHeader(http.StatusUnauthorized)
+		fmt.Fprint(writer, `{"errorMessages": ["You are not authenticated."]}`)
+	}))
+	defer server.Close()
+
+	client := jira.NewClient(server.URL, "", "token", "", time.Second)
+
+	_, err := client.LookupTicket("PROJ-1")
+	require.ErrorContains(t, err, "JIRA API returned 401 Unauthorized: You are not authenticated.")
+
+	_, err = client.LookupTicket("PROJ-2")
+	require.Error(t, err)
+	require.Equal(t, 1, requests)
+}
+
+func TestLookupTicket_Timeout(t *testing.T) {
+	release := make(chan struct{})
+
+	server := httptest.NewServer(http.HandlerFunc(func(_ http.ResponseWriter, _ *http.Request) {
+		<-release
+	}))
+	defer server.Close()
+	defer close(release)
+
+	client := jira.NewClient(server.URL, "", "", "", 50*time.Millisecond)
+
+	start := time.Now()
+	_, err := client.LookupTicket("PROJ-1")
+
+	require.Error(t, err)
+	require.Less(t, time.Since(start), 5*time.Second)
+}
+
+func TestLookupTicket_Cache(t *testing.T) {
+	requests := 0
+
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, _ *http.Request) {
+		requests++
+
+		fmt.Fprint(writer, `{"key": "PROJ-1", "fields": {"project": {"key": "PROJ"}}}`)
+	}))
+	defer server.Close()
+
+	dir := t.TempDir()
+
+	for range 2 {
+		client := jira.NewClient(server.URL, "", "", "", time.Second).WithCache(dir, time.Hour)
+
+		ticket, err := client.LookupTicket("PROJ-1")
+		require.NoError(t, err)
+		require.Equal(t, "PROJ", ticket.Project)
+	}
+
+	// The second client, like a hook run for the next commit, reads the cache file
+	require.Equal(t, 1, requests)
+
+	client := jira.NewClient(server.URL, "", "", "", time.Second).WithCache(dir, time.Nanosecond)
+	_, err := client.LookupTicket("PROJ-1")
+	require.NoError(t, err)
+	require.Equal(t, 2, requests)
+}
@@ -0,0 +1,19 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+/*
+Package jira looks up the tickets referenced by commit messages in the JIRA REST API.
+
+It backs the online mode of the jirareference rule, enabled with jira.online:
+
+  - Client.LookupTicket fetches the project, status and sprints of an issue
+  - looked up tickets are kept in a cache file for jira.online.cache_ttl
+  - every request is bounded by jira.online.timeout, and after the first failed
+    request the client stops asking for the rest of the run
+
+The client authenticates with the token in JIRA_API_TOKEN, sent as basic
+authentication together with JIRA_USER_EMAIL on JIRA Cloud and as a bearer
+token (a personal access token of JIRA Data Center) when no email is set.
+*/
+package jira
@@ -9,17 +9,44 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"strings"
 	"time"
 
 	"github.com/itiquette/gommitlint/internal/domain"
 	"github.com/rs/zerolog"
 )
 
+// Components tagging the log lines of the parts of gommitlint.
+const (
+	ComponentCLI        = "cli"
+	ComponentValidation = "validation"
+	ComponentHooks      = "hooks"
+	ComponentConfig     = "config"
+)
+
 // Logger implements domain.Logger interface using zerolog.
 type Logger struct {
-	logger zerolog.Logger
+	logger  zerolog.Logger
+	sampler *debugSampler // Thins out repeated debug lines; nil logs them all
 }
 
+// Options configures the logger created by InitLogger.
+type Options struct {
+	Format      string // text (default) or json
+	Level       string // Level, optionally followed by component overrides, e.g. "info,validation=debug"
+	Debug       bool   // Add source locations
+	SampleEvery uint   // Log only every Nth repetition of a debug line after a burst; 0 logs all
+}
+
+// settings are the logger settings of a run that component loggers are created with.
+type settings struct {
+	componentLevels map[string]zerolog.Level
+	sampler         *debugSampler
+}
+
+// settingsKey is the context key of the logger settings.
+type settingsKey struct{}
+
 // New creates a new domain logger from zerolog.
 func New(logger zerolog.Logger) domain.Logger {
 	return Logger{logger: logger}
@@ -27,6 +54,10 @@ func New(logger zerolog.Logger) domain.Logger {
 
 // Debug outputs a debug-level message with optional key-value arguments.
 func (l Logger) Debug(msg string, args ...interface{}) {
+	if !l.sampler.allow(msg) {
+		return
+	}
+
 	l.logWithArgs(l.logger.Debug(), msg, args...)
 }
 
@@ -42,6 +73,10 @@ func (l Logger) Error(msg string, args ...interface{}) {
 
 // Log outputs a message with the specified level and optional key-value arguments.
 func (l Logger) Log(level string, msg string, args ...interface{}) {
+	if level == "debug" && !l.sampler.allow(msg) {
+		return
+	}
+
 	event := l.getLogEvent(level)
 	l.logWithArgs(event, msg, args...)
 }
@@ -64,6 +99,14 @@ func (l Logger) addFields(event *zerolog.Event, args ...interface{}) *zerolog.Ev
 
 	for i := 0; i < len(args); i += 2 {
 		key := fmt.Sprint(args[i])
+
+		// Errors have no exported fields and would be logged as {}
+		if err, ok := args[i+1].(error); ok {
+			event = event.Str(key, err.Error())
+
+			continue
+		}
+
 		event = event.Interface(key, args[i+1])
 	}
 
@@ -108,15 +151,61 @@ func parseLogLevel(level string) zerolog.Level {
 	}
 }
 
+// parseLogLevels splits a level specification such as "info,validation=debug" into
+// the level and the level overrides by component.
+func parseLogLevels(spec string) (zerolog.Level, map[string]zerolog.Level) {
+	level := zerolog.InfoLevel
+	componentLevels := make(map[string]zerolog.Level)
+
+	for _, part := range strings.Split(spec, ",") {
+		if component, componentLevel, found := strings.Cut(part, "="); found {
+			componentLevels[strings.TrimSpace(component)] = parseLogLevel(strings.TrimSpace(componentLevel))
+
+			continue
+		}
+
+		level = parseLogLevel(part)
+	}
+
+	return level, componentLevels
+}
+
+// ValidateFormat checks that format is a supported log format.
+func ValidateFormat(format string) error {
+	if format != "text" && format != "json" {
+		return fmt.Errorf("unsupported log format '%s', supported formats: text, json", format)
+	}
+
+	return nil
+}
+
 // InitLogger creates a configured zerolog instance.
-func InitLogger(ctx context.Context, outputFormat string, debug bool, logLevel string) context.Context {
-	level := parseLogLevel(logLevel)
-	writer := createWriter(outputFormat)
-	logger := createZerologger(writer, level, debug)
+func InitLogger(ctx context.Context, options Options) context.Context {
+	level, componentLevels := parseLogLevels(options.Level)
+	writer := createWriter(options.Format)
+	logger := createZerologger(writer, level, options.Debug)
+
+	ctx = context.WithValue(ctx, settingsKey{}, settings{
+		componentLevels: componentLevels,
+		sampler:         newDebugSampler(options.SampleEvery),
+	})
 
 	return logger.WithContext(ctx)
 }
 
+// ComponentLogger creates a domain logger for component from the logger in ctx. Its
+// lines carry the component field and follow the level override for the component.
+func ComponentLogger(ctx context.Context, component string) domain.Logger {
+	settings, _ := ctx.Value(settingsKey{}).(settings)
+
+	logger := zerolog.Ctx(ctx).With().Str(domain.LogFieldComponent, component).Logger()
+	if level, found := settings.componentLevels[component]; found {
+		logger = logger.Level(level)
+	}
+
+	return Logger{logger: logger, sampler: settings.sampler}
+}
+
 // GetLogger retrieves zerolog from context.
 func GetLogger(ctx context.Context) *zerolog.Logger {
 	return zerolog.Ctx(ctx)
@@ -129,10 +218,11 @@ func NewDomainLogger(logger *zerolog.Logger) domain.Logger {
 
 // createWriter creates appropriate io.Writer based on format.
 func createWriter(outputFormat string) io.Writer {
+	// Logs go to stderr in every format, keeping stdout for reports
 	if outputFormat == "json" {
 		zerolog.TimeFieldFormat = time.RFC3339
 
-		return os.Stdout
+		return os.Stderr
 	}
 
 	return zerolog.ConsoleWriter{
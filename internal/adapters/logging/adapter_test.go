@@ -5,6 +5,10 @@
 package logging
 
 import (
+	"bytes"
+	"context"
+	"errors"
+	"strings"
 	"testing"
 
 	"github.com/rs/zerolog"
@@ -118,3 +122,65 @@ func TestParseLogLevel_AllAdvertisedLevels(t *testing.T) {
 		})
 	}
 }
+
+func TestParseLogLevels(t *testing.T) {
+	tests := []struct {
+		spec            string
+		level           zerolog.Level
+		componentLevels map[string]zerolog.Level
+	}{
+		{spec: "warn", level: zerolog.WarnLevel, componentLevels: map[string]zerolog.Level{}},
+		{
+			spec:            "info,validation=debug",
+			level:           zerolog.InfoLevel,
+			componentLevels: map[string]zerolog.Level{"validation": zerolog.DebugLevel},
+		},
+		{
+			spec:            "hooks=error, validation = trace",
+			level:           zerolog.InfoLevel,
+			componentLevels: map[string]zerolog.Level{"hooks": zerolog.ErrorLevel, "validation": zerolog.TraceLevel},
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.spec, func(t *testing.T) {
+			level, componentLevels := parseLogLevels(testCase.spec)
+			require.Equal(t, testCase.level, level)
+			require.Equal(t, testCase.componentLevels, componentLevels)
+		})
+	}
+}
+
+func TestComponentLogger(t *testing.T) {
+	var buffer bytes.Buffer
+
+	ctx := context.WithValue(context.Background(), settingsKey{}, settings{
+		componentLevels: map[string]zerolog.Level{ComponentValidation: zerolog.DebugLevel},
+	})
+	ctx = zerolog.New(&buffer).Level(zerolog.InfoLevel).WithContext(ctx)
+
+	ComponentLogger(ctx, ComponentHooks).Debug("Hidden by the level")
+	ComponentLogger(ctx, ComponentValidation).Debug("Rule failed", "rule", "Subject", "error", errors.New("too long"))
+
+	require.JSONEq(t, `{"level":"debug","component":"validation","rule":"Subject","error":"too long","message":"Rule failed"}`,
+		buffer.String())
+}
+
+func TestDebugSampler(t *testing.T) {
+	var buffer bytes.Buffer
+
+	logger := Logger{logger: zerolog.New(&buffer), sampler: newDebugSampler(5)}
+
+	for range 30 {
+		logger.Debug("Validated commit")
+		logger.Log("debug", "Rule failed")
+	}
+
+	logger.Info("Finished validation")
+
+	lines := strings.Split(strings.TrimSpace(buffer.String()), "\n")
+	// Each message: 10 before sampling starts, then the 15th, 20th, 25th and 30th
+	require.Len(t, lines, 2*(sampleBurst+4)+1)
+
+	require.Nil(t, newDebugSampler(0), "sampling is off by default")
+}
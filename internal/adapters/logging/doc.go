@@ -12,6 +12,8 @@ Key features:
   - Single adapter implementation (no overengineering)
   - Consistent key-value argument handling
   - CLI integration for configuration
+  - Text or JSON lines, with level overrides by component
+  - Sampling of debug lines repeated for every commit of large ranges
 
 Usage:
 
@@ -21,8 +23,9 @@ Usage:
 	// Use in domain functions
 	logger.Info("Processing commit", "hash", commit.Hash)
 
-	// Initialize from CLI context
-	ctx = logging.InitLogger(ctx, cmd, "text")
+	// Initialize from CLI flags, then log as a component
+	ctx = logging.InitLogger(ctx, logging.Options{Format: "json", Level: "info,validation=debug"})
+	logger = logging.ComponentLogger(ctx, logging.ComponentValidation)
 
 The adapter ensures that domain logic can log messages without
 depending on specific logging implementations.
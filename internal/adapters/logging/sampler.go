@@ -0,0 +1,43 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package logging
+
+import "sync"
+
+// sampleBurst is how many times a debug line is logged before sampling starts.
+const sampleBurst = 10
+
+// debugSampler thins out debug lines repeated with the same message, such as those
+// logged for every commit of a large range. Each message is logged sampleBurst times
+// and after that only every Nth time.
+type debugSampler struct {
+	every  uint
+	mutex  sync.Mutex
+	counts map[string]uint
+}
+
+// newDebugSampler creates a sampler keeping every Nth repetition, or nil when every is 0.
+func newDebugSampler(every uint) *debugSampler {
+	if every == 0 {
+		return nil
+	}
+
+	return &debugSampler{every: every, counts: make(map[string]uint)}
+}
+
+// allow reports whether the debug line msg is logged. A nil sampler allows every line.
+func (s *debugSampler) allow(msg string) bool {
+	if s == nil {
+		return true
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.counts[msg]++
+	count := s.counts[msg]
+
+	return count <= sampleBurst || (count-sampleBurst)%s.every == 0
+}
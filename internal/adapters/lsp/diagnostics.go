@@ -0,0 +1,238 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package lsp
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf16"
+
+	"github.com/itiquette/gommitlint/internal/domain"
+)
+
+// diagnosticSource names gommitlint as the origin of diagnostics in the editor.
+const diagnosticSource = "gommitlint"
+
+// ValidateFunc validates a commit message and returns its failures.
+type ValidateFunc func(message string) ([]domain.ValidationError, error)
+
+// bodyRules are rules whose failures concern the message body as a whole.
+var bodyRules = map[string]bool{
+	"CommitBody": true,
+	"SignOff":    true,
+	"Trailers":   true,
+}
+
+// locatableContext lists context keys, in order of preference, that may hold text
+// quoted from the message.
+var locatableContext = []string{domain.ContextActual, "first_word"}
+
+// document is a commit message buffer with git comments removed.
+type document struct {
+	lines        []string // All buffer lines
+	messageLines []int    // Buffer line index of each message line
+}
+
+// parseDocument splits a buffer into lines and finds the lines of the commit message,
+// skipping comment lines and everything below the scissors line.
+func parseDocument(text string) document {
+	lines := strings.Split(strings.ReplaceAll(text, "\r\n", "\n"), "\n")
+	messageLines := make([]int, 0, len(lines))
+
+	for index, line := range lines {
+		if strings.HasPrefix(line, "#") {
+			if strings.Contains(line, ">8") {
+				break
+			}
+
+			continue
+		}
+
+		messageLines = append(messageLines, index)
+	}
+
+	// Blank lines around the message are trimmed like the validator does
+	for len(messageLines) > 0 && strings.TrimSpace(lines[messageLines[0]]) == "" {
+		messageLines = messageLines[1:]
+	}
+
+	for len(messageLines) > 0 && strings.TrimSpace(lines[messageLines[len(messageLines)-1]]) == "" {
+		messageLines = messageLines[:len(messageLines)-1]
+	}
+
+	return document{lines: lines, messageLines: messageLines}
+}
+
+// message returns the commit message text of the document.
+func (d document) message() string {
+	texts := make([]string, 0, len(d.messageLines))
+	for _, index := range d.messageLines {
+		texts = append(texts, d.lines[index])
+	}
+
+	return strings.Join(texts, "\n")
+}
+
+// Diagnostics validates the commit message in text and maps each failure to a range.
+func Diagnostics(text string, validate ValidateFunc) []Diagnostic {
+	diagnostics := []Diagnostic{}
+
+	doc := parseDocument(text)
+	if len(doc.messageLines) == 0 {
+		return diagnostics
+	}
+
+	errs, err := validate(doc.message())
+	if err != nil {
+		return diagnostics
+	}
+
+	for _, validationErr := range errs {
+		severity := SeverityError
+		if !validationErr.IsBlocking() {
+			severity = SeverityWarning
+		}
+
+		diagnostics = append(diagnostics, Diagnostic{
+			Range:    doc.locate(validationErr),
+			Severity: severity,
+			Code:     validationErr.Code,
+			Source:   diagnosticSource,
+			Message:  fmt.Sprintf("%s: %s", validationErr.Rule, validationErr.Message),
+		})
+	}
+
+	return diagnostics
+}
+
+// locate finds the buffer range a failure refers to.
+func (d document) locate(err domain.ValidationError) Range {
+	subjectLine := d.messageLines[0]
+
+	if err.Code == string(domain.ErrSubjectTooLong) {
+		var maxLength int
+		if _, scanErr := fmt.Sscanf(err.Expected(), "max %d", &maxLength); scanErr == nil {
+			return d.subjectOverflow(maxLength, err.Context["length_mode"])
+		}
+	}
+
+	if position, ok := err.Position(); ok {
+		if found, ok := d.characterRange(position); ok {
+			return found
+		}
+	}
+
+	// Suffixes sit at the end of the subject, other words at their first occurrence
+	last := err.Code == string(domain.ErrSubjectSuffix)
+
+	for _, key := range locatableContext {
+		word := err.Context[key]
+		if !isLocatable(word) {
+			continue
+		}
+
+		for _, index := range d.messageLines {
+			if found, ok := findInLine(d.lines[index], index, word, last); ok {
+				return found
+			}
+		}
+	}
+
+	if bodyRules[err.Rule] && len(d.messageLines) > 2 {
+		firstLine := d.messageLines[2]
+		lastLine := d.messageLines[len(d.messageLines)-1]
+
+		return Range{
+			Start: Position{Line: firstLine},
+			End:   Position{Line: lastLine, Character: utf16Length(d.lines[lastLine])},
+		}
+	}
+
+	return d.lineRange(subjectLine)
+}
+
+// subjectOverflow marks the part of the subject beyond maxLength characters, counted
+// in the length mode of the subject rule.
+func (d document) subjectOverflow(maxLength int, lengthMode string) Range {
+	subjectLine := d.messageLines[0]
+	line := d.lines[subjectLine]
+	trimmed := strings.TrimSpace(line)
+	start := strings.Index(line, trimmed)
+
+	if maxLength < 0 || maxLength >= domain.TextLength(trimmed, lengthMode) {
+		return d.lineRange(subjectLine)
+	}
+
+	within := domain.TruncateToLength(trimmed, maxLength, lengthMode)
+
+	return Range{
+		Start: Position{Line: subjectLine, Character: utf16Length(line[:start]) + utf16Length(within)},
+		End:   Position{Line: subjectLine, Character: utf16Length(line[:start+len(trimmed)])},
+	}
+}
+
+// characterRange returns the range of the character at a position in the message.
+func (d document) characterRange(position domain.Position) (Range, bool) {
+	if position.Line > len(d.messageLines) {
+		return Range{}, false
+	}
+
+	index := d.messageLines[position.Line-1]
+
+	runes := []rune(d.lines[index])
+	if position.Column > len(runes) {
+		return Range{}, false
+	}
+
+	start := utf16Length(string(runes[:position.Column-1]))
+
+	return Range{
+		Start: Position{Line: index, Character: start},
+		End:   Position{Line: index, Character: start + utf16Length(string(runes[position.Column-1]))},
+	}, true
+}
+
+// lineRange covers the text of a buffer line.
+func (d document) lineRange(index int) Range {
+	return Range{
+		Start: Position{Line: index},
+		End:   Position{Line: index, Character: utf16Length(d.lines[index])},
+	}
+}
+
+// findInLine returns the range of word in line, using its last occurrence if last is set.
+func findInLine(line string, index int, word string, last bool) (Range, bool) {
+	offset := strings.Index(line, word)
+	if last {
+		offset = strings.LastIndex(line, word)
+	}
+
+	if offset < 0 {
+		return Range{}, false
+	}
+
+	start := utf16Length(line[:offset])
+
+	return Range{
+		Start: Position{Line: index, Character: start},
+		End:   Position{Line: index, Character: start + utf16Length(word)},
+	}, true
+}
+
+// isLocatable reports whether a context value is text that can be found in the message.
+// Counts such as lengths would match unrelated digits.
+func isLocatable(value string) bool {
+	if strings.TrimSpace(value) == "" {
+		return false
+	}
+
+	return strings.ContainsFunc(value, func(r rune) bool { return !unicode.IsDigit(r) })
+}
+
+// utf16Length returns the length of text in UTF-16 code units, the LSP default position encoding.
+func utf16Length(text string) int {
+	return len(utf16.Encode([]rune(text)))
+}
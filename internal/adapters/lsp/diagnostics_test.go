@@ -0,0 +1,137 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package lsp
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/itiquette/gommitlint/internal/domain"
+	"github.com/stretchr/testify/require"
+)
+
+// validateWith returns a ValidateFunc that records the message and returns errs.
+func validateWith(received *string, errs ...domain.ValidationError) ValidateFunc {
+	return func(message string) ([]domain.ValidationError, error) {
+		*received = message
+
+		return errs, nil
+	}
+}
+
+func lineRange(line, start, end int) Range {
+	return Range{Start: Position{Line: line, Character: start}, End: Position{Line: line, Character: end}}
+}
+
+func TestDiagnostics_Message(t *testing.T) {
+	text := "\n# Please enter the commit message\nAdd parser\n\nBody text.\n\n# Changes to be committed:\n#\tmodified: a.go\n" +
+		"# ------------------------ >8 ------------------------\ndiff --git a/a.go b/a.go\n"
+
+	var received string
+
+	diagnostics := Diagnostics(text, validateWith(&received))
+	require.Equal(t, "Add parser\n\nBody text.", received)
+	require.NotNil(t, diagnostics)
+	require.Empty(t, diagnostics)
+
+	received = "untouched"
+	require.Empty(t, Diagnostics("# only comments\n", validateWith(&received)))
+	require.Equal(t, "untouched", received, "empty messages are not validated")
+
+	require.Empty(t, Diagnostics("Add parser", func(string) ([]domain.ValidationError, error) {
+		return nil, errors.New("failed")
+	}))
+}
+
+func TestDiagnostics_Ranges(t *testing.T) {
+	text := "# comment\nfeat: add a very long subject — with ünïcode 🎉 text\n\nFirst body line.\nSigned-off-by: A <a@b.c>"
+
+	tests := []struct {
+		name     string
+		err      domain.ValidationError
+		expected Range
+	}{
+		{
+			name: "subject too long marks overflow",
+			err: domain.New("Subject", domain.ErrSubjectTooLong, "Subject too long").
+				WithContextMap(map[string]string{"actual": "51", "expected": "max 30"}),
+			// 🎉 counts as two UTF-16 code units
+			expected: lineRange(1, 30, 52),
+		},
+		{
+			name: "subject too long by display width keeps wide emoji whole",
+			err: domain.New("Subject", domain.ErrSubjectTooLong, "Subject too long").
+				WithContextMap(map[string]string{"actual": "53", "expected": "max 46", "length_mode": "display-width"}),
+			// 🎉 is two columns wide, so it no longer fits within 46
+			expected: lineRange(1, 45, 52),
+		},
+		{
+			name: "character at line and column",
+			err: domain.New("Characters", domain.ErrNonASCIICharacter, "Non-ASCII character U+1F389 at line 1, column 46").
+				WithContextMap(map[string]string{"actual": "U+1F389", "line": "1", "column": "46", "part": "subject"}),
+			// Columns count runes, so the emoji before it is no wider than other characters
+			expected: lineRange(1, 45, 47),
+		},
+		{
+			name: "word in subject",
+			err: domain.New("ImperativeVerb", domain.ErrNonImperative, "Use imperative").
+				WithContextMap(map[string]string{"actual": "text"}),
+			expected: lineRange(1, 48, 52),
+		},
+		{
+			name: "first word of subject",
+			err: domain.New("Subject", domain.ErrWrongCaseUpper, "First letter 'f' should be 'F'").
+				WithContextMap(map[string]string{"first_word": "feat:", "expected_word": "Feat:"}),
+			expected: lineRange(1, 0, 5),
+		},
+		{
+			name: "suffix uses last occurrence",
+			err: domain.New("Subject", domain.ErrSubjectSuffix, "Invalid suffix").
+				WithContextMap(map[string]string{"actual": "t"}),
+			expected: lineRange(1, 51, 52),
+		},
+		{
+			name: "word in body",
+			err: domain.New("Spell", domain.ErrMisspelledWord, "Misspelled word").
+				WithContextMap(map[string]string{"actual": "body"}),
+			expected: lineRange(3, 6, 10),
+		},
+		{
+			name: "body rule covers body",
+			err: domain.New("CommitBody", domain.ErrBodyTooShort, "Body too short").
+				WithContextMap(map[string]string{"actual": "12"}),
+			expected: Range{Start: Position{Line: 3}, End: Position{Line: 4, Character: 24}},
+		},
+		{
+			name:     "other failures mark subject",
+			err:      domain.New("Signature", domain.ErrMissingSignature, "Missing signature"),
+			expected: lineRange(1, 0, 52),
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			var received string
+
+			diagnostics := Diagnostics(text, validateWith(&received, testCase.err))
+			require.Len(t, diagnostics, 1)
+			require.Equal(t, testCase.expected, diagnostics[0].Range)
+			require.Equal(t, SeverityError, diagnostics[0].Severity)
+			require.Equal(t, testCase.err.Code, diagnostics[0].Code)
+			require.Equal(t, "gommitlint", diagnostics[0].Source)
+			require.Equal(t, testCase.err.Rule+": "+testCase.err.Message, diagnostics[0].Message)
+		})
+	}
+}
+
+func TestDiagnostics_WarningSeverity(t *testing.T) {
+	var received string
+
+	warning := domain.New("NoWIP", domain.ErrForbiddenPattern, "No WIP").WithSeverity(domain.SeverityWarning)
+
+	diagnostics := Diagnostics("WIP parser", validateWith(&received, warning))
+	require.Len(t, diagnostics, 1)
+	require.Equal(t, SeverityWarning, diagnostics[0].Severity)
+}
@@ -0,0 +1,20 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+/*
+Package lsp provides a minimal Language Server Protocol server that publishes
+validation failures of commit message buffers as editor diagnostics.
+
+The server speaks JSON-RPC 2.0 over stdin/stdout with full document sync and
+supports only what diagnostics need: initialize, shutdown, exit and the
+textDocument open, change, save and close notifications. Editors attach it to
+gitcommit buffers, so rule logic stays in gommitlint rather than being
+reimplemented in editor plugins.
+
+Failures are mapped to ranges in the buffer: an overlong subject is marked from
+the maximum length to the end of the line, failures naming a word are marked at
+that word, body failures cover the body, and everything else marks the subject.
+Git comment lines and anything below the scissors line are ignored.
+*/
+package lsp
@@ -0,0 +1,154 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package lsp
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// JSON-RPC error codes used by the server.
+const (
+	codeParseError     = -32700
+	codeMethodNotFound = -32601
+	codeInvalidParams  = -32602
+	codeInternalError  = -32603
+)
+
+// message is a JSON-RPC 2.0 request, notification or response.
+type message struct {
+	JSONRPC string           `json:"jsonrpc"`
+	ID      *json.RawMessage `json:"id,omitempty"`
+	Method  string           `json:"method,omitempty"`
+	Params  json.RawMessage  `json:"params,omitempty"`
+	Result  json.RawMessage  `json:"result,omitempty"`
+	Error   *responseError   `json:"error,omitempty"`
+}
+
+// responseError is the error member of a JSON-RPC response.
+type responseError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+// Position is a zero-based line and UTF-16 character offset in a document.
+type Position struct {
+	Line      int `json:"line"`
+	Character int `json:"character"`
+}
+
+// Range is a span between two positions in a document.
+type Range struct {
+	Start Position `json:"start"`
+	End   Position `json:"end"`
+}
+
+// DiagnosticSeverity is the LSP severity of a diagnostic.
+type DiagnosticSeverity int
+
+// Diagnostic severities used by the server.
+const (
+	SeverityError   DiagnosticSeverity = 1
+	SeverityWarning DiagnosticSeverity = 2
+)
+
+// Diagnostic is a single failure shown in the editor.
+type Diagnostic struct {
+	Range    Range              `json:"range"`
+	Severity DiagnosticSeverity `json:"severity"`
+	Code     string             `json:"code,omitempty"`
+	Source   string             `json:"source"`
+	Message  string             `json:"message"`
+}
+
+// Parameters of the notifications handled by the server.
+type (
+	textDocumentItem struct {
+		URI  string `json:"uri"`
+		Text string `json:"text"`
+	}
+
+	didOpenParams struct {
+		TextDocument textDocumentItem `json:"textDocument"`
+	}
+
+	didChangeParams struct {
+		TextDocument   textDocumentItem `json:"textDocument"`
+		ContentChanges []struct {
+			Text string `json:"text"`
+		} `json:"contentChanges"`
+	}
+
+	didSaveParams struct {
+		TextDocument textDocumentItem `json:"textDocument"`
+		Text         *string          `json:"text"`
+	}
+
+	didCloseParams struct {
+		TextDocument textDocumentItem `json:"textDocument"`
+	}
+
+	publishDiagnosticsParams struct {
+		URI         string       `json:"uri"`
+		Diagnostics []Diagnostic `json:"diagnostics"`
+	}
+)
+
+// readMessage reads one Content-Length framed message.
+func readMessage(reader *bufio.Reader) ([]byte, error) {
+	length := -1
+
+	for {
+		line, err := reader.ReadString('\n')
+		if err != nil {
+			return nil, err
+		}
+
+		line = strings.TrimRight(line, "\r\n")
+		if line == "" {
+			break
+		}
+
+		name, value, found := strings.Cut(line, ":")
+		if found && strings.EqualFold(strings.TrimSpace(name), "Content-Length") {
+			length, err = strconv.Atoi(strings.TrimSpace(value))
+			if err != nil {
+				return nil, fmt.Errorf("invalid Content-Length header: %w", err)
+			}
+		}
+	}
+
+	if length < 0 {
+		return nil, errors.New("missing Content-Length header")
+	}
+
+	body := make([]byte, length)
+	if _, err := io.ReadFull(reader, body); err != nil {
+		return nil, fmt.Errorf("failed to read message body: %w", err)
+	}
+
+	return body, nil
+}
+
+// writeMessage writes one Content-Length framed message.
+func writeMessage(writer io.Writer, msg message) error {
+	msg.JSONRPC = "2.0"
+
+	body, err := json.Marshal(msg)
+	if err != nil {
+		return fmt.Errorf("failed to encode message: %w", err)
+	}
+
+	if _, err := fmt.Fprintf(writer, "Content-Length: %d\r\n\r\n%s", len(body), body); err != nil {
+		return fmt.Errorf("failed to write message: %w", err)
+	}
+
+	return nil
+}
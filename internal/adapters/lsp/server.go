@@ -0,0 +1,176 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package lsp
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// Server publishes diagnostics for commit message documents.
+type Server struct {
+	validate ValidateFunc
+	name     string
+	version  string
+}
+
+// NewServer creates a server that validates documents with validate.
+func NewServer(validate ValidateFunc, version string) Server {
+	return Server{validate: validate, name: diagnosticSource, version: version}
+}
+
+// Run serves LSP messages from input until the client sends exit, input ends or ctx is done.
+func (s Server) Run(ctx context.Context, input io.Reader, output io.Writer) error {
+	reader := bufio.NewReader(input)
+
+	for {
+		if ctx.Err() != nil {
+			return nil
+		}
+
+		body, err := readMessage(reader)
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+
+		if err != nil {
+			return err
+		}
+
+		var msg message
+		if err := json.Unmarshal(body, &msg); err != nil {
+			if err := writeMessage(output, errorResponse(nil, codeParseError, "invalid JSON")); err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		if msg.Method == "exit" {
+			return nil
+		}
+
+		if err := s.handle(msg, output); err != nil {
+			return err
+		}
+	}
+}
+
+// handle processes a single request or notification.
+func (s Server) handle(msg message, output io.Writer) error {
+	switch msg.Method {
+	case "initialize":
+		return writeMessage(output, result(msg.ID, map[string]any{
+			"capabilities": map[string]any{
+				"textDocumentSync": map[string]any{
+					"openClose": true,
+					"change":    1, // Full document sync
+					"save":      map[string]any{"includeText": true},
+				},
+			},
+			"serverInfo": map[string]string{"name": s.name, "version": s.version},
+		}))
+	case "shutdown":
+		return writeMessage(output, result(msg.ID, nil))
+	case "textDocument/didOpen":
+		var params didOpenParams
+		if err := json.Unmarshal(msg.Params, &params); err != nil {
+			return s.invalidParams(msg, output, err)
+		}
+
+		return s.publish(output, params.TextDocument.URI, params.TextDocument.Text)
+	case "textDocument/didChange":
+		var params didChangeParams
+		if err := json.Unmarshal(msg.Params, &params); err != nil {
+			return s.invalidParams(msg, output, err)
+		}
+
+		if len(params.ContentChanges) == 0 {
+			return nil
+		}
+
+		// With full sync the last change holds the whole document
+		return s.publish(output, params.TextDocument.URI, params.ContentChanges[len(params.ContentChanges)-1].Text)
+	case "textDocument/didSave":
+		var params didSaveParams
+		if err := json.Unmarshal(msg.Params, &params); err != nil {
+			return s.invalidParams(msg, output, err)
+		}
+
+		if params.Text == nil {
+			return nil
+		}
+
+		return s.publish(output, params.TextDocument.URI, *params.Text)
+	case "textDocument/didClose":
+		var params didCloseParams
+		if err := json.Unmarshal(msg.Params, &params); err != nil {
+			return s.invalidParams(msg, output, err)
+		}
+
+		return writeMessage(output, notification("textDocument/publishDiagnostics", publishDiagnosticsParams{
+			URI:         params.TextDocument.URI,
+			Diagnostics: []Diagnostic{},
+		}))
+	default:
+		// Unknown notifications are ignored; unknown requests need an answer
+		if msg.ID == nil {
+			return nil
+		}
+
+		return writeMessage(output, errorResponse(msg.ID, codeMethodNotFound, "method not supported: "+msg.Method))
+	}
+}
+
+// publish validates text and sends its diagnostics for uri.
+func (s Server) publish(output io.Writer, uri, text string) error {
+	return writeMessage(output, notification("textDocument/publishDiagnostics", publishDiagnosticsParams{
+		URI:         uri,
+		Diagnostics: Diagnostics(text, s.validate),
+	}))
+}
+
+// invalidParams answers a request with malformed parameters; malformed notifications are dropped.
+func (s Server) invalidParams(msg message, output io.Writer, err error) error {
+	if msg.ID == nil {
+		return nil
+	}
+
+	return writeMessage(output, errorResponse(msg.ID, codeInvalidParams, fmt.Sprintf("invalid params: %v", err)))
+}
+
+// result builds a successful response.
+func result(id *json.RawMessage, value any) message {
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		return errorResponse(id, codeInternalError, "failed to encode result")
+	}
+
+	return message{ID: id, Result: encoded}
+}
+
+// errorResponse builds an error response.
+func errorResponse(id *json.RawMessage, code int, text string) message {
+	if id == nil {
+		null := json.RawMessage("null")
+		id = &null
+	}
+
+	return message{ID: id, Error: &responseError{Code: code, Message: text}}
+}
+
+// notification builds a server notification.
+func notification(method string, params any) message {
+	encoded, err := json.Marshal(params)
+	if err != nil {
+		encoded = json.RawMessage("null")
+	}
+
+	return message{Method: method, Params: encoded}
+}
@@ -0,0 +1,113 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package lsp
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/itiquette/gommitlint/internal/domain"
+	"github.com/stretchr/testify/require"
+)
+
+func frame(body string) string {
+	return fmt.Sprintf("Content-Length: %d\r\n\r\n%s", len(body), body)
+}
+
+// readAll decodes every framed message the server wrote.
+func readAll(t *testing.T, output *bytes.Buffer) []map[string]any {
+	t.Helper()
+
+	reader := bufio.NewReader(output)
+
+	var messages []map[string]any
+
+	for {
+		body, err := readMessage(reader)
+		if err != nil {
+			return messages
+		}
+
+		var decoded map[string]any
+		require.NoError(t, json.Unmarshal(body, &decoded))
+
+		messages = append(messages, decoded)
+	}
+}
+
+func TestServer_Run(t *testing.T) {
+	validate := func(message string) ([]domain.ValidationError, error) {
+		if strings.HasPrefix(message, "feat:") {
+			return nil, nil
+		}
+
+		return []domain.ValidationError{domain.New("ConventionalCommit", domain.ErrInvalidFormat, "Invalid format")}, nil
+	}
+
+	input := strings.Join([]string{
+		frame(`{"jsonrpc":"2.0","id":1,"method":"initialize","params":{}}`),
+		frame(`{"jsonrpc":"2.0","method":"initialized","params":{}}`),
+		frame(`{"jsonrpc":"2.0","method":"textDocument/didOpen","params":{"textDocument":{"uri":"file:///r/.git/COMMIT_EDITMSG","languageId":"gitcommit","version":1,"text":"Add parser\n# comment\n"}}}`),
+		frame(`{"jsonrpc":"2.0","method":"textDocument/didChange","params":{"textDocument":{"uri":"file:///r/.git/COMMIT_EDITMSG","version":2},"contentChanges":[{"text":"feat: add parser\n"}]}}`),
+		frame(`{"jsonrpc":"2.0","id":2,"method":"textDocument/hover","params":{}}`),
+		frame(`{"jsonrpc":"2.0","method":"textDocument/didClose","params":{"textDocument":{"uri":"file:///r/.git/COMMIT_EDITMSG"}}}`),
+		frame(`{"jsonrpc":"2.0","id":3,"method":"shutdown"}`),
+		frame(`{"jsonrpc":"2.0","method":"exit"}`),
+		frame(`{"jsonrpc":"2.0","id":4,"method":"shutdown"}`),
+	}, "")
+
+	var output bytes.Buffer
+
+	err := NewServer(validate, "1.0.0").Run(context.Background(), strings.NewReader(input), &output)
+	require.NoError(t, err)
+
+	messages := readAll(t, &output)
+	require.Len(t, messages, 6, "nothing is answered after exit")
+
+	initialize := messages[0]
+	require.InDelta(t, 1, initialize["id"], 0)
+	require.Equal(t, map[string]any{"name": "gommitlint", "version": "1.0.0"}, initialize["result"].(map[string]any)["serverInfo"])
+
+	opened := messages[1]["params"].(map[string]any)
+	require.Equal(t, "textDocument/publishDiagnostics", messages[1]["method"])
+	require.Equal(t, "file:///r/.git/COMMIT_EDITMSG", opened["uri"])
+	require.Len(t, opened["diagnostics"], 1)
+	require.Equal(t, "invalid_format", opened["diagnostics"].([]any)[0].(map[string]any)["code"])
+
+	changed := messages[2]["params"].(map[string]any)
+	require.Empty(t, changed["diagnostics"])
+	require.NotNil(t, changed["diagnostics"], "diagnostics are cleared with an empty list")
+
+	require.InDelta(t, codeMethodNotFound, messages[3]["error"].(map[string]any)["code"], 0)
+
+	closed := messages[4]["params"].(map[string]any)
+	require.Empty(t, closed["diagnostics"])
+
+	shutdown := messages[5]
+	require.InDelta(t, 3, shutdown["id"], 0)
+	require.Contains(t, shutdown, "result")
+	require.Nil(t, shutdown["result"])
+}
+
+func TestServer_RunInvalidInput(t *testing.T) {
+	var output bytes.Buffer
+
+	input := frame(`{not json`) + frame(`{"jsonrpc":"2.0","method":"exit"}`)
+
+	err := NewServer(nil, "dev").Run(context.Background(), strings.NewReader(input), &output)
+	require.NoError(t, err)
+
+	messages := readAll(t, &output)
+	require.Len(t, messages, 1)
+	require.InDelta(t, codeParseError, messages[0]["error"].(map[string]any)["code"], 0)
+
+	err = NewServer(nil, "dev").Run(context.Background(), strings.NewReader("Content-Type: x\r\n\r\n"), &output)
+	require.ErrorContains(t, err, "missing Content-Length header")
+}
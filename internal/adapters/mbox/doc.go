@@ -0,0 +1,29 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+/*
+Package mbox provides a repository adapter serving the commits of a patch series.
+
+Patch series are the mbox files git format-patch writes and git am applies, the
+form changes take in mailing list based projects. Each mail of a series becomes a
+commit the way git am would create it:
+
+	From 1a2b3c... Mon Sep 17 00:00:00 2001    hash of the original commit
+	From: Jane Doe <jane@example.com>          author
+	Date: Sat, 1 Mar 2025 10:00:00 +0100       author date
+	Subject: [PATCH v2 1/3] fix: handle ...    subject, without the [PATCH] prefix
+
+	Body of the commit message.                message, up to the --- line
+
+	Signed-off-by: Jane Doe <jane@example.com>
+	---
+	 src/file.go | 4 +++-                      diffstat and diff, which give the
+	 1 file changed, 3 insertions(+), 1 ...    changed paths and the commit size
+	diff --git a/src/file.go b/src/file.go
+
+Mails without a diff, such as the cover letter of a series, are skipped. The
+repository only serves the series as a whole, as the range from its base to its
+last patch, so that range rules see the commits in the order they will be applied.
+*/
+package mbox
@@ -0,0 +1,403 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package mbox
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"mime/quotedprintable"
+	"net/mail"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/itiquette/gommitlint/internal/adapters/charset"
+	"github.com/itiquette/gommitlint/internal/domain"
+)
+
+// Patch is a commit of a patch series together with the changes of its diff.
+type Patch struct {
+	Commit domain.Commit
+	Paths  []string
+	Stat   domain.DiffStat
+}
+
+// Series is the patches of an mbox file in the order they apply.
+type Series struct {
+	// Base is the commit the series applies to, from the base-commit line git
+	// format-patch --base writes, or empty when the series does not name it.
+	Base    string
+	Patches []Patch
+}
+
+var (
+	// separatorPattern matches the "From " line starting each mail of an mbox, such as
+	// "From 1a2b3c... Mon Sep 17 00:00:00 2001" written by git format-patch.
+	separatorPattern = regexp.MustCompile(`^From (\S+) +(Mon|Tue|Wed|Thu|Fri|Sat|Sun) `)
+
+	// hashPattern matches the SHA-1 and SHA-256 commit hashes of separator lines.
+	hashPattern = regexp.MustCompile(`^([0-9a-f]{40}|[0-9a-f]{64})$`)
+
+	// subjectPrefixPattern matches the prefixes git am removes from subjects, such as
+	// "[PATCH v2 1/3]" and "Re:".
+	subjectPrefixPattern = regexp.MustCompile(`^\s*(\[[^\]]*\]|(?i:re|fwd?):)\s*`)
+
+	// hunkPattern matches hunk headers and captures the line counts of both sides.
+	hunkPattern = regexp.MustCompile(`^@@ -\d+(?:,(\d+))? \+\d+(?:,(\d+))? @@`)
+
+	// baseCommitPattern matches the base-commit line of git format-patch --base.
+	baseCommitPattern = regexp.MustCompile(`^base-commit: ([0-9a-f]{40}|[0-9a-f]{64})$`)
+)
+
+// Parse reads the patches of an mbox file. Input without "From " separator lines is
+// read as a single mail.
+func Parse(reader io.Reader) (Series, error) {
+	mails, err := splitMails(reader)
+	if err != nil {
+		return Series{}, err
+	}
+
+	var series Series
+
+	for index, raw := range mails {
+		patch, base, isPatch, err := parseMail(raw.content)
+		if err != nil {
+			return Series{}, fmt.Errorf("mail %d: %w", index+1, err)
+		}
+
+		if base != "" {
+			series.Base = base
+		}
+
+		if !isPatch {
+			continue
+		}
+
+		patch.Commit.Hash = raw.hash
+		if patch.Commit.Hash == "" {
+			patch.Commit.Hash = "patch-" + strconv.Itoa(len(series.Patches)+1)
+		}
+
+		series.Patches = append(series.Patches, patch)
+	}
+
+	if len(series.Patches) == 0 {
+		return Series{}, errors.New("no patches found")
+	}
+
+	return series, nil
+}
+
+// rawMail is a mail of an mbox with the commit hash of its separator line, if any.
+type rawMail struct {
+	hash    string
+	content []byte
+}
+
+// splitMails splits an mbox at its separator lines.
+func splitMails(reader io.Reader) ([]rawMail, error) {
+	scanner := bufio.NewScanner(reader)
+	scanner.Buffer(make([]byte, 64*1024), 16*1024*1024)
+
+	var (
+		mails   []rawMail
+		current *rawMail
+	)
+
+	for scanner.Scan() {
+		line := strings.TrimSuffix(scanner.Text(), "\r")
+
+		if match := separatorPattern.FindStringSubmatch(line); match != nil {
+			mails = append(mails, rawMail{})
+			current = &mails[len(mails)-1]
+
+			// git format-patch --zero-commit writes a hash of zeros
+			if hashPattern.MatchString(match[1]) && strings.Trim(match[1], "0") != "" {
+				current.hash = match[1]
+			}
+
+			continue
+		}
+
+		if current == nil {
+			// A single mail without separator
+			mails = append(mails, rawMail{})
+			current = &mails[0]
+		}
+
+		current.content = append(current.content, line...)
+		current.content = append(current.content, '\n')
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("read mbox: %w", err)
+	}
+
+	return mails, nil
+}
+
+// parseMail converts a mail to a patch. It reports the base commit the mail names and
+// whether the mail carries a diff at all.
+func parseMail(raw []byte) (Patch, string, bool, error) {
+	message, err := mail.ReadMessage(bytes.NewReader(raw))
+	if err != nil {
+		return Patch{}, "", false, fmt.Errorf("read mail headers: %w", err)
+	}
+
+	body, err := decodeBody(message)
+	if err != nil {
+		return Patch{}, "", false, err
+	}
+
+	headers := mailHeaders{
+		from:    message.Header.Get("From"),
+		date:    message.Header.Get("Date"),
+		subject: decodeHeader(message.Header.Get("Subject")),
+	}
+
+	lines := strings.Split(charset.NormalizeLineEndings(body), "\n")
+	lines = headers.applyInBody(lines)
+
+	// The base is named by the cover letter, or by the last patch without one
+	base := ""
+
+	for _, line := range lines {
+		if match := baseCommitPattern.FindStringSubmatch(line); match != nil {
+			base = match[1]
+		}
+	}
+
+	description, rest := splitDescription(lines)
+
+	patch := Patch{}
+
+	patch.Paths, patch.Stat = parseDiff(rest)
+	if len(patch.Paths) == 0 {
+		return Patch{}, base, false, nil
+	}
+
+	subject := cleanSubject(headers.subject)
+
+	text := subject
+	if body := strings.Trim(strings.Join(description, "\n"), "\n"); body != "" {
+		text += "\n\n" + body
+	}
+
+	name, email := parseAuthor(headers.from)
+
+	patch.Commit = domain.NewCommit("", text, name, email, formatDate(headers.date), "", false)
+
+	return patch, base, true, nil
+}
+
+// mailHeaders are the headers of a mail git am takes the commit from.
+type mailHeaders struct {
+	from    string
+	date    string
+	subject string
+}
+
+// applyInBody applies the From:, Date: and Subject: lines that start a mail body, as
+// sent on behalf of another author, and returns the body without them.
+func (h *mailHeaders) applyInBody(lines []string) []string {
+	start := 0
+	for start < len(lines) && strings.TrimSpace(lines[start]) == "" {
+		start++
+	}
+
+	end := start
+
+	for ; end < len(lines); end++ {
+		name, value, found := strings.Cut(lines[end], ": ")
+		if !found {
+			break
+		}
+
+		switch name {
+		case "From":
+			h.from = value
+		case "Date":
+			h.date = value
+		case "Subject":
+			h.subject = value
+		default:
+			return lines
+		}
+	}
+
+	// In-body headers end with a blank line
+	if end == start || (end < len(lines) && strings.TrimSpace(lines[end]) != "") {
+		return lines
+	}
+
+	return lines[end:]
+}
+
+// splitDescription splits a mail body at the --- line git format-patch writes between
+// the commit message and the diffstat, or at the diff when there is no such line.
+func splitDescription(lines []string) ([]string, []string) {
+	for index, line := range lines {
+		if line == "---" {
+			return lines[:index], lines[index+1:]
+		}
+
+		if strings.HasPrefix(line, "diff --git ") || strings.HasPrefix(line, "Index: ") {
+			return lines[:index], lines[index:]
+		}
+	}
+
+	return lines, nil
+}
+
+// parseDiff returns the paths a diff changes and its diffstat. Hunks are read by the
+// line counts of their headers, so that the "-- " signature after the last hunk is
+// not taken for a deleted line.
+func parseDiff(lines []string) ([]string, domain.DiffStat) {
+	var (
+		paths         []string
+		stat          domain.DiffStat
+		oldLeft       int
+		newLeft       int
+		inHunk        bool
+		seenGitHeader bool
+	)
+
+	for _, line := range lines {
+		if inHunk && (oldLeft > 0 || newLeft > 0) {
+			switch {
+			case strings.HasPrefix(line, "+"):
+				stat.Insertions++
+				newLeft--
+			case strings.HasPrefix(line, "-"):
+				stat.Deletions++
+				oldLeft--
+			case strings.HasPrefix(line, `\`):
+				// "\ No newline at end of file"
+			default:
+				oldLeft--
+				newLeft--
+			}
+
+			continue
+		}
+
+		inHunk = false
+
+		switch {
+		case strings.HasPrefix(line, "diff --git "):
+			seenGitHeader = true
+
+			if index := strings.LastIndex(line, " b/"); index >= 0 {
+				paths = append(paths, line[index+3:])
+			}
+		case !seenGitHeader && strings.HasPrefix(line, "+++ "):
+			// Plain diffs without git headers name the file on their +++ line
+			if path := diffPath(line); path != "" {
+				paths = append(paths, path)
+			}
+		case strings.HasPrefix(line, "@@ "):
+			if match := hunkPattern.FindStringSubmatch(line); match != nil {
+				oldLeft, newLeft = hunkCount(match[1]), hunkCount(match[2])
+				inHunk = true
+			}
+		}
+	}
+
+	stat.Files = len(paths)
+
+	return paths, stat
+}
+
+// hunkCount returns a line count of a hunk header, which is 1 when left out.
+func hunkCount(count string) int {
+	if count == "" {
+		return 1
+	}
+
+	value, err := strconv.Atoi(count)
+	if err != nil {
+		return 0
+	}
+
+	return value
+}
+
+// diffPath returns the path of a +++ line without its b/ prefix and timestamp.
+func diffPath(line string) string {
+	path, _, _ := strings.Cut(strings.TrimPrefix(line, "+++ "), "\t")
+	if path == "/dev/null" {
+		return ""
+	}
+
+	return strings.TrimPrefix(path, "b/")
+}
+
+// cleanSubject removes the [PATCH] and Re: prefixes of a mail subject.
+func cleanSubject(subject string) string {
+	for {
+		cleaned := subjectPrefixPattern.ReplaceAllString(subject, "")
+		if cleaned == subject {
+			return strings.TrimSpace(subject)
+		}
+
+		subject = cleaned
+	}
+}
+
+// parseAuthor returns the name and address of a From header.
+func parseAuthor(from string) (string, string) {
+	address, err := mail.ParseAddress(from)
+	if err != nil {
+		return strings.TrimSpace(from), ""
+	}
+
+	return address.Name, address.Address
+}
+
+// formatDate formats a Date header like the commit dates of the git adapters, keeping
+// the time zone of the author.
+func formatDate(date string) string {
+	parsed, err := mail.ParseDate(date)
+	if err != nil {
+		return ""
+	}
+
+	return parsed.Format("2006-01-02T15:04:05Z")
+}
+
+// decodeHeader decodes the RFC 2047 encoded words of a header, as in subjects with
+// characters outside ASCII.
+func decodeHeader(value string) string {
+	decoded, err := new(mime.WordDecoder).DecodeHeader(value)
+	if err != nil {
+		return value
+	}
+
+	return decoded
+}
+
+// decodeBody returns the body of a mail, decoding its transfer encoding.
+func decodeBody(message *mail.Message) (string, error) {
+	var reader io.Reader = message.Body
+
+	switch strings.ToLower(strings.TrimSpace(message.Header.Get("Content-Transfer-Encoding"))) {
+	case "quoted-printable":
+		reader = quotedprintable.NewReader(reader)
+	case "base64":
+		reader = base64.NewDecoder(base64.StdEncoding, reader)
+	}
+
+	body, err := io.ReadAll(reader)
+	if err != nil {
+		return "", fmt.Errorf("read mail body: %w", err)
+	}
+
+	return string(body), nil
+}
@@ -0,0 +1,181 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package mbox_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/itiquette/gommitlint/internal/adapters/mbox"
+	"github.com/itiquette/gommitlint/internal/domain"
+)
+
+const (
+	firstHash  = "1111111111111111111111111111111111111111"
+	secondHash = "2222222222222222222222222222222222222222"
+	baseHash   = "0123456789abcdef0123456789abcdef01234567"
+)
+
+// series is a patch series as written by git format-patch --cover-letter --base.
+const series = `From 0000000000000000000000000000000000000000 Mon Sep 17 00:00:00 2001
+From: Jane Doe <jane@example.com>
+Date: Sat, 1 Mar 2025 09:00:00 +0100
+Subject: [PATCH v2 0/2] Login page
+
+*** BLURB HERE ***
+
+Jane Doe (2):
+  feat: add login page
+  fix: handle empty password
+
+base-commit: ` + baseHash + `
+--
+2.39.5
+
+From ` + firstHash + ` Mon Sep 17 00:00:00 2001
+From: Jane Doe <jane@example.com>
+Date: Sat, 1 Mar 2025 10:00:00 +0100
+Subject: [PATCH v2 1/2] feat: add login page
+
+Add a page to sign in with a password.
+
+Signed-off-by: Jane Doe <jane@example.com>
+---
+ login.go   | 4 ++++
+ main.go    | 3 ++-
+ 2 files changed, 6 insertions(+), 1 deletion(-)
+
+diff --git a/login.go b/login.go
+new file mode 100644
+--- /dev/null
++++ b/login.go
+@@ -0,0 +1,4 @@
++package main
++
++// login signs in.
++func login() {}
+diff --git a/main.go b/main.go
+--- a/main.go
++++ b/main.go
+@@ -1,3 +1,4 @@
+ package main
+
+-func main() {}
++func main() {
++}
+--
+2.39.5
+
+From ` + secondHash + ` Mon Sep 17 00:00:00 2001
+From: Maintainer <maintainer@example.com>
+Date: Sat, 1 Mar 2025 11:00:00 +0100
+Subject: =?UTF-8?q?=5BPATCH=20v2=202/2=5D=20fix=3A=20handle=20empty=20p?=
+ =?UTF-8?q?assword=20f=C3=B6r=20login?=
+Content-Type: text/plain; charset=UTF-8
+Content-Transfer-Encoding: quoted-printable
+
+From: Bj=C3=B6rn Author <bjorn@example.com>
+
+Reject empty passwords before checking them.
+
+Signed-off-by: Bj=C3=B6rn Author <bjorn@example.com>
+---
+ login.go | 1 +
+ 1 file changed, 1 insertion(+)
+
+diff --git a/login.go b/login.go
+--- a/login.go
++++ b/login.go
+@@ -4 +4,2 @@
+ func login() {}
++// TODO: check password
+--
+2.39.5
+`
+
+func TestParse(t *testing.T) {
+	parsed, err := mbox.Parse(strings.NewReader(series))
+	require.NoError(t, err)
+
+	require.Equal(t, baseHash, parsed.Base)
+	require.Len(t, parsed.Patches, 2, "the cover letter has no diff")
+
+	first := parsed.Patches[0]
+	require.Equal(t, firstHash, first.Commit.Hash)
+	require.Equal(t, "feat: add login page", first.Commit.Subject)
+	require.Equal(t, "Add a page to sign in with a password.\n\nSigned-off-by: Jane Doe <jane@example.com>", first.Commit.Body)
+	require.Equal(t, "Jane Doe", first.Commit.Author)
+	require.Equal(t, "jane@example.com", first.Commit.AuthorEmail)
+	require.Equal(t, "2025-03-01T10:00:00Z", first.Commit.CommitDate)
+	require.Equal(t, []string{"login.go", "main.go"}, first.Paths)
+	require.Equal(t, domain.DiffStat{Files: 2, Insertions: 6, Deletions: 1}, first.Stat,
+		"the signature after the last hunk is not a deleted line")
+
+	second := parsed.Patches[1]
+	require.Equal(t, "fix: handle empty password för login", second.Commit.Subject)
+	require.Equal(t, "Björn Author", second.Commit.Author, "in-body From line overrides the mail header")
+	require.Equal(t, "bjorn@example.com", second.Commit.AuthorEmail)
+	require.Equal(t, "Reject empty passwords before checking them.\n\nSigned-off-by: Björn Author <bjorn@example.com>",
+		second.Commit.Body)
+	require.Equal(t, domain.DiffStat{Files: 1, Insertions: 1}, second.Stat)
+}
+
+func TestParseSingleMail(t *testing.T) {
+	// A single patch saved from a mail client has no separator line
+	single := series[strings.Index(series, "From: Jane Doe <jane@example.com>\nDate: Sat, 1 Mar 2025 10:00"):strings.Index(series, "From "+secondHash)]
+
+	parsed, err := mbox.Parse(strings.NewReader(single))
+	require.NoError(t, err)
+	require.Len(t, parsed.Patches, 1)
+	require.Equal(t, "patch-1", parsed.Patches[0].Commit.Hash)
+	require.Equal(t, "feat: add login page", parsed.Patches[0].Commit.Subject)
+}
+
+func TestParseWithoutPatches(t *testing.T) {
+	_, err := mbox.Parse(strings.NewReader("From: Jane Doe <jane@example.com>\nSubject: Re: [PATCH] question\n\nWhy?\n"))
+	require.ErrorContains(t, err, "no patches found")
+}
+
+func TestRepository(t *testing.T) {
+	ctx := context.Background()
+
+	parsed, err := mbox.Parse(strings.NewReader(series))
+	require.NoError(t, err)
+
+	repo := mbox.NewRepository(parsed)
+	require.Equal(t, baseHash, repo.BaseHash())
+	require.Equal(t, secondHash, repo.HeadHash())
+
+	commits, err := repo.GetCommitRange(ctx, repo.BaseHash(), "HEAD")
+	require.NoError(t, err)
+	require.Len(t, commits, 2)
+	require.Equal(t, secondHash, commits[0].Hash, "newest first")
+	require.Equal(t, firstHash, commits[1].Hash)
+
+	_, err = repo.GetCommitRange(ctx, "main", "HEAD")
+	require.Error(t, err)
+
+	commit, err := repo.GetCommit(ctx, firstHash[:7])
+	require.NoError(t, err)
+	require.Equal(t, firstHash, commit.Hash)
+
+	paths, err := repo.GetChangedPaths(ctx, firstHash)
+	require.NoError(t, err)
+	require.Equal(t, []string{"login.go", "main.go"}, paths)
+
+	stat, err := repo.GetDiffStat(ctx, "HEAD")
+	require.NoError(t, err)
+	require.Equal(t, 1, stat.Lines())
+
+	ahead, err := repo.GetCommitsAheadCount(ctx, "main")
+	require.NoError(t, err)
+	require.Equal(t, 2, ahead)
+
+	_, err = repo.GetCommit(ctx, "3333333")
+	require.Error(t, err)
+}
@@ -0,0 +1,153 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package mbox
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/itiquette/gommitlint/internal/domain"
+)
+
+// Repository serves the commits of a patch series. Only the series as a whole, from
+// its base to its last patch, is available.
+type Repository struct {
+	base    string
+	patches []Patch // In the order they apply
+}
+
+// Ensure Repository implements the domain interfaces it is used through.
+var (
+	_ domain.Repository       = (*Repository)(nil)
+	_ domain.PathResolver     = (*Repository)(nil)
+	_ domain.DiffStatResolver = (*Repository)(nil)
+)
+
+// NewRepository creates a repository serving the patches of series.
+func NewRepository(series Series) *Repository {
+	return &Repository{
+		base:    series.Base,
+		patches: series.Patches,
+	}
+}
+
+// Open parses the mbox file at path, or standard input for "-".
+func Open(path string) (*Repository, error) {
+	var reader io.Reader = os.Stdin
+
+	if path != "-" {
+		file, err := os.Open(path)
+		if err != nil {
+			return nil, fmt.Errorf("open patch file: %w", err)
+		}
+		defer file.Close()
+
+		reader = file
+	}
+
+	series, err := Parse(reader)
+	if err != nil {
+		return nil, fmt.Errorf("parse patch file %s: %w", path, err)
+	}
+
+	return NewRepository(series), nil
+}
+
+// BaseHash returns the commit the series applies to, or an empty string when the
+// series does not name it.
+func (r *Repository) BaseHash() string {
+	return r.base
+}
+
+// HeadHash returns the hash of the last patch of the series.
+func (r *Repository) HeadHash() string {
+	return r.patches[len(r.patches)-1].Commit.Hash
+}
+
+// GetCommit returns a patch of the series by hash, abbreviated hash or HEAD.
+func (r *Repository) GetCommit(_ context.Context, ref string) (domain.Commit, error) {
+	patch, err := r.patch(ref)
+	if err != nil {
+		return domain.Commit{}, err
+	}
+
+	return patch.Commit, nil
+}
+
+// GetCommitRange returns the patches of the series, newest first. The range must run
+// from the base of the series to its last patch.
+func (r *Repository) GetCommitRange(_ context.Context, from, to string) ([]domain.Commit, error) {
+	if from != r.base || (to != r.HeadHash() && to != "HEAD") {
+		return nil, errors.New("only the range of the whole patch series is available")
+	}
+
+	return r.newestFirst(len(r.patches)), nil
+}
+
+// GetHeadCommits returns the last count patches of the series.
+func (r *Repository) GetHeadCommits(_ context.Context, count int) ([]domain.Commit, error) {
+	return r.newestFirst(min(count, len(r.patches))), nil
+}
+
+// GetCommitsAheadCount returns the number of patches in the series, which are the
+// commits ahead of its base once applied.
+func (r *Repository) GetCommitsAheadCount(_ context.Context, _ string) (int, error) {
+	return len(r.patches), nil
+}
+
+// GetChangedPaths returns the files changed by a patch of the series.
+func (r *Repository) GetChangedPaths(_ context.Context, ref string) ([]string, error) {
+	patch, err := r.patch(ref)
+	if err != nil {
+		return nil, err
+	}
+
+	return patch.Paths, nil
+}
+
+// GetStagedPaths is not available for a patch series, which has no index.
+func (r *Repository) GetStagedPaths(_ context.Context) ([]string, error) {
+	return nil, errors.New("a patch series has no staged files")
+}
+
+// GetDiffStat returns the diffstat of a patch of the series.
+func (r *Repository) GetDiffStat(_ context.Context, ref string) (domain.DiffStat, error) {
+	patch, err := r.patch(ref)
+	if err != nil {
+		return domain.DiffStat{}, err
+	}
+
+	return patch.Stat, nil
+}
+
+// patch finds a patch by hash, abbreviated hash or HEAD.
+func (r *Repository) patch(ref string) (Patch, error) {
+	if ref == "HEAD" {
+		ref = r.HeadHash()
+	}
+
+	for _, patch := range r.patches {
+		if patch.Commit.Hash == ref || (len(ref) >= 7 && strings.HasPrefix(patch.Commit.Hash, ref)) {
+			return patch, nil
+		}
+	}
+
+	return Patch{}, fmt.Errorf("commit %s is not part of the patch series", ref)
+}
+
+// newestFirst returns the last count patches, newest first.
+func (r *Repository) newestFirst(count int) []domain.Commit {
+	commits := make([]domain.Commit, 0, count)
+
+	for i := len(r.patches) - 1; i >= len(r.patches)-count; i-- {
+		commits = append(commits, r.patches[i].Commit)
+	}
+
+	return commits
+}
@@ -0,0 +1,227 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package output
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/itiquette/gommitlint/internal/domain"
+)
+
+// auditFormats lists the formats of signature audit reports.
+var auditFormats = []string{"text", "json", "csv", "markdown"}
+
+// SupportedAuditFormats returns the formats signature audits can be written in.
+func SupportedAuditFormats() []string {
+	return append([]string(nil), auditFormats...)
+}
+
+// AuditOptions contains the options of signature audit reports.
+type AuditOptions struct {
+	LinkTemplate string // URL of a commit with {hash}, see domain.CommitLink; used by markdown
+}
+
+// FormatSignatureAudit formats a signature audit in one of the SupportedAuditFormats.
+func FormatSignatureAudit(format string, audit domain.SignatureAudit, options AuditOptions) (string, error) {
+	switch format {
+	case "text":
+		return SignatureAuditText(audit), nil
+	case "json":
+		return SignatureAuditJSON(audit)
+	case "csv":
+		return SignatureAuditCSV(audit)
+	case "markdown":
+		return SignatureAuditMarkdown(audit, options), nil
+	default:
+		return "", fmt.Errorf("unsupported audit format '%s', supported formats: %s",
+			format, strings.Join(auditFormats, ", "))
+	}
+}
+
+// SignatureAuditJSON formats a signature audit as JSON.
+func SignatureAuditJSON(audit domain.SignatureAudit) (string, error) {
+	summary := audit.Summary()
+	commits := make([]map[string]interface{}, 0, len(audit.Entries))
+
+	for _, entry := range audit.Entries {
+		commit := map[string]interface{}{
+			"hash":          entry.Hash,
+			"subject":       entry.Subject,
+			"author":        entry.Author,
+			"committer":     entry.Committer,
+			"commitDate":    entry.Date,
+			"signed":        entry.Signed,
+			"status":        entry.Status,
+			"identityMatch": entry.IdentityMatch,
+		}
+
+		optional := map[string]string{
+			"signatureType": entry.SignatureType,
+			"fingerprint":   entry.Fingerprint,
+			"signer":        entry.Signer,
+			"statusCode":    entry.StatusCode,
+			"statusMessage": entry.StatusMessage,
+		}
+
+		for key, value := range optional {
+			if value != "" {
+				commit[key] = value
+			}
+		}
+
+		commits = append(commits, commit)
+	}
+
+	output := map[string]interface{}{
+		"range":     audit.Range,
+		"timestamp": audit.GeneratedAt.Format(time.RFC3339),
+		"summary": map[string]int{
+			"totalCommits":    summary.Total,
+			"signedCommits":   summary.Signed,
+			"verifiedCommits": summary.Verified,
+			"identityMatches": summary.IdentityMatch,
+		},
+		"commits": commits,
+	}
+
+	jsonBytes, err := json.MarshalIndent(output, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal audit: %w", err)
+	}
+
+	return string(jsonBytes) + "\n", nil
+}
+
+// SignatureAuditCSV formats a signature audit as CSV with a header row, one row per commit.
+func SignatureAuditCSV(audit domain.SignatureAudit) (string, error) {
+	var builder strings.Builder
+
+	writer := csv.NewWriter(&builder)
+	rows := [][]string{{
+		"hash", "commit_date", "author", "committer", "signed", "signature_type",
+		"fingerprint", "status", "signer", "identity_match", "status_message",
+	}}
+
+	for _, entry := range audit.Entries {
+		rows = append(rows, []string{
+			entry.Hash, entry.Date, entry.Author, entry.Committer, strconv.FormatBool(entry.Signed),
+			entry.SignatureType, entry.Fingerprint, entry.Status, entry.Signer,
+			strconv.FormatBool(entry.IdentityMatch), entry.StatusMessage,
+		})
+	}
+
+	if err := writer.WriteAll(rows); err != nil {
+		return "", fmt.Errorf("failed to write audit CSV: %w", err)
+	}
+
+	return builder.String(), nil
+}
+
+// SignatureAuditMarkdown formats a signature audit as a Markdown document, linking the
+// commits when options has a link template.
+func SignatureAuditMarkdown(audit domain.SignatureAudit, options AuditOptions) string {
+	var builder strings.Builder
+
+	summary := audit.Summary()
+
+	builder.WriteString("# Signature Audit\n\n")
+	fmt.Fprintf(&builder, "- Range: `%s`\n", audit.Range)
+	fmt.Fprintf(&builder, "- Generated: %s\n", audit.GeneratedAt.Format(time.RFC3339))
+	fmt.Fprintf(&builder, "- Signed: %d of %d commits\n", summary.Signed, summary.Total)
+	fmt.Fprintf(&builder, "- Verified: %d of %d commits\n", summary.Verified, summary.Total)
+	fmt.Fprintf(&builder, "- Signer matches committer: %d of %d commits\n\n", summary.IdentityMatch, summary.Total)
+
+	builder.WriteString("| Commit | Date | Committer | Signature | Fingerprint | Status | Signer | Identity match |\n")
+	builder.WriteString("|--------|------|-----------|-----------|-------------|--------|--------|----------------|\n")
+
+	for _, entry := range audit.Entries {
+		commit := "`" + shortHash(entry.Hash) + "`"
+		if link := domain.CommitLink(options.LinkTemplate, entry.Hash); link != "" {
+			commit = "[" + commit + "](" + link + ")"
+		}
+
+		fmt.Fprintf(&builder, "| %s | %s | %s | %s | %s | %s | %s | %s |\n",
+			commit, entry.Date, markdownCell(entry.Committer), markdownCell(entry.SignatureType),
+			markdownCode(entry.Fingerprint), markdownCell(auditStatus(entry)), markdownCell(entry.Signer),
+			yesNo(entry.IdentityMatch))
+	}
+
+	return builder.String()
+}
+
+// SignatureAuditText formats a signature audit as an aligned table with a summary line.
+func SignatureAuditText(audit domain.SignatureAudit) string {
+	var builder strings.Builder
+
+	summary := audit.Summary()
+	table := tabwriter.NewWriter(&builder, 0, 0, 2, ' ', 0)
+
+	fmt.Fprintln(table, "COMMIT\tSTATUS\tTYPE\tFINGERPRINT\tSIGNER\tIDENTITY MATCH")
+
+	for _, entry := range audit.Entries {
+		fmt.Fprintf(table, "%s\t%s\t%s\t%s\t%s\t%s\n", shortHash(entry.Hash), auditStatus(entry),
+			dash(entry.SignatureType), dash(entry.Fingerprint), dash(entry.Signer), yesNo(entry.IdentityMatch))
+	}
+
+	_ = table.Flush()
+
+	fmt.Fprintf(&builder, "\n%d commits: %d signed, %d verified, %d signed by the committer\n",
+		summary.Total, summary.Signed, summary.Verified, summary.IdentityMatch)
+
+	return builder.String()
+}
+
+// auditStatus returns the status of an entry, detailed by its status code when there is one.
+func auditStatus(entry domain.SignatureAuditEntry) string {
+	if entry.StatusCode == "" {
+		return entry.Status
+	}
+
+	return entry.Status + " (" + entry.StatusCode + ")"
+}
+
+// shortHash abbreviates a hash to twelve characters for the reports kept or read by other
+// tools, where it must stay unique across large histories.
+func shortHash(hash string) string {
+	if len(hash) > 12 {
+		return hash[:12]
+	}
+
+	return hash
+}
+
+func markdownCell(value string) string {
+	return strings.ReplaceAll(value, "|", `\|`)
+}
+
+func markdownCode(value string) string {
+	if value == "" {
+		return ""
+	}
+
+	return "`" + value + "`"
+}
+
+func dash(value string) string {
+	if value == "" {
+		return "-"
+	}
+
+	return value
+}
+
+func yesNo(value bool) string {
+	if value {
+		return "yes"
+	}
+
+	return "no"
+}
@@ -0,0 +1,119 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package output
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/itiquette/gommitlint/internal/domain"
+)
+
+func testSignatureAudit() domain.SignatureAudit {
+	return domain.SignatureAudit{
+		Range:       "v1.0.0..HEAD",
+		GeneratedAt: time.Date(2025, 6, 14, 10, 0, 0, 0, time.UTC),
+		Entries: []domain.SignatureAuditEntry{
+			{
+				Hash:          "0123456789abcdef0123456789abcdef01234567",
+				Subject:       "feat: add audit",
+				Author:        "Dev <dev@example.com>",
+				Committer:     "Dev <dev@example.com>",
+				Date:          "2025-06-14T09:00:00Z",
+				Signed:        true,
+				SignatureType: "ssh",
+				Fingerprint:   "SHA256:abc",
+				Status:        "verified",
+				Signer:        "Dev <dev@example.com>",
+				IdentityMatch: true,
+			},
+			{
+				Hash:      "fedcba9876543210fedcba9876543210fedcba98",
+				Subject:   "fix: handle | in subjects",
+				Author:    "Other <other@example.com>",
+				Committer: "Other <other@example.com>",
+				Date:      "2025-06-13T09:00:00Z",
+				Status:    domain.SignatureAuditStatusUnsigned,
+			},
+		},
+	}
+}
+
+func TestSignatureAuditJSON(t *testing.T) {
+	result, err := SignatureAuditJSON(testSignatureAudit())
+	require.NoError(t, err)
+
+	var parsed map[string]interface{}
+	require.NoError(t, json.Unmarshal([]byte(result), &parsed))
+
+	require.Equal(t, "v1.0.0..HEAD", parsed["range"])
+	require.Equal(t, "2025-06-14T10:00:00Z", parsed["timestamp"])
+	require.Equal(t, map[string]interface{}{
+		"totalCommits": 2.0, "signedCommits": 1.0, "verifiedCommits": 1.0, "identityMatches": 1.0,
+	}, parsed["summary"])
+
+	commits, ok := parsed["commits"].([]interface{})
+	require.True(t, ok)
+	require.Len(t, commits, 2)
+
+	signed, ok := commits[0].(map[string]interface{})
+	require.True(t, ok)
+	require.Equal(t, "SHA256:abc", signed["fingerprint"])
+	require.Equal(t, true, signed["identityMatch"])
+
+	unsigned, ok := commits[1].(map[string]interface{})
+	require.True(t, ok)
+	require.Equal(t, "unsigned", unsigned["status"])
+	require.NotContains(t, unsigned, "fingerprint")
+}
+
+func TestSignatureAuditCSV(t *testing.T) {
+	result, err := SignatureAuditCSV(testSignatureAudit())
+	require.NoError(t, err)
+
+	records, err := csv.NewReader(strings.NewReader(result)).ReadAll()
+	require.NoError(t, err)
+	require.Len(t, records, 3)
+	require.Equal(t, "hash", records[0][0])
+	require.Equal(t, []string{
+		"0123456789abcdef0123456789abcdef01234567", "2025-06-14T09:00:00Z", "Dev <dev@example.com>",
+		"Dev <dev@example.com>", "true", "ssh", "SHA256:abc", "verified", "Dev <dev@example.com>", "true", "",
+	}, records[1])
+	require.Equal(t, "unsigned", records[2][7])
+}
+
+func TestSignatureAuditMarkdown(t *testing.T) {
+	result := SignatureAuditMarkdown(testSignatureAudit(), AuditOptions{})
+
+	require.Contains(t, result, "- Range: `v1.0.0..HEAD`")
+	require.Contains(t, result, "- Signed: 1 of 2 commits")
+	require.Contains(t, result, "| `0123456789ab` | 2025-06-14T09:00:00Z | Dev <dev@example.com> | ssh | `SHA256:abc` | verified | Dev <dev@example.com> | yes |")
+	require.Contains(t, result, "| `fedcba987654` | 2025-06-13T09:00:00Z | Other <other@example.com> |  |  | unsigned |  | no |")
+}
+
+func TestSignatureAuditMarkdown_CommitLinks(t *testing.T) {
+	result := SignatureAuditMarkdown(testSignatureAudit(),
+		AuditOptions{LinkTemplate: "https://codeberg.org/org/repo/commit/{hash}"})
+
+	require.Contains(t, result, "| [`0123456789ab`](https://codeberg.org/org/repo/commit/0123456789abcdef0123456789abcdef01234567) | 2025-06-14T09:00:00Z |")
+}
+
+func TestFormatSignatureAudit(t *testing.T) {
+	for _, format := range SupportedAuditFormats() {
+		t.Run(format, func(t *testing.T) {
+			result, err := FormatSignatureAudit(format, testSignatureAudit(), AuditOptions{})
+			require.NoError(t, err)
+			require.Contains(t, result, "0123456789ab")
+		})
+	}
+
+	_, err := FormatSignatureAudit("github", testSignatureAudit(), AuditOptions{})
+	require.ErrorContains(t, err, "unsupported audit format")
+}
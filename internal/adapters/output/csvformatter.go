@@ -0,0 +1,56 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package output
+
+import (
+	"strings"
+
+	"github.com/itiquette/gommitlint/internal/domain"
+)
+
+// failureColumns is the header row of the CSV and TSV formats.
+var failureColumns = []string{"commit", "author", "date", "rule", "code", "severity", "message"}
+
+// CSV formats the failures of a report as CSV with a header row, one row per failure,
+// for importing results into spreadsheets. Repository failures have an empty commit.
+func CSV(report domain.Report) string {
+	return failureTable(report, ',')
+}
+
+// TSV formats the failures of a report like CSV, separated by tabs.
+func TSV(report domain.Report) string {
+	return failureTable(report, '\t')
+}
+
+// failureTable writes one row per failure and warning of report, separated by comma.
+func failureTable(report domain.Report, comma rune) string {
+	var builder strings.Builder
+
+	if err := newTableStream(&builder, comma).Close(report); err != nil {
+		return "error: failed to write failures: " + err.Error() + "\n"
+	}
+
+	return builder.String()
+}
+
+// appendFailureRows appends a row for each failure and warning of ruleResults.
+func appendFailureRows(rows [][]string, ruleResults []domain.RuleReport, hash, author, date string) [][]string {
+	for _, ruleReport := range ruleResults {
+		if ruleReport.Status != domain.StatusFailed && ruleReport.Status != domain.StatusWarning {
+			continue
+		}
+
+		for _, err := range ruleReport.Errors {
+			severity := string(err.Severity)
+			if severity == "" {
+				severity = string(domain.SeverityError)
+			}
+
+			rows = append(rows, []string{hash, author, date, ruleReport.Name, err.Code, severity, err.Message})
+		}
+	}
+
+	return rows
+}
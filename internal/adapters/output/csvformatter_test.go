@@ -0,0 +1,83 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package output
+
+import (
+	"encoding/csv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/itiquette/gommitlint/internal/domain"
+)
+
+func TestFailureTable(t *testing.T) {
+	report := domain.Report{
+		Commits: []domain.CommitReport{
+			{
+				Commit: domain.Commit{Hash: "abc123", Author: "Ada Lovelace", CommitDate: "2025-06-01T12:00:00Z"},
+				RuleResults: []domain.RuleReport{
+					{
+						Name:   "Subject",
+						Status: domain.StatusFailed,
+						Errors: []domain.ValidationError{
+							domain.New("Subject", domain.ErrSubjectTooLong, "Subject too long, \"by\" 8\tcharacters"),
+						},
+					},
+					{
+						Name:   "Spell",
+						Status: domain.StatusWarning,
+						Errors: []domain.ValidationError{
+							domain.New("Spell", domain.ErrMisspelledWord, "Misspelled word").WithSeverity(domain.SeverityWarning),
+						},
+					},
+					{Name: "SignOff", Status: domain.StatusPassed},
+				},
+			},
+			{Commit: domain.Commit{Hash: "def456", Author: "Grace Hopper"}, Passed: true},
+		},
+		Repository: domain.RepositoryReport{
+			RuleResults: []domain.RuleReport{
+				{
+					Name:   "BranchAhead",
+					Status: domain.StatusFailed,
+					Errors: []domain.ValidationError{domain.New("BranchAhead", domain.ErrTooManyCommits, "Too many commits")},
+				},
+			},
+		},
+	}
+
+	expected := [][]string{
+		{"commit", "author", "date", "rule", "code", "severity", "message"},
+		{"abc123", "Ada Lovelace", "2025-06-01T12:00:00Z", "Subject", "subject_too_long", "error", "Subject too long, \"by\" 8\tcharacters"},
+		{"abc123", "Ada Lovelace", "2025-06-01T12:00:00Z", "Spell", "misspelled_word", "warning", "Misspelled word"},
+		{"", "", "", "BranchAhead", "too_many_commits", "error", "Too many commits"},
+	}
+
+	tests := []struct {
+		name   string
+		format func(domain.Report) string
+		comma  rune
+	}{
+		{name: "csv", format: CSV, comma: ','},
+		{name: "tsv", format: TSV, comma: '\t'},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			reader := csv.NewReader(strings.NewReader(testCase.format(report)))
+			reader.Comma = testCase.comma
+
+			records, err := reader.ReadAll()
+			require.NoError(t, err)
+			require.Equal(t, expected, records)
+		})
+	}
+}
+
+func TestFailureTable_NoFailures(t *testing.T) {
+	require.Equal(t, "commit,author,date,rule,code,severity,message\n", CSV(domain.Report{}))
+}
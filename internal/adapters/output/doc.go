@@ -8,7 +8,7 @@ Package output provides output formatting adapters for gommitlint.
 This package implements the outgoing port for formatting validation
 results, following hexagonal architecture principles. It provides:
 
-  - Multiple output format support (text, JSON, GitHub, GitLab)
+  - Multiple output format support (text, JSON, GitHub, GitLab, HTML, CSV)
   - Configurable formatting options
   - Color and symbol customization
   - CI/CD-specific output formats
@@ -22,6 +22,11 @@ Key components:
   - json.go: JSON formatter for machine-readable output
   - github.go: GitHub Actions-specific formatter
   - gitlab.go: GitLab CI-specific formatter
+  - htmlformatter.go: Self-contained HTML report with filters
+  - csvformatter.go: One row per failure as CSV or TSV
+  - auditformatter.go: Signature audit reports as text, JSON, CSV and Markdown
+  - explainformatter.go: Rule documentation for the explain command
+  - scoreformatter.go: Message scores and ranked improvements
 
 Each formatter implements the domain.ResultFormatter interface,
 allowing the domain to remain independent of presentation concerns.
@@ -0,0 +1,127 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package output
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/itiquette/gommitlint/internal/domain"
+)
+
+// RuleExplanationText formats the documentation of a rule for the terminal.
+func RuleExplanationText(metadata domain.RuleMetadata) string {
+	var builder strings.Builder
+
+	fmt.Fprintf(&builder, "%s (%s)\n", metadata.Name, metadata.ID)
+	fmt.Fprintf(&builder, "%s\n\n", metadata.Summary)
+
+	for _, line := range wrapTextWithIndent(metadata.Description) {
+		builder.WriteString(line + "\n")
+	}
+
+	fmt.Fprintf(&builder, "\nSeverity: %s\n", metadata.Severity)
+
+	if metadata.Category != "" {
+		fmt.Fprintf(&builder, "Category: %s\n", metadata.Category)
+	}
+
+	builder.WriteString("\nError codes:\n")
+
+	for _, code := range metadata.ErrorCodes {
+		fmt.Fprintf(&builder, "  %s\n", code)
+	}
+
+	if len(metadata.ConfigKeys) > 0 {
+		builder.WriteString("\nConfiguration:\n")
+
+		for _, key := range metadata.ConfigKeys {
+			fmt.Fprintf(&builder, "  %s\n", key)
+		}
+	}
+
+	if len(metadata.Examples) > 0 {
+		builder.WriteString("\nExamples:\n")
+
+		for _, example := range metadata.Examples {
+			symbol := "✗"
+			if example.Valid {
+				symbol = "✓"
+			}
+
+			lines := strings.Split(example.Message, "\n")
+			fmt.Fprintf(&builder, "  %s %s\n", symbol, lines[0])
+
+			for _, line := range lines[1:] {
+				if line == "" {
+					builder.WriteString("\n")
+
+					continue
+				}
+
+				fmt.Fprintf(&builder, "    %s\n", line)
+			}
+
+			if example.Note != "" {
+				fmt.Fprintf(&builder, "    (%s)\n", example.Note)
+			}
+		}
+	}
+
+	return builder.String()
+}
+
+// RuleExplanationJSON formats the documentation of a rule as JSON.
+func RuleExplanationJSON(metadata domain.RuleMetadata) (string, error) {
+	examples := make([]map[string]interface{}, 0, len(metadata.Examples))
+	for _, example := range metadata.Examples {
+		examples = append(examples, map[string]interface{}{
+			"message": example.Message,
+			"valid":   example.Valid,
+			"note":    example.Note,
+		})
+	}
+
+	configKeys := metadata.ConfigKeys
+	if configKeys == nil {
+		configKeys = []string{}
+	}
+
+	output := map[string]interface{}{
+		"id":          metadata.ID,
+		"name":        metadata.Name,
+		"category":    metadata.Category,
+		"summary":     metadata.Summary,
+		"description": metadata.Description,
+		"severity":    metadata.Severity,
+		"errorCodes":  metadata.ErrorCodes,
+		"configKeys":  configKeys,
+		"examples":    examples,
+	}
+
+	jsonBytes, err := json.MarshalIndent(output, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal rule explanation: %w", err)
+	}
+
+	return string(jsonBytes) + "\n", nil
+}
+
+// RuleIndexText lists rules with their summaries, for choosing one to explain.
+func RuleIndexText(metadata []domain.RuleMetadata) string {
+	var builder strings.Builder
+
+	width := 0
+	for _, rule := range metadata {
+		width = max(width, len(rule.ID))
+	}
+
+	for _, rule := range metadata {
+		fmt.Fprintf(&builder, "  %-*s  %s\n", width, rule.ID, rule.Summary)
+	}
+
+	return builder.String()
+}
@@ -0,0 +1,80 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package output
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/itiquette/gommitlint/internal/domain"
+)
+
+func testRuleMetadata() domain.RuleMetadata {
+	return domain.RuleMetadata{
+		ID:          "signoff",
+		Name:        "SignOff",
+		Category:    domain.CategorySignature,
+		Summary:     "Developer Certificate of Origin sign-off",
+		Description: "Requires Signed-off-by lines.",
+		Severity:    domain.SeverityError,
+		ErrorCodes:  []domain.ValidationErrorCode{domain.ErrMissingSignoff},
+		ConfigKeys:  []string{"message.body.min_signoff_count"},
+		Examples: []domain.RuleExample{
+			{Message: "fix: handle empty input\n\nSigned-off-by: Dev <dev@example.com>", Valid: true},
+			{Message: "fix: handle empty input", Note: "no sign-off"},
+		},
+	}
+}
+
+func TestRuleExplanationText(t *testing.T) {
+	expected := `SignOff (signoff)
+Developer Certificate of Origin sign-off
+
+Requires Signed-off-by lines.
+
+Severity: error
+Category: signature
+
+Error codes:
+  missing_signoff
+
+Configuration:
+  message.body.min_signoff_count
+
+Examples:
+  ✓ fix: handle empty input
+
+    Signed-off-by: Dev <dev@example.com>
+  ✗ fix: handle empty input
+    (no sign-off)
+`
+
+	require.Equal(t, expected, RuleExplanationText(testRuleMetadata()))
+}
+
+func TestRuleExplanationJSON(t *testing.T) {
+	result, err := RuleExplanationJSON(testRuleMetadata())
+	require.NoError(t, err)
+
+	var parsed map[string]interface{}
+	require.NoError(t, json.Unmarshal([]byte(result), &parsed))
+
+	require.Equal(t, "signoff", parsed["id"])
+	require.Equal(t, "signature", parsed["category"])
+	require.Equal(t, []interface{}{"missing_signoff"}, parsed["errorCodes"])
+	require.Equal(t, []interface{}{"message.body.min_signoff_count"}, parsed["configKeys"])
+	require.Len(t, parsed["examples"], 2)
+}
+
+func TestRuleIndexText(t *testing.T) {
+	result := RuleIndexText([]domain.RuleMetadata{
+		{ID: "signoff", Summary: "Sign-off"},
+		{ID: "linearhistory", Summary: "Linear history"},
+	})
+
+	require.Equal(t, "  signoff        Sign-off\n  linearhistory  Linear history\n", result)
+}
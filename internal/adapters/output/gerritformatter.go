@@ -0,0 +1,131 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package output
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/itiquette/gommitlint/internal/domain"
+)
+
+// GerritCheckName names the check runs of gommitlint among the checks of a change.
+const GerritCheckName = "gommitlint"
+
+// gerritFetchResponse is the FetchResponse a checks provider of the Gerrit Checks API
+// returns for a change.
+type gerritFetchResponse struct {
+	ResponseCode string           `json:"responseCode"`
+	Runs         []gerritCheckRun `json:"runs"`
+}
+
+// gerritCheckRun is a CheckRun of the Gerrit Checks API.
+type gerritCheckRun struct {
+	ExternalID       string              `json:"externalId"`
+	CheckName        string              `json:"checkName"`
+	CheckDescription string              `json:"checkDescription"`
+	Status           string              `json:"status"`
+	Results          []gerritCheckResult `json:"results"`
+}
+
+// gerritCheckResult is a CheckResult of the Gerrit Checks API.
+type gerritCheckResult struct {
+	Category string           `json:"category"`
+	Summary  string           `json:"summary"`
+	Message  string           `json:"message,omitempty"`
+	Tags     []gerritCheckTag `json:"tags,omitempty"`
+}
+
+// gerritCheckTag is a tag of a CheckResult, naming the rule that reported it or the
+// error code.
+type gerritCheckTag struct {
+	Name string `json:"name"`
+}
+
+// Gerrit formats a report as the FetchResponse of a Gerrit Checks API provider, with a
+// completed run for each commit, which in Gerrit are the patch sets of changes. Failed
+// rules are ERROR results and warnings WARNING results, one for each error, and a run
+// without either has a single SUCCESS result. Repository rule results have no patch set
+// and are left out.
+func Gerrit(report domain.Report) string {
+	response := gerritFetchResponse{ResponseCode: "OK", Runs: make([]gerritCheckRun, 0, len(report.Commits))}
+
+	for _, commitReport := range report.Commits {
+		if commitReport.Commit.Hash == "" {
+			continue
+		}
+
+		response.Runs = append(response.Runs, gerritCheckRun{
+			ExternalID:       commitReport.Commit.Hash,
+			CheckName:        GerritCheckName,
+			CheckDescription: "Commit message lint of " + shortHash(commitReport.Commit.Hash),
+			Status:           "COMPLETED",
+			Results:          newGerritCheckResults(commitReport),
+		})
+	}
+
+	jsonBytes, err := json.MarshalIndent(response, "", "  ")
+	if err != nil {
+		return `{"error": "failed to marshal JSON"}` + "\n"
+	}
+
+	return string(jsonBytes) + "\n"
+}
+
+// newGerritCheckResults returns the results of a commit: an ERROR or WARNING result for
+// each error of a failed or warning rule, an INFO result for a skipped commit, or a
+// SUCCESS result when all rules passed.
+func newGerritCheckResults(commitReport domain.CommitReport) []gerritCheckResult {
+	if commitReport.SkipReason != "" {
+		return []gerritCheckResult{{Category: "INFO", Summary: "Skipped: " + commitReport.SkipReason}}
+	}
+
+	var results []gerritCheckResult
+
+	passed := 0
+
+	for _, ruleReport := range commitReport.RuleResults {
+		category := ""
+
+		switch ruleReport.Status {
+		case domain.StatusFailed:
+			category = "ERROR"
+		case domain.StatusWarning:
+			category = "WARNING"
+		case domain.StatusPassed:
+			passed++
+
+			continue
+		default:
+			continue
+		}
+
+		for _, ruleErr := range ruleReport.Errors {
+			results = append(results, gerritCheckResult{
+				Category: category,
+				Summary:  ruleReport.Name + ": " + ruleErr.Message,
+				Message:  ruleErr.Help,
+				Tags:     gerritTags(ruleReport.Name, ruleErr),
+			})
+		}
+	}
+
+	if len(results) == 0 {
+		results = append(results, gerritCheckResult{Category: "SUCCESS", Summary: fmt.Sprintf("All %d rules passed", passed)})
+	}
+
+	return results
+}
+
+// gerritTags returns the tags of the result of err: the rule and, when err has one,
+// the error code.
+func gerritTags(ruleName string, err domain.ValidationError) []gerritCheckTag {
+	tags := []gerritCheckTag{{Name: ruleName}}
+	if err.Code != "" {
+		tags = append(tags, gerritCheckTag{Name: err.Code})
+	}
+
+	return tags
+}
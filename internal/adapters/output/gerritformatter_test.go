@@ -0,0 +1,94 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package output
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/itiquette/gommitlint/internal/domain"
+)
+
+func TestGerrit(t *testing.T) {
+	report := domain.Report{
+		Commits: []domain.CommitReport{
+			{
+				Commit: domain.Commit{Hash: "0123456789abcdef"},
+				RuleResults: []domain.RuleReport{
+					{
+						Name:   "ChangeId",
+						Status: domain.StatusFailed,
+						Errors: []domain.ValidationError{{
+							Code:    string(domain.ErrMissingChangeID),
+							Message: "Commit message has no Change-Id trailer",
+							Help:    "Install the commit-msg hook of Gerrit",
+						}},
+					},
+					{
+						Name:   "CommitSize",
+						Status: domain.StatusWarning,
+						Errors: []domain.ValidationError{{Message: "Commit changes 600 lines"}},
+					},
+					{Name: "Subject", Status: domain.StatusPassed},
+				},
+			},
+			{
+				Commit: domain.Commit{Hash: "fedcba9876543210"},
+				RuleResults: []domain.RuleReport{
+					{Name: "Subject", Status: domain.StatusPassed},
+					{Name: "ChangeId", Status: domain.StatusPassed},
+					{Name: "Spell", Status: domain.StatusNotApplicable},
+				},
+				Passed: true,
+			},
+			{
+				Commit:     domain.Commit{Hash: "1111111111111111"},
+				Passed:     true,
+				SkipReason: domain.SkipReasonMergeCommit,
+			},
+		},
+		Repository: domain.RepositoryReport{
+			RuleResults: []domain.RuleReport{{Name: "BranchAhead", Status: domain.StatusFailed}},
+		},
+	}
+
+	require.JSONEq(t, `{
+		"responseCode": "OK",
+		"runs": [
+			{
+				"externalId": "0123456789abcdef",
+				"checkName": "gommitlint",
+				"checkDescription": "Commit message lint of 0123456789ab",
+				"status": "COMPLETED",
+				"results": [
+					{
+						"category": "ERROR",
+						"summary": "ChangeId: Commit message has no Change-Id trailer",
+						"message": "Install the commit-msg hook of Gerrit",
+						"tags": [{"name": "ChangeId"}, {"name": "missing_change_id"}]
+					},
+					{"category": "WARNING", "summary": "CommitSize: Commit changes 600 lines", "tags": [{"name": "CommitSize"}]}
+				]
+			},
+			{
+				"externalId": "fedcba9876543210",
+				"checkName": "gommitlint",
+				"checkDescription": "Commit message lint of fedcba987654",
+				"status": "COMPLETED",
+				"results": [{"category": "SUCCESS", "summary": "All 2 rules passed"}]
+			},
+			{
+				"externalId": "1111111111111111",
+				"checkName": "gommitlint",
+				"checkDescription": "Commit message lint of 111111111111",
+				"status": "COMPLETED",
+				"results": [{"category": "INFO", "summary": "Skipped: merge commit"}]
+			}
+		]
+	}`, Gerrit(report))
+
+	require.JSONEq(t, `{"responseCode": "OK", "runs": []}`, Gerrit(domain.Report{}))
+}
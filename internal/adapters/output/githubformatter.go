@@ -5,6 +5,7 @@
 package output
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 
@@ -18,7 +19,7 @@ func GitHub(report domain.Report) string {
 	// GitHub Actions group for summary
 	builder.WriteString("::group::Summary\n")
 	builder.WriteString(fmt.Sprintf("Validated %d commits\n", report.Summary.TotalCommits))
-	builder.WriteString(fmt.Sprintf("Passed: %d, Failed: %d\n", report.Summary.PassedCommits, report.Summary.FailedCommits))
+	builder.WriteString(ciSummaryCounts(report.Summary))
 	builder.WriteString("::endgroup::\n")
 
 	// Format each commit in its own group
@@ -35,15 +36,19 @@ func GitHub(report domain.Report) string {
 	}
 
 	// Format repository-level results
-	if len(report.Repository.RuleResults) > 0 {
+	if report.Repository.HasDetails() {
 		builder.WriteString("::group::Repository Validation\n")
+		writeRepositoryDetails(&builder, report.Repository)
 
 		for _, repoResult := range report.Repository.RuleResults {
-			if repoResult.Status == domain.StatusFailed {
-				for _, err := range repoResult.Errors {
-					builder.WriteString(fmt.Sprintf("::error title=%s::%s\n",
-						repoResult.Name, err.Message))
+			for _, err := range repoResult.Errors {
+				command := gitHubCommand(repoResult.Status, err)
+				if command == "" {
+					continue
 				}
+
+				builder.WriteString(fmt.Sprintf("::%s title=%s::%s\n",
+					command, escapeGitHubProperty(withCode(repoResult.Name, err)), gitHubMessage(err)))
 			}
 		}
 
@@ -61,16 +66,28 @@ func GitHub(report domain.Report) string {
 }
 
 func writeGitHubRules(builder *strings.Builder, commitReport domain.CommitReport) {
+	if commitReport.SkipReason != "" {
+		builder.WriteString("⏭ Skipped: " + commitReport.SkipReason + "\n")
+
+		return
+	}
+
 	failedCount := 0
 
 	for _, ruleReport := range commitReport.RuleResults {
 		if ruleReport.Status == domain.StatusFailed {
 			failedCount++
+		}
 
-			for _, err := range ruleReport.Errors {
-				builder.WriteString(fmt.Sprintf("::error file=%s,line=1,title=%s::%s\n",
-					commitReport.Commit.Hash, ruleReport.Name, err.Message))
+		for _, err := range ruleReport.Errors {
+			command := gitHubCommand(ruleReport.Status, err)
+			if command == "" {
+				continue
 			}
+
+			builder.WriteString(fmt.Sprintf("::%s file=%s,%s,title=%s::%s\n",
+				command, escapeGitHubProperty(commitReport.Commit.Hash), gitHubLocation(err),
+				escapeGitHubProperty(withCode(ruleReport.Name, err)), gitHubMessage(err)))
 		}
 	}
 
@@ -80,3 +97,110 @@ func writeGitHubRules(builder *strings.Builder, commitReport domain.CommitReport
 		builder.WriteString(fmt.Sprintf("❌ %d rules failed\n", failedCount))
 	}
 }
+
+// gitHubCommand returns the workflow command annotating err: "error" for failures that
+// fail validation and "notice" for warnings and informational findings, which GitHub
+// shows without marking the check as failed. Errors of passed rules are not annotated.
+func gitHubCommand(status domain.ValidationStatus, err domain.ValidationError) string {
+	switch {
+	case status == domain.StatusFailed && err.IsBlocking():
+		return "error"
+	case status == domain.StatusFailed, status == domain.StatusWarning:
+		return "notice"
+	default:
+		return ""
+	}
+}
+
+// gitHubLocation returns the line and, when the failure has a position, the column
+// parameters of an annotation. Failures without a position point at the subject.
+func gitHubLocation(err domain.ValidationError) string {
+	if position, ok := err.Position(); ok {
+		return fmt.Sprintf("line=%d,col=%d", position.Line, position.Column)
+	}
+
+	return "line=1"
+}
+
+// withCode returns the name of a rule followed by the code of err in brackets, such as
+// "Subject [subject_too_long]", for reports that have no field of their own for codes.
+func withCode(ruleName string, err domain.ValidationError) string {
+	if err.Code == "" {
+		return ruleName
+	}
+
+	return ruleName + " [" + err.Code + "]"
+}
+
+// gitHubMessage returns the escaped annotation message of err. A suggestion is shown as
+// a suggested change on a line of its own.
+func gitHubMessage(err domain.ValidationError) string {
+	message := escapeGitHubData(err.Message)
+
+	if suggestion := err.Suggestion(); suggestion != "" {
+		message += "%0ASuggested change: " + escapeGitHubData(suggestion)
+	}
+
+	return message
+}
+
+// escapeGitHubData escapes the message of a workflow command, where "%" starts an
+// escape and a newline would end the command.
+func escapeGitHubData(value string) string {
+	return strings.NewReplacer("%", "%25", "\r", "%0D", "\n", "%0A").Replace(value)
+}
+
+// escapeGitHubProperty escapes a workflow command parameter, which additionally may
+// not contain the ":" and "," separating parameters.
+func escapeGitHubProperty(value string) string {
+	return strings.NewReplacer(":", "%3A", ",", "%2C").Replace(escapeGitHubData(value))
+}
+
+// problemMatcher is a GitHub Actions problem matcher, which turns matching lines of the
+// log into annotations.
+type problemMatcher struct {
+	Owner    string           `json:"owner"`
+	Severity string           `json:"severity"`
+	Pattern  []matcherPattern `json:"pattern"`
+}
+
+// matcherPattern matches one log line; the numbers name the capture groups holding the
+// rule and the message.
+type matcherPattern struct {
+	Regexp  string `json:"regexp"`
+	Code    int    `json:"code,omitempty"`
+	Message int    `json:"message,omitempty"`
+	Loop    bool   `json:"loop,omitempty"`
+}
+
+// GitHubProblemMatcher returns a problem matcher for the default text output, for jobs
+// that print text rather than using the github format. A rule with a single failure is
+// printed as "✗ Rule: message"; with several, the messages follow "✗ Rule:" on lines of
+// their own. Warnings use "⚠" instead of "✗".
+func GitHubProblemMatcher() string {
+	var matchers []problemMatcher
+
+	for _, level := range []struct{ owner, severity, symbol string }{
+		{"gommitlint", "error", "✗"},
+		{"gommitlint-warning", "warning", "⚠"},
+	} {
+		matchers = append(matchers,
+			problemMatcher{
+				Owner:    level.owner,
+				Severity: level.severity,
+				Pattern:  []matcherPattern{{Regexp: "^" + level.symbol + ` (\S+): (.+)$`, Code: 1, Message: 2}},
+			},
+			problemMatcher{
+				Owner:    level.owner + "-multiple",
+				Severity: level.severity,
+				Pattern: []matcherPattern{
+					{Regexp: "^" + level.symbol + ` (\S+):$`, Code: 1},
+					{Regexp: `^(\S.*)$`, Message: 1, Loop: true},
+				},
+			})
+	}
+
+	matcherJSON, _ := json.MarshalIndent(map[string][]problemMatcher{"problemMatcher": matchers}, "", "  ")
+
+	return string(matcherJSON) + "\n"
+}
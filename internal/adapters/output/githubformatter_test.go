@@ -5,6 +5,9 @@
 package output
 
 import (
+	"encoding/json"
+	"regexp"
+	"slices"
 	"strings"
 	"testing"
 	"time"
@@ -98,9 +101,9 @@ func TestGitHub_ValidReport(t *testing.T) {
 	require.Contains(t, result, "Subject: feat: add new feature", "should show commit subject")
 
 	// Check error annotations
-	require.Contains(t, result, "::error file=abc1234,line=1,title=TestRule::Test validation error",
+	require.Contains(t, result, "::error file=abc1234,line=1,title=TestRule [test_error]::Test validation error",
 		"should contain error annotation for first error")
-	require.Contains(t, result, "::error file=abc1234,line=1,title=TestRule::Another test error",
+	require.Contains(t, result, "::error file=abc1234,line=1,title=TestRule [another_error]::Another test error",
 		"should contain error annotation for second error")
 
 	// Check failure indication
@@ -247,15 +250,25 @@ func TestGitHub_WithRepositoryResults(t *testing.T) {
 	require.Contains(t, result, "::group::Repository Validation", "should contain repository group")
 
 	// Check repository error annotations
-	require.Contains(t, result, "::error title=BranchRule::Branch validation failed",
+	require.Contains(t, result, "::error title=BranchRule [branch_error]::Branch validation failed",
 		"should contain repository error annotation")
-	require.Contains(t, result, "::error title=RepoRule::Repository check failed",
+	require.Contains(t, result, "::error title=RepoRule [repo_error]::Repository check failed",
 		"should contain second repository error annotation")
 
 	// Should fail overall
 	require.Contains(t, result, "::set-output name=passed::false", "should fail with repository errors")
 }
 
+func TestGitHub_RepositoryDetails(t *testing.T) {
+	report := domain.Report{
+		Repository: domain.RepositoryReport{Name: "itiquette/gommitlint", Branch: "feature/login", Range: "abc1234..def5678"},
+	}
+
+	require.Contains(t, GitHub(report),
+		"::group::Repository Validation\nRepository: itiquette/gommitlint\nBranch: feature/login\nRange: abc1234..def5678\n::endgroup::\n")
+	require.NotContains(t, GitHub(domain.Report{}), "Repository Validation")
+}
+
 func TestGitHub_MultipleCommits(t *testing.T) {
 	// Test with multiple commits
 	commits := []domain.CommitReport{
@@ -466,10 +479,102 @@ func TestGitHub_SpecialCharacters(t *testing.T) {
 	// Should handle special characters without breaking format
 	require.Contains(t, result, "Subject: feat: add \"quotes\" and <brackets>",
 		"should preserve special characters in subject")
-	require.Contains(t, result, "::error file=abc1234,line=1,title=TestRule::Error with \"quotes\" and <brackets> & symbols",
+	require.Contains(t, result, "::error file=abc1234,line=1,title=TestRule [special_chars]::Error with \"quotes\" and <brackets> & symbols",
 		"should preserve special characters in error messages")
 
 	// Should be valid GitHub Actions format
 	require.Contains(t, result, "::group::", "should maintain valid GitHub Actions format")
 	require.Contains(t, result, "::endgroup::", "should maintain valid GitHub Actions format")
 }
+
+func TestGitHub_Suggestion(t *testing.T) {
+	failure := domain.New("Subject", domain.ErrSubjectSuffix, "Subject has invalid suffix \".\"").
+		WithSuggestion("feat: add login")
+
+	report := domain.Report{
+		Commits: []domain.CommitReport{{
+			Commit: domain.Commit{Hash: "abc1234", Subject: "feat: add login."},
+			RuleResults: []domain.RuleReport{
+				{Name: "Subject", Status: domain.StatusFailed, Errors: []domain.ValidationError{failure}},
+			},
+		}},
+	}
+
+	require.Contains(t, GitHub(report),
+		"::error file=abc1234,line=1,title=Subject [invalid_suffix]::Subject has invalid suffix \".\"%0ASuggested change: feat: add login\n")
+}
+
+func TestGitHub_Annotations(t *testing.T) {
+	warning := domain.New("Spell", domain.ErrMisspelledWord, "Misspelled word: 'teh'").
+		WithSeverity(domain.SeverityWarning)
+	positioned := domain.New("Characters", domain.ErrBidiCharacter, "Bidi character, 100% hidden\nreally").
+		WithPosition(3, 14)
+
+	report := domain.Report{
+		Commits: []domain.CommitReport{{
+			Commit: domain.Commit{Hash: "abc1234", Subject: "fix: thing"},
+			RuleResults: []domain.RuleReport{
+				{Name: "Spell", Status: domain.StatusWarning, Errors: []domain.ValidationError{warning}},
+				{Name: "Characters", Status: domain.StatusFailed, Errors: []domain.ValidationError{positioned}},
+				{Name: "Odd: name, really", Status: domain.StatusFailed, Errors: []domain.ValidationError{domain.New("Odd", "odd", "odd")}},
+			},
+		}},
+		Repository: domain.RepositoryReport{RuleResults: []domain.RuleReport{
+			{Name: "BranchAhead", Status: domain.StatusWarning, Errors: []domain.ValidationError{warning}},
+		}},
+	}
+
+	result := GitHub(report)
+
+	require.Contains(t, result, "::notice file=abc1234,line=1,title=Spell [misspelled_word]::Misspelled word: 'teh'\n")
+	require.Contains(t, result, "::error file=abc1234,line=3,col=14,title=Characters [bidi_character]::Bidi character, 100%25 hidden%0Areally\n")
+	require.Contains(t, result, "title=Odd%3A name%2C really [odd]::odd\n")
+	require.Contains(t, result, "::notice title=BranchAhead [misspelled_word]::Misspelled word: 'teh'\n")
+	require.NotContains(t, result, "::warning")
+}
+
+func TestGitHubProblemMatcher(t *testing.T) {
+	var matcher struct {
+		ProblemMatcher []problemMatcher `json:"problemMatcher"`
+	}
+
+	require.NoError(t, json.Unmarshal([]byte(GitHubProblemMatcher()), &matcher))
+
+	patterns := make(map[string][]*regexp.Regexp)
+
+	for _, entry := range matcher.ProblemMatcher {
+		for _, pattern := range entry.Pattern {
+			patterns[entry.Owner] = append(patterns[entry.Owner], regexp.MustCompile(pattern.Regexp))
+		}
+	}
+
+	report := domain.Report{
+		Commits: []domain.CommitReport{{
+			Commit: domain.Commit{Hash: "abc1234", Subject: "Fix thing."},
+			RuleResults: []domain.RuleReport{
+				{Name: "Subject", Status: domain.StatusFailed, Errors: []domain.ValidationError{
+					domain.New("Subject", domain.ErrSubjectSuffix, "Subject has invalid suffix"),
+				}},
+				{Name: "ConventionalCommit", Status: domain.StatusFailed, Errors: []domain.ValidationError{
+					domain.New("ConventionalCommit", domain.ErrInvalidConventionalType, "Invalid type 'Fix'"),
+					domain.New("ConventionalCommit", domain.ErrInvalidSpacing, "Missing space"),
+				}},
+			},
+		}},
+	}
+
+	lines := strings.Split(Text(report, TextOptions{}), "\n")
+
+	require.Contains(t, lines, "✗ Subject: Subject has invalid suffix")
+	require.True(t, patterns["gommitlint"][0].MatchString("✗ Subject: Subject has invalid suffix"))
+	require.False(t, patterns["gommitlint"][0].MatchString("✗ ConventionalCommit:"))
+
+	index := slices.Index(lines, "✗ ConventionalCommit:")
+	require.GreaterOrEqual(t, index, 0)
+
+	multiple := patterns["gommitlint-multiple"]
+	require.Equal(t, []string{"✗ ConventionalCommit:", "ConventionalCommit"}, multiple[0].FindStringSubmatch(lines[index]))
+	require.True(t, multiple[1].MatchString(lines[index+1]))
+	require.True(t, multiple[1].MatchString(lines[index+2]))
+	require.False(t, multiple[1].MatchString(lines[index+3]), "the blank line ends the loop")
+}
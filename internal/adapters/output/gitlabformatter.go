@@ -18,7 +18,7 @@ func GitLab(report domain.Report) string {
 	// GitLab CI section for summary
 	builder.WriteString("section_start:$(date +%s):summary[collapsed=true]\n")
 	builder.WriteString(fmt.Sprintf("Validated %d commits\n", report.Summary.TotalCommits))
-	builder.WriteString(fmt.Sprintf("Passed: %d, Failed: %d\n", report.Summary.PassedCommits, report.Summary.FailedCommits))
+	builder.WriteString(ciSummaryCounts(report.Summary))
 	builder.WriteString("section_end:$(date +%s):summary\n")
 
 	// Format each commit in its own section
@@ -37,17 +37,21 @@ func GitLab(report domain.Report) string {
 	}
 
 	// Format repository-level results
-	if len(report.Repository.RuleResults) > 0 {
+	if report.Repository.HasDetails() {
 		builder.WriteString("section_start:$(date +%s):repository[collapsed=true]\n")
 		builder.WriteString("Repository Validation\n")
+		writeRepositoryDetails(&builder, report.Repository)
 
 		for _, repoResult := range report.Repository.RuleResults {
-			if repoResult.Status == domain.StatusFailed {
+			switch repoResult.Status {
+			case domain.StatusFailed:
 				for _, err := range repoResult.Errors {
 					builder.WriteString(fmt.Sprintf("ERROR: %s - %s\n",
-						repoResult.Name, err.Message))
+						withCode(repoResult.Name, err), err.Message))
 				}
-			} else {
+			case domain.StatusSkipped:
+				builder.WriteString(fmt.Sprintf("⏭ %s: skipped, %s\n", repoResult.Name, repoResult.SkipReason))
+			default:
 				builder.WriteString(fmt.Sprintf("✅ %s: passed\n", repoResult.Name))
 			}
 		}
@@ -58,7 +62,40 @@ func GitLab(report domain.Report) string {
 	return builder.String()
 }
 
+// ciSummaryCounts writes the passed and failed commits, and the skipped commits when
+// there are any, for the summary sections of CI logs.
+func ciSummaryCounts(summary domain.ReportSummary) string {
+	counts := fmt.Sprintf("Passed: %d, Failed: %d", summary.PassedCommits, summary.FailedCommits)
+	if summary.SkippedCommits > 0 {
+		counts += fmt.Sprintf(", Skipped: %d", summary.SkippedCommits)
+	}
+
+	return counts + "\n"
+}
+
+// writeRepositoryDetails writes the repository, branch and range validated on a line
+// each, for the repository sections of CI logs.
+func writeRepositoryDetails(builder *strings.Builder, repoReport domain.RepositoryReport) {
+	if repoReport.Name != "" {
+		builder.WriteString(fmt.Sprintf("Repository: %s\n", repoReport.Name))
+	}
+
+	if repoReport.Branch != "" {
+		builder.WriteString(fmt.Sprintf("Branch: %s\n", repoReport.Branch))
+	}
+
+	if repoReport.Range != "" {
+		builder.WriteString(fmt.Sprintf("Range: %s\n", repoReport.Range))
+	}
+}
+
 func writeGitLabRules(builder *strings.Builder, commitReport domain.CommitReport) {
+	if commitReport.SkipReason != "" {
+		builder.WriteString("⏭ Skipped: " + commitReport.SkipReason + "\n")
+
+		return
+	}
+
 	failedCount := 0
 
 	for _, ruleReport := range commitReport.RuleResults {
@@ -71,7 +108,18 @@ func writeGitLabRules(builder *strings.Builder, commitReport domain.CommitReport
 					hash = hash[:7]
 				}
 
-				builder.WriteString(fmt.Sprintf("ERROR: %s - %s: %s\n", hash, ruleReport.Name, err.Message))
+				builder.WriteString(fmt.Sprintf("ERROR: %s - %s: %s\n", hash, withCode(ruleReport.Name, err), err.Message))
+			}
+		}
+
+		if ruleReport.Status == domain.StatusWarning {
+			for _, err := range ruleReport.Errors {
+				hash := commitReport.Commit.Hash
+				if len(hash) > 7 {
+					hash = hash[:7]
+				}
+
+				builder.WriteString(fmt.Sprintf("WARNING: %s - %s: %s\n", hash, withCode(ruleReport.Name, err), err.Message))
 			}
 		}
 	}
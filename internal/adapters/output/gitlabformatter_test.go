@@ -101,9 +101,9 @@ func TestGitLab_ValidReport(t *testing.T) {
 	require.Contains(t, result, "Subject: feat: add new feature", "should show commit subject")
 
 	// Check error messages (with short hash)
-	require.Contains(t, result, "ERROR: abc1234 - TestRule: Test validation error",
+	require.Contains(t, result, "ERROR: abc1234 - TestRule [test_error]: Test validation error",
 		"should contain error with short hash for first error")
-	require.Contains(t, result, "ERROR: abc1234 - TestRule: Another test error",
+	require.Contains(t, result, "ERROR: abc1234 - TestRule [another_error]: Another test error",
 		"should contain error with short hash for second error")
 
 	// Check failure indication
@@ -243,7 +243,7 @@ func TestGitLab_WithRepositoryResults(t *testing.T) {
 	require.Contains(t, result, "Repository Validation", "should contain repository section title")
 
 	// Check repository error messages
-	require.Contains(t, result, "ERROR: BranchRule - Branch validation failed",
+	require.Contains(t, result, "ERROR: BranchRule [branch_error] - Branch validation failed",
 		"should contain repository error")
 
 	// Check passing repository rule
@@ -251,6 +251,18 @@ func TestGitLab_WithRepositoryResults(t *testing.T) {
 		"should show passing repository rule")
 }
 
+func TestGitLab_RepositoryDetails(t *testing.T) {
+	report := domain.Report{
+		Repository: domain.RepositoryReport{
+			Name:        "gommitlint",
+			Range:       "HEAD",
+			RuleResults: []domain.RuleReport{{Name: "BranchAhead", Status: domain.StatusPassed}},
+		},
+	}
+
+	require.Contains(t, GitLab(report), "Repository Validation\nRepository: gommitlint\nRange: HEAD\n✅ BranchAhead: passed\n")
+}
+
 func TestGitLab_MultipleCommits(t *testing.T) {
 	// Test with multiple commits
 	commits := []domain.CommitReport{
@@ -536,7 +548,7 @@ func TestGitLab_SpecialCharacters(t *testing.T) {
 	// Should handle special characters without breaking format
 	require.Contains(t, result, "Subject: feat: add \"quotes\" and <brackets>",
 		"should preserve special characters in subject")
-	require.Contains(t, result, "ERROR: abc1234 - TestRule: Error with \"quotes\" and <brackets> & symbols",
+	require.Contains(t, result, "ERROR: abc1234 - TestRule [special_chars]: Error with \"quotes\" and <brackets> & symbols",
 		"should preserve special characters in error messages")
 
 	// Should be valid GitLab CI format
@@ -0,0 +1,213 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package output
+
+import (
+	_ "embed"
+	"html/template"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/itiquette/gommitlint/internal/domain"
+)
+
+// htmlReportTemplate is a complete page with its styles and filter script inline, so
+// that the report is a single file that can be mailed or attached to a ticket.
+//
+//go:embed templates/report.html
+var htmlReportTemplate string
+
+// htmlReport is the data of the HTML report template.
+type htmlReport struct {
+	Generated  string
+	Summary    domain.ReportSummary
+	Commits    []htmlCommit
+	Repository htmlRepository
+	Rules      []string
+	Authors    []string
+}
+
+// htmlCommit is a validated commit with its failures.
+type htmlCommit struct {
+	Hash       string
+	ShortHash  string
+	Link       string   // URL of the commit at its host, empty without a link template
+	Duplicates []string // Hashes of the commits reported with this one, see domain.CommitReport
+	Subject    string
+	Author     string
+	Date       string
+	Submodule  string
+	Passed     bool
+	SkipReason string // Why no rule ran for the commit, such as for merge commits
+	Failures   []htmlFailure
+}
+
+// htmlRepository is the validated repository with the failures of repository rules.
+type htmlRepository struct {
+	Name         string
+	Branch       string
+	Range        string
+	RuleCount    int
+	PassedCount  int
+	SkippedCount int // Rules that did not run, as there is no repository
+	Failures     []htmlFailure
+}
+
+// Shown reports whether the repository section has anything to show.
+func (r htmlRepository) Shown() bool {
+	return r.Name != "" || r.Branch != "" || r.Range != "" || r.RuleCount > 0
+}
+
+// htmlFailure is a failure or warning of a rule.
+type htmlFailure struct {
+	Rule       string
+	Code       string
+	Message    string
+	Help       string
+	Suggestion string
+	Warning    bool
+}
+
+// HTMLOptions contains the options of HTML reports.
+type HTMLOptions struct {
+	LinkTemplate string // URL of a commit with {hash}, see domain.CommitLink; empty links no commits
+}
+
+// HTML formats a domain report as a self-contained HTML page, with filters by rule,
+// author and commit for reading the results of long history scans in a browser.
+func HTML(report domain.Report) string {
+	return HTMLWithOptions(report, HTMLOptions{})
+}
+
+// HTMLWithOptions formats a domain report as HTML, as HTML does, with options.
+func HTMLWithOptions(report domain.Report, options HTMLOptions) string {
+	page, err := template.New("report").Funcs(template.FuncMap{"shortHash": shortHash}).Parse(htmlReportTemplate)
+	if err != nil {
+		return "<!DOCTYPE html><p>failed to parse report template: " + template.HTMLEscapeString(err.Error()) + "</p>\n"
+	}
+
+	var builder strings.Builder
+	if err := page.Execute(&builder, buildHTMLReport(report, options)); err != nil {
+		return "<!DOCTYPE html><p>failed to render report: " + template.HTMLEscapeString(err.Error()) + "</p>\n"
+	}
+
+	return builder.String()
+}
+
+// buildHTMLReport collects the template data of report, with the rules and authors
+// offered as filters.
+func buildHTMLReport(report domain.Report, options HTMLOptions) htmlReport {
+	generated := report.Metadata.Timestamp
+	if generated.IsZero() {
+		generated = time.Now()
+	}
+
+	data := htmlReport{
+		Generated: generated.Format(time.RFC3339),
+		Summary:   report.Summary,
+	}
+
+	rules := make(map[string]bool)
+	authors := make(map[string]bool)
+
+	for _, commitReport := range report.Commits {
+		if commitReport.Commit.Hash == "" {
+			continue
+		}
+
+		commit := htmlCommit{
+			Hash:       commitReport.Commit.Hash,
+			ShortHash:  shortHash(commitReport.Commit.Hash),
+			Link:       commitLink(options.LinkTemplate, commitReport),
+			Duplicates: commitReport.Duplicates,
+			Subject:    commitReport.Commit.Subject,
+			Author:     commitReport.Commit.Author,
+			Date:       commitReport.Commit.CommitDate,
+			Submodule:  commitReport.Submodule,
+			Passed:     commitReport.Passed,
+			SkipReason: commitReport.SkipReason,
+			Failures:   htmlFailures(commitReport.RuleResults),
+		}
+
+		if commit.Author != "" {
+			authors[commit.Author] = true
+		}
+
+		for _, failure := range commit.Failures {
+			rules[failure.Rule] = true
+		}
+
+		data.Commits = append(data.Commits, commit)
+	}
+
+	data.Repository = buildHTMLRepository(report.Repository)
+	for _, failure := range data.Repository.Failures {
+		rules[failure.Rule] = true
+	}
+
+	data.Rules = sortedKeys(rules)
+	data.Authors = sortedKeys(authors)
+
+	return data
+}
+
+// buildHTMLRepository collects the template data of the repository report.
+func buildHTMLRepository(repoReport domain.RepositoryReport) htmlRepository {
+	repository := htmlRepository{
+		Name:      repoReport.Name,
+		Branch:    repoReport.Branch,
+		Range:     repoReport.Range,
+		RuleCount: len(repoReport.RuleResults),
+		Failures:  htmlFailures(repoReport.RuleResults),
+	}
+
+	for _, ruleReport := range repoReport.RuleResults {
+		switch ruleReport.Status {
+		case domain.StatusPassed:
+			repository.PassedCount++
+		case domain.StatusSkipped:
+			repository.SkippedCount++
+		}
+	}
+
+	return repository
+}
+
+// htmlFailures returns the failures and warnings of rule results.
+func htmlFailures(ruleResults []domain.RuleReport) []htmlFailure {
+	var failures []htmlFailure
+
+	for _, ruleReport := range ruleResults {
+		if ruleReport.Status != domain.StatusFailed && ruleReport.Status != domain.StatusWarning {
+			continue
+		}
+
+		for _, err := range ruleReport.Errors {
+			failures = append(failures, htmlFailure{
+				Rule:       ruleReport.Name,
+				Code:       err.Code,
+				Message:    err.Message,
+				Help:       err.Help,
+				Suggestion: err.Suggestion(),
+				Warning:    !err.IsBlocking(),
+			})
+		}
+	}
+
+	return failures
+}
+
+// sortedKeys returns the keys of set in order.
+func sortedKeys(set map[string]bool) []string {
+	keys := make([]string, 0, len(set))
+	for key := range set {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
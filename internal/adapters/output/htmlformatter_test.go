@@ -0,0 +1,112 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package output
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/itiquette/gommitlint/internal/domain"
+)
+
+func TestHTML_Report(t *testing.T) {
+	report := domain.Report{
+		Summary: domain.ReportSummary{TotalCommits: 2, PassedCommits: 1, FailedCommits: 1, SkippedCommits: 1},
+		Commits: []domain.CommitReport{
+			{
+				Commit: domain.Commit{Hash: "abc1234567890def", Subject: "Fix <script>alert(1)</script>", Author: "Ada Lovelace"},
+				RuleResults: []domain.RuleReport{
+					{
+						Name:   "Subject",
+						Status: domain.StatusFailed,
+						Errors: []domain.ValidationError{
+							domain.New("Subject", domain.ErrSubjectTooLong, "Subject too long").WithSuggestion("Fix alert"),
+						},
+					},
+					{Name: "SignOff", Status: domain.StatusPassed},
+				},
+			},
+			{
+				Commit: domain.Commit{Hash: "0123456789abcdef", Subject: "Add docs", Author: "Grace Hopper"},
+				Passed: true,
+			},
+			{
+				Commit:     domain.Commit{Hash: "fedcba9876543210", Subject: "Merge branch 'docs'", Author: "Grace Hopper"},
+				Passed:     true,
+				SkipReason: domain.SkipReasonMergeCommit,
+			},
+		},
+		Repository: domain.RepositoryReport{
+			Name:   "gommitlint",
+			Branch: "feature/login",
+			Range:  "main..HEAD",
+			RuleResults: []domain.RuleReport{
+				{
+					Name:   "BranchAhead",
+					Status: domain.StatusFailed,
+					Errors: []domain.ValidationError{domain.New("BranchAhead", domain.ErrTooManyCommits, "Too many commits")},
+				},
+			},
+		},
+		Metadata: domain.ReportMetadata{Timestamp: time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)},
+	}
+
+	page := HTML(report)
+
+	require.True(t, strings.HasPrefix(page, "<!DOCTYPE html>"))
+	require.Contains(t, page, "Generated 2025-06-01T12:00:00Z")
+	require.Contains(t, page, "Fix &lt;script&gt;alert(1)&lt;/script&gt;")
+	require.NotContains(t, page, "<script>alert(1)")
+	require.Contains(t, page, `<code title="abc1234567890def">abc123456789</code>`)
+	require.Contains(t, page, "Suggested change: Fix alert")
+	require.Contains(t, page, "Too many commits")
+	require.Contains(t, page, "<dt>Name</dt><dd>gommitlint</dd>")
+	require.Contains(t, page, "<dt>Range</dt><dd><code>main..HEAD</code></dd>")
+	require.Contains(t, page, "<dt>Rules</dt><dd>0 of 1 passed</dd>")
+	require.Contains(t, page, `<div class="skipped"><strong>1</strong>skipped</div>`)
+	require.Contains(t, page, `<span class="skipped">skipped: merge commit</span>`)
+
+	// Only rules that failed are offered as filters, authors of all commits are.
+	require.Contains(t, page, `<option value="Subject">Subject</option>`)
+	require.Contains(t, page, `<option value="BranchAhead">BranchAhead</option>`)
+	require.NotContains(t, page, `<option value="SignOff">`)
+	require.Contains(t, page, `<option value="Ada Lovelace">Ada Lovelace</option>`)
+	require.Contains(t, page, `<option value="Grace Hopper">Grace Hopper</option>`)
+	require.Contains(t, page, `data-rules="Subject "`)
+	require.Contains(t, page, `document.getElementById("filter-rule")`)
+
+	// The page is self-contained.
+	require.NotContains(t, page, "src=")
+	require.NotContains(t, page, `href="http`)
+	require.NotContains(t, page, "@import")
+}
+
+func TestHTMLWithOptions_CommitLinks(t *testing.T) {
+	report := domain.Report{
+		Commits: []domain.CommitReport{
+			{Commit: domain.Commit{Hash: "abc1234567890def", Subject: "feat: add login"}, Passed: true},
+			{Commit: domain.Commit{Hash: "fed0987654321abc", Subject: "chore: bump lib"}, Submodule: "vendor/lib", Passed: true},
+		},
+	}
+
+	page := HTMLWithOptions(report, HTMLOptions{LinkTemplate: "https://gitlab.com/org/repo/-/commit/{hash}"})
+	require.Contains(t, page, `<a href="https://gitlab.com/org/repo/-/commit/abc1234567890def"><code title="abc1234567890def">abc123456789</code></a>`)
+	require.Contains(t, page, `<td><code title="fed0987654321abc">fed098765432</code>`)
+
+	// Templates with unsafe schemes are not rendered as links
+	page = HTMLWithOptions(report, HTMLOptions{LinkTemplate: "javascript:alert('{hash}')"})
+	require.NotContains(t, page, `href="javascript:`)
+}
+
+func TestHTML_EmptyReport(t *testing.T) {
+	page := HTML(domain.Report{})
+
+	require.Contains(t, page, "<tbody>")
+	require.Contains(t, page, "No commits match the filters.")
+	require.NotContains(t, page, "<h2>Repository</h2>")
+}
@@ -7,28 +7,18 @@ package output
 import (
 	"encoding/json"
 	"maps"
+	"strings"
 	"time"
 
 	"github.com/itiquette/gommitlint/internal/domain"
+	"github.com/itiquette/gommitlint/internal/domain/errcodes"
 )
 
 // JSON formats a domain report as JSON (pure function).
 func JSON(report domain.Report) string {
-	output := map[string]interface{}{
-		"timestamp":     report.Metadata.Timestamp.Format(time.RFC3339),
-		"allPassed":     report.Summary.AllPassed,
-		"totalCommits":  report.Summary.TotalCommits,
-		"passedCommits": report.Summary.PassedCommits,
-		"ruleSummary":   report.Summary.FailedRules,
-		"commitResults": convertCommitsToJSON(report.Commits),
-	}
-
-	if len(report.Repository.RuleResults) > 0 {
-		output["repositoryResults"] = convertRepositoryResultsToJSON(report.Repository.RuleResults)
-	}
+	var builder strings.Builder
 
-	jsonBytes, err := json.MarshalIndent(output, "", "  ")
-	if err != nil {
+	if err := (&jsonStream{writer: &builder}).Close(report); err != nil {
 		// Return properly formatted JSON error
 		errorOutput := map[string]interface{}{
 			"error":     "failed to marshal JSON",
@@ -42,7 +32,31 @@ func JSON(report domain.Report) string {
 		return `{"error": "failed to marshal JSON", "details": "unknown error"}`
 	}
 
-	return string(jsonBytes)
+	return builder.String()
+}
+
+// reportFieldsJSON returns the fields of the JSON format other than the commit results.
+func reportFieldsJSON(report domain.Report) map[string]interface{} {
+	output := map[string]interface{}{
+		"schemaVersion":     domain.ContextSchemaVersion,
+		"errorCodesVersion": errcodes.Version,
+		"timestamp":         report.Metadata.Timestamp.Format(time.RFC3339),
+		"allPassed":         report.Summary.AllPassed,
+		"totalCommits":      report.Summary.TotalCommits,
+		"passedCommits":     report.Summary.PassedCommits,
+		"skippedCommits":    report.Summary.SkippedCommits,
+		"ruleSummary":       report.Summary.FailedRules,
+	}
+
+	if repository := convertRepositoryToJSON(report.Repository); len(repository) > 0 {
+		output["repository"] = repository
+	}
+
+	if len(report.Repository.RuleResults) > 0 {
+		output["repositoryResults"] = convertRepositoryResultsToJSON(report.Repository.RuleResults)
+	}
+
+	return output
 }
 
 func convertCommitsToJSON(commits []domain.CommitReport) []map[string]interface{} {
@@ -59,7 +73,23 @@ func convertCommitsToJSON(commits []domain.CommitReport) []map[string]interface{
 			"passed":       commitReport.Passed,
 			"ruleResults":  convertRulesToJSON(commitReport.RuleResults),
 			"errorCount":   countErrors(commitReport.RuleResults),
-			"warningCount": 0,
+			"warningCount": countWarnings(commitReport.RuleResults),
+		}
+
+		if commitReport.Submodule != "" {
+			commit["submodule"] = commitReport.Submodule
+		}
+
+		if len(commitReport.Duplicates) > 0 {
+			commit["duplicates"] = commitReport.Duplicates
+		}
+
+		if commitReport.ConventionalType != "" {
+			commit["conventionalType"] = commitReport.ConventionalType
+		}
+
+		if commitReport.SkipReason != "" {
+			commit["skipReason"] = commitReport.SkipReason
 		}
 
 		if commitReport.Commit.CommitDate != "" {
@@ -96,11 +126,39 @@ func convertRulesToJSON(rules []domain.RuleReport) []map[string]interface{} {
 			"message": ruleReport.Message,
 			"errors":  convertErrorsToJSON(ruleReport.Errors),
 		}
+
+		if ruleReport.SkipReason != "" {
+			results[i]["skipReason"] = ruleReport.SkipReason
+		}
+
+		if len(ruleReport.Details) > 0 {
+			results[i]["details"] = maps.Clone(ruleReport.Details)
+		}
 	}
 
 	return results
 }
 
+// convertRepositoryToJSON returns the repository, branch and range validated, leaving
+// out what is unknown.
+func convertRepositoryToJSON(repoReport domain.RepositoryReport) map[string]interface{} {
+	repository := make(map[string]interface{})
+
+	if repoReport.Name != "" {
+		repository["name"] = repoReport.Name
+	}
+
+	if repoReport.Branch != "" {
+		repository["branch"] = repoReport.Branch
+	}
+
+	if repoReport.Range != "" {
+		repository["range"] = repoReport.Range
+	}
+
+	return repository
+}
+
 func convertRepositoryResultsToJSON(repoResults []domain.RuleReport) []map[string]interface{} {
 	results := make([]map[string]interface{}, len(repoResults))
 
@@ -111,6 +169,15 @@ func convertRepositoryResultsToJSON(repoResults []domain.RuleReport) []map[strin
 			"status": string(ruleReport.Status),
 			"errors": convertErrorsToJSON(ruleReport.Errors),
 		}
+
+		// Repository rules are skipped when there is no repository to check
+		if ruleReport.SkipReason != "" {
+			results[i]["skipReason"] = ruleReport.SkipReason
+		}
+
+		if len(ruleReport.Details) > 0 {
+			results[i]["details"] = maps.Clone(ruleReport.Details)
+		}
 	}
 
 	return results
@@ -132,11 +199,37 @@ func convertErrorsToJSON(validationErrors []domain.ValidationError) []map[string
 		if err.Help != "" {
 			results[idx]["help"] = err.Help
 		}
+
+		if err.Severity != "" {
+			results[idx]["severity"] = string(err.Severity)
+		}
+
+		addStructuredContext(results[idx], err)
 	}
 
 	return results
 }
 
+// addStructuredContext copies the standard context keys of err into typed fields, so
+// that consumers need not know which rule put them in the context map.
+func addStructuredContext(result map[string]interface{}, err domain.ValidationError) {
+	if expected := err.Expected(); expected != "" {
+		result["expected"] = expected
+	}
+
+	if actual := err.Actual(); actual != "" {
+		result["actual"] = actual
+	}
+
+	if suggestion := err.Suggestion(); suggestion != "" {
+		result["suggestion"] = suggestion
+	}
+
+	if position, ok := err.Position(); ok {
+		result["position"] = map[string]int{"line": position.Line, "column": position.Column}
+	}
+}
+
 func countErrors(rules []domain.RuleReport) int {
 	total := 0
 
@@ -148,3 +241,15 @@ func countErrors(rules []domain.RuleReport) int {
 
 	return total
 }
+
+func countWarnings(rules []domain.RuleReport) int {
+	total := 0
+
+	for _, rule := range rules {
+		if rule.Status == domain.StatusWarning {
+			total += len(rule.Errors)
+		}
+	}
+
+	return total
+}
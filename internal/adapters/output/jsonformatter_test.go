@@ -12,6 +12,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/itiquette/gommitlint/internal/domain"
+	"github.com/itiquette/gommitlint/internal/domain/errcodes"
 )
 
 func TestJSON_ValidReport(t *testing.T) {
@@ -85,6 +86,8 @@ func TestJSON_ValidReport(t *testing.T) {
 	require.NoError(t, err, "should produce valid JSON")
 
 	// Check required fields
+	require.Equal(t, domain.ContextSchemaVersion, jsonData["schemaVersion"])
+	require.Equal(t, errcodes.Version, jsonData["errorCodesVersion"])
 	require.Equal(t, "2025-06-14T10:00:00Z", jsonData["timestamp"])
 	require.Equal(t, false, jsonData["allPassed"])
 	require.InDelta(t, 1, jsonData["totalCommits"], 0.01)
@@ -139,6 +142,103 @@ func TestJSON_ValidReport(t *testing.T) {
 	require.True(t, ok)
 	require.Equal(t, "something", context["expected"])
 	require.Equal(t, "something else", context["found"])
+
+	// Standard keys are also typed fields
+	require.Equal(t, "something", errorData["expected"])
+	require.NotContains(t, errorData, "actual")
+	require.NotContains(t, errorData, "position")
+}
+
+func TestJSON_StructuredContext(t *testing.T) {
+	validationError := domain.New("Characters", domain.ErrBidiCharacter, "Bidirectional formatting character").
+		WithContextMap(map[string]string{"actual": "U+202E", "part": "body"}).
+		WithPosition(3, 14).
+		WithSuggestion("remove it")
+
+	report := domain.Report{
+		Commits: []domain.CommitReport{{
+			Commit: domain.Commit{Hash: "abc1234"},
+			RuleResults: []domain.RuleReport{{
+				Name:   "Characters",
+				Status: domain.StatusFailed,
+				Errors: []domain.ValidationError{validationError},
+			}},
+		}},
+	}
+
+	var jsonData struct {
+		CommitResults []struct {
+			RuleResults []struct {
+				Errors []struct {
+					Actual     string            `json:"actual"`
+					Suggestion string            `json:"suggestion"`
+					Position   map[string]int    `json:"position"`
+					Context    map[string]string `json:"context"`
+				} `json:"errors"`
+			} `json:"ruleResults"`
+		} `json:"commitResults"`
+	}
+
+	require.NoError(t, json.Unmarshal([]byte(JSON(report)), &jsonData))
+
+	errorData := jsonData.CommitResults[0].RuleResults[0].Errors[0]
+	require.Equal(t, "U+202E", errorData.Actual)
+	require.Equal(t, "remove it", errorData.Suggestion)
+	require.Equal(t, map[string]int{"line": 3, "column": 14}, errorData.Position)
+	require.Equal(t, "body", errorData.Context["part"])
+	require.Equal(t, "3", errorData.Context["line"])
+}
+
+func TestJSON_RuleDetails(t *testing.T) {
+	details := map[string]string{"fingerprint": "SHA256:abc", "algorithm": "ed25519", "identity_match": "true"}
+	report := domain.Report{
+		Commits: []domain.CommitReport{{
+			Commit: domain.Commit{Hash: "abc1234"},
+			RuleResults: []domain.RuleReport{
+				{Name: "Signature", Status: domain.StatusPassed, Message: "Passed", Details: details},
+				{Name: "Subject", Status: domain.StatusPassed, Message: "Passed"},
+			},
+			Passed: true,
+		}},
+		Repository: domain.RepositoryReport{
+			RuleResults: []domain.RuleReport{
+				{Name: "PushCertificate", Status: domain.StatusPassed, Details: map[string]string{"signer": "Dev <dev@example.com>"}},
+			},
+		},
+	}
+
+	var jsonData struct {
+		CommitResults []struct {
+			RuleResults []map[string]interface{} `json:"ruleResults"`
+		} `json:"commitResults"`
+		RepositoryResults []map[string]interface{} `json:"repositoryResults"`
+	}
+
+	require.NoError(t, json.Unmarshal([]byte(JSON(report)), &jsonData))
+
+	ruleResults := jsonData.CommitResults[0].RuleResults
+	require.Equal(t, map[string]interface{}{
+		"fingerprint": "SHA256:abc", "algorithm": "ed25519", "identity_match": "true",
+	}, ruleResults[0]["details"])
+	require.NotContains(t, ruleResults[1], "details")
+	require.Equal(t, map[string]interface{}{"signer": "Dev <dev@example.com>"}, jsonData.RepositoryResults[0]["details"])
+}
+
+func TestJSON_Duplicates(t *testing.T) {
+	report := domain.Report{
+		Commits: []domain.CommitReport{
+			{Commit: domain.Commit{Hash: "abc1234"}, Duplicates: []string{"bcd2345", "cde3456"}, Passed: true},
+			{Commit: domain.Commit{Hash: "def4567"}, Passed: true},
+		},
+	}
+
+	var jsonData struct {
+		CommitResults []map[string]interface{} `json:"commitResults"`
+	}
+
+	require.NoError(t, json.Unmarshal([]byte(JSON(report)), &jsonData))
+	require.Equal(t, []interface{}{"bcd2345", "cde3456"}, jsonData.CommitResults[0]["duplicates"])
+	require.NotContains(t, jsonData.CommitResults[1], "duplicates")
 }
 
 func TestJSON_EmptyReport(t *testing.T) {
@@ -170,6 +270,7 @@ func TestJSON_EmptyReport(t *testing.T) {
 	require.Equal(t, true, jsonData["allPassed"])
 	require.InDelta(t, 0, jsonData["totalCommits"], 0.01)
 	require.InDelta(t, 0, jsonData["passedCommits"], 0.01)
+	require.NotContains(t, jsonData, "repository")
 }
 
 func TestJSON_WithRepositoryResults(t *testing.T) {
@@ -205,6 +306,8 @@ func TestJSON_WithRepositoryResults(t *testing.T) {
 		},
 		Commits: nil,
 		Repository: domain.RepositoryReport{
+			Name:        "gommitlint",
+			Range:       "main..HEAD",
 			RuleResults: repoResults,
 		},
 	}
@@ -216,6 +319,9 @@ func TestJSON_WithRepositoryResults(t *testing.T) {
 	err := json.Unmarshal([]byte(result), &jsonData)
 	require.NoError(t, err, "should produce valid JSON")
 
+	// The branch is unknown and left out
+	require.Equal(t, map[string]interface{}{"name": "gommitlint", "range": "main..HEAD"}, jsonData["repository"])
+
 	// Check repository results are included
 	repoData, exists := jsonData["repositoryResults"]
 	require.True(t, exists, "should include repository results")
@@ -228,6 +334,59 @@ func TestJSON_WithRepositoryResults(t *testing.T) {
 	require.True(t, isRepoRuleMap)
 	require.Equal(t, "BranchRule", repoRule["name"])
 	require.Equal(t, "failed", repoRule["status"])
+	require.NotContains(t, repoRule, "skipReason")
+
+	// Without a repository the rules are skipped
+	report.Repository.RuleResults = []domain.RuleReport{
+		{Name: "BranchAhead", Status: domain.StatusSkipped, SkipReason: domain.SkipReasonNoRepository},
+	}
+	require.NoError(t, json.Unmarshal([]byte(JSON(report)), &jsonData))
+	require.Equal(t, []interface{}{map[string]interface{}{
+		"id": "BranchAhead", "name": "BranchAhead", "status": "skipped", "skipReason": "no repository", "errors": nil,
+	}}, jsonData["repositoryResults"])
+}
+
+func TestJSON_RuleStatuses(t *testing.T) {
+	report := domain.Report{
+		Commits: []domain.CommitReport{
+			{
+				Commit:      domain.Commit{Hash: "abc1234", Subject: "docs: update guide"},
+				RuleResults: []domain.RuleReport{{Name: "Spell", Status: domain.StatusNotApplicable, Message: "Not applicable to this commit"}},
+				Passed:      true,
+			},
+			{
+				Commit: domain.Commit{Hash: "def5678", Subject: "Merge branch 'feature'"},
+				RuleResults: []domain.RuleReport{
+					{Name: "Spell", Status: domain.StatusSkipped, Message: "Skipped: merge commit", SkipReason: domain.SkipReasonMergeCommit},
+				},
+				Passed:     true,
+				SkipReason: domain.SkipReasonMergeCommit,
+			},
+		},
+		Summary: domain.ReportSummary{TotalCommits: 1, PassedCommits: 1, SkippedCommits: 1, AllPassed: true},
+	}
+
+	var jsonData map[string]interface{}
+	require.NoError(t, json.Unmarshal([]byte(JSON(report)), &jsonData))
+	require.InDelta(t, 1, jsonData["skippedCommits"], 0)
+
+	commits, ok := jsonData["commitResults"].([]interface{})
+	require.True(t, ok)
+	require.Len(t, commits, 2)
+
+	validated, ok := commits[0].(map[string]interface{})
+	require.True(t, ok)
+	require.NotContains(t, validated, "skipReason")
+	require.Equal(t, []interface{}{map[string]interface{}{
+		"id": "Spell", "name": "Spell", "status": "not_applicable", "message": "Not applicable to this commit", "errors": nil,
+	}}, validated["ruleResults"])
+
+	skipped, ok := commits[1].(map[string]interface{})
+	require.True(t, ok)
+	require.Equal(t, "merge commit", skipped["skipReason"])
+	require.Equal(t, []interface{}{map[string]interface{}{
+		"id": "Spell", "name": "Spell", "status": "skipped", "message": "Skipped: merge commit", "skipReason": "merge commit", "errors": nil,
+	}}, skipped["ruleResults"])
 }
 
 func TestJSON_CommitWithMissingFields(t *testing.T) {
@@ -0,0 +1,31 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package output
+
+import "github.com/itiquette/gommitlint/internal/domain"
+
+// commitLink returns the URL of the commit of commitReport from linkTemplate. Commits
+// of submodules are hosted elsewhere, so they are not linked.
+func commitLink(linkTemplate string, commitReport domain.CommitReport) string {
+	if commitReport.Submodule != "" {
+		return ""
+	}
+
+	return domain.CommitLink(linkTemplate, commitReport.Commit.Hash)
+}
+
+// textCommitLink links text to link. Terminals get an OSC 8 hyperlink, which keeps the
+// short hash on screen; elsewhere, such as in CI logs, the link follows the text, where
+// log viewers make it clickable.
+func textCommitLink(text, link string, terminal bool) string {
+	switch {
+	case link == "":
+		return text
+	case terminal:
+		return "\x1b]8;;" + link + "\x1b\\" + text + "\x1b]8;;\x1b\\"
+	default:
+		return text + " " + link
+	}
+}
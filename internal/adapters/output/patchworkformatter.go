@@ -0,0 +1,107 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package output
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/itiquette/gommitlint/internal/domain"
+)
+
+// PatchworkCheckContext names the checks of gommitlint among the checks of a patch.
+const PatchworkCheckContext = "gommitlint"
+
+// patchworkCheck is the body of POST /api/patches/{id}/checks/ together with the patch
+// it belongs to.
+type patchworkCheck struct {
+	Patch       string `json:"patch"`
+	State       string `json:"state"`
+	Context     string `json:"context"`
+	Description string `json:"description"`
+}
+
+// Patchwork formats a report as a JSON array with a Patchwork check for each commit,
+// which for --patchwork-series are the patches of the series. A check fails when a
+// rule fails and warns when rules only warn. Repository rule results have no patch
+// and are left out.
+func Patchwork(report domain.Report) string {
+	checks := make([]patchworkCheck, 0, len(report.Commits))
+
+	for _, commitReport := range report.Commits {
+		if commitReport.Commit.Hash == "" {
+			continue
+		}
+
+		checks = append(checks, newPatchworkCheck(commitReport))
+	}
+
+	jsonBytes, err := json.MarshalIndent(checks, "", "  ")
+	if err != nil {
+		return `{"error": "failed to marshal JSON"}` + "\n"
+	}
+
+	return string(jsonBytes) + "\n"
+}
+
+// newPatchworkCheck summarizes the rule results of a commit as a check. Skipped
+// commits, which no rule ran for, succeed.
+func newPatchworkCheck(commitReport domain.CommitReport) patchworkCheck {
+	var failed, warned []string
+
+	passed := 0
+
+	for _, ruleReport := range commitReport.RuleResults {
+		switch ruleReport.Status {
+		case domain.StatusFailed:
+			failed = append(failed, withCodes(ruleReport))
+		case domain.StatusWarning:
+			warned = append(warned, withCodes(ruleReport))
+		case domain.StatusPassed:
+			passed++
+		}
+	}
+
+	check := patchworkCheck{
+		Patch:   commitReport.Commit.Hash,
+		State:   "success",
+		Context: PatchworkCheckContext,
+	}
+
+	switch {
+	case commitReport.SkipReason != "":
+		check.Description = "Skipped: " + commitReport.SkipReason
+	case len(failed) > 0:
+		check.State = "fail"
+		check.Description = "Failed: " + strings.Join(failed, ", ")
+	case len(warned) > 0:
+		check.State = "warning"
+		check.Description = "Warnings: " + strings.Join(warned, ", ")
+	default:
+		check.Description = fmt.Sprintf("All %d rules passed", passed)
+	}
+
+	return check
+}
+
+// withCodes returns the name of a rule followed by the distinct codes of its errors in
+// brackets, such as "Subject [subject_too_long]".
+func withCodes(ruleReport domain.RuleReport) string {
+	var codes []string
+
+	for _, err := range ruleReport.Errors {
+		if err.Code != "" && !slices.Contains(codes, err.Code) {
+			codes = append(codes, err.Code)
+		}
+	}
+
+	if len(codes) == 0 {
+		return ruleReport.Name
+	}
+
+	return ruleReport.Name + " [" + strings.Join(codes, ", ") + "]"
+}
@@ -0,0 +1,67 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package output
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/itiquette/gommitlint/internal/domain"
+)
+
+func TestPatchwork(t *testing.T) {
+	report := domain.Report{
+		Commits: []domain.CommitReport{
+			{
+				Commit: domain.Commit{Hash: "101"},
+				RuleResults: []domain.RuleReport{
+					{Name: "Subject", Status: domain.StatusFailed, Errors: []domain.ValidationError{
+						domain.New("Subject", domain.ErrSubjectTooLong, "Subject too long"),
+						domain.New("Subject", domain.ErrSubjectSuffix, "Subject ends with a period"),
+						domain.New("Subject", domain.ErrSubjectTooLong, "Subject too long"),
+					}},
+					{Name: "SignOff", Status: domain.StatusFailed},
+					{Name: "CommitSize", Status: domain.StatusWarning},
+				},
+			},
+			{
+				Commit: domain.Commit{Hash: "102"},
+				RuleResults: []domain.RuleReport{
+					{Name: "Subject", Status: domain.StatusPassed},
+					{Name: "CommitSize", Status: domain.StatusWarning},
+				},
+				Passed: true,
+			},
+			{
+				Commit: domain.Commit{Hash: "103"},
+				RuleResults: []domain.RuleReport{
+					{Name: "Subject", Status: domain.StatusPassed},
+					{Name: "SignOff", Status: domain.StatusPassed},
+					{Name: "Spell", Status: domain.StatusNotApplicable},
+				},
+				Passed: true,
+			},
+			{
+				Commit:      domain.Commit{Hash: "104"},
+				RuleResults: []domain.RuleReport{{Name: "Subject", Status: domain.StatusSkipped, SkipReason: domain.SkipReasonMergeCommit}},
+				Passed:      true,
+				SkipReason:  domain.SkipReasonMergeCommit,
+			},
+		},
+		Repository: domain.RepositoryReport{
+			RuleResults: []domain.RuleReport{{Name: "BranchAhead", Status: domain.StatusFailed}},
+		},
+	}
+
+	require.JSONEq(t, `[
+		{"patch": "101", "state": "fail", "context": "gommitlint", "description": "Failed: Subject [subject_too_long, invalid_suffix], SignOff"},
+		{"patch": "102", "state": "warning", "context": "gommitlint", "description": "Warnings: CommitSize"},
+		{"patch": "103", "state": "success", "context": "gommitlint", "description": "All 2 rules passed"},
+		{"patch": "104", "state": "success", "context": "gommitlint", "description": "Skipped: merge commit"}
+	]`, Patchwork(report))
+
+	require.JSONEq(t, `[]`, Patchwork(domain.Report{}))
+}
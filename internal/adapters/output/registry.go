@@ -14,10 +14,15 @@ type TextOnlyFormatter func(report domain.Report) string
 
 // formatters maps format names to their corresponding formatter functions.
 var formatters = map[string]interface{}{
-	"text":   Text,   // func(domain.Report, TextOptions) string
-	"json":   JSON,   // func(domain.Report) string
-	"github": GitHub, // func(domain.Report) string
-	"gitlab": GitLab, // func(domain.Report) string
+	"text":      Text,      // func(domain.Report, TextOptions) string
+	"json":      JSON,      // func(domain.Report) string
+	"github":    GitHub,    // func(domain.Report) string
+	"gitlab":    GitLab,    // func(domain.Report) string
+	"html":      HTML,      // func(domain.Report) string
+	"csv":       CSV,       // func(domain.Report) string
+	"tsv":       TSV,       // func(domain.Report) string
+	"patchwork": Patchwork, // func(domain.Report) string
+	"gerrit":    Gerrit,    // func(domain.Report) string
 }
 
 // Format formats a report using the specified format (main entry point).
@@ -36,6 +41,16 @@ func Format(format string, report domain.Report, options interface{}) string {
 		return GitHub(report)
 	case "gitlab":
 		return GitLab(report)
+	case "html":
+		return HTML(report)
+	case "csv":
+		return CSV(report)
+	case "tsv":
+		return TSV(report)
+	case "patchwork":
+		return Patchwork(report)
+	case "gerrit":
+		return Gerrit(report)
 	default:
 		// Default to text format
 		if textOpts, ok := options.(TextOptions); ok {
@@ -0,0 +1,79 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package output
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/itiquette/gommitlint/internal/domain"
+)
+
+// MessageScoreText formats a message score with its ranked improvements for the terminal.
+func MessageScoreText(score domain.MessageScore) string {
+	var builder strings.Builder
+
+	fmt.Fprintf(&builder, "Score: %d/%d\n", score.Score, domain.MaxMessageScore)
+	fmt.Fprintf(&builder, "Rules passed: %d/%d\n", score.RulesPassed, score.RulesChecked)
+
+	if len(score.Improvements) == 0 {
+		builder.WriteString("\nNo improvements found.\n")
+
+		return builder.String()
+	}
+
+	builder.WriteString("\nImprovements:\n")
+
+	for index, improvement := range score.Improvements {
+		fmt.Fprintf(&builder, "  %d. +%d %s (%s): %s\n", index+1, improvement.Penalty,
+			improvement.Rule, improvement.Severity, improvement.Message)
+
+		if improvement.Help != "" {
+			for _, line := range strings.Split(strings.TrimSpace(improvement.Help), "\n") {
+				if line == "" {
+					builder.WriteString("\n")
+
+					continue
+				}
+
+				fmt.Fprintf(&builder, "     %s\n", line)
+			}
+		}
+	}
+
+	return builder.String()
+}
+
+// MessageScoreJSON formats a message score as JSON.
+func MessageScoreJSON(score domain.MessageScore) (string, error) {
+	improvements := make([]map[string]interface{}, 0, len(score.Improvements))
+	for index, improvement := range score.Improvements {
+		improvements = append(improvements, map[string]interface{}{
+			"rank":     index + 1,
+			"rule":     improvement.Rule,
+			"code":     improvement.Code,
+			"message":  improvement.Message,
+			"help":     improvement.Help,
+			"severity": improvement.Severity,
+			"points":   improvement.Penalty,
+		})
+	}
+
+	output := map[string]interface{}{
+		"score":        score.Score,
+		"maxScore":     domain.MaxMessageScore,
+		"rulesChecked": score.RulesChecked,
+		"rulesPassed":  score.RulesPassed,
+		"improvements": improvements,
+	}
+
+	jsonBytes, err := json.MarshalIndent(output, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal message score: %w", err)
+	}
+
+	return string(jsonBytes) + "\n", nil
+}
@@ -0,0 +1,62 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package output
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/itiquette/gommitlint/internal/domain"
+)
+
+func testMessageScore() domain.MessageScore {
+	return domain.NewMessageScore([]domain.ValidationError{
+		domain.New("NoWIP", "custom_rule_failed", "WIP marker").WithSeverity(domain.SeverityWarning),
+		domain.New("Subject", domain.ErrSubjectTooLong, "subject too long").WithHelp("Keep it short.\n\nMove details to the body."),
+	}, 4)
+}
+
+func TestMessageScoreText(t *testing.T) {
+	expected := `Score: 75/100
+Rules passed: 2/4
+
+Improvements:
+  1. +20 Subject (error): subject too long
+     Keep it short.
+
+     Move details to the body.
+  2. +5 NoWIP (warning): WIP marker
+`
+
+	require.Equal(t, expected, MessageScoreText(testMessageScore()))
+	require.Contains(t, MessageScoreText(domain.NewMessageScore(nil, 4)), "No improvements found.")
+}
+
+func TestMessageScoreJSON(t *testing.T) {
+	result, err := MessageScoreJSON(testMessageScore())
+	require.NoError(t, err)
+
+	var decoded struct {
+		Score        int `json:"score"`
+		MaxScore     int `json:"maxScore"`
+		RulesPassed  int `json:"rulesPassed"`
+		Improvements []struct {
+			Rank   int    `json:"rank"`
+			Rule   string `json:"rule"`
+			Points int    `json:"points"`
+		} `json:"improvements"`
+	}
+
+	require.NoError(t, json.Unmarshal([]byte(result), &decoded))
+	require.Equal(t, 75, decoded.Score)
+	require.Equal(t, 100, decoded.MaxScore)
+	require.Equal(t, 2, decoded.RulesPassed)
+	require.Len(t, decoded.Improvements, 2)
+	require.Equal(t, 1, decoded.Improvements[0].Rank)
+	require.Equal(t, "Subject", decoded.Improvements[0].Rule)
+	require.Equal(t, 20, decoded.Improvements[0].Points)
+}
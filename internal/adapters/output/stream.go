@@ -0,0 +1,223 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package output
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"strings"
+
+	"github.com/itiquette/gommitlint/internal/domain"
+)
+
+// CommitStream writes a report commit by commit as the commits are validated, before the
+// rest of the report is known, so that long ranges are never held in memory.
+type CommitStream interface {
+	// WriteCommit writes the report of one commit.
+	WriteCommit(commitReport domain.CommitReport)
+	// Close writes the commits left in report, then its repository results and summary,
+	// and returns the first error writing any of it.
+	Close(report domain.Report) error
+}
+
+// NewCommitStream returns a stream writing reports in format to writer, and false for the
+// formats that are only written whole.
+func NewCommitStream(format string, writer io.Writer, options TextOptions) (CommitStream, bool) {
+	switch format {
+	case "text":
+		return newTextStream(writer, options), true
+	case "json":
+		return &jsonStream{writer: writer}, true
+	case "csv":
+		return newTableStream(writer, ','), true
+	case "tsv":
+		return newTableStream(writer, '\t'), true
+	default:
+		return nil, false
+	}
+}
+
+// textStream writes the text format. Commits are only numbered when there are several,
+// so the first commit is held until the next one or the end of the report.
+type textStream struct {
+	writer  io.Writer
+	options TextOptions
+	colors  colorScheme
+	first   *domain.CommitReport
+	written int
+	err     error
+}
+
+func newTextStream(writer io.Writer, options TextOptions) *textStream {
+	return &textStream{writer: writer, options: options, colors: getColorScheme(options.Theme, options.UseColor)}
+}
+
+func (s *textStream) WriteCommit(commitReport domain.CommitReport) {
+	if s.first == nil && s.written == 0 {
+		s.first = &commitReport
+
+		return
+	}
+
+	if s.first != nil {
+		s.writeCommit(*s.first, 2)
+		s.first = nil
+	}
+
+	s.writeCommit(commitReport, 2)
+}
+
+func (s *textStream) Close(report domain.Report) error {
+	for _, commitReport := range report.Commits {
+		s.WriteCommit(commitReport)
+	}
+
+	if s.first != nil {
+		s.writeCommit(*s.first, 1)
+		s.first = nil
+	}
+
+	var builder strings.Builder
+
+	// Repository rules after all commits
+	if len(report.Repository.RuleResults) > 0 {
+		writeRepositoryRules(&builder, report.Repository, s.colors, s.options)
+	}
+
+	// Summary for multiple commits - show at the end
+	if s.written > 1 {
+		writeTextSummary(&builder, report.Summary, s.colors, s.options)
+	}
+
+	s.write(builder.String())
+
+	return s.err
+}
+
+// writeCommit writes a commit of a report with totalCommits commits, numbering it when
+// there are several.
+func (s *textStream) writeCommit(commitReport domain.CommitReport, totalCommits int) {
+	var builder strings.Builder
+
+	writeCommitHeader(&builder, commitReport, s.written, totalCommits, s.colors, s.options)
+	writeCommitRules(&builder, commitReport, s.colors, s.options, nil) // Don't show repo rules per commit
+
+	s.written++
+	s.write(builder.String())
+}
+
+func (s *textStream) write(text string) {
+	if s.err == nil && text != "" {
+		_, s.err = io.WriteString(s.writer, text)
+	}
+}
+
+// jsonStream writes the JSON format, with the commit results first, as only they are
+// known before the end of the report.
+type jsonStream struct {
+	writer  io.Writer
+	commits int
+	err     error
+}
+
+func (s *jsonStream) WriteCommit(commitReport domain.CommitReport) {
+	results := convertCommitsToJSON([]domain.CommitReport{commitReport})
+	if len(results) == 0 || s.err != nil {
+		return
+	}
+
+	data, err := json.MarshalIndent(results[0], "    ", "  ")
+	if err != nil {
+		s.err = err
+
+		return
+	}
+
+	separator := ",\n    "
+	if s.commits == 0 {
+		separator = "{\n  \"commitResults\": [\n    "
+	}
+
+	s.commits++
+	s.write(separator + string(data))
+}
+
+func (s *jsonStream) Close(report domain.Report) error {
+	for _, commitReport := range report.Commits {
+		s.WriteCommit(commitReport)
+	}
+
+	data, err := json.MarshalIndent(reportFieldsJSON(report), "", "  ")
+	if err != nil && s.err == nil {
+		s.err = err
+	}
+
+	if s.err != nil {
+		return s.err
+	}
+
+	// The rest of the report continues the object opened by the commit results
+	if s.commits == 0 {
+		s.write("{\n  \"commitResults\": []," + string(data[1:]))
+	} else {
+		s.write("\n  ]," + string(data[1:]))
+	}
+
+	return s.err
+}
+
+func (s *jsonStream) write(text string) {
+	if s.err == nil {
+		_, s.err = io.WriteString(s.writer, text)
+	}
+}
+
+// tableStream writes the CSV and TSV formats, the header row before the first failure.
+type tableStream struct {
+	writer *csv.Writer
+	header bool
+	err    error
+}
+
+func newTableStream(writer io.Writer, comma rune) *tableStream {
+	csvWriter := csv.NewWriter(writer)
+	csvWriter.Comma = comma
+
+	return &tableStream{writer: csvWriter}
+}
+
+func (s *tableStream) WriteCommit(commitReport domain.CommitReport) {
+	commit := commitReport.Commit
+	s.writeRows(appendFailureRows(nil, commitReport.RuleResults, commit.Hash, commit.Author, commit.CommitDate))
+}
+
+func (s *tableStream) Close(report domain.Report) error {
+	for _, commitReport := range report.Commits {
+		s.WriteCommit(commitReport)
+	}
+
+	s.writeRows(appendFailureRows(nil, report.Repository.RuleResults, "", "", ""))
+	s.writer.Flush()
+
+	if s.err != nil {
+		return s.err
+	}
+
+	return s.writer.Error()
+}
+
+func (s *tableStream) writeRows(rows [][]string) {
+	if !s.header {
+		rows = append([][]string{failureColumns}, rows...)
+		s.header = true
+	}
+
+	for _, row := range rows {
+		if s.err == nil {
+			s.err = s.writer.Write(row)
+		}
+	}
+}
@@ -0,0 +1,87 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package output
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/itiquette/gommitlint/internal/domain"
+)
+
+func TestCommitStream(t *testing.T) {
+	failed := domain.CommitReport{
+		Commit: domain.Commit{Hash: "abc1234567890", Subject: "Add login", Author: "Ada Lovelace", CommitDate: "2025-06-01T12:00:00Z"},
+		RuleResults: []domain.RuleReport{
+			{
+				Name:   "ConventionalCommit",
+				Status: domain.StatusFailed,
+				Errors: []domain.ValidationError{domain.New("ConventionalCommit", domain.ErrInvalidConventionalFormat, "Invalid format")},
+			},
+		},
+	}
+	passed := domain.CommitReport{
+		Commit:      domain.Commit{Hash: "def4567890123", Subject: "fix: handle empty password", CommitDate: "2025-06-02T12:00:00Z"},
+		RuleResults: []domain.RuleReport{{Name: "ConventionalCommit", Status: domain.StatusPassed}},
+		Passed:      true,
+	}
+	report := domain.Report{
+		Summary: domain.ReportSummary{TotalCommits: 2, PassedCommits: 1, FailedCommits: 1, FailedRules: map[string]int{"ConventionalCommit": 1}},
+		Commits: []domain.CommitReport{failed, passed},
+	}
+
+	for _, format := range []string{"text", "json", "csv", "tsv"} {
+		t.Run(format, func(t *testing.T) {
+			whole := Format(format, report, TextOptions{})
+
+			// Commits written before the rest of the report print as the whole report does
+			for streamed := range len(report.Commits) + 1 {
+				var builder strings.Builder
+
+				stream, ok := NewCommitStream(format, &builder, TextOptions{})
+				require.True(t, ok)
+
+				for _, commitReport := range report.Commits[:streamed] {
+					stream.WriteCommit(commitReport)
+				}
+
+				rest := report
+				rest.Commits = report.Commits[streamed:]
+
+				require.NoError(t, stream.Close(rest))
+				require.Equal(t, whole, builder.String(), "%d commits streamed", streamed)
+			}
+		})
+	}
+
+	var decoded map[string]interface{}
+
+	require.NoError(t, json.Unmarshal([]byte(JSON(report)), &decoded))
+	require.Len(t, decoded["commitResults"], 2)
+	require.Equal(t, false, decoded["allPassed"])
+
+	require.NoError(t, json.Unmarshal([]byte(JSON(domain.Report{})), &decoded))
+	require.Empty(t, decoded["commitResults"])
+
+	_, ok := NewCommitStream("html", &strings.Builder{}, TextOptions{})
+	require.False(t, ok, "HTML is only written whole")
+}
+
+func TestCommitStream_SingleCommitIsNotNumbered(t *testing.T) {
+	var builder strings.Builder
+
+	stream, ok := NewCommitStream("text", &builder, TextOptions{})
+	require.True(t, ok)
+
+	stream.WriteCommit(domain.CommitReport{Commit: domain.Commit{Hash: "abc1234567890", Subject: "fix: handle empty password"}, Passed: true})
+	require.Empty(t, builder.String(), "the first commit waits to learn whether commits are numbered")
+
+	require.NoError(t, stream.Close(domain.Report{Summary: domain.ReportSummary{TotalCommits: 1, PassedCommits: 1, AllPassed: true}}))
+	require.Contains(t, builder.String(), "fix: handle empty password")
+	require.NotContains(t, builder.String(), "COMMIT #1:")
+}
@@ -0,0 +1,72 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package output
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/itiquette/gommitlint/internal/domain"
+)
+
+// SummaryText formats only the counts of a report on one line, such as
+// "3 commits checked: 2 passed, 1 failed, 1 skipped; failures: Subject 2", for
+// status badges and scheduled jobs.
+func SummaryText(report domain.Report, options TextOptions) string {
+	summary := report.Summary
+
+	line := options.textf("summary_counts", "%d commits checked: %d passed, %d failed",
+		summary.TotalCommits, summary.PassedCommits, summary.FailedCommits)
+
+	if summary.SkippedCommits > 0 {
+		line += ", " + options.textf("summary_skipped_count", "%d skipped", summary.SkippedCommits)
+	}
+
+	rules := make([]string, 0, len(summary.FailedRules))
+	for rule := range summary.FailedRules {
+		rules = append(rules, rule)
+	}
+
+	sort.Strings(rules)
+
+	failures := make([]string, 0, len(rules))
+	for _, rule := range rules {
+		failures = append(failures, fmt.Sprintf("%s %d", rule, summary.FailedRules[rule]))
+	}
+
+	if len(failures) > 0 {
+		line += "; " + options.textf("summary_failures", "failures: %s", strings.Join(failures, ", "))
+	}
+
+	return line + "\n"
+}
+
+// SummaryJSON formats only the counts of a report as JSON, with the field names of
+// the full JSON report.
+func SummaryJSON(report domain.Report) string {
+	ruleSummary := report.Summary.FailedRules
+	if ruleSummary == nil {
+		ruleSummary = map[string]int{}
+	}
+
+	output := map[string]interface{}{
+		"schemaVersion":  domain.ContextSchemaVersion,
+		"allPassed":      report.Summary.AllPassed,
+		"totalCommits":   report.Summary.TotalCommits,
+		"passedCommits":  report.Summary.PassedCommits,
+		"failedCommits":  report.Summary.FailedCommits,
+		"skippedCommits": report.Summary.SkippedCommits,
+		"ruleSummary":    ruleSummary,
+	}
+
+	jsonBytes, err := json.MarshalIndent(output, "", "  ")
+	if err != nil {
+		return `{"error": "failed to marshal JSON"}` + "\n"
+	}
+
+	return string(jsonBytes) + "\n"
+}
@@ -0,0 +1,78 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package output
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/itiquette/gommitlint/internal/domain"
+)
+
+func TestSummaryText(t *testing.T) {
+	tests := []struct {
+		name     string
+		summary  domain.ReportSummary
+		texts    map[string]string
+		expected string
+	}{
+		{
+			name:     "all passed",
+			summary:  domain.ReportSummary{TotalCommits: 4, PassedCommits: 4, AllPassed: true},
+			expected: "4 commits checked: 4 passed, 0 failed\n",
+		},
+		{
+			name: "failures sorted by rule",
+			summary: domain.ReportSummary{
+				TotalCommits: 3, PassedCommits: 1, FailedCommits: 2,
+				FailedRules: map[string]int{"Subject": 2, "ConventionalCommit": 1},
+			},
+			expected: "3 commits checked: 1 passed, 2 failed; failures: ConventionalCommit 1, Subject 2\n",
+		},
+		{
+			name:     "skipped merge commits",
+			summary:  domain.ReportSummary{TotalCommits: 2, PassedCommits: 2, SkippedCommits: 1, AllPassed: true},
+			expected: "2 commits checked: 2 passed, 0 failed, 1 skipped\n",
+		},
+		{
+			name:    "translated",
+			summary: domain.ReportSummary{TotalCommits: 1, FailedCommits: 1, FailedRules: map[string]int{"Subject": 1}},
+			texts: map[string]string{
+				"summary_counts":   "%d commits kontrollerade: %d godkända, %d underkända",
+				"summary_failures": "fel: %s",
+			},
+			expected: "1 commits kontrollerade: 0 godkända, 1 underkända; fel: Subject 1\n",
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			report := domain.Report{Summary: testCase.summary}
+			require.Equal(t, testCase.expected, SummaryText(report, TextOptions{Texts: testCase.texts}))
+		})
+	}
+}
+
+func TestSummaryJSON(t *testing.T) {
+	report := domain.Report{
+		Summary: domain.ReportSummary{TotalCommits: 2, PassedCommits: 2, AllPassed: true},
+		Commits: []domain.CommitReport{{Commit: domain.Commit{Hash: "abc1234"}, Passed: true}},
+	}
+
+	var jsonData map[string]interface{}
+	require.NoError(t, json.Unmarshal([]byte(SummaryJSON(report)), &jsonData))
+
+	require.Equal(t, map[string]interface{}{
+		"schemaVersion":  domain.ContextSchemaVersion,
+		"allPassed":      true,
+		"totalCommits":   float64(2),
+		"passedCommits":  float64(2),
+		"failedCommits":  float64(0),
+		"skippedCommits": float64(0),
+		"ruleSummary":    map[string]interface{}{},
+	}, jsonData)
+}
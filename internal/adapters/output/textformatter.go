@@ -9,7 +9,6 @@ import (
 	"sort"
 	"strings"
 
-	"github.com/fatih/color"
 	"github.com/itiquette/gommitlint/internal/domain"
 )
 
@@ -30,36 +29,48 @@ type TextOptions struct {
 	ShowRuleHelp bool
 	RuleHelpName string
 	UseColor     bool
+	Theme        string            // Color theme, see Themes; empty is the default theme
+	ASCII        bool              // ASCII symbols instead of unicode ones
+	Texts        map[string]string // Translated text keyed by text identifier, see textf
+	LinkTemplate string            // URL of a commit with {hash}, see domain.CommitLink; empty links no commits
+}
+
+// textf formats the text identified by key, using the translation from Texts when
+// present and the English format otherwise. Translations use the same verbs as the English format.
+func (o TextOptions) textf(key, format string, args ...interface{}) string {
+	if translated, ok := o.Texts[key]; ok && translated != "" {
+		format = translated
+	}
+
+	if len(args) == 0 {
+		return format
+	}
+
+	return fmt.Sprintf(format, args...)
 }
 
 // Text formats a domain report as plain text with colors (pure function).
 func Text(report domain.Report, options TextOptions) string {
 	var builder strings.Builder
 
-	colors := getColorScheme(options.UseColor)
+	// Writing to a strings.Builder never fails
+	_ = newTextStream(&builder, options).Close(report)
 
-	// Format each commit
-	for i, commitReport := range report.Commits {
-		writeCommitHeader(&builder, commitReport, i, len(report.Commits), colors)
-		writeCommitRules(&builder, commitReport, colors, options, nil) // Don't show repo rules per commit
-	}
+	return builder.String()
+}
 
-	// Repository rules after all commits
-	if len(report.Repository.RuleResults) > 0 {
-		writeRepositoryRules(&builder, report.Repository.RuleResults, colors, options)
+// writeTextSummary writes the counts of a report of several commits and its failed rules.
+func writeTextSummary(builder *strings.Builder, summary domain.ReportSummary, colors colorScheme, options TextOptions) {
+	if summary.AllPassed {
+		builder.WriteString(colors.Success(options.textf("summary_all_passed", "SUCCESS: All %d commits passed validation", summary.TotalCommits) + "\n\n"))
+	} else {
+		builder.WriteString(colors.Warning(options.textf("summary_some_passed", "SUMMARY: %d of %d commits passed validation", summary.PassedCommits, summary.TotalCommits) + "\n\n"))
+		writeFailedRulesSummary(builder, summary, colors, options)
 	}
 
-	// Summary for multiple commits - show at the end
-	if len(report.Commits) > 1 {
-		if report.Summary.AllPassed {
-			builder.WriteString(colors.Success(fmt.Sprintf("SUCCESS: All %d commits passed validation\n\n", report.Summary.TotalCommits)))
-		} else {
-			builder.WriteString(colors.Warning(fmt.Sprintf("SUMMARY: %d of %d commits passed validation\n\n", report.Summary.PassedCommits, report.Summary.TotalCommits)))
-			writeFailedRulesSummary(&builder, report.Summary, colors)
-		}
+	if summary.SkippedCommits > 0 {
+		builder.WriteString(colors.Muted(options.textf("summary_skipped", "SKIPPED: %d commits, no rules ran", summary.SkippedCommits) + "\n\n"))
 	}
-
-	return builder.String()
 }
 
 // createErrorSummary creates a concise summary for multiple errors (pure function).
@@ -106,37 +117,7 @@ func extractErrorType(err domain.ValidationError) string {
 	return err.Message
 }
 
-// getColorScheme returns the appropriate color scheme based on color usage setting.
-func getColorScheme(useColor bool) colorScheme {
-	if !useColor {
-		// No color - return functions that return plain text
-		noOp := func(a ...interface{}) string {
-			return fmt.Sprint(a...)
-		}
-
-		return colorScheme{
-			Success: noOp,
-			Warning: noOp,
-			Error:   noOp,
-			Header:  noOp,
-			Bold:    noOp,
-			Muted:   noOp,
-		}
-	}
-
-	// Universal bright colors that work on both dark and light backgrounds
-	// Using high intensity colors for better readability
-	return colorScheme{
-		Success: color.New(color.FgHiGreen).SprintFunc(),
-		Warning: color.New(color.FgHiYellow).SprintFunc(),
-		Error:   color.New(color.FgHiRed, color.Bold).SprintFunc(),
-		Header:  color.New(color.FgHiCyan, color.Bold).SprintFunc(),
-		Bold:    color.New(color.Bold).SprintFunc(),
-		Muted:   color.New(color.Reset).SprintFunc(), // Default terminal color
-	}
-}
-
-func writeFailedRulesSummary(builder *strings.Builder, summary domain.ReportSummary, colors colorScheme) {
+func writeFailedRulesSummary(builder *strings.Builder, summary domain.ReportSummary, colors colorScheme, options TextOptions) {
 	if len(summary.FailedRules) == 0 {
 		return
 	}
@@ -151,17 +132,17 @@ func writeFailedRulesSummary(builder *strings.Builder, summary domain.ReportSumm
 
 	for _, ruleName := range ruleNames {
 		count := summary.FailedRules[ruleName]
-		builder.WriteString(fmt.Sprintf("  - %s: %d failure(s)\n", colors.Bold(ruleName), count))
+		builder.WriteString(fmt.Sprintf("  - %s: %s\n", colors.Bold(ruleName), options.textf("rule_failures", "%d failure(s)", count)))
 	}
 }
 
-func writeCommitHeader(builder *strings.Builder, commitReport domain.CommitReport, index, totalCommits int, colors colorScheme) {
+func writeCommitHeader(builder *strings.Builder, commitReport domain.CommitReport, index, totalCommits int, colors colorScheme, options TextOptions) {
 	if commitReport.Commit.Hash == "" {
 		return
 	}
 
 	if totalCommits > 1 {
-		builder.WriteString(colors.Header(fmt.Sprintf("COMMIT #%d:\n", index+1)))
+		builder.WriteString(colors.Header(options.textf("commit_number", "COMMIT #%d:", index+1) + "\n"))
 	}
 
 	divider := strings.Repeat("=", 80)
@@ -172,14 +153,33 @@ func writeCommitHeader(builder *strings.Builder, commitReport domain.CommitRepor
 		shortSHA = shortSHA[:7]
 	}
 
-	builder.WriteString(fmt.Sprintf("%s %s\n", colors.Header("COMMIT-SHA:"), colors.Bold(shortSHA)))
-	builder.WriteString(fmt.Sprintf("%s %s\n", colors.Header("SUBJECT:"), commitReport.Commit.Subject))
-	builder.WriteString(fmt.Sprintf("%s %s\n", colors.Header("DATE:"), commitReport.Commit.CommitDate))
+	builder.WriteString(fmt.Sprintf("%s %s\n", colors.Header(options.textf("commit_sha", "COMMIT-SHA:")),
+		textCommitLink(colors.Bold(shortSHA), commitLink(options.LinkTemplate, commitReport), options.UseColor)))
+
+	if len(commitReport.Duplicates) > 0 {
+		duplicates := make([]string, len(commitReport.Duplicates))
+		for i, hash := range commitReport.Duplicates {
+			if len(hash) > 7 {
+				hash = hash[:7]
+			}
+
+			duplicates[i] = hash
+		}
+
+		builder.WriteString(fmt.Sprintf("%s %s\n", colors.Header(options.textf("duplicates", "DUPLICATES:")), strings.Join(duplicates, ", ")))
+	}
+	builder.WriteString(fmt.Sprintf("%s %s\n", colors.Header(options.textf("subject", "SUBJECT:")), commitReport.Commit.Subject))
+
+	if commitReport.Submodule != "" {
+		builder.WriteString(fmt.Sprintf("%s %s\n", colors.Header(options.textf("submodule", "SUBMODULE:")), commitReport.Submodule))
+	}
+
+	builder.WriteString(fmt.Sprintf("%s %s\n", colors.Header(options.textf("date", "DATE:")), commitReport.Commit.CommitDate))
 
 	if commitReport.Commit.Message != "" {
 		parts := strings.SplitN(commitReport.Commit.Message, "\n", 2)
 		if len(parts) > 1 && parts[1] != "" {
-			builder.WriteString(fmt.Sprintf("%s\n%s\n", colors.Header("MESSAGE:"), parts[1]))
+			builder.WriteString(fmt.Sprintf("%s\n%s\n", colors.Header(options.textf("message", "MESSAGE:")), parts[1]))
 		}
 	}
 
@@ -187,6 +187,13 @@ func writeCommitHeader(builder *strings.Builder, commitReport domain.CommitRepor
 }
 
 func writeCommitRules(builder *strings.Builder, commitReport domain.CommitReport, colors colorScheme, options TextOptions, _ []domain.RuleReport) {
+	// No rule ran for skipped commits, so there are no rules to list
+	if commitReport.SkipReason != "" {
+		builder.WriteString(colors.Muted(commitSkipMessage(commitReport.SkipReason, options)) + "\n\n")
+
+		return
+	}
+
 	// Only use commit rules
 	commitRules := commitReport.RuleResults
 
@@ -216,23 +223,40 @@ func writeCommitRules(builder *strings.Builder, commitReport domain.CommitReport
 
 	// Summary line - only show if we're showing all rules or if we found the specific rule
 	if !options.ShowRuleHelp || len(rulesToShow) > 0 {
-		totalRules := len(rulesToShow)
+		// Rules that do not apply to the commit are listed, but not counted
+		totalRules := len(rulesToShow) - countStatus(rulesToShow, domain.StatusNotApplicable)
 		if passedCount == totalRules {
-			builder.WriteString(colors.Success(fmt.Sprintf("\nPASS: All %d rules passed\n\n", totalRules)))
+			builder.WriteString(colors.Success("\n" + options.textf("rules_all_passed", "PASS: All %d rules passed", totalRules) + "\n\n"))
 		} else {
-			builder.WriteString(colors.Warning(fmt.Sprintf("\nFAIL: %d of %d rules passed\n\n", passedCount, totalRules)))
+			builder.WriteString(colors.Warning("\n" + options.textf("rules_some_passed", "FAIL: %d of %d rules passed", passedCount, totalRules) + "\n\n"))
 		}
 	} else if options.ShowRuleHelp {
 		// Rule not found
-		builder.WriteString(colors.Warning(fmt.Sprintf("\nRule '%s' not found in validation results\n\n", options.RuleHelpName)))
+		builder.WriteString(colors.Warning("\n" + options.textf("rule_not_found", "Rule '%s' not found in validation results", options.RuleHelpName) + "\n\n"))
 	}
 }
 
-// writeRepositoryRules writes repository-level validation results.
-func writeRepositoryRules(builder *strings.Builder, repoResults []domain.RuleReport, colors colorScheme, options TextOptions) {
+// writeRepositoryRules writes repository-level validation results, headed by the
+// repository, branch and range validated.
+func writeRepositoryRules(builder *strings.Builder, repoReport domain.RepositoryReport, colors colorScheme, options TextOptions) {
+	repoResults := repoReport.RuleResults
+
 	divider := strings.Repeat("=", 80)
 	builder.WriteString(colors.Header(divider) + "\n")
-	builder.WriteString(colors.Header("REPOSITORY VALIDATION:") + "\n")
+	builder.WriteString(colors.Header(options.textf("repository_validation", "REPOSITORY VALIDATION:")) + "\n")
+
+	if repoReport.Name != "" {
+		builder.WriteString(fmt.Sprintf("%s %s\n", colors.Header(options.textf("repository_name", "REPOSITORY:")), colors.Bold(repoReport.Name)))
+	}
+
+	if repoReport.Branch != "" {
+		builder.WriteString(fmt.Sprintf("%s %s\n", colors.Header(options.textf("branch", "BRANCH:")), repoReport.Branch))
+	}
+
+	if repoReport.Range != "" {
+		builder.WriteString(fmt.Sprintf("%s %s\n", colors.Header(options.textf("range", "RANGE:")), repoReport.Range))
+	}
+
 	builder.WriteString(colors.Header(divider) + "\n\n")
 
 	// Filter rules if specific rule help is requested
@@ -259,12 +283,17 @@ func writeRepositoryRules(builder *strings.Builder, repoResults []domain.RuleRep
 
 	passedCount := writeRuleReports(builder, rulesToShow, colors, options)
 
-	// Repository summary line
+	// Repository rules are only skipped without a repository, and then all of them are
 	totalRules := len(rulesToShow)
-	if passedCount == totalRules {
-		builder.WriteString(colors.Success(fmt.Sprintf("\nPASS: All %d repository rules passed\n\n", totalRules)))
-	} else {
-		builder.WriteString(colors.Warning(fmt.Sprintf("\nFAIL: %d of %d repository rules passed\n\n", passedCount, totalRules)))
+
+	switch {
+	case totalRules > 0 && rulesToShow[0].Status == domain.StatusSkipped:
+		builder.WriteString(colors.Muted("\n" + options.textf("repository_rules_skipped",
+			"SKIPPED: No repository, %d repository rules did not run", totalRules) + "\n\n"))
+	case passedCount == totalRules:
+		builder.WriteString(colors.Success("\n" + options.textf("repository_rules_all_passed", "PASS: All %d repository rules passed", totalRules) + "\n\n"))
+	default:
+		builder.WriteString(colors.Warning("\n" + options.textf("repository_rules_some_passed", "FAIL: %d of %d repository rules passed", passedCount, totalRules) + "\n\n"))
 	}
 }
 
@@ -272,7 +301,7 @@ func writeRepositoryRules(builder *strings.Builder, repoResults []domain.RuleRep
 func writeFormattedError(builder *strings.Builder, err domain.ValidationError, options TextOptions, showHelpText bool, colors colorScheme) {
 	if options.VerboseLevel == 0 {
 		// Default: just the message
-		builder.WriteString(fmt.Sprintf("%s%s\n", baseIndent, err.Message))
+		builder.WriteString(fmt.Sprintf("%s%s\n", baseIndent, errorMessage(err, options, colors)))
 
 		return
 	}
@@ -281,14 +310,14 @@ func writeFormattedError(builder *strings.Builder, err domain.ValidationError, o
 	if err.Code != "" {
 		builder.WriteString(fmt.Sprintf("%s%s %s\n",
 			baseIndent,
-			colors.Bold("Error Code:"),
+			colors.Bold(options.textf("error_code", "Error Code:")),
 			colors.Warning(err.Code)))
 	}
 
 	builder.WriteString(fmt.Sprintf("%s%s %s\n",
 		baseIndent,
-		colors.Bold("Error Message:"),
-		err.Message))
+		colors.Bold(options.textf("error_message", "Error Message:")),
+		errorMessage(err, options, colors)))
 
 	// Show context in structured format
 	if len(err.Context) > 0 {
@@ -310,7 +339,7 @@ func writeFormattedError(builder *strings.Builder, err domain.ValidationError, o
 
 		// Write remaining context fields (excluding subject and ordered keys)
 		for key, value := range err.Context {
-			if !contains(orderedKeys, key) && key != "subject" {
+			if !contains(orderedKeys, key) && key != "subject" && key != domain.ContextSuggestion {
 				displayKey := formatContextKey(key)
 				formattedValue := formatContextValue(key, value, colors)
 				builder.WriteString(fmt.Sprintf("%s%s %s\n", baseIndent, colors.Muted(displayKey+":"), formattedValue))
@@ -320,11 +349,21 @@ func writeFormattedError(builder *strings.Builder, err domain.ValidationError, o
 
 	// Show help with -vv or specific rule help
 	if showHelpText && err.Help != "" {
-		builder.WriteString(fmt.Sprintf("\n%s%s\n", baseIndent, colors.Bold("Help:")))
-		writeHelpSection(builder, err.Help, colors)
+		builder.WriteString(fmt.Sprintf("\n%s%s\n", baseIndent, colors.Bold(options.textf("help", "Help:"))))
+		writeHelpSection(builder, err.Help, colors, getSymbols(options.ASCII))
 	}
 }
 
+// errorMessage returns the message of err, followed by its suggestion if it has one.
+func errorMessage(err domain.ValidationError, options TextOptions, colors colorScheme) string {
+	suggestion := err.Suggestion()
+	if suggestion == "" {
+		return err.Message
+	}
+
+	return err.Message + " (" + options.textf("did_you_mean", "did you mean %s?", colors.Success("'"+suggestion+"'")) + ")"
+}
+
 // formatTechnicalContextKey formats specific technical context keys with better names.
 func formatTechnicalContextKey(key string) string {
 	switch key {
@@ -362,19 +401,19 @@ func formatContextValue(key, value string, colors colorScheme) string {
 }
 
 // writeHelpSection writes the help text with proper formatting and consistent indentation.
-func writeHelpSection(builder *strings.Builder, helpText string, colors colorScheme) {
-	lines := strings.Split(helpText, "\n")
+func writeHelpSection(builder *strings.Builder, helpText string, colors colorScheme, symbols symbolSet) {
+	lines := strings.Split(symbols.replace(helpText), "\n")
 
 	for _, line := range lines {
 		if strings.TrimSpace(line) == "" {
 			builder.WriteString("\n")
-		} else if strings.HasPrefix(line, "✓") {
+		} else if strings.HasPrefix(line, symbols.Passed) {
 			// Success examples - highlight in green, wrap with consistent indentation
 			wrappedLines := wrapTextWithIndent(line)
 			for _, wrappedLine := range wrappedLines {
 				builder.WriteString(fmt.Sprintf("%s%s\n", baseIndent, colors.Success(wrappedLine)))
 			}
-		} else if strings.HasPrefix(line, "•") {
+		} else if strings.HasPrefix(line, symbols.Bullet) {
 			// Bullet points - use muted color, wrap with hanging indent
 			wrappedLines := wrapTextWithIndent(line)
 			for lineIndex, wrappedLine := range wrappedLines {
@@ -466,18 +505,72 @@ func formatContextKey(key string) string {
 	return strings.Join(words, " ")
 }
 
+// skipMessage tells why a skipped rule did not run.
+func skipMessage(ruleReport domain.RuleReport, options TextOptions) string {
+	if ruleReport.SkipReason == domain.SkipReasonNoRepository {
+		return options.textf("rule_skipped_no_repository", "skipped: no repository")
+	}
+
+	return options.textf("rule_skipped", "skipped, %s failed", ruleReport.BlockedBy)
+}
+
+// commitSkipMessage tells why no rule ran for a commit.
+func commitSkipMessage(reason string, options TextOptions) string {
+	if reason == domain.SkipReasonMergeCommit {
+		return options.textf("commit_skipped_merge", "SKIPPED: Merge commit, no rules ran")
+	}
+
+	return options.textf("commit_skipped", "SKIPPED: %s, no rules ran", reason)
+}
+
+// countStatus counts the rule reports with status.
+func countStatus(ruleReports []domain.RuleReport, status domain.ValidationStatus) int {
+	count := 0
+
+	for _, ruleReport := range ruleReports {
+		if ruleReport.Status == status {
+			count++
+		}
+	}
+
+	return count
+}
+
 // writeRuleReports writes rule reports and returns the count of passed rules.
 func writeRuleReports(builder *strings.Builder, rulesToShow []domain.RuleReport, colors colorScheme, options TextOptions) int {
 	passedCount := 0
+	symbols := getSymbols(options.ASCII)
 
 	for _, ruleReport := range rulesToShow {
-		symbol := "✓"
+		if ruleReport.Status == domain.StatusNotApplicable {
+			// Conditional rules that do not apply to the commit did not run
+			builder.WriteString(fmt.Sprintf("%s %s: %s\n", colors.Muted("-"), colors.Bold(ruleReport.Name),
+				options.textf("rule_not_applicable", "not applicable")))
+
+			continue
+		}
+
+		if ruleReport.Status == domain.StatusSkipped {
+			// Skipped rules did not run, so they count neither as passed nor as failed
+			builder.WriteString(fmt.Sprintf("%s %s: %s\n", colors.Muted("-"), colors.Bold(ruleReport.Name),
+				skipMessage(ruleReport, options)))
+
+			continue
+		}
+
+		symbol := symbols.Passed
 		statusColor := colors.Success
 
-		if len(ruleReport.Errors) > 0 {
-			symbol = "✗"
+		switch {
+		case ruleReport.Status == domain.StatusWarning:
+			// Warnings are shown but do not fail the rule
+			symbol = symbols.Warning
+			statusColor = colors.Warning
+			passedCount++
+		case len(ruleReport.Errors) > 0:
+			symbol = symbols.Failed
 			statusColor = colors.Error
-		} else {
+		default:
 			passedCount++
 		}
 
@@ -487,7 +580,7 @@ func writeRuleReports(builder *strings.Builder, rulesToShow []domain.RuleReport,
 			builder.WriteString(fmt.Sprintf("%s %s\n", statusColor(symbol), colors.Bold(ruleReport.Name)))
 		} else if len(ruleReport.Errors) == 1 && options.VerboseLevel == 0 {
 			// Single error at basic level - show inline
-			builder.WriteString(fmt.Sprintf("%s %s: %s\n", statusColor(symbol), colors.Bold(ruleReport.Name), ruleReport.Errors[0].Message))
+			builder.WriteString(fmt.Sprintf("%s %s: %s\n", statusColor(symbol), colors.Bold(ruleReport.Name), errorMessage(ruleReport.Errors[0], options, colors)))
 		} else {
 			// Multiple errors or verbose mode
 			if options.VerboseLevel == 0 {
@@ -496,7 +589,7 @@ func writeRuleReports(builder *strings.Builder, rulesToShow []domain.RuleReport,
 
 				for _, err := range ruleReport.Errors {
 					// Basic level formatting - just the message without indentation
-					builder.WriteString(err.Message + "\n")
+					builder.WriteString(errorMessage(err, options, colors) + "\n")
 				}
 			} else {
 				// Verbose and very verbose: show details
@@ -511,7 +604,7 @@ func writeRuleReports(builder *strings.Builder, rulesToShow []domain.RuleReport,
 
 					// Add light dim orange divider after every error section in verbose modes
 					if options.VerboseLevel >= 1 {
-						writeDivider(builder, options.UseColor)
+						writeDivider(builder, options)
 					}
 				}
 
@@ -527,11 +620,12 @@ func writeRuleReports(builder *strings.Builder, rulesToShow []domain.RuleReport,
 	return passedCount
 }
 
-// writeDivider writes a formatted divider with optional color support.
-func writeDivider(builder *strings.Builder, useColor bool) {
-	dividerText := dividerPattern
-	if useColor {
-		dividerText = lightOrangeANSI214 + dividerPattern + resetANSI
+// writeDivider writes a formatted divider with optional color support. The monochrome
+// theme leaves it uncolored.
+func writeDivider(builder *strings.Builder, options TextOptions) {
+	dividerText := getSymbols(options.ASCII).Divider
+	if options.UseColor && options.Theme != ThemeMonochrome {
+		dividerText = lightOrangeANSI214 + dividerText + resetANSI
 	}
 
 	builder.WriteString(fmt.Sprintf("\n%s%s\n", baseIndent, dividerText))
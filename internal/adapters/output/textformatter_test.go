@@ -204,6 +204,187 @@ func TestText_SingleError(t *testing.T) {
 	require.NotContains(t, result, "Help:")
 }
 
+func TestText_Suggestion(t *testing.T) {
+	failure := domain.New("ImperativeVerb", domain.ErrPastTense, "'Added' is past tense").
+		WithContextMap(map[string]string{"actual": "Added"}).
+		WithSuggestion("Add")
+
+	report := domain.Report{
+		Commits: []domain.CommitReport{{
+			Commit: domain.Commit{Hash: "abc1234", Subject: "Added login", Message: "Added login"},
+			RuleResults: []domain.RuleReport{
+				{Name: "ImperativeVerb", Status: domain.StatusFailed, Errors: []domain.ValidationError{failure}},
+			},
+		}},
+		Summary: domain.ReportSummary{TotalCommits: 1},
+	}
+
+	result := Text(report, TextOptions{})
+	require.Contains(t, result, "ImperativeVerb: 'Added' is past tense (did you mean 'Add'?)")
+
+	verbose := Text(report, TextOptions{Verbose: true, VerboseLevel: 1})
+	require.Contains(t, verbose, "Error Message: 'Added' is past tense (did you mean 'Add'?)")
+	require.NotContains(t, verbose, "Suggestion:")
+}
+
+func TestText_SkippedRule(t *testing.T) {
+	failure := domain.New("Subject", domain.ErrEmptySubject, "Subject is empty")
+
+	report := domain.Report{
+		Commits: []domain.CommitReport{{
+			Commit: domain.Commit{Hash: "abc1234", Subject: "", Message: ""},
+			RuleResults: []domain.RuleReport{
+				{Name: "Subject", Status: domain.StatusFailed, Errors: []domain.ValidationError{failure}},
+				{Name: "Spell", Status: domain.StatusSkipped, BlockedBy: "Subject"},
+			},
+		}},
+		Summary: domain.ReportSummary{TotalCommits: 1, FailedCommits: 1},
+	}
+
+	result := Text(report, TextOptions{})
+	require.Contains(t, result, "- Spell: skipped, Subject failed\n")
+	require.Contains(t, result, "FAIL: 0 of 2 rules passed")
+}
+
+func TestText_SkippedCommitAndNotApplicableRule(t *testing.T) {
+	report := domain.Report{
+		Commits: []domain.CommitReport{
+			{
+				Commit: domain.Commit{Hash: "abc1234", Subject: "docs: update guide"},
+				RuleResults: []domain.RuleReport{
+					{Name: "Spell", Status: domain.StatusNotApplicable},
+					{Name: "Subject", Status: domain.StatusPassed},
+				},
+				Passed: true,
+			},
+			{
+				Commit:      domain.Commit{Hash: "def5678", Subject: "Merge branch 'feature'"},
+				RuleResults: []domain.RuleReport{{Name: "Subject", Status: domain.StatusSkipped, SkipReason: domain.SkipReasonMergeCommit}},
+				Passed:      true,
+				SkipReason:  domain.SkipReasonMergeCommit,
+			},
+		},
+		Summary: domain.ReportSummary{TotalCommits: 1, PassedCommits: 1, SkippedCommits: 1, AllPassed: true},
+	}
+
+	result := Text(report, TextOptions{})
+	require.Contains(t, result, "- Spell: not applicable\n")
+	require.Contains(t, result, "PASS: All 1 rules passed")
+	require.Contains(t, result, "SKIPPED: Merge commit, no rules ran")
+	require.Contains(t, result, "SKIPPED: 1 commits, no rules ran")
+	require.Equal(t, 1, strings.Count(result, "✓ Subject"), "rules of skipped commits are not listed")
+}
+
+func TestText_RepositoryDetails(t *testing.T) {
+	report := domain.Report{
+		Repository: domain.RepositoryReport{
+			Name:        "gommitlint",
+			Branch:      "feature/login",
+			Range:       "main..HEAD",
+			RuleResults: []domain.RuleReport{{Name: "BranchAhead", Status: domain.StatusPassed}},
+		},
+	}
+
+	result := Text(report, TextOptions{})
+	require.Contains(t, result, "REPOSITORY VALIDATION:\nREPOSITORY: gommitlint\nBRANCH: feature/login\nRANGE: main..HEAD\n")
+	require.Contains(t, result, "PASS: All 1 repository rules passed")
+
+	// Without repository rules there is no repository section
+	report.Repository.RuleResults = nil
+	require.NotContains(t, Text(report, TextOptions{}), "REPOSITORY")
+
+	// Without a repository its rules did not run
+	report.Repository.RuleResults = []domain.RuleReport{
+		{Name: "BranchAhead", Status: domain.StatusSkipped, SkipReason: domain.SkipReasonNoRepository},
+	}
+	result = Text(report, TextOptions{})
+	require.Contains(t, result, "- BranchAhead: skipped: no repository\n")
+	require.Contains(t, result, "SKIPPED: No repository, 1 repository rules did not run")
+	require.NotContains(t, result, "FAIL")
+}
+
+func TestText_CommitLinks(t *testing.T) {
+	report := domain.Report{
+		Commits: []domain.CommitReport{
+			{Commit: domain.Commit{Hash: "abc1234567890def", Subject: "feat: add login"}, Passed: true},
+			{Commit: domain.Commit{Hash: "fed0987654321abc", Subject: "chore: bump lib"}, Submodule: "vendor/lib", Passed: true},
+		},
+	}
+
+	template := "https://github.com/org/repo/commit/{hash}"
+
+	result := Text(report, TextOptions{LinkTemplate: template})
+	require.Contains(t, result, "COMMIT-SHA: abc1234 https://github.com/org/repo/commit/abc1234567890def\n")
+	require.Contains(t, result, "COMMIT-SHA: fed0987\n", "commits of submodules are not linked")
+
+	// Terminals get a hyperlink on the short hash
+	result = Text(report, TextOptions{LinkTemplate: template, UseColor: true, Theme: "monochrome"})
+	require.Contains(t, result, "\x1b]8;;https://github.com/org/repo/commit/abc1234567890def\x1b\\")
+	require.NotContains(t, result, "abc1234 https://")
+
+	require.Contains(t, Text(report, TextOptions{}), "COMMIT-SHA: abc1234\n")
+}
+
+func TestText_Duplicates(t *testing.T) {
+	report := domain.Report{
+		Commits: []domain.CommitReport{
+			{
+				Commit:     domain.Commit{Hash: "abc1234567890def", Subject: "fix: handle empty input"},
+				Duplicates: []string{"bcd2345678901efa", "cde3456789012fab"},
+				Passed:     true,
+			},
+		},
+	}
+
+	result := Text(report, TextOptions{})
+	require.Contains(t, result, "COMMIT-SHA: abc1234\nDUPLICATES: bcd2345, cde3456\nSUBJECT: fix: handle empty input\n")
+
+	report.Commits[0].Duplicates = nil
+	require.NotContains(t, Text(report, TextOptions{}), "DUPLICATES")
+}
+
+func TestText_TranslatedTexts(t *testing.T) {
+	report := domain.Report{
+		Commits: []domain.CommitReport{
+			{
+				Commit: domain.Commit{Hash: "abc1234", Subject: "test commit", Message: "test commit"},
+				RuleResults: []domain.RuleReport{
+					{Name: "SignOff", Status: domain.StatusFailed, Errors: []domain.ValidationError{{Code: "missing_signoff", Message: "Sign-off saknas"}}},
+					{Name: "Subject", Status: domain.StatusPassed},
+				},
+			},
+			{
+				Commit:      domain.Commit{Hash: "def5678", Subject: "other commit", Message: "other commit"},
+				RuleResults: []domain.RuleReport{{Name: "Subject", Status: domain.StatusPassed}},
+				Passed:      true,
+			},
+		},
+		Summary: domain.ReportSummary{
+			TotalCommits:  2,
+			PassedCommits: 1,
+			FailedCommits: 1,
+			FailedRules:   map[string]int{"SignOff": 1},
+		},
+	}
+
+	options := TextOptions{Texts: map[string]string{
+		"subject":             "ÄMNESRAD:",
+		"rules_some_passed":   "UNDERKÄND: %d av %d regler uppfylldes",
+		"summary_some_passed": "SAMMANFATTNING: %d av %d commits klarade valideringen",
+		"rule_failures":       "%d fel",
+	}}
+	result := Text(report, options)
+
+	require.Contains(t, result, "ÄMNESRAD: test commit")
+	require.Contains(t, result, "UNDERKÄND: 1 av 2 regler uppfylldes")
+	require.Contains(t, result, "SAMMANFATTNING: 1 av 2 commits klarade valideringen")
+	require.Contains(t, result, "SignOff: 1 fel")
+
+	// Text without a translation stays in English
+	require.Contains(t, result, "COMMIT-SHA: abc1234")
+	require.Contains(t, result, "PASS: All 1 rules passed")
+}
+
 func TestCreateErrorSummary(t *testing.T) {
 	tests := []struct {
 		name   string
@@ -0,0 +1,125 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package output
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/fatih/color"
+)
+
+// Color themes of text output.
+const (
+	ThemeDefault      = "default"       // Bright colors that read on dark and light backgrounds
+	ThemeHighContrast = "high-contrast" // Bold colors, with failures on a red background
+	ThemeMonochrome   = "monochrome"    // Bold and underline only, for terminals without colors
+)
+
+// Themes returns the names of the color themes.
+func Themes() []string {
+	return []string{ThemeDefault, ThemeHighContrast, ThemeMonochrome}
+}
+
+// IsValidTheme reports whether theme names a color theme. An empty theme is the default.
+func IsValidTheme(theme string) bool {
+	switch theme {
+	case "", ThemeDefault, ThemeHighContrast, ThemeMonochrome:
+		return true
+	default:
+		return false
+	}
+}
+
+// symbolSet holds the status symbols of text output.
+type symbolSet struct {
+	Passed  string
+	Failed  string
+	Warning string
+	Bullet  string
+	Divider string // Line dividing the errors of verbose output
+
+	replacer *strings.Replacer // Replaces the unicode symbols in help text; nil keeps them
+}
+
+// unicodeSymbols are the symbols text output uses by default.
+var unicodeSymbols = symbolSet{Passed: "✓", Failed: "✗", Warning: "⚠", Bullet: "•", Divider: dividerPattern}
+
+// asciiSymbols replace the unicode symbols for terminals and CI logs that mangle them.
+var asciiSymbols = symbolSet{
+	Passed: "+", Failed: "x", Warning: "!", Bullet: "*", Divider: strings.Repeat(".", utf8.RuneCountInString(dividerPattern)),
+	replacer: strings.NewReplacer("✓", "+", "✗", "x", "⚠", "!", "•", "*", "→", "->"),
+}
+
+// getSymbols returns the ASCII symbols when ascii is set and the unicode symbols otherwise.
+func getSymbols(ascii bool) symbolSet {
+	if ascii {
+		return asciiSymbols
+	}
+
+	return unicodeSymbols
+}
+
+// replace returns text with its unicode symbols replaced by those of the set.
+func (s symbolSet) replace(text string) string {
+	if s.replacer == nil {
+		return text
+	}
+
+	return s.replacer.Replace(text)
+}
+
+// getColorScheme returns the color scheme of theme, or plain text without color.
+func getColorScheme(theme string, useColor bool) colorScheme {
+	if !useColor {
+		// No color - return functions that return plain text
+		noOp := func(a ...interface{}) string {
+			return fmt.Sprint(a...)
+		}
+
+		return colorScheme{
+			Success: noOp,
+			Warning: noOp,
+			Error:   noOp,
+			Header:  noOp,
+			Bold:    noOp,
+			Muted:   noOp,
+		}
+	}
+
+	switch theme {
+	case ThemeHighContrast:
+		return colorScheme{
+			Success: color.New(color.FgHiGreen, color.Bold).SprintFunc(),
+			Warning: color.New(color.FgHiYellow, color.Bold).SprintFunc(),
+			Error:   color.New(color.FgHiWhite, color.BgRed, color.Bold).SprintFunc(),
+			Header:  color.New(color.FgHiWhite, color.Bold, color.Underline).SprintFunc(),
+			Bold:    color.New(color.FgHiWhite, color.Bold).SprintFunc(),
+			Muted:   color.New(color.FgHiWhite).SprintFunc(),
+		}
+	case ThemeMonochrome:
+		// Attributes without hue, so that no meaning hangs on telling colors apart
+		return colorScheme{
+			Success: color.New(color.Reset).SprintFunc(),
+			Warning: color.New(color.Underline).SprintFunc(),
+			Error:   color.New(color.Bold, color.Underline).SprintFunc(),
+			Header:  color.New(color.Bold).SprintFunc(),
+			Bold:    color.New(color.Bold).SprintFunc(),
+			Muted:   color.New(color.Faint).SprintFunc(),
+		}
+	default:
+		// Universal bright colors that work on both dark and light backgrounds
+		// Using high intensity colors for better readability
+		return colorScheme{
+			Success: color.New(color.FgHiGreen).SprintFunc(),
+			Warning: color.New(color.FgHiYellow).SprintFunc(),
+			Error:   color.New(color.FgHiRed, color.Bold).SprintFunc(),
+			Header:  color.New(color.FgHiCyan, color.Bold).SprintFunc(),
+			Bold:    color.New(color.Bold).SprintFunc(),
+			Muted:   color.New(color.Reset).SprintFunc(), // Default terminal color
+		}
+	}
+}
@@ -0,0 +1,74 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package output
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/fatih/color"
+	"github.com/stretchr/testify/require"
+
+	"github.com/itiquette/gommitlint/internal/domain"
+)
+
+func TestText_ASCII(t *testing.T) {
+	failure := domain.New("SignOff", domain.ErrMissingSignoff, "Missing sign-off").
+		WithHelp("Add a sign-off:\n✓ Signed-off-by: Dev <dev@example.com>\n• Use git commit -s")
+	warning := domain.New("CommitSize", domain.ErrInvalidFormat, "Large commit").WithSeverity(domain.SeverityWarning)
+
+	report := domain.Report{
+		Commits: []domain.CommitReport{{
+			Commit: domain.Commit{Hash: "abc1234", Subject: "Add feature"},
+			RuleResults: []domain.RuleReport{
+				{Name: "CommitSize", Status: domain.StatusWarning, Errors: []domain.ValidationError{warning}},
+				{Name: "SignOff", Status: domain.StatusFailed, Errors: []domain.ValidationError{failure}},
+				{Name: "Subject", Status: domain.StatusPassed},
+			},
+		}},
+	}
+
+	result := Text(report, TextOptions{ASCII: true})
+	require.Contains(t, result, "! CommitSize: Large commit\n")
+	require.Contains(t, result, "x SignOff: Missing sign-off\n")
+	require.Contains(t, result, "+ Subject\n")
+
+	verbose := Text(report, TextOptions{ASCII: true, VerboseLevel: 2})
+	require.Contains(t, verbose, "+ Signed-off-by: Dev <dev@example.com>")
+	require.Contains(t, verbose, "* Use git commit -s")
+	require.Contains(t, verbose, strings.Repeat(".", 82))
+
+	for _, output := range []string{result, verbose} {
+		for _, char := range output {
+			require.Less(t, char, rune(128), "unexpected %q in ASCII output", char)
+		}
+	}
+
+	require.Contains(t, Text(report, TextOptions{}), "✗ SignOff: Missing sign-off\n")
+}
+
+func TestGetColorScheme(t *testing.T) {
+	noColor := color.NoColor
+	color.NoColor = false
+
+	t.Cleanup(func() { color.NoColor = noColor })
+
+	defaultError := getColorScheme(ThemeDefault, true).Error("failed")
+	highContrastError := getColorScheme(ThemeHighContrast, true).Error("failed")
+	monochromeError := getColorScheme(ThemeMonochrome, true).Error("failed")
+
+	require.Equal(t, defaultError, getColorScheme("", true).Error("failed"), "the empty theme is the default")
+	require.NotEqual(t, defaultError, highContrastError)
+	require.Contains(t, highContrastError, "41", "failures are on a red background")
+	require.True(t, strings.HasPrefix(monochromeError, "\x1b[1;4mfailed"), "monochrome failures are bold and underlined")
+
+	for _, theme := range Themes() {
+		require.True(t, IsValidTheme(theme))
+		require.Equal(t, "failed", getColorScheme(theme, false).Error("failed"))
+	}
+
+	require.True(t, IsValidTheme(""))
+	require.False(t, IsValidTheme("solarized"))
+}
@@ -0,0 +1,192 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package patchwork
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/itiquette/gommitlint/internal/adapters/mbox"
+)
+
+// requestTimeout bounds every API request.
+const requestTimeout = 30 * time.Second
+
+// seriesPathPattern matches the series ID in API paths such as /api/1.3/series/123/
+// and web paths such as /series/123/mbox/.
+var seriesPathPattern = regexp.MustCompile(`^(.*?)(?:/api(?:/[0-9.]+)?)?/series/([0-9]+)(?:/.*)?$`)
+
+// Series identifies a series of a Patchwork instance.
+type Series struct {
+	BaseURL string // Root of the instance, such as https://patchwork.kernel.org
+	ID      int
+}
+
+// String returns the API URL of the series.
+func (s Series) String() string {
+	return fmt.Sprintf("%s/api/series/%d/", s.BaseURL, s.ID)
+}
+
+// ParseSeries parses the URL of a series, as the API or the web interface shows it:
+// https://patchwork.example.org/api/series/123/, .../series/123/mbox/ or
+// .../project/name/list/?series=123.
+func ParseSeries(reference string) (Series, error) {
+	parsed, err := url.Parse(strings.TrimSpace(reference))
+	if err != nil || (parsed.Scheme != "https" && parsed.Scheme != "http") || parsed.Host == "" {
+		return Series{}, fmt.Errorf("invalid series %q (expected a URL such as https://patchwork.example.org/api/series/123/)", reference)
+	}
+
+	prefix := ""
+	idText := parsed.Query().Get("series")
+
+	if idText != "" {
+		// The series filter of a project list, under /project/<name>/list/
+		if index := strings.Index(parsed.Path, "/project/"); index >= 0 {
+			prefix = parsed.Path[:index]
+		}
+	} else if matches := seriesPathPattern.FindStringSubmatch(parsed.Path); matches != nil {
+		prefix, idText = matches[1], matches[2]
+	}
+
+	id, err := strconv.Atoi(idText)
+	if err != nil || id <= 0 {
+		return Series{}, fmt.Errorf("invalid series %q: no series ID found", reference)
+	}
+
+	return Series{BaseURL: parsed.Scheme + "://" + parsed.Host + strings.TrimSuffix(prefix, "/"), ID: id}, nil
+}
+
+// Client calls the Patchwork REST API.
+type Client struct {
+	httpClient *http.Client
+}
+
+// NewClient creates a client for Patchwork instances.
+func NewClient() *Client {
+	return &Client{httpClient: &http.Client{Timeout: requestTimeout}}
+}
+
+// apiSeries is the part of a series response the client uses.
+type apiSeries struct {
+	ID          int        `json:"id"`
+	Total       int        `json:"total"`
+	ReceivedAll bool       `json:"received_all"`
+	Patches     []apiPatch `json:"patches"`
+}
+
+// apiPatch is a patch as listed by a series.
+type apiPatch struct {
+	ID   int    `json:"id"`
+	Mbox string `json:"mbox"`
+}
+
+// apiError is the error body of a failed request.
+type apiError struct {
+	Detail string `json:"detail"`
+}
+
+// OpenSeries fetches a series and its patches. Series still missing patches are
+// rejected, as their checks would be incomplete.
+func (c *Client) OpenSeries(ctx context.Context, series Series) (*mbox.Repository, error) {
+	var response apiSeries
+	if err := c.getJSON(ctx, series.String(), &response); err != nil {
+		return nil, fmt.Errorf("get series %d: %w", series.ID, err)
+	}
+
+	if len(response.Patches) == 0 {
+		return nil, fmt.Errorf("series %d has no patches", series.ID)
+	}
+
+	if !response.ReceivedAll {
+		return nil, fmt.Errorf("series %d is incomplete: received %d of %d patches", series.ID, len(response.Patches), response.Total)
+	}
+
+	patches := make([]mbox.Patch, 0, len(response.Patches))
+
+	for _, listed := range response.Patches {
+		patch, err := c.getPatch(ctx, listed)
+		if err != nil {
+			return nil, err
+		}
+
+		patches = append(patches, patch)
+	}
+
+	return mbox.NewRepository(mbox.Series{Patches: patches}), nil
+}
+
+// getPatch fetches the mbox of a patch and converts it to a commit identified by the
+// Patchwork ID of the patch.
+func (c *Client) getPatch(ctx context.Context, listed apiPatch) (mbox.Patch, error) {
+	body, err := c.get(ctx, listed.Mbox, "application/mbox")
+	if err != nil {
+		return mbox.Patch{}, fmt.Errorf("get mbox of patch %d: %w", listed.ID, err)
+	}
+
+	parsed, err := mbox.Parse(bytes.NewReader(body))
+	if err != nil {
+		return mbox.Patch{}, fmt.Errorf("parse mbox of patch %d: %w", listed.ID, err)
+	}
+
+	patch := parsed.Patches[0]
+	patch.Commit.Hash = strconv.Itoa(listed.ID)
+
+	return patch, nil
+}
+
+// getJSON requests rawURL and decodes the JSON response into target.
+func (c *Client) getJSON(ctx context.Context, rawURL string, target interface{}) error {
+	body, err := c.get(ctx, rawURL, "application/json")
+	if err != nil {
+		return err
+	}
+
+	if err := json.Unmarshal(body, target); err != nil {
+		return fmt.Errorf("decode response: %w", err)
+	}
+
+	return nil
+}
+
+// get requests rawURL for the media type accept and returns the response body.
+func (c *Client) get(ctx context.Context, rawURL, accept string) ([]byte, error) {
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, rawURL, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	request.Header.Set("Accept", accept)
+
+	response, err := c.httpClient.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	body, err := io.ReadAll(response.Body)
+	if err != nil {
+		return nil, fmt.Errorf("read response: %w", err)
+	}
+
+	if response.StatusCode != http.StatusOK {
+		var failure apiError
+		if json.Unmarshal(body, &failure) == nil && failure.Detail != "" {
+			return nil, fmt.Errorf("Patchwork API returned %s: %s", response.Status, failure.Detail)
+		}
+
+		return nil, fmt.Errorf("Patchwork API returned %s", response.Status)
+	}
+
+	return body, nil
+}
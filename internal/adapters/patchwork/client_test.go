@@ -0,0 +1,134 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package patchwork_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/itiquette/gommitlint/internal/adapters/patchwork"
+)
+
+func TestParseSeries(t *testing.T) {
+	tests := []struct {
+		name        string
+		reference   string
+		expected    patchwork.Series
+		expectError bool
+	}{
+		{
+			name:      "API URL",
+			reference: "https://patchwork.kernel.org/api/series/123/",
+			expected:  patchwork.Series{BaseURL: "https://patchwork.kernel.org", ID: 123},
+		},
+		{
+			name:      "versioned API URL under a path",
+			reference: "https://lists.example.org/patchwork/api/1.3/series/7",
+			expected:  patchwork.Series{BaseURL: "https://lists.example.org/patchwork", ID: 7},
+		},
+		{
+			name:      "series mbox",
+			reference: "https://patchwork.ozlabs.org/series/42/mbox/",
+			expected:  patchwork.Series{BaseURL: "https://patchwork.ozlabs.org", ID: 42},
+		},
+		{
+			name:      "project list filtered by series",
+			reference: "https://patchwork.kernel.org/project/netdevbpf/list/?series=99",
+			expected:  patchwork.Series{BaseURL: "https://patchwork.kernel.org", ID: 99},
+		},
+		{name: "no series", reference: "https://patchwork.kernel.org/project/netdevbpf/list/", expectError: true},
+		{name: "zero series", reference: "https://patchwork.kernel.org/api/series/0/", expectError: true},
+		{name: "not a URL", reference: "series 123", expectError: true},
+		{name: "other scheme", reference: "file:///api/series/1/", expectError: true},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			series, err := patchwork.ParseSeries(testCase.reference)
+
+			if testCase.expectError {
+				require.Error(t, err)
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, testCase.expected, series)
+		})
+	}
+}
+
+// patchMbox is the mbox Patchwork serves for a patch.
+func patchMbox(subject, path string) string {
+	return `From patchwork Sat Mar  1 10:00:00 2025
+From: Jane Doe <jane@example.com>
+Date: Sat, 1 Mar 2025 10:00:00 +0100
+Subject: [v2,` + subject + `
+X-Patchwork-Id: 1
+
+Signed-off-by: Jane Doe <jane@example.com>
+---
+diff --git a/` + path + ` b/` + path + `
+--- a/` + path + `
++++ b/` + path + `
+@@ -1 +1 @@
+-old
++new
+`
+}
+
+func TestOpenSeries(t *testing.T) {
+	var server *httptest.Server
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/series/5/", func(writer http.ResponseWriter, _ *http.Request) {
+		fmt.Fprintf(writer, `{"id": 5, "total": 2, "received_all": true, "patches": [
+			{"id": 101, "name": "[v2,1/2] feat: add login page", "mbox": "%[1]s/patch/101/mbox/"},
+			{"id": 102, "name": "[v2,2/2] fix: handle empty password", "mbox": "%[1]s/patch/102/mbox/"}]}`, server.URL)
+	})
+	mux.HandleFunc("/patch/101/mbox/", func(writer http.ResponseWriter, _ *http.Request) {
+		fmt.Fprint(writer, patchMbox("1/2] feat: add login page", "login.go"))
+	})
+	mux.HandleFunc("/patch/102/mbox/", func(writer http.ResponseWriter, _ *http.Request) {
+		fmt.Fprint(writer, patchMbox("2/2] fix: handle empty password", "password.go"))
+	})
+	mux.HandleFunc("/api/series/6/", func(writer http.ResponseWriter, _ *http.Request) {
+		fmt.Fprintf(writer, `{"id": 6, "total": 3, "received_all": false, "patches": [
+			{"id": 103, "mbox": "%[1]s/patch/101/mbox/"}]}`, server.URL)
+	})
+
+	server = httptest.NewServer(mux)
+	defer server.Close()
+
+	ctx := context.Background()
+	client := patchwork.NewClient()
+
+	repo, err := client.OpenSeries(ctx, patchwork.Series{BaseURL: server.URL, ID: 5})
+	require.NoError(t, err)
+	require.Equal(t, "102", repo.HeadHash())
+
+	commits, err := repo.GetCommitRange(ctx, repo.BaseHash(), repo.HeadHash())
+	require.NoError(t, err)
+	require.Len(t, commits, 2)
+	require.Equal(t, "102", commits[0].Hash)
+	require.Equal(t, "fix: handle empty password", commits[0].Subject)
+	require.Equal(t, "101", commits[1].Hash)
+	require.Equal(t, "feat: add login page", commits[1].Subject)
+
+	paths, err := repo.GetChangedPaths(ctx, "101")
+	require.NoError(t, err)
+	require.Equal(t, []string{"login.go"}, paths)
+
+	_, err = client.OpenSeries(ctx, patchwork.Series{BaseURL: server.URL, ID: 6})
+	require.ErrorContains(t, err, "received 1 of 3 patches")
+
+	_, err = client.OpenSeries(ctx, patchwork.Series{BaseURL: server.URL, ID: 7})
+	require.ErrorContains(t, err, "Patchwork API returned 404")
+}
@@ -0,0 +1,19 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+/*
+Package patchwork fetches patch series from the REST API of a Patchwork instance.
+
+Patchwork collects the patches sent to the mailing lists of a project, as
+patchwork.kernel.org does for the Linux kernel, and groups them into series:
+
+  - ParseSeries reads series URLs of the API or the web interface
+  - Client.OpenSeries fetches the series and the mbox of each of its patches
+  - the patches are served through the repository of the mbox adapter
+
+Each patch gets its Patchwork ID as commit hash, which is the ID checks are
+posted to with POST /api/patches/{id}/checks/. Reading public projects needs
+no token.
+*/
+package patchwork
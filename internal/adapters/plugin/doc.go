@@ -0,0 +1,28 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+/*
+Package plugin provides commit rules backed by external executables and WASM modules.
+
+Plugins are declared in configuration and run once per validated commit:
+
+  - the commit is passed to the plugin as a JSON object, on stdin for
+    executables and through host functions for WASM modules (see WasmRule)
+  - the plugin returns its result as a JSON object, on stdout for executables
+  - failures become validation errors of a rule named after the plugin
+
+Input:
+
+	{"hash": "...", "subject": "...", "body": "...", "message": "...",
+	 "author": "...", "authorEmail": "...", "commitDate": "...", "isMergeCommit": false}
+
+Output (empty output means the commit passed):
+
+	{"failures": [{"code": "...", "message": "...", "help": "...", "context": {"key": "value"}}]}
+
+A plugin that cannot be started, times out, exits non-zero without a result,
+exceeds its memory limit, or writes invalid JSON produces a plugin_failed
+error so that broken plugins never pass silently.
+*/
+package plugin
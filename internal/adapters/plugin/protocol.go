@@ -0,0 +1,131 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package plugin
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/itiquette/gommitlint/internal/domain"
+	"github.com/itiquette/gommitlint/internal/domain/config"
+	"github.com/itiquette/gommitlint/internal/domain/rules"
+)
+
+// DefaultTimeout bounds how long a single plugin invocation may run.
+const DefaultTimeout = 30 * time.Second
+
+// commitInput is the JSON document passed to a plugin.
+type commitInput struct {
+	Hash          string `json:"hash"`
+	Subject       string `json:"subject"`
+	Body          string `json:"body"`
+	Message       string `json:"message"`
+	Author        string `json:"author"`
+	AuthorEmail   string `json:"authorEmail"`
+	CommitDate    string `json:"commitDate"`
+	IsMergeCommit bool   `json:"isMergeCommit"`
+}
+
+// pluginOutput is the JSON document returned by a plugin.
+type pluginOutput struct {
+	Failures []pluginFailure `json:"failures"`
+}
+
+// pluginFailure is a single failure reported by a plugin.
+type pluginFailure struct {
+	Code    string            `json:"code"`
+	Message string            `json:"message"`
+	Help    string            `json:"help"`
+	Context map[string]string `json:"context"`
+}
+
+// CreateRules creates rules for all configured plugins that are not disabled.
+// Relative commands and module paths are resolved against workDir.
+func CreateRules(cfg config.Config, workDir string) []domain.CommitRule {
+	created := make([]domain.CommitRule, 0, len(cfg.Plugins))
+
+	for _, pluginCfg := range cfg.Plugins {
+		if !rules.IsRuleActive(pluginCfg.Name, cfg.Rules.Enabled, cfg.Rules.Disabled) {
+			continue
+		}
+
+		var rule domain.CommitRule
+
+		if pluginCfg.Wasm != "" {
+			rule = NewWasmRule(pluginCfg, workDir)
+		} else {
+			rule = NewRule(pluginCfg, workDir)
+		}
+
+		created = append(created, domain.WithCommitCondition(rule, cfg.Rules.Conditions, pluginCfg.Name))
+	}
+
+	return created
+}
+
+// encodeCommit encodes the commit as plugin input.
+func encodeCommit(commit domain.Commit) ([]byte, error) {
+	return json.Marshal(commitInput{
+		Hash:          commit.Hash,
+		Subject:       commit.Subject,
+		Body:          commit.Body,
+		Message:       commit.Message,
+		Author:        commit.Author,
+		AuthorEmail:   commit.AuthorEmail,
+		CommitDate:    commit.CommitDate,
+		IsMergeCommit: commit.IsMergeCommit,
+	})
+}
+
+// decodeFailures parses plugin output into validation errors attributed to ruleName.
+func decodeFailures(ruleName string, output []byte) ([]domain.ValidationError, error) {
+	var result pluginOutput
+	if err := json.Unmarshal(output, &result); err != nil {
+		return nil, fmt.Errorf("invalid plugin output: %w", err)
+	}
+
+	errors := make([]domain.ValidationError, 0, len(result.Failures))
+
+	for _, failure := range result.Failures {
+		errors = append(errors, toValidationError(ruleName, failure))
+	}
+
+	return errors, nil
+}
+
+// toValidationError converts a plugin failure to a validation error.
+func toValidationError(ruleName string, failure pluginFailure) domain.ValidationError {
+	code := failure.Code
+	if code == "" {
+		code = string(domain.ErrUnknown)
+	}
+
+	message := failure.Message
+	if message == "" {
+		message = "plugin reported a failure"
+	}
+
+	validationErr := domain.New(ruleName, domain.ValidationErrorCode(code), message)
+
+	if len(failure.Context) > 0 {
+		validationErr = validationErr.WithContextMap(failure.Context)
+	}
+
+	if failure.Help != "" {
+		validationErr = validationErr.WithHelp(failure.Help)
+	}
+
+	return validationErr
+}
+
+// pluginTimeout returns the configured invocation timeout or the default.
+func pluginTimeout(pluginCfg config.PluginConfig) time.Duration {
+	if timeout, err := time.ParseDuration(pluginCfg.Timeout); err == nil && timeout > 0 {
+		return timeout
+	}
+
+	return DefaultTimeout
+}
@@ -0,0 +1,119 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package plugin
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os/exec"
+	"strings"
+	"time"
+
+	"github.com/itiquette/gommitlint/internal/domain"
+	"github.com/itiquette/gommitlint/internal/domain/config"
+)
+
+// Rule is a commit rule that delegates validation to an external executable.
+type Rule struct {
+	name    string
+	command string
+	args    []string
+	workDir string
+	timeout time.Duration
+}
+
+// Ensure Rule implements the domain commit rule interface.
+var _ domain.CommitRule = Rule{}
+
+// NewRule creates an executable plugin rule from its configuration.
+// Relative commands are resolved against workDir, normally the repository root.
+func NewRule(pluginCfg config.PluginConfig, workDir string) Rule {
+	return Rule{
+		name:    pluginCfg.Name,
+		command: pluginCfg.Command,
+		args:    pluginCfg.Args,
+		workDir: workDir,
+		timeout: pluginTimeout(pluginCfg),
+	}
+}
+
+// WithTimeout returns a copy of the rule with a different invocation timeout.
+func (r Rule) WithTimeout(timeout time.Duration) Rule {
+	result := r
+	result.timeout = timeout
+
+	return result
+}
+
+// Name returns the plugin name as declared in configuration.
+func (r Rule) Name() string {
+	return r.name
+}
+
+// Validate runs the plugin for the commit and converts its failures to validation errors.
+func (r Rule) Validate(commit domain.Commit, _ config.Config) []domain.ValidationError {
+	input, err := encodeCommit(commit)
+	if err != nil {
+		return []domain.ValidationError{r.pluginError(fmt.Sprintf("failed to encode commit: %v", err))}
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), r.timeout)
+	defer cancel()
+
+	cmd := exec.CommandContext(ctx, r.command, r.args...)
+	cmd.Dir = r.workDir
+	cmd.WaitDelay = time.Second // Do not wait on children that keep the output pipes open
+	cmd.Stdin = bytes.NewReader(input)
+
+	var stdout, stderr bytes.Buffer
+
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	runErr := cmd.Run()
+
+	if ctx.Err() != nil {
+		return []domain.ValidationError{r.pluginError("plugin timed out after " + r.timeout.String())}
+	}
+
+	output := bytes.TrimSpace(stdout.Bytes())
+	if len(output) == 0 {
+		if runErr != nil {
+			return []domain.ValidationError{r.pluginError(describeRunError(runErr, stderr.String()))}
+		}
+
+		return nil
+	}
+
+	errors, err := decodeFailures(r.name, output)
+	if err != nil {
+		return []domain.ValidationError{r.pluginError(err.Error())}
+	}
+
+	if len(errors) == 0 && runErr != nil {
+		return []domain.ValidationError{r.pluginError(describeRunError(runErr, stderr.String()))}
+	}
+
+	return errors
+}
+
+// pluginError creates an error describing a plugin that did not run correctly.
+func (r Rule) pluginError(message string) domain.ValidationError {
+	return domain.New(r.name, domain.ErrPluginFailed, message).
+		WithContextMap(map[string]string{"command": r.command}).
+		WithHelp("Check that the plugin command exists, is executable, and writes JSON to stdout")
+}
+
+// describeRunError summarizes a failed plugin process, including its stderr if any.
+func describeRunError(runErr error, stderr string) string {
+	message := fmt.Sprintf("plugin failed: %v", runErr)
+
+	if trimmed := strings.TrimSpace(stderr); trimmed != "" {
+		message += ": " + trimmed
+	}
+
+	return message
+}
@@ -0,0 +1,134 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package plugin_test
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/itiquette/gommitlint/internal/adapters/plugin"
+	"github.com/itiquette/gommitlint/internal/domain"
+	"github.com/itiquette/gommitlint/internal/domain/config"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRuleValidate(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("plugin test scripts require a POSIX shell")
+	}
+
+	tests := []struct {
+		name          string
+		script        string
+		subject       string
+		expectedCodes []string
+		expectedMsg   string
+	}{
+		{
+			name:          "empty output passes",
+			script:        "cat > /dev/null",
+			subject:       "Add feature",
+			expectedCodes: nil,
+		},
+		{
+			name:          "failures are reported",
+			script:        `grep -q WIP && echo '{"failures":[{"code":"wip","message":"no WIP","help":"finish","context":{"word":"WIP"}}]}'; exit 0`,
+			subject:       "WIP add feature",
+			expectedCodes: []string{"wip"},
+			expectedMsg:   "no WIP",
+		},
+		{
+			name:          "commit is passed as JSON",
+			script:        `grep -q '"subject":"Add feature"' || echo '{"failures":[{"message":"bad input"}]}'`,
+			subject:       "Add feature",
+			expectedCodes: nil,
+		},
+		{
+			name:          "non-zero exit without output is a plugin failure",
+			script:        "echo boom >&2; exit 3",
+			subject:       "Add feature",
+			expectedCodes: []string{string(domain.ErrPluginFailed)},
+			expectedMsg:   "plugin failed: exit status 3: boom",
+		},
+		{
+			name:          "invalid JSON is a plugin failure",
+			script:        "echo not-json",
+			subject:       "Add feature",
+			expectedCodes: []string{string(domain.ErrPluginFailed)},
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			dir := t.TempDir()
+			writeScript(t, dir, "check", testCase.script)
+
+			rule := plugin.NewRule(config.PluginConfig{Name: "MyRule", Command: "./check"}, dir)
+			require.Equal(t, "MyRule", rule.Name())
+
+			errors := rule.Validate(domain.Commit{Subject: testCase.subject, Message: testCase.subject}, config.Config{})
+
+			codes := make([]string, 0, len(errors))
+			for _, validationErr := range errors {
+				require.Equal(t, "MyRule", validationErr.Rule)
+
+				codes = append(codes, validationErr.Code)
+			}
+
+			if testCase.expectedCodes == nil {
+				require.Empty(t, errors)
+			} else {
+				require.Equal(t, testCase.expectedCodes, codes)
+			}
+
+			if testCase.expectedMsg != "" {
+				require.Equal(t, testCase.expectedMsg, errors[0].Message)
+			}
+		})
+	}
+}
+
+func TestRuleValidate_Timeout(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("plugin test scripts require a POSIX shell")
+	}
+
+	dir := t.TempDir()
+	writeScript(t, dir, "slow", "sleep 5")
+
+	rule := plugin.NewRule(config.PluginConfig{Name: "Slow", Command: "./slow"}, dir).
+		WithTimeout(100 * time.Millisecond)
+
+	errors := rule.Validate(domain.Commit{Subject: "Add feature"}, config.Config{})
+	require.Len(t, errors, 1)
+	require.Equal(t, string(domain.ErrPluginFailed), errors[0].Code)
+	require.Contains(t, errors[0].Message, "timed out")
+}
+
+func TestCreateRules(t *testing.T) {
+	cfg := config.Config{
+		Plugins: []config.PluginConfig{
+			{Name: "First", Command: "first"},
+			{Name: "Second", Command: "second"},
+		},
+		Rules: config.RulesConfig{Disabled: []string{"second"}},
+	}
+
+	rules := plugin.CreateRules(cfg, ".")
+	require.Len(t, rules, 1)
+	require.Equal(t, "First", rules[0].Name())
+}
+
+// writeScript creates an executable shell script in dir.
+func writeScript(t *testing.T, dir, name, body string) {
+	t.Helper()
+
+	path := filepath.Join(dir, name)
+	require.NoError(t, os.WriteFile(path, []byte("#!/bin/sh\n"+body+"\n"), 0600))
+	require.NoError(t, os.Chmod(path, 0700))
+}
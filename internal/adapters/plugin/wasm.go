@@ -0,0 +1,244 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package plugin
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/itiquette/gommitlint/internal/domain"
+	"github.com/itiquette/gommitlint/internal/domain/config"
+	"github.com/tetratelabs/wazero"
+	"github.com/tetratelabs/wazero/api"
+	"github.com/tetratelabs/wazero/imports/wasi_snapshot_preview1"
+)
+
+// Host ABI of WASM plugins.
+const (
+	// HostModule is the import module name of the host functions.
+	HostModule = "gommitlint"
+
+	// ValidateExport is the guest function called once per commit.
+	// It takes no parameters and returns zero on success.
+	ValidateExport = "validate"
+
+	// DefaultMemoryLimitMB bounds the linear memory of a WASM plugin.
+	DefaultMemoryLimitMB = 64
+
+	// wasmPageSize is the size of a WASM memory page in bytes.
+	wasmPageSize = 64 * 1024
+)
+
+// wasmCallKey is the context key holding the state of the current plugin call.
+type wasmCallKey struct{}
+
+// wasmCall carries commit input and plugin output between host and guest.
+type wasmCall struct {
+	input  []byte
+	output []byte
+}
+
+// WasmRule is a commit rule implemented by a sandboxed WASM module.
+//
+// The module imports these host functions from the "gommitlint" module:
+//
+//	input_size() -> i32            size of the commit JSON in bytes
+//	read_input(ptr i32)            copy the commit JSON to guest memory at ptr
+//	write_output(ptr i32, len i32) set the result JSON from guest memory
+//
+// and exports its memory and a "validate() -> i32" function. Input and output
+// use the same JSON documents as executable plugins. WASI is available so that
+// modules built by common toolchains can be loaded, without filesystem or network access.
+type WasmRule struct {
+	name        string
+	path        string
+	timeout     time.Duration
+	memoryLimit uint32 // In WASM pages
+	cache       wazero.CompilationCache
+}
+
+// Ensure WasmRule implements the domain commit rule interface.
+var _ domain.CommitRule = WasmRule{}
+
+// NewWasmRule creates a WASM plugin rule from its configuration.
+// Relative module paths are resolved against workDir, normally the repository root.
+func NewWasmRule(pluginCfg config.PluginConfig, workDir string) WasmRule {
+	path := pluginCfg.Wasm
+	if !filepath.IsAbs(path) {
+		path = filepath.Join(workDir, path)
+	}
+
+	memoryLimitMB := pluginCfg.MemoryLimitMB
+	if memoryLimitMB <= 0 {
+		memoryLimitMB = DefaultMemoryLimitMB
+	}
+
+	return WasmRule{
+		name:        pluginCfg.Name,
+		path:        path,
+		timeout:     pluginTimeout(pluginCfg),
+		memoryLimit: uint32(memoryLimitMB * 1024 * 1024 / wasmPageSize), //nolint:gosec // bounded by validated config
+		cache:       wazero.NewCompilationCache(),
+	}
+}
+
+// WithTimeout returns a copy of the rule with a different invocation timeout.
+func (r WasmRule) WithTimeout(timeout time.Duration) WasmRule {
+	result := r
+	result.timeout = timeout
+
+	return result
+}
+
+// Name returns the plugin name as declared in configuration.
+func (r WasmRule) Name() string {
+	return r.name
+}
+
+// Validate runs the module for the commit and converts its failures to validation errors.
+func (r WasmRule) Validate(commit domain.Commit, _ config.Config) []domain.ValidationError {
+	input, err := encodeCommit(commit)
+	if err != nil {
+		return []domain.ValidationError{r.pluginError(fmt.Sprintf("failed to encode commit: %v", err))}
+	}
+
+	output, err := r.run(input)
+	if err != nil {
+		return []domain.ValidationError{r.pluginError(err.Error())}
+	}
+
+	output = bytes.TrimSpace(output)
+	if len(output) == 0 {
+		return nil
+	}
+
+	errors, err := decodeFailures(r.name, output)
+	if err != nil {
+		return []domain.ValidationError{r.pluginError(err.Error())}
+	}
+
+	return errors
+}
+
+// run executes the module in a fresh sandbox and returns the output it reported.
+func (r WasmRule) run(input []byte) ([]byte, error) {
+	module, err := os.ReadFile(r.path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read module: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), r.timeout)
+	defer cancel()
+
+	call := &wasmCall{input: input}
+	ctx = context.WithValue(ctx, wasmCallKey{}, call)
+
+	runtimeConfig := wazero.NewRuntimeConfig().
+		WithMemoryLimitPages(r.memoryLimit).
+		WithCloseOnContextDone(true).
+		WithCompilationCache(r.cache)
+
+	runtime := wazero.NewRuntimeWithConfig(ctx, runtimeConfig)
+	defer runtime.Close(context.Background())
+
+	if _, err := wasi_snapshot_preview1.Instantiate(ctx, runtime); err != nil {
+		return nil, fmt.Errorf("failed to initialize WASI: %w", err)
+	}
+
+	if err := instantiateHostModule(ctx, runtime); err != nil {
+		return nil, err
+	}
+
+	compiled, err := runtime.CompileModule(ctx, module)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compile module: %w", err)
+	}
+
+	instance, err := runtime.InstantiateModule(ctx, compiled,
+		wazero.NewModuleConfig().WithName("").WithStartFunctions("_initialize"))
+	if err != nil {
+		return nil, r.wrapRunError(ctx, "failed to instantiate module", err)
+	}
+
+	validate := instance.ExportedFunction(ValidateExport)
+	if validate == nil {
+		return nil, fmt.Errorf("module does not export %q", ValidateExport)
+	}
+
+	results, err := validate.Call(ctx)
+	if err != nil {
+		return nil, r.wrapRunError(ctx, "plugin trapped", err)
+	}
+
+	if len(results) > 0 && api.DecodeI32(results[0]) != 0 {
+		return nil, fmt.Errorf("plugin returned status %d", api.DecodeI32(results[0]))
+	}
+
+	return call.output, nil
+}
+
+// wrapRunError reports timeouts distinctly from other runtime failures.
+func (r WasmRule) wrapRunError(ctx context.Context, message string, err error) error {
+	if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		return errors.New("plugin timed out after " + r.timeout.String())
+	}
+
+	return fmt.Errorf("%s: %w", message, err)
+}
+
+// pluginError creates an error describing a module that did not run correctly.
+func (r WasmRule) pluginError(message string) domain.ValidationError {
+	return domain.New(r.name, domain.ErrPluginFailed, message).
+		WithContextMap(map[string]string{"module": r.path}).
+		WithHelp("Check that the module exists, exports memory and validate(), and stays within its time and memory limits")
+}
+
+// instantiateHostModule provides the host functions of the plugin ABI.
+func instantiateHostModule(ctx context.Context, runtime wazero.Runtime) error {
+	_, err := runtime.NewHostModuleBuilder(HostModule).
+		NewFunctionBuilder().
+		WithFunc(func(ctx context.Context) uint32 {
+			return uint32(len(currentCall(ctx).input)) //nolint:gosec // commit JSON is far below 4GiB
+		}).
+		Export("input_size").
+		NewFunctionBuilder().
+		WithFunc(func(ctx context.Context, module api.Module, ptr uint32) {
+			if !module.Memory().Write(ptr, currentCall(ctx).input) {
+				panic(errors.New("read_input: pointer out of range"))
+			}
+		}).
+		Export("read_input").
+		NewFunctionBuilder().
+		WithFunc(func(ctx context.Context, module api.Module, ptr, length uint32) {
+			data, ok := module.Memory().Read(ptr, length)
+			if !ok {
+				panic(errors.New("write_output: pointer out of range"))
+			}
+
+			currentCall(ctx).output = bytes.Clone(data)
+		}).
+		Export("write_output").
+		Instantiate(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to initialize host functions: %w", err)
+	}
+
+	return nil
+}
+
+// currentCall returns the call state stored in the context by run.
+func currentCall(ctx context.Context) *wasmCall {
+	call, _ := ctx.Value(wasmCallKey{}).(*wasmCall)
+	if call == nil {
+		return &wasmCall{}
+	}
+
+	return call
+}
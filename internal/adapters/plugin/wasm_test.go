@@ -0,0 +1,214 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package plugin_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/itiquette/gommitlint/internal/adapters/plugin"
+	"github.com/itiquette/gommitlint/internal/domain"
+	"github.com/itiquette/gommitlint/internal/domain/config"
+	"github.com/stretchr/testify/require"
+)
+
+// WASM instructions used by the test modules.
+const (
+	opLoop     = 0x03
+	opBr       = 0x0c
+	opEnd      = 0x0b
+	opCall     = 0x10
+	opI32Const = 0x41
+	blockVoid  = 0x40
+)
+
+// Function indexes of the imported host functions.
+const (
+	fnInputSize   = 0
+	fnReadInput   = 1
+	fnWriteOutput = 2
+)
+
+func TestWasmRuleValidate(t *testing.T) {
+	failureJSON := `{"failures":[{"code":"wip","message":"no WIP","help":"finish"}]}`
+
+	tests := []struct {
+		name          string
+		module        []byte
+		memoryLimitMB int
+		timeout       time.Duration
+		expectedCode  string
+		expectedMsg   string
+	}{
+		{
+			name:   "no output passes",
+			module: buildModule(1, []byte{opI32Const, 0x00, opEnd}, nil),
+		},
+		{
+			name: "commit input is readable and echoed output passes",
+			module: buildModule(1, []byte{
+				opI32Const, 0x00, opCall, fnReadInput,
+				opI32Const, 0x00, opCall, fnInputSize, opCall, fnWriteOutput,
+				opI32Const, 0x00, opEnd,
+			}, nil),
+		},
+		{
+			name: "reported failures become validation errors",
+			module: buildModule(1, concat(
+				[]byte{opI32Const, 0x00},
+				i32Const(len(failureJSON)),
+				[]byte{opCall, fnWriteOutput, opI32Const, 0x00, opEnd},
+			), []byte(failureJSON)),
+			expectedCode: "wip",
+			expectedMsg:  "no WIP",
+		},
+		{
+			name:         "non-zero status is a plugin failure",
+			module:       buildModule(1, []byte{opI32Const, 0x01, opEnd}, nil),
+			expectedCode: string(domain.ErrPluginFailed),
+			expectedMsg:  "plugin returned status 1",
+		},
+		{
+			name: "endless loop is stopped by the timeout",
+			module: buildModule(1, []byte{
+				opLoop, blockVoid, opBr, 0x00, opEnd,
+				opI32Const, 0x00, opEnd,
+			}, nil),
+			timeout:      100 * time.Millisecond,
+			expectedCode: string(domain.ErrPluginFailed),
+			expectedMsg:  "plugin timed out after 100ms",
+		},
+		{
+			name:          "memory above the limit is rejected",
+			module:        buildModule(32, []byte{opI32Const, 0x00, opEnd}, nil),
+			memoryLimitMB: 1,
+			expectedCode:  string(domain.ErrPluginFailed),
+		},
+		{
+			name:         "invalid module is a plugin failure",
+			module:       []byte("not wasm"),
+			expectedCode: string(domain.ErrPluginFailed),
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			dir := t.TempDir()
+			require.NoError(t, os.WriteFile(filepath.Join(dir, "rule.wasm"), testCase.module, 0600))
+
+			rule := plugin.NewWasmRule(config.PluginConfig{
+				Name:          "WasmRule",
+				Wasm:          "rule.wasm",
+				MemoryLimitMB: testCase.memoryLimitMB,
+			}, dir)
+
+			if testCase.timeout > 0 {
+				rule = rule.WithTimeout(testCase.timeout)
+			}
+
+			require.Equal(t, "WasmRule", rule.Name())
+
+			errors := rule.Validate(domain.Commit{Hash: "abc", Subject: "Add feature"}, config.Config{})
+			if testCase.expectedCode == "" {
+				require.Empty(t, errors)
+
+				return
+			}
+
+			require.Len(t, errors, 1)
+			require.Equal(t, "WasmRule", errors[0].Rule)
+			require.Equal(t, testCase.expectedCode, errors[0].Code)
+
+			if testCase.expectedMsg != "" {
+				require.Equal(t, testCase.expectedMsg, errors[0].Message)
+			}
+		})
+	}
+}
+
+// buildModule assembles a WASM module importing the plugin host functions and
+// exporting memory with the given initial pages and a validate function with body code.
+// Data, if any, is placed at address 0.
+func buildModule(memoryPages byte, code, data []byte) []byte {
+	module := []byte{0x00, 0x61, 0x73, 0x6d, 0x01, 0x00, 0x00, 0x00}
+
+	// Types: 0 = () -> i32, 1 = (i32) -> (), 2 = (i32, i32) -> ()
+	module = appendSection(module, 1, []byte{
+		0x03,
+		0x60, 0x00, 0x01, 0x7f,
+		0x60, 0x01, 0x7f, 0x00,
+		0x60, 0x02, 0x7f, 0x7f, 0x00,
+	})
+
+	imports := []byte{0x03}
+	imports = append(imports, importFunc("input_size", 0)...)
+	imports = append(imports, importFunc("read_input", 1)...)
+	imports = append(imports, importFunc("write_output", 2)...)
+	module = appendSection(module, 2, imports)
+
+	module = appendSection(module, 3, []byte{0x01, 0x00})
+	module = appendSection(module, 5, []byte{0x01, 0x00, memoryPages})
+
+	exports := []byte{0x02}
+	exports = append(exports, name("memory")...)
+	exports = append(exports, 0x02, 0x00)
+	exports = append(exports, name("validate")...)
+	exports = append(exports, 0x00, 0x03)
+	module = appendSection(module, 7, exports)
+
+	body := append([]byte{0x00}, code...)
+	module = appendSection(module, 10, append([]byte{0x01, byte(len(body))}, body...))
+
+	if len(data) > 0 {
+		segment := []byte{0x01, 0x00, opI32Const, 0x00, opEnd, byte(len(data))}
+		module = appendSection(module, 11, append(segment, data...))
+	}
+
+	return module
+}
+
+// i32Const encodes an i32.const instruction with a signed LEB128 immediate.
+func i32Const(value int) []byte {
+	encoded := []byte{opI32Const}
+
+	for {
+		current := byte(value & 0x7f)
+		value >>= 7
+
+		if (value == 0 && current&0x40 == 0) || (value == -1 && current&0x40 != 0) {
+			return append(encoded, current)
+		}
+
+		encoded = append(encoded, current|0x80)
+	}
+}
+
+func concat(parts ...[]byte) []byte {
+	var result []byte
+	for _, part := range parts {
+		result = append(result, part...)
+	}
+
+	return result
+}
+
+func importFunc(field string, typeIndex byte) []byte {
+	entry := name(plugin.HostModule)
+	entry = append(entry, name(field)...)
+
+	return append(entry, 0x00, typeIndex)
+}
+
+func name(value string) []byte {
+	return append([]byte{byte(len(value))}, value...)
+}
+
+func appendSection(module []byte, id byte, content []byte) []byte {
+	module = append(module, id, byte(len(content)))
+
+	return append(module, content...)
+}
@@ -0,0 +1,22 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+/*
+Package profiling measures how long rules take, for --profile-rules.
+
+A Profiler wraps the rules of a validation, like the tracing package does, and
+records the duration of every rule for every commit it validates. After the
+validation it reports the rules that took longest in total and the commits that
+took longest over all rules:
+
+	profiler := profiling.New()
+	commitRules = profiler.CommitRules(commitRules)
+	repoRules = profiler.RepositoryRules(repoRules)
+	// validate
+	profiler.WriteReport(os.Stderr, 10)
+
+Repository-level and range validations are recorded without a commit. Rules that
+conditions skip for a commit are not run and not recorded.
+*/
+package profiling
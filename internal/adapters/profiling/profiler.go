@@ -0,0 +1,294 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package profiling
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"sync"
+	"text/tabwriter"
+	"time"
+
+	"github.com/itiquette/gommitlint/internal/domain"
+	"github.com/itiquette/gommitlint/internal/domain/config"
+)
+
+// Sample is one validation of a commit by a rule.
+type Sample struct {
+	Rule     string
+	Commit   string // Hash of the commit, empty for repository-level and range validation
+	Subject  string
+	Duration time.Duration
+}
+
+// RuleProfile is the time a rule took over all commits.
+type RuleProfile struct {
+	Rule  string
+	Total time.Duration
+	Max   time.Duration
+	Calls int
+}
+
+// Average returns the mean duration of one validation.
+func (p RuleProfile) Average() time.Duration {
+	if p.Calls == 0 {
+		return 0
+	}
+
+	return p.Total / time.Duration(p.Calls)
+}
+
+// CommitProfile is the time all rules took for a commit.
+type CommitProfile struct {
+	Commit      string
+	Subject     string
+	Total       time.Duration
+	SlowestRule string
+	Slowest     time.Duration
+}
+
+// Profiler records the duration of rule validations. It is safe for concurrent use.
+type Profiler struct {
+	mutex   sync.Mutex
+	samples []Sample
+	now     func() time.Time
+}
+
+// New creates a profiler without samples.
+func New() *Profiler {
+	return &Profiler{now: time.Now}
+}
+
+// CommitRules wraps rules to record every commit they validate. Conditional rules keep
+// their condition.
+func (p *Profiler) CommitRules(rules []domain.CommitRule) []domain.CommitRule {
+	profiled := make([]domain.CommitRule, 0, len(rules))
+
+	for _, rule := range rules {
+		if conditional, ok := rule.(domain.ConditionalCommitRule); ok {
+			conditional.CommitRule = profiledCommitRule{CommitRule: conditional.CommitRule, profiler: p}
+			profiled = append(profiled, conditional)
+
+			continue
+		}
+
+		profiled = append(profiled, profiledCommitRule{CommitRule: rule, profiler: p})
+	}
+
+	return profiled
+}
+
+// RepositoryRules wraps rules like CommitRules. Range rules are recorded as well.
+func (p *Profiler) RepositoryRules(rules []domain.RepositoryRule) []domain.RepositoryRule {
+	profiled := make([]domain.RepositoryRule, 0, len(rules))
+
+	for _, rule := range rules {
+		if conditional, ok := rule.(domain.ConditionalRepositoryRule); ok {
+			conditional.RepositoryRule = p.profileRepositoryRule(conditional.RepositoryRule)
+			profiled = append(profiled, conditional)
+
+			continue
+		}
+
+		profiled = append(profiled, p.profileRepositoryRule(rule))
+	}
+
+	return profiled
+}
+
+// profileRepositoryRule wraps rule, keeping it a range rule when it is one.
+func (p *Profiler) profileRepositoryRule(rule domain.RepositoryRule) domain.RepositoryRule {
+	profiled := profiledRepositoryRule{RepositoryRule: rule, profiler: p}
+	if rangeRule, ok := rule.(domain.RangeRule); ok {
+		return profiledRangeRule{profiledRepositoryRule: profiled, rangeRule: rangeRule}
+	}
+
+	return profiled
+}
+
+// Samples returns the recorded samples in the order they were recorded.
+func (p *Profiler) Samples() []Sample {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	return append([]Sample(nil), p.samples...)
+}
+
+// record adds the sample of rule validating commit since start.
+func (p *Profiler) record(rule string, commit domain.Commit, start time.Time) {
+	duration := p.now().Sub(start)
+
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	p.samples = append(p.samples, Sample{Rule: rule, Commit: commit.Hash, Subject: commit.Subject, Duration: duration})
+}
+
+// Rules returns the time of each rule, slowest in total first.
+func (p *Profiler) Rules() []RuleProfile {
+	byRule := make(map[string]*RuleProfile)
+
+	var order []string
+
+	for _, sample := range p.Samples() {
+		profile, found := byRule[sample.Rule]
+		if !found {
+			profile = &RuleProfile{Rule: sample.Rule}
+			byRule[sample.Rule] = profile
+			order = append(order, sample.Rule)
+		}
+
+		profile.Total += sample.Duration
+		profile.Calls++
+		profile.Max = max(profile.Max, sample.Duration)
+	}
+
+	profiles := make([]RuleProfile, 0, len(order))
+	for _, rule := range order {
+		profiles = append(profiles, *byRule[rule])
+	}
+
+	sort.SliceStable(profiles, func(i, j int) bool {
+		return profiles[i].Total > profiles[j].Total
+	})
+
+	return profiles
+}
+
+// Commits returns the time of each commit over all rules, slowest first.
+// Repository-level and range validations belong to no commit and are left out.
+func (p *Profiler) Commits() []CommitProfile {
+	byCommit := make(map[string]*CommitProfile)
+
+	var order []string
+
+	for _, sample := range p.Samples() {
+		if sample.Commit == "" {
+			continue
+		}
+
+		profile, found := byCommit[sample.Commit]
+		if !found {
+			profile = &CommitProfile{Commit: sample.Commit, Subject: sample.Subject}
+			byCommit[sample.Commit] = profile
+			order = append(order, sample.Commit)
+		}
+
+		profile.Total += sample.Duration
+
+		if sample.Duration > profile.Slowest {
+			profile.SlowestRule, profile.Slowest = sample.Rule, sample.Duration
+		}
+	}
+
+	profiles := make([]CommitProfile, 0, len(order))
+	for _, commit := range order {
+		profiles = append(profiles, *byCommit[commit])
+	}
+
+	sort.SliceStable(profiles, func(i, j int) bool {
+		return profiles[i].Total > profiles[j].Total
+	})
+
+	return profiles
+}
+
+// WriteReport writes the top slowest rules and commits as aligned tables.
+func (p *Profiler) WriteReport(writer io.Writer, top int) error {
+	rules := p.Rules()
+	commits := p.Commits()
+
+	var total time.Duration
+	for _, rule := range rules {
+		total += rule.Total
+	}
+
+	table := tabwriter.NewWriter(writer, 0, 0, 2, ' ', 0)
+
+	fmt.Fprintf(table, "Rule profile: %d commits, %d rules, %s in rules\n\n", len(commits), len(rules), formatDuration(total))
+	fmt.Fprintln(table, "Slowest rules:")
+	fmt.Fprintln(table, "  RULE\tTOTAL\tCALLS\tAVERAGE\tMAX")
+
+	for _, rule := range rules[:min(top, len(rules))] {
+		fmt.Fprintf(table, "  %s\t%s\t%d\t%s\t%s\n",
+			rule.Rule, formatDuration(rule.Total), rule.Calls, formatDuration(rule.Average()), formatDuration(rule.Max))
+	}
+
+	if len(commits) > 0 {
+		fmt.Fprintln(table, "\nSlowest commits:")
+		fmt.Fprintln(table, "  COMMIT\tTOTAL\tSLOWEST RULE\tSUBJECT")
+
+		for _, commit := range commits[:min(top, len(commits))] {
+			fmt.Fprintf(table, "  %s\t%s\t%s %s\t%s\n",
+				domain.ShortHash(commit.Commit), formatDuration(commit.Total), commit.SlowestRule,
+				formatDuration(commit.Slowest), commit.Subject)
+		}
+	}
+
+	return table.Flush()
+}
+
+// formatDuration rounds d to hundredths of its largest unit, such as 1.23s or 456.79µs.
+func formatDuration(d time.Duration) string {
+	for _, unit := range []time.Duration{time.Second, time.Millisecond, time.Microsecond} {
+		if d >= unit {
+			return d.Round(unit / 100).String()
+		}
+	}
+
+	return d.String()
+}
+
+// profiledCommitRule records the duration of every validation of a commit rule.
+type profiledCommitRule struct {
+	domain.CommitRule
+
+	profiler *Profiler
+}
+
+// Validate validates commit and records how long it took.
+func (r profiledCommitRule) Validate(commit domain.Commit, cfg config.Config) []domain.ValidationError {
+	defer r.profiler.record(r.Name(), commit, r.profiler.now())
+
+	return r.CommitRule.Validate(commit, cfg)
+}
+
+// Metadata returns the documentation of the wrapped rule, which names it in configuration.
+func (r profiledCommitRule) Metadata() domain.RuleMetadata {
+	if described, ok := r.CommitRule.(domain.DescribedRule); ok {
+		return described.Metadata()
+	}
+
+	return domain.RuleMetadata{}
+}
+
+// profiledRepositoryRule records the duration of every validation of a repository rule.
+type profiledRepositoryRule struct {
+	domain.RepositoryRule
+
+	profiler *Profiler
+}
+
+// Validate validates commit and records how long it took.
+func (r profiledRepositoryRule) Validate(commit domain.Commit, repo domain.Repository, cfg config.Config) []domain.ValidationError {
+	defer r.profiler.record(r.Name(), commit, r.profiler.now())
+
+	return r.RepositoryRule.Validate(commit, repo, cfg)
+}
+
+// profiledRangeRule records the duration of the range validation of a range rule as well.
+type profiledRangeRule struct {
+	profiledRepositoryRule
+
+	rangeRule domain.RangeRule
+}
+
+// ValidateRange validates the range and records how long it took.
+func (r profiledRangeRule) ValidateRange(commits []domain.Commit, cfg config.Config) []domain.ValidationError {
+	defer r.profiler.record(r.Name(), domain.Commit{}, r.profiler.now())
+
+	return r.rangeRule.ValidateRange(commits, cfg)
+}
@@ -0,0 +1,129 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package profiling
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/itiquette/gommitlint/internal/domain"
+	"github.com/itiquette/gommitlint/internal/domain/config"
+	"github.com/itiquette/gommitlint/internal/domain/rules"
+)
+
+// steppingClock returns a clock that advances by the next of steps on every reading
+// after a validation started, so that each validation takes the next step.
+func steppingClock(steps ...time.Duration) func() time.Time {
+	current := time.Date(2025, 3, 1, 10, 0, 0, 0, time.UTC)
+	readings := 0
+
+	return func() time.Time {
+		if readings%2 == 1 {
+			current = current.Add(steps[readings/2])
+		}
+
+		readings++
+
+		return current
+	}
+}
+
+func TestCommitRules(t *testing.T) {
+	cfg := config.NewDefault()
+	subject := rules.NewSubjectRule(cfg)
+	conditional := domain.ConditionalCommitRule{
+		CommitRule: rules.NewConventionalCommitRule(cfg),
+		Condition:  config.RuleCondition{Branches: []string{"main"}},
+	}
+	unprofiled := []domain.CommitRule{subject, conditional}
+
+	profiler := New()
+	profiler.now = steppingClock(3*time.Millisecond, time.Millisecond, 2*time.Millisecond, 5*time.Millisecond)
+
+	profiled := profiler.CommitRules(unprofiled)
+	require.Len(t, profiled, 2)
+
+	kept, ok := profiled[1].(domain.ConditionalCommitRule)
+	require.True(t, ok, "conditional rules keep their condition")
+	require.Equal(t, conditional.Condition, kept.Condition)
+
+	first := domain.ParseCommitMessage("Fix the parser.")
+	first.Hash = "1111111111"
+	second := domain.ParseCommitMessage("feat: add login page")
+	second.Hash = "2222222222"
+
+	require.Equal(t, domain.ValidateCommitRules(first, unprofiled, cfg), domain.ValidateCommitRules(first, profiled, cfg),
+		"profiling does not change results")
+	domain.ValidateCommitRules(second, profiled, cfg)
+
+	require.Equal(t, []Sample{
+		{Rule: "Subject", Commit: "1111111111", Subject: "Fix the parser.", Duration: 3 * time.Millisecond},
+		{Rule: "ConventionalCommit", Commit: "1111111111", Subject: "Fix the parser.", Duration: time.Millisecond},
+		{Rule: "Subject", Commit: "2222222222", Subject: "feat: add login page", Duration: 2 * time.Millisecond},
+		{Rule: "ConventionalCommit", Commit: "2222222222", Subject: "feat: add login page", Duration: 5 * time.Millisecond},
+	}, profiler.Samples())
+
+	require.Equal(t, []RuleProfile{
+		{Rule: "ConventionalCommit", Total: 6 * time.Millisecond, Max: 5 * time.Millisecond, Calls: 2},
+		{Rule: "Subject", Total: 5 * time.Millisecond, Max: 3 * time.Millisecond, Calls: 2},
+	}, profiler.Rules())
+	require.Equal(t, 3*time.Millisecond, profiler.Rules()[0].Average())
+
+	require.Equal(t, []CommitProfile{
+		{Commit: "2222222222", Subject: "feat: add login page", Total: 7 * time.Millisecond,
+			SlowestRule: "ConventionalCommit", Slowest: 5 * time.Millisecond},
+		{Commit: "1111111111", Subject: "Fix the parser.", Total: 4 * time.Millisecond,
+			SlowestRule: "Subject", Slowest: 3 * time.Millisecond},
+	}, profiler.Commits())
+}
+
+func TestRepositoryRules(t *testing.T) {
+	cfg := config.NewDefault()
+	profiler := New()
+	profiler.now = steppingClock(time.Millisecond)
+
+	profiled := profiler.RepositoryRules([]domain.RepositoryRule{rules.NewLinearHistoryRule(cfg)})
+	require.Len(t, profiled, 1)
+
+	rangeRule, ok := profiled[0].(domain.RangeRule)
+	require.True(t, ok, "range validation is forwarded")
+	rangeRule.ValidateRange(nil, cfg)
+
+	require.Equal(t, []Sample{{Rule: "LinearHistory", Duration: time.Millisecond}}, profiler.Samples())
+	require.Empty(t, profiler.Commits(), "range validations belong to no commit")
+
+	branchAhead := profiler.RepositoryRules([]domain.RepositoryRule{rules.NewBranchAheadRule(cfg)})
+	require.False(t, domain.HasRangeRules(branchAhead, nil), "other rules do not become range rules")
+}
+
+func TestWriteReport(t *testing.T) {
+	profiler := New()
+	profiler.samples = []Sample{
+		{Rule: "Subject", Commit: "1111111111", Subject: "Fix the parser.", Duration: 1500 * time.Microsecond},
+		{Rule: "JiraTicket", Commit: "1111111111", Subject: "Fix the parser.", Duration: 1234567 * time.Microsecond},
+		{Rule: "Subject", Commit: "2222222222", Subject: "feat: add login page", Duration: 500 * time.Microsecond},
+		{Rule: "JiraTicket", Commit: "2222222222", Subject: "feat: add login page", Duration: 20 * time.Millisecond},
+	}
+
+	var output bytes.Buffer
+	require.NoError(t, profiler.WriteReport(&output, 1))
+	require.Equal(t, `Rule profile: 2 commits, 2 rules, 1.26s in rules
+
+Slowest rules:
+  RULE        TOTAL  CALLS  AVERAGE   MAX
+  JiraTicket  1.25s  2      627.28ms  1.23s
+
+Slowest commits:
+  COMMIT   TOTAL  SLOWEST RULE      SUBJECT
+  1111111  1.24s  JiraTicket 1.23s  Fix the parser.
+`, output.String())
+
+	output.Reset()
+	require.NoError(t, New().WriteReport(&output, 10))
+	require.Equal(t, "Rule profile: 0 commits, 0 rules, 0s in rules\n\nSlowest rules:\n  RULE  TOTAL  CALLS  AVERAGE  MAX\n", output.String())
+}
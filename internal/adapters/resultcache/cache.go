@@ -0,0 +1,227 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+// Package resultcache keeps the results of commit rules for commit messages in files,
+// so that hooks validating the same message again, as during rebase and amend loops,
+// reuse them instead of running the rules.
+package resultcache
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/itiquette/gommitlint/internal/adapters/signing"
+	"github.com/itiquette/gommitlint/internal/domain"
+	"github.com/itiquette/gommitlint/internal/domain/config"
+)
+
+// retention is how long a cached message is kept after it was last stored.
+const retention = 30 * 24 * time.Hour
+
+// Cache holds the rule results of messages validated with one configuration and build.
+// It is best effort: a file that cannot be read or written only costs running the rules.
+type Cache struct {
+	dir         string
+	fingerprint string
+	volatile    []string // Rules whose results depend on more than the message
+
+	mutex    sync.Mutex
+	messages map[string]map[string][]domain.ValidationError
+	pruned   bool
+}
+
+// New creates a cache in dir for results under cfg. build identifies the running
+// binary, so that results are not reused by a version whose rules behave differently.
+func New(dir string, cfg config.Config, build string) (*Cache, error) {
+	fingerprint, err := Fingerprint(cfg, build)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Cache{
+		dir:         dir,
+		fingerprint: fingerprint,
+		volatile:    volatileRules(cfg),
+		messages:    make(map[string]map[string][]domain.ValidationError),
+	}, nil
+}
+
+// Fingerprint returns the hash of cfg and build, which every cache key includes so that
+// a change of either invalidates all results.
+func Fingerprint(cfg config.Config, build string) (string, error) {
+	// Maps are encoded with sorted keys, so equal configurations encode equally
+	encoded, err := json.Marshal(cfg)
+	if err != nil {
+		return "", fmt.Errorf("encode configuration: %w", err)
+	}
+
+	sum := sha256.Sum256(append([]byte(build+"\x00"), encoded...))
+
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// volatileRules returns the rules of cfg whose results depend on more than the message
+// and the configuration: plugins, which may change without the configuration, rules
+// that look up tickets online, and the CLA rule, which reads the contributors file and
+// may query a remote registry.
+func volatileRules(cfg config.Config) []string {
+	names := []string{"IssueReference", "CLA"}
+
+	if cfg.Jira.Online.Enabled {
+		names = append(names, "JiraReference")
+	}
+
+	for _, plugin := range cfg.Plugins {
+		names = append(names, plugin.Name)
+	}
+
+	return names
+}
+
+// CommitRules wraps rules to reuse and store their results. Conditions of conditional
+// rules stay outside the cache, as they depend on the repository.
+func (c *Cache) CommitRules(rules []domain.CommitRule) []domain.CommitRule {
+	cached := make([]domain.CommitRule, 0, len(rules))
+
+	for _, rule := range rules {
+		if slices.Contains(c.volatile, rule.Name()) {
+			cached = append(cached, rule)
+
+			continue
+		}
+
+		if conditional, ok := rule.(domain.ConditionalCommitRule); ok {
+			conditional.CommitRule = cachedCommitRule{CommitRule: conditional.CommitRule, cache: c}
+			cached = append(cached, conditional)
+
+			continue
+		}
+
+		cached = append(cached, cachedCommitRule{CommitRule: rule, cache: c})
+	}
+
+	return cached
+}
+
+// cachedCommitRule reuses the stored result of a rule for a message it validated before.
+type cachedCommitRule struct {
+	domain.CommitRule
+
+	cache *Cache
+}
+
+// Validate returns the stored result for the message of commit, or validates it and
+// stores the result.
+func (r cachedCommitRule) Validate(commit domain.Commit, cfg config.Config) []domain.ValidationError {
+	key := r.cache.key(commit.Message)
+
+	if errors, found := r.cache.get(key, r.Name()); found {
+		return errors
+	}
+
+	errors := r.CommitRule.Validate(commit, cfg)
+	r.cache.put(key, r.Name(), errors)
+
+	return errors
+}
+
+// Metadata returns the documentation of the wrapped rule, which identifies the rule by
+// its configuration name in rules.blocking.
+func (r cachedCommitRule) Metadata() domain.RuleMetadata {
+	if described, ok := r.CommitRule.(domain.DescribedRule); ok {
+		return described.Metadata()
+	}
+
+	return domain.RuleMetadata{Name: r.Name()}
+}
+
+// key returns the cache key of message.
+func (c *Cache) key(message string) string {
+	sum := sha256.Sum256([]byte(c.fingerprint + "\x00" + message))
+
+	return hex.EncodeToString(sum[:])
+}
+
+// path returns the file holding the results of the message with key.
+func (c *Cache) path(key string) string {
+	return filepath.Join(c.dir, key+".json")
+}
+
+// get returns the stored result of rule for the message with key.
+func (c *Cache) get(key, rule string) ([]domain.ValidationError, bool) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	errors, found := c.load(key)[rule]
+
+	return errors, found
+}
+
+// put stores the result of rule for the message with key and writes its file.
+func (c *Cache) put(key, rule string, errors []domain.ValidationError) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	results := c.load(key)
+	results[rule] = errors
+
+	data, err := json.Marshal(results)
+	if err != nil {
+		return
+	}
+
+	c.prune()
+
+	_ = signing.SafeWriteFile(c.path(key), data, 0o600)
+}
+
+// load returns the results of the message with key, reading its file the first time.
+func (c *Cache) load(key string) map[string][]domain.ValidationError {
+	if results, found := c.messages[key]; found {
+		return results
+	}
+
+	results := make(map[string][]domain.ValidationError)
+
+	if data, err := os.ReadFile(c.path(key)); err == nil && json.Unmarshal(data, &results) != nil {
+		results = make(map[string][]domain.ValidationError)
+	}
+
+	c.messages[key] = results
+
+	return results
+}
+
+// prune removes the files of messages not stored within the retention, once per run.
+func (c *Cache) prune() {
+	if c.pruned {
+		return
+	}
+
+	c.pruned = true
+
+	entries, err := os.ReadDir(c.dir)
+	if err != nil {
+		return
+	}
+
+	for _, entry := range entries {
+		info, err := entry.Info()
+		if err != nil || !strings.HasSuffix(entry.Name(), ".json") {
+			continue
+		}
+
+		if time.Since(info.ModTime()) > retention {
+			_ = os.Remove(filepath.Join(c.dir, entry.Name()))
+		}
+	}
+}
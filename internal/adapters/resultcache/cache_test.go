@@ -0,0 +1,132 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package resultcache
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/itiquette/gommitlint/internal/domain"
+	"github.com/itiquette/gommitlint/internal/domain/config"
+)
+
+// countingRule fails every message and counts its validations.
+type countingRule struct {
+	name  string
+	calls *int
+}
+
+func (r countingRule) Name() string {
+	return r.name
+}
+
+func (r countingRule) Validate(_ domain.Commit, _ config.Config) []domain.ValidationError {
+	*r.calls++
+
+	return []domain.ValidationError{
+		domain.New(r.name, domain.ErrSubjectCase, "subject must start with an uppercase letter").
+			WithContextMap(map[string]string{"subject": "fix parser"}).
+			WithHelp("Capitalize the subject").
+			WithSeverity(domain.SeverityWarning),
+	}
+}
+
+// validate validates message with rules created by a new cache in dir.
+func validate(t *testing.T, dir string, cfg config.Config, rule domain.CommitRule, message string) []domain.ValidationError {
+	t.Helper()
+
+	cache, err := New(dir, cfg, "v1.0.0")
+	require.NoError(t, err)
+
+	return domain.ValidateCommitRules(domain.ParseCommitMessage(message), cache.CommitRules([]domain.CommitRule{rule}), cfg)
+}
+
+func TestCache_ReusesResults(t *testing.T) {
+	dir := t.TempDir()
+	cfg := config.NewDefault()
+	calls := 0
+	rule := countingRule{name: "Subject", calls: &calls}
+
+	first := validate(t, dir, cfg, rule, "fix parser")
+	require.Equal(t, 1, calls)
+
+	// A later run reads the result stored by the first
+	second := validate(t, dir, cfg, rule, "fix parser")
+	require.Equal(t, 1, calls)
+	require.Equal(t, first, second)
+	require.Equal(t, domain.SeverityWarning, second[0].Severity)
+	require.Equal(t, "fix parser", second[0].Context["subject"])
+
+	validate(t, dir, cfg, rule, "fix lexer")
+	require.Equal(t, 2, calls, "another message is validated")
+}
+
+func TestCache_ConfigurationChangeInvalidates(t *testing.T) {
+	dir := t.TempDir()
+	cfg := config.NewDefault()
+	calls := 0
+	rule := countingRule{name: "Subject", calls: &calls}
+
+	validate(t, dir, cfg, rule, "fix parser")
+
+	cfg.Message.Subject.MaxLength++
+	validate(t, dir, cfg, rule, "fix parser")
+	require.Equal(t, 2, calls)
+
+	validate(t, dir, cfg, rule, "fix parser")
+	require.Equal(t, 2, calls)
+
+	cache, err := New(dir, cfg, "v1.0.1")
+	require.NoError(t, err)
+	domain.ValidateCommitRules(domain.ParseCommitMessage("fix parser"), cache.CommitRules([]domain.CommitRule{rule}), cfg)
+	require.Equal(t, 3, calls, "another build does not reuse results")
+}
+
+func TestCache_VolatileRulesAreNotCached(t *testing.T) {
+	dir := t.TempDir()
+	cfg := config.NewDefault()
+	cfg.Plugins = []config.PluginConfig{{Name: "ticket-lookup", Command: "lookup"}}
+
+	calls := 0
+	rule := countingRule{name: "ticket-lookup", calls: &calls}
+
+	validate(t, dir, cfg, rule, "fix parser")
+	validate(t, dir, cfg, rule, "fix parser")
+	require.Equal(t, 2, calls)
+}
+
+func TestCache_CLARuleIsNotCached(t *testing.T) {
+	dir := t.TempDir()
+	cfg := config.NewDefault()
+
+	// The contributors file may change without the configuration
+	calls := 0
+	rule := countingRule{name: "CLA", calls: &calls}
+
+	validate(t, dir, cfg, rule, "fix parser")
+	validate(t, dir, cfg, rule, "fix parser")
+	require.Equal(t, 2, calls)
+}
+
+func TestCache_ConditionsStayOutside(t *testing.T) {
+	cfg := config.NewDefault()
+	cache, err := New(t.TempDir(), cfg, "v1.0.0")
+	require.NoError(t, err)
+
+	calls := 0
+	conditional := domain.ConditionalCommitRule{
+		CommitRule: countingRule{name: "Subject", calls: &calls},
+		Condition:  config.RuleCondition{Branches: []string{"main"}},
+	}
+
+	cached := cache.CommitRules([]domain.CommitRule{conditional})
+	require.Len(t, cached, 1)
+
+	kept, ok := cached[0].(domain.ConditionalCommitRule)
+	require.True(t, ok, "conditional rules keep their condition")
+	require.Equal(t, conditional.Condition, kept.Condition)
+	require.IsType(t, cachedCommitRule{}, kept.CommitRule)
+}
@@ -5,7 +5,7 @@
 /*
 Package signing provides cryptographic signature verification adapters.
 
-This package implements domain interfaces for verifying commit signatures,
+This package implements domain interfaces for verifying commit and tag signatures,
 isolating cryptographic complexity from the core domain logic.
 
 Key components:
@@ -13,6 +13,7 @@ Key components:
   - verification.go: Main verification logic and domain interface implementation
   - gpg.go: GPG/OpenPGP signature verification
   - ssh.go: SSH signature verification
+  - sshsig.go: SSHSIG signatures as written by git and ssh-keygen
   - files.go: Secure file operations for key management
   - repository.go: Key repository abstraction
 
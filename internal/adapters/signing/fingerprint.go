@@ -0,0 +1,72 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package signing
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/ProtonMail/go-crypto/openpgp/armor"
+	"github.com/ProtonMail/go-crypto/openpgp/packet"
+	"github.com/itiquette/gommitlint/internal/domain"
+	"golang.org/x/crypto/ssh"
+)
+
+// gpgFingerprint formats an OpenPGP key fingerprint as upper case hex, as gpg shows it.
+func gpgFingerprint(fingerprint []byte) string {
+	return strings.ToUpper(hex.EncodeToString(fingerprint))
+}
+
+// signatureFingerprint returns what a signature tells about the key that made it: the
+// issuer fingerprint, or else the key ID, of a GPG signature and the SHA256 fingerprint
+// of the key embedded in an SSH signature. It is used to report unverified signatures,
+// and is empty when the signature cannot be parsed.
+func signatureFingerprint(signature domain.Signature) string {
+	switch signature.Type() {
+	case domain.SignatureTypeGPG:
+		block, err := armor.Decode(strings.NewReader(signature.Data()))
+		if err != nil {
+			return ""
+		}
+
+		parsed, err := packet.Read(block.Body)
+		if err != nil {
+			return ""
+		}
+
+		sig, ok := parsed.(*packet.Signature)
+		if !ok {
+			return ""
+		}
+
+		if len(sig.IssuerFingerprint) > 0 {
+			return gpgFingerprint(sig.IssuerFingerprint)
+		}
+
+		if sig.IssuerKeyId != nil {
+			return fmt.Sprintf("%016X", *sig.IssuerKeyId)
+		}
+
+	case domain.SignatureTypeSSH:
+		format, blob, err := parseSSHSignature(signature.Data())
+		if err != nil || format != sshSigFormat {
+			return ""
+		}
+
+		sig, err := parseSSHSig(blob)
+		if err != nil {
+			return ""
+		}
+
+		if pubKey, err := ssh.ParsePublicKey(sig.PublicKey); err == nil {
+			return ssh.FingerprintSHA256(pubKey)
+		}
+
+	case domain.SignatureTypeUnknown:
+	}
+
+	return ""
+}
@@ -5,6 +5,7 @@
 package signing
 
 import (
+	"crypto"
 	"fmt"
 	"os"
 	"strings"
@@ -17,15 +18,18 @@ import (
 
 // GPGSecuritySettings defines security requirements for GPG keys.
 type GPGSecuritySettings struct {
-	MinimumRSABits uint16
-	MinimumECBits  uint16
+	MinimumRSABits    uint16
+	MinimumECBits     uint16
+	AllowedAlgorithms []string // Key algorithms such as "rsa" or "ed25519"
+	AllowSHA1         bool     // Accept keys whose user IDs are self-signed with SHA-1
 }
 
 // DefaultGPGSecuritySettings provides reasonable default security settings.
 func DefaultGPGSecuritySettings() GPGSecuritySettings {
 	return GPGSecuritySettings{
-		MinimumRSABits: 2048,
-		MinimumECBits:  256,
+		MinimumRSABits:    2048,
+		MinimumECBits:     256,
+		AllowedAlgorithms: defaultAllowedAlgorithms(),
 	}
 }
 
@@ -44,6 +48,15 @@ func VerifyGPGSignature(signature domain.Signature, data []byte, keyDir string,
 		).WithError("empty_signature", "GPG signature is empty")
 	}
 
+	// The ssh-agent and known signers, searched without a key directory, hold no GPG keys
+	if keyDir == "" {
+		return domain.NewVerificationResult(
+			domain.VerificationStatusNoKey,
+			domain.NewIdentity("", ""),
+			signature,
+		).WithError("no_keys", "No key directory with GPG keys configured")
+	}
+
 	// Sanitize key directory path
 	sanitizedKeyDir, err := SanitizePath(keyDir)
 	if err != nil {
@@ -72,6 +85,12 @@ func VerifyGPGSignature(signature domain.Signature, data []byte, keyDir string,
 		).WithError("no_keys", "No GPG key files found in "+keyDir)
 	}
 
+	// Set when the signing key was found but does not meet the key policy
+	var (
+		rejected    error
+		rejectedKey *openpgp.Entity
+	)
+
 	// Try each key file
 	for _, keyFile := range keyFiles {
 		entities, err := loadGPGKey(keyFile)
@@ -82,7 +101,7 @@ func VerifyGPGSignature(signature domain.Signature, data []byte, keyDir string,
 		// Try each key in the file
 		for _, entity := range entities {
 			// Skip invalid keys
-			if isKeyRevoked(entity) || isKeyExpired(entity, time.Now()) || !hasMinimumGPGKeyStrength(entity, settings) {
+			if isKeyRevoked(entity) || isKeyExpired(entity, time.Now()) {
 				continue
 			}
 
@@ -98,18 +117,32 @@ func VerifyGPGSignature(signature domain.Signature, data []byte, keyDir string,
 			)
 
 			if err == nil && verifiedEntity != nil {
-				// Found a matching key
+				// Found a matching key, which must also meet the key policy
+				if err := checkGPGKeyPolicy(entity, settings); err != nil {
+					rejected, rejectedKey = err, entity
+
+					continue
+				}
+
 				identity := extractGPGIdentity(verifiedEntity)
 
-				return domain.NewVerificationResult(
+				return withGPGKey(domain.NewVerificationResult(
 					domain.VerificationStatusVerified,
 					identity,
 					signature,
-				)
+				), entity)
 			}
 		}
 	}
 
+	if rejected != nil {
+		return withGPGKey(domain.NewVerificationResult(
+			domain.VerificationStatusFailed,
+			domain.NewIdentity("", ""),
+			signature,
+		), rejectedKey).WithError("weak_key", "GPG signing key rejected: "+rejected.Error())
+	}
+
 	// If we get here, no keys matched
 	return domain.NewVerificationResult(
 		domain.VerificationStatusFailed,
@@ -118,6 +151,25 @@ func VerifyGPGSignature(signature domain.Signature, data []byte, keyDir string,
 	).WithError("verification_failed", "GPG signature not verified with any trusted key")
 }
 
+// withGPGKey returns result with the fingerprint, algorithm and dates of the primary
+// key of entity.
+func withGPGKey(result domain.VerificationResult, entity *openpgp.Entity) domain.VerificationResult {
+	return result.WithFingerprint(gpgFingerprint(entity.PrimaryKey.Fingerprint)).
+		WithKeyDetails(gpgKeyAlgorithm(entity.PrimaryKey.PubKeyAlgo), entity.PrimaryKey.CreationTime, gpgKeyExpiry(entity))
+}
+
+// gpgKeyExpiry returns when the primary key of entity expires, as set by the self-signature
+// of its primary user ID, or zero when it does not expire.
+func gpgKeyExpiry(entity *openpgp.Entity) time.Time {
+	identity := entity.PrimaryIdentity()
+	if identity == nil || identity.SelfSignature == nil || identity.SelfSignature.KeyLifetimeSecs == nil ||
+		*identity.SelfSignature.KeyLifetimeSecs == 0 {
+		return time.Time{}
+	}
+
+	return entity.PrimaryKey.CreationTime.Add(time.Duration(*identity.SelfSignature.KeyLifetimeSecs) * time.Second)
+}
+
 // loadGPGKey loads a GPG key from a file.
 func loadGPGKey(path string) ([]*openpgp.Entity, error) {
 	data, err := os.ReadFile(path)
@@ -205,6 +257,13 @@ func hasMinimumGPGKeyStrength(entity *openpgp.Entity, settings GPGSecuritySettin
 		return bitLength >= settings.MinimumRSABits
 	}
 
+	// DSA is a finite field algorithm like RSA and held to the same minimum
+	if entity.PrimaryKey.PubKeyAlgo == packet.PubKeyAlgoDSA {
+		bitLength, err := entity.PrimaryKey.BitLength()
+
+		return err == nil && bitLength >= settings.MinimumRSABits
+	}
+
 	// For EC keys
 	if entity.PrimaryKey.PubKeyAlgo == packet.PubKeyAlgoECDSA ||
 		entity.PrimaryKey.PubKeyAlgo == packet.PubKeyAlgoEdDSA ||
@@ -229,6 +288,47 @@ func hasMinimumGPGKeyStrength(entity *openpgp.Entity, settings GPGSecuritySettin
 	return false
 }
 
+// checkGPGKeyPolicy checks a key's algorithm and strength and, unless SHA-1 is allowed,
+// rejects keys with user IDs self-signed using SHA-1.
+func checkGPGKeyPolicy(entity *openpgp.Entity, settings GPGSecuritySettings) error {
+	algorithm := gpgKeyAlgorithm(entity.PrimaryKey.PubKeyAlgo)
+	if !isAllowedAlgorithm(algorithm, settings.AllowedAlgorithms) {
+		return fmt.Errorf("key algorithm %s is not allowed", algorithmName(algorithm))
+	}
+
+	if !hasMinimumGPGKeyStrength(entity, settings) {
+		return fmt.Errorf("%s key is below the minimum key size", algorithm)
+	}
+
+	if settings.AllowSHA1 {
+		return nil
+	}
+
+	for name, ident := range entity.Identities {
+		if ident.SelfSignature != nil && ident.SelfSignature.Hash == crypto.SHA1 {
+			return fmt.Errorf("user ID %q is self-signed with SHA-1", name)
+		}
+	}
+
+	return nil
+}
+
+// gpgKeyAlgorithm names an OpenPGP public key algorithm as in allowed_algorithms.
+func gpgKeyAlgorithm(algorithm packet.PublicKeyAlgorithm) string {
+	switch algorithm {
+	case packet.PubKeyAlgoRSA, packet.PubKeyAlgoRSAEncryptOnly, packet.PubKeyAlgoRSASignOnly:
+		return "rsa"
+	case packet.PubKeyAlgoDSA:
+		return "dsa"
+	case packet.PubKeyAlgoECDSA:
+		return "ecdsa"
+	case packet.PubKeyAlgoEdDSA, packet.PubKeyAlgoEd25519:
+		return "ed25519"
+	default:
+		return ""
+	}
+}
+
 // extractGPGIdentity extracts a domain.Identity from an OpenPGP entity.
 func extractGPGIdentity(entity *openpgp.Entity) domain.Identity {
 	if entity == nil {
@@ -0,0 +1,78 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package signing
+
+import (
+	"math"
+	"slices"
+	"strings"
+
+	"github.com/itiquette/gommitlint/internal/domain/config"
+)
+
+// NewGPGSecuritySettings creates GPG security settings from the signature configuration,
+// using the defaults for unset values.
+func NewGPGSecuritySettings(cfg config.SignatureConfig) GPGSecuritySettings {
+	defaults := DefaultGPGSecuritySettings()
+
+	return GPGSecuritySettings{
+		MinimumRSABits:    bitsOrDefault(cfg.MinRSABits, defaults.MinimumRSABits),
+		MinimumECBits:     bitsOrDefault(cfg.MinECBits, defaults.MinimumECBits),
+		AllowedAlgorithms: algorithmsOrDefault(cfg.AllowedAlgorithms),
+		AllowSHA1:         cfg.AllowSHA1,
+	}
+}
+
+// NewSSHSecuritySettings creates SSH security settings from the signature configuration,
+// using the defaults for unset values.
+func NewSSHSecuritySettings(cfg config.SignatureConfig) SSHSecuritySettings {
+	defaults := DefaultSSHSecuritySettings()
+
+	return SSHSecuritySettings{
+		MinimumRSABits:    bitsOrDefault(cfg.MinRSABits, defaults.MinimumRSABits),
+		MinimumECBits:     bitsOrDefault(cfg.MinECBits, defaults.MinimumECBits),
+		AllowedAlgorithms: algorithmsOrDefault(cfg.AllowedAlgorithms),
+		AllowSHA1:         cfg.AllowSHA1,
+		UseAgent:          cfg.SSHAgent,
+		KnownSignersFile:  cfg.KnownSigners,
+	}
+}
+
+// defaultAllowedAlgorithms returns the key algorithms accepted by default; DSA is not.
+func defaultAllowedAlgorithms() []string {
+	return []string{"rsa", "ecdsa", "ed25519"}
+}
+
+// isAllowedAlgorithm reports whether a key algorithm is in the allowed list, ignoring case.
+func isAllowedAlgorithm(algorithm string, allowed []string) bool {
+	return algorithm != "" && slices.ContainsFunc(allowed, func(name string) bool {
+		return strings.EqualFold(name, algorithm)
+	})
+}
+
+// algorithmName returns the algorithm for messages.
+func algorithmName(algorithm string) string {
+	if algorithm == "" {
+		return "unknown"
+	}
+
+	return algorithm
+}
+
+func bitsOrDefault(bits int, fallback uint16) uint16 {
+	if bits <= 0 || bits > math.MaxUint16 {
+		return fallback
+	}
+
+	return uint16(bits)
+}
+
+func algorithmsOrDefault(algorithms []string) []string {
+	if len(algorithms) == 0 {
+		return defaultAllowedAlgorithms()
+	}
+
+	return algorithms
+}
@@ -0,0 +1,95 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package signing
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/ssh"
+)
+
+// securityKeyApplicationPrefix starts the application string of FIDO keys made for SSH,
+// as ssh-keygen -t ed25519-sk requires.
+const securityKeyApplicationPrefix = "ssh:"
+
+// securityKeyUserPresent is the flag of a FIDO signature made with the user touching the key.
+const securityKeyUserPresent = 0x01
+
+// isSecurityKey reports whether pubKey is held by a FIDO security key.
+func isSecurityKey(pubKey ssh.PublicKey) bool {
+	switch pubKey.Type() {
+	case ssh.KeyAlgoSKED25519, ssh.KeyAlgoSKECDSA256:
+		return true
+	default:
+		return false
+	}
+}
+
+// checkSecurityKeySignature checks what the signature of a FIDO security key adds to the
+// key: an application string made for SSH, as OpenSSH checks, and the touch of the user,
+// which OpenSSH requires by default.
+func checkSecurityKeySignature(pubKey ssh.PublicKey, signature *ssh.Signature) error {
+	application, err := securityKeyApplication(pubKey)
+	if err != nil {
+		return err
+	}
+
+	if !strings.HasPrefix(application, securityKeyApplicationPrefix) {
+		return fmt.Errorf("security key application %q is not for SSH", application)
+	}
+
+	var fields struct {
+		Flags   byte
+		Counter uint32
+	}
+
+	if err := ssh.Unmarshal(signature.Rest, &fields); err != nil {
+		return fmt.Errorf("malformed security key signature: %w", err)
+	}
+
+	if fields.Flags&securityKeyUserPresent == 0 {
+		return errors.New("security key signature made without user presence")
+	}
+
+	return nil
+}
+
+// securityKeyApplication returns the application string in the wire format of a FIDO
+// public key, which follows the key itself.
+func securityKeyApplication(pubKey ssh.PublicKey) (string, error) {
+	var err error
+
+	switch pubKey.Type() {
+	case ssh.KeyAlgoSKED25519:
+		var key struct {
+			Name        string
+			KeyBytes    []byte
+			Application string
+		}
+
+		err = ssh.Unmarshal(pubKey.Marshal(), &key)
+		if err == nil {
+			return key.Application, nil
+		}
+	case ssh.KeyAlgoSKECDSA256:
+		var key struct {
+			Name        string
+			Curve       string
+			KeyBytes    []byte
+			Application string
+		}
+
+		err = ssh.Unmarshal(pubKey.Marshal(), &key)
+		if err == nil {
+			return key.Application, nil
+		}
+	default:
+		return "", fmt.Errorf("%s is not a security key", pubKey.Type())
+	}
+
+	return "", fmt.Errorf("malformed security key: %w", err)
+}
@@ -9,25 +9,34 @@ import (
 	"encoding/base64"
 	"errors"
 	"fmt"
+	"net"
 	"os"
 	"path/filepath"
+	"slices"
 	"strings"
+	"time"
 
 	"github.com/itiquette/gommitlint/internal/domain"
 	"golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/agent"
 )
 
 // SSHSecuritySettings defines security requirements for SSH keys.
 type SSHSecuritySettings struct {
-	MinimumRSABits uint16
-	MinimumECBits  uint16
+	MinimumRSABits    uint16
+	MinimumECBits     uint16
+	AllowedAlgorithms []string // Key algorithms such as "rsa" or "ed25519"
+	AllowSHA1         bool     // Accept ssh-rsa signatures, which hash with SHA-1
+	UseAgent          bool     // Also trust the keys held by the running ssh-agent
+	KnownSignersFile  string   // OpenSSH allowed signers file whose keys are also trusted
 }
 
 // DefaultSSHSecuritySettings provides reasonable default security settings.
 func DefaultSSHSecuritySettings() SSHSecuritySettings {
 	return SSHSecuritySettings{
-		MinimumRSABits: 2048,
-		MinimumECBits:  256,
+		MinimumRSABits:    2048,
+		MinimumECBits:     256,
+		AllowedAlgorithms: defaultAllowedAlgorithms(),
 	}
 }
 
@@ -56,32 +65,59 @@ func VerifySSHSignature(signature domain.Signature, data []byte, keyDir string,
 		).WithError("invalid_signature", fmt.Sprintf("Invalid SSH signature format: %s", err))
 	}
 
-	// Sanitize key directory path
-	sanitizedKeyDir, err := SanitizePath(keyDir)
-	if err != nil {
-		return domain.NewVerificationResult(
-			domain.VerificationStatusFailed,
-			domain.NewIdentity("", ""),
-			signature,
-		).WithError("invalid_key_dir", fmt.Sprintf("Invalid key directory: %s", err))
+	var keys []trustedSSHKey
+
+	// Without a key directory only the keys of the agent and known signers are trusted
+	if keyDir != "" {
+		// Sanitize key directory path
+		sanitizedKeyDir, err := SanitizePath(keyDir)
+		if err != nil {
+			return domain.NewVerificationResult(
+				domain.VerificationStatusFailed,
+				domain.NewIdentity("", ""),
+				signature,
+			).WithError("invalid_key_dir", fmt.Sprintf("Invalid key directory: %s", err))
+		}
+
+		// Find SSH key files
+		keyFiles, err := FindFilesWithExtensions(sanitizedKeyDir, []string{".pub", ".ssh"})
+		if err != nil {
+			return domain.NewVerificationResult(
+				domain.VerificationStatusFailed,
+				domain.NewIdentity("", ""),
+				signature,
+			).WithError("key_dir_error", fmt.Sprintf("Failed to find SSH keys: %s", err))
+		}
+
+		for _, keyFile := range keyFiles {
+			keyName, pubKey, err := loadSSHKey(keyFile)
+			if err != nil {
+				continue // Skip invalid keys
+			}
+
+			keys = append(keys, trustedSSHKey{name: keyName, key: pubKey})
+		}
 	}
 
-	// Find SSH key files
-	keyFiles, err := FindFilesWithExtensions(sanitizedKeyDir, []string{".pub", ".ssh"})
-	if err != nil {
-		return domain.NewVerificationResult(
-			domain.VerificationStatusFailed,
-			domain.NewIdentity("", ""),
-			signature,
-		).WithError("key_dir_error", fmt.Sprintf("Failed to find SSH keys: %s", err))
+	if settings.UseAgent {
+		keys = append(keys, agentSSHKeys()...)
 	}
 
-	if len(keyFiles) == 0 {
+	if settings.KnownSignersFile != "" {
+		keys = append(keys, knownSignerKeys(settings.KnownSignersFile)...)
+	}
+
+	if len(keys) == 0 {
+		message := "No SSH key files found in " + keyDir
+		if keyDir == "" {
+			message = "No SSH keys found in ssh-agent or known signers"
+		}
+
 		return domain.NewVerificationResult(
 			domain.VerificationStatusNoKey,
 			domain.NewIdentity("", ""),
 			signature,
-		).WithError("no_keys", "No SSH key files found in "+keyDir)
+		).WithError("no_keys", message)
 	}
 
 	// Create SSH signature
@@ -90,29 +126,53 @@ func VerifySSHSignature(signature domain.Signature, data []byte, keyDir string,
 		Blob:   blob,
 	}
 
+	// Set when the signing key was found but does not meet the key policy
+	var (
+		rejected    error
+		rejectedKey ssh.PublicKey
+	)
+
 	// Try each key
-	for _, keyFile := range keyFiles {
-		keyName, pubKey, err := loadSSHKey(keyFile)
-		if err != nil {
-			continue // Skip invalid keys
+	for _, trusted := range keys {
+		pubKey := trusted.key
+
+		// Verify signature
+		var err error
+
+		verified := sshSignature
+		if format == sshSigFormat {
+			verified, err = verifySSHSig(blob, data, pubKey)
+		} else {
+			err = pubKey.Verify(data, sshSignature)
 		}
 
-		// Check if key meets minimum strength requirements
-		if !hasMinimumSSHKeyStrength(pubKey, settings) {
-			continue // Skip weak keys
+		if err != nil {
+			continue
 		}
 
-		// Verify signature
-		if err := pubKey.Verify(data, sshSignature); err == nil {
-			// Generate identity from key name
-			identity := extractSSHIdentity(keyName, keyFile)
+		// Found a matching key, which must also meet the key policy
+		if err := checkSSHKeyPolicy(pubKey, verified, settings); err != nil {
+			rejected, rejectedKey = err, pubKey
 
-			return domain.NewVerificationResult(
-				domain.VerificationStatusVerified,
-				identity,
-				signature,
-			)
+			continue
 		}
+
+		// Generate identity from key name
+		identity := extractSSHIdentity(trusted.name, "")
+
+		return withSSHKey(domain.NewVerificationResult(
+			domain.VerificationStatusVerified,
+			identity,
+			signature,
+		), pubKey)
+	}
+
+	if rejected != nil {
+		return withSSHKey(domain.NewVerificationResult(
+			domain.VerificationStatusFailed,
+			domain.NewIdentity("", ""),
+			signature,
+		), rejectedKey).WithError("weak_key", "SSH signing key rejected: "+rejected.Error())
 	}
 
 	// If we get here, no keys matched
@@ -123,6 +183,102 @@ func VerifySSHSignature(signature domain.Signature, data []byte, keyDir string,
 	).WithError("verification_failed", "SSH signature not verified with any trusted key")
 }
 
+// trustedSSHKey is a public key trusted for verifying SSH signatures, with the name its
+// signer is identified by.
+type trustedSSHKey struct {
+	name string
+	key  ssh.PublicKey
+}
+
+// agentSSHKeys returns the keys held by the ssh-agent at SSH_AUTH_SOCK, named by their
+// comments. Without a reachable agent there are none.
+func agentSSHKeys() []trustedSSHKey {
+	socket := os.Getenv("SSH_AUTH_SOCK")
+	if socket == "" {
+		return nil
+	}
+
+	conn, err := net.Dial("unix", socket)
+	if err != nil {
+		return nil
+	}
+	defer conn.Close()
+
+	agentKeys, err := agent.NewClient(conn).List()
+	if err != nil {
+		return nil
+	}
+
+	keys := make([]trustedSSHKey, 0, len(agentKeys))
+
+	for _, agentKey := range agentKeys {
+		// Parsing gives the key of its type, which the key policy needs for its size
+		pubKey, err := ssh.ParsePublicKey(agentKey.Marshal())
+		if err != nil {
+			continue
+		}
+
+		keys = append(keys, trustedSSHKey{name: agentKey.Comment, key: pubKey})
+	}
+
+	return keys
+}
+
+// knownSignerKeys returns the keys of an OpenSSH allowed signers file, as written for
+// gpg.ssh.allowedSignersFile, named by their first principal. Keys limited by a
+// namespaces option to namespaces other than git are left out. A file that cannot be
+// read has no keys.
+func knownSignerKeys(path string) []trustedSSHKey {
+	if rest, found := strings.CutPrefix(path, "~/"); found {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return nil
+		}
+
+		path = filepath.Join(home, rest)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil
+	}
+
+	var keys []trustedSSHKey
+
+	for _, line := range strings.Split(string(data), "\n") {
+		principals, entry, found := strings.Cut(strings.TrimSpace(line), " ")
+		if !found || strings.HasPrefix(principals, "#") {
+			continue
+		}
+
+		// The rest of the line is a key with options, as in authorized_keys
+		pubKey, _, options, _, err := ssh.ParseAuthorizedKey([]byte(entry))
+		if err != nil || !allowsGitNamespace(options) {
+			continue
+		}
+
+		name, _, _ := strings.Cut(principals, ",")
+		keys = append(keys, trustedSSHKey{name: name, key: pubKey})
+	}
+
+	return keys
+}
+
+// allowsGitNamespace reports whether the options of an allowed signer permit signatures
+// in the git namespace.
+func allowsGitNamespace(options []string) bool {
+	for _, option := range options {
+		namespaces, found := strings.CutPrefix(strings.ToLower(option), "namespaces=")
+		if !found {
+			continue
+		}
+
+		return slices.Contains(strings.Split(strings.Trim(namespaces, `"`), ","), "git")
+	}
+
+	return true
+}
+
 // parseSSHSignature parses an SSH signature string into its components.
 func parseSSHSignature(signatureData string) (string, []byte, error) {
 	// Check if signature is in the SSH signature block format
@@ -154,9 +310,8 @@ func parseSSHSignature(signatureData string) (string, []byte, error) {
 			return "", nil, fmt.Errorf("failed to decode SSH signature blob: %w", err)
 		}
 
-		// The format is typically embedded in the blob
-		// For now, return a default format
-		return "ssh-rsa", decodedData, nil
+		// The key type and signature format are embedded in the SSHSIG blob
+		return sshSigFormat, decodedData, nil
 	}
 
 	// Otherwise check for format:blob
@@ -245,13 +400,65 @@ func hasMinimumSSHKeyStrength(pubKey ssh.PublicKey, settings SSHSecuritySettings
 		return settings.MinimumECBits <= 384
 	case "ecdsa-sha2-nistp521":
 		return settings.MinimumECBits <= 521
-	case "ssh-ed25519":
+	case "ssh-ed25519", ssh.KeyAlgoSKED25519:
 		return settings.MinimumECBits <= 256 // Ed25519 is always 256 bits
+	case ssh.KeyAlgoSKECDSA256:
+		return settings.MinimumECBits <= 256
+	case "ssh-dss":
+		return settings.MinimumRSABits <= 1024 // SSH only supports 1024 bit DSA keys
 	default:
 		return false
 	}
 }
 
+// checkSSHKeyPolicy checks a key's algorithm and strength and, unless SHA-1 is allowed,
+// rejects ssh-rsa signatures, which hash with SHA-1. Signatures of FIDO security keys
+// must also pass checkSecurityKeySignature.
+func checkSSHKeyPolicy(pubKey ssh.PublicKey, signature *ssh.Signature, settings SSHSecuritySettings) error {
+	algorithm := sshKeyAlgorithm(pubKey.Type())
+	if !isAllowedAlgorithm(algorithm, settings.AllowedAlgorithms) {
+		return fmt.Errorf("key algorithm %s is not allowed", algorithmName(algorithm))
+	}
+
+	if !hasMinimumSSHKeyStrength(pubKey, settings) {
+		return fmt.Errorf("%s key is below the minimum key size", algorithm)
+	}
+
+	if signature.Format == ssh.KeyAlgoRSA && !settings.AllowSHA1 {
+		return errors.New("ssh-rsa signatures use SHA-1")
+	}
+
+	if isSecurityKey(pubKey) {
+		return checkSecurityKeySignature(pubKey, signature)
+	}
+
+	return nil
+}
+
+// withSSHKey returns result with the fingerprint and algorithm of pubKey. SSH keys carry
+// no creation or expiry dates.
+func withSSHKey(result domain.VerificationResult, pubKey ssh.PublicKey) domain.VerificationResult {
+	return result.WithFingerprint(ssh.FingerprintSHA256(pubKey)).
+		WithKeyDetails(sshKeyAlgorithm(pubKey.Type()), time.Time{}, time.Time{})
+}
+
+// sshKeyAlgorithm names an SSH public key type as in allowed_algorithms. FIDO security
+// keys are named by the algorithm they sign with.
+func sshKeyAlgorithm(keyType string) string {
+	switch {
+	case keyType == ssh.KeyAlgoRSA:
+		return "rsa"
+	case keyType == ssh.InsecureKeyAlgoDSA:
+		return "dsa"
+	case strings.HasPrefix(keyType, "ecdsa-sha2-"), keyType == ssh.KeyAlgoSKECDSA256:
+		return "ecdsa"
+	case keyType == ssh.KeyAlgoED25519, keyType == ssh.KeyAlgoSKED25519:
+		return "ed25519"
+	default:
+		return ""
+	}
+}
+
 // extractSSHIdentity extracts a domain.Identity from an SSH key name and file path.
 func extractSSHIdentity(keyName string, _ string) domain.Identity {
 	// If the key name looks like an email, parse it
@@ -0,0 +1,115 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package signing
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"crypto/sha512"
+	"errors"
+	"fmt"
+
+	"golang.org/x/crypto/ssh"
+)
+
+// sshSigFormat marks a signature parsed from an armored SSHSIG block, as written by
+// ssh-keygen -Y sign and git's SSH signing.
+const sshSigFormat = "sshsig"
+
+// sshSigMagic is the preamble of SSHSIG blobs and of the data they sign.
+const sshSigMagic = "SSHSIG"
+
+// gitSSHNamespace is the namespace git uses for commit and tag signatures.
+const gitSSHNamespace = "git"
+
+// sshSigBlob is the wire format of an SSHSIG blob following the magic preamble.
+type sshSigBlob struct {
+	Version       uint32
+	PublicKey     []byte
+	Namespace     string
+	Reserved      string
+	HashAlgorithm string
+	Signature     []byte
+}
+
+// sshSigSignedData is the structure the SSHSIG signature is computed over.
+type sshSigSignedData struct {
+	Namespace     string
+	Reserved      string
+	HashAlgorithm string
+	Hash          []byte
+}
+
+// parseSSHSig parses an SSHSIG blob, including its magic preamble.
+func parseSSHSig(blob []byte) (sshSigBlob, error) {
+	if !bytes.HasPrefix(blob, []byte(sshSigMagic)) {
+		return sshSigBlob{}, errors.New("missing SSHSIG preamble")
+	}
+
+	var sig sshSigBlob
+	if err := ssh.Unmarshal(blob[len(sshSigMagic):], &sig); err != nil {
+		return sshSigBlob{}, fmt.Errorf("malformed SSHSIG blob: %w", err)
+	}
+
+	return sig, nil
+}
+
+// verifySSHSig verifies an SSHSIG blob over data with pubKey and returns the signature it
+// holds, whose format names the signature algorithm, such as rsa-sha2-512. The blob must
+// name pubKey as its signer and use the git namespace.
+func verifySSHSig(blob []byte, data []byte, pubKey ssh.PublicKey) (*ssh.Signature, error) {
+	sig, err := parseSSHSig(blob)
+	if err != nil {
+		return nil, err
+	}
+
+	if sig.Version != 1 {
+		return nil, fmt.Errorf("unsupported SSHSIG version %d", sig.Version)
+	}
+
+	if sig.Namespace != gitSSHNamespace {
+		return nil, fmt.Errorf("unexpected SSHSIG namespace %q", sig.Namespace)
+	}
+
+	signer, err := ssh.ParsePublicKey(sig.PublicKey)
+	if err != nil {
+		return nil, fmt.Errorf("invalid SSHSIG public key: %w", err)
+	}
+
+	if !bytes.Equal(signer.Marshal(), pubKey.Marshal()) {
+		return nil, errors.New("signed by a different key")
+	}
+
+	var hash []byte
+
+	switch sig.HashAlgorithm {
+	case "sha256":
+		sum := sha256.Sum256(data)
+		hash = sum[:]
+	case "sha512":
+		sum := sha512.Sum512(data)
+		hash = sum[:]
+	default:
+		return nil, fmt.Errorf("unsupported SSHSIG hash algorithm %q", sig.HashAlgorithm)
+	}
+
+	signature := new(ssh.Signature)
+	if err := ssh.Unmarshal(sig.Signature, signature); err != nil {
+		return nil, fmt.Errorf("malformed SSHSIG signature: %w", err)
+	}
+
+	signed := append([]byte(sshSigMagic), ssh.Marshal(sshSigSignedData{
+		Namespace:     sig.Namespace,
+		Reserved:      sig.Reserved,
+		HashAlgorithm: sig.HashAlgorithm,
+		Hash:          hash,
+	})...)
+
+	if err := pubKey.Verify(signed, signature); err != nil {
+		return nil, err
+	}
+
+	return signature, nil
+}
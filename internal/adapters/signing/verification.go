@@ -8,21 +8,47 @@ import (
 	"context"
 
 	"github.com/itiquette/gommitlint/internal/domain"
+	"github.com/itiquette/gommitlint/internal/domain/config"
 )
 
 // VerificationAdapter provides crypto verification services.
 // It implements the domain.SignatureVerifier interface directly without complex patterns.
-type VerificationAdapter struct{}
+type VerificationAdapter struct {
+	gpgSettings GPGSecuritySettings
+	sshSettings SSHSecuritySettings
+}
 
 // Ensure VerificationAdapter implements SignatureVerifier interface.
 var _ domain.SignatureVerifier = (*VerificationAdapter)(nil)
 
-// NewVerificationAdapter creates a new verification adapter.
+// NewVerificationAdapter creates a new verification adapter with the default key policy.
 func NewVerificationAdapter() *VerificationAdapter {
-	return &VerificationAdapter{}
+	return &VerificationAdapter{
+		gpgSettings: DefaultGPGSecuritySettings(),
+		sshSettings: DefaultSSHSecuritySettings(),
+	}
+}
+
+// NewVerificationAdapterFromConfig creates a verification adapter enforcing the key
+// strength and algorithm policy of the signature configuration.
+func NewVerificationAdapterFromConfig(cfg config.SignatureConfig) *VerificationAdapter {
+	return &VerificationAdapter{
+		gpgSettings: NewGPGSecuritySettings(cfg),
+		sshSettings: NewSSHSecuritySettings(cfg),
+	}
 }
 
 // VerifyCommit implements the domain.SignatureVerifier interface.
 func (a *VerificationAdapter) VerifyCommit(ctx context.Context, commit domain.Commit, keyDir string) domain.VerificationResult {
-	return VerifyCommit(ctx, commit, keyDir)
+	return verifyCommit(ctx, commit, keyDir, a.gpgSettings, a.sshSettings)
+}
+
+// VerifyTag implements the domain.SignatureVerifier interface.
+func (a *VerificationAdapter) VerifyTag(ctx context.Context, tag domain.Tag, keyDir string) domain.VerificationResult {
+	return verifyTag(ctx, tag, keyDir, a.gpgSettings, a.sshSettings)
+}
+
+// VerifyPushCertificate verifies a push certificate with the key policy of the adapter.
+func (a *VerificationAdapter) VerifyPushCertificate(ctx context.Context, cert domain.PushCertificate, keyDir string) domain.VerificationResult {
+	return verifyPushCertificate(ctx, cert, keyDir, a.gpgSettings, a.sshSettings)
 }
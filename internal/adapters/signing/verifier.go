@@ -6,16 +6,34 @@ package signing
 
 import (
 	"context"
-	"fmt"
 
 	"github.com/itiquette/gommitlint/internal/domain"
 )
 
 // VerifyCommit implements signature verification for commit messages.
-func VerifyCommit(_ context.Context, commit domain.Commit, keyDir string) domain.VerificationResult {
+func VerifyCommit(ctx context.Context, commit domain.Commit, keyDir string) domain.VerificationResult {
+	return verifyCommit(ctx, commit, keyDir, DefaultGPGSecuritySettings(), DefaultSSHSecuritySettings())
+}
+
+// VerifyTag verifies an annotated tag's signature over the tag object without its signature.
+func VerifyTag(ctx context.Context, tag domain.Tag, keyDir string) domain.VerificationResult {
+	return verifyTag(ctx, tag, keyDir, DefaultGPGSecuritySettings(), DefaultSSHSecuritySettings())
+}
+
+// VerifyPushCertificate verifies the signature of a push certificate over the certificate
+// without its signature.
+func VerifyPushCertificate(ctx context.Context, cert domain.PushCertificate, keyDir string) domain.VerificationResult {
+	return verifyPushCertificate(ctx, cert, keyDir, DefaultGPGSecuritySettings(), DefaultSSHSecuritySettings())
+}
+
+func verifyCommit(ctx context.Context, commit domain.Commit, keyDir string, gpgSettings GPGSecuritySettings, sshSettings SSHSecuritySettings) domain.VerificationResult {
 	// Create signature from commit
 	signature := domain.NewSignature(commit.Signature)
 
+	if ctx.Err() != nil {
+		return timedOut(ctx, signature)
+	}
+
 	// Skip if there's no signature
 	if signature.IsEmpty() {
 		return domain.NewVerificationResult(
@@ -25,23 +43,86 @@ func VerifyCommit(_ context.Context, commit domain.Commit, keyDir string) domain
 		).WithError("missing_signature", "Commit has no signature")
 	}
 
-	// Prepare commit data for verification
-	commitData := []byte(fmt.Sprintf("commit %s by %s", commit.Hash, commit.AuthorEmail))
+	// The signature covers the commit object without the signature
+	if commit.SignedPayload == "" {
+		return domain.NewVerificationResult(
+			domain.VerificationStatusFailed,
+			domain.NewIdentity("", ""),
+			signature,
+		).WithFingerprint(signatureFingerprint(signature)).WithError("missing_payload", "Signed commit data is not available")
+	}
+
+	return verifyPayload(signature, []byte(commit.SignedPayload), keyDir, gpgSettings, sshSettings)
+}
 
-	// Try GPG verification first
-	if CanVerifyGPG(signature) {
-		return VerifyGPGSignature(signature, commitData, keyDir, DefaultGPGSecuritySettings())
+func verifyTag(ctx context.Context, tag domain.Tag, keyDir string, gpgSettings GPGSecuritySettings, sshSettings SSHSecuritySettings) domain.VerificationResult {
+	signature := domain.NewSignature(tag.Signature)
+
+	if ctx.Err() != nil {
+		return timedOut(ctx, signature)
 	}
 
-	// Try SSH verification second
-	if CanVerifySSH(signature) {
-		return VerifySSHSignature(signature, commitData, keyDir, DefaultSSHSecuritySettings())
+	if signature.IsEmpty() {
+		return domain.NewVerificationResult(
+			domain.VerificationStatusFailed,
+			domain.NewIdentity("", ""),
+			signature,
+		).WithError("missing_signature", "Tag has no signature")
 	}
 
-	// No suitable verifier found
+	return verifyPayload(signature, []byte(tag.Payload), keyDir, gpgSettings, sshSettings)
+}
+
+func verifyPushCertificate(ctx context.Context, cert domain.PushCertificate, keyDir string, gpgSettings GPGSecuritySettings, sshSettings SSHSecuritySettings) domain.VerificationResult {
+	signature := domain.NewSignature(cert.Signature)
+
+	if ctx.Err() != nil {
+		return timedOut(ctx, signature)
+	}
+
+	if signature.IsEmpty() {
+		return domain.NewVerificationResult(
+			domain.VerificationStatusFailed,
+			domain.NewIdentity("", ""),
+			signature,
+		).WithError("missing_signature", "Push certificate has no signature")
+	}
+
+	return verifyPayload(signature, []byte(cert.Payload), keyDir, gpgSettings, sshSettings)
+}
+
+// verifyPayload dispatches the signature to the GPG or SSH verifier. Results without a
+// verified key carry the fingerprint named by the signature itself.
+func verifyPayload(signature domain.Signature, data []byte, keyDir string, gpgSettings GPGSecuritySettings, sshSettings SSHSecuritySettings) domain.VerificationResult {
+	var result domain.VerificationResult
+
+	switch {
+	case CanVerifyGPG(signature):
+		result = VerifyGPGSignature(signature, data, keyDir, gpgSettings)
+	case CanVerifySSH(signature):
+		result = VerifySSHSignature(signature, data, keyDir, sshSettings)
+	default:
+		// No suitable verifier found
+		return domain.NewVerificationResult(
+			domain.VerificationStatusUnsupported,
+			domain.NewIdentity("", ""),
+			signature,
+		).WithError("unsupported_signature", "No suitable verifier found for signature type")
+	}
+
+	if result.Fingerprint() == "" {
+		result = result.WithFingerprint(signatureFingerprint(signature))
+	}
+
+	return result
+}
+
+// timedOut returns the result of a verification not started because the deadline of
+// ctx has passed or ctx was canceled.
+func timedOut(ctx context.Context, signature domain.Signature) domain.VerificationResult {
 	return domain.NewVerificationResult(
-		domain.VerificationStatusUnsupported,
+		domain.VerificationStatusFailed,
 		domain.NewIdentity("", ""),
 		signature,
-	).WithError("unsupported_signature", "No suitable verifier found for signature type")
+	).WithFingerprint(signatureFingerprint(signature)).WithError(domain.ErrTimeout.String(), "Signature verification stopped: "+ctx.Err().Error())
 }
@@ -0,0 +1,634 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package signing_test
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/base64"
+	"encoding/hex"
+	"io"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ProtonMail/go-crypto/openpgp"
+	"github.com/ProtonMail/go-crypto/openpgp/armor"
+	"github.com/ProtonMail/go-crypto/openpgp/packet"
+	"github.com/itiquette/gommitlint/internal/adapters/signing"
+	"github.com/itiquette/gommitlint/internal/domain"
+	"github.com/itiquette/gommitlint/internal/domain/config"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/agent"
+)
+
+const tagPayload = "object 0123456789abcdef0123456789abcdef01234567\ntype commit\ntag v1.0.0\n" +
+	"tagger Dev <dev@example.com> 1700000000 +0000\n\nRelease 1.0.0\n"
+
+const commitPayload = "tree 0123456789abcdef0123456789abcdef01234567\n" +
+	"author Dev <dev@example.com> 1700000000 +0000\ncommitter Dev <dev@example.com> 1700000000 +0000\n\nAdd feature\n"
+
+// sshSign signs payload the way ssh-keygen -Y sign -n git does.
+func sshSign(t *testing.T, signer ssh.Signer, payload string) string {
+	t.Helper()
+
+	return sshSignWithAlgorithm(t, signer, payload, "")
+}
+
+// sshSignWithAlgorithm signs payload with a signature algorithm such as rsa-sha2-512,
+// or the key's default algorithm when empty.
+func sshSignWithAlgorithm(t *testing.T, signer ssh.Signer, payload, algorithm string) string {
+	t.Helper()
+
+	hash := sha512.Sum512([]byte(payload))
+	signed := append([]byte("SSHSIG"), ssh.Marshal(struct {
+		Namespace, Reserved, HashAlgorithm string
+		Hash                               []byte
+	}{"git", "", "sha512", hash[:]})...)
+
+	var (
+		signature *ssh.Signature
+		err       error
+	)
+
+	if algorithm == "" {
+		signature, err = signer.Sign(rand.Reader, signed)
+	} else {
+		algorithmSigner, ok := signer.(ssh.AlgorithmSigner)
+		require.True(t, ok)
+
+		signature, err = algorithmSigner.SignWithAlgorithm(rand.Reader, signed, algorithm)
+	}
+
+	require.NoError(t, err)
+
+	blob := append([]byte("SSHSIG"), ssh.Marshal(struct {
+		Version                            uint32
+		PublicKey                          []byte
+		Namespace, Reserved, HashAlgorithm string
+		Signature                          []byte
+	}{1, signer.PublicKey().Marshal(), "git", "", "sha512", ssh.Marshal(signature)})...)
+
+	return "-----BEGIN SSH SIGNATURE-----\n" + base64.StdEncoding.EncodeToString(blob) + "\n-----END SSH SIGNATURE-----\n"
+}
+
+func newSSHSigner(t *testing.T) ssh.Signer {
+	t.Helper()
+
+	_, key, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	signer, err := ssh.NewSignerFromKey(key)
+	require.NoError(t, err)
+
+	return signer
+}
+
+// securityKeySigner signs as a FIDO security key holding an Ed25519 or ECDSA P-256 key.
+type securityKeySigner struct {
+	ed25519Key  ed25519.PrivateKey
+	ecdsaKey    *ecdsa.PrivateKey
+	application string
+	flags       byte
+}
+
+func newSecurityKeySigner(t *testing.T, keyType, application string, flags byte) securityKeySigner {
+	t.Helper()
+
+	signer := securityKeySigner{application: application, flags: flags}
+
+	var err error
+	if keyType == ssh.KeyAlgoSKED25519 {
+		_, signer.ed25519Key, err = ed25519.GenerateKey(rand.Reader)
+	} else {
+		signer.ecdsaKey, err = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	}
+
+	require.NoError(t, err)
+
+	return signer
+}
+
+func (s securityKeySigner) PublicKey() ssh.PublicKey {
+	var wire []byte
+	if s.ed25519Key != nil {
+		wire = ssh.Marshal(struct {
+			Name, Key, Application string
+		}{ssh.KeyAlgoSKED25519, string(s.ed25519Key.Public().(ed25519.PublicKey)), s.application})
+	} else {
+		wire = ssh.Marshal(struct {
+			Name, Curve, Key, Application string
+		}{ssh.KeyAlgoSKECDSA256, "nistp256", string(elliptic.Marshal(elliptic.P256(), s.ecdsaKey.X, s.ecdsaKey.Y)), s.application})
+	}
+
+	key, err := ssh.ParsePublicKey(wire)
+	if err != nil {
+		panic(err)
+	}
+
+	return key
+}
+
+// Sign signs data as FIDO authenticators do, over the hashes of the application and
+// data with the flags and a counter between them.
+func (s securityKeySigner) Sign(_ io.Reader, data []byte) (*ssh.Signature, error) {
+	applicationHash := sha256.Sum256([]byte(s.application))
+	dataHash := sha256.Sum256(data)
+	signed := append(append(applicationHash[:], s.flags, 0, 0, 0, 1), dataHash[:]...)
+	rest := ssh.Marshal(struct {
+		Flags   byte
+		Counter uint32
+	}{s.flags, 1})
+
+	if s.ed25519Key != nil {
+		return &ssh.Signature{Format: ssh.KeyAlgoSKED25519, Blob: ed25519.Sign(s.ed25519Key, signed), Rest: rest}, nil
+	}
+
+	digest := sha256.Sum256(signed)
+
+	r, sigS, err := ecdsa.Sign(rand.Reader, s.ecdsaKey, digest[:])
+	if err != nil {
+		return nil, err
+	}
+
+	return &ssh.Signature{Format: ssh.KeyAlgoSKECDSA256, Blob: ssh.Marshal(struct{ R, S *big.Int }{r, sigS}), Rest: rest}, nil
+}
+
+func newGPGEntity(t *testing.T, email string) *openpgp.Entity {
+	t.Helper()
+
+	entity, err := openpgp.NewEntity("Dev", "", email, &packet.Config{Algorithm: packet.PubKeyAlgoEdDSA})
+	require.NoError(t, err)
+
+	return entity
+}
+
+func writeSSHKey(t *testing.T, dir string, key ssh.PublicKey) {
+	t.Helper()
+
+	authorizedKey := strings.TrimSpace(string(ssh.MarshalAuthorizedKey(key))) + " dev@example.com\n"
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "dev.pub"), []byte(authorizedKey), 0o600))
+}
+
+func writeGPGKey(t *testing.T, dir string, entity *openpgp.Entity) {
+	t.Helper()
+
+	var armoredKey bytes.Buffer
+
+	keyWriter, err := armor.Encode(&armoredKey, openpgp.PublicKeyType, nil)
+	require.NoError(t, err)
+	require.NoError(t, entity.Serialize(keyWriter))
+	require.NoError(t, keyWriter.Close())
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "dev.asc"), armoredKey.Bytes(), 0o600))
+}
+
+func gpgSign(t *testing.T, entity *openpgp.Entity, payload string) string {
+	t.Helper()
+
+	var signature bytes.Buffer
+	require.NoError(t, openpgp.ArmoredDetachSign(&signature, entity, strings.NewReader(payload), nil))
+
+	return signature.String()
+}
+
+func TestVerifyTag(t *testing.T) {
+	sshSigner := newSSHSigner(t)
+	otherSSHSigner := newSSHSigner(t)
+	entity := newGPGEntity(t, "dev@example.com")
+	otherEntity := newGPGEntity(t, "other@example.com")
+
+	keyDir := t.TempDir()
+	writeSSHKey(t, keyDir, sshSigner.PublicKey())
+	writeGPGKey(t, keyDir, entity)
+
+	tests := []struct {
+		name      string
+		signature string
+		payload   string
+		status    domain.VerificationStatus
+		email     string
+	}{
+		{
+			name:      "ssh signature of trusted key",
+			signature: sshSign(t, sshSigner, tagPayload),
+			payload:   tagPayload,
+			status:    domain.VerificationStatusVerified,
+			email:     "dev@example.com",
+		},
+		{
+			name:      "ssh signature of altered tag",
+			signature: sshSign(t, sshSigner, tagPayload),
+			payload:   strings.Replace(tagPayload, "1.0.0", "1.0.1", 1),
+			status:    domain.VerificationStatusFailed,
+		},
+		{
+			name:      "ssh signature of untrusted key",
+			signature: sshSign(t, otherSSHSigner, tagPayload),
+			payload:   tagPayload,
+			status:    domain.VerificationStatusFailed,
+		},
+		{
+			name:      "gpg signature of trusted key",
+			signature: gpgSign(t, entity, tagPayload),
+			payload:   tagPayload,
+			status:    domain.VerificationStatusVerified,
+			email:     "dev@example.com",
+		},
+		{
+			name:      "gpg signature of untrusted key",
+			signature: gpgSign(t, otherEntity, tagPayload),
+			payload:   tagPayload,
+			status:    domain.VerificationStatusFailed,
+		},
+		{
+			name:    "unsigned tag",
+			payload: tagPayload,
+			status:  domain.VerificationStatusFailed,
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			tag := domain.Tag{Name: "v1.0.0", Hash: "abc123", Signature: testCase.signature, Payload: testCase.payload}
+
+			result := signing.NewVerificationAdapter().VerifyTag(context.Background(), tag, keyDir)
+			require.Equal(t, testCase.status, result.Status(), result.ErrorMessage())
+			require.Equal(t, testCase.email, result.Identity().Email())
+		})
+	}
+}
+
+func TestVerifyPushCertificate(t *testing.T) {
+	entity := newGPGEntity(t, "dev@example.com")
+	sshSigner := newSSHSigner(t)
+
+	keyDir := t.TempDir()
+	writeGPGKey(t, keyDir, entity)
+	writeSSHKey(t, keyDir, sshSigner.PublicKey())
+
+	payload := "certificate version 0.1\n" +
+		"pusher Dev <dev@example.com> 1700000000 +0100\n" +
+		"pushee https://git.example.com/repo.git\n" +
+		"\n" +
+		"0000000000000000000000000000000000000000 1111111111111111111111111111111111111111 refs/heads/main\n"
+	altered := strings.Replace(payload, "refs/heads/main", "refs/heads/release", 1)
+
+	tests := []struct {
+		name   string
+		text   string
+		status domain.VerificationStatus
+	}{
+		{name: "gpg signed", text: payload + gpgSign(t, entity, payload), status: domain.VerificationStatusVerified},
+		{name: "ssh signed", text: payload + sshSign(t, sshSigner, payload), status: domain.VerificationStatusVerified},
+		{name: "altered updates", text: altered + gpgSign(t, entity, payload), status: domain.VerificationStatusFailed},
+		{name: "unsigned", text: payload, status: domain.VerificationStatusFailed},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			cert := domain.ParsePushCertificate(testCase.text)
+
+			result := signing.NewVerificationAdapter().VerifyPushCertificate(context.Background(), cert, keyDir)
+			require.Equal(t, testCase.status, result.Status(), result.ErrorMessage())
+		})
+	}
+}
+
+func TestVerifyCommit_SignedPayload(t *testing.T) {
+	sshSigner := newSSHSigner(t)
+	entity := newGPGEntity(t, "dev@example.com")
+
+	keyDir := t.TempDir()
+	writeSSHKey(t, keyDir, sshSigner.PublicKey())
+	writeGPGKey(t, keyDir, entity)
+
+	tests := []struct {
+		name        string
+		signature   string
+		payload     string
+		status      domain.VerificationStatus
+		errorCode   string
+		fingerprint string
+	}{
+		{
+			name:        "ssh signed commit",
+			signature:   sshSign(t, sshSigner, commitPayload),
+			payload:     commitPayload,
+			status:      domain.VerificationStatusVerified,
+			fingerprint: ssh.FingerprintSHA256(sshSigner.PublicKey()),
+		},
+		{
+			name:        "gpg signed commit",
+			signature:   gpgSign(t, entity, commitPayload),
+			payload:     commitPayload,
+			status:      domain.VerificationStatusVerified,
+			fingerprint: strings.ToUpper(hex.EncodeToString(entity.PrimaryKey.Fingerprint)),
+		},
+		{
+			name:        "altered commit keeps the signing key fingerprint",
+			signature:   sshSign(t, sshSigner, commitPayload),
+			payload:     strings.Replace(commitPayload, "Add feature", "Add other feature", 1),
+			status:      domain.VerificationStatusFailed,
+			fingerprint: ssh.FingerprintSHA256(sshSigner.PublicKey()),
+		},
+		{
+			name:        "signed commit without payload",
+			signature:   sshSign(t, sshSigner, commitPayload),
+			status:      domain.VerificationStatusFailed,
+			errorCode:   "missing_payload",
+			fingerprint: ssh.FingerprintSHA256(sshSigner.PublicKey()),
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			commit := domain.Commit{Hash: "abc123", Signature: testCase.signature, SignedPayload: testCase.payload}
+
+			result := signing.NewVerificationAdapter().VerifyCommit(context.Background(), commit, keyDir)
+			require.Equal(t, testCase.status, result.Status(), result.ErrorMessage())
+			require.Equal(t, testCase.fingerprint, result.Fingerprint())
+
+			if testCase.errorCode != "" {
+				require.Equal(t, testCase.errorCode, result.ErrorCode())
+			}
+		})
+	}
+}
+
+func TestVerifyCommit_KeyDetails(t *testing.T) {
+	const lifetime = 365 * 24 * 60 * 60
+
+	entity, err := openpgp.NewEntity("Dev", "", "dev@example.com",
+		&packet.Config{Algorithm: packet.PubKeyAlgoEdDSA, KeyLifetimeSecs: lifetime})
+	require.NoError(t, err)
+
+	sshSigner := newSSHSigner(t)
+
+	keyDir := t.TempDir()
+	writeGPGKey(t, keyDir, entity)
+	writeSSHKey(t, keyDir, sshSigner.PublicKey())
+
+	verifier := signing.NewVerificationAdapter()
+
+	gpgResult := verifier.VerifyCommit(context.Background(),
+		domain.Commit{Hash: "abc123", Signature: gpgSign(t, entity, commitPayload), SignedPayload: commitPayload}, keyDir)
+	require.True(t, gpgResult.IsVerified(), gpgResult.ErrorMessage())
+	require.Equal(t, "ed25519", gpgResult.KeyAlgorithm())
+	require.True(t, entity.PrimaryKey.CreationTime.Equal(gpgResult.KeyCreated()))
+	require.True(t, entity.PrimaryKey.CreationTime.Add(lifetime*time.Second).Equal(gpgResult.KeyExpires()))
+
+	sshResult := verifier.VerifyCommit(context.Background(),
+		domain.Commit{Hash: "abc123", Signature: sshSign(t, sshSigner, commitPayload), SignedPayload: commitPayload}, keyDir)
+	require.True(t, sshResult.IsVerified(), sshResult.ErrorMessage())
+	require.Equal(t, "ed25519", sshResult.KeyAlgorithm())
+	require.True(t, sshResult.KeyCreated().IsZero(), "SSH keys have no dates")
+	require.True(t, sshResult.KeyExpires().IsZero())
+}
+
+func TestVerifyCommit_SSHAgent(t *testing.T) {
+	_, key, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	signer, err := ssh.NewSignerFromKey(key)
+	require.NoError(t, err)
+
+	keyring := agent.NewKeyring()
+	require.NoError(t, keyring.Add(agent.AddedKey{PrivateKey: key, Comment: "dev@example.com"}))
+
+	socket := filepath.Join(t.TempDir(), "agent.sock")
+	listener, err := net.Listen("unix", socket)
+	require.NoError(t, err)
+
+	t.Cleanup(func() { listener.Close() })
+
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+
+			go func() {
+				defer conn.Close()
+
+				_ = agent.ServeAgent(keyring, conn)
+			}()
+		}
+	}()
+
+	t.Setenv("SSH_AUTH_SOCK", socket)
+
+	commit := domain.Commit{Hash: "abc123", Signature: sshSign(t, signer, commitPayload), SignedPayload: commitPayload}
+
+	result := signing.NewVerificationAdapterFromConfig(config.SignatureConfig{SSHAgent: true}).
+		VerifyCommit(context.Background(), commit, "")
+	require.True(t, result.IsVerified(), result.ErrorMessage())
+	require.Equal(t, "dev@example.com", result.Identity().Email())
+
+	result = signing.NewVerificationAdapter().VerifyCommit(context.Background(), commit, t.TempDir())
+	require.False(t, result.IsVerified(), "agent keys are trusted only with ssh_agent")
+}
+
+func TestVerifyCommit_KnownSigners(t *testing.T) {
+	signer := newSSHSigner(t)
+	other := newSSHSigner(t)
+	authorizedKey := func(key ssh.PublicKey) string {
+		return strings.TrimSpace(string(ssh.MarshalAuthorizedKey(key)))
+	}
+
+	knownSigners := filepath.Join(t.TempDir(), "known_signers")
+	content := "# Team keys\n" +
+		"other@example.com namespaces=\"file\" " + authorizedKey(other.PublicKey()) + "\n" +
+		"dev@example.com,dev@example.org namespaces=\"git\" " + authorizedKey(signer.PublicKey()) + "\n"
+	require.NoError(t, os.WriteFile(knownSigners, []byte(content), 0o600))
+
+	verifier := signing.NewVerificationAdapterFromConfig(config.SignatureConfig{KnownSigners: knownSigners})
+
+	result := verifier.VerifyCommit(context.Background(),
+		domain.Commit{Hash: "abc123", Signature: sshSign(t, signer, commitPayload), SignedPayload: commitPayload}, "")
+	require.True(t, result.IsVerified(), result.ErrorMessage())
+	require.Equal(t, "dev@example.com", result.Identity().Email())
+
+	result = verifier.VerifyCommit(context.Background(),
+		domain.Commit{Hash: "abc123", Signature: sshSign(t, other, commitPayload), SignedPayload: commitPayload}, "")
+	require.False(t, result.IsVerified(), "keys limited to other namespaces do not verify commits")
+}
+
+func TestVerifyCommit_SecurityKey(t *testing.T) {
+	tests := []struct {
+		name        string
+		keyType     string
+		application string
+		flags       byte
+		verified    bool
+		errorCode   string
+	}{
+		{name: "ed25519-sk key", keyType: ssh.KeyAlgoSKED25519, application: "ssh:", flags: 0x01, verified: true},
+		{name: "ecdsa-sk key", keyType: ssh.KeyAlgoSKECDSA256, application: "ssh:git", flags: 0x05, verified: true},
+		{name: "application not for ssh", keyType: ssh.KeyAlgoSKED25519, application: "https://example.com", flags: 0x01, errorCode: "weak_key"},
+		{name: "signed without touch", keyType: ssh.KeyAlgoSKECDSA256, application: "ssh:", flags: 0x00, errorCode: "weak_key"},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			signer := newSecurityKeySigner(t, testCase.keyType, testCase.application, testCase.flags)
+
+			keyDir := t.TempDir()
+			writeSSHKey(t, keyDir, signer.PublicKey())
+
+			commit := domain.Commit{Hash: "abc123", Signature: sshSign(t, signer, commitPayload), SignedPayload: commitPayload}
+
+			result := signing.NewVerificationAdapter().VerifyCommit(context.Background(), commit, keyDir)
+			require.Equal(t, testCase.verified, result.IsVerified(), result.ErrorMessage())
+			require.Equal(t, testCase.errorCode, result.ErrorCode())
+			require.Equal(t, ssh.FingerprintSHA256(signer.PublicKey()), result.Fingerprint())
+		})
+	}
+}
+
+func TestVerifyCommit_Timeout(t *testing.T) {
+	sshSigner := newSSHSigner(t)
+
+	keyDir := t.TempDir()
+	writeSSHKey(t, keyDir, sshSigner.PublicKey())
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Nanosecond)
+	defer cancel()
+
+	<-ctx.Done()
+
+	commit := domain.Commit{Hash: "abc123", Signature: sshSign(t, sshSigner, commitPayload), SignedPayload: commitPayload}
+
+	result := signing.NewVerificationAdapter().VerifyCommit(ctx, commit, keyDir)
+	require.Equal(t, domain.VerificationStatusFailed, result.Status())
+	require.Equal(t, domain.ErrTimeout.String(), result.ErrorCode())
+	require.Equal(t, ssh.FingerprintSHA256(sshSigner.PublicKey()), result.Fingerprint())
+}
+
+func TestVerifyTag_KeyPolicy(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	rsaSigner, err := ssh.NewSignerFromKey(rsaKey)
+	require.NoError(t, err)
+
+	ed25519Signer := newSSHSigner(t)
+	entity := newGPGEntity(t, "dev@example.com")
+
+	// Key generation refuses SHA-1, so re-sign the user ID as an old key would be
+	sha1Entity := newGPGEntity(t, "dev@example.com")
+	noSaltNotation := false
+
+	for _, ident := range sha1Entity.Identities {
+		ident.SelfSignature.Hash = crypto.SHA1
+		require.NoError(t, ident.SelfSignature.SignUserId(ident.UserId.Id, sha1Entity.PrimaryKey, sha1Entity.PrivateKey,
+			&packet.Config{NonDeterministicSignaturesViaNotation: &noSaltNotation}))
+	}
+
+	defaults := config.NewDefault().Signature
+
+	withPolicy := func(update func(*config.SignatureConfig)) config.SignatureConfig {
+		policy := defaults
+		update(&policy)
+
+		return policy
+	}
+
+	tests := []struct {
+		name      string
+		policy    config.SignatureConfig
+		writeKey  func(t *testing.T, dir string)
+		signature string
+		errorCode string
+	}{
+		{
+			name:      "ssh rsa-sha2-512 signature",
+			policy:    defaults,
+			writeKey:  func(t *testing.T, dir string) { t.Helper(); writeSSHKey(t, dir, rsaSigner.PublicKey()) },
+			signature: sshSignWithAlgorithm(t, rsaSigner, tagPayload, ssh.KeyAlgoRSASHA512),
+		},
+		{
+			name:      "ssh-rsa signature uses SHA-1",
+			policy:    defaults,
+			writeKey:  func(t *testing.T, dir string) { t.Helper(); writeSSHKey(t, dir, rsaSigner.PublicKey()) },
+			signature: sshSignWithAlgorithm(t, rsaSigner, tagPayload, ssh.KeyAlgoRSA),
+			errorCode: "weak_key",
+		},
+		{
+			name:      "ssh-rsa signature with SHA-1 allowed",
+			policy:    withPolicy(func(policy *config.SignatureConfig) { policy.AllowSHA1 = true }),
+			writeKey:  func(t *testing.T, dir string) { t.Helper(); writeSSHKey(t, dir, rsaSigner.PublicKey()) },
+			signature: sshSignWithAlgorithm(t, rsaSigner, tagPayload, ssh.KeyAlgoRSA),
+		},
+		{
+			name:      "ssh rsa key below minimum size",
+			policy:    withPolicy(func(policy *config.SignatureConfig) { policy.MinRSABits = 3072 }),
+			writeKey:  func(t *testing.T, dir string) { t.Helper(); writeSSHKey(t, dir, rsaSigner.PublicKey()) },
+			signature: sshSignWithAlgorithm(t, rsaSigner, tagPayload, ssh.KeyAlgoRSASHA512),
+			errorCode: "weak_key",
+		},
+		{
+			name:      "ssh ed25519 key below minimum size",
+			policy:    withPolicy(func(policy *config.SignatureConfig) { policy.MinECBits = 384 }),
+			writeKey:  func(t *testing.T, dir string) { t.Helper(); writeSSHKey(t, dir, ed25519Signer.PublicKey()) },
+			signature: sshSign(t, ed25519Signer, tagPayload),
+			errorCode: "weak_key",
+		},
+		{
+			name:      "ssh algorithm not allowed",
+			policy:    withPolicy(func(policy *config.SignatureConfig) { policy.AllowedAlgorithms = []string{"rsa"} }),
+			writeKey:  func(t *testing.T, dir string) { t.Helper(); writeSSHKey(t, dir, ed25519Signer.PublicKey()) },
+			signature: sshSign(t, ed25519Signer, tagPayload),
+			errorCode: "weak_key",
+		},
+		{
+			name:      "gpg algorithm not allowed",
+			policy:    withPolicy(func(policy *config.SignatureConfig) { policy.AllowedAlgorithms = []string{"RSA"} }),
+			writeKey:  func(t *testing.T, dir string) { t.Helper(); writeGPGKey(t, dir, entity) },
+			signature: gpgSign(t, entity, tagPayload),
+			errorCode: "weak_key",
+		},
+		{
+			name:      "gpg SHA-1 self-signature",
+			policy:    defaults,
+			writeKey:  func(t *testing.T, dir string) { t.Helper(); writeGPGKey(t, dir, sha1Entity) },
+			signature: gpgSign(t, sha1Entity, tagPayload),
+			errorCode: "weak_key",
+		},
+		{
+			name:      "gpg SHA-1 self-signature allowed",
+			policy:    withPolicy(func(policy *config.SignatureConfig) { policy.AllowSHA1 = true }),
+			writeKey:  func(t *testing.T, dir string) { t.Helper(); writeGPGKey(t, dir, sha1Entity) },
+			signature: gpgSign(t, sha1Entity, tagPayload),
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			keyDir := t.TempDir()
+			testCase.writeKey(t, keyDir)
+
+			tag := domain.Tag{Name: "v1.0.0", Hash: "abc123", Signature: testCase.signature, Payload: tagPayload}
+			adapter := signing.NewVerificationAdapterFromConfig(testCase.policy)
+
+			result := adapter.VerifyTag(context.Background(), tag, keyDir)
+			require.Equal(t, testCase.errorCode, result.ErrorCode(), result.ErrorMessage())
+			require.Equal(t, testCase.errorCode == "", result.IsVerified())
+		})
+	}
+}
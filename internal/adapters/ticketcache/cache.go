@@ -0,0 +1,109 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+// Package ticketcache keeps tickets looked up in issue trackers in a file, so that hooks
+// running for every commit do not ask the tracker about the same tickets again.
+package ticketcache
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/itiquette/gommitlint/internal/adapters/signing"
+	"github.com/itiquette/gommitlint/internal/domain"
+)
+
+// retention is how long entries are kept after their time to live, for trackers that can
+// revalidate a stale entry cheaply with its ETag.
+const retention = 30 * 24 * time.Hour
+
+// Entry is a looked up ticket.
+type Entry struct {
+	Ticket  domain.Ticket `json:"ticket"`
+	Fetched time.Time     `json:"fetched"`
+	ETag    string        `json:"etag,omitempty"`
+}
+
+// Cache is the cache file of one tracker instance. It is best effort: a file that cannot
+// be read or written only costs requests. A nil cache caches nothing.
+type Cache struct {
+	path    string
+	ttl     time.Duration
+	entries map[string]Entry
+}
+
+// New creates a cache in dir for the tracker instance, such as its base URL, with
+// entries that are fresh for ttl.
+func New(dir, instance string, ttl time.Duration) *Cache {
+	sum := sha256.Sum256([]byte(instance))
+
+	return &Cache{
+		path: filepath.Join(dir, hex.EncodeToString(sum[:8])+".json"),
+		ttl:  ttl,
+	}
+}
+
+// Get returns the entry of key, also when it is no longer fresh.
+func (c *Cache) Get(key string) (Entry, bool) {
+	if c == nil {
+		return Entry{}, false
+	}
+
+	c.load()
+
+	entry, found := c.entries[key]
+
+	return entry, found
+}
+
+// Fresh reports whether entry was fetched within the time to live.
+func (c *Cache) Fresh(entry Entry) bool {
+	return c != nil && time.Since(entry.Fetched) <= c.ttl
+}
+
+// Put stores entry under key and writes the cache file.
+func (c *Cache) Put(key string, entry Entry) {
+	if c == nil {
+		return
+	}
+
+	c.load()
+	c.entries[key] = entry
+
+	// Old entries are dropped so that the file does not grow forever
+	for entryKey, stored := range c.entries {
+		if time.Since(stored.Fetched) > c.ttl+retention {
+			delete(c.entries, entryKey)
+		}
+	}
+
+	data, err := json.Marshal(c.entries)
+	if err != nil {
+		return
+	}
+
+	_ = signing.SafeWriteFile(c.path, data, 0o600)
+}
+
+// load reads the cache file the first time the cache is used.
+func (c *Cache) load() {
+	if c.entries != nil {
+		return
+	}
+
+	c.entries = make(map[string]Entry)
+
+	data, err := os.ReadFile(c.path)
+	if err != nil {
+		return
+	}
+
+	if json.Unmarshal(data, &c.entries) != nil {
+		c.entries = make(map[string]Entry)
+	}
+}
@@ -0,0 +1,27 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+/*
+Package tracing provides opt-in OpenTelemetry tracing of the validation pipeline.
+
+Tracing is enabled with --tracing or GOMMITLINT_TRACING. Setup then creates a tracer
+provider exporting spans through OTLP over HTTP and carries it in the context, like
+the logger. Without one, Start returns no-op spans, so adapters can be instrumented
+unconditionally:
+
+	ctx, span := tracing.Start(ctx, "git GetCommitRange")
+	defer span.End()
+
+Rules take no context, so CommitRules and RepositoryRules wrap them to record one
+span per validated commit as children of the span they were wrapped in:
+
+	validate
+	├── git GetCommitRange
+	├── rule Subject            gommitlint.rule, gommitlint.commit, gommitlint.errors
+	├── rule ConventionalCommit
+	└── render report
+
+The exporter follows the standard OTEL_EXPORTER_OTLP_* environment variables.
+*/
+package tracing
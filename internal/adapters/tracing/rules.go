@@ -0,0 +1,144 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package tracing
+
+import (
+	"context"
+
+	"github.com/itiquette/gommitlint/internal/domain"
+	"github.com/itiquette/gommitlint/internal/domain/config"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// CommitRules wraps rules to record a span for every commit they validate, as children
+// of the span in ctx. Without a tracer provider in ctx the rules are returned as they are.
+// Conditional rules keep their condition.
+func CommitRules(ctx context.Context, rules []domain.CommitRule) []domain.CommitRule {
+	if !Enabled(ctx) {
+		return rules
+	}
+
+	parent := trace.SpanFromContext(ctx)
+	traced := make([]domain.CommitRule, 0, len(rules))
+
+	for _, rule := range rules {
+		if conditional, ok := rule.(domain.ConditionalCommitRule); ok {
+			conditional.CommitRule = tracedCommitRule{CommitRule: conditional.CommitRule, tracer: tracer(ctx), parent: parent}
+			traced = append(traced, conditional)
+
+			continue
+		}
+
+		traced = append(traced, tracedCommitRule{CommitRule: rule, tracer: tracer(ctx), parent: parent})
+	}
+
+	return traced
+}
+
+// RepositoryRules wraps rules like CommitRules. Range rules are traced as well.
+func RepositoryRules(ctx context.Context, rules []domain.RepositoryRule) []domain.RepositoryRule {
+	if !Enabled(ctx) {
+		return rules
+	}
+
+	parent := trace.SpanFromContext(ctx)
+	traced := make([]domain.RepositoryRule, 0, len(rules))
+
+	for _, rule := range rules {
+		if conditional, ok := rule.(domain.ConditionalRepositoryRule); ok {
+			conditional.RepositoryRule = traceRepositoryRule(conditional.RepositoryRule, tracer(ctx), parent)
+			traced = append(traced, conditional)
+
+			continue
+		}
+
+		traced = append(traced, traceRepositoryRule(rule, tracer(ctx), parent))
+	}
+
+	return traced
+}
+
+// traceRepositoryRule wraps rule, keeping it a range rule when it is one.
+func traceRepositoryRule(rule domain.RepositoryRule, tracer trace.Tracer, parent trace.Span) domain.RepositoryRule {
+	traced := tracedRepositoryRule{RepositoryRule: rule, tracer: tracer, parent: parent}
+	if rangeRule, ok := rule.(domain.RangeRule); ok {
+		return tracedRangeRule{tracedRepositoryRule: traced, rangeRule: rangeRule}
+	}
+
+	return traced
+}
+
+// tracedCommitRule records a span for every validation of a commit rule. Rules take no
+// context, so the rule keeps the parent span instead.
+type tracedCommitRule struct {
+	domain.CommitRule
+
+	tracer trace.Tracer
+	parent trace.Span
+}
+
+// Validate validates commit within a span.
+func (r tracedCommitRule) Validate(commit domain.Commit, cfg config.Config) []domain.ValidationError {
+	span := startRuleSpan(r.tracer, r.parent, r.Name(), commit.Hash)
+	defer span.End()
+
+	errors := r.CommitRule.Validate(commit, cfg)
+	span.SetAttributes(attribute.Int(AttributeErrors, len(errors)))
+
+	return errors
+}
+
+// tracedRepositoryRule records a span for every validation of a repository rule.
+type tracedRepositoryRule struct {
+	domain.RepositoryRule
+
+	tracer trace.Tracer
+	parent trace.Span
+}
+
+// Validate validates commit within a span.
+func (r tracedRepositoryRule) Validate(commit domain.Commit, repo domain.Repository, cfg config.Config) []domain.ValidationError {
+	span := startRuleSpan(r.tracer, r.parent, r.Name(), commit.Hash)
+	defer span.End()
+
+	errors := r.RepositoryRule.Validate(commit, repo, cfg)
+	span.SetAttributes(attribute.Int(AttributeErrors, len(errors)))
+
+	return errors
+}
+
+// tracedRangeRule records a span for the range validation of a range rule as well.
+type tracedRangeRule struct {
+	tracedRepositoryRule
+
+	rangeRule domain.RangeRule
+}
+
+// ValidateRange validates the range within a span.
+func (r tracedRangeRule) ValidateRange(commits []domain.Commit, cfg config.Config) []domain.ValidationError {
+	span := startRuleSpan(r.tracer, r.parent, r.Name(), "")
+	defer span.End()
+
+	errors := r.rangeRule.ValidateRange(commits, cfg)
+	span.SetAttributes(attribute.Int(AttributeErrors, len(errors)))
+
+	return errors
+}
+
+// startRuleSpan starts the span of a rule validating commit, which is empty for
+// repository-level and range validation.
+func startRuleSpan(tracer trace.Tracer, parent trace.Span, rule, commit string) trace.Span {
+	attributes := []attribute.KeyValue{attribute.String(AttributeRule, rule)}
+	if commit != "" {
+		attributes = append(attributes, attribute.String(AttributeCommit, commit))
+	}
+
+	//nolint:contextcheck // Rules take no context; the span is parented explicitly
+	_, span := tracer.Start(trace.ContextWithSpan(context.Background(), parent), "rule "+rule,
+		trace.WithAttributes(attributes...))
+
+	return span
+}
@@ -0,0 +1,106 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/noop"
+)
+
+// instrumentationName names the tracer of gommitlint spans.
+const instrumentationName = "github.com/itiquette/gommitlint"
+
+// exportTimeout bounds exporting the remaining spans, so that an unreachable collector
+// does not keep gommitlint from exiting.
+const exportTimeout = 10 * time.Second
+
+// Span attributes recorded by gommitlint.
+const (
+	AttributeCommit = "gommitlint.commit"
+	AttributeRule   = "gommitlint.rule"
+	AttributeErrors = "gommitlint.errors"
+)
+
+// providerKey is the context key of the tracer provider.
+type providerKey struct{}
+
+// Setup creates a tracer provider exporting spans through OTLP over HTTP and returns a
+// context carrying it together with a function flushing and stopping it. The exporter
+// is configured by the standard OTEL_EXPORTER_OTLP_* environment variables, and the
+// service by OTEL_SERVICE_NAME and OTEL_RESOURCE_ATTRIBUTES.
+func Setup(ctx context.Context, version string) (context.Context, func(context.Context) error, error) {
+	exporter, err := otlptracehttp.New(ctx)
+	if err != nil {
+		return ctx, nil, fmt.Errorf("failed to create OTLP exporter: %w", err)
+	}
+
+	serviceResource, err := resource.New(ctx,
+		resource.WithAttributes(
+			attribute.String("service.name", "gommitlint"),
+			attribute.String("service.version", version),
+		),
+		resource.WithFromEnv(),
+		resource.WithTelemetrySDK(),
+	)
+	if err != nil {
+		return ctx, nil, fmt.Errorf("failed to describe service: %w", err)
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(serviceResource),
+	)
+
+	shutdown := func(ctx context.Context) error {
+		// Spans of a command stopped by --timeout are exported all the same
+		ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), exportTimeout)
+		defer cancel()
+
+		return provider.Shutdown(ctx)
+	}
+
+	return context.WithValue(ctx, providerKey{}, provider), shutdown, nil
+}
+
+// Enabled reports whether ctx carries a tracer provider from Setup.
+func Enabled(ctx context.Context) bool {
+	_, ok := ctx.Value(providerKey{}).(*sdktrace.TracerProvider)
+
+	return ok
+}
+
+// Start starts a span with the tracer provider of ctx. Without one the span is a no-op,
+// so instrumented code needs no checks of its own.
+func Start(ctx context.Context, name string, attributes ...attribute.KeyValue) (context.Context, trace.Span) {
+	return tracer(ctx).Start(ctx, name, trace.WithAttributes(attributes...))
+}
+
+// Flush exports the finished spans of the tracer provider of ctx, for commands that
+// exit the process before the provider is shut down.
+func Flush(ctx context.Context) {
+	if provider, ok := ctx.Value(providerKey{}).(*sdktrace.TracerProvider); ok {
+		ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), exportTimeout)
+		defer cancel()
+
+		_ = provider.ForceFlush(ctx)
+	}
+}
+
+// tracer returns the tracer of the tracer provider of ctx.
+func tracer(ctx context.Context) trace.Tracer {
+	if provider, ok := ctx.Value(providerKey{}).(*sdktrace.TracerProvider); ok {
+		return provider.Tracer(instrumentationName)
+	}
+
+	return noop.NewTracerProvider().Tracer(instrumentationName)
+}
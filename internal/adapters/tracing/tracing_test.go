@@ -0,0 +1,94 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package tracing
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+
+	"github.com/itiquette/gommitlint/internal/domain"
+	"github.com/itiquette/gommitlint/internal/domain/config"
+	"github.com/itiquette/gommitlint/internal/domain/rules"
+)
+
+// tracedContext returns a context carrying a tracer provider recording into exporter.
+func tracedContext(exporter *tracetest.InMemoryExporter) context.Context {
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+
+	return context.WithValue(context.Background(), providerKey{}, provider)
+}
+
+func TestStartWithoutProvider(t *testing.T) {
+	ctx, span := Start(context.Background(), "validate")
+	defer span.End()
+
+	require.False(t, Enabled(ctx))
+	require.False(t, span.SpanContext().IsValid(), "spans are no-ops without a provider")
+}
+
+func TestCommitRules(t *testing.T) {
+	cfg := config.NewDefault()
+	subject := rules.NewSubjectRule(cfg)
+	conditional := domain.ConditionalCommitRule{
+		CommitRule: rules.NewConventionalCommitRule(cfg),
+		Condition:  config.RuleCondition{Branches: []string{"main"}},
+	}
+
+	untraced := []domain.CommitRule{subject, conditional}
+	require.Equal(t, untraced, CommitRules(context.Background(), untraced), "rules are unchanged without a provider")
+
+	exporter := tracetest.NewInMemoryExporter()
+	ctx, parent := Start(tracedContext(exporter), "validate")
+
+	traced := CommitRules(ctx, untraced)
+	require.Len(t, traced, 2)
+
+	kept, ok := traced[1].(domain.ConditionalCommitRule)
+	require.True(t, ok, "conditional rules keep their condition")
+	require.Equal(t, conditional.Condition, kept.Condition)
+
+	commit := domain.ParseCommitMessage("Fix the parser.")
+	commit.Hash = "abc123"
+
+	errors := domain.ValidateCommitRules(commit, traced, cfg)
+	require.Equal(t, domain.ValidateCommitRules(commit, untraced, cfg), errors, "tracing does not change results")
+
+	parent.End()
+
+	spans := exporter.GetSpans()
+	require.Len(t, spans, 3)
+
+	ruleSpan := spans[0]
+	require.Equal(t, "rule Subject", ruleSpan.Name)
+	require.Equal(t, parent.SpanContext().SpanID(), ruleSpan.Parent.SpanID())
+	require.Contains(t, ruleSpan.Attributes, attribute.String(AttributeRule, "Subject"))
+	require.Contains(t, ruleSpan.Attributes, attribute.String(AttributeCommit, "abc123"))
+	require.Contains(t, ruleSpan.Attributes, attribute.Int(AttributeErrors, 2))
+}
+
+func TestRepositoryRules(t *testing.T) {
+	cfg := config.NewDefault()
+	exporter := tracetest.NewInMemoryExporter()
+	ctx := tracedContext(exporter)
+
+	traced := RepositoryRules(ctx, []domain.RepositoryRule{rules.NewLinearHistoryRule(cfg)})
+
+	merge := domain.Commit{Hash: "c3", Subject: "Merge branch 'topic'", IsMergeCommit: true, ParentHashes: []string{"c2", "c1"}}
+	errors := domain.ValidateRange([]domain.Commit{merge}, traced, nil, cfg)
+	require.NotEmpty(t, errors, "range rules stay range rules")
+
+	branchAhead := RepositoryRules(ctx, []domain.RepositoryRule{rules.NewBranchAheadRule(cfg)})
+	require.False(t, domain.HasRangeRules(branchAhead, nil), "other rules do not become range rules")
+
+	spans := exporter.GetSpans()
+	require.Len(t, spans, 1)
+	require.Equal(t, "rule LinearHistory", spans[0].Name)
+	require.Contains(t, spans[0].Attributes, attribute.Int(AttributeErrors, len(errors)))
+}
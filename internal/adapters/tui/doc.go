@@ -0,0 +1,16 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+/*
+Package tui provides an interactive terminal screen for reviewing validation failures.
+
+The screen follows the model-update-view pattern: Model holds the review state,
+Update returns a new model for each key press together with any side effect to
+perform, and View renders the model as lines for the current terminal size. Only
+Run touches the terminal, which keeps navigation and rendering testable.
+
+Suggested fixes are copied with an OSC 52 escape sequence, which most terminal
+emulators and tmux (with set-clipboard enabled) turn into a clipboard update.
+*/
+package tui
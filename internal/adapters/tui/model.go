@@ -0,0 +1,214 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package tui
+
+import (
+	"github.com/itiquette/gommitlint/internal/domain"
+)
+
+// Key is a key press understood by the model.
+type Key string
+
+// Keys handled by Update.
+const (
+	KeyUp        Key = "up"
+	KeyDown      Key = "down"
+	KeyNext      Key = "next"
+	KeyPrevious  Key = "previous"
+	KeyToggle    Key = "toggle"
+	KeyCopy      Key = "copy"
+	KeyFilter    Key = "filter"
+	KeyQuit      Key = "quit"
+	KeyUndefined Key = ""
+)
+
+// Effect is a side effect requested by Update and performed by the terminal loop.
+type Effect struct {
+	Copy string // Text to copy to the clipboard
+	Quit bool
+}
+
+// failure is a failed rule error of a commit, flattened for navigation.
+type failure struct {
+	Rule  string
+	Error domain.ValidationError
+}
+
+// Model is the state of the interactive review screen.
+type Model struct {
+	report     domain.Report
+	commit     int  // Index into visibleCommits
+	failure    int  // Index into the failures of the selected commit
+	expanded   bool // Whether help of the selected failure is shown
+	onlyFailed bool
+	status     string
+}
+
+// NewModel creates a model for reviewing report, starting at the first failing commit.
+func NewModel(report domain.Report) Model {
+	model := Model{report: report, onlyFailed: true}
+	if len(model.visibleCommits()) == 0 {
+		model.onlyFailed = false
+	}
+
+	return model
+}
+
+// Update returns the model after handling key, and any side effect to perform.
+func (m Model) Update(key Key) (Model, Effect) {
+	m.status = ""
+
+	switch key {
+	case KeyUp:
+		if m.failure > 0 {
+			m.failure--
+			m.expanded = false
+		}
+	case KeyDown:
+		if m.failure < len(m.failures())-1 {
+			m.failure++
+			m.expanded = false
+		}
+	case KeyNext:
+		if m.commit < len(m.visibleCommits())-1 {
+			m = m.selectCommit(m.commit + 1)
+		}
+	case KeyPrevious:
+		if m.commit > 0 {
+			m = m.selectCommit(m.commit - 1)
+		}
+	case KeyToggle:
+		if len(m.failures()) > 0 {
+			m.expanded = !m.expanded
+		}
+	case KeyFilter:
+		m = m.toggleFilter()
+	case KeyCopy:
+		selected, ok := m.selectedFailure()
+		if !ok {
+			return m, Effect{}
+		}
+
+		fix := SuggestedFix(selected.Error)
+		if fix == "" {
+			m.status = "No suggested fix for this failure"
+
+			return m, Effect{}
+		}
+
+		m.status = "Copied suggested fix to clipboard"
+
+		return m, Effect{Copy: fix}
+	case KeyQuit:
+		return m, Effect{Quit: true}
+	case KeyUndefined:
+	}
+
+	return m, Effect{}
+}
+
+// SuggestedFix returns a replacement the user can paste for a failure, or empty if the rule offers none.
+func SuggestedFix(err domain.ValidationError) string {
+	if suggestion := err.Suggestion(); suggestion != "" {
+		return suggestion
+	}
+
+	// The subject rule names the first word with the expected case
+	return err.Context["expected_word"]
+}
+
+// selectCommit selects the commit at index and resets the failure selection.
+func (m Model) selectCommit(index int) Model {
+	m.commit = index
+	m.failure = 0
+	m.expanded = false
+
+	return m
+}
+
+// toggleFilter switches between showing failing commits and all commits, keeping the selected commit.
+func (m Model) toggleFilter() Model {
+	selected, hasSelection := m.selectedCommit()
+
+	m.onlyFailed = !m.onlyFailed
+	if m.onlyFailed && len(m.visibleCommits()) == 0 {
+		m.onlyFailed = false
+		m.status = "No failing commits"
+
+		return m
+	}
+
+	m = m.selectCommit(0)
+
+	if hasSelection {
+		for i, commitReport := range m.visibleCommits() {
+			if commitReport.Commit.Hash == selected.Commit.Hash {
+				m.commit = i
+			}
+		}
+	}
+
+	return m
+}
+
+// visibleCommits returns the commits shown with the current filter.
+func (m Model) visibleCommits() []domain.CommitReport {
+	if !m.onlyFailed {
+		return m.report.Commits
+	}
+
+	commits := make([]domain.CommitReport, 0, len(m.report.Commits))
+
+	for _, commitReport := range m.report.Commits {
+		if len(commitFailures(commitReport)) > 0 {
+			commits = append(commits, commitReport)
+		}
+	}
+
+	return commits
+}
+
+// selectedCommit returns the selected commit report.
+func (m Model) selectedCommit() (domain.CommitReport, bool) {
+	commits := m.visibleCommits()
+	if m.commit >= len(commits) {
+		return domain.CommitReport{}, false
+	}
+
+	return commits[m.commit], true
+}
+
+// failures returns the failures of the selected commit.
+func (m Model) failures() []failure {
+	commitReport, ok := m.selectedCommit()
+	if !ok {
+		return nil
+	}
+
+	return commitFailures(commitReport)
+}
+
+// selectedFailure returns the selected failure of the selected commit.
+func (m Model) selectedFailure() (failure, bool) {
+	failures := m.failures()
+	if m.failure >= len(failures) {
+		return failure{}, false
+	}
+
+	return failures[m.failure], true
+}
+
+// commitFailures flattens the errors and warnings of a commit's rule reports.
+func commitFailures(commitReport domain.CommitReport) []failure {
+	var failures []failure
+
+	for _, ruleReport := range commitReport.RuleResults {
+		for _, err := range ruleReport.Errors {
+			failures = append(failures, failure{Rule: ruleReport.Name, Error: err})
+		}
+	}
+
+	return failures
+}
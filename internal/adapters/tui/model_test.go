@@ -0,0 +1,204 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package tui
+
+import (
+	"bytes"
+	"encoding/base64"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/itiquette/gommitlint/internal/domain"
+)
+
+func testReport() domain.Report {
+	caseErr := domain.New("Subject", domain.ErrWrongCaseLower, "First letter 'A' should be 'a'").
+		WithContextMap(map[string]string{"first_word": "Add", "expected_word": "add"}).
+		WithHelp("Use lower case")
+	tenseErr := domain.New("Subject", domain.ErrPastTense, "'Added' is past tense").
+		WithContextMap(map[string]string{"actual": "Added", "suggestion": "Add"})
+	signoffErr := domain.New("SignOff", domain.ErrMissingSignoff, "Missing required sign-off").
+		WithHelp("Add a Signed-off-by line")
+
+	return domain.Report{
+		Summary: domain.ReportSummary{TotalCommits: 3, PassedCommits: 1, FailedCommits: 2},
+		Commits: []domain.CommitReport{
+			{
+				Commit: domain.Commit{Hash: "aaaaaaa1111", Subject: "Add parser"},
+				RuleResults: []domain.RuleReport{
+					{Name: "Subject", Status: domain.StatusFailed, Errors: []domain.ValidationError{caseErr}},
+					{Name: "SignOff", Status: domain.StatusFailed, Errors: []domain.ValidationError{signoffErr}},
+				},
+			},
+			{
+				Commit:      domain.Commit{Hash: "bbbbbbb2222", Subject: "fix: handle nil"},
+				RuleResults: []domain.RuleReport{{Name: "Subject", Status: domain.StatusPassed}},
+				Passed:      true,
+			},
+			{
+				Commit:      domain.Commit{Hash: "ccccccc3333", Subject: "Added tests"},
+				RuleResults: []domain.RuleReport{{Name: "Subject", Status: domain.StatusFailed, Errors: []domain.ValidationError{tenseErr}}},
+			},
+		},
+	}
+}
+
+func update(model Model, keys ...Key) (Model, Effect) {
+	var effect Effect
+
+	for _, key := range keys {
+		model, effect = model.Update(key)
+	}
+
+	return model, effect
+}
+
+func TestModel_Navigation(t *testing.T) {
+	model := NewModel(testReport())
+
+	// Failing commits only by default
+	require.Len(t, model.visibleCommits(), 2)
+
+	selected, _ := model.selectedFailure()
+	require.Equal(t, "Subject", selected.Rule)
+
+	model, _ = update(model, KeyDown, KeyDown)
+	selected, _ = model.selectedFailure()
+	require.Equal(t, "SignOff", selected.Rule, "moving past the last failure stays on it")
+
+	model, _ = update(model, KeyNext)
+	commitReport, _ := model.selectedCommit()
+	require.Equal(t, "ccccccc3333", commitReport.Commit.Hash)
+	require.Equal(t, 0, model.failure)
+
+	model, _ = update(model, KeyNext, KeyPrevious)
+	commitReport, _ = model.selectedCommit()
+	require.Equal(t, "aaaaaaa1111", commitReport.Commit.Hash)
+
+	// Showing all commits keeps the selection
+	model, _ = update(model, KeyNext, KeyFilter)
+	require.Len(t, model.visibleCommits(), 3)
+	commitReport, _ = model.selectedCommit()
+	require.Equal(t, "ccccccc3333", commitReport.Commit.Hash)
+
+	model, effect := update(model, KeyQuit)
+	require.True(t, effect.Quit)
+	require.False(t, model.expanded)
+}
+
+func TestModel_AllPassed(t *testing.T) {
+	report := testReport()
+	report.Commits = report.Commits[1:2]
+
+	model := NewModel(report)
+	require.False(t, model.onlyFailed)
+
+	model, _ = update(model, KeyFilter)
+	require.False(t, model.onlyFailed)
+	require.Equal(t, "No failing commits", model.status)
+
+	model, effect := update(model, KeyToggle, KeyCopy)
+	require.False(t, model.expanded)
+	require.Empty(t, effect.Copy)
+}
+
+func TestModel_CopySuggestedFix(t *testing.T) {
+	model := NewModel(testReport())
+
+	model, effect := update(model, KeyCopy)
+	require.Equal(t, "add", effect.Copy)
+	require.Equal(t, "Copied suggested fix to clipboard", model.status)
+
+	model, effect = update(model, KeyDown, KeyCopy)
+	require.Empty(t, effect.Copy)
+	require.Equal(t, "No suggested fix for this failure", model.status)
+
+	_, effect = update(model, KeyNext, KeyCopy)
+	require.Equal(t, "Add", effect.Copy)
+}
+
+func TestModel_View(t *testing.T) {
+	model := NewModel(testReport())
+
+	lines := model.View(80, 20)
+	require.Len(t, lines, 20)
+
+	screen := strings.Join(lines, "\n")
+	require.Contains(t, screen, "2 of 3 commits failed (showing failing commits)")
+	require.Contains(t, screen, "✗ aaaaaaa Add parser")
+	require.Contains(t, screen, "✗ Subject: First letter 'A' should be 'a'")
+	require.Contains(t, screen, "✗ SignOff: Missing required sign-off")
+	require.NotContains(t, screen, "Use lower case")
+
+	model, _ = update(model, KeyToggle)
+	screen = strings.Join(model.View(80, 20), "\n")
+	require.Contains(t, screen, "Use lower case")
+	require.Contains(t, screen, "expected_word: add")
+	require.Contains(t, screen, "Suggested fix: add (press c to copy)")
+
+	// Small screens clip details but keep the footer
+	lines = model.View(30, 8)
+	require.Len(t, lines, 8)
+	require.Contains(t, lines[7], "↑/↓ failure")
+
+	for _, line := range lines {
+		plain := strings.NewReplacer(reverseVideo, "", bold, "", dim, "", resetStyle, "").Replace(line)
+		require.LessOrEqual(t, len([]rune(plain)), 30, line)
+	}
+}
+
+func TestParseKey(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected Key
+	}{
+		{input: "\033[A", expected: KeyUp},
+		{input: "j", expected: KeyDown},
+		{input: "\033[C", expected: KeyNext},
+		{input: "p", expected: KeyPrevious},
+		{input: "\r", expected: KeyToggle},
+		{input: "c", expected: KeyCopy},
+		{input: "f", expected: KeyFilter},
+		{input: "\x03", expected: KeyQuit},
+		{input: "x", expected: KeyUndefined},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.input, func(t *testing.T) {
+			require.Equal(t, testCase.expected, ParseKey([]byte(testCase.input)))
+		})
+	}
+}
+
+func TestLoop(t *testing.T) {
+	var output bytes.Buffer
+
+	// Each read returns one key press: copy the fix of the first failure, then quit
+	input := &keyReader{keys: []string{"c", "q"}}
+
+	err := loop(NewModel(testReport()), input, &output, func() (int, int) { return 80, 24 })
+	require.NoError(t, err)
+	require.Contains(t, output.String(), "\033]52;c;"+base64.StdEncoding.EncodeToString([]byte("add"))+"\a")
+	require.Contains(t, output.String(), "Copied suggested fix to clipboard")
+}
+
+// keyReader returns one key per Read call, then io.EOF.
+type keyReader struct {
+	keys []string
+}
+
+func (r *keyReader) Read(buffer []byte) (int, error) {
+	if len(r.keys) == 0 {
+		return 0, io.EOF
+	}
+
+	count := copy(buffer, r.keys[0])
+	r.keys = r.keys[1:]
+
+	return count, nil
+}
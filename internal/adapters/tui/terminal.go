@@ -0,0 +1,125 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package tui
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"golang.org/x/term"
+
+	"github.com/itiquette/gommitlint/internal/domain"
+)
+
+// Terminal control sequences.
+const (
+	enterAltScreen = "\033[?1049h\033[?25l"
+	exitAltScreen  = "\033[?25h\033[?1049l"
+	clearScreen    = "\033[H\033[2J"
+)
+
+// Fallback screen size when the terminal size cannot be determined.
+const (
+	defaultWidth  = 80
+	defaultHeight = 24
+)
+
+// ErrNotTerminal is returned when interactive review is requested without a terminal.
+var ErrNotTerminal = errors.New("interactive mode requires a terminal on stdin and stdout")
+
+// Run shows the report in an interactive review screen until the user quits.
+func Run(report domain.Report, input, output *os.File) error {
+	inFd := int(input.Fd())
+	outFd := int(output.Fd())
+
+	if !term.IsTerminal(inFd) || !term.IsTerminal(outFd) {
+		return ErrNotTerminal
+	}
+
+	oldState, err := term.MakeRaw(inFd)
+	if err != nil {
+		return fmt.Errorf("failed to switch terminal to raw mode: %w", err)
+	}
+
+	defer func() {
+		_ = term.Restore(inFd, oldState)
+	}()
+
+	fmt.Fprint(output, enterAltScreen)
+	defer fmt.Fprint(output, exitAltScreen)
+
+	return loop(NewModel(report), input, output, func() (int, int) {
+		width, height, err := term.GetSize(outFd)
+		if err != nil {
+			return defaultWidth, defaultHeight
+		}
+
+		return width, height
+	})
+}
+
+// loop renders the model and applies key presses read from input until the model quits.
+func loop(model Model, input io.Reader, output io.Writer, size func() (int, int)) error {
+	buffer := make([]byte, 16)
+
+	for {
+		width, height := size()
+		fmt.Fprint(output, clearScreen+strings.Join(model.View(width, height), "\r\n"))
+
+		count, err := input.Read(buffer)
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("failed to read key: %w", err)
+		}
+
+		var effect Effect
+
+		model, effect = model.Update(ParseKey(buffer[:count]))
+
+		if effect.Copy != "" {
+			fmt.Fprint(output, copySequence(effect.Copy))
+		}
+
+		if effect.Quit {
+			return nil
+		}
+	}
+}
+
+// ParseKey maps the bytes of a key press to a Key.
+func ParseKey(input []byte) Key {
+	switch string(input) {
+	case "\033[A", "k":
+		return KeyUp
+	case "\033[B", "j":
+		return KeyDown
+	case "\033[C", "n", "l", "\t":
+		return KeyNext
+	case "\033[D", "p", "h", "\033[Z":
+		return KeyPrevious
+	case "\r", "\n", " ":
+		return KeyToggle
+	case "c", "y":
+		return KeyCopy
+	case "f":
+		return KeyFilter
+	case "q", "\033", "\x03":
+		return KeyQuit
+	default:
+		return KeyUndefined
+	}
+}
+
+// copySequence returns the OSC 52 sequence asking the terminal to put text on the clipboard.
+func copySequence(text string) string {
+	return "\033]52;c;" + base64.StdEncoding.EncodeToString([]byte(text)) + "\a"
+}
@@ -0,0 +1,180 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package tui
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/itiquette/gommitlint/internal/domain"
+)
+
+// ANSI sequences used by the view.
+const (
+	reverseVideo = "\033[7m"
+	bold         = "\033[1m"
+	dim          = "\033[2m"
+	resetStyle   = "\033[0m"
+)
+
+// keyHints describes the key bindings in the footer.
+const keyHints = "↑/↓ failure  ←/→ commit  enter help  c copy fix  f filter  q quit"
+
+// View renders the model as screen lines fitting width and height.
+func (m Model) View(width, height int) []string {
+	commits := m.visibleCommits()
+
+	header := fmt.Sprintf("gommitlint review: %d of %d commits failed", m.report.Summary.FailedCommits, m.report.Summary.TotalCommits)
+	if m.onlyFailed {
+		header += " (showing failing commits)"
+	}
+
+	lines := []string{bold + fit(header, width) + resetStyle, ""}
+
+	listHeight := min(len(commits), max(3, height/3))
+	lines = append(lines, m.commitList(commits, listHeight, width)...)
+	lines = append(lines, strings.Repeat("─", max(width, 1)))
+
+	footer := []string{m.status, dim + fit(keyHints, width) + resetStyle}
+
+	detailHeight := height - len(lines) - len(footer)
+	details := m.details(width)
+
+	if detailHeight < len(details) {
+		details = details[:max(detailHeight, 0)]
+	}
+
+	lines = append(lines, details...)
+
+	for len(lines) < height-len(footer) {
+		lines = append(lines, "")
+	}
+
+	return append(lines, footer...)
+}
+
+// commitList renders a window of the commit list that keeps the selected commit visible.
+func (m Model) commitList(commits []domain.CommitReport, listHeight, width int) []string {
+	start := 0
+	if m.commit >= listHeight {
+		start = m.commit - listHeight + 1
+	}
+
+	lines := make([]string, 0, listHeight)
+
+	for i := start; i < len(commits) && i < start+listHeight; i++ {
+		commitReport := commits[i]
+
+		line := fmt.Sprintf("  %s %s %s", commitSymbol(commitReport), domain.ShortHash(commitReport.Commit.Hash), commitReport.Commit.Subject)
+		if commitReport.Submodule != "" {
+			line += " [" + commitReport.Submodule + "]"
+		}
+
+		line = fit(line, width)
+
+		if i == m.commit {
+			line = reverseVideo + line + resetStyle
+		}
+
+		lines = append(lines, line)
+	}
+
+	return lines
+}
+
+// details renders the failures of the selected commit.
+func (m Model) details(width int) []string {
+	commitReport, ok := m.selectedCommit()
+	if !ok {
+		return []string{"No commits to review"}
+	}
+
+	lines := []string{fit(fmt.Sprintf("%s %s", domain.ShortHash(commitReport.Commit.Hash), commitReport.Commit.Subject), width), ""}
+
+	failures := commitFailures(commitReport)
+	if len(failures) == 0 {
+		return append(lines, "✓ All rules passed")
+	}
+
+	for i, item := range failures {
+		symbol := "✗"
+		if !item.Error.IsBlocking() {
+			symbol = "⚠"
+		}
+
+		line := fit(fmt.Sprintf("%s %s: %s", symbol, item.Rule, item.Error.Message), width)
+		if i == m.failure {
+			line = reverseVideo + line + resetStyle
+		}
+
+		lines = append(lines, line)
+
+		if i == m.failure && m.expanded {
+			lines = append(lines, expandedFailure(item.Error, width)...)
+		}
+	}
+
+	return lines
+}
+
+// expandedFailure renders the help text, context and suggested fix of a failure.
+func expandedFailure(err domain.ValidationError, width int) []string {
+	const indent = "    "
+
+	var lines []string
+
+	if err.Code != "" {
+		lines = append(lines, fit(indent+"Code: "+err.Code, width))
+	}
+
+	keys := make([]string, 0, len(err.Context))
+	for key := range err.Context {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		lines = append(lines, fit(fmt.Sprintf("%s%s: %s", indent, key, err.Context[key]), width))
+	}
+
+	if err.Help != "" {
+		lines = append(lines, "")
+
+		for _, helpLine := range strings.Split(err.Help, "\n") {
+			lines = append(lines, fit(indent+helpLine, width))
+		}
+	}
+
+	if fix := SuggestedFix(err); fix != "" {
+		lines = append(lines, "", fit(fmt.Sprintf("%sSuggested fix: %s (press c to copy)", indent, fix), width))
+	}
+
+	return append(lines, "")
+}
+
+// commitSymbol returns the status symbol of a commit.
+func commitSymbol(commitReport domain.CommitReport) string {
+	if !commitReport.Passed {
+		return "✗"
+	}
+
+	if len(commitFailures(commitReport)) > 0 {
+		return "⚠"
+	}
+
+	return "✓"
+}
+
+// fit truncates line to width runes, marking truncation with an ellipsis.
+func fit(line string, width int) string {
+	runes := []rune(line)
+	if width <= 0 || len(runes) <= width {
+		return line
+	}
+
+	return string(runes[:width-1]) + "…"
+}
@@ -0,0 +1,142 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package domain
+
+import (
+	"strconv"
+	"time"
+)
+
+// SignatureAuditStatusUnsigned marks commits without a signature in a signature audit.
+const SignatureAuditStatusUnsigned = "unsigned"
+
+// SignatureAudit is a signed-history report of the commits in a range.
+type SignatureAudit struct {
+	Range       string
+	GeneratedAt time.Time
+	Entries     []SignatureAuditEntry
+}
+
+// SignatureAuditEntry records the signature evidence of one commit.
+type SignatureAuditEntry struct {
+	Hash          string
+	Subject       string
+	Author        string
+	Committer     string
+	Date          string
+	Signed        bool
+	SignatureType string
+	Fingerprint   string
+	Status        string
+	Signer        string
+	IdentityMatch bool
+	StatusCode    string
+	StatusMessage string
+}
+
+// SignatureAuditSummary counts the entries of a signature audit.
+type SignatureAuditSummary struct {
+	Total         int
+	Signed        int
+	Verified      int
+	IdentityMatch int
+}
+
+// NewSignatureAuditEntry records the verification result of commit. The signer identity
+// matches when the verified signer is the committer, the identity git records for whoever
+// created the commit object and signed it, after resolving both through the .mailmap.
+func NewSignatureAuditEntry(commit Commit, result VerificationResult) SignatureAuditEntry {
+	committer := NewIdentity(commit.Committer, commit.CommitterEmail)
+	if committer.IsEmpty() {
+		committer = NewIdentity(commit.Author, commit.AuthorEmail)
+	}
+
+	entry := SignatureAuditEntry{
+		Hash:      commit.Hash,
+		Subject:   commit.Subject,
+		Author:    NewIdentity(commit.Author, commit.AuthorEmail).String(),
+		Committer: committer.String(),
+		Date:      commit.CommitDate,
+		Signed:    commit.IsSigned(),
+		Status:    SignatureAuditStatusUnsigned,
+	}
+
+	if !entry.Signed {
+		return entry
+	}
+
+	entry.SignatureType = string(result.Signature().Type())
+	entry.Fingerprint = result.Fingerprint()
+	entry.Status = string(result.Status())
+	entry.StatusCode = result.ErrorCode()
+	entry.StatusMessage = result.ErrorMessage()
+
+	if result.IsVerified() {
+		signer := commit.Mailmap.Resolve(result.Identity())
+		entry.Signer = result.Identity().String()
+		entry.IdentityMatch = signer.Matches(commit.Mailmap.Resolve(committer))
+	}
+
+	return entry
+}
+
+// VerificationContext returns the verification result of commit as context values for
+// reports: the signature type, the status and the fingerprint, algorithm and dates of the
+// signing key, and for verified signatures the signer and whether it matches the committer.
+// Dates are in RFC 3339; key_expires is "never" for keys without an expiry date.
+func VerificationContext(commit Commit, result VerificationResult) map[string]string {
+	entry := NewSignatureAuditEntry(commit, result)
+
+	context := map[string]string{
+		"signature_type": entry.SignatureType,
+		"status":         entry.Status,
+	}
+
+	if entry.Fingerprint != "" {
+		context["fingerprint"] = entry.Fingerprint
+	}
+
+	if algorithm := result.KeyAlgorithm(); algorithm != "" {
+		context["algorithm"] = algorithm
+	}
+
+	// Only keys with a creation date have dates at all
+	if created := result.KeyCreated(); !created.IsZero() {
+		context["key_created"] = created.UTC().Format(time.RFC3339)
+		context["key_expires"] = "never"
+
+		if expires := result.KeyExpires(); !expires.IsZero() {
+			context["key_expires"] = expires.UTC().Format(time.RFC3339)
+		}
+	}
+
+	if result.IsVerified() {
+		context["signer"] = entry.Signer
+		context["identity_match"] = strconv.FormatBool(entry.IdentityMatch)
+	}
+
+	return context
+}
+
+// Summary counts the signed, verified and identity matched commits of the audit.
+func (a SignatureAudit) Summary() SignatureAuditSummary {
+	summary := SignatureAuditSummary{Total: len(a.Entries)}
+
+	for _, entry := range a.Entries {
+		if entry.Signed {
+			summary.Signed++
+		}
+
+		if entry.Status == string(VerificationStatusVerified) {
+			summary.Verified++
+		}
+
+		if entry.IdentityMatch {
+			summary.IdentityMatch++
+		}
+	}
+
+	return summary
+}
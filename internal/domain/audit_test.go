@@ -0,0 +1,116 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package domain_test
+
+import (
+	"testing"
+
+	"github.com/itiquette/gommitlint/internal/domain"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewSignatureAuditEntry(t *testing.T) {
+	signature := "-----BEGIN SSH SIGNATURE-----"
+	commit := domain.Commit{
+		Hash:           "abc123",
+		Subject:        "feat: add audit",
+		Author:         "Dev",
+		AuthorEmail:    "dev@example.com",
+		Committer:      "Dev",
+		CommitterEmail: "dev@example.com",
+		CommitDate:     "2025-06-14T10:00:00Z",
+		Signature:      signature,
+	}
+	verifiedBy := func(identity domain.Identity) domain.VerificationResult {
+		return domain.NewVerificationResult(domain.VerificationStatusVerified, identity,
+			domain.NewSignature(signature)).WithFingerprint("SHA256:abc")
+	}
+
+	tests := []struct {
+		name     string
+		commit   func() domain.Commit
+		result   domain.VerificationResult
+		expected domain.SignatureAuditEntry
+	}{
+		{
+			name: "unsigned commit",
+			commit: func() domain.Commit {
+				unsigned := commit
+				unsigned.Signature = ""
+
+				return unsigned
+			},
+			expected: domain.SignatureAuditEntry{Status: domain.SignatureAuditStatusUnsigned},
+		},
+		{
+			name:   "signed by the committer",
+			commit: func() domain.Commit { return commit },
+			result: verifiedBy(domain.NewIdentity("Dev", "dev@example.com")),
+			expected: domain.SignatureAuditEntry{
+				Signed: true, SignatureType: "ssh", Fingerprint: "SHA256:abc", Status: "verified",
+				Signer: "Dev <dev@example.com>", IdentityMatch: true,
+			},
+		},
+		{
+			name:   "signed by someone else",
+			commit: func() domain.Commit { return commit },
+			result: verifiedBy(domain.NewIdentity("Other", "other@example.com")),
+			expected: domain.SignatureAuditEntry{
+				Signed: true, SignatureType: "ssh", Fingerprint: "SHA256:abc", Status: "verified",
+				Signer: "Other <other@example.com>",
+			},
+		},
+		{
+			name: "signer matches committer through mailmap",
+			commit: func() domain.Commit {
+				mapped := commit
+				mapped.Mailmap = domain.ParseMailmap("Dev <dev@example.com> <dev@old.example.com>\n")
+
+				return mapped
+			},
+			result: verifiedBy(domain.NewIdentity("Dev", "dev@old.example.com")),
+			expected: domain.SignatureAuditEntry{
+				Signed: true, SignatureType: "ssh", Fingerprint: "SHA256:abc", Status: "verified",
+				Signer: "Dev <dev@old.example.com>", IdentityMatch: true,
+			},
+		},
+		{
+			name:   "verification failed",
+			commit: func() domain.Commit { return commit },
+			result: domain.NewVerificationResult(domain.VerificationStatusNoKey, domain.Identity{},
+				domain.NewSignature(signature)).WithError("key_not_trusted", "No trusted key matches"),
+			expected: domain.SignatureAuditEntry{
+				Signed: true, SignatureType: "ssh", Status: "no_key",
+				StatusCode: "key_not_trusted", StatusMessage: "No trusted key matches",
+			},
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			entry := domain.NewSignatureAuditEntry(testCase.commit(), testCase.result)
+
+			expected := testCase.expected
+			expected.Hash = commit.Hash
+			expected.Subject = commit.Subject
+			expected.Author = "Dev <dev@example.com>"
+			expected.Committer = "Dev <dev@example.com>"
+			expected.Date = commit.CommitDate
+
+			require.Equal(t, expected, entry)
+		})
+	}
+}
+
+func TestSignatureAuditSummary(t *testing.T) {
+	audit := domain.SignatureAudit{Entries: []domain.SignatureAuditEntry{
+		{Signed: true, Status: "verified", IdentityMatch: true},
+		{Signed: true, Status: "verified"},
+		{Signed: true, Status: "no_key"},
+		{Status: domain.SignatureAuditStatusUnsigned},
+	}}
+
+	require.Equal(t, domain.SignatureAuditSummary{Total: 4, Signed: 3, Verified: 2, IdentityMatch: 1}, audit.Summary())
+}
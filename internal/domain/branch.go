@@ -0,0 +1,29 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package domain
+
+import "context"
+
+// BranchResolver defines the contract for finding the branch commits are headed for.
+type BranchResolver interface {
+	// GetTargetBranch returns the short name of the upstream branch of the checked out
+	// branch, or of the checked out branch itself when it has no upstream.
+	// An empty name means HEAD is detached.
+	GetTargetBranch(ctx context.Context) (string, error)
+}
+
+// BehindCounter defines the contract for finding how far the current branch lags behind.
+type BehindCounter interface {
+	// GetCommitsBehindCount returns how many commits of the reference branch the current
+	// branch does not contain.
+	GetCommitsBehindCount(ctx context.Context, referenceBranch string) (int, error)
+}
+
+// CurrentBranchResolver defines the contract for finding the branch commits are made on.
+type CurrentBranchResolver interface {
+	// GetCurrentBranch returns the short name of the checked out branch, or of the head
+	// branch of a pull request. An empty name means HEAD is detached.
+	GetCurrentBranch(ctx context.Context) (string, error)
+}
@@ -24,20 +24,53 @@ type Commit struct {
 	// Message is the complete commit message including subject and body.
 	Message string
 
+	// Trailers are the trailers at the end of the message, as split by the message
+	// parser. Commits built without a parser have none; see MessageTrailers.
+	Trailers []Trailer
+
+	// Gitmoji is the gitmoji the gitmoji message parser took out of the subject.
+	Gitmoji string
+
+	// Encoding is the encoding the encoding header of the commit object declares for the
+	// message, such as ISO-8859-1, or empty without one. Repositories transcode messages
+	// to UTF-8 as they read them.
+	Encoding string
+
+	// EncodingError tells why the message could not be transcoded from Encoding, in
+	// which case Message is the message as stored.
+	EncodingError string
+
 	// Author is the name of the commit author.
 	Author string
 
 	// AuthorEmail is the email address of the commit author.
 	AuthorEmail string
 
+	// Committer is the name of the committer, which differs from the author
+	// for rebased, amended or applied commits.
+	Committer string
+
+	// CommitterEmail is the email address of the committer.
+	CommitterEmail string
+
+	// Mailmap holds the canonical identities of the repository the commit belongs to.
+	Mailmap Mailmap
+
 	// CommitDate is the date of the commit in ISO format.
 	CommitDate string
 
 	// Signature is the signature attached to the commit, if any.
 	Signature string
 
+	// SignedPayload is the commit object without its signature, the data the signature
+	// covers. It is empty for unsigned commits and when the repository cannot provide it.
+	SignedPayload string
+
 	// IsMergeCommit indicates whether this is a merge commit.
 	IsMergeCommit bool
+
+	// ParentHashes are the hashes of the commit's parents, first parent first.
+	ParentHashes []string
 }
 
 // HasBody returns true if the commit has a body.
@@ -45,6 +78,42 @@ func (c Commit) HasBody() bool {
 	return strings.TrimSpace(c.Body) != ""
 }
 
+// MessageTrailers returns the trailers of the message, parsed from the body for commits
+// built without a message parser.
+func (c Commit) MessageTrailers() []Trailer {
+	if c.Trailers != nil {
+		return c.Trailers
+	}
+
+	return ParseTrailers(c.Body)
+}
+
+// FullMessage returns the message of the commit, put together from its subject and body
+// for commits built without one.
+func (c Commit) FullMessage() string {
+	if c.Message != "" {
+		return c.Message
+	}
+
+	return strings.TrimRight(c.Subject+"\n\n"+c.Body, "\n")
+}
+
+// ParsedWith returns the commit with its message split by parser. Commits without a
+// message are returned as they are.
+func (c Commit) ParsedWith(parser MessageParser) Commit {
+	if c.Message == "" {
+		return c
+	}
+
+	parsed := parser.Parse(c.Message)
+	c.Subject = parsed.Subject
+	c.Body = parsed.Body
+	c.Trailers = parsed.Trailers
+	c.Gitmoji = parsed.Gitmoji
+
+	return c
+}
+
 // IsValid returns true if the commit has basic required fields.
 func (c Commit) IsValid() bool {
 	return c.Hash != "" && strings.TrimSpace(c.Subject) != ""
@@ -55,6 +124,16 @@ func (c Commit) IsSigned() bool {
 	return c.Signature != ""
 }
 
+// ShortHash abbreviates a commit hash to seven characters, as git log --oneline does.
+// Shorter hashes are returned as they are.
+func ShortHash(hash string) string {
+	if len(hash) > 7 {
+		return hash[:7]
+	}
+
+	return hash
+}
+
 // SplitCommitMessage splits a commit message into subject and body following Git conventions.
 // Git convention: subject + blank line + body. Without blank line, everything is subject.
 func SplitCommitMessage(message string) (string, string) {
@@ -80,13 +159,14 @@ func SplitCommitMessage(message string) (string, string) {
 
 // NewCommit creates a Commit from its components.
 func NewCommit(hash, message, author, authorEmail, commitDate, signature string, isMerge bool) Commit {
-	subject, body := SplitCommitMessage(message)
+	parsed := GitParser{}.Parse(message)
 
 	return Commit{
 		Hash:          hash,
-		Subject:       subject,
-		Body:          body,
+		Subject:       parsed.Subject,
+		Body:          parsed.Body,
 		Message:       message,
+		Trailers:      parsed.Trailers,
 		Author:        author,
 		AuthorEmail:   authorEmail,
 		CommitDate:    commitDate,
@@ -128,18 +208,59 @@ type Repository interface {
 	GetCommitsAheadCount(ctx context.Context, referenceBranch string) (int, error)
 }
 
+// CommitStreamer defines the contract for reading the commits of a range one at a time,
+// so that huge ranges are validated without holding all of their commits in memory.
+type CommitStreamer interface {
+	// StreamCommitRange calls visit with each commit of the range from..to, as
+	// GetCommitRange returns them, and stops at the first error visit returns.
+	StreamCommitRange(ctx context.Context, from, to string, visit func(Commit) error) error
+}
+
+// OldestFirstCommitStreamer defines the contract for reading the commits of a range one at
+// a time in the order reports list them, so that they can be written as they are validated.
+type OldestFirstCommitStreamer interface {
+	// StreamCommitRangeOldestFirst calls visit with each commit of the range from..to in
+	// the reverse of the order of StreamCommitRange, and stops at the first error visit returns.
+	StreamCommitRangeOldestFirst(ctx context.Context, from, to string, visit func(Commit) error) error
+}
+
+// RepositoryNameResolver defines the contract for naming the validated repository in reports.
+type RepositoryNameResolver interface {
+	// GetRepositoryName returns the name of the repository, such as the directory of its
+	// work tree or owner/repo of a pull request.
+	GetRepositoryName(ctx context.Context) (string, error)
+}
+
 // ValidationResult represents the validation outcome for a single commit.
 type ValidationResult struct {
-	Commit Commit
-	Errors []ValidationError
+	Commit        Commit
+	Errors        []ValidationError
+	Skipped       map[string]string // Rule name to the blocking rule whose failure skipped it
+	NotApplicable map[string]bool   // Names of the rules whose condition the commit does not match
+	SkipReason    string            // Why no rule ran for the commit, such as SkipReasonMergeCommit
+	// Details are the details the rules reported about what they checked, keyed by rule
+	// name, such as the key that verified the signature.
+	Details map[string]map[string]string
 }
 
-// HasFailures returns true if there are any validation failures.
+// SkipCommit returns the result of a commit that is not validated for reason.
+func SkipCommit(commit Commit, reason string) ValidationResult {
+	return ValidationResult{Commit: commit, SkipReason: reason}
+}
+
+// HasFailures returns true if there are any blocking validation failures.
+// Warnings are reported but do not count as failures.
 func (v ValidationResult) HasFailures() bool {
-	return len(v.Errors) > 0
+	for _, err := range v.Errors {
+		if err.IsBlocking() {
+			return true
+		}
+	}
+
+	return false
 }
 
-// Passed returns true if validation passed (no failures).
+// Passed returns true if validation passed (no blocking failures).
 func (v ValidationResult) Passed() bool {
-	return len(v.Errors) == 0
+	return !v.HasFailures()
 }
@@ -92,3 +92,10 @@ func TestCommitMethods(t *testing.T) {
 		})
 	}
 }
+
+func TestShortHash(t *testing.T) {
+	require.Equal(t, "0123456", domain.ShortHash("0123456789abcdef0123456789abcdef01234567"))
+	require.Equal(t, "0123456", domain.ShortHash("0123456"))
+	require.Equal(t, "abc", domain.ShortHash("abc"))
+	require.Empty(t, domain.ShortHash(""))
+}
@@ -0,0 +1,300 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package domain
+
+import (
+	"context"
+	"errors"
+	"path"
+	"strings"
+
+	"github.com/itiquette/gommitlint/internal/domain/config"
+)
+
+// PathResolver defines the contract for finding the files a commit touches.
+type PathResolver interface {
+	// GetChangedPaths returns the paths changed by the commit compared to its first parent.
+	GetChangedPaths(ctx context.Context, ref string) ([]string, error)
+
+	// GetStagedPaths returns the paths staged for the next commit.
+	GetStagedPaths(ctx context.Context) ([]string, error)
+}
+
+// ErrMissingObjects is returned, wrapped, by path resolvers when a partial clone lacks the
+// objects needed to find the files of a commit. Resolvers report it rather than have the
+// objects fetched from the promisor remote one by one.
+var ErrMissingObjects = errors.New("objects missing from partial clone")
+
+// ConditionFacts are the commit properties rule conditions are evaluated against.
+// Facts that could not be determined are empty and match no criterion.
+type ConditionFacts struct {
+	Branch string   // Target branch of the commit
+	Type   string   // Conventional commit type
+	Author string   // Author email, resolved through the .mailmap
+	Paths  []string // Files changed by the commit, or staged for a message being written
+
+	PathsMissing bool // Paths could not be determined as the objects are missing from a partial clone
+}
+
+// MatchesCondition reports whether facts satisfy every criterion set in condition.
+func MatchesCondition(condition config.RuleCondition, facts ConditionFacts) bool {
+	if len(condition.Branches) > 0 && !matchesAny(condition.Branches, func(pattern string) bool {
+		return facts.Branch != "" && matchGlob(pattern, facts.Branch)
+	}) {
+		return false
+	}
+
+	if len(condition.Types) > 0 && !matchesAny(condition.Types, func(commitType string) bool {
+		return facts.Type != "" && strings.EqualFold(commitType, facts.Type)
+	}) {
+		return false
+	}
+
+	if len(condition.Authors) > 0 && !matchesAny(condition.Authors, func(author string) bool {
+		pattern := NewIdentityFromString(author).Email()
+
+		return facts.Author != "" && matchGlob(strings.ToLower(pattern), strings.ToLower(facts.Author))
+	}) {
+		return false
+	}
+
+	if len(condition.Paths) > 0 && !matchesAny(condition.Paths, func(pattern string) bool {
+		for _, changed := range facts.Paths {
+			if matchPath(pattern, changed) {
+				return true
+			}
+		}
+
+		return false
+	}) {
+		return false
+	}
+
+	return true
+}
+
+// matchesAny returns true if match accepts any of the entries.
+func matchesAny(entries []string, match func(string) bool) bool {
+	for _, entry := range entries {
+		if match(entry) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// matchGlob matches a name against a path.Match pattern, treating malformed patterns as literals.
+func matchGlob(pattern, name string) bool {
+	matched, err := path.Match(pattern, name)
+
+	return matched || (err != nil && pattern == name)
+}
+
+// matchPath matches a changed file against a path pattern. "dir/**" matches every file
+// below dir, and a pattern without a slash matches the file name in any directory.
+func matchPath(pattern, file string) bool {
+	if dir, found := strings.CutSuffix(pattern, "/**"); found {
+		return file == dir || strings.HasPrefix(file, dir+"/")
+	}
+
+	if !strings.Contains(pattern, "/") {
+		return matchGlob(pattern, path.Base(file))
+	}
+
+	return matchGlob(pattern, file)
+}
+
+// ConditionalCommitRule restricts a commit rule to the commits matching a condition.
+type ConditionalCommitRule struct {
+	CommitRule
+
+	Condition config.RuleCondition
+}
+
+// ConditionalRepositoryRule restricts a repository rule to the commits matching a condition.
+type ConditionalRepositoryRule struct {
+	RepositoryRule
+
+	Condition config.RuleCondition
+}
+
+func (r ConditionalCommitRule) ruleCondition() config.RuleCondition {
+	return r.Condition
+}
+
+func (r ConditionalRepositoryRule) ruleCondition() config.RuleCondition {
+	return r.Condition
+}
+
+// conditionalRule is implemented by the rule wrappers carrying a condition.
+type conditionalRule interface {
+	ruleCondition() config.RuleCondition
+}
+
+// WithCommitCondition wraps rule when conditions has an entry for one of its names,
+// typically the configuration key and the rule's Name.
+func WithCommitCondition(rule CommitRule, conditions map[string]config.RuleCondition, names ...string) CommitRule {
+	if condition, found := lookupCondition(conditions, names); found {
+		return ConditionalCommitRule{CommitRule: rule, Condition: condition}
+	}
+
+	return rule
+}
+
+// WithRepositoryCondition wraps rule when conditions has an entry for one of its names.
+func WithRepositoryCondition(rule RepositoryRule, conditions map[string]config.RuleCondition, names ...string) RepositoryRule {
+	if condition, found := lookupCondition(conditions, names); found {
+		return ConditionalRepositoryRule{RepositoryRule: rule, Condition: condition}
+	}
+
+	return rule
+}
+
+// lookupCondition finds the condition configured for any of the names, ignoring case.
+func lookupCondition(conditions map[string]config.RuleCondition, names []string) (config.RuleCondition, bool) {
+	for key, condition := range conditions {
+		for _, name := range names {
+			if CleanRuleName(key) == CleanRuleName(name) {
+				return condition, true
+			}
+		}
+	}
+
+	return config.RuleCondition{}, false
+}
+
+// ApplicableRules returns the rules that apply to commit, dropping conditional rules whose
+// condition it does not match. The target branch and changed paths are resolved through
+// repo only when a condition needs them.
+func ApplicableRules(commit Commit, commitRules []CommitRule, repoRules []RepositoryRule, repo Repository) ([]CommitRule, []RepositoryRule) {
+	commitRules, repoRules, _ = applicableRules(commit, commitRules, repoRules, repo)
+
+	return commitRules, repoRules
+}
+
+// applicableRules is ApplicableRules that also returns a warning for each rule skipped
+// because its condition needs the changed paths and the partial clone lacks them.
+func applicableRules(commit Commit, commitRules []CommitRule, repoRules []RepositoryRule, repo Repository) ([]CommitRule, []RepositoryRule, []ValidationError) {
+	var conditions []config.RuleCondition
+
+	for _, rule := range commitRules {
+		if conditional, ok := rule.(conditionalRule); ok {
+			conditions = append(conditions, conditional.ruleCondition())
+		}
+	}
+
+	for _, rule := range repoRules {
+		if conditional, ok := rule.(conditionalRule); ok {
+			conditions = append(conditions, conditional.ruleCondition())
+		}
+	}
+
+	if len(conditions) == 0 {
+		return commitRules, repoRules, nil
+	}
+
+	facts := resolveConditionFacts(commit, repo, conditions)
+
+	var warnings []ValidationError
+	if facts.PathsMissing {
+		warnings = append(missingPathWarnings(commitRules, facts), missingPathWarnings(repoRules, facts)...)
+	}
+
+	return filterApplicable(commitRules, facts), filterApplicable(repoRules, facts), warnings
+}
+
+// missingPathWarnings warns about the rules whose condition has path criteria and would
+// otherwise match facts, as they are skipped without the changed paths.
+func missingPathWarnings[R interface{ Name() string }](rules []R, facts ConditionFacts) []ValidationError {
+	var warnings []ValidationError
+
+	for _, rule := range rules {
+		conditional, ok := any(rule).(conditionalRule)
+		if !ok || len(conditional.ruleCondition().Paths) == 0 {
+			continue
+		}
+
+		withoutPaths := conditional.ruleCondition()
+		withoutPaths.Paths = nil
+
+		if !MatchesCondition(withoutPaths, facts) {
+			continue
+		}
+
+		warnings = append(warnings,
+			New(rule.Name(), ErrInsufficientData, "Skipped: the files changed by the commit are missing from this partial clone").
+				WithContextMap(map[string]string{
+					"actual":   "objects missing from partial clone",
+					"expected": "changed paths",
+				}).
+				WithHelp("Fetch the history without a filter, for example with 'git fetch --refetch', to apply rules limited to some paths").
+				WithSeverity(SeverityWarning))
+	}
+
+	return warnings
+}
+
+// filterApplicable keeps the rules without a condition and those whose condition facts match.
+func filterApplicable[R any](rules []R, facts ConditionFacts) []R {
+	applicable := make([]R, 0, len(rules))
+
+	for _, rule := range rules {
+		if conditional, ok := any(rule).(conditionalRule); ok && !MatchesCondition(conditional.ruleCondition(), facts) {
+			continue
+		}
+
+		applicable = append(applicable, rule)
+	}
+
+	return applicable
+}
+
+// resolveConditionFacts gathers the facts of a commit, querying the repository for the
+// branch and paths only when one of the conditions has criteria on them.
+func resolveConditionFacts(commit Commit, repo Repository, conditions []config.RuleCondition) ConditionFacts {
+	facts := ConditionFacts{Type: ParseConventionalCommit(commit.Subject).Type}
+
+	if commit.AuthorEmail != "" {
+		facts.Author = commit.Mailmap.Resolve(NewIdentity(commit.Author, commit.AuthorEmail)).Email()
+	}
+
+	needsBranch, needsPaths := false, false
+
+	for _, condition := range conditions {
+		needsBranch = needsBranch || len(condition.Branches) > 0
+		needsPaths = needsPaths || len(condition.Paths) > 0
+	}
+
+	ctx := context.Background()
+
+	if resolver, ok := repo.(BranchResolver); ok && needsBranch {
+		if branch, err := resolver.GetTargetBranch(ctx); err == nil {
+			facts.Branch = branch
+		}
+	}
+
+	if resolver, ok := repo.(PathResolver); ok && needsPaths {
+		var (
+			paths []string
+			err   error
+		)
+
+		// A message being written has no commit yet; its files are in the index
+		if commit.Hash == "" {
+			paths, err = resolver.GetStagedPaths(ctx)
+		} else {
+			paths, err = resolver.GetChangedPaths(ctx, commit.Hash)
+		}
+
+		if err == nil {
+			facts.Paths = paths
+		}
+
+		facts.PathsMissing = errors.Is(err, ErrMissingObjects)
+	}
+
+	return facts
+}
@@ -0,0 +1,197 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package domain_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/itiquette/gommitlint/internal/domain"
+	"github.com/itiquette/gommitlint/internal/domain/config"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMatchesCondition(t *testing.T) {
+	facts := domain.ConditionFacts{
+		Branch: "release/1.2",
+		Type:   "feat",
+		Author: "dev@example.com",
+		Paths:  []string{"cmd/main.go", "docs/guide/intro.md"},
+	}
+
+	tests := []struct {
+		name      string
+		condition config.RuleCondition
+		facts     domain.ConditionFacts
+		expected  bool
+	}{
+		{
+			name:     "empty condition",
+			expected: true,
+		},
+		{
+			name:      "branch glob",
+			condition: config.RuleCondition{Branches: []string{"main", "release/*"}},
+			expected:  true,
+		},
+		{
+			name:      "branch mismatch",
+			condition: config.RuleCondition{Branches: []string{"main"}},
+		},
+		{
+			name:      "type ignores case",
+			condition: config.RuleCondition{Types: []string{"FEAT", "fix"}},
+			expected:  true,
+		},
+		{
+			name:      "author domain glob",
+			condition: config.RuleCondition{Authors: []string{"*@example.com"}},
+			expected:  true,
+		},
+		{
+			name:      "author identity",
+			condition: config.RuleCondition{Authors: []string{"Dev Eloper <DEV@example.com>"}},
+			expected:  true,
+		},
+		{
+			name:      "directory pattern",
+			condition: config.RuleCondition{Paths: []string{"docs/**"}},
+			expected:  true,
+		},
+		{
+			name:      "file name pattern in any directory",
+			condition: config.RuleCondition{Paths: []string{"*.go"}},
+			expected:  true,
+		},
+		{
+			name:      "path mismatch",
+			condition: config.RuleCondition{Paths: []string{"internal/**", "*.rs"}},
+		},
+		{
+			name:      "all criteria must match",
+			condition: config.RuleCondition{Branches: []string{"release/*"}, Types: []string{"fix"}},
+		},
+		{
+			name:      "unknown branch matches no pattern",
+			condition: config.RuleCondition{Branches: []string{"*"}},
+			facts:     domain.ConditionFacts{Type: "feat"},
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			testFacts := facts
+			if testCase.facts.Type != "" {
+				testFacts = testCase.facts
+			}
+
+			require.Equal(t, testCase.expected, domain.MatchesCondition(testCase.condition, testFacts))
+		})
+	}
+}
+
+// conditionRepository resolves the branch and paths used by rule conditions.
+type conditionRepository struct {
+	domain.Repository
+
+	branch   string
+	paths    []string
+	staged   []string
+	pathsErr error
+}
+
+func (r conditionRepository) GetTargetBranch(_ context.Context) (string, error) {
+	return r.branch, nil
+}
+
+func (r conditionRepository) GetChangedPaths(_ context.Context, _ string) ([]string, error) {
+	return r.paths, r.pathsErr
+}
+
+func (r conditionRepository) GetStagedPaths(_ context.Context) ([]string, error) {
+	return r.staged, nil
+}
+
+// failingRule reports one error for every commit it validates.
+type failingRule struct {
+	name string
+}
+
+func (r failingRule) Name() string {
+	return r.name
+}
+
+func (r failingRule) Validate(_ domain.Commit, _ config.Config) []domain.ValidationError {
+	return []domain.ValidationError{domain.New(r.name, domain.ErrInvalidFormat, "failed")}
+}
+
+func TestValidateCommit_RuleConditions(t *testing.T) {
+	conditions := map[string]config.RuleCondition{
+		"jira":      {Branches: []string{"release/*"}},
+		"signature": {Types: []string{"feat", "fix"}},
+		"docs":      {Paths: []string{"docs/**"}},
+	}
+
+	commitRules := []domain.CommitRule{
+		domain.WithCommitCondition(failingRule{name: "Jira"}, conditions, "jira"),
+		domain.WithCommitCondition(failingRule{name: "Signature"}, conditions, "signature"),
+		domain.WithCommitCondition(failingRule{name: "Docs"}, conditions, "docs"),
+		domain.WithCommitCondition(failingRule{name: "Subject"}, conditions, "subject"),
+	}
+
+	repo := conditionRepository{branch: "release/2.0", paths: []string{"cmd/main.go"}, staged: []string{"docs/index.md"}}
+	commit := domain.NewCommit("abc123", "docs: update guide", "Dev", "dev@example.com", "", "", false)
+
+	result := domain.ValidateCommit(commit, commitRules, nil, repo, config.NewDefault())
+	require.Equal(t, []string{"Jira", "Subject"}, failedRules(result))
+	require.Equal(t, map[string]bool{"Signature": true, "Docs": true}, result.NotApplicable)
+
+	// A message being written is matched against the staged files
+	result, err := domain.ValidateMessageInRepository("feat: add guide", commitRules, repo, config.NewDefault())
+	require.NoError(t, err)
+	require.Equal(t, []string{"Jira", "Signature", "Docs", "Subject"}, failedRules(result))
+	require.Nil(t, result.NotApplicable)
+
+	// Without a repository the branch and paths are unknown
+	result, err = domain.ValidateMessage("feat: add guide", commitRules, config.NewDefault())
+	require.NoError(t, err)
+	require.Equal(t, []string{"Signature", "Subject"}, failedRules(result))
+}
+
+func TestValidateCommit_PathsMissingFromPartialClone(t *testing.T) {
+	conditions := map[string]config.RuleCondition{
+		"docs":    {Paths: []string{"docs/**"}},
+		"release": {Branches: []string{"release/*"}, Paths: []string{"docs/**"}},
+	}
+
+	commitRules := []domain.CommitRule{
+		domain.WithCommitCondition(failingRule{name: "Docs"}, conditions, "docs"),
+		domain.WithCommitCondition(failingRule{name: "Release"}, conditions, "release"),
+		failingRule{name: "Subject"},
+	}
+
+	repo := conditionRepository{
+		branch:   "main",
+		pathsErr: fmt.Errorf("get commit tree: %w", domain.ErrMissingObjects),
+	}
+	commit := domain.NewCommit("abc123", "docs: update guide", "Dev", "dev@example.com", "", "", false)
+
+	// Rules limited to some paths are skipped with a warning, unless the rest of the
+	// condition rules them out anyway
+	result := domain.ValidateCommit(commit, commitRules, nil, repo, config.NewDefault())
+	require.Equal(t, []string{"Subject", "Docs"}, failedRules(result))
+	require.Equal(t, string(domain.ErrInsufficientData), result.Errors[1].Code)
+	require.Equal(t, domain.SeverityWarning, result.Errors[1].Severity)
+}
+
+func failedRules(result domain.ValidationResult) []string {
+	rules := make([]string, 0, len(result.Errors))
+	for _, err := range result.Errors {
+		rules = append(rules, err.Rule)
+	}
+
+	return rules
+}
@@ -4,22 +4,49 @@
 
 package config
 
+import (
+	"fmt"
+	"math"
+	"path"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+	"text/template"
+	"time"
+	"unicode"
+)
+
 // NewDefault creates a configuration with sensible defaults.
 func NewDefault() Config {
 	return Config{
 		Message: MessageConfig{
 			Subject: SubjectConfig{
 				MaxLength:         72,
+				LengthMode:        "runes",
 				Case:              "sentence",
 				RequireImperative: false,
-				ForbidEndings:     []string{".", "!", "?"},
+				Imperative: ImperativeConfig{
+					Mode:             "heuristic",
+					Verbs:            []string{},
+					Language:         "en",
+					SkipNonASCII:     false,
+					CustomExceptions: []string{},
+					CustomNonVerbs:   []string{},
+				},
+				ForbidEndings:    []string{".", "!", "?"},
+				ExcludedPrefixes: []string{},
 			},
 			Body: BodyConfig{
-				Required:         false,
-				MinLength:        0,
-				AllowSignoffOnly: false,
-				MinSignoffCount:  0,
+				Required:           false,
+				MinLength:          0,
+				AllowSignoffOnly:   false,
+				MinSignoffCount:    0,
+				MinWords:           0,
+				MinParagraphs:      0,
+				MinParagraphsTypes: []string{},
 			},
+			Parser: "git",
 		},
 		Conventional: ConventionalConfig{
 			RequireScope:         false,
@@ -27,18 +54,29 @@ func NewDefault() Config {
 			Scopes:               []string{},
 			AllowBreaking:        true,
 			MaxDescriptionLength: 72,
+			ScopeCase:            "",
+			MaxScopeLength:       0,
+			ScopeCharacters:      "a-z0-9/-",
+			MaxScopes:            0,
+			TypeAliases:          map[string]string{},
+			AliasMode:            "warn",
 		},
 		Signature: SignatureConfig{
-			Required:       false,
-			VerifyFormat:   false,
-			KeyDirectory:   "",
-			AllowedSigners: []string{},
+			Required:          false,
+			VerifyFormat:      false,
+			KeyDirectory:      "",
+			AllowedSigners:    []string{},
+			MinRSABits:        2048,
+			MinECBits:         256,
+			AllowedAlgorithms: []string{"rsa", "ecdsa", "ed25519"},
+			AllowSHA1:         false,
 		},
 		Identity: IdentityConfig{
 			AllowedAuthors: []string{},
 		},
 		Repo: RepoConfig{
 			MaxCommitsAhead:   0, // 0 means disabled
+			MaxCommitsBehind:  0, // 0 means disabled
 			ReferenceBranch:   "main",
 			AllowMergeCommits: true,
 		},
@@ -47,16 +85,117 @@ func NewDefault() Config {
 			RequireInBody:        false,
 			RequireInSubject:     false,
 			IgnoreTicketPatterns: []string{},
+			Online: JiraOnlineConfig{
+				Enabled:     false,
+				SprintField: "customfield_10020",
+				Timeout:     "5s",
+				CacheTTL:    "1h",
+			},
 		},
 		Spell: SpellConfig{
 			IgnoreWords: []string{},
 			Locale:      "en_US",
 		},
+		Trailers: TrailersConfig{
+			Allowed: []string{
+				"Signed-off-by", "Co-authored-by", "Reviewed-by", "Acked-by", "Tested-by",
+				"Reported-by", "Suggested-by", "Helped-by", "Cc", "Fixes", "Closes",
+				"Resolves", "Refs", "Link", "Change-Id", "BREAKING CHANGE",
+			},
+			Unique:         []string{"Change-Id", "BREAKING CHANGE"},
+			Order:          []string{},
+			RequiredByType: map[string][]string{},
+			Patterns:       map[string]string{},
+		},
+		Characters: CharactersConfig{
+			AllowControl:   false,
+			AllowZeroWidth: false,
+			AllowBidi:      false,
+			ASCIIOnly:      false,
+			AllowedScripts: []string{},
+		},
+		Links: LinksConfig{
+			RequireHTTPS:   false,
+			AllowedDomains: []string{},
+			Forbid:         false,
+		},
+		Placeholders: PlaceholdersConfig{
+			Patterns: []string{},
+		},
+		Language: LanguageConfig{
+			Languages: []string{"en"},
+			MinLength: 40,
+		},
+		Issues: IssuesConfig{
+			Repository:  "",
+			RequireOpen: false,
+			Timeout:     "5s",
+			CacheTTL:    "1h",
+		},
+		BranchTicket: BranchTicketConfig{
+			Pattern:          "[A-Za-z][A-Za-z0-9]+-[0-9]+",
+			RequireReference: false,
+		},
+		SubjectPattern: SubjectPatternConfig{
+			Pattern: "",
+			Message: "",
+			Groups:  map[string]string{},
+		},
+		MergeSubject: MergeSubjectConfig{
+			// The subjects git, GitHub and GitLab write for merges
+			Patterns: []string{
+				`^Merge branch '[^']+'( of \S+)?( into \S+)?$`,
+				`^Merge branches '[^']+'(, '[^']+')* and '[^']+'( into \S+)?$`,
+				`^Merge remote-tracking branch '[^']+'( into \S+)?$`,
+				`^Merge tag '[^']+'( of \S+)?( into \S+)?$`,
+				`^Merge pull request #[0-9]+ from \S+$`,
+			},
+		},
+		BreakingChange: BreakingChangeConfig{
+			MinBodyLength:    0,
+			RequireMigration: false,
+			Branches:         []string{},
+			RequiredTrailers: []string{},
+		},
+		Revert: RevertConfig{
+			Format:      "any",
+			ExemptRules: []string{},
+		},
+		Fixup: FixupConfig{
+			ProtectedBranches: []string{},
+		},
+		ForcePush: ForcePushConfig{
+			Upstream: "",
+			Branches: []string{},
+		},
+		CommitSize: CommitSizeConfig{
+			MaxLines: 500,
+			MaxFiles: 0,
+		},
+		CLA: CLAConfig{
+			Offline:  "warn",
+			Timeout:  "5s",
+			CacheTTL: "24h",
+		},
+		Review: ReviewConfig{
+			Branches:  []ReviewBranchConfig{},
+			Reviewers: []string{},
+		},
+		ChangeID: ChangeIDConfig{
+			Mode: "require",
+		},
 		Rules: RulesConfig{
-			Enabled:  []string{},
-			Disabled: []string{},
+			Enabled:         []string{},
+			Disabled:        []string{},
+			RelatedFailures: "deduplicate",
+			Conditions:      map[string]RuleCondition{},
 		},
-		Output: "text",
+		Plugins:     []PluginConfig{},
+		CustomRules: []CustomRuleConfig{},
+		Messages:    MessagesConfig{},
+		Profiles:    map[string]ProfileConfig{},
+		Display:     DisplayConfig{Theme: "default"},
+		Output:      "text",
 	}
 }
 
@@ -69,13 +208,267 @@ func (c Config) Validate() []string {
 		errors = append(errors, "subject max_length must be positive")
 	}
 
+	// Validate the message parser
+	switch c.Message.Parser {
+	case "", "git", "gerrit", "gitmoji":
+	default:
+		errors = append(errors, "message parser must be one of: git, gerrit, gitmoji")
+	}
+
+	// Validate subject length mode
+	switch c.Message.Subject.LengthMode {
+	case "", "runes", "graphemes", "display-width":
+	default:
+		errors = append(errors, "subject length_mode must be one of: runes, graphemes, display-width")
+	}
+
+	// Validate the scope format policy
+	switch c.Conventional.ScopeCase {
+	case "", "lower", "kebab", "camel":
+	default:
+		errors = append(errors, "conventional scope_case must be one of: lower, kebab, camel")
+	}
+
+	if c.Conventional.MaxScopeLength < 0 || c.Conventional.MaxScopes < 0 {
+		errors = append(errors, "conventional max_scope_length and max_scopes cannot be negative")
+	}
+
+	if c.Conventional.ScopeCharacters != "" {
+		if _, err := regexp.Compile("^[" + c.Conventional.ScopeCharacters + "]+$"); err != nil {
+			errors = append(errors, fmt.Sprintf("conventional scope_characters is not a valid bracket expression: %v", err))
+		}
+	}
+
+	// Validate the type aliases, which must name an allowed type
+	switch c.Conventional.AliasMode {
+	case "", "accept", "warn", "reject":
+	default:
+		errors = append(errors, "conventional alias_mode must be one of: accept, warn, reject")
+	}
+
+	for _, alias := range sortedKeys(c.Conventional.TypeAliases) {
+		if canonical := c.Conventional.TypeAliases[alias]; !slices.Contains(c.Conventional.Types, canonical) {
+			errors = append(errors, fmt.Sprintf("conventional type_aliases: '%s' maps to '%s', which is not in types", alias, canonical))
+		}
+
+		if slices.Contains(c.Conventional.Types, alias) {
+			errors = append(errors, fmt.Sprintf("conventional type_aliases: '%s' is already an allowed type", alias))
+		}
+	}
+
+	// Validate imperative mode, language and word lists
+	switch c.Message.Subject.Imperative.Mode {
+	case "", "heuristic", "allowlist":
+	default:
+		errors = append(errors, "subject imperative mode must be one of: heuristic, allowlist")
+	}
+
+	switch c.Message.Subject.Imperative.Language {
+	case "", "en", "es", "fr", "sv":
+	default:
+		errors = append(errors, "subject imperative language must be one of: en, es, fr, sv")
+	}
+
+	for _, word := range c.Message.Subject.Imperative.CustomExceptions {
+		if slices.ContainsFunc(c.Message.Subject.Imperative.CustomNonVerbs, func(nonVerb string) bool {
+			return strings.EqualFold(nonVerb, word)
+		}) {
+			errors = append(errors, fmt.Sprintf("subject imperative word %q cannot be both a custom exception and a custom non-verb", word))
+		}
+	}
+
+	// Validate body structure
+	if c.Message.Body.MinWords < 0 || c.Message.Body.MinParagraphs < 0 {
+		errors = append(errors, "body min_words and min_paragraphs cannot be negative")
+	}
+
+	// Validate the limit on commits behind the reference branch
+	if c.Repo.MaxCommitsBehind < 0 {
+		errors = append(errors, "repo max_commits_behind cannot be negative")
+	}
+
 	// Validate conventional types
 	if len(c.Conventional.Types) == 0 {
 		errors = append(errors, "conventional types cannot be empty")
 	}
 
+	// Validate allowed scripts
+	for _, script := range c.Characters.AllowedScripts {
+		if _, ok := unicode.Scripts[script]; !ok {
+			errors = append(errors, fmt.Sprintf("characters allowed_scripts: unknown Unicode script '%s'", script))
+		}
+	}
+
+	// Validate the JIRA online mode, whose requests must be bounded
+	if c.Jira.Online.Enabled && !strings.HasPrefix(c.Jira.Online.URL, "https://") && !strings.HasPrefix(c.Jira.Online.URL, "http://") {
+		errors = append(errors, "jira online url must be an http or https URL when online checks are enabled")
+	}
+
+	if c.Jira.Online.Timeout != "" {
+		if timeout, err := time.ParseDuration(c.Jira.Online.Timeout); err != nil || timeout <= 0 {
+			errors = append(errors, "jira online timeout must be a positive duration such as 5s")
+		}
+	}
+
+	if c.Jira.Online.CacheTTL != "" {
+		if ttl, err := time.ParseDuration(c.Jira.Online.CacheTTL); err != nil || ttl < 0 {
+			errors = append(errors, "jira online cache_ttl must be a duration such as 1h, or 0s to disable the cache")
+		}
+	}
+
+	// Validate the GitHub issue lookups
+	if repository := c.Issues.Repository; repository != "" && strings.Count(repository, "/") != 1 {
+		errors = append(errors, fmt.Sprintf("issues repository '%s' must be in owner/repo form", repository))
+	}
+
+	if c.Issues.Timeout != "" {
+		if timeout, err := time.ParseDuration(c.Issues.Timeout); err != nil || timeout <= 0 {
+			errors = append(errors, "issues timeout must be a positive duration such as 5s")
+		}
+	}
+
+	if c.Issues.CacheTTL != "" {
+		if ttl, err := time.ParseDuration(c.Issues.CacheTTL); err != nil || ttl < 0 {
+			errors = append(errors, "issues cache_ttl must be a duration such as 1h, or 0s to disable the cache")
+		}
+	}
+
+	// Validate the branch ticket pattern
+	if _, err := regexp.Compile(c.BranchTicket.Pattern); err != nil {
+		errors = append(errors, fmt.Sprintf("branch_ticket pattern is not a valid regular expression: %v", err))
+	}
+
+	// Validate the subject pattern and the groups given messages
+	if c.SubjectPattern.Pattern != "" {
+		if pattern, err := regexp.Compile(c.SubjectPattern.Pattern); err != nil {
+			errors = append(errors, fmt.Sprintf("subject_pattern pattern is not a valid regular expression: %v", err))
+		} else {
+			for _, group := range sortedKeys(c.SubjectPattern.Groups) {
+				if pattern.SubexpIndex(group) < 0 {
+					errors = append(errors, fmt.Sprintf("subject_pattern groups: '%s' is not a named group of the pattern", group))
+				}
+			}
+		}
+	}
+
+	// Validate the excluded subject prefixes
+	for _, pattern := range c.Message.Subject.ExcludedPrefixes {
+		if _, err := regexp.Compile(pattern); err != nil {
+			errors = append(errors, fmt.Sprintf("message subject excluded_prefixes: '%s' is not a valid regular expression: %v", pattern, err))
+		}
+	}
+
+	// Validate the placeholder patterns
+	for _, pattern := range c.Placeholders.Patterns {
+		if _, err := regexp.Compile(pattern); err != nil {
+			errors = append(errors, fmt.Sprintf("placeholders patterns: '%s' is not a valid regular expression: %v", pattern, err))
+		}
+	}
+
+	// Validate the message languages
+	for _, language := range c.Language.Languages {
+		switch language {
+		case "de", "en", "es", "fr", "it", "nl", "pt", "sv":
+		default:
+			errors = append(errors, fmt.Sprintf("language languages: '%s' must be one of: de, en, es, fr, it, nl, pt, sv", language))
+		}
+	}
+
+	if c.Language.MinLength < 0 {
+		errors = append(errors, "language min_length must not be negative")
+	}
+
+	// Validate the trailer value patterns
+	for _, key := range sortedKeys(c.Trailers.Patterns) {
+		if _, err := regexp.Compile(c.Trailers.Patterns[key]); err != nil {
+			errors = append(errors, fmt.Sprintf("trailers patterns: '%s' for %s is not a valid regular expression: %v", c.Trailers.Patterns[key], key, err))
+		}
+	}
+
+	// Validate the merge subject patterns
+	for _, pattern := range c.MergeSubject.Patterns {
+		if _, err := regexp.Compile(pattern); err != nil {
+			errors = append(errors, fmt.Sprintf("merge_subject patterns: '%s' is not a valid regular expression: %v", pattern, err))
+		}
+	}
+
+	// Validate the breaking change requirements
+	if c.BreakingChange.MinBodyLength < 0 {
+		errors = append(errors, "breaking_change min_body_length cannot be negative")
+	}
+
+	for _, branch := range c.BreakingChange.Branches {
+		if _, err := path.Match(branch, ""); err != nil {
+			errors = append(errors, fmt.Sprintf("breaking_change branches: '%s' is not a valid glob", branch))
+		}
+	}
+
+	// Validate the revert format
+	switch c.Revert.Format {
+	case "", "any", "git", "conventional":
+	default:
+		errors = append(errors, "revert format must be one of: any, git, conventional")
+	}
+
+	for _, branch := range c.Fixup.ProtectedBranches {
+		if _, err := path.Match(branch, ""); err != nil {
+			errors = append(errors, fmt.Sprintf("fixup protected_branches: '%s' is not a valid glob", branch))
+		}
+	}
+
+	for _, branch := range c.ForcePush.Branches {
+		if _, err := path.Match(branch, ""); err != nil {
+			errors = append(errors, fmt.Sprintf("force_push branches: '%s' is not a valid glob", branch))
+		}
+	}
+
+	if c.CommitSize.MaxLines < 0 {
+		errors = append(errors, "commit_size max_lines cannot be negative")
+	}
+
+	if c.CommitSize.MaxFiles < 0 {
+		errors = append(errors, "commit_size max_files cannot be negative")
+	}
+
+	// Validate the CLA lookups
+	switch c.CLA.Offline {
+	case "", "warn", "fail", "allow":
+	default:
+		errors = append(errors, fmt.Sprintf("cla offline '%s' must be one of warn, fail or allow", c.CLA.Offline))
+	}
+
+	if rawURL := c.CLA.URL; rawURL != "" && !strings.HasPrefix(rawURL, "https://") && !strings.HasPrefix(rawURL, "http://") {
+		errors = append(errors, fmt.Sprintf("cla url '%s' must be an http or https URL", rawURL))
+	}
+
+	if c.CLA.Timeout != "" {
+		if timeout, err := time.ParseDuration(c.CLA.Timeout); err != nil || timeout <= 0 {
+			errors = append(errors, "cla timeout must be a positive duration such as 5s")
+		}
+	}
+
+	if c.CLA.CacheTTL != "" {
+		if ttl, err := time.ParseDuration(c.CLA.CacheTTL); err != nil || ttl < 0 {
+			errors = append(errors, "cla cache_ttl must be a duration such as 24h, or 0s to disable the cache")
+		}
+	}
+
+	// Validate the Change-Id mode
+	switch c.ChangeID.Mode {
+	case "", "require", "forbid":
+	default:
+		errors = append(errors, fmt.Sprintf("change_id mode '%s' must be require or forbid", c.ChangeID.Mode))
+	}
+
+	// Validate allowed link domains, which are host names and not URLs
+	for _, domain := range c.Links.AllowedDomains {
+		if domain == "" || strings.ContainsAny(domain, "/:") {
+			errors = append(errors, fmt.Sprintf("links allowed_domains: '%s' is not a host name", domain))
+		}
+	}
+
 	// Validate output format
-	validOutputs := []string{"text", "json", "github", "gitlab"}
+	validOutputs := []string{"text", "json", "github", "gitlab", "html", "csv", "tsv", "patchwork", "gerrit"}
 	isValidOutput := false
 
 	for _, valid := range validOutputs {
@@ -87,8 +480,194 @@ func (c Config) Validate() []string {
 	}
 
 	if !isValidOutput {
-		errors = append(errors, "output must be one of: text, json, github, gitlab")
+		errors = append(errors, "output must be one of: "+strings.Join(validOutputs, ", "))
+	}
+
+	// Validate display theme
+	validThemes := []string{"default", "high-contrast", "monochrome"}
+	if !slices.Contains(validThemes, c.Display.Theme) {
+		errors = append(errors, "display theme must be one of: "+strings.Join(validThemes, ", "))
+	}
+
+	// Validate key strength policy
+	if c.Signature.MinRSABits <= 0 || c.Signature.MinRSABits > math.MaxUint16 {
+		errors = append(errors, fmt.Sprintf("signature min_rsa_bits must be between 1 and %d", math.MaxUint16))
+	}
+
+	if c.Signature.MinECBits <= 0 || c.Signature.MinECBits > math.MaxUint16 {
+		errors = append(errors, fmt.Sprintf("signature min_ec_bits must be between 1 and %d", math.MaxUint16))
+	}
+
+	for _, algorithm := range c.Signature.AllowedAlgorithms {
+		if !slices.Contains(SignatureAlgorithms, strings.ToLower(algorithm)) {
+			errors = append(errors, fmt.Sprintf("signature allowed_algorithms has unknown algorithm %q, known: %s",
+				algorithm, strings.Join(SignatureAlgorithms, ", ")))
+		}
+	}
+
+	// Validate key scopes
+	scopeNames := make(map[string]bool, len(c.Identity.KeyScopes))
+
+	for i, scope := range c.Identity.KeyScopes {
+		switch name := strings.ToLower(scope.Name); {
+		case scope.Name == "":
+			errors = append(errors, fmt.Sprintf("identity key scope %d must have a name", i+1))
+		case scopeNames[name]:
+			errors = append(errors, "duplicate identity key scope name: "+scope.Name)
+		default:
+			scopeNames[name] = true
+		}
+
+		if scope.Directory == "" {
+			errors = append(errors, fmt.Sprintf("identity key scope %d must have a directory", i+1))
+		}
+
+		if len(scope.Authors) == 0 && len(scope.Paths) == 0 {
+			errors = append(errors, fmt.Sprintf("identity key scope %d must have authors or paths", i+1))
+		}
+	}
+
+	// Validate plugins
+	pluginNames := make(map[string]bool, len(c.Plugins))
+
+	for i, plugin := range c.Plugins {
+		if plugin.Name == "" {
+			errors = append(errors, fmt.Sprintf("plugin %d must have a name", i+1))
+		} else if pluginNames[strings.ToLower(plugin.Name)] {
+			errors = append(errors, "duplicate plugin name: "+plugin.Name)
+		}
+
+		pluginNames[strings.ToLower(plugin.Name)] = true
+
+		if (plugin.Command == "") == (plugin.Wasm == "") {
+			errors = append(errors, fmt.Sprintf("plugin %d must have exactly one of command or wasm", i+1))
+		}
+
+		if plugin.Timeout != "" {
+			if timeout, err := time.ParseDuration(plugin.Timeout); err != nil || timeout <= 0 {
+				errors = append(errors, fmt.Sprintf("plugin %d timeout must be a positive duration such as 5s", i+1))
+			}
+		}
+
+		if plugin.MemoryLimitMB < 0 {
+			errors = append(errors, fmt.Sprintf("plugin %d memory_limit_mb cannot be negative", i+1))
+		}
+	}
+
+	// Validate custom rules
+	customNames := make(map[string]bool, len(c.CustomRules))
+
+	for i, rule := range c.CustomRules {
+		name := strings.ToLower(rule.Name)
+
+		switch {
+		case rule.Name == "":
+			errors = append(errors, fmt.Sprintf("custom rule %d must have a name", i+1))
+		case customNames[name] || pluginNames[name]:
+			errors = append(errors, "duplicate custom rule name: "+rule.Name)
+		}
+
+		customNames[name] = true
+
+		if rule.Pattern == "" {
+			errors = append(errors, fmt.Sprintf("custom rule %d must have a pattern", i+1))
+		} else if _, err := regexp.Compile(rule.Pattern); err != nil {
+			errors = append(errors, fmt.Sprintf("custom rule %d has an invalid pattern: %v", i+1, err))
+		}
+
+		switch rule.Target {
+		case "", "subject", "body", "message":
+		default:
+			errors = append(errors, fmt.Sprintf("custom rule %d target must be one of: subject, body, message", i+1))
+		}
+
+		switch rule.Severity {
+		case "", "error", "warning":
+		default:
+			errors = append(errors, fmt.Sprintf("custom rule %d severity must be one of: error, warning", i+1))
+		}
+	}
+
+	// Validate review branches
+	for i, branch := range c.Review.Branches {
+		if branch.Branch == "" {
+			errors = append(errors, fmt.Sprintf("review branch %d must have a branch name or pattern", i+1))
+		} else if _, err := path.Match(branch.Branch, ""); err != nil {
+			errors = append(errors, fmt.Sprintf("review branch %d has an invalid pattern: %v", i+1, err))
+		}
+
+		if branch.MinReviewedBy < 0 || branch.MinAckedBy < 0 {
+			errors = append(errors, fmt.Sprintf("review branch %d minimum counts cannot be negative", i+1))
+		}
+	}
+
+	// Validate related failure reporting
+	switch c.Rules.RelatedFailures {
+	case "", "deduplicate", "verbose":
+	default:
+		errors = append(errors, "rules related_failures must be one of: deduplicate, verbose")
+	}
+
+	// Validate rule conditions
+	for _, rule := range sortedConditionRules(c.Rules.Conditions) {
+		condition := c.Rules.Conditions[rule]
+
+		for _, pattern := range slices.Concat(condition.Branches, condition.Authors, condition.Paths) {
+			if _, err := path.Match(pattern, ""); err != nil {
+				errors = append(errors, fmt.Sprintf("rules.conditions.%s has an invalid pattern %q: %v", rule, pattern, err))
+			}
+		}
+	}
+
+	// Validate message templates
+	for _, rule := range sortedTemplateRules(c.Messages) {
+		templates := c.Messages[rule]
+
+		if _, err := template.New(rule).Parse(templates.Message); err != nil {
+			errors = append(errors, fmt.Sprintf("messages.%s.message is not a valid template: %v", rule, err))
+		}
+
+		if _, err := template.New(rule).Parse(templates.Help); err != nil {
+			errors = append(errors, fmt.Sprintf("messages.%s.help is not a valid template: %v", rule, err))
+		}
 	}
 
 	return errors
 }
+
+// sortedConditionRules returns the rule names of the conditions in a stable order.
+func sortedConditionRules(conditions map[string]RuleCondition) []string {
+	rules := make([]string, 0, len(conditions))
+	for rule := range conditions {
+		rules = append(rules, rule)
+	}
+
+	sort.Strings(rules)
+
+	return rules
+}
+
+// sortedTemplateRules returns the rule names of the message templates in a stable order.
+func sortedTemplateRules(messages MessagesConfig) []string {
+	rules := make([]string, 0, len(messages))
+	for rule := range messages {
+		rules = append(rules, rule)
+	}
+
+	sort.Strings(rules)
+
+	return rules
+}
+
+// sortedKeys returns the keys of values, such as the groups of the subject pattern
+// messages, in a stable order.
+func sortedKeys(values map[string]string) []string {
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
@@ -8,74 +8,184 @@ package config
 
 // Config represents the complete configuration for gommitlint.
 type Config struct {
-	Message      MessageConfig      `json:"message"      toml:"message"      yaml:"message"`
-	Conventional ConventionalConfig `json:"conventional" toml:"conventional" yaml:"conventional"`
-	Signature    SignatureConfig    `json:"signature"    toml:"signature"    yaml:"signature"`
-	Identity     IdentityConfig     `json:"identity"     toml:"identity"     yaml:"identity"`
-	Repo         RepoConfig         `json:"repo"         toml:"repo"         yaml:"repo"`
-	Jira         JiraConfig         `json:"jira"         toml:"jira"         yaml:"jira"`
-	Spell        SpellConfig        `json:"spell"        toml:"spell"        yaml:"spell"`
-	Rules        RulesConfig        `json:"rules"        toml:"rules"        yaml:"rules"`
-	Output       string             `json:"output"       toml:"output"       yaml:"output"`
+	Message        MessageConfig            `json:"message"         toml:"message"         yaml:"message"`
+	Conventional   ConventionalConfig       `json:"conventional"    toml:"conventional"    yaml:"conventional"`
+	Signature      SignatureConfig          `json:"signature"       toml:"signature"       yaml:"signature"`
+	Identity       IdentityConfig           `json:"identity"        toml:"identity"        yaml:"identity"`
+	Repo           RepoConfig               `json:"repo"            toml:"repo"            yaml:"repo"`
+	Jira           JiraConfig               `json:"jira"            toml:"jira"            yaml:"jira"`
+	Spell          SpellConfig              `json:"spell"           toml:"spell"           yaml:"spell"`
+	Trailers       TrailersConfig           `json:"trailers"        toml:"trailers"        yaml:"trailers"`
+	Characters     CharactersConfig         `json:"characters"      toml:"characters"      yaml:"characters"`
+	Links          LinksConfig              `json:"links"           toml:"links"           yaml:"links"`
+	Placeholders   PlaceholdersConfig       `json:"placeholders"    toml:"placeholders"    yaml:"placeholders"`
+	Language       LanguageConfig           `json:"language"        toml:"language"        yaml:"language"`
+	Issues         IssuesConfig             `json:"issues"          toml:"issues"          yaml:"issues"`
+	BranchTicket   BranchTicketConfig       `json:"branch_ticket"   toml:"branch_ticket"   yaml:"branch_ticket"`
+	SubjectPattern SubjectPatternConfig     `json:"subject_pattern" toml:"subject_pattern" yaml:"subject_pattern"`
+	MergeSubject   MergeSubjectConfig       `json:"merge_subject"   toml:"merge_subject"   yaml:"merge_subject"`
+	BreakingChange BreakingChangeConfig     `json:"breaking_change" toml:"breaking_change" yaml:"breaking_change"`
+	Revert         RevertConfig             `json:"revert"          toml:"revert"          yaml:"revert"`
+	Fixup          FixupConfig              `json:"fixup"           toml:"fixup"           yaml:"fixup"`
+	ForcePush      ForcePushConfig          `json:"force_push"      toml:"force_push"      yaml:"force_push"`
+	CommitSize     CommitSizeConfig         `json:"commit_size"     toml:"commit_size"     yaml:"commit_size"`
+	CLA            CLAConfig                `json:"cla"             toml:"cla"             yaml:"cla"`
+	Review         ReviewConfig             `json:"review"          toml:"review"          yaml:"review"`
+	ChangeID       ChangeIDConfig           `json:"change_id"       toml:"change_id"       yaml:"change_id"`
+	Rules          RulesConfig              `json:"rules"           toml:"rules"           yaml:"rules"`
+	Plugins        []PluginConfig           `json:"plugins"         toml:"plugins"         yaml:"plugins"`
+	CustomRules    []CustomRuleConfig       `json:"custom_rules"    toml:"custom_rules"    yaml:"custom_rules"`
+	Messages       MessagesConfig           `json:"messages"        toml:"messages"        yaml:"messages"`
+	I18n           I18nConfig               `json:"i18n"            toml:"i18n"            yaml:"i18n"`
+	Display        DisplayConfig            `json:"display"         toml:"display"         yaml:"display"`
+	Profiles       map[string]ProfileConfig `json:"profiles"        toml:"profiles"        yaml:"profiles"`
+	Output         string                   `json:"output"          toml:"output"          yaml:"output"`
 }
 
+// ProfileConfig holds the settings a named profile overrides, laid out like Config.
+// The values are kept as written so that only the keys a profile sets replace the
+// top-level settings when the profile is selected.
+type ProfileConfig map[string]interface{}
+
 // MessageConfig contains configuration for commit message validation.
 type MessageConfig struct {
-	Subject SubjectConfig `json:"subject" toml:"subject" yaml:"subject"`
-	Body    BodyConfig    `json:"body"    toml:"body"    yaml:"body"`
+	Subject          SubjectConfig `json:"subject"           toml:"subject"           yaml:"subject"`
+	Body             BodyConfig    `json:"body"              toml:"body"              yaml:"body"`
+	FallbackEncoding string        `json:"fallback_encoding" toml:"fallback_encoding" yaml:"fallback_encoding"` // Encoding of message files that are not UTF-8, e.g. "windows-1252"; empty keeps the bytes
+	Parser           string        `json:"parser"            toml:"parser"            yaml:"parser"`            // How messages are split into subject, body and trailers: git, gerrit or gitmoji
 }
 
 // SubjectConfig contains configuration options for commit subject validation.
 type SubjectConfig struct {
-	MaxLength         int      `json:"max_length"         toml:"max_length"         yaml:"max_length"`
-	Case              string   `json:"case"               toml:"case"               yaml:"case"`
-	RequireImperative bool     `json:"require_imperative" toml:"require_imperative" yaml:"require_imperative"`
-	ForbidEndings     []string `json:"forbid_endings"     toml:"forbid_endings"     yaml:"forbid_endings"`
+	MaxLength         int              `json:"max_length"         toml:"max_length"         yaml:"max_length"`
+	LengthMode        string           `json:"length_mode"        toml:"length_mode"        yaml:"length_mode"` // How max_length is counted: runes, graphemes or display-width
+	Case              string           `json:"case"               toml:"case"               yaml:"case"`
+	CaseLocale        string           `json:"case_locale"        toml:"case_locale"        yaml:"case_locale"` // Locale of case mapping, e.g. "tr" or "de"; empty uses Unicode simple case mapping
+	RequireImperative bool             `json:"require_imperative" toml:"require_imperative" yaml:"require_imperative"`
+	Imperative        ImperativeConfig `json:"imperative"         toml:"imperative"         yaml:"imperative"`
+	ForbidEndings     []string         `json:"forbid_endings"     toml:"forbid_endings"     yaml:"forbid_endings"`
+	ExcludedPrefixes  []string         `json:"excluded_prefixes"  toml:"excluded_prefixes"  yaml:"excluded_prefixes"` // Regular expressions of prefixes, such as a gitmoji or ticket, left out of the length and case checks
+}
+
+// ImperativeConfig contains the mode and language of the imperative mood check of
+// require_imperative, and word lists for project vocabulary the built-in lists get wrong.
+type ImperativeConfig struct {
+	Mode             string   `json:"mode"              toml:"mode"              yaml:"mode"`              // How verbs are recognized: heuristic, or allowlist for only the listed verbs
+	Verbs            []string `json:"verbs"             toml:"verbs"             yaml:"verbs"`             // Verbs accepted in allowlist mode; empty uses a bundled list of English verbs
+	Language         string   `json:"language"          toml:"language"          yaml:"language"`          // Language of subjects: en, es, fr or sv
+	SkipNonASCII     bool     `json:"skip_non_ascii"    toml:"skip_non_ascii"    yaml:"skip_non_ascii"`    // Accept first words with letters outside ASCII, e.g. in other languages
+	CustomExceptions []string `json:"custom_exceptions" toml:"custom_exceptions" yaml:"custom_exceptions"` // Words accepted as imperative verbs, e.g. "proceed" or "process"
+	CustomNonVerbs   []string `json:"custom_non_verbs"  toml:"custom_non_verbs"  yaml:"custom_non_verbs"`  // Words rejected as subject starters, e.g. "misc" or "wip"
 }
 
 // BodyConfig contains configuration options for commit body validation.
 type BodyConfig struct {
-	Required         bool `json:"required"           toml:"required"           yaml:"required"`
-	MinLength        int  `json:"min_length"         toml:"min_length"         yaml:"min_length"`
-	AllowSignoffOnly bool `json:"allow_signoff_only" toml:"allow_signoff_only" yaml:"allow_signoff_only"`
-	MinSignoffCount  int  `json:"min_signoff_count"  toml:"min_signoff_count"  yaml:"min_signoff_count"`
+	Required              bool     `json:"required"                toml:"required"                yaml:"required"`
+	MinLength             int      `json:"min_length"              toml:"min_length"              yaml:"min_length"`
+	AllowSignoffOnly      bool     `json:"allow_signoff_only"      toml:"allow_signoff_only"      yaml:"allow_signoff_only"`
+	MinSignoffCount       int      `json:"min_signoff_count"       toml:"min_signoff_count"       yaml:"min_signoff_count"`
+	SignoffMatchAuthor    bool     `json:"signoff_match_author"    toml:"signoff_match_author"    yaml:"signoff_match_author"`
+	SignoffAllowCommitter bool     `json:"signoff_allow_committer" toml:"signoff_allow_committer" yaml:"signoff_allow_committer"`
+	MinWords              int      `json:"min_words"               toml:"min_words"               yaml:"min_words"`               // Minimum words in a body that is present, trailers not counted
+	MinParagraphs         int      `json:"min_paragraphs"          toml:"min_paragraphs"          yaml:"min_paragraphs"`          // Minimum paragraphs of description, trailers not counted
+	MinParagraphsTypes    []string `json:"min_paragraphs_types"    toml:"min_paragraphs_types"    yaml:"min_paragraphs_types"`    // Conventional types min_paragraphs applies to; empty applies it to all commits
+	ForbidRepeatedSubject bool     `json:"forbid_repeated_subject" toml:"forbid_repeated_subject" yaml:"forbid_repeated_subject"` // Reject body lines that repeat the subject
 }
 
 // ConventionalConfig contains configuration options for conventional commit format validation.
 type ConventionalConfig struct {
-	RequireScope         bool     `json:"require_scope"          toml:"require_scope"          yaml:"require_scope"`
-	Types                []string `json:"types"                  toml:"types"                  yaml:"types"`
-	Scopes               []string `json:"scopes"                 toml:"scopes"                 yaml:"scopes"`
-	AllowBreaking        bool     `json:"allow_breaking"         toml:"allow_breaking"         yaml:"allow_breaking"`
-	MaxDescriptionLength int      `json:"max_description_length" toml:"max_description_length" yaml:"max_description_length"`
+	RequireScope         bool              `json:"require_scope"          toml:"require_scope"          yaml:"require_scope"`
+	Types                []string          `json:"types"                  toml:"types"                  yaml:"types"`
+	Scopes               []string          `json:"scopes"                 toml:"scopes"                 yaml:"scopes"`
+	AllowBreaking        bool              `json:"allow_breaking"         toml:"allow_breaking"         yaml:"allow_breaking"`
+	MaxDescriptionLength int               `json:"max_description_length" toml:"max_description_length" yaml:"max_description_length"`
+	ScopeCase            string            `json:"scope_case"             toml:"scope_case"             yaml:"scope_case"`       // Case of scopes: lower, kebab or camel; empty allows any
+	MaxScopeLength       int               `json:"max_scope_length"       toml:"max_scope_length"       yaml:"max_scope_length"` // Maximum characters of one scope; 0 allows any length
+	ScopeCharacters      string            `json:"scope_characters"       toml:"scope_characters"       yaml:"scope_characters"` // Characters allowed in scopes as in a regular expression bracket, e.g. "a-zA-Z0-9_"
+	MaxScopes            int               `json:"max_scopes"             toml:"max_scopes"             yaml:"max_scopes"`       // Maximum scopes of a multi-scope commit such as feat(ui,api); 0 allows any number
+	TypeAliases          map[string]string `json:"type_aliases"           toml:"type_aliases"           yaml:"type_aliases"`     // Alias to canonical type, e.g. "feature": "feat"
+	AliasMode            string            `json:"alias_mode"             toml:"alias_mode"             yaml:"alias_mode"`       // How aliases are treated: accept, warn or reject
 }
 
 // SignatureConfig contains configuration options for cryptographic signature validation.
 type SignatureConfig struct {
-	Required       bool     `json:"required"        toml:"required"        yaml:"required"`
-	VerifyFormat   bool     `json:"verify_format"   toml:"verify_format"   yaml:"verify_format"`
-	KeyDirectory   string   `json:"key_directory"   toml:"key_directory"   yaml:"key_directory"`
-	AllowedSigners []string `json:"allowed_signers" toml:"allowed_signers" yaml:"allowed_signers"`
+	Required          bool     `json:"required"           toml:"required"           yaml:"required"`
+	VerifyFormat      bool     `json:"verify_format"      toml:"verify_format"      yaml:"verify_format"`
+	KeyDirectory      string   `json:"key_directory"      toml:"key_directory"      yaml:"key_directory"`
+	KeyDirectories    []string `json:"key_directories"    toml:"key_directories"    yaml:"key_directories"` // Further directories of trusted keys, searched after key_directory
+	AllowedSigners    []string `json:"allowed_signers"    toml:"allowed_signers"    yaml:"allowed_signers"`
+	MinRSABits        int      `json:"min_rsa_bits"       toml:"min_rsa_bits"       yaml:"min_rsa_bits"`
+	MinECBits         int      `json:"min_ec_bits"        toml:"min_ec_bits"        yaml:"min_ec_bits"`
+	AllowedAlgorithms []string `json:"allowed_algorithms" toml:"allowed_algorithms" yaml:"allowed_algorithms"`
+	AllowSHA1         bool     `json:"allow_sha1"         toml:"allow_sha1"         yaml:"allow_sha1"`
+	SSHAgent          bool     `json:"ssh_agent"          toml:"ssh_agent"          yaml:"ssh_agent"`     // Also trust the SSH keys of the running ssh-agent
+	KnownSigners      string   `json:"known_signers"      toml:"known_signers"      yaml:"known_signers"` // OpenSSH allowed signers file whose keys are also trusted, e.g. ~/.ssh/known_signers
+}
+
+// TrustedKeyDirectories returns key_directory followed by key_directories, leaving out
+// empty entries.
+func (c SignatureConfig) TrustedKeyDirectories() []string {
+	var directories []string
+
+	for _, directory := range append([]string{c.KeyDirectory}, c.KeyDirectories...) {
+		if directory != "" {
+			directories = append(directories, directory)
+		}
+	}
+
+	return directories
+}
+
+// HasTrustedKeys reports whether any trusted keys are configured: key directories, the
+// ssh-agent or a known signers file.
+func (c SignatureConfig) HasTrustedKeys() bool {
+	return len(c.TrustedKeyDirectories()) > 0 || c.SSHAgent || c.KnownSigners != ""
 }
 
+// SignatureAlgorithms lists the public key algorithms accepted in allowed_algorithms.
+var SignatureAlgorithms = []string{"rsa", "dsa", "ecdsa", "ed25519"}
+
 // IdentityConfig contains configuration options for commit author identity validation.
 type IdentityConfig struct {
-	AllowedAuthors []string `json:"allowed_authors" toml:"allowed_authors" yaml:"allowed_authors"`
+	AllowedAuthors []string         `json:"allowed_authors" toml:"allowed_authors" yaml:"allowed_authors"`
+	KeyScopes      []KeyScopeConfig `json:"key_scopes"      toml:"key_scopes"      yaml:"key_scopes"` // Keys that must sign the commits of some authors or paths
+}
+
+// KeyScopeConfig requires the commits of the matching authors, or changing the matching
+// paths, to be signed by a key in Directory. At least one of Authors and Paths must be set.
+type KeyScopeConfig struct {
+	Name      string   `json:"name"      toml:"name"      yaml:"name"`      // Reported in the rule name, such as "Identity (payments)"
+	Directory string   `json:"directory" toml:"directory" yaml:"directory"` // Public keys of the scope
+	Authors   []string `json:"authors"   toml:"authors"   yaml:"authors"`   // Author emails or globs such as "*@payments.example.com"
+	Paths     []string `json:"paths"     toml:"paths"     yaml:"paths"`     // Changed file globs such as "payments/**"
 }
 
 // RepoConfig contains configuration options for repository-level validation.
 type RepoConfig struct {
 	MaxCommitsAhead   int    `json:"max_commits_ahead"   toml:"max_commits_ahead"   yaml:"max_commits_ahead"`
+	MaxCommitsBehind  int    `json:"max_commits_behind"  toml:"max_commits_behind"  yaml:"max_commits_behind"`
 	ReferenceBranch   string `json:"reference_branch"    toml:"reference_branch"    yaml:"reference_branch"`
 	AllowMergeCommits bool   `json:"allow_merge_commits" toml:"allow_merge_commits" yaml:"allow_merge_commits"`
 }
 
 // JiraConfig contains configuration options for JIRA reference validation.
 type JiraConfig struct {
-	ProjectPrefixes      []string `json:"project_prefixes"       toml:"project_prefixes"       yaml:"project_prefixes"`
-	RequireInBody        bool     `json:"require_in_body"        toml:"require_in_body"        yaml:"require_in_body"`
-	RequireInSubject     bool     `json:"require_in_subject"     toml:"require_in_subject"     yaml:"require_in_subject"`
-	IgnoreTicketPatterns []string `json:"ignore_ticket_patterns" toml:"ignore_ticket_patterns" yaml:"ignore_ticket_patterns"`
+	ProjectPrefixes      []string         `json:"project_prefixes"       toml:"project_prefixes"       yaml:"project_prefixes"`
+	RequireInBody        bool             `json:"require_in_body"        toml:"require_in_body"        yaml:"require_in_body"`
+	RequireInSubject     bool             `json:"require_in_subject"     toml:"require_in_subject"     yaml:"require_in_subject"`
+	IgnoreTicketPatterns []string         `json:"ignore_ticket_patterns" toml:"ignore_ticket_patterns" yaml:"ignore_ticket_patterns"`
+	Online               JiraOnlineConfig `json:"online"                 toml:"online"                 yaml:"online"`
+}
+
+// JiraOnlineConfig contains configuration options for checking referenced tickets against
+// the JIRA REST API. The API token is read from the JIRA_API_TOKEN environment variable.
+type JiraOnlineConfig struct {
+	Enabled     bool   `json:"enabled"      toml:"enabled"      yaml:"enabled"`      // Look up referenced tickets; off by default
+	URL         string `json:"url"          toml:"url"          yaml:"url"`          // Base URL of the JIRA instance, e.g. "https://example.atlassian.net"
+	Sprint      string `json:"sprint"       toml:"sprint"       yaml:"sprint"`       // Sprint tickets must belong to; empty skips the check
+	SprintField string `json:"sprint_field" toml:"sprint_field" yaml:"sprint_field"` // Custom field holding the sprints of a ticket
+	Timeout     string `json:"timeout"      toml:"timeout"      yaml:"timeout"`      // Time limit of one request, e.g. "5s"
+	CacheTTL    string `json:"cache_ttl"    toml:"cache_ttl"    yaml:"cache_ttl"`    // How long looked up tickets are reused; "0s" disables the cache
 }
 
 // SpellConfig contains configuration options for spell checking.
@@ -84,8 +194,193 @@ type SpellConfig struct {
 	Locale      string   `json:"locale"       toml:"locale"       yaml:"locale"`
 }
 
+// TrailersConfig contains configuration options for commit message trailer validation.
+type TrailersConfig struct {
+	Allowed        []string            `json:"allowed"          toml:"allowed"          yaml:"allowed"`          // Known trailer keys in canonical capitalization; "*" allows any key
+	Unique         []string            `json:"unique"           toml:"unique"           yaml:"unique"`           // Keys that may appear at most once
+	Order          []string            `json:"order"            toml:"order"            yaml:"order"`            // Keys that must appear in this relative order
+	RequiredByType map[string][]string `json:"required_by_type" toml:"required_by_type" yaml:"required_by_type"` // Keys commits of a conventional type must carry, e.g. "fix": ["Refs", "Risk"]
+	Patterns       map[string]string   `json:"patterns"         toml:"patterns"         yaml:"patterns"`         // Regular expressions the values of a key must match, e.g. "Risk": "^(low|medium|high)$"
+}
+
+// CharactersConfig contains configuration options for the characters allowed in commit messages.
+type CharactersConfig struct {
+	AllowControl   bool     `json:"allow_control"    toml:"allow_control"    yaml:"allow_control"`    // Accept control characters other than tab
+	AllowZeroWidth bool     `json:"allow_zero_width" toml:"allow_zero_width" yaml:"allow_zero_width"` // Accept zero-width characters; joiners inside emoji are always accepted
+	AllowBidi      bool     `json:"allow_bidi"       toml:"allow_bidi"       yaml:"allow_bidi"`       // Accept bidirectional formatting characters (Trojan Source)
+	ASCIIOnly      bool     `json:"ascii_only"       toml:"ascii_only"       yaml:"ascii_only"`       // Report every non-ASCII character
+	AllowedScripts []string `json:"allowed_scripts"  toml:"allowed_scripts"  yaml:"allowed_scripts"`  // Unicode scripts letters must belong to, e.g. "Latin"; empty allows all
+}
+
+// LinksConfig contains configuration options for the URLs in commit message bodies.
+type LinksConfig struct {
+	RequireHTTPS   bool     `json:"require_https"   toml:"require_https"   yaml:"require_https"`   // Report URLs with another scheme than https
+	AllowedDomains []string `json:"allowed_domains" toml:"allowed_domains" yaml:"allowed_domains"` // Hosts URLs may point to, subdomains included; empty allows all
+	Forbid         bool     `json:"forbid"          toml:"forbid"          yaml:"forbid"`          // Report every URL, for public repositories that must not link internal systems
+}
+
+// PlaceholdersConfig contains configuration options for template text left in messages.
+type PlaceholdersConfig struct {
+	Patterns []string `json:"patterns" toml:"patterns" yaml:"patterns"` // Regular expressions of further placeholders, such as those of the team's commit template
+}
+
+// LanguageConfig contains configuration options for the natural language of messages.
+type LanguageConfig struct {
+	Languages []string `json:"languages"  toml:"languages"  yaml:"languages"`  // ISO 639-1 codes of the languages messages may be written in
+	MinLength int      `json:"min_length" toml:"min_length" yaml:"min_length"` // Letters a message needs before its language is judged
+}
+
+// IssuesConfig contains configuration options for checking #123 references against the
+// issues of a GitHub repository. The API token is read from GITHUB_TOKEN or GH_TOKEN.
+type IssuesConfig struct {
+	Repository  string `json:"repository"   toml:"repository"   yaml:"repository"`   // Repository of #123 references in owner/repo form; empty uses GITHUB_REPOSITORY
+	RequireOpen bool   `json:"require_open" toml:"require_open" yaml:"require_open"` // Report references to closed issues
+	Timeout     string `json:"timeout"      toml:"timeout"      yaml:"timeout"`      // Time limit of one request, e.g. "5s"
+	CacheTTL    string `json:"cache_ttl"    toml:"cache_ttl"    yaml:"cache_ttl"`    // How long looked up issues are reused; "0s" disables the cache
+}
+
+// BranchTicketConfig contains configuration options for matching the ticket referenced by
+// a commit against the ticket in the branch name.
+type BranchTicketConfig struct {
+	Pattern          string `json:"pattern"           toml:"pattern"           yaml:"pattern"`           // Ticket in the branch name; the first capture group is used when there is one
+	RequireReference bool   `json:"require_reference" toml:"require_reference" yaml:"require_reference"` // Report commits that reference no ticket at all
+}
+
+// SubjectPatternConfig contains configuration options for subjects that must match a
+// regular expression instead of, or in addition to, the conventional commit format.
+type SubjectPatternConfig struct {
+	Pattern string            `json:"pattern" toml:"pattern" yaml:"pattern"` // Regular expression of the whole subject, with named groups for its parts
+	Message string            `json:"message" toml:"message" yaml:"message"` // Message for subjects that do not match; empty names the pattern
+	Groups  map[string]string `json:"groups"  toml:"groups"  yaml:"groups"`  // Messages by named group, for subjects where that part is wrong
+}
+
+// BreakingChangeConfig contains configuration options for the extra requirements of
+// breaking changes.
+type BreakingChangeConfig struct {
+	MinBodyLength    int      `json:"min_body_length"   toml:"min_body_length"   yaml:"min_body_length"`   // Minimum characters of the explanation; 0 allows any length
+	RequireMigration bool     `json:"require_migration" toml:"require_migration" yaml:"require_migration"` // Require a paragraph starting with "Migration:"
+	Branches         []string `json:"branches"          toml:"branches"          yaml:"branches"`          // Target branch names or globs breaking changes may land on; empty allows all
+	RequiredTrailers []string `json:"required_trailers" toml:"required_trailers" yaml:"required_trailers"` // Trailers breaking changes must carry, e.g. "Approved-by"
+}
+
+// MergeSubjectConfig contains configuration options for the subjects of merge commits.
+type MergeSubjectConfig struct {
+	Patterns []string `json:"patterns" toml:"patterns" yaml:"patterns"` // Regular expressions of which merge subjects must match one
+}
+
+// RevertConfig contains configuration options for revert commits.
+type RevertConfig struct {
+	Format      string   `json:"format"       toml:"format"       yaml:"format"`       // Subject of reverts: any, git (Revert "...") or conventional (revert: ...)
+	ExemptRules []string `json:"exempt_rules" toml:"exempt_rules" yaml:"exempt_rules"` // Commit rules that do not check reverts
+}
+
+// FixupConfig contains configuration options for fixup!, squash! and amend! commits.
+type FixupConfig struct {
+	ProtectedBranches []string `json:"protected_branches" toml:"protected_branches" yaml:"protected_branches"` // Target branch names or globs fixup commits may not land on
+}
+
+// ForcePushConfig contains configuration options for comparing HEAD with its upstream.
+type ForcePushConfig struct {
+	Upstream string   `json:"upstream" toml:"upstream" yaml:"upstream"` // Remote tracking branch to compare HEAD with, e.g. "origin/main"; empty uses the upstream of the checked out branch
+	Branches []string `json:"branches" toml:"branches" yaml:"branches"` // Target branch names or globs to check; empty checks all
+}
+
+// CommitSizeConfig contains configuration options for the size of commits.
+type CommitSizeConfig struct {
+	MaxLines int `json:"max_lines" toml:"max_lines" yaml:"max_lines"` // Maximum inserted and deleted lines of a commit; 0 allows any number
+	MaxFiles int `json:"max_files" toml:"max_files" yaml:"max_files"` // Maximum changed files of a commit; 0 allows any number
+}
+
+// CLAConfig contains configuration options for checking that contributors have signed
+// the contributor license agreement.
+type CLAConfig struct {
+	ContributorsFile string `json:"contributors_file" toml:"contributors_file" yaml:"contributors_file"` // File listing signed contributors, one email, "Name <email>" or glob such as *@example.com per line
+	URL              string `json:"url"               toml:"url"               yaml:"url"`               // CLA service asked about contributors missing from the file; {email} is replaced by the email
+	SignURL          string `json:"sign_url"          toml:"sign_url"          yaml:"sign_url"`          // Where contributors sign the agreement, shown in the help
+	Offline          string `json:"offline"           toml:"offline"           yaml:"offline"`           // When the service cannot be reached: "warn", "fail" or "allow"
+	Timeout          string `json:"timeout"           toml:"timeout"           yaml:"timeout"`           // Time limit of one request, e.g. "5s"
+	CacheTTL         string `json:"cache_ttl"         toml:"cache_ttl"         yaml:"cache_ttl"`         // How long signed contributors are reused; "0s" disables the cache
+}
+
+// ReviewConfig contains configuration options for Reviewed-by and Acked-by requirements.
+type ReviewConfig struct {
+	Branches  []ReviewBranchConfig `json:"branches"  toml:"branches"  yaml:"branches"`
+	Reviewers []string             `json:"reviewers" toml:"reviewers" yaml:"reviewers"` // Accepted reviewer identities; empty accepts anyone but the author
+}
+
+// ChangeIDConfig contains configuration options for the Change-Id trailers of Gerrit.
+type ChangeIDConfig struct {
+	Mode string `json:"mode" toml:"mode" yaml:"mode"` // "require" a valid Change-Id, as Gerrit does, or "forbid" it, for repositories outside Gerrit
+}
+
+// ReviewBranchConfig sets the review trailers required on commits targeting matching branches.
+type ReviewBranchConfig struct {
+	Branch        string `json:"branch"          toml:"branch"          yaml:"branch"` // Branch name or glob such as "release/*"
+	MinReviewedBy int    `json:"min_reviewed_by" toml:"min_reviewed_by" yaml:"min_reviewed_by"`
+	MinAckedBy    int    `json:"min_acked_by"    toml:"min_acked_by"    yaml:"min_acked_by"`
+}
+
 // RulesConfig contains configuration for rule activation.
 type RulesConfig struct {
-	Enabled  []string `json:"enabled"  toml:"enabled"  yaml:"enabled"`
-	Disabled []string `json:"disabled" toml:"disabled" yaml:"disabled"`
+	Enabled         []string                 `json:"enabled"          toml:"enabled"          yaml:"enabled"`
+	Disabled        []string                 `json:"disabled"         toml:"disabled"         yaml:"disabled"`
+	Order           []string                 `json:"order"            toml:"order"            yaml:"order"`            // Commit rules to run first, in this order
+	Blocking        []string                 `json:"blocking"         toml:"blocking"         yaml:"blocking"`         // Commit rules whose failure skips the rules after them
+	Warnings        []string                 `json:"warnings"         toml:"warnings"         yaml:"warnings"`         // Rules whose failures are reported as warnings without failing validation
+	RelatedFailures string                   `json:"related_failures" toml:"related_failures" yaml:"related_failures"` // "deduplicate" drops failures repeating another rule's, "verbose" marks them
+	Conditions      map[string]RuleCondition `json:"conditions"       toml:"conditions"       yaml:"conditions"`       // Rule name to the commits it applies to
+}
+
+// RuleCondition restricts a rule to commits matching every criterion that is set.
+// A criterion matches when any of its entries does.
+type RuleCondition struct {
+	Branches []string `json:"branches" toml:"branches" yaml:"branches"` // Target branch names or globs such as "release/*"
+	Types    []string `json:"types"    toml:"types"    yaml:"types"`    // Conventional commit types
+	Authors  []string `json:"authors"  toml:"authors"  yaml:"authors"`  // Author emails or globs such as "*@example.com"
+	Paths    []string `json:"paths"    toml:"paths"    yaml:"paths"`    // Changed file globs; "dir/**" matches everything below dir
+}
+
+// PluginConfig declares an external executable or WASM module that acts as a commit rule.
+// Exactly one of Command and Wasm must be set.
+type PluginConfig struct {
+	Name          string   `json:"name"            toml:"name"            yaml:"name"`
+	Command       string   `json:"command"         toml:"command"         yaml:"command"`
+	Args          []string `json:"args"            toml:"args"            yaml:"args"`
+	Wasm          string   `json:"wasm"            toml:"wasm"            yaml:"wasm"`
+	Timeout       string   `json:"timeout"         toml:"timeout"         yaml:"timeout"`
+	MemoryLimitMB int      `json:"memory_limit_mb" toml:"memory_limit_mb" yaml:"memory_limit_mb"`
+}
+
+// CustomRuleConfig declares a regex rule without writing code.
+// The pattern must match the target unless Forbid is set, in which case it must not match.
+type CustomRuleConfig struct {
+	Name     string `json:"name"     toml:"name"     yaml:"name"`
+	Pattern  string `json:"pattern"  toml:"pattern"  yaml:"pattern"`
+	Target   string `json:"target"   toml:"target"   yaml:"target"`   // subject, body or message (default subject)
+	Forbid   bool   `json:"forbid"   toml:"forbid"   yaml:"forbid"`   // Fail when the pattern matches
+	Message  string `json:"message"  toml:"message"  yaml:"message"`  // Shown when the rule fails
+	Severity string `json:"severity" toml:"severity" yaml:"severity"` // error (default) or warning
+}
+
+// MessagesConfig maps rule names to templates overriding the text of their failures.
+type MessagesConfig map[string]MessageTemplateConfig
+
+// MessageTemplateConfig holds text/template overrides for the message and help of a rule's failures.
+// Templates can use .Rule, .Code, .Message, .Help and .Context values such as {{.Context.expected}}.
+type MessageTemplateConfig struct {
+	Message string `json:"message" toml:"message" yaml:"message"`
+	Help    string `json:"help"    toml:"help"    yaml:"help"`
+}
+
+// I18nConfig contains configuration for translated output.
+type I18nConfig struct {
+	Locale    string `json:"locale"    toml:"locale"    yaml:"locale"`    // Locale such as "sv"; empty uses LANG
+	Directory string `json:"directory" toml:"directory" yaml:"directory"` // Directory with external <locale>.yaml translation files
+}
+
+// DisplayConfig contains the appearance of text output and the commit links of reports.
+type DisplayConfig struct {
+	Theme        string `json:"theme"         toml:"theme"         yaml:"theme"`         // Color theme: default, high-contrast or monochrome
+	ASCII        bool   `json:"ascii"         toml:"ascii"         yaml:"ascii"`         // ASCII symbols instead of ✓, ✗ and ⚠, for terminals and logs that mangle them
+	LinkTemplate string `json:"link_template" toml:"link_template" yaml:"link_template"` // URL of a commit with {hash} and {short_hash}, or "auto" to derive it from the origin remote; empty links no commits
 }
@@ -20,9 +20,10 @@ type ConventionalCommitFormat struct {
 }
 
 var (
-	// Format: <type>[optional scope][optional !]: <description>.
+	// Format: <type>[optional scope][optional !]: <description>. The characters of
+	// scopes are left to conventional.scope_characters.
 	conventionalCommitRegex = regexp.MustCompile(
-		`^(?P<type>[a-zA-Z]+)(?:\((?P<scope>[a-z0-9/,-]+)\))?(?P<breaking>!)?:(?P<space>\s?)(?P<description>.*)`,
+		`^(?P<type>[a-zA-Z]+)(?:\((?P<scope>[^()\s]+)\))?(?P<breaking>!)?:(?P<space>\s?)(?P<description>.*)`,
 	)
 
 	// partialConventionalRegex matches strings that look like they're trying to be conventional
@@ -113,6 +114,22 @@ func IsConventionalCommitLike(subject string) bool {
 	return missingColonPattern.MatchString(subject)
 }
 
+// CanonicalConventionalType returns the type of a conventional commit subject, with an
+// alias in aliases replaced by the type it stands for. It returns an empty string when
+// the subject is not a conventional commit.
+func CanonicalConventionalType(subject string, aliases map[string]string) string {
+	parsed := ParseConventionalCommit(subject)
+	if !parsed.IsValid {
+		return ""
+	}
+
+	if canonical, found := aliases[parsed.Type]; found {
+		return canonical
+	}
+
+	return parsed.Type
+}
+
 // ExtractDescriptionFromConventional extracts just the description part from a conventional commit.
 // Returns the full subject if it's not a conventional commit.
 func ExtractDescriptionFromConventional(subject string) string {
@@ -0,0 +1,26 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package domain
+
+import "context"
+
+// DiffStat summarizes the changes of a commit as git diff --stat does.
+type DiffStat struct {
+	Files      int // Files added, modified or removed
+	Insertions int // Lines added
+	Deletions  int // Lines removed
+}
+
+// Lines returns the number of changed lines, insertions and deletions together.
+func (s DiffStat) Lines() int {
+	return s.Insertions + s.Deletions
+}
+
+// DiffStatResolver defines the contract for measuring the size of a commit.
+type DiffStatResolver interface {
+	// GetDiffStat returns the diffstat of the commit compared to its first parent.
+	// Binary files count as changed files without changed lines.
+	GetDiffStat(ctx context.Context, ref string) (DiffStat, error)
+}
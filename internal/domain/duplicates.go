@@ -0,0 +1,80 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package domain
+
+import (
+	"maps"
+	"slices"
+	"strings"
+)
+
+// WithDuplicatesGrouped returns a new report in which commits with the same message and
+// the same results, such as the cherry-picks of a release branch, are one entry: the
+// first of them, with the hashes of the others in Duplicates. Commits whose results
+// differ, as when only some of them are signed, are kept apart. The summary still
+// counts every commit.
+func (r Report) WithDuplicatesGrouped() Report {
+	commits := make([]CommitReport, 0, len(r.Commits))
+	groups := make(map[string]int)
+
+	for _, commitReport := range r.Commits {
+		// Messages without a commit, such as message files, are never duplicates
+		if commitReport.Commit.Hash == "" {
+			commits = append(commits, commitReport)
+
+			continue
+		}
+
+		key := duplicateKey(commitReport)
+		if index, found := groups[key]; found {
+			commits[index].Duplicates = append(commits[index].Duplicates, commitReport.Commit.Hash)
+
+			continue
+		}
+
+		commitReport.Duplicates = slices.Clone(commitReport.Duplicates)
+		groups[key] = len(commits)
+		commits = append(commits, commitReport)
+	}
+
+	r.Commits = commits
+
+	return r
+}
+
+// duplicateKey returns what commits must share to be reported as one: their message,
+// submodule and the status, failures and details of every rule.
+func duplicateKey(commitReport CommitReport) string {
+	var key strings.Builder
+
+	message := commitReport.Commit.Message
+	if message == "" {
+		message = commitReport.Commit.Subject + "\n\n" + commitReport.Commit.Body
+	}
+
+	writeKeyParts(&key, message, commitReport.Submodule, commitReport.SkipReason)
+
+	for _, ruleResult := range commitReport.RuleResults {
+		writeKeyParts(&key, ruleResult.Name, string(ruleResult.Status), ruleResult.BlockedBy, ruleResult.SkipReason)
+
+		for _, err := range ruleResult.Errors {
+			writeKeyParts(&key, err.Rule, err.Code, err.Message, string(err.Severity))
+		}
+
+		for _, name := range slices.Sorted(maps.Keys(ruleResult.Details)) {
+			writeKeyParts(&key, name, ruleResult.Details[name])
+		}
+	}
+
+	return key.String()
+}
+
+// writeKeyParts writes parts to key, each ended by a NUL, which messages do not hold.
+func writeKeyParts(key *strings.Builder, parts ...string) {
+	for _, part := range parts {
+		key.WriteString(part)
+		key.WriteByte(0)
+	}
+}
@@ -0,0 +1,12 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+// Package errcodes enumerates the error codes of validation failures with their
+// descriptions, for tools that match on codes rather than on translated messages.
+//
+// The codes themselves are the ValidationErrorCode constants of the domain package.
+// Within a Version, a code listed here is never removed, renamed or given another
+// meaning; new codes may be added. testdata/codes.golden records the codes of the
+// current version so that tests catch changes breaking this contract.
+package errcodes
@@ -0,0 +1,281 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package errcodes
+
+import (
+	"github.com/itiquette/gommitlint/internal/domain"
+)
+
+// Version is the version of the error code enumeration, reported as errorCodesVersion
+// in JSON output. It changes when a code is removed or changes meaning; new codes do
+// not change it.
+const Version = "1"
+
+// Code describes an error code.
+type Code struct {
+	Code        domain.ValidationErrorCode `json:"code"`
+	Category    string                     `json:"category"`
+	Description string                     `json:"description"`
+}
+
+// catalog lists the codes by category, in the order of the domain constants.
+var catalog = []Code{
+	// Format
+	{domain.ErrInvalidFormat, "format", "The subject does not have the required format"},
+	{domain.ErrSpacing, "format", "Whitespace in the message is not as required"},
+
+	// Subject
+	{domain.ErrSubjectTooLong, "subject", "The subject is longer than message.subject.max_length"},
+	{domain.ErrSubjectLength, "subject", "The length of the subject is outside the allowed range"},
+	{domain.ErrSubjectCase, "subject", "The first word of the subject is not in the required case"},
+	{domain.ErrSubjectSuffix, "subject", "The subject ends with a forbidden character"},
+	{domain.ErrMissingSubject, "subject", "The message has no subject"},
+	{domain.ErrNoFirstWord, "subject", "The subject has no word to check"},
+	{domain.ErrEmptyMessage, "subject", "The commit message is empty"},
+	{domain.ErrEmptySubject, "subject", "The subject line is empty"},
+	{domain.ErrInvalidUTF8, "subject", "The message is not valid UTF-8"},
+	{domain.ErrMissingConventionalSubject, "subject", "A conventional commit has no description after the type"},
+	{domain.ErrWrongCaseUpper, "subject", "The first word of the subject is not upper case"},
+	{domain.ErrWrongCaseLower, "subject", "The first word of the subject is not lower case"},
+
+	// Body
+	{domain.ErrInvalidBody, "body", "The body does not meet the body requirements"},
+	{domain.ErrMissingBody, "body", "The message has no body where one is required"},
+	{domain.ErrBodyTooShort, "body", "The body is shorter than message.body.min_length"},
+	{domain.ErrMissingBlankLine, "body", "No blank line separates the subject from the body"},
+	{domain.ErrInvalidStructure, "body", "The body is laid out in a way that is not allowed"},
+	{domain.ErrBodyTooFewWords, "body", "The body has fewer words than required"},
+	{domain.ErrTooFewParagraphs, "body", "The body has fewer paragraphs than required"},
+	{domain.ErrRepeatedSubject, "body", "The body only repeats the subject"},
+
+	// Conventional commit
+	{domain.ErrInvalidType, "conventional", "The commit type is not allowed"},
+	{domain.ErrInvalidScope, "conventional", "The scope is not allowed"},
+	{domain.ErrEmptyDescription, "conventional", "The description after the type is empty"},
+	{domain.ErrDescriptionTooLong, "conventional", "The description after the type is too long"},
+	{domain.ErrInvalidConventionalFormat, "conventional", "The subject is not in the type(scope): description format"},
+	{domain.ErrInvalidConventionalType, "conventional", "The commit type is not one of conventional.types"},
+	{domain.ErrMissingConventionalScope, "conventional", "The commit has no scope where one is required"},
+	{domain.ErrInvalidConventionalScope, "conventional", "The scope is not one of conventional.scopes"},
+	{domain.ErrConventionalDescTooLong, "conventional", "The description is longer than conventional.max_description_length"},
+	{domain.ErrInvalidMultiScope, "conventional", "Several scopes are given where only one is allowed"},
+	{domain.ErrInvalidSpacing, "conventional", "The spacing after the colon of the type is wrong"},
+	{domain.ErrEmptyConventionalDesc, "conventional", "The conventional commit has an empty description"},
+	{domain.ErrConventionalScopeCase, "conventional", "The scope is not in the required case"},
+	{domain.ErrConventionalScopeTooLong, "conventional", "The scope is longer than allowed"},
+	{domain.ErrConventionalScopeChars, "conventional", "The scope contains characters that are not allowed"},
+	{domain.ErrTooManyConventionalScopes, "conventional", "The commit names more scopes than allowed"},
+	{domain.ErrConventionalTypeAlias, "conventional", "The commit type is an alias of a preferred type"},
+
+	// Jira
+	{domain.ErrMissingJira, "jira", "The message references no Jira issue"},
+	{domain.ErrMissingJiraInSubject, "jira", "The subject references no Jira issue"},
+	{domain.ErrMissingJiraInBody, "jira", "The body references no Jira issue"},
+	{domain.ErrMisplacedJira, "jira", "The Jira reference is not in the required part of the message"},
+	{domain.ErrInvalidProject, "jira", "The Jira issue belongs to a project that is not allowed"},
+	{domain.ErrInvalidJiraFormat, "jira", "The Jira reference is malformed"},
+	{domain.ErrIgnoredJiraPattern, "jira", "The Jira reference matches an ignored pattern"},
+	{domain.ErrConventionalPlacement, "jira", "The Jira reference is placed wrongly in a conventional commit"},
+	{domain.ErrMissingJiraKeySubject, "jira", "The subject has no Jira key"},
+	{domain.ErrMissingJiraKeyBody, "jira", "The body has no Jira key"},
+	{domain.ErrJiraKeyNotAtEnd, "jira", "The Jira key is not at the end of the subject"},
+	{domain.ErrInvalidRefsFormat, "jira", "The Refs trailer is malformed"},
+	{domain.ErrInvalidKeyFormat, "jira", "The Jira key is not in the PROJECT-123 format"},
+	{domain.ErrRefsAfterSignoff, "jira", "The Refs trailer follows the Signed-off-by trailer"},
+	{domain.ErrJiraTicketNotFound, "jira", "The referenced Jira issue does not exist"},
+	{domain.ErrJiraTicketClosed, "jira", "The referenced Jira issue is closed"},
+	{domain.ErrJiraTicketProject, "jira", "The referenced Jira issue is not in an allowed project"},
+	{domain.ErrJiraTicketSprint, "jira", "The referenced Jira issue is not in an active sprint"},
+	{domain.ErrJiraLookupFailed, "jira", "The Jira server could not be asked about the issue"},
+
+	// GitHub issues
+	{domain.ErrIssueNotFound, "issue", "The referenced GitHub issue does not exist"},
+	{domain.ErrIssueClosed, "issue", "The referenced GitHub issue is closed"},
+	{domain.ErrIssueLookupFailed, "issue", "GitHub could not be asked about the issue"},
+
+	// Branch ticket
+	{domain.ErrBranchTicketMismatch, "branch-ticket", "The message references another ticket than the branch name"},
+	{domain.ErrBranchTicketMissing, "branch-ticket", "The message lacks the ticket of the branch name"},
+
+	// Subject echo
+	{domain.ErrSubjectEchoesBranch, "subject-echo", "The subject only repeats the branch name"},
+	{domain.ErrSubjectEchoesTicket, "subject-echo", "The subject only repeats the title of the ticket"},
+
+	// Imperative mood
+	{domain.ErrNonImperative, "imperative", "The subject does not start with a verb in the imperative mood"},
+	{domain.ErrNonVerb, "imperative", "The first word of the subject is not a verb"},
+	{domain.ErrPastTense, "imperative", "The first word of the subject is in the past tense"},
+	{domain.ErrGerund, "imperative", "The first word of the subject is a gerund"},
+	{domain.ErrThirdPerson, "imperative", "The first word of the subject is in the third person"},
+	{domain.ErrVerbNotAllowed, "imperative", "The verb starting the subject is not allowed"},
+
+	// Signature
+	{domain.ErrCommitNil, "signature", "There is no commit to check the signature of"},
+	{domain.ErrNoKeyDir, "signature", "No key directory is configured to verify signatures with"},
+	{domain.ErrInvalidKeyDir, "signature", "The key directory cannot be used"},
+	{domain.ErrMissingSignature, "signature", "The commit is not signed"},
+	{domain.ErrInvalidSignature, "signature", "The signature is invalid"},
+	{domain.ErrInvalidSignatureFormat, "signature", "The signature is malformed"},
+	{domain.ErrUnknownSigFormat, "signature", "The signature is neither GPG nor SSH"},
+	{domain.ErrKeyNotTrusted, "signature", "The signing key or the signer is not trusted"},
+	{domain.ErrWeakKey, "signature", "The signing key is weaker than allowed"},
+	{domain.ErrVerificationFailed, "signature", "The signature does not verify"},
+	{domain.ErrDisallowedSigType, "signature", "The type of signature is not allowed"},
+	{domain.ErrIncompleteGPGSig, "signature", "The GPG signature is incomplete"},
+	{domain.ErrIncompleteSSHSig, "signature", "The SSH signature is incomplete"},
+	{domain.ErrInvalidGPGFormat, "signature", "The GPG signature is malformed"},
+	{domain.ErrInvalidSSHFormat, "signature", "The SSH signature is malformed"},
+	{domain.ErrLightweightTag, "signature", "The tag is lightweight and cannot be signed"},
+	{domain.ErrInvalidCommit, "signature", "The commit cannot be checked"},
+	{domain.ErrBadPushNonce, "signature", "The nonce of the push certificate is not the one the server sent"},
+
+	// Sign-off
+	{domain.ErrMissingSignoff, "signoff", "The message has no Signed-off-by trailer"},
+	{domain.ErrInvalidSignoffFormat, "signoff", "The Signed-off-by trailer is malformed"},
+	{domain.ErrMisplacedSignoff, "signoff", "The Signed-off-by trailer is not in the trailer block"},
+	{domain.ErrInsufficientSignoffs, "signoff", "The message has fewer sign-offs than required"},
+	{domain.ErrSignoffNotAuthor, "signoff", "No sign-off is by the author of the commit"},
+
+	// Trailers
+	{domain.ErrUnknownTrailer, "trailer", "The trailer key is not allowed"},
+	{domain.ErrTrailerCase, "trailer", "The trailer key is not in its canonical case"},
+	{domain.ErrDuplicateTrailer, "trailer", "The trailer appears more often than allowed"},
+	{domain.ErrTrailerOrder, "trailer", "The trailers are not in the required order"},
+	{domain.ErrMissingTrailer, "trailer", "A trailer required for the commit type is missing"},
+	{domain.ErrTrailerValue, "trailer", "The trailer value does not match its pattern"},
+
+	// Characters
+	{domain.ErrControlCharacter, "character", "The message contains a control character"},
+	{domain.ErrZeroWidthCharacter, "character", "The message contains a zero-width character"},
+	{domain.ErrBidiCharacter, "character", "The message contains a bidirectional formatting character"},
+	{domain.ErrNonASCIICharacter, "character", "The message contains a character outside ASCII where only ASCII is allowed"},
+	{domain.ErrDisallowedScript, "character", "The message contains letters of a script that is not allowed"},
+
+	// Links
+	{domain.ErrInvalidURL, "link", "A URL in the message is malformed"},
+	{domain.ErrInsecureURL, "link", "A URL in the message does not use HTTPS"},
+	{domain.ErrDisallowedURLDomain, "link", "A URL in the message points to a domain that is not allowed"},
+	{domain.ErrForbiddenURL, "link", "A URL in the message matches a forbidden pattern"},
+
+	// Review
+	{domain.ErrMissingReview, "review", "The message has fewer review trailers than required"},
+	{domain.ErrInvalidReviewer, "review", "A reviewer is not allowed or is the author"},
+
+	// Encoding
+	{domain.ErrUndecodableMessage, "encoding", "The message cannot be decoded from its declared encoding"},
+
+	// Change-Id
+	{domain.ErrMissingChangeID, "change-id", "The message has no Change-Id trailer"},
+	{domain.ErrInvalidChangeID, "change-id", "The Change-Id trailer is malformed"},
+	{domain.ErrMultipleChangeIDs, "change-id", "The message has more than one Change-Id trailer"},
+	{domain.ErrForbiddenChangeID, "change-id", "The message has a Change-Id trailer where none is allowed"},
+
+	// Breaking changes
+	{domain.ErrBreakingChangeBodyTooShort, "breaking-change", "The explanation of the breaking change is too short"},
+	{domain.ErrMissingMigration, "breaking-change", "The breaking change has no migration section"},
+	{domain.ErrBreakingChangeBranch, "breaking-change", "The breaking change targets a branch that does not allow them"},
+	{domain.ErrMissingBreakingChangeTrailer, "breaking-change", "A commit marked breaking has no BREAKING CHANGE trailer"},
+
+	// Reverts
+	{domain.ErrMissingRevertReference, "revert", "The revert does not name the commit it reverts"},
+	{domain.ErrRevertedCommitNotFound, "revert", "The reverted commit is not in the repository"},
+	{domain.ErrInvalidRevertFormat, "revert", "The revert message is not in the format git revert writes"},
+
+	// Fixups
+	{domain.ErrFixupTargetNotFound, "fixup", "The commit a fixup or squash commit amends is not in the range"},
+	{domain.ErrFixupOnProtectedBranch, "fixup", "A fixup or squash commit is on a branch that does not allow them"},
+
+	// Force pushes
+	{domain.ErrForcePushRequired, "force-push", "The push would rewrite history of the branch"},
+	{domain.ErrPublishedCommitRewritten, "force-push", "A commit that was already published is rewritten"},
+
+	// Commit size
+	{domain.ErrTooManyChangedLines, "commit-size", "The commit changes more lines than allowed"},
+	{domain.ErrTooManyChangedFiles, "commit-size", "The commit changes more files than allowed"},
+
+	// Contributor license agreement
+	{domain.ErrCLANotSigned, "cla", "The author has not signed the contributor license agreement"},
+	{domain.ErrCLALookupFailed, "cla", "The signatories of the contributor license agreement could not be read"},
+
+	// Spelling
+	{domain.ErrSpelling, "spelling", "The message has spelling mistakes"},
+	{domain.ErrMisspelledWord, "spelling", "A word in the message is misspelled"},
+	{domain.ErrSpellCheckFailed, "spelling", "The spell checker could not check the message"},
+
+	// Commits ahead and behind
+	{domain.ErrTooManyCommits, "branch", "The branch is more commits ahead of the reference than allowed"},
+	{domain.ErrTooManyCommitsBehind, "branch", "The branch is more commits behind the reference than allowed"},
+
+	// Linear history
+	{domain.ErrMergeCommit, "history", "The range contains a merge commit where history must be linear"},
+	{domain.ErrNonLinearHistory, "history", "The history of the range is not linear"},
+
+	// Operations
+	{domain.ErrInvalidRepo, "operation", "The repository cannot be read"},
+	{domain.ErrInvalidConfig, "operation", "The configuration of the rule cannot be used"},
+	{domain.ErrCancelled, "operation", "The operation was cancelled"},
+	{domain.ErrGitOperationFailed, "operation", "A git operation the rule depends on failed"},
+	{domain.ErrInsufficientData, "operation", "Data the rule needs, such as the changed files missing from a partial clone, is unavailable"},
+	{domain.ErrContextCancelled, "operation", "Validation was cancelled before the rule finished"},
+	{domain.ErrTimeout, "operation", "The operation took longer than allowed"},
+	{domain.ErrCommitNotFound, "operation", "The commit is not in the repository"},
+	{domain.ErrRangeNotFound, "operation", "The range cannot be resolved"},
+
+	// References
+	{domain.ErrInvalidReference, "reference", "The reference is malformed"},
+	{domain.ErrMissingReference, "reference", "The reference to compare with is missing"},
+
+	// Plugins
+	{domain.ErrPluginFailed, "plugin", "The plugin failed or returned an unusable result"},
+
+	// Subject patterns
+	{domain.ErrSubjectPatternMismatch, "subject-pattern", "The subject does not match the configured pattern"},
+	{domain.ErrSubjectPatternGroup, "subject-pattern", "A named group of the subject pattern captured a value that is not allowed"},
+
+	// Template placeholders
+	{domain.ErrTemplatePlaceholder, "placeholder", "The message still contains a placeholder of its template"},
+	{domain.ErrTemplateComment, "placeholder", "The message still contains comment lines of its template"},
+
+	// Message hygiene
+	{domain.ErrWhitespaceOnlyBody, "hygiene", "The body consists of whitespace only"},
+	{domain.ErrDiffInBody, "hygiene", "The body contains a pasted diff"},
+	{domain.ErrConflictMarkers, "hygiene", "The message contains merge conflict markers"},
+
+	// Message language
+	{domain.ErrWrongLanguage, "language", "The message is written in a language that is not agreed on"},
+
+	// Merge subjects
+	{domain.ErrMergeSubjectMismatch, "merge-subject", "The subject of the merge commit does not match the required pattern"},
+
+	// Custom rules
+	{domain.ErrPatternMismatch, "custom", "The message does not match the pattern of a custom rule"},
+	{domain.ErrForbiddenPattern, "custom", "The message matches a pattern a custom rule forbids"},
+
+	// Max length
+	{domain.ErrMaxLengthExceeded, "length", "A part of the message is longer than allowed"},
+
+	// Generic
+	{domain.ErrUnknown, "generic", "A failure without a more specific code, such as one reported by a plugin"},
+	{domain.ErrRuleDetails, "generic", "Not a failure: marks the details a rule reports about what it checked"},
+}
+
+// All returns every error code, grouped by category.
+func All() []Code {
+	result := make([]Code, len(catalog))
+	copy(result, catalog)
+
+	return result
+}
+
+// Lookup returns the description of code.
+func Lookup(code string) (Code, bool) {
+	for _, entry := range catalog {
+		if string(entry.Code) == code {
+			return entry, true
+		}
+	}
+
+	return Code{}, false
+}
@@ -0,0 +1,99 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package errcodes_test
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/itiquette/gommitlint/internal/domain"
+	"github.com/itiquette/gommitlint/internal/domain/errcodes"
+	"github.com/stretchr/testify/require"
+)
+
+// enumeratedCodes returns the codes of errcodes.All as strings.
+func enumeratedCodes(t *testing.T) []string {
+	t.Helper()
+
+	var codes []string
+
+	for _, code := range errcodes.All() {
+		require.NotEmpty(t, code.Category, code.Code)
+		require.NotEmpty(t, code.Description, code.Code)
+		require.NotContains(t, codes, string(code.Code), "listed twice")
+
+		codes = append(codes, string(code.Code))
+	}
+
+	return codes
+}
+
+func TestAll_ListsEveryDomainCode(t *testing.T) {
+	file, err := parser.ParseFile(token.NewFileSet(), "../errors.go", nil, 0)
+	require.NoError(t, err)
+
+	var declared []string
+
+	ast.Inspect(file, func(node ast.Node) bool {
+		spec, ok := node.(*ast.ValueSpec)
+		if !ok {
+			return true
+		}
+
+		if typeName, ok := spec.Type.(*ast.Ident); !ok || typeName.Name != "ValidationErrorCode" {
+			return true
+		}
+
+		for _, value := range spec.Values {
+			code, err := strconv.Unquote(value.(*ast.BasicLit).Value)
+			require.NoError(t, err)
+
+			declared = append(declared, code)
+		}
+
+		return true
+	})
+
+	require.NotEmpty(t, declared)
+	require.ElementsMatch(t, declared, enumeratedCodes(t), "every ValidationErrorCode needs an entry in the catalog")
+}
+
+func TestAll_Stable(t *testing.T) {
+	content, err := os.ReadFile("testdata/codes.golden")
+	require.NoError(t, err)
+
+	var golden []string
+
+	for _, line := range strings.Split(string(content), "\n") {
+		if line = strings.TrimSpace(line); line != "" && !strings.HasPrefix(line, "#") {
+			golden = append(golden, line)
+		}
+	}
+
+	codes := enumeratedCodes(t)
+
+	for _, code := range golden {
+		require.Contains(t, codes, code, "codes of version %s may not be removed or renamed", errcodes.Version)
+	}
+
+	for _, code := range codes {
+		require.Contains(t, golden, code, "record new codes in testdata/codes.golden")
+	}
+}
+
+func TestLookup(t *testing.T) {
+	code, found := errcodes.Lookup("subject_too_long")
+	require.True(t, found)
+	require.Equal(t, domain.ErrSubjectTooLong, code.Code)
+	require.Equal(t, "subject", code.Category)
+
+	_, found = errcodes.Lookup("no_such_code")
+	require.False(t, found)
+}
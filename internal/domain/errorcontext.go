@@ -0,0 +1,93 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package domain
+
+import "strconv"
+
+// ContextSchemaVersion is the version of the standard context keys below, reported as
+// schemaVersion in JSON output. It changes when a key is removed or changes meaning;
+// new keys do not change it.
+const ContextSchemaVersion = "1"
+
+// Standard keys of ValidationError.Context. Formatters read them through the typed
+// accessors; any other key is specific to a rule and passed through as it is.
+const (
+	// ContextExpected holds what the rule requires, such as "max 72" or "conventional".
+	ContextExpected = "expected"
+
+	// ContextActual holds what the rule found, often text quoted from the message.
+	ContextActual = "actual"
+
+	// ContextLine holds the 1-based line of the message the failure points at.
+	ContextLine = "line"
+
+	// ContextColumn holds the 1-based column, counted in characters, within ContextLine.
+	ContextColumn = "column"
+
+	// ContextSuggestion holds replacement text that fixes the failure.
+	ContextSuggestion = "suggestion"
+
+	// ContextRelatedTo holds the rule that reported the same mistake first, on failures
+	// that repeat it.
+	ContextRelatedTo = "related_to"
+
+	// ContextRatchetBase holds the base branch whose history has failures of the same rule
+	// and code, on failures that --ratchet reports as warnings.
+	ContextRatchetBase = "ratchet_base"
+)
+
+// Position is a 1-based location in a commit message. Columns count characters
+// (runes), not bytes.
+type Position struct {
+	Line   int
+	Column int
+}
+
+// Expected returns what the rule requires, or empty if the rule did not say.
+func (e ValidationError) Expected() string {
+	return e.Context[ContextExpected]
+}
+
+// Actual returns what the rule found, or empty if the rule did not say.
+func (e ValidationError) Actual() string {
+	return e.Context[ContextActual]
+}
+
+// Suggestion returns replacement text that fixes the failure, or empty if there is none.
+func (e ValidationError) Suggestion() string {
+	return e.Context[ContextSuggestion]
+}
+
+// RelatedTo returns the rule that reported the same mistake, or empty if the failure
+// does not repeat another.
+func (e ValidationError) RelatedTo() string {
+	return e.Context[ContextRelatedTo]
+}
+
+// Position returns the location the failure points at. It reports false when the
+// failure has no position or the recorded one is not valid.
+func (e ValidationError) Position() (Position, bool) {
+	line, lineErr := strconv.Atoi(e.Context[ContextLine])
+	column, columnErr := strconv.Atoi(e.Context[ContextColumn])
+
+	if lineErr != nil || columnErr != nil || line < 1 || column < 1 {
+		return Position{}, false
+	}
+
+	return Position{Line: line, Column: column}, true
+}
+
+// WithPosition records the 1-based line and column the failure points at.
+func (e ValidationError) WithPosition(line, column int) ValidationError {
+	return e.WithContextMap(map[string]string{
+		ContextLine:   strconv.Itoa(line),
+		ContextColumn: strconv.Itoa(column),
+	})
+}
+
+// WithSuggestion records replacement text that fixes the failure.
+func (e ValidationError) WithSuggestion(suggestion string) ValidationError {
+	return e.WithContextMap(map[string]string{ContextSuggestion: suggestion})
+}
@@ -0,0 +1,70 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package domain_test
+
+import (
+	"testing"
+
+	"github.com/itiquette/gommitlint/internal/domain"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidationError_ContextAccessors(t *testing.T) {
+	err := domain.New("Subject", domain.ErrSubjectTooLong, "Subject too long").
+		WithContextMap(map[string]string{"expected": "max 72", "actual": "80"}).
+		WithSuggestion("shorter subject").
+		WithPosition(1, 73)
+
+	require.Equal(t, "max 72", err.Expected())
+	require.Equal(t, "80", err.Actual())
+	require.Equal(t, "shorter subject", err.Suggestion())
+
+	position, ok := err.Position()
+	require.True(t, ok)
+	require.Equal(t, domain.Position{Line: 1, Column: 73}, position)
+	require.Equal(t, "73", err.Context[domain.ContextColumn])
+}
+
+func TestValidationError_Position(t *testing.T) {
+	tests := []struct {
+		name     string
+		context  map[string]string
+		expected domain.Position
+		valid    bool
+	}{
+		{
+			name:     "line and column",
+			context:  map[string]string{"line": "2", "column": "5"},
+			expected: domain.Position{Line: 2, Column: 5},
+			valid:    true,
+		},
+		{
+			name:    "no position",
+			context: map[string]string{"actual": "80"},
+		},
+		{
+			name:    "line without column",
+			context: map[string]string{"line": "2"},
+		},
+		{
+			name:    "not a number",
+			context: map[string]string{"line": "first", "column": "5"},
+		},
+		{
+			name:    "zero column",
+			context: map[string]string{"line": "1", "column": "0"},
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			err := domain.New("Rule", domain.ErrInvalidFormat, "failure").WithContextMap(testCase.context)
+
+			position, ok := err.Position()
+			require.Equal(t, testCase.valid, ok)
+			require.Equal(t, testCase.expected, position)
+		})
+	}
+}
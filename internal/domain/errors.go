@@ -44,6 +44,9 @@ const (
 	ErrBodyTooShort     ValidationErrorCode = "body_too_short"
 	ErrMissingBlankLine ValidationErrorCode = "missing_blank_line"
 	ErrInvalidStructure ValidationErrorCode = "invalid_structure"
+	ErrBodyTooFewWords  ValidationErrorCode = "body_too_few_words"
+	ErrTooFewParagraphs ValidationErrorCode = "too_few_paragraphs"
+	ErrRepeatedSubject  ValidationErrorCode = "repeated_subject"
 
 	// Conventional commit errors.
 	ErrInvalidType               ValidationErrorCode = "invalid_type"
@@ -58,6 +61,11 @@ const (
 	ErrInvalidMultiScope         ValidationErrorCode = "invalid_multi_scope"
 	ErrInvalidSpacing            ValidationErrorCode = "invalid_spacing"
 	ErrEmptyConventionalDesc     ValidationErrorCode = "empty_conventional_desc"
+	ErrConventionalScopeCase     ValidationErrorCode = "conventional_scope_case"
+	ErrConventionalScopeTooLong  ValidationErrorCode = "conventional_scope_too_long"
+	ErrConventionalScopeChars    ValidationErrorCode = "conventional_scope_characters"
+	ErrTooManyConventionalScopes ValidationErrorCode = "too_many_conventional_scopes"
+	ErrConventionalTypeAlias     ValidationErrorCode = "conventional_type_alias"
 
 	// Jira errors.
 	ErrMissingJira           ValidationErrorCode = "missing_jira"
@@ -77,12 +85,33 @@ const (
 	ErrInvalidKeyFormat      ValidationErrorCode = "invalid_key_format"
 	ErrRefsAfterSignoff      ValidationErrorCode = "refs_after_signoff"
 
+	// JIRA ticket errors of the online mode.
+	ErrJiraTicketNotFound ValidationErrorCode = "jira_ticket_not_found"
+	ErrJiraTicketClosed   ValidationErrorCode = "jira_ticket_closed"
+	ErrJiraTicketProject  ValidationErrorCode = "jira_ticket_project"
+	ErrJiraTicketSprint   ValidationErrorCode = "jira_ticket_sprint"
+	ErrJiraLookupFailed   ValidationErrorCode = "jira_lookup_failed"
+
+	// GitHub issue errors.
+	ErrIssueNotFound     ValidationErrorCode = "issue_not_found"
+	ErrIssueClosed       ValidationErrorCode = "issue_closed"
+	ErrIssueLookupFailed ValidationErrorCode = "issue_lookup_failed"
+
+	// Branch ticket errors.
+	ErrBranchTicketMismatch ValidationErrorCode = "branch_ticket_mismatch"
+	ErrBranchTicketMissing  ValidationErrorCode = "branch_ticket_missing"
+
+	// Subject echo errors.
+	ErrSubjectEchoesBranch ValidationErrorCode = "subject_echoes_branch"
+	ErrSubjectEchoesTicket ValidationErrorCode = "subject_echoes_ticket"
+
 	// Imperative mood errors.
-	ErrNonImperative ValidationErrorCode = "non_imperative"
-	ErrNonVerb       ValidationErrorCode = "non_verb"
-	ErrPastTense     ValidationErrorCode = "past_tense"
-	ErrGerund        ValidationErrorCode = "gerund"
-	ErrThirdPerson   ValidationErrorCode = "third_person"
+	ErrNonImperative  ValidationErrorCode = "non_imperative"
+	ErrNonVerb        ValidationErrorCode = "non_verb"
+	ErrPastTense      ValidationErrorCode = "past_tense"
+	ErrGerund         ValidationErrorCode = "gerund"
+	ErrThirdPerson    ValidationErrorCode = "third_person"
+	ErrVerbNotAllowed ValidationErrorCode = "verb_not_allowed"
 
 	// Signature errors.
 	ErrCommitNil              ValidationErrorCode = "commit_nil"
@@ -100,28 +129,99 @@ const (
 	ErrIncompleteSSHSig       ValidationErrorCode = "incomplete_ssh_signature"
 	ErrInvalidGPGFormat       ValidationErrorCode = "invalid_gpg_format"
 	ErrInvalidSSHFormat       ValidationErrorCode = "invalid_ssh_format"
+	ErrLightweightTag         ValidationErrorCode = "lightweight_tag"
 	ErrInvalidCommit          ValidationErrorCode = "invalid_commit"
+	ErrBadPushNonce           ValidationErrorCode = "bad_push_nonce"
 
 	// Signoff errors.
 	ErrMissingSignoff       ValidationErrorCode = "missing_signoff"
 	ErrInvalidSignoffFormat ValidationErrorCode = "invalid_signoff_format"
 	ErrMisplacedSignoff     ValidationErrorCode = "misplaced_signoff"
 	ErrInsufficientSignoffs ValidationErrorCode = "insufficient_signoffs"
+	ErrSignoffNotAuthor     ValidationErrorCode = "signoff_not_author"
+
+	// Trailer errors.
+	ErrUnknownTrailer   ValidationErrorCode = "unknown_trailer"
+	ErrTrailerCase      ValidationErrorCode = "trailer_case"
+	ErrDuplicateTrailer ValidationErrorCode = "duplicate_trailer"
+	ErrTrailerOrder     ValidationErrorCode = "trailer_order"
+	ErrMissingTrailer   ValidationErrorCode = "missing_trailer"
+	ErrTrailerValue     ValidationErrorCode = "invalid_trailer_value"
+
+	// Character errors.
+	ErrControlCharacter   ValidationErrorCode = "control_character"
+	ErrZeroWidthCharacter ValidationErrorCode = "zero_width_character"
+	ErrBidiCharacter      ValidationErrorCode = "bidi_character"
+	ErrNonASCIICharacter  ValidationErrorCode = "non_ascii_character"
+	ErrDisallowedScript   ValidationErrorCode = "disallowed_script"
+
+	// Link errors.
+	ErrInvalidURL          ValidationErrorCode = "invalid_url"
+	ErrInsecureURL         ValidationErrorCode = "insecure_url"
+	ErrDisallowedURLDomain ValidationErrorCode = "disallowed_url_domain"
+	ErrForbiddenURL        ValidationErrorCode = "forbidden_url"
+
+	// Review errors.
+	ErrMissingReview   ValidationErrorCode = "missing_review"
+	ErrInvalidReviewer ValidationErrorCode = "invalid_reviewer"
+
+	// Encoding errors.
+	ErrUndecodableMessage ValidationErrorCode = "undecodable_message"
+
+	// Change-Id errors.
+	ErrMissingChangeID   ValidationErrorCode = "missing_change_id"
+	ErrInvalidChangeID   ValidationErrorCode = "invalid_change_id"
+	ErrMultipleChangeIDs ValidationErrorCode = "multiple_change_ids"
+	ErrForbiddenChangeID ValidationErrorCode = "forbidden_change_id"
+
+	// Breaking change errors.
+	ErrBreakingChangeBodyTooShort   ValidationErrorCode = "breaking_change_body_too_short"
+	ErrMissingMigration             ValidationErrorCode = "missing_migration_section"
+	ErrBreakingChangeBranch         ValidationErrorCode = "breaking_change_branch"
+	ErrMissingBreakingChangeTrailer ValidationErrorCode = "missing_breaking_change_trailer"
+
+	// Revert errors.
+	ErrMissingRevertReference ValidationErrorCode = "missing_revert_reference"
+	ErrRevertedCommitNotFound ValidationErrorCode = "reverted_commit_not_found"
+	ErrInvalidRevertFormat    ValidationErrorCode = "invalid_revert_format"
+
+	// Fixup errors.
+	ErrFixupTargetNotFound    ValidationErrorCode = "fixup_target_not_found"
+	ErrFixupOnProtectedBranch ValidationErrorCode = "fixup_on_protected_branch"
+
+	// Force push errors.
+	ErrForcePushRequired        ValidationErrorCode = "force_push_required"
+	ErrPublishedCommitRewritten ValidationErrorCode = "published_commit_rewritten"
+
+	// Commit size errors.
+	ErrTooManyChangedLines ValidationErrorCode = "too_many_changed_lines"
+	ErrTooManyChangedFiles ValidationErrorCode = "too_many_changed_files"
+
+	// Contributor license agreement errors.
+	ErrCLANotSigned    ValidationErrorCode = "cla_not_signed"
+	ErrCLALookupFailed ValidationErrorCode = "cla_lookup_failed"
 
 	// Spelling errors.
 	ErrSpelling         ValidationErrorCode = "spelling_error"
 	ErrMisspelledWord   ValidationErrorCode = "misspelled_word"
 	ErrSpellCheckFailed ValidationErrorCode = "spell_check_failed"
 
-	// Commits ahead errors.
-	ErrTooManyCommits ValidationErrorCode = "too_many_commits"
+	// Commits ahead and behind errors.
+	ErrTooManyCommits       ValidationErrorCode = "too_many_commits"
+	ErrTooManyCommitsBehind ValidationErrorCode = "too_many_commits_behind"
+
+	// Linear history errors.
+	ErrMergeCommit      ValidationErrorCode = "merge_commit"
+	ErrNonLinearHistory ValidationErrorCode = "non_linear_history"
 
 	// Git operation errors.
 	ErrInvalidRepo        ValidationErrorCode = "invalid_repo"
 	ErrInvalidConfig      ValidationErrorCode = "invalid_config"
 	ErrCancelled          ValidationErrorCode = "operation_cancelled"
 	ErrGitOperationFailed ValidationErrorCode = "git_operation_failed"
+	ErrInsufficientData   ValidationErrorCode = "insufficient_data"
 	ErrContextCancelled   ValidationErrorCode = "context_cancelled"
+	ErrTimeout            ValidationErrorCode = "timeout"
 	ErrCommitNotFound     ValidationErrorCode = "commit_not_found"
 	ErrRangeNotFound      ValidationErrorCode = "range_not_found"
 
@@ -129,11 +229,40 @@ const (
 	ErrInvalidReference ValidationErrorCode = "invalid_reference"
 	ErrMissingReference ValidationErrorCode = "missing_reference"
 
+	// Plugin errors.
+	ErrPluginFailed ValidationErrorCode = "plugin_failed"
+
+	// Subject pattern errors.
+	ErrSubjectPatternMismatch ValidationErrorCode = "subject_pattern_mismatch"
+	ErrSubjectPatternGroup    ValidationErrorCode = "subject_pattern_group"
+
+	// Template placeholder errors.
+	ErrTemplatePlaceholder ValidationErrorCode = "template_placeholder"
+	ErrTemplateComment     ValidationErrorCode = "template_comment"
+
+	// Message hygiene errors.
+	ErrWhitespaceOnlyBody ValidationErrorCode = "whitespace_only_body"
+	ErrDiffInBody         ValidationErrorCode = "diff_in_body"
+	ErrConflictMarkers    ValidationErrorCode = "conflict_markers"
+
+	// Message language errors.
+	ErrWrongLanguage ValidationErrorCode = "wrong_language"
+
+	// Merge subject errors.
+	ErrMergeSubjectMismatch ValidationErrorCode = "merge_subject_mismatch"
+
+	// Custom rule errors.
+	ErrPatternMismatch  ValidationErrorCode = "pattern_mismatch"
+	ErrForbiddenPattern ValidationErrorCode = "forbidden_pattern"
+
 	// Max length errors.
 	ErrMaxLengthExceeded ValidationErrorCode = "max_length_exceeded"
 
 	// Generic errors.
 	ErrUnknown ValidationErrorCode = "unknown_error"
+
+	// ErrRuleDetails marks the details a rule reports about what it checked, see NewDetails.
+	ErrRuleDetails ValidationErrorCode = "rule_details"
 )
 
 // ValidationError represents an error detected during validation.
@@ -153,6 +282,9 @@ type ValidationError struct {
 
 	// Context contains additional information about the error.
 	Context map[string]string
+
+	// Severity is the severity of the violation. An empty severity is treated as an error.
+	Severity SeverityLevel
 }
 
 // Error implements the error interface.
@@ -187,6 +319,20 @@ func (e ValidationError) WithHelp(help string) ValidationError {
 	return result
 }
 
+// WithSeverity sets the severity of a ValidationError.
+func (e ValidationError) WithSeverity(severity SeverityLevel) ValidationError {
+	result := e
+	result.Severity = severity
+
+	return result
+}
+
+// IsBlocking returns true if the error should fail validation.
+// Warnings and informational findings are reported without failing.
+func (e ValidationError) IsBlocking() bool {
+	return e.Severity != SeverityWarning && e.Severity != SeverityInfo
+}
+
 // WithUserMessage updates the error message with a user-friendly version.
 // This allows providing clearer, more actionable messages while preserving the original technical message.
 func (e ValidationError) WithUserMessage(format string, args ...interface{}) ValidationError {
@@ -206,3 +352,15 @@ func New(rule string, code ValidationErrorCode, message string) ValidationError
 		Context: make(map[string]string),
 	}
 }
+
+// NewDetails creates the details rule reports about what it checked, such as the key that
+// verified a signature. Details are not findings: they are taken out of the errors of a
+// rule and reported with it whether it passed or failed.
+func NewDetails(rule string, details map[string]string) ValidationError {
+	return New(rule, ErrRuleDetails, "").WithContextMap(details).WithSeverity(SeverityInfo)
+}
+
+// IsDetails reports whether e holds the details of a rule rather than a finding.
+func (e ValidationError) IsDetails() bool {
+	return e.Code == string(ErrRuleDetails)
+}
@@ -0,0 +1,17 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package domain
+
+import "context"
+
+// HistoryResolver defines the contract for the range semantics used by pull requests.
+type HistoryResolver interface {
+	// GetMergeBase returns the hash of the best common ancestor of two refs.
+	GetMergeBase(ctx context.Context, ref, other string) (string, error)
+
+	// GetFirstParentRange returns the commits on the first-parent chain of to
+	// that are not reachable from from, newest first.
+	GetFirstParentRange(ctx context.Context, from, to string) ([]Commit, error)
+}
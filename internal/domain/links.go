@@ -0,0 +1,92 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package domain
+
+import (
+	"context"
+	"net/url"
+	"strings"
+)
+
+// AutoLinkTemplate selects the link template derived from the URL of the origin remote.
+const AutoLinkTemplate = "auto"
+
+// RemoteURLResolver defines the contract for finding where a repository is hosted.
+type RemoteURLResolver interface {
+	// GetRemoteURL returns the fetch URL of remote, such as origin.
+	GetRemoteURL(ctx context.Context, remote string) (string, error)
+}
+
+// CommitLink returns the URL of the commit with hash, from a template holding {hash}
+// and {short_hash}. It is empty when template is.
+func CommitLink(template, hash string) string {
+	if template == "" || hash == "" {
+		return ""
+	}
+
+	return strings.NewReplacer("{hash}", hash, "{short_hash}", ShortHash(hash)).Replace(template)
+}
+
+// CommitLinkTemplate derives the template of commit links from a remote URL in the
+// https, ssh or scp-like form, such as git@github.com:org/repo.git. Commits are linked
+// the way GitLab and Bitbucket lay out their pages when the host is named after them,
+// and the way GitHub, Gitea and Forgejo do otherwise. Remotes that are local paths have
+// no template.
+func CommitLinkTemplate(remoteURL string) string {
+	host, repoPath, ok := splitRemoteURL(strings.TrimSpace(remoteURL))
+	if !ok {
+		return ""
+	}
+
+	repoPath = strings.TrimSuffix(strings.Trim(repoPath, "/"), ".git")
+	if repoPath == "" {
+		return ""
+	}
+
+	base := "https://" + host + "/" + repoPath
+
+	switch {
+	case strings.Contains(host, "gitlab"):
+		return base + "/-/commit/{hash}"
+	case strings.Contains(host, "bitbucket"):
+		return base + "/commits/{hash}"
+	default:
+		return base + "/commit/{hash}"
+	}
+}
+
+// splitRemoteURL returns the host, without user and port, and the path of a remote URL.
+func splitRemoteURL(remoteURL string) (string, string, bool) {
+	if strings.Contains(remoteURL, "://") {
+		parsed, err := url.Parse(remoteURL)
+		if err != nil || parsed.Hostname() == "" {
+			return "", "", false
+		}
+
+		switch parsed.Scheme {
+		case "http", "https", "ssh", "git", "git+ssh", "ssh+git":
+			return parsed.Hostname(), parsed.Path, true
+		default:
+			return "", "", false
+		}
+	}
+
+	// The scp-like form [user@]host:path, which has no slash before the colon
+	hostPart, repoPath, found := strings.Cut(remoteURL, ":")
+	if !found || strings.Contains(hostPart, "/") {
+		return "", "", false
+	}
+
+	if _, host, hasUser := strings.Cut(hostPart, "@"); hasUser {
+		hostPart = host
+	}
+
+	// A single letter is the drive of a Windows path, such as C:\repo
+	if len(hostPart) < 2 {
+		return "", "", false
+	}
+
+	return hostPart, repoPath, true
+}
@@ -0,0 +1,78 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package domain_test
+
+import (
+	"testing"
+
+	"github.com/itiquette/gommitlint/internal/domain"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCommitLinkTemplate(t *testing.T) {
+	tests := []struct {
+		name      string
+		remoteURL string
+		expected  string
+	}{
+		{
+			name:      "github https",
+			remoteURL: "https://github.com/org/repo.git",
+			expected:  "https://github.com/org/repo/commit/{hash}",
+		},
+		{
+			name:      "github scp-like",
+			remoteURL: "git@github.com:org/repo.git",
+			expected:  "https://github.com/org/repo/commit/{hash}",
+		},
+		{
+			name:      "gitlab subgroup over ssh with port",
+			remoteURL: "ssh://git@gitlab.example.com:2222/group/sub/repo.git",
+			expected:  "https://gitlab.example.com/group/sub/repo/-/commit/{hash}",
+		},
+		{
+			name:      "bitbucket with user",
+			remoteURL: "https://dev@bitbucket.org/team/repo.git",
+			expected:  "https://bitbucket.org/team/repo/commits/{hash}",
+		},
+		{
+			name:      "without .git suffix",
+			remoteURL: "https://codeberg.org/org/repo",
+			expected:  "https://codeberg.org/org/repo/commit/{hash}",
+		},
+		{
+			name:      "local path",
+			remoteURL: "/srv/git/repo.git",
+		},
+		{
+			name:      "file url",
+			remoteURL: "file:///srv/git/repo.git",
+		},
+		{
+			name:      "windows path",
+			remoteURL: `C:\git\repo.git`,
+		},
+		{
+			name: "empty",
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			require.Equal(t, testCase.expected, domain.CommitLinkTemplate(testCase.remoteURL))
+		})
+	}
+}
+
+func TestCommitLink(t *testing.T) {
+	hash := "0123456789abcdef0123456789abcdef01234567"
+
+	require.Equal(t, "https://github.com/org/repo/commit/"+hash,
+		domain.CommitLink("https://github.com/org/repo/commit/{hash}", hash))
+	require.Equal(t, "https://ci.example.com/builds?commit=0123456",
+		domain.CommitLink("https://ci.example.com/builds?commit={short_hash}", hash))
+	require.Empty(t, domain.CommitLink("", hash))
+	require.Empty(t, domain.CommitLink("https://github.com/org/repo/commit/{hash}", ""))
+}
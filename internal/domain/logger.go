@@ -4,6 +4,15 @@
 
 package domain
 
+// Field names of log lines, shared so that JSON logs can be queried the same way
+// whichever component wrote them.
+const (
+	LogFieldComponent  = "component"
+	LogFieldCommit     = "commit"
+	LogFieldRule       = "rule"
+	LogFieldDurationMs = "duration_ms"
+)
+
 // Logger defines logging interface for domain layer.
 // This interface allows domain functions to log without depending on specific logging implementations.
 type Logger interface {
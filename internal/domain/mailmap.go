@@ -0,0 +1,128 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package domain
+
+import "strings"
+
+// Mailmap maps identities recorded in commits to canonical identities, following
+// the format of git's .mailmap file.
+type Mailmap struct {
+	entries []mailmapEntry
+}
+
+// mailmapEntry is one .mailmap line. Empty proper fields keep the recorded value,
+// and an empty commit name matches any name.
+type mailmapEntry struct {
+	properName  string
+	properEmail string
+	commitName  string
+	commitEmail string
+}
+
+// ParseMailmap parses the content of a .mailmap file. Malformed lines are ignored, as git does.
+func ParseMailmap(content string) Mailmap {
+	var mailmap Mailmap
+
+	for _, line := range strings.Split(content, "\n") {
+		if index := strings.Index(line, "#"); index >= 0 {
+			line = line[:index]
+		}
+
+		if entry, ok := parseMailmapLine(line); ok {
+			mailmap.entries = append(mailmap.entries, entry)
+		}
+	}
+
+	return mailmap
+}
+
+// parseMailmapLine parses the forms
+//
+//	Proper Name <commit@email>
+//	<proper@email> <commit@email>
+//	Proper Name <proper@email> <commit@email>
+//	Proper Name <proper@email> Commit Name <commit@email>
+func parseMailmapLine(line string) (mailmapEntry, bool) {
+	firstName, firstEmail, rest, ok := cutMailmapIdentity(line)
+	if !ok {
+		return mailmapEntry{}, false
+	}
+
+	secondName, secondEmail, _, ok := cutMailmapIdentity(rest)
+	if !ok {
+		// A single identity maps the name used with that email
+		return mailmapEntry{properName: firstName, commitEmail: firstEmail}, firstName != ""
+	}
+
+	return mailmapEntry{
+		properName:  firstName,
+		properEmail: firstEmail,
+		commitName:  secondName,
+		commitEmail: secondEmail,
+	}, true
+}
+
+// cutMailmapIdentity splits the leading "Name <email>" off text.
+func cutMailmapIdentity(text string) (string, string, string, bool) {
+	name, rest, found := strings.Cut(text, "<")
+	if !found {
+		return "", "", "", false
+	}
+
+	email, rest, found := strings.Cut(rest, ">")
+	if !found {
+		return "", "", "", false
+	}
+
+	return strings.TrimSpace(name), strings.TrimSpace(email), rest, true
+}
+
+// IsEmpty returns true if the mailmap has no entries.
+func (m Mailmap) IsEmpty() bool {
+	return len(m.entries) == 0
+}
+
+// Resolve returns the canonical identity for identity. Entries naming the commit name
+// take precedence over entries matching on email only; names and emails compare
+// case-insensitively.
+func (m Mailmap) Resolve(identity Identity) Identity {
+	var match *mailmapEntry
+
+	for index := range m.entries {
+		entry := &m.entries[index]
+		if !strings.EqualFold(entry.commitEmail, identity.Email()) {
+			continue
+		}
+
+		if entry.commitName == "" {
+			if match == nil {
+				match = entry
+			}
+
+			continue
+		}
+
+		if strings.EqualFold(entry.commitName, identity.Name()) {
+			match = entry
+
+			break
+		}
+	}
+
+	if match == nil {
+		return identity
+	}
+
+	name, email := identity.Name(), identity.Email()
+	if match.properName != "" {
+		name = match.properName
+	}
+
+	if match.properEmail != "" {
+		email = match.properEmail
+	}
+
+	return NewIdentity(name, email)
+}
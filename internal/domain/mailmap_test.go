@@ -0,0 +1,67 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package domain_test
+
+import (
+	"testing"
+
+	"github.com/itiquette/gommitlint/internal/domain"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMailmapResolve(t *testing.T) {
+	mailmap := domain.ParseMailmap(`# Canonical identities
+Jane Doe <jane@example.com>
+<jane@example.com> <jane@old.example.com>
+John Smith <john@example.com> <jsmith@example.com>
+Build Bot <bot@example.com> ci <shared@example.com>
+malformed line <without closing
+`)
+
+	tests := []struct {
+		name     string
+		identity domain.Identity
+		expected string
+	}{
+		{
+			name:     "name mapped by email",
+			identity: domain.NewIdentity("jane", "jane@example.com"),
+			expected: "Jane Doe <jane@example.com>",
+		},
+		{
+			name:     "email mapped, name kept",
+			identity: domain.NewIdentity("Jane D", "Jane@Old.Example.com"),
+			expected: "Jane D <jane@example.com>",
+		},
+		{
+			name:     "name and email mapped",
+			identity: domain.NewIdentity("js", "jsmith@example.com"),
+			expected: "John Smith <john@example.com>",
+		},
+		{
+			name:     "entry with commit name matches that name only",
+			identity: domain.NewIdentity("CI", "shared@example.com"),
+			expected: "Build Bot <bot@example.com>",
+		},
+		{
+			name:     "other name on shared email",
+			identity: domain.NewIdentity("Someone", "shared@example.com"),
+			expected: "Someone <shared@example.com>",
+		},
+		{
+			name:     "unknown identity",
+			identity: domain.NewIdentity("Other", "other@example.com"),
+			expected: "Other <other@example.com>",
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			require.Equal(t, testCase.expected, mailmap.Resolve(testCase.identity).String())
+		})
+	}
+
+	require.True(t, domain.ParseMailmap("# only comments\n").IsEmpty())
+}
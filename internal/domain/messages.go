@@ -0,0 +1,108 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package domain
+
+import (
+	"strings"
+	"text/template"
+
+	"github.com/itiquette/gommitlint/internal/domain/config"
+)
+
+// ruleConfigNames maps rule names that differ from their configuration name.
+var ruleConfigNames = map[string]string{
+	"conventionalcommit": "conventional",
+}
+
+// messageTemplateData is the data available to message and help templates.
+type messageTemplateData struct {
+	Rule    string
+	Code    string
+	Message string
+	Help    string
+	Context map[string]string
+}
+
+// ApplyMessageTemplates rewrites the message and help of errors using the templates configured for their rule.
+// A template that fails to render leaves the original text in place.
+func ApplyMessageTemplates(errors []ValidationError, messages config.MessagesConfig) []ValidationError {
+	if len(messages) == 0 || len(errors) == 0 {
+		return errors
+	}
+
+	result := make([]ValidationError, len(errors))
+
+	for i, err := range errors {
+		templateCfg, found := FindMessageTemplate(messages, err.Rule)
+		if !found {
+			result[i] = err
+
+			continue
+		}
+
+		result[i] = RenderMessageTemplate(err, templateCfg)
+	}
+
+	return result
+}
+
+// FindMessageTemplate returns the templates configured for a rule.
+// Templates are looked up by rule name or configuration name, ignoring case.
+func FindMessageTemplate(messages config.MessagesConfig, rule string) (config.MessageTemplateConfig, bool) {
+	name := CleanRuleName(rule)
+	configName := ruleConfigNames[name]
+
+	for key, templateCfg := range messages {
+		cleanKey := CleanRuleName(key)
+		if cleanKey == name || (configName != "" && cleanKey == configName) {
+			return templateCfg, true
+		}
+	}
+
+	return config.MessageTemplateConfig{}, false
+}
+
+// RenderMessageTemplate returns a copy of err with the given templates applied.
+// Empty templates leave the corresponding text unchanged.
+func RenderMessageTemplate(err ValidationError, templateCfg config.MessageTemplateConfig) ValidationError {
+	data := messageTemplateData{
+		Rule:    err.Rule,
+		Code:    err.Code,
+		Message: err.Message,
+		Help:    err.Help,
+		Context: err.Context,
+	}
+
+	result := err
+
+	if message, ok := renderTemplate(templateCfg.Message, data); ok {
+		result.Message = message
+	}
+
+	if help, ok := renderTemplate(templateCfg.Help, data); ok {
+		result.Help = help
+	}
+
+	return result
+}
+
+// renderTemplate executes text as a template, reporting false if text is empty or fails to render.
+func renderTemplate(text string, data messageTemplateData) (string, bool) {
+	if text == "" {
+		return "", false
+	}
+
+	tmpl, err := template.New("message").Option("missingkey=zero").Parse(text)
+	if err != nil {
+		return "", false
+	}
+
+	var builder strings.Builder
+	if err := tmpl.Execute(&builder, data); err != nil {
+		return "", false
+	}
+
+	return builder.String(), true
+}
@@ -0,0 +1,88 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package domain_test
+
+import (
+	"testing"
+
+	"github.com/itiquette/gommitlint/internal/domain"
+	"github.com/itiquette/gommitlint/internal/domain/config"
+	"github.com/stretchr/testify/require"
+)
+
+func TestApplyMessageTemplates(t *testing.T) {
+	subjectErr := domain.New("Subject", domain.ErrSubjectTooLong, "Subject too long").
+		WithContextMap(map[string]string{"actual": "80", "expected": "72"}).
+		WithHelp("Shorten the subject")
+	conventionalErr := domain.New("ConventionalCommit", domain.ErrInvalidType, "Invalid type").
+		WithHelp("Use a valid type")
+
+	tests := []struct {
+		name            string
+		messages        config.MessagesConfig
+		err             domain.ValidationError
+		expectedMessage string
+		expectedHelp    string
+	}{
+		{
+			name: "message and help with context",
+			messages: config.MessagesConfig{
+				"subject": {
+					Message: "Ämnesraden är för lång ({{.Context.actual}}/{{.Context.expected}})",
+					Help:    "{{.Help}}. See https://wiki.example.com/commits#{{.Code}}",
+				},
+			},
+			err:             subjectErr,
+			expectedMessage: "Ämnesraden är för lång (80/72)",
+			expectedHelp:    "Shorten the subject. See https://wiki.example.com/commits#subject_too_long",
+		},
+		{
+			name:            "only help overridden",
+			messages:        config.MessagesConfig{"Subject": {Help: "See CONTRIBUTING.md"}},
+			err:             subjectErr,
+			expectedMessage: "Subject too long",
+			expectedHelp:    "See CONTRIBUTING.md",
+		},
+		{
+			name:            "configuration name of rule",
+			messages:        config.MessagesConfig{"conventional": {Message: "{{.Rule}}: {{.Message}}"}},
+			err:             conventionalErr,
+			expectedMessage: "ConventionalCommit: Invalid type",
+			expectedHelp:    "Use a valid type",
+		},
+		{
+			name:            "other rule untouched",
+			messages:        config.MessagesConfig{"signoff": {Message: "Sign off"}},
+			err:             subjectErr,
+			expectedMessage: "Subject too long",
+			expectedHelp:    "Shorten the subject",
+		},
+		{
+			name:            "missing context value renders empty",
+			messages:        config.MessagesConfig{"subject": {Message: "[{{.Context.missing}}]"}},
+			err:             subjectErr,
+			expectedMessage: "[]",
+			expectedHelp:    "Shorten the subject",
+		},
+		{
+			name:            "failing template keeps original",
+			messages:        config.MessagesConfig{"subject": {Message: "{{.Unknown}}"}},
+			err:             subjectErr,
+			expectedMessage: "Subject too long",
+			expectedHelp:    "Shorten the subject",
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			result := domain.ApplyMessageTemplates([]domain.ValidationError{testCase.err}, testCase.messages)
+
+			require.Len(t, result, 1)
+			require.Equal(t, testCase.expectedMessage, result[0].Message)
+			require.Equal(t, testCase.expectedHelp, result[0].Help)
+			require.Equal(t, testCase.err.Code, result[0].Code)
+		})
+	}
+}
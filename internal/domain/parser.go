@@ -0,0 +1,146 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package domain
+
+import (
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Message parsers of message.parser.
+const (
+	// ParserGit splits messages the way git does: the first line is the subject, the
+	// paragraphs after the blank line below it are the body, and the last paragraph is
+	// the trailer block when it holds only trailers.
+	ParserGit = "git"
+
+	// ParserGerrit splits messages as ParserGit does, and leaves the Change-Id trailers
+	// added by the commit-msg hook of Gerrit out of the body.
+	ParserGerrit = "gerrit"
+
+	// ParserGitmoji splits messages as ParserGit does, and takes the gitmoji at the start
+	// of the subject, such as ✨ or :sparkles:, out of the subject.
+	ParserGitmoji = "gitmoji"
+)
+
+// MessageParsers lists the supported message parsers.
+func MessageParsers() []string {
+	return []string{ParserGit, ParserGerrit, ParserGitmoji}
+}
+
+// ParsedMessage is a commit message split into the parts rules check.
+type ParsedMessage struct {
+	Subject  string
+	Body     string
+	Trailers []Trailer
+	Gitmoji  string // Gitmoji taken out of the subject, by ParserGitmoji only
+}
+
+// MessageParser splits commit messages into their parts.
+type MessageParser interface {
+	Parse(message string) ParsedMessage
+}
+
+// NewMessageParser returns the parser named name, one of MessageParsers. Unknown names,
+// which configuration validation reports, and an empty name select ParserGit.
+func NewMessageParser(name string) MessageParser {
+	switch name {
+	case ParserGerrit:
+		return GerritParser{}
+	case ParserGitmoji:
+		return GitmojiParser{}
+	default:
+		return GitParser{}
+	}
+}
+
+// GitParser implements ParserGit.
+type GitParser struct{}
+
+// Parse splits message into subject, body and trailers.
+func (GitParser) Parse(message string) ParsedMessage {
+	subject, body := SplitCommitMessage(message)
+
+	return ParsedMessage{Subject: subject, Body: body, Trailers: ParseTrailers(body)}
+}
+
+// GerritParser implements ParserGerrit.
+type GerritParser struct{}
+
+// Parse splits message as GitParser does, without the Change-Id trailers in the body, so
+// that a message of a subject and a Change-Id has no body.
+func (GerritParser) Parse(message string) ParsedMessage {
+	parsed := GitParser{}.Parse(message)
+	if len(TrailerValues(parsed.Trailers, ChangeIDTrailer)) == 0 {
+		return parsed
+	}
+
+	paragraphs := strings.Split(strings.ReplaceAll(parsed.Body, "\r\n", "\n"), "\n\n")
+
+	var kept []string
+
+	for _, line := range strings.Split(paragraphs[len(paragraphs)-1], "\n") {
+		if key, _, found := strings.Cut(line, ":"); !found || !strings.EqualFold(strings.TrimSpace(key), ChangeIDTrailer) {
+			kept = append(kept, line)
+		}
+	}
+
+	paragraphs = paragraphs[:len(paragraphs)-1]
+	if block := strings.TrimSpace(strings.Join(kept, "\n")); block != "" {
+		paragraphs = append(paragraphs, block)
+	}
+
+	parsed.Body = strings.TrimSpace(strings.Join(paragraphs, "\n\n"))
+
+	return parsed
+}
+
+// GitmojiParser implements ParserGitmoji.
+type GitmojiParser struct{}
+
+// gitmojiCodePattern matches a gitmoji written as its code, such as :sparkles:.
+var gitmojiCodePattern = regexp.MustCompile(`^:[a-z0-9_+-]+:`)
+
+// Parse splits message as GitParser does, with the gitmoji at the start of the subject
+// moved from Subject to Gitmoji. The gitmoji must be followed by a space.
+func (GitmojiParser) Parse(message string) ParsedMessage {
+	parsed := GitParser{}.Parse(message)
+
+	gitmoji := gitmojiCodePattern.FindString(parsed.Subject)
+	if gitmoji == "" {
+		gitmoji = leadingEmoji(parsed.Subject)
+	}
+
+	rest, found := strings.CutPrefix(parsed.Subject, gitmoji+" ")
+	if gitmoji == "" || !found {
+		return parsed
+	}
+
+	parsed.Gitmoji = gitmoji
+	parsed.Subject = strings.TrimSpace(rest)
+
+	return parsed
+}
+
+// leadingEmoji returns the emoji at the start of text: symbols with their variation
+// selectors, skin tone modifiers and zero width joiners.
+func leadingEmoji(text string) string {
+	end := 0
+
+	for end < len(text) {
+		char, size := utf8.DecodeRuneInString(text[end:])
+		emoji := unicode.Is(unicode.So, char) || unicode.Is(unicode.Sk, char) ||
+			unicode.Is(unicode.Variation_Selector, char) || char == '\u200d'
+		if !emoji || char <= unicode.MaxASCII {
+			break
+		}
+
+		end += size
+	}
+
+	return text[:end]
+}
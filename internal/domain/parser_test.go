@@ -0,0 +1,149 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package domain_test
+
+import (
+	"testing"
+
+	"github.com/itiquette/gommitlint/internal/domain"
+	"github.com/itiquette/gommitlint/internal/domain/config"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMessageParsers(t *testing.T) {
+	tests := []struct {
+		name     string
+		parser   string
+		message  string
+		expected domain.ParsedMessage
+	}{
+		{
+			name:    "git",
+			parser:  domain.ParserGit,
+			message: "fix: handle empty input\n\nInput may be empty.\n\nChange-Id: I1234\nRefs: #42",
+			expected: domain.ParsedMessage{
+				Subject:  "fix: handle empty input",
+				Body:     "Input may be empty.\n\nChange-Id: I1234\nRefs: #42",
+				Trailers: []domain.Trailer{{Key: "Change-Id", Value: "I1234"}, {Key: "Refs", Value: "#42"}},
+			},
+		},
+		{
+			name:    "gerrit leaves Change-Id out of the body",
+			parser:  domain.ParserGerrit,
+			message: "fix: handle empty input\n\nInput may be empty.\n\nChange-Id: I1234\nRefs: #42",
+			expected: domain.ParsedMessage{
+				Subject:  "fix: handle empty input",
+				Body:     "Input may be empty.\n\nRefs: #42",
+				Trailers: []domain.Trailer{{Key: "Change-Id", Value: "I1234"}, {Key: "Refs", Value: "#42"}},
+			},
+		},
+		{
+			name:    "gerrit message of a subject and a Change-Id has no body",
+			parser:  domain.ParserGerrit,
+			message: "fix: handle empty input\n\nChange-Id: I1234",
+			expected: domain.ParsedMessage{
+				Subject:  "fix: handle empty input",
+				Trailers: []domain.Trailer{{Key: "Change-Id", Value: "I1234"}},
+			},
+		},
+		{
+			name:    "gerrit keeps a Change-Id in the description",
+			parser:  domain.ParserGerrit,
+			message: "fix: handle empty input\n\nChange-Id: I1234 was abandoned.\nThis one replaces it.",
+			expected: domain.ParsedMessage{
+				Subject: "fix: handle empty input",
+				Body:    "Change-Id: I1234 was abandoned.\nThis one replaces it.",
+			},
+		},
+		{
+			name:     "gitmoji emoji",
+			parser:   domain.ParserGitmoji,
+			message:  "✨ feat: add login",
+			expected: domain.ParsedMessage{Subject: "feat: add login", Gitmoji: "✨"},
+		},
+		{
+			name:     "gitmoji with variation selector",
+			parser:   domain.ParserGitmoji,
+			message:  "⬆️ build: upgrade go",
+			expected: domain.ParsedMessage{Subject: "build: upgrade go", Gitmoji: "⬆️"},
+		},
+		{
+			name:     "gitmoji code",
+			parser:   domain.ParserGitmoji,
+			message:  ":bug: fix: handle empty input",
+			expected: domain.ParsedMessage{Subject: "fix: handle empty input", Gitmoji: ":bug:"},
+		},
+		{
+			name:     "no gitmoji",
+			parser:   domain.ParserGitmoji,
+			message:  "fix: handle empty input",
+			expected: domain.ParsedMessage{Subject: "fix: handle empty input"},
+		},
+		{
+			name:     "gitmoji without a space",
+			parser:   domain.ParserGitmoji,
+			message:  "✨feat: add login",
+			expected: domain.ParsedMessage{Subject: "✨feat: add login"},
+		},
+		{
+			name:     "unknown parser splits as git does",
+			parser:   "svn",
+			message:  "✨ feat: add login",
+			expected: domain.ParsedMessage{Subject: "✨ feat: add login"},
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			require.Equal(t, testCase.expected, domain.NewMessageParser(testCase.parser).Parse(testCase.message))
+		})
+	}
+}
+
+func TestCommit_ParsedWith(t *testing.T) {
+	commit := domain.NewCommit("abc123", ":sparkles: feat: add login\n\nChange-Id: I1", "Dev", "dev@example.com", "", "", false)
+	require.Equal(t, []domain.Trailer{{Key: "Change-Id", Value: "I1"}}, commit.Trailers)
+
+	parsed := commit.ParsedWith(domain.GitmojiParser{})
+	require.Equal(t, "feat: add login", parsed.Subject)
+	require.Equal(t, ":sparkles:", parsed.Gitmoji)
+	require.Equal(t, commit.Message, parsed.Message, "the message is kept as written")
+
+	// Commits built without a message keep their parts
+	built := domain.Commit{Subject: "✨ feat: add login", Body: "Refs: #1"}
+	require.Equal(t, built, built.ParsedWith(domain.GitmojiParser{}))
+	require.Equal(t, []domain.Trailer{{Key: "Refs", Value: "#1"}}, built.MessageTrailers())
+	require.Equal(t, "✨ feat: add login\n\nRefs: #1", built.FullMessage())
+}
+
+func TestValidateMessage_Parser(t *testing.T) {
+	var subjects []string
+
+	rule := recordingRule{record: func(commit domain.Commit) { subjects = append(subjects, commit.Subject) }}
+
+	cfg := config.NewDefault()
+	_, err := domain.ValidateMessage("✨ feat: add login", []domain.CommitRule{rule}, cfg)
+	require.NoError(t, err)
+
+	cfg.Message.Parser = domain.ParserGitmoji
+	result, err := domain.ValidateMessage("✨ feat: add login", []domain.CommitRule{rule}, cfg)
+	require.NoError(t, err)
+
+	require.Equal(t, []string{"✨ feat: add login", "feat: add login"}, subjects)
+	require.Equal(t, "✨", result.Commit.Gitmoji)
+}
+
+// recordingRule passes every commit, recording the commits it is given.
+type recordingRule struct {
+	record func(domain.Commit)
+}
+
+func (r recordingRule) Name() string { return "Recording" }
+
+func (r recordingRule) Validate(commit domain.Commit, _ config.Config) []domain.ValidationError {
+	r.record(commit)
+
+	return nil
+}
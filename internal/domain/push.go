@@ -0,0 +1,46 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package domain
+
+import "context"
+
+// PushUpdate describes one ref update of a push, as reported to the pre-push hook.
+type PushUpdate struct {
+	// LocalRef is the local ref being pushed, "(delete)" when deleting.
+	LocalRef string
+
+	// LocalHash is the commit being pushed, empty when the remote ref is deleted.
+	LocalHash string
+
+	// RemoteRef is the ref updated on the remote.
+	RemoteRef string
+
+	// RemoteHash is the commit the remote ref points to, empty when the ref is new.
+	RemoteHash string
+}
+
+// IsDeletion returns true if the update deletes the remote ref.
+func (u PushUpdate) IsDeletion() bool {
+	return u.LocalHash == ""
+}
+
+// IsNewRef returns true if the update creates the remote ref.
+func (u PushUpdate) IsNewRef() bool {
+	return u.RemoteHash == ""
+}
+
+// PushResolver defines the contract for finding the commits a push sends.
+type PushResolver interface {
+	// GetOutgoingCommits returns the commits of update that the remote does not have yet.
+	GetOutgoingCommits(ctx context.Context, remote string, update PushUpdate) ([]Commit, error)
+}
+
+// ReceiveResolver defines the contract for finding the commits a repository receives in
+// a push, as seen from its pre-receive hook.
+type ReceiveResolver interface {
+	// GetReceivedCommits returns the commits of update that no ref of the repository
+	// reaches yet.
+	GetReceivedCommits(ctx context.Context, update PushUpdate) ([]Commit, error)
+}
@@ -0,0 +1,176 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package domain
+
+import (
+	"context"
+	"strings"
+)
+
+// PushCertificateRule is the rule name reported for push certificate failures.
+const PushCertificateRule = "PushCertificate"
+
+// Nonce statuses git reports to the pre-receive hook in GIT_PUSH_CERT_NONCE_STATUS that
+// reject a push certificate. A missing or bad nonce lets a recorded certificate be
+// replayed.
+const (
+	PushNonceMissing = "MISSING"
+	PushNonceBad     = "BAD"
+)
+
+// PushCertificate is the statement a client signs for git push --signed, naming the
+// pusher and the ref updates of the push.
+type PushCertificate struct {
+	Pusher      string // Identity of the pusher followed by the time of the push
+	Pushee      string // URL the push was sent to
+	Nonce       string // Nonce the receiving repository asked the client to sign
+	NonceStatus string // How git judged the nonce, such as OK, SLOP or BAD
+	Signature   string // GPG or SSH signature of the certificate
+	Payload     string // Certificate without its signature, the data that was signed
+}
+
+// PushCertificateResolver defines the contract for reading the push certificate git
+// stores for the pre-receive hook.
+type PushCertificateResolver interface {
+	// GetPushCertificate returns the push certificate stored in the blob hash.
+	GetPushCertificate(ctx context.Context, hash string) (PushCertificate, error)
+}
+
+// ParsePushCertificate parses a push certificate, which is a header of "name value"
+// lines and the ref updates of the push, followed by the signature.
+func ParsePushCertificate(text string) PushCertificate {
+	var cert PushCertificate
+
+	cert.Payload = text
+
+	// The signature starts at the last armor line, as git splits signed buffers
+	for start := len(text); start > 0; {
+		index := strings.LastIndex(text[:start], "-----BEGIN ")
+		if index < 0 {
+			break
+		}
+
+		if index == 0 || text[index-1] == '\n' {
+			cert.Payload, cert.Signature = text[:index], text[index:]
+
+			break
+		}
+
+		start = index
+	}
+
+	for _, line := range strings.Split(cert.Payload, "\n") {
+		if line == "" {
+			break
+		}
+
+		name, value, _ := strings.Cut(line, " ")
+
+		switch name {
+		case "pusher":
+			cert.Pusher = value
+		case "pushee":
+			cert.Pushee = value
+		case "nonce":
+			cert.Nonce = value
+		}
+	}
+
+	return cert
+}
+
+// ValidatePushCertificate turns the verification result of a push certificate into
+// validation errors. A certificate whose nonce git found missing or bad fails, and a
+// verified signature must also belong to one of allowedSigners when the list is set.
+func ValidatePushCertificate(cert PushCertificate, result VerificationResult, allowedSigners []string) []ValidationError {
+	if strings.TrimSpace(cert.Signature) == "" {
+		return []ValidationError{
+			New(PushCertificateRule, ErrMissingSignature, "Push is not signed").
+				WithContextMap(map[string]string{
+					"actual":   "no push certificate",
+					"expected": "signed push",
+				}).
+				WithHelp("Push with 'git push --signed'"),
+		}
+	}
+
+	if cert.NonceStatus == PushNonceMissing || cert.NonceStatus == PushNonceBad {
+		return []ValidationError{
+			New(PushCertificateRule, ErrBadPushNonce, "Push certificate does not carry the nonce the repository sent").
+				WithContextMap(map[string]string{
+					"actual": cert.NonceStatus,
+					"pusher": cert.Pusher,
+				}).
+				WithHelp("Push again with 'git push --signed'; a certificate cannot be reused for another push"),
+		}
+	}
+
+	if !result.IsVerified() {
+		code, help := unverifiedSignatureCode(result, "Add the pusher's public key to the trusted key directory")
+
+		return []ValidationError{
+			New(PushCertificateRule, code, result.ErrorMessage()).
+				WithContextMap(map[string]string{
+					"actual": result.ErrorCode(),
+					"pusher": cert.Pusher,
+				}).
+				WithHelp(help),
+		}
+	}
+
+	if len(allowedSigners) == 0 || isAllowedSigner(result.Identity(), allowedSigners) {
+		return nil
+	}
+
+	return []ValidationError{
+		New(PushCertificateRule, ErrKeyNotTrusted, "Signer not in allowed signers list").
+			WithContextMap(map[string]string{
+				"actual":   result.Identity().String(),
+				"expected": strings.Join(allowedSigners, ", "),
+				"pusher":   cert.Pusher,
+			}).
+			WithHelp("Contact your repository administrator to add your signing key"),
+	}
+}
+
+// NewPushCertificateReport returns the repository rule report of a push certificate
+// with the errors of ValidatePushCertificate. The details name the pusher and, when the
+// signature verified, the signer and key.
+func NewPushCertificateReport(cert PushCertificate, result VerificationResult, errs []ValidationError) RuleReport {
+	details := map[string]string{}
+
+	for name, value := range map[string]string{
+		"pusher":         cert.Pusher,
+		"pushee":         cert.Pushee,
+		"nonce_status":   cert.NonceStatus,
+		"signature_type": string(result.Signature().Type()),
+		"fingerprint":    result.Fingerprint(),
+	} {
+		if value != "" {
+			details[name] = value
+		}
+	}
+
+	if result.IsVerified() {
+		details["signer"] = result.Identity().String()
+	}
+
+	if len(errs) == 0 {
+		return RuleReport{Name: PushCertificateRule, Status: StatusPassed, Message: "Passed", Details: details}
+	}
+
+	messages := make([]string, 0, len(errs))
+	for _, err := range errs {
+		messages = append(messages, err.Message)
+	}
+
+	return RuleReport{
+		Name:    PushCertificateRule,
+		Status:  failureStatus(errs),
+		Errors:  errs,
+		Message: strings.Join(messages, "; "),
+		Details: details,
+	}
+}
@@ -0,0 +1,131 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package domain_test
+
+import (
+	"testing"
+
+	"github.com/itiquette/gommitlint/internal/domain"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParsePushCertificate(t *testing.T) {
+	payload := "certificate version 0.1\n" +
+		"pusher Dev <dev@example.com> 1700000000 +0100\n" +
+		"pushee https://git.example.com/repo.git\n" +
+		"nonce 1700000000-abcdef\n" +
+		"\n" +
+		"0000000000000000000000000000000000000000 1111111111111111111111111111111111111111 refs/heads/main\n"
+	signature := "-----BEGIN PGP SIGNATURE-----\n\niQEzBAABCAAdFiEE\n-----END PGP SIGNATURE-----\n"
+
+	cert := domain.ParsePushCertificate(payload + signature)
+
+	require.Equal(t, "Dev <dev@example.com> 1700000000 +0100", cert.Pusher)
+	require.Equal(t, "https://git.example.com/repo.git", cert.Pushee)
+	require.Equal(t, "1700000000-abcdef", cert.Nonce)
+	require.Equal(t, payload, cert.Payload)
+	require.Equal(t, signature, cert.Signature)
+
+	unsigned := domain.ParsePushCertificate(payload)
+	require.Equal(t, payload, unsigned.Payload)
+	require.Empty(t, unsigned.Signature)
+}
+
+func TestValidatePushCertificate(t *testing.T) {
+	signed := domain.PushCertificate{Pusher: "Dev <dev@example.com>", NonceStatus: "OK", Signature: "-----BEGIN SSH SIGNATURE-----"}
+	verified := domain.NewVerificationResult(domain.VerificationStatusVerified,
+		domain.NewIdentity("Dev", "dev@example.com"), domain.NewSignature(signed.Signature))
+
+	tests := []struct {
+		name           string
+		cert           domain.PushCertificate
+		result         domain.VerificationResult
+		allowedSigners []string
+		expectedCode   domain.ValidationErrorCode
+	}{
+		{
+			name:   "verified signature",
+			cert:   signed,
+			result: verified,
+		},
+		{
+			name:   "nonce within the allowed slop",
+			cert:   domain.PushCertificate{NonceStatus: "SLOP", Signature: signed.Signature},
+			result: verified,
+		},
+		{
+			name:         "unsigned push",
+			cert:         domain.PushCertificate{},
+			expectedCode: domain.ErrMissingSignature,
+		},
+		{
+			name:         "bad nonce",
+			cert:         domain.PushCertificate{NonceStatus: domain.PushNonceBad, Signature: signed.Signature},
+			result:       verified,
+			expectedCode: domain.ErrBadPushNonce,
+		},
+		{
+			name:         "missing nonce",
+			cert:         domain.PushCertificate{NonceStatus: domain.PushNonceMissing, Signature: signed.Signature},
+			result:       verified,
+			expectedCode: domain.ErrBadPushNonce,
+		},
+		{
+			name:           "signer not allowed",
+			cert:           signed,
+			result:         verified,
+			allowedSigners: []string{"release@example.com"},
+			expectedCode:   domain.ErrKeyNotTrusted,
+		},
+		{
+			name: "no trusted keys",
+			cert: signed,
+			result: domain.NewVerificationResult(domain.VerificationStatusNoKey, domain.Identity{}, domain.Signature{}).
+				WithError("no_keys", "No SSH key files found in keys"),
+			expectedCode: domain.ErrKeyNotTrusted,
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			errors := domain.ValidatePushCertificate(testCase.cert, testCase.result, testCase.allowedSigners)
+
+			if testCase.expectedCode == "" {
+				require.Empty(t, errors)
+
+				return
+			}
+
+			require.Len(t, errors, 1)
+			require.Equal(t, string(testCase.expectedCode), errors[0].Code)
+			require.Equal(t, domain.PushCertificateRule, errors[0].Rule)
+		})
+	}
+}
+
+func TestNewPushCertificateReport(t *testing.T) {
+	cert := domain.PushCertificate{Pusher: "Dev <dev@example.com>", NonceStatus: "OK", Signature: "-----BEGIN SSH SIGNATURE-----"}
+	verified := domain.NewVerificationResult(domain.VerificationStatusVerified,
+		domain.NewIdentity("Dev", "dev@example.com"), domain.NewSignature(cert.Signature))
+
+	passed := domain.NewPushCertificateReport(cert, verified, nil)
+	require.Equal(t, domain.StatusPassed, passed.Status)
+	require.Equal(t, map[string]string{
+		"pusher":         "Dev <dev@example.com>",
+		"nonce_status":   "OK",
+		"signature_type": "ssh",
+		"signer":         "Dev <dev@example.com>",
+	}, passed.Details)
+
+	errs := domain.ValidatePushCertificate(domain.PushCertificate{}, domain.VerificationResult{}, nil)
+	failed := domain.NewPushCertificateReport(domain.PushCertificate{}, domain.VerificationResult{}, errs)
+	require.Equal(t, domain.StatusFailed, failed.Status)
+	require.Equal(t, "Push is not signed", failed.Message)
+
+	report := domain.Report{Summary: domain.ReportSummary{AllPassed: true}}.WithRepositoryResult(failed)
+	require.False(t, report.Summary.AllPassed)
+	require.Equal(t, 1, report.Summary.FailedRules[domain.PushCertificateRule])
+	require.Len(t, report.Repository.RuleResults, 1)
+}
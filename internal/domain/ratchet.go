@@ -0,0 +1,121 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package domain
+
+// FailureClass is a kind of failure, the code of a rule, whichever commit it is found in.
+type FailureClass struct {
+	Rule string
+	Code string
+}
+
+// FailureClasses returns the classes of the blocking failures in report, of commit and
+// repository rules alike.
+func FailureClasses(report Report) map[FailureClass]bool {
+	classes := make(map[FailureClass]bool)
+
+	collect := func(ruleResults []RuleReport) {
+		for _, ruleResult := range ruleResults {
+			for _, err := range ruleResult.Errors {
+				if err.IsBlocking() {
+					classes[FailureClass{Rule: err.Rule, Code: err.Code}] = true
+				}
+			}
+		}
+	}
+
+	for _, commitReport := range report.Commits {
+		collect(commitReport.RuleResults)
+	}
+
+	collect(report.Repository.RuleResults)
+
+	return classes
+}
+
+// RatchetReport returns report with the blocking failures of the classes in baseline,
+// found in the history of base, turned into warnings, so that only failures of new
+// classes fail validation. The summary is recomputed from the commit reports, so report
+// must not be a summary only.
+func RatchetReport(report Report, baseline map[FailureClass]bool, base string) Report {
+	result := report
+	result.Commits = make([]CommitReport, len(report.Commits))
+	result.Summary.FailedRules = make(map[string]int)
+	result.Summary.PassedCommits = 0
+
+	for i, commitReport := range report.Commits {
+		commitReport.RuleResults = ratchetRuleReports(commitReport.RuleResults, baseline, base)
+		commitReport.Passed = !hasBlockingFailures(commitReport.RuleResults)
+		result.Commits[i] = commitReport
+
+		if commitReport.SkipReason != "" {
+			continue
+		}
+
+		if commitReport.Passed {
+			result.Summary.PassedCommits++
+		}
+
+		countFailedRules(result.Summary.FailedRules, commitReport.RuleResults)
+	}
+
+	result.Repository.RuleResults = ratchetRuleReports(report.Repository.RuleResults, baseline, base)
+	countFailedRules(result.Summary.FailedRules, result.Repository.RuleResults)
+
+	result.Summary.FailedCommits = result.Summary.TotalCommits - result.Summary.PassedCommits
+	result.Summary.AllPassed = result.Summary.FailedCommits == 0 && !hasBlockingFailures(result.Repository.RuleResults)
+
+	return result
+}
+
+// ratchetRuleReports turns the blocking failures of the classes in baseline into warnings
+// and updates the status of the rules they belong to.
+func ratchetRuleReports(ruleResults []RuleReport, baseline map[FailureClass]bool, base string) []RuleReport {
+	reports := make([]RuleReport, len(ruleResults))
+
+	for i, ruleResult := range ruleResults {
+		if len(ruleResult.Errors) > 0 {
+			errs := make([]ValidationError, len(ruleResult.Errors))
+
+			for j, err := range ruleResult.Errors {
+				if err.IsBlocking() && baseline[FailureClass{Rule: err.Rule, Code: err.Code}] {
+					err = err.WithSeverity(SeverityWarning).WithContextMap(map[string]string{ContextRatchetBase: base})
+				}
+
+				errs[j] = err
+			}
+
+			ruleResult.Errors = errs
+			ruleResult.Status = failureStatus(errs)
+		}
+
+		reports[i] = ruleResult
+	}
+
+	return reports
+}
+
+// hasBlockingFailures reports whether any of ruleResults has a blocking failure.
+func hasBlockingFailures(ruleResults []RuleReport) bool {
+	for _, ruleResult := range ruleResults {
+		for _, err := range ruleResult.Errors {
+			if err.IsBlocking() {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// countFailedRules adds the blocking failures of ruleResults to the counts per rule.
+func countFailedRules(failedRules map[string]int, ruleResults []RuleReport) {
+	for _, ruleResult := range ruleResults {
+		for _, err := range ruleResult.Errors {
+			if err.IsBlocking() {
+				failedRules[err.Rule]++
+			}
+		}
+	}
+}
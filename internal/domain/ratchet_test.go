@@ -0,0 +1,107 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package domain_test
+
+import (
+	"testing"
+
+	"github.com/itiquette/gommitlint/internal/domain"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFailureClasses(t *testing.T) {
+	commitRules := []domain.CommitRule{namedRule("Subject"), namedRule("NoWIP")}
+
+	results := []domain.ValidationResult{
+		{Commit: domain.Commit{Hash: "a"}, Errors: []domain.ValidationError{
+			domain.New("Subject", domain.ErrSubjectTooLong, "too long"),
+			domain.New("NoWIP", domain.ErrForbiddenPattern, "work in progress").WithSeverity(domain.SeverityWarning),
+		}},
+		{Commit: domain.Commit{Hash: "b"}, Errors: []domain.ValidationError{
+			domain.New("Subject", domain.ErrSubjectTooLong, "too long"),
+		}},
+	}
+
+	report := domain.BuildReport(results, nil, commitRules, nil, domain.ReportOptions{})
+
+	// Warnings do not fail validation, so they are no class of failure
+	require.Equal(t, map[domain.FailureClass]bool{
+		{Rule: "Subject", Code: string(domain.ErrSubjectTooLong)}: true,
+	}, domain.FailureClasses(report))
+}
+
+func TestRatchetReport(t *testing.T) {
+	commitRules := []domain.CommitRule{namedRule("Subject"), namedRule("SignOff")}
+	repoRules := []domain.RepositoryRule{namedRepositoryRule("BranchAhead")}
+
+	baseline := map[domain.FailureClass]bool{
+		{Rule: "Subject", Code: string(domain.ErrSubjectTooLong)}: true,
+	}
+
+	tests := []struct {
+		name          string
+		errors        []domain.ValidationError
+		allPassed     bool
+		failedRules   map[string]int
+		subjectStatus domain.ValidationStatus
+	}{
+		{
+			name:          "known failure becomes a warning",
+			errors:        []domain.ValidationError{domain.New("Subject", domain.ErrSubjectTooLong, "too long")},
+			allPassed:     true,
+			failedRules:   map[string]int{},
+			subjectStatus: domain.StatusWarning,
+		},
+		{
+			name:          "other code of a known rule fails",
+			errors:        []domain.ValidationError{domain.New("Subject", domain.ErrSubjectSuffix, "invalid suffix")},
+			allPassed:     false,
+			failedRules:   map[string]int{"Subject": 1},
+			subjectStatus: domain.StatusFailed,
+		},
+		{
+			name: "new failure next to a known one fails",
+			errors: []domain.ValidationError{
+				domain.New("Subject", domain.ErrSubjectTooLong, "too long"),
+				domain.New("SignOff", domain.ErrMissingSignoff, "missing sign-off"),
+			},
+			allPassed:     false,
+			failedRules:   map[string]int{"SignOff": 1},
+			subjectStatus: domain.StatusWarning,
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			results := []domain.ValidationResult{{Commit: domain.Commit{Hash: "a"}, Errors: testCase.errors}}
+			report := domain.BuildReport(results, nil, commitRules, repoRules, domain.ReportOptions{})
+
+			ratcheted := domain.RatchetReport(report, baseline, "main")
+
+			require.Equal(t, testCase.allPassed, ratcheted.Summary.AllPassed)
+			require.Equal(t, testCase.failedRules, ratcheted.Summary.FailedRules)
+			require.Equal(t, testCase.allPassed, ratcheted.Commits[0].Passed)
+			require.Equal(t, testCase.subjectStatus, ratcheted.Commits[0].RuleResults[0].Status)
+
+			// The report passed in is left as it is
+			require.False(t, report.Summary.AllPassed)
+		})
+	}
+
+	t.Run("ratchet base in context", func(t *testing.T) {
+		results := []domain.ValidationResult{{Commit: domain.Commit{Hash: "a"}, Errors: []domain.ValidationError{
+			domain.New("Subject", domain.ErrSubjectTooLong, "too long"),
+		}}}
+		report := domain.BuildReport(results, nil, commitRules, repoRules, domain.ReportOptions{})
+
+		ratcheted := domain.RatchetReport(report, baseline, "origin/main")
+
+		err := ratcheted.Commits[0].RuleResults[0].Errors[0]
+		require.Equal(t, domain.SeverityWarning, err.Severity)
+		require.Equal(t, "origin/main", err.Context[domain.ContextRatchetBase])
+		require.Equal(t, 1, ratcheted.Summary.PassedCommits)
+		require.Equal(t, 0, ratcheted.Summary.FailedCommits)
+	})
+}
@@ -5,6 +5,7 @@
 package domain
 
 import (
+	"slices"
 	"sort"
 	"strings"
 	"time"
@@ -20,11 +21,12 @@ type Report struct {
 
 // ReportSummary contains high-level validation statistics.
 type ReportSummary struct {
-	TotalCommits  int
-	PassedCommits int
-	FailedCommits int
-	AllPassed     bool
-	FailedRules   map[string]int // Rule name -> failure count
+	TotalCommits   int // Validated commits, without the skipped commits
+	PassedCommits  int
+	FailedCommits  int
+	SkippedCommits int // Commits no rule ran for, such as merge commits
+	AllPassed      bool
+	FailedRules    map[string]int // Rule name -> failure count
 }
 
 // CommitReport contains formatted information about a single commit validation.
@@ -32,21 +34,55 @@ type CommitReport struct {
 	Commit      Commit
 	RuleResults []RuleReport
 	Passed      bool
+	Submodule   string // Submodule path, empty for commits of the validated repository
+	// ConventionalType is the conventional commit type with aliases resolved, for
+	// changelog tooling. It is empty for commits that are not conventional commits.
+	ConventionalType string
+	// SkipReason tells why no rule ran for the commit, such as SkipReasonMergeCommit.
+	// It is empty for validated commits.
+	SkipReason string
+	// Duplicates are the hashes of the commits following this one in the report with the
+	// same message and results, such as cherry-picks, as grouped by
+	// Report.WithDuplicatesGrouped.
+	Duplicates []string
 }
 
+// Reasons for skipping rules and commits.
+const (
+	// SkipReasonNoRepository skips the rules that need a repository when there is none.
+	SkipReasonNoRepository = "no repository"
+
+	// SkipReasonMergeCommit skips merge commits, whose changes were validated in the
+	// commits they merge.
+	SkipReasonMergeCommit = "merge commit"
+)
+
 // RuleReport contains formatted rule validation information.
 type RuleReport struct {
-	Name    string
-	Status  ValidationStatus
-	Errors  []ValidationError
-	Message string // Formatted message for display
+	Name       string
+	Status     ValidationStatus
+	Errors     []ValidationError
+	Message    string // Formatted message for display
+	BlockedBy  string // Blocking rule whose failure skipped this rule, when Status is StatusSkipped
+	SkipReason string // Why this rule was skipped when no rule blocked it, such as SkipReasonNoRepository
+	// Details are what the rule checked, such as the key that verified the signature, for
+	// the rules that report details.
+	Details map[string]string
 }
 
-// RepositoryReport contains repository-level validation results.
+// RepositoryReport contains repository-level validation results and what was validated.
 type RepositoryReport struct {
+	Name        string // Repository name, empty when unknown
+	Branch      string // Checked out branch, or head branch of a pull request; empty when detached or unknown
+	Range       string // Commits validated, such as "main..HEAD" or a single ref; empty for messages and pushes
 	RuleResults []RuleReport
 }
 
+// HasDetails reports whether the repository report has anything to show.
+func (r RepositoryReport) HasDetails() bool {
+	return r.Name != "" || r.Branch != "" || r.Range != "" || len(r.RuleResults) > 0
+}
+
 // ReportMetadata contains report generation context.
 type ReportMetadata struct {
 	Timestamp time.Time
@@ -57,71 +93,265 @@ type ReportMetadata struct {
 // BuildReport creates a report showing all executed rules (both passed and failed).
 func BuildReport(commitResults []ValidationResult, repoErrors []ValidationError,
 	commitRules []CommitRule, repoRules []RepositoryRule, options ReportOptions) Report {
+	builder := NewReportBuilder(commitRules, repoRules, options)
+	for _, result := range commitResults {
+		builder.Add(result)
+	}
+
+	return builder.Build(repoErrors)
+}
+
+// ReportBuilder builds a report from commit results added one at a time, so that the
+// commits of a range need not be held while it is read. Each result is reduced to its
+// commit report as it is added, which is kept until Build unless the builder streams
+// it elsewhere or ReportOptions.SummaryOnly drops it.
+type ReportBuilder struct {
+	commitRules []CommitRule
+	repoRules   []RepositoryRule
+	options     ReportOptions
+	total       int
+	passed      int
+	skipped     int
+	failedRules map[string]int
+	commits     []CommitReport
+	emit        func(CommitReport)
+}
+
+// NewReportBuilder creates a builder for reports of commitRules and repoRules.
+func NewReportBuilder(commitRules []CommitRule, repoRules []RepositoryRule, options ReportOptions) *ReportBuilder {
+	return &ReportBuilder{
+		commitRules: commitRules,
+		repoRules:   repoRules,
+		options:     options,
+		failedRules: make(map[string]int),
+	}
+}
+
+// StreamTo makes the builder pass each commit report to emit as it is added instead of
+// keeping it, so that the commits of a range can be written while it is read. The
+// report built then has no commits.
+func (b *ReportBuilder) StreamTo(emit func(CommitReport)) *ReportBuilder {
+	b.emit = emit
+
+	return b
+}
+
+// Add adds the result of validating a commit. Skipped commits are reported, but are
+// counted apart from the validated commits.
+func (b *ReportBuilder) Add(result ValidationResult) {
+	result.Errors = correlateCommitFailures(result.Errors, b.options.Deduplicate)
+
+	switch {
+	case b.emit != nil:
+		b.emit(buildCommitReport(result, b.commitRules, b.repoRules, b.options.TypeAliases))
+	case !b.options.SummaryOnly:
+		b.commits = append(b.commits, buildCommitReport(result, b.commitRules, b.repoRules, b.options.TypeAliases))
+	}
+
+	if result.SkipReason != "" {
+		b.skipped++
+
+		return
+	}
+
+	b.total++
+	if !result.HasFailures() {
+		b.passed++
+	}
+
+	for _, err := range result.Errors {
+		if err.IsBlocking() {
+			b.failedRules[err.Rule]++
+		}
+	}
+}
+
+// Build returns the report of the added commits and of repoErrors, the errors of the
+// repository-level and range rules. Commits are reported oldest first.
+func (b *ReportBuilder) Build(repoErrors []ValidationError) Report {
 	return Report{
-		Summary:    buildSummary(commitResults, repoErrors),
-		Commits:    buildCommitReports(commitResults, commitRules),
-		Repository: buildRepositoryReport(repoErrors, repoRules),
-		Metadata:   buildMetadata(options),
+		Summary:    b.buildSummary(repoErrors),
+		Commits:    sortCommitReports(b.commits),
+		Repository: buildRepositoryReport(repoErrors, b.repoRules),
+		Metadata:   buildMetadata(b.options),
 	}
 }
 
-// buildSummary creates report summary from validation results.
-func buildSummary(commitResults []ValidationResult, repoErrors []ValidationError) ReportSummary {
-	totalCommits := len(commitResults)
-	passedCommits := 0
-	failedRules := make(map[string]int)
+// WithSubmodule returns a new report with all commit reports attributed to the submodule at path.
+func (r Report) WithSubmodule(path string) Report {
+	commits := make([]CommitReport, len(r.Commits))
+	for i, commitReport := range r.Commits {
+		commitReport.Submodule = path
+		commits[i] = commitReport
+	}
 
-	// Count passed commits and collect failed rules
-	for _, result := range commitResults {
-		if !result.HasFailures() {
-			passedCommits++
+	r.Commits = commits
+
+	return r
+}
+
+// WithoutRepository returns a new report in which repoRules are skipped because there is
+// no repository to check, instead of leaving them out as if they did not apply.
+func (r Report) WithoutRepository(repoRules []RepositoryRule) Report {
+	results := make([]RuleReport, 0, len(repoRules))
+	for _, rule := range repoRules {
+		results = append(results, RuleReport{
+			Name:       rule.Name(),
+			Status:     StatusSkipped,
+			Message:    "Skipped because there is no repository",
+			SkipReason: SkipReasonNoRepository,
+		})
+	}
+
+	r.Repository.RuleResults = results
+
+	return r
+}
+
+// WithRepositoryResult returns a new report with ruleReport added to the repository
+// results, for checks of the whole validation run such as the push certificate. A failed
+// result fails the report.
+func (r Report) WithRepositoryResult(ruleReport RuleReport) Report {
+	r.Repository.RuleResults = append(slices.Clone(r.Repository.RuleResults), ruleReport)
+
+	if ruleReport.Status == StatusFailed {
+		failedRules := make(map[string]int, len(r.Summary.FailedRules)+1)
+		for rule, count := range r.Summary.FailedRules {
+			failedRules[rule] = count
 		}
 
-		// Count rule failures
-		for _, err := range result.Errors {
-			failedRules[err.Rule]++
+		failedRules[ruleReport.Name]++
+		r.Summary.FailedRules = failedRules
+		r.Summary.AllPassed = false
+	}
+
+	return r
+}
+
+// MergeReports appends the commit reports of others to base and recomputes the summary.
+// Repository results and metadata are taken from base.
+func MergeReports(base Report, others ...Report) Report {
+	result := base
+	result.Commits = append([]CommitReport{}, base.Commits...)
+	result.Summary.FailedRules = make(map[string]int, len(base.Summary.FailedRules))
+
+	for rule, count := range base.Summary.FailedRules {
+		result.Summary.FailedRules[rule] = count
+	}
+
+	for _, other := range others {
+		result.Commits = append(result.Commits, other.Commits...)
+		result.Summary.TotalCommits += other.Summary.TotalCommits
+		result.Summary.PassedCommits += other.Summary.PassedCommits
+		result.Summary.FailedCommits += other.Summary.FailedCommits
+		result.Summary.SkippedCommits += other.Summary.SkippedCommits
+		result.Summary.AllPassed = result.Summary.AllPassed && other.Summary.AllPassed
+
+		for rule, count := range other.Summary.FailedRules {
+			result.Summary.FailedRules[rule] += count
+		}
+	}
+
+	return result
+}
+
+// relatedFailures maps failure codes to the code another rule reports for the same
+// mistake and that says more about it.
+var relatedFailures = map[ValidationErrorCode]ValidationErrorCode{
+	ErrMissingConventionalSubject: ErrEmptyConventionalDesc, // "feat:" has no description
+	ErrConventionalDescTooLong:    ErrSubjectTooLong,        // A long description makes a long subject
+	ErrDescriptionTooLong:         ErrSubjectTooLong,
+	ErrWrongCaseLower:             ErrInvalidConventionalFormat, // "Bad commit" has no type to start it
+	ErrWrongCaseUpper:             ErrInvalidConventionalFormat,
+}
+
+// correlateCommitFailures finds the failures of a commit that repeat a failure of
+// another rule, either with the same code or with a code in relatedFailures. They are
+// marked with the rule that reported the mistake first, or dropped when deduplicate is set.
+func correlateCommitFailures(errs []ValidationError, deduplicate bool) []ValidationError {
+	firstRule := make(map[string]string, len(errs))
+
+	for _, err := range errs {
+		if _, found := firstRule[err.Code]; !found && err.IsBlocking() {
+			firstRule[err.Code] = err.Rule
 		}
 	}
 
+	result := make([]ValidationError, 0, len(errs))
+
+	for _, err := range errs {
+		code := err.Code
+		if related, found := relatedFailures[ValidationErrorCode(code)]; found {
+			code = string(related)
+		}
+
+		rule, found := firstRule[code]
+		if !found || rule == err.Rule || !err.IsBlocking() {
+			result = append(result, err)
+
+			continue
+		}
+
+		if !deduplicate {
+			result = append(result, err.WithContextMap(map[string]string{ContextRelatedTo: rule}))
+		}
+	}
+
+	return result
+}
+
+// buildSummary creates the report summary from the counts of the added commits.
+func (b *ReportBuilder) buildSummary(repoErrors []ValidationError) ReportSummary {
+	failedRules := make(map[string]int, len(b.failedRules))
+	for rule, count := range b.failedRules {
+		failedRules[rule] = count
+	}
+
 	// Count repository rule failures
+	repoFailures := 0
+
 	for _, err := range repoErrors {
-		failedRules[err.Rule]++
+		if err.IsBlocking() {
+			failedRules[err.Rule]++
+			repoFailures++
+		}
 	}
 
-	failedCommits := totalCommits - passedCommits
-	allPassed := failedCommits == 0 && len(repoErrors) == 0
+	failedCommits := b.total - b.passed
+	allPassed := failedCommits == 0 && repoFailures == 0
 
 	return ReportSummary{
-		TotalCommits:  totalCommits,
-		PassedCommits: passedCommits,
-		FailedCommits: failedCommits,
-		AllPassed:     allPassed,
-		FailedRules:   failedRules,
+		TotalCommits:   b.total,
+		PassedCommits:  b.passed,
+		FailedCommits:  failedCommits,
+		SkippedCommits: b.skipped,
+		AllPassed:      allPassed,
+		FailedRules:    failedRules,
 	}
 }
 
-// buildCommitReports creates commit reports showing all executed rules.
-func buildCommitReports(commitResults []ValidationResult, commitRules []CommitRule) []CommitReport {
-	// Sort commits by date (oldest first)
-	sortedResults := make([]ValidationResult, len(commitResults))
-	copy(sortedResults, commitResults)
-
-	sort.Slice(sortedResults, func(i, j int) bool {
-		// Compare commit dates - oldest first (ascending order)
-		return sortedResults[i].Commit.CommitDate < sortedResults[j].Commit.CommitDate
-	})
+// buildCommitReport creates the report of a commit showing all executed rules.
+func buildCommitReport(result ValidationResult, commitRules []CommitRule, repoRules []RepositoryRule,
+	typeAliases map[string]string) CommitReport {
+	return CommitReport{
+		Commit:           result.Commit,
+		RuleResults:      buildRuleReports(result, commitRules, repoRules),
+		Passed:           !result.HasFailures(),
+		ConventionalType: CanonicalConventionalType(result.Commit.Subject, typeAliases),
+		SkipReason:       result.SkipReason,
+	}
+}
 
-	reports := make([]CommitReport, len(sortedResults))
+// sortCommitReports returns a copy of reports sorted by commit date, oldest first.
+func sortCommitReports(reports []CommitReport) []CommitReport {
+	sorted := make([]CommitReport, len(reports))
+	copy(sorted, reports)
 
-	for i, result := range sortedResults {
-		reports[i] = CommitReport{
-			Commit:      result.Commit,
-			RuleResults: buildRuleReports(result, commitRules),
-			Passed:      !result.HasFailures(),
-		}
-	}
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Commit.CommitDate < sorted[j].Commit.CommitDate
+	})
 
-	return reports
+	return sorted
 }
 
 // buildRepositoryReport creates repository report showing all executed rules.
@@ -131,8 +361,9 @@ func buildRepositoryReport(repoErrors []ValidationError, repoRules []RepositoryR
 	}
 }
 
-// buildRuleReports creates rule reports showing all executed commit rules.
-func buildRuleReports(result ValidationResult, commitRules []CommitRule) []RuleReport {
+// buildRuleReports creates rule reports showing all executed commit rules, followed by
+// the repository rules that failed for the commit.
+func buildRuleReports(result ValidationResult, commitRules []CommitRule, repoRules []RepositoryRule) []RuleReport {
 	// Group errors by rule
 	errorsByRule := make(map[string][]ValidationError)
 	for _, err := range result.Errors {
@@ -146,38 +377,82 @@ func buildRuleReports(result ValidationResult, commitRules []CommitRule) []RuleR
 		ruleName := rule.Name()
 		errs, hasFailed := errorsByRule[ruleName]
 
-		if hasFailed {
-			// Failed rule
-			var messageBuilder strings.Builder
-
-			for i, err := range errs {
-				if i > 0 {
-					messageBuilder.WriteString("; ")
-				}
+		if result.SkipReason != "" {
+			reports = append(reports, RuleReport{
+				Name:       ruleName,
+				Status:     StatusSkipped,
+				Message:    "Skipped: " + result.SkipReason,
+				SkipReason: result.SkipReason,
+			})
 
-				messageBuilder.WriteString(err.Message)
-			}
+			continue
+		}
 
+		if result.NotApplicable[ruleName] {
 			reports = append(reports, RuleReport{
 				Name:    ruleName,
-				Status:  StatusFailed,
-				Errors:  errs,
-				Message: messageBuilder.String(),
+				Status:  StatusNotApplicable,
+				Message: "Not applicable to this commit",
 			})
-		} else {
-			// Passed rule
+
+			continue
+		}
+
+		if blockedBy, skipped := result.Skipped[ruleName]; skipped {
 			reports = append(reports, RuleReport{
-				Name:    ruleName,
-				Status:  StatusPassed,
-				Errors:  nil,
-				Message: "Passed",
+				Name:      ruleName,
+				Status:    StatusSkipped,
+				Message:   "Skipped because " + blockedBy + " failed",
+				BlockedBy: blockedBy,
 			})
+
+			continue
+		}
+
+		report := RuleReport{
+			Name:    ruleName,
+			Status:  StatusPassed,
+			Errors:  nil,
+			Message: "Passed",
+		}
+		if hasFailed {
+			report = failedRuleReport(ruleName, errs)
+		}
+
+		report.Details = result.Details[ruleName]
+		reports = append(reports, report)
+	}
+
+	// Repository rules that check each commit, such as review, pass in the repository report
+	for _, rule := range repoRules {
+		if errs, hasFailed := errorsByRule[rule.Name()]; hasFailed {
+			reports = append(reports, failedRuleReport(rule.Name(), errs))
 		}
 	}
 
 	return reports
 }
 
+// failedRuleReport creates the report of a rule that failed with errs.
+func failedRuleReport(ruleName string, errs []ValidationError) RuleReport {
+	var messageBuilder strings.Builder
+
+	for i, err := range errs {
+		if i > 0 {
+			messageBuilder.WriteString("; ")
+		}
+
+		messageBuilder.WriteString(err.Message)
+	}
+
+	return RuleReport{
+		Name:    ruleName,
+		Status:  failureStatus(errs),
+		Errors:  errs,
+		Message: messageBuilder.String(),
+	}
+}
+
 // buildRepositoryRuleReports creates rule reports showing all executed repository rules.
 func buildRepositoryRuleReports(repoErrors []ValidationError, repoRules []RepositoryRule) []RuleReport {
 	// Group errors by rule
@@ -207,7 +482,7 @@ func buildRepositoryRuleReports(repoErrors []ValidationError, repoRules []Reposi
 
 			reports = append(reports, RuleReport{
 				Name:    ruleName,
-				Status:  StatusFailed,
+				Status:  failureStatus(errs),
 				Errors:  errs,
 				Message: messageBuilder.String(),
 			})
@@ -225,6 +500,17 @@ func buildRepositoryRuleReports(repoErrors []ValidationError, repoRules []Reposi
 	return reports
 }
 
+// failureStatus returns StatusWarning when none of errs is blocking, otherwise StatusFailed.
+func failureStatus(errs []ValidationError) ValidationStatus {
+	for _, err := range errs {
+		if err.IsBlocking() {
+			return StatusFailed
+		}
+	}
+
+	return StatusWarning
+}
+
 // buildMetadata creates report metadata.
 func buildMetadata(options ReportOptions) ReportMetadata {
 	return ReportMetadata{
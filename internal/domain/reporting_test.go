@@ -0,0 +1,347 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package domain_test
+
+import (
+	"testing"
+
+	"github.com/itiquette/gommitlint/internal/domain"
+	"github.com/itiquette/gommitlint/internal/domain/config"
+	"github.com/stretchr/testify/require"
+)
+
+type namedRule string
+
+func (r namedRule) Name() string { return string(r) }
+
+func (r namedRule) Validate(_ domain.Commit, _ config.Config) []domain.ValidationError { return nil }
+
+type namedRepositoryRule string
+
+func (r namedRepositoryRule) Name() string { return string(r) }
+
+func (r namedRepositoryRule) Validate(_ domain.Commit, _ domain.Repository, _ config.Config) []domain.ValidationError {
+	return nil
+}
+
+func TestBuildReportWarnings(t *testing.T) {
+	warning := domain.New("NoWIP", domain.ErrForbiddenPattern, "work in progress").
+		WithSeverity(domain.SeverityWarning)
+	failure := domain.New("Subject", domain.ErrSubjectTooLong, "too long")
+
+	commitRules := []domain.CommitRule{namedRule("NoWIP"), namedRule("Subject")}
+
+	tests := []struct {
+		name          string
+		errors        []domain.ValidationError
+		allPassed     bool
+		failedRules   map[string]int
+		warningStatus domain.ValidationStatus
+	}{
+		{
+			name:          "warning only passes",
+			errors:        []domain.ValidationError{warning},
+			allPassed:     true,
+			failedRules:   map[string]int{},
+			warningStatus: domain.StatusWarning,
+		},
+		{
+			name:          "warning with failure fails",
+			errors:        []domain.ValidationError{warning, failure},
+			allPassed:     false,
+			failedRules:   map[string]int{"Subject": 1},
+			warningStatus: domain.StatusWarning,
+		},
+		{
+			name:          "no findings",
+			allPassed:     true,
+			failedRules:   map[string]int{},
+			warningStatus: domain.StatusPassed,
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			results := []domain.ValidationResult{{Commit: domain.Commit{Hash: "a"}, Errors: testCase.errors}}
+
+			report := domain.BuildReport(results, nil, commitRules, nil, domain.ReportOptions{})
+
+			require.Equal(t, testCase.allPassed, report.Summary.AllPassed)
+			require.Equal(t, testCase.failedRules, report.Summary.FailedRules)
+			require.Equal(t, testCase.allPassed, report.Commits[0].Passed)
+			require.Equal(t, testCase.warningStatus, report.Commits[0].RuleResults[0].Status)
+		})
+	}
+}
+
+func TestBuildReport_ConventionalType(t *testing.T) {
+	results := []domain.ValidationResult{
+		{Commit: domain.Commit{Hash: "a", Subject: "feature(ui): add login", CommitDate: "1"}},
+		{Commit: domain.Commit{Hash: "b", Subject: "fix: handle empty input", CommitDate: "2"}},
+		{Commit: domain.Commit{Hash: "c", Subject: "Update readme", CommitDate: "3"}},
+	}
+
+	report := domain.BuildReport(results, nil, nil, nil, domain.ReportOptions{
+		TypeAliases: map[string]string{"feature": "feat"},
+	})
+
+	require.Equal(t, "feat", report.Commits[0].ConventionalType)
+	require.Equal(t, "fix", report.Commits[1].ConventionalType)
+	require.Empty(t, report.Commits[2].ConventionalType)
+}
+
+func TestBuildReport_RepositoryRuleFailures(t *testing.T) {
+	commitRules := []domain.CommitRule{namedRule("Subject")}
+	repoRules := []domain.RepositoryRule{namedRepositoryRule("BranchAhead"), namedRepositoryRule("Review")}
+
+	results := []domain.ValidationResult{
+		{Commit: domain.Commit{Hash: "a"}, Errors: []domain.ValidationError{domain.New("Review", domain.ErrMissingReview, "needs review")}},
+		{Commit: domain.Commit{Hash: "b"}},
+	}
+
+	report := domain.BuildReport(results, nil, commitRules, repoRules, domain.ReportOptions{})
+
+	// Repository rules that fail for a commit are listed with its commit rules
+	require.Len(t, report.Commits[0].RuleResults, 2)
+	require.Equal(t, "Review", report.Commits[0].RuleResults[1].Name)
+	require.Equal(t, domain.StatusFailed, report.Commits[0].RuleResults[1].Status)
+	require.Equal(t, "needs review", report.Commits[0].RuleResults[1].Message)
+	require.Len(t, report.Commits[1].RuleResults, 1)
+}
+
+func TestMergeReports(t *testing.T) {
+	base := domain.Report{
+		Summary: domain.ReportSummary{
+			TotalCommits:  1,
+			PassedCommits: 1,
+			AllPassed:     true,
+			FailedRules:   map[string]int{},
+		},
+		Commits: []domain.CommitReport{{Commit: domain.Commit{Hash: "a"}, Passed: true}},
+	}
+	other := domain.Report{
+		Summary: domain.ReportSummary{
+			TotalCommits:  2,
+			PassedCommits: 1,
+			FailedCommits: 1,
+			AllPassed:     false,
+			FailedRules:   map[string]int{"Subject": 1},
+		},
+		Commits: []domain.CommitReport{
+			{Commit: domain.Commit{Hash: "b"}, Passed: true},
+			{Commit: domain.Commit{Hash: "c"}, Passed: false},
+		},
+	}.WithSubmodule("lib")
+
+	merged := domain.MergeReports(base, other)
+
+	require.Equal(t, 3, merged.Summary.TotalCommits)
+	require.Equal(t, 2, merged.Summary.PassedCommits)
+	require.Equal(t, 1, merged.Summary.FailedCommits)
+	require.False(t, merged.Summary.AllPassed)
+	require.Equal(t, map[string]int{"Subject": 1}, merged.Summary.FailedRules)
+	require.Len(t, merged.Commits, 3)
+	require.Empty(t, merged.Commits[0].Submodule)
+	require.Equal(t, "lib", merged.Commits[1].Submodule)
+	require.Equal(t, "lib", merged.Commits[2].Submodule)
+
+	// Inputs are left untouched
+	require.Len(t, base.Commits, 1)
+	require.Empty(t, base.Summary.FailedRules)
+}
+
+func TestBuildReport_RelatedFailures(t *testing.T) {
+	commitRules := []domain.CommitRule{namedRule("ConventionalCommit"), namedRule("Subject")}
+	errors := []domain.ValidationError{
+		domain.New("ConventionalCommit", domain.ErrEmptyConventionalDesc, "Description is empty"),
+		domain.New("ConventionalCommit", domain.ErrInvalidConventionalFormat, "Not conventional"),
+		domain.New("Subject", domain.ErrMissingConventionalSubject, "Subject is missing"),
+		domain.New("Subject", domain.ErrInvalidConventionalFormat, "Not conventional"),
+		domain.New("Subject", domain.ErrSubjectSuffix, "Ends with a period"),
+	}
+	results := []domain.ValidationResult{{Commit: domain.Commit{Hash: "a"}, Errors: errors}}
+
+	tests := []struct {
+		name            string
+		deduplicate     bool
+		expectedSubject []string
+		expectedFailed  int
+	}{
+		{
+			name:            "verbose marks related failures",
+			expectedSubject: []string{"ConventionalCommit", "ConventionalCommit", ""},
+			expectedFailed:  3,
+		},
+		{
+			name:            "deduplicate drops related failures",
+			deduplicate:     true,
+			expectedSubject: []string{""},
+			expectedFailed:  1,
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			report := domain.BuildReport(results, nil, commitRules, nil, domain.ReportOptions{Deduplicate: testCase.deduplicate})
+
+			conventional := report.Commits[0].RuleResults[0]
+			require.Len(t, conventional.Errors, 2)
+			require.Empty(t, conventional.Errors[0].RelatedTo())
+
+			subject := report.Commits[0].RuleResults[1]
+			relatedTo := make([]string, 0, len(subject.Errors))
+
+			for _, err := range subject.Errors {
+				relatedTo = append(relatedTo, err.RelatedTo())
+			}
+
+			require.Equal(t, testCase.expectedSubject, relatedTo)
+			require.Equal(t, testCase.expectedFailed, report.Summary.FailedRules["Subject"])
+			require.False(t, report.Summary.AllPassed)
+		})
+	}
+}
+
+func TestBuildReport_CaseFollowsConventionalFormat(t *testing.T) {
+	commitRules := []domain.CommitRule{namedRule("ConventionalCommit"), namedRule("Subject")}
+	conventional := domain.New("ConventionalCommit", domain.ErrInvalidConventionalFormat, "Not conventional")
+	wrongCase := domain.New("Subject", domain.ErrWrongCaseLower, "First letter 'B' should be 'b'")
+
+	// "Bad commit" starts with a capital because it has no type, not as a mistake of its own
+	report := domain.BuildReport([]domain.ValidationResult{
+		{Commit: domain.Commit{Hash: "a"}, Errors: []domain.ValidationError{conventional, wrongCase}},
+	}, nil, commitRules, nil, domain.ReportOptions{})
+
+	subject := report.Commits[0].RuleResults[1]
+	require.Len(t, subject.Errors, 1)
+	require.Equal(t, "ConventionalCommit", subject.Errors[0].RelatedTo())
+
+	// "feat: Add login" is conventional, so its case is a mistake of its own
+	report = domain.BuildReport([]domain.ValidationResult{
+		{Commit: domain.Commit{Hash: "b"}, Errors: []domain.ValidationError{wrongCase}},
+	}, nil, commitRules, nil, domain.ReportOptions{Deduplicate: true})
+
+	subject = report.Commits[0].RuleResults[1]
+	require.Len(t, subject.Errors, 1)
+	require.Empty(t, subject.Errors[0].RelatedTo())
+}
+
+func TestReportBuilder(t *testing.T) {
+	failure := domain.New("Subject", domain.ErrSubjectTooLong, "too long")
+	repoFailure := domain.New("BranchAhead", domain.ErrTooManyCommits, "too many commits")
+	commitRules := []domain.CommitRule{namedRule("Subject")}
+	repoRules := []domain.RepositoryRule{namedRepositoryRule("BranchAhead")}
+	results := []domain.ValidationResult{
+		{Commit: domain.Commit{Hash: "c2", CommitDate: "2025-03-02T10:00:00Z"}, Errors: []domain.ValidationError{failure}},
+		{Commit: domain.Commit{Hash: "c1", CommitDate: "2025-03-01T10:00:00Z"}},
+	}
+
+	expected := domain.BuildReport(results, []domain.ValidationError{repoFailure}, commitRules, repoRules, domain.ReportOptions{})
+
+	builder := domain.NewReportBuilder(commitRules, repoRules, domain.ReportOptions{})
+	for _, result := range results {
+		builder.Add(result)
+	}
+
+	report := builder.Build([]domain.ValidationError{repoFailure})
+	require.Equal(t, expected.Summary, report.Summary)
+	require.Equal(t, expected.Commits, report.Commits)
+	require.Equal(t, "c1", report.Commits[0].Commit.Hash, "commits are reported oldest first")
+
+	summaryOnly := domain.NewReportBuilder(commitRules, repoRules, domain.ReportOptions{SummaryOnly: true})
+	for _, result := range results {
+		summaryOnly.Add(result)
+	}
+
+	report = summaryOnly.Build([]domain.ValidationError{repoFailure})
+	require.Equal(t, expected.Summary, report.Summary)
+	require.Equal(t, map[string]int{"Subject": 1, "BranchAhead": 1}, report.Summary.FailedRules)
+	require.Empty(t, report.Commits)
+	require.Equal(t, expected.Repository, report.Repository)
+
+	var streamed []domain.CommitReport
+
+	streaming := domain.NewReportBuilder(commitRules, repoRules, domain.ReportOptions{}).StreamTo(func(commitReport domain.CommitReport) {
+		streamed = append(streamed, commitReport)
+	})
+	for _, result := range results {
+		streaming.Add(result)
+	}
+
+	report = streaming.Build([]domain.ValidationError{repoFailure})
+	require.Equal(t, expected.Summary, report.Summary)
+	require.Empty(t, report.Commits)
+	require.ElementsMatch(t, expected.Commits, streamed, "each commit is streamed as it is added")
+}
+
+func TestReport_WithoutRepository(t *testing.T) {
+	report := domain.BuildReport(nil, nil, nil, []domain.RepositoryRule{namedRepositoryRule("BranchAhead")}, domain.ReportOptions{})
+	require.Equal(t, domain.StatusPassed, report.Repository.RuleResults[0].Status)
+
+	report = report.WithoutRepository([]domain.RepositoryRule{namedRepositoryRule("BranchAhead"), namedRepositoryRule("Review")})
+	require.Equal(t, []domain.RuleReport{
+		{Name: "BranchAhead", Status: domain.StatusSkipped, Message: "Skipped because there is no repository", SkipReason: domain.SkipReasonNoRepository},
+		{Name: "Review", Status: domain.StatusSkipped, Message: "Skipped because there is no repository", SkipReason: domain.SkipReasonNoRepository},
+	}, report.Repository.RuleResults)
+	require.True(t, report.Summary.AllPassed, "skipped rules do not fail validation")
+}
+
+func TestReport_WithDuplicatesGrouped(t *testing.T) {
+	commitRules := []domain.CommitRule{namedRule("Subject"), namedRule("Signature")}
+	tooLong := domain.New("Subject", domain.ErrSubjectTooLong, "too long")
+	unsigned := domain.New("Signature", domain.ErrMissingSignature, "not signed")
+
+	results := []domain.ValidationResult{
+		{Commit: domain.Commit{Hash: "a", Message: "fix: handle empty input", CommitDate: "1"}, Errors: []domain.ValidationError{tooLong}},
+		{Commit: domain.Commit{Hash: "b", Message: "feat: add login", CommitDate: "2"}},
+		{Commit: domain.Commit{Hash: "c", Message: "fix: handle empty input", CommitDate: "3"}, Errors: []domain.ValidationError{tooLong}},
+		{Commit: domain.Commit{Hash: "d", Message: "fix: handle empty input", CommitDate: "4"}, Errors: []domain.ValidationError{tooLong, unsigned}},
+		{Commit: domain.Commit{Hash: "e", Message: "fix: handle empty input", CommitDate: "5"}, Errors: []domain.ValidationError{tooLong}},
+	}
+
+	report := domain.BuildReport(results, nil, commitRules, nil, domain.ReportOptions{})
+	grouped := report.WithDuplicatesGrouped()
+
+	// The commit whose results differ is reported on its own
+	require.Len(t, grouped.Commits, 3)
+	require.Equal(t, "a", grouped.Commits[0].Commit.Hash)
+	require.Equal(t, []string{"c", "e"}, grouped.Commits[0].Duplicates)
+	require.Empty(t, grouped.Commits[1].Duplicates)
+	require.Equal(t, "d", grouped.Commits[2].Commit.Hash)
+	require.Empty(t, grouped.Commits[2].Duplicates)
+
+	require.Equal(t, report.Summary, grouped.Summary, "every commit is still counted")
+	require.Len(t, report.Commits, 5, "the report is left as it is")
+	require.Empty(t, report.Commits[0].Duplicates)
+}
+
+func TestBuildReport_RuleStatuses(t *testing.T) {
+	commitRules := []domain.CommitRule{namedRule("Spell"), namedRule("Subject")}
+	merge := domain.Commit{Hash: "m1", CommitDate: "2025-03-02T10:00:00Z", IsMergeCommit: true}
+	results := []domain.ValidationResult{
+		{Commit: domain.Commit{Hash: "c1", CommitDate: "2025-03-01T10:00:00Z"}, NotApplicable: map[string]bool{"Spell": true}},
+		domain.SkipCommit(merge, domain.SkipReasonMergeCommit),
+	}
+
+	report := domain.BuildReport(results, nil, commitRules, nil, domain.ReportOptions{})
+	require.Equal(t, 1, report.Summary.TotalCommits)
+	require.Equal(t, 1, report.Summary.PassedCommits)
+	require.Equal(t, 1, report.Summary.SkippedCommits)
+	require.True(t, report.Summary.AllPassed)
+
+	require.Equal(t, []domain.RuleReport{
+		{Name: "Spell", Status: domain.StatusNotApplicable, Message: "Not applicable to this commit"},
+		{Name: "Subject", Status: domain.StatusPassed, Message: "Passed"},
+	}, report.Commits[0].RuleResults)
+
+	skipped := report.Commits[1]
+	require.Equal(t, domain.SkipReasonMergeCommit, skipped.SkipReason)
+	require.True(t, skipped.Passed)
+
+	for _, ruleReport := range skipped.RuleResults {
+		require.Equal(t, domain.StatusSkipped, ruleReport.Status)
+		require.Equal(t, domain.SkipReasonMergeCommit, ruleReport.SkipReason)
+	}
+}
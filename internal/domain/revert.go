@@ -0,0 +1,75 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package domain
+
+import (
+	"regexp"
+	"strings"
+)
+
+var (
+	// revertReferencePattern finds the "This reverts commit <hash>." lines git writes
+	// into the body of reverts.
+	revertReferencePattern = regexp.MustCompile(`(?m)^This reverts commit ([0-9a-fA-F]{7,64})\b`)
+
+	// gitRevertSubjectPattern matches the subject git writes for reverts.
+	gitRevertSubjectPattern = regexp.MustCompile(`^Revert "(.*)"$`)
+)
+
+// Subject forms of reverts.
+const (
+	RevertSubjectGit          = "git"          // Revert "<subject>"
+	RevertSubjectConventional = "conventional" // revert: <subject>
+)
+
+// Revert describes a commit that reverts other commits.
+type Revert struct {
+	// SubjectForm is RevertSubjectGit or RevertSubjectConventional, or empty when only
+	// the body marks the commit as a revert.
+	SubjectForm string
+
+	// Hashes are the commits named by "This reverts commit <hash>" lines.
+	Hashes []string
+
+	// RevertedSubject is the subject of the reverted commit as the revert quotes it, from
+	// a git `Revert "<subject>"` subject or the description of a conventional revert.
+	RevertedSubject string
+}
+
+// ParseRevert returns what commit reverts. A commit is a revert when its subject has the
+// form git writes, its conventional commit type is revert, or its body names a reverted
+// commit with "This reverts commit <hash>".
+func ParseRevert(commit Commit) (Revert, bool) {
+	var revert Revert
+
+	for _, match := range revertReferencePattern.FindAllStringSubmatch(strings.ReplaceAll(commit.Body, "\r\n", "\n"), -1) {
+		revert.Hashes = append(revert.Hashes, strings.ToLower(match[1]))
+	}
+
+	subject := strings.TrimSpace(commit.Subject)
+
+	if match := gitRevertSubjectPattern.FindStringSubmatch(subject); match != nil {
+		revert.SubjectForm = RevertSubjectGit
+		revert.RevertedSubject = match[1]
+
+		return revert, true
+	}
+
+	if parsed := ParseConventionalCommit(subject); parsed.IsValid && strings.EqualFold(parsed.Type, "revert") {
+		revert.SubjectForm = RevertSubjectConventional
+		revert.RevertedSubject = parsed.Description
+
+		return revert, true
+	}
+
+	return revert, len(revert.Hashes) > 0
+}
+
+// IsRevertCommit reports whether commit reverts other commits.
+func IsRevertCommit(commit Commit) bool {
+	_, isRevert := ParseRevert(commit)
+
+	return isRevert
+}
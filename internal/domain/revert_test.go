@@ -0,0 +1,69 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package domain_test
+
+import (
+	"testing"
+
+	"github.com/itiquette/gommitlint/internal/domain"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseRevert(t *testing.T) {
+	tests := []struct {
+		name     string
+		subject  string
+		body     string
+		isRevert bool
+		expected domain.Revert
+	}{
+		{
+			name:     "git revert",
+			subject:  `Revert "feat: add login page"`,
+			body:     "This reverts commit 1A2B3C4D5E6F7A8B9C0D1E2F3A4B5C6D7E8F9A0B.",
+			isRevert: true,
+			expected: domain.Revert{
+				SubjectForm:     domain.RevertSubjectGit,
+				Hashes:          []string{"1a2b3c4d5e6f7a8b9c0d1e2f3a4b5c6d7e8f9a0b"},
+				RevertedSubject: "feat: add login page",
+			},
+		},
+		{
+			name:     "conventional revert of several commits",
+			subject:  "revert(auth): add login page",
+			body:     "This reverts commit 1a2b3c4.\nThis reverts commit 5d6e7f8.",
+			isRevert: true,
+			expected: domain.Revert{
+				SubjectForm:     domain.RevertSubjectConventional,
+				Hashes:          []string{"1a2b3c4", "5d6e7f8"},
+				RevertedSubject: "add login page",
+			},
+		},
+		{
+			name:     "revert marked in the body only",
+			subject:  "Undo the login page",
+			body:     "It broke the build.\n\nThis reverts commit 1a2b3c4.",
+			isRevert: true,
+			expected: domain.Revert{Hashes: []string{"1a2b3c4"}},
+		},
+		{
+			name:    "commit mentioning a revert",
+			subject: "fix: reverting the cache makes login slow",
+			body:    "The commit that reverts commit 1a2b3c4 was wrong.",
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			revert, isRevert := domain.ParseRevert(domain.Commit{Subject: testCase.subject, Body: testCase.body})
+
+			require.Equal(t, testCase.isRevert, isRevert)
+
+			if testCase.isRevert {
+				require.Equal(t, testCase.expected, revert)
+			}
+		})
+	}
+}
@@ -5,6 +5,8 @@
 package domain
 
 import (
+	"maps"
+	"slices"
 	"strings"
 
 	"github.com/itiquette/gommitlint/internal/domain/config"
@@ -28,14 +30,192 @@ type RepositoryRule interface {
 	Validate(commit Commit, repo Repository, config config.Config) []ValidationError
 }
 
+// RangeRule is implemented by repository rules that check the shape of a validated range
+// as a whole rather than each commit on its own.
+type RangeRule interface {
+	// ValidateRange validates all commits of a range, merge commits included.
+	ValidateRange(commits []Commit, config config.Config) []ValidationError
+}
+
+// MessageRule is implemented by repository rules that check the message of each commit.
+// ValidateRepository skips them, as its repository-level pass runs without a commit.
+type MessageRule interface {
+	// ChecksMessage marks the rule as depending on the commit message.
+	ChecksMessage()
+}
+
+// checksMessage reports whether rule, or the rule a condition restricts, is a MessageRule.
+func checksMessage(rule RepositoryRule) bool {
+	if conditional, ok := rule.(ConditionalRepositoryRule); ok {
+		rule = conditional.RepositoryRule
+	}
+
+	_, ok := rule.(MessageRule)
+
+	return ok
+}
+
 // ValidateCommitRules validates commit using CommitRule implementations.
 func ValidateCommitRules(commit Commit, rules []CommitRule, cfg config.Config) []ValidationError {
-	var errors []ValidationError
+	errors, _, _ := runCommitRules(commit, rules, cfg, nil)
+
+	return errors
+}
+
+// runCommitRules runs rules in the order of rules.order until a rule listed in
+// rules.blocking fails. The rules after it are returned as skipped, keyed by name to the
+// name of the failed rule. Failures waived for the commit do not block. The details the
+// rules report are returned apart from their errors, keyed by rule name.
+func runCommitRules(commit Commit, rules []CommitRule, cfg config.Config, waivers []Waiver) ([]ValidationError, map[string]string, map[string]map[string]string) {
+	var (
+		errors  []ValidationError
+		details map[string]map[string]string
+	)
+
+	rules = orderCommitRules(rules, cfg.Rules.Order)
+
+	// Reverts restore earlier content, which the exempt rules need not check again
+	if len(cfg.Revert.ExemptRules) > 0 && IsRevertCommit(commit) {
+		rules = slices.DeleteFunc(slices.Clone(rules), func(rule CommitRule) bool {
+			return matchesRuleName(rule, cfg.Revert.ExemptRules)
+		})
+	}
+
+	for index, rule := range rules {
+		ruleErrors, ruleDetails := splitDetails(ApplyRuleWarnings(rule.Validate(commit, cfg), cfg.Rules.Warnings))
+		errors = append(errors, ruleErrors...)
+
+		if ruleDetails != nil {
+			if details == nil {
+				details = make(map[string]map[string]string)
+			}
+
+			details[rule.Name()] = ruleDetails
+		}
+
+		if !matchesRuleName(rule, cfg.Rules.Blocking) || !(ValidationResult{Errors: ApplyWaivers(ruleErrors, waivers)}).HasFailures() {
+			continue
+		}
+
+		skipped := make(map[string]string, len(rules)-index-1)
+		for _, remaining := range rules[index+1:] {
+			skipped[remaining.Name()] = rule.Name()
+		}
+
+		return ApplyMessageTemplates(errors, cfg.Messages), skipped, details
+	}
+
+	return ApplyMessageTemplates(errors, cfg.Messages), nil, details
+}
+
+// splitDetails separates the details a rule reported from its errors, merging the
+// details when there are several.
+func splitDetails(ruleErrors []ValidationError) ([]ValidationError, map[string]string) {
+	var details map[string]string
+
+	for _, err := range ruleErrors {
+		if err.IsDetails() {
+			if details == nil {
+				details = make(map[string]string, len(err.Context))
+			}
+
+			maps.Copy(details, err.Context)
+		}
+	}
+
+	if details == nil {
+		return ruleErrors, nil
+	}
+
+	return slices.DeleteFunc(slices.Clone(ruleErrors), ValidationError.IsDetails), details
+}
+
+// orderCommitRules returns rules with the rules named in order first, in that order,
+// followed by the others in the order they were given.
+func orderCommitRules(rules []CommitRule, order []string) []CommitRule {
+	if len(order) == 0 {
+		return rules
+	}
+
+	rank := func(rule CommitRule) int {
+		for index, name := range order {
+			if matchesRuleName(rule, []string{name}) {
+				return index
+			}
+		}
+
+		return len(order)
+	}
+
+	ordered := slices.Clone(rules)
+	slices.SortStableFunc(ordered, func(left, right CommitRule) int {
+		return rank(left) - rank(right)
+	})
+
+	return ordered
+}
+
+// namedRule is implemented by commit and repository rules alike.
+type namedRule interface {
+	Name() string
+}
+
+// SelectCommitRules returns the rules named in only, or all rules when only is empty,
+// leaving out those named in skip. Rules are named as in rules.blocking.
+func SelectCommitRules(rules []CommitRule, only, skip []string) []CommitRule {
+	selected := make([]CommitRule, 0, len(rules))
+
 	for _, rule := range rules {
-		errors = append(errors, rule.Validate(commit, cfg)...)
+		if isSelectedRule(rule, only, skip) {
+			selected = append(selected, rule)
+		}
 	}
 
-	return errors
+	return selected
+}
+
+// SelectRepositoryRules returns the repository rules named in only, or all of them when
+// only is empty, leaving out those named in skip.
+func SelectRepositoryRules(rules []RepositoryRule, only, skip []string) []RepositoryRule {
+	selected := make([]RepositoryRule, 0, len(rules))
+
+	for _, rule := range rules {
+		if isSelectedRule(rule, only, skip) {
+			selected = append(selected, rule)
+		}
+	}
+
+	return selected
+}
+
+// isSelectedRule reports whether rule is named in only, or only is empty, and not in skip.
+func isSelectedRule(rule namedRule, only, skip []string) bool {
+	return (len(only) == 0 || matchesRuleName(rule, only)) && !matchesRuleName(rule, skip)
+}
+
+// matchesRuleName reports whether names lists rule, either by its name in reports or,
+// for rules that describe themselves, by its configuration name.
+func matchesRuleName(rule namedRule, names []string) bool {
+	if len(names) == 0 {
+		return false
+	}
+
+	if contains(names, CleanRuleName(rule.Name())) {
+		return true
+	}
+
+	switch conditional := rule.(type) {
+	case ConditionalCommitRule:
+		rule = conditional.CommitRule
+	case ConditionalRepositoryRule:
+		rule = conditional.RepositoryRule
+	}
+
+	if described, ok := rule.(DescribedRule); ok {
+		return contains(names, described.Metadata().ID)
+	}
+
+	return false
 }
 
 // ValidateRepositoryRules validates commit using RepositoryRule implementations.
@@ -45,34 +225,34 @@ func ValidateRepositoryRules(commit Commit, rules []RepositoryRule, repo Reposit
 		errors = append(errors, rule.Validate(commit, repo, cfg)...)
 	}
 
-	return errors
+	return ApplyMessageTemplates(ApplyRuleWarnings(errors, cfg.Rules.Warnings), cfg.Messages)
 }
 
-// DefaultDisabledRulesList contains rules that are disabled by default.
-// Only rules that require explicit opt-in should be listed here.
-var DefaultDisabledRulesList = []string{
-	"jirareference", // Organization-specific, requires JIRA setup
-	"commitbody",    // Not all projects require detailed commit bodies
-	"spell",         // Spell checking requires dictionary setup
-}
+// ApplyRuleWarnings reports the failures of the rules listed in warnings, by rule or
+// configuration name, as warnings, so that they are shown without failing validation.
+func ApplyRuleWarnings(errors []ValidationError, warnings []string) []ValidationError {
+	if len(warnings) == 0 || len(errors) == 0 {
+		return errors
+	}
 
-// IsRuleActive determines if a rule should run based on configuration.
-// Priority: 1) Explicit enable wins, 2) Explicit disable, 3) Default disabled, 4) Enabled by default.
-func IsRuleActive(ruleName string, enabled, disabled []string) bool {
-	cleanName := CleanRuleName(ruleName)
+	result := make([]ValidationError, len(errors))
 
-	// Priority 1: Explicitly enabled always runs
-	if contains(enabled, cleanName) {
-		return true
-	}
+	for i, err := range errors {
+		name := CleanRuleName(err.Rule)
+		configName := ruleConfigNames[name]
 
-	// Priority 2: Explicitly disabled never runs
-	if contains(disabled, cleanName) {
-		return false
+		if err.IsBlocking() && !err.IsDetails() && slices.ContainsFunc(warnings, func(warning string) bool {
+			warning = CleanRuleName(warning)
+
+			return warning == name || configName != "" && warning == configName
+		}) {
+			err = err.WithSeverity(SeverityWarning)
+		}
+
+		result[i] = err
 	}
 
-	// Priority 3: Default behavior
-	return !contains(DefaultDisabledRulesList, cleanName)
+	return result
 }
 
 // contains is a helper to check if a rule is in a list.
@@ -14,10 +14,11 @@ import (
 )
 
 // BranchAheadRule validates that the current branch is not too many commits ahead
-// of the reference branch.
+// of the reference branch, nor too many commits behind it.
 type BranchAheadRule struct {
-	maxCommitsAhead int
-	reference       string
+	maxCommitsAhead  int
+	maxCommitsBehind int
+	reference        string
 }
 
 // NewBranchAheadRule creates a new rule for checking commits ahead of a reference branch from config.
@@ -33,8 +34,9 @@ func NewBranchAheadRule(cfg config.Config) BranchAheadRule {
 	}
 
 	return BranchAheadRule{
-		maxCommitsAhead: maxCommitsAhead,
-		reference:       reference,
+		maxCommitsAhead:  maxCommitsAhead,
+		maxCommitsBehind: cfg.Repo.MaxCommitsBehind,
+		reference:        reference,
 	}
 }
 
@@ -69,7 +71,8 @@ func (r BranchAheadRule) validateConfig() []domain.ValidationError {
 	return errors
 }
 
-// Validate checks that the current branch is not too many commits ahead of the reference branch.
+// Validate checks that the current branch is not too many commits ahead of the reference
+// branch, and with repo.max_commits_behind set, not too many commits behind it.
 // This rule requires repository access, so it checks if repository is available.
 func (r BranchAheadRule) Validate(_ domain.Commit, repo domain.Repository, cfg config.Config) []domain.ValidationError {
 	// First validate configuration
@@ -82,13 +85,24 @@ func (r BranchAheadRule) Validate(_ domain.Commit, repo domain.Repository, cfg c
 		return nil
 	}
 
-	// Skip validation if explicitly disabled in original config (set to 0)
-	if cfg.Repo.MaxCommitsAhead == 0 {
-		return nil
+	ctx := context.Background()
+
+	var errors []domain.ValidationError
+
+	// Skip the ahead check if explicitly disabled in original config (set to 0)
+	if cfg.Repo.MaxCommitsAhead != 0 {
+		errors = append(errors, r.validateAhead(ctx, repo)...)
 	}
 
-	ctx := context.Background()
+	if r.maxCommitsBehind > 0 {
+		errors = append(errors, r.validateBehind(ctx, repo)...)
+	}
 
+	return errors
+}
+
+// validateAhead checks the number of commits ahead of the reference branch.
+func (r BranchAheadRule) validateAhead(ctx context.Context, repo domain.Repository) []domain.ValidationError {
 	// Get the number of commits ahead with enhanced error handling
 	commitsAhead, err := repo.GetCommitsAheadCount(ctx, r.reference)
 	if err != nil {
@@ -100,7 +114,7 @@ func (r BranchAheadRule) Validate(_ domain.Commit, repo domain.Repository, cfg c
 		}
 
 		// Other errors are actual problems
-		return r.handleRepositoryError(err)
+		return r.handleRepositoryError(err, "ahead of")
 	}
 
 	// Validate against the maximum
@@ -113,9 +127,44 @@ func (r BranchAheadRule) Validate(_ domain.Commit, repo domain.Repository, cfg c
 	return nil
 }
 
-// handleRepositoryError provides enhanced error handling for different types of repository errors.
-// Note: Reference not found errors are handled separately in Validate() as non-errors.
-func (r BranchAheadRule) handleRepositoryError(err error) []domain.ValidationError {
+// validateBehind checks the number of commits behind the reference branch. Repositories
+// that cannot count them, such as pull requests read from the GitHub API, are not checked.
+func (r BranchAheadRule) validateBehind(ctx context.Context, repo domain.Repository) []domain.ValidationError {
+	counter, ok := repo.(domain.BehindCounter)
+	if !ok {
+		return nil
+	}
+
+	commitsBehind, err := counter.GetCommitsBehindCount(ctx, r.reference)
+	if err != nil {
+		// A missing reference branch is nothing to be behind
+		if isReferenceNotFoundError(err.Error()) {
+			return nil
+		}
+
+		return r.handleRepositoryError(err, "behind")
+	}
+
+	if commitsBehind <= r.maxCommitsBehind {
+		return nil
+	}
+
+	return []domain.ValidationError{
+		domain.New(r.Name(), domain.ErrTooManyCommitsBehind,
+			fmt.Sprintf("Current branch is %d commits behind '%s' (maximum allowed: %d)",
+				commitsBehind, r.reference, r.maxCommitsBehind)).
+			WithContextMap(map[string]string{
+				"actual":   strconv.Itoa(commitsBehind),
+				"expected": "max " + strconv.Itoa(r.maxCommitsBehind),
+			}).
+			WithHelp(fmt.Sprintf("Rebase onto or merge the latest '%s' to test the changes against what is there now", r.reference)),
+	}
+}
+
+// handleRepositoryError provides enhanced error handling for different types of repository errors
+// when counting the commits ahead of or behind the reference branch, as direction says.
+// Note: Reference not found errors are handled separately as non-errors.
+func (r BranchAheadRule) handleRepositoryError(err error, direction string) []domain.ValidationError {
 	errMsg := err.Error()
 
 	// Different error handling based on error type
@@ -135,7 +184,7 @@ func (r BranchAheadRule) handleRepositoryError(err error) []domain.ValidationErr
 		// Other git operation errors - provide general error
 		return []domain.ValidationError{
 			domain.New(r.Name(), domain.ErrGitOperationFailed,
-				fmt.Sprintf("Failed to check commits ahead of '%s'", r.reference)).
+				fmt.Sprintf("Failed to check commits %s '%s'", direction, r.reference)).
 				WithContextMap(map[string]string{
 					"actual":   "operation failed",
 					"expected": "successful git operation",
@@ -194,6 +243,26 @@ func (r BranchAheadRule) Name() string {
 	return "BranchAhead"
 }
 
+// Metadata returns the documentation of the rule.
+func (r BranchAheadRule) Metadata() domain.RuleMetadata {
+	return domain.RuleMetadata{
+		ID:       "branchahead",
+		Name:     r.Name(),
+		Category: domain.CategoryHistory,
+		Severity: domain.SeverityError,
+		Summary:  "Commits ahead of and behind the reference branch",
+		Description: "Fails when the checked out branch is more than repo.max_commits_ahead " +
+			"commits ahead of repo.reference_branch, to keep branches small and short-lived, " +
+			"or more than repo.max_commits_behind commits behind it, as changes tested " +
+			"against an old base are more likely to break once merged.",
+		ErrorCodes: []domain.ValidationErrorCode{
+			domain.ErrTooManyCommits, domain.ErrTooManyCommitsBehind, domain.ErrInvalidConfig,
+			domain.ErrMissingReference, domain.ErrInvalidRepo, domain.ErrGitOperationFailed,
+		},
+		ConfigKeys: []string{"repo.max_commits_ahead", "repo.max_commits_behind", "repo.reference_branch"},
+	}
+}
+
 // ensureFullReference converts short reference names to full Git reference paths.
 // This ensures unambiguous reference resolution in Git commands, supporting both
 // packed and loose reference storage formats.
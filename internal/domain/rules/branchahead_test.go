@@ -19,6 +19,7 @@ import (
 // Only implements GetCommitsAhead for testing the branchahead rule.
 type mockRepository struct {
 	commitsAhead     int
+	commitsBehind    int
 	err              error
 	refBranchName    string
 	currentBranch    string
@@ -52,6 +53,13 @@ func (m *mockRepository) GetCommitsAheadCount(_ context.Context, refBranch strin
 	return m.commitsAhead, nil
 }
 
+// GetCommitsBehindCount returns the number of commits behind stored in the mock.
+func (m *mockRepository) GetCommitsBehindCount(_ context.Context, refBranch string) (int, error) {
+	m.refBranchName = refBranch
+
+	return m.commitsBehind, m.err
+}
+
 // Stub implementations for Repository interface (not used in branchahead tests).
 func (m *mockRepository) GetCommit(_ context.Context, _ string) (domain.Commit, error) {
 	return domain.Commit{}, nil
@@ -158,6 +166,67 @@ func TestBranchAheadRule(t *testing.T) {
 	}
 }
 
+// TestBranchAheadRule_Behind tests the limit on commits behind the reference branch.
+func TestBranchAheadRule_Behind(t *testing.T) {
+	tests := []struct {
+		name            string
+		maxCommitsAhead int
+		maxBehind       int
+		repo            *mockRepository
+		wantErrorCodes  []string
+	}{
+		{
+			name:      "within limit",
+			maxBehind: 20,
+			repo:      &mockRepository{commitsBehind: 20},
+		},
+		{
+			name:           "exceeds limit",
+			maxBehind:      20,
+			repo:           &mockRepository{commitsBehind: 21},
+			wantErrorCodes: []string{string(domain.ErrTooManyCommitsBehind)},
+		},
+		{
+			name: "no limit",
+			repo: &mockRepository{commitsBehind: 500},
+		},
+		{
+			name:            "both limits exceeded",
+			maxCommitsAhead: 5,
+			maxBehind:       20,
+			repo:            &mockRepository{commitsAhead: 8, commitsBehind: 30},
+			wantErrorCodes:  []string{string(domain.ErrTooManyCommits), string(domain.ErrTooManyCommitsBehind)},
+		},
+		{
+			name:           "git error",
+			maxBehind:      20,
+			repo:           &mockRepository{err: errors.New("git error")},
+			wantErrorCodes: []string{string(domain.ErrGitOperationFailed)},
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			cfg := config.Config{
+				Repo: config.RepoConfig{
+					MaxCommitsAhead:  testCase.maxCommitsAhead,
+					MaxCommitsBehind: testCase.maxBehind,
+					ReferenceBranch:  "main",
+				},
+			}
+
+			failures := NewBranchAheadRule(cfg).Validate(domain.Commit{}, testCase.repo, cfg)
+
+			codes := make([]string, 0, len(failures))
+			for _, failure := range failures {
+				codes = append(codes, failure.Code)
+			}
+
+			require.ElementsMatch(t, testCase.wantErrorCodes, codes)
+		})
+	}
+}
+
 // TestBranchAheadRule_WithConfig tests the config-based pattern.
 func TestBranchAheadRule_WithConfig(t *testing.T) {
 	t.Run("default options", func(t *testing.T) {
@@ -0,0 +1,176 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package rules
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/itiquette/gommitlint/internal/domain"
+	"github.com/itiquette/gommitlint/internal/domain/config"
+)
+
+// ticketKeyPattern finds JIRA-style ticket keys such as PROJ-123 in a commit message.
+var ticketKeyPattern = regexp.MustCompile(`\b[A-Z][A-Z0-9]+-[0-9]+\b`)
+
+// BranchTicketRule requires commits to reference the ticket named by the branch they are
+// made on, which catches tickets pasted from another change.
+type BranchTicketRule struct {
+	pattern          *regexp.Regexp
+	requireReference bool
+	projects         []string
+}
+
+// NewBranchTicketRule creates a new BranchTicketRule from config. An invalid pattern,
+// which configuration validation reports, leaves the rule without a pattern.
+func NewBranchTicketRule(cfg config.Config) BranchTicketRule {
+	pattern, _ := regexp.Compile(cfg.BranchTicket.Pattern)
+
+	return BranchTicketRule{
+		pattern:          pattern,
+		requireReference: cfg.BranchTicket.RequireReference,
+		projects:         cfg.Jira.ProjectPrefixes,
+	}
+}
+
+// Name returns the rule name.
+func (r BranchTicketRule) Name() string {
+	return "BranchTicket"
+}
+
+// Metadata returns the documentation of the rule.
+func (r BranchTicketRule) Metadata() domain.RuleMetadata {
+	return domain.RuleMetadata{
+		ID:       "branchticket",
+		Name:     r.Name(),
+		Category: domain.CategoryReference,
+		Severity: domain.SeverityError,
+		Summary:  "Referenced ticket matches the ticket in the branch name",
+		Description: "Takes the ticket from the name of the checked out branch, or the head branch " +
+			"of a pull request, with branch_ticket.pattern and requires the tickets the message " +
+			"references to include it. Numeric tickets, as in fix/123-crash, are matched against " +
+			"#123 references, other tickets against keys of the project of the branch ticket " +
+			"or of jira.project_prefixes, so that words such as UTF-8 are not taken for " +
+			"tickets. Messages without any reference pass unless " +
+			"branch_ticket.require_reference is set. Branches without a ticket are not checked.",
+		ErrorCodes: []domain.ValidationErrorCode{domain.ErrBranchTicketMismatch, domain.ErrBranchTicketMissing},
+		ConfigKeys: []string{"branch_ticket.pattern", "branch_ticket.require_reference", "jira.project_prefixes"},
+		Examples: []domain.RuleExample{
+			{Message: "fix: retry uploads\n\nRefs PROJ-123", Valid: true, Note: "on feature/PROJ-123-retry"},
+			{Message: "fix: retry uploads\n\nRefs PROJ-132", Note: "on feature/PROJ-123-retry"},
+		},
+	}
+}
+
+// ChecksMessage marks the rule as a domain.MessageRule.
+func (r BranchTicketRule) ChecksMessage() {}
+
+// Validate checks the tickets referenced by a commit against the ticket of the current
+// branch. Commits are not checked when the branch cannot be resolved or names no ticket.
+func (r BranchTicketRule) Validate(commit domain.Commit, repo domain.Repository, _ config.Config) []domain.ValidationError {
+	if r.pattern == nil || commit.IsMergeCommit {
+		return nil
+	}
+
+	resolver, ok := repo.(domain.CurrentBranchResolver)
+	if !ok {
+		return nil
+	}
+
+	branch, err := resolver.GetCurrentBranch(context.Background())
+	if err != nil || branch == "" {
+		return nil
+	}
+
+	ticket := r.branchTicket(branch)
+	if ticket == "" {
+		return nil
+	}
+
+	references := r.messageTickets(commit.Subject+"\n"+commit.Body, ticket)
+
+	switch {
+	case len(references) == 0 && r.requireReference:
+		return []domain.ValidationError{
+			domain.New(r.Name(), domain.ErrBranchTicketMissing,
+				fmt.Sprintf("Commit does not reference %s from branch '%s'", ticket, branch)).
+				WithContextMap(map[string]string{"expected": ticket, "branch": branch}).
+				WithHelp("Add " + ticket + " to the commit message"),
+		}
+	case len(references) == 0 || slices.Contains(references, ticket):
+		return nil
+	}
+
+	actual := strings.Join(references, ", ")
+
+	return []domain.ValidationError{
+		domain.New(r.Name(), domain.ErrBranchTicketMismatch,
+			fmt.Sprintf("Commit references %s but branch '%s' belongs to %s", actual, branch, ticket)).
+			WithContextMap(map[string]string{"actual": actual, "expected": ticket, "branch": branch}).
+			WithHelp("Reference " + ticket + ", or commit on the branch of " + actual),
+	}
+}
+
+// branchTicket returns the ticket in branch, from the first capture group of the pattern
+// when it has one. Keys are uppercased and numbers written as #123.
+func (r BranchTicketRule) branchTicket(branch string) string {
+	match := r.pattern.FindStringSubmatch(branch)
+	if match == nil {
+		return ""
+	}
+
+	ticket := match[0]
+	if len(match) > 1 {
+		ticket = match[1]
+	}
+
+	ticket = strings.ToUpper(strings.TrimPrefix(ticket, "#"))
+	if ticket == "" {
+		return ""
+	}
+
+	if strings.Trim(ticket, "0123456789") == "" {
+		return "#" + ticket
+	}
+
+	return ticket
+}
+
+// messageTickets returns the distinct tickets referenced in text, in the form of ticket:
+// #123 issue references for a numeric ticket and keys of known projects otherwise.
+func (r BranchTicketRule) messageTickets(text, ticket string) []string {
+	if strings.HasPrefix(ticket, "#") {
+		var references []string
+
+		for _, reference := range issueReferences(text) {
+			// Issues of other repositories cannot be the ticket of the branch
+			if strings.HasPrefix(reference, "#") {
+				references = append(references, reference)
+			}
+		}
+
+		return references
+	}
+
+	project, _, _ := strings.Cut(ticket, "-")
+
+	var references []string
+
+	for _, key := range ticketKeyPattern.FindAllString(text, -1) {
+		keyProject, _, _ := strings.Cut(key, "-")
+		if keyProject != project && !slices.Contains(r.projects, keyProject) {
+			continue
+		}
+
+		if !slices.Contains(references, key) {
+			references = append(references, key)
+		}
+	}
+
+	return references
+}
@@ -0,0 +1,147 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package rules_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/itiquette/gommitlint/internal/domain"
+	"github.com/itiquette/gommitlint/internal/domain/config"
+	"github.com/itiquette/gommitlint/internal/domain/rules"
+	"github.com/stretchr/testify/require"
+)
+
+// currentBranchRepository is a repository stub that only resolves the current branch.
+type currentBranchRepository struct {
+	domain.Repository
+
+	branch string
+}
+
+func (r currentBranchRepository) GetCurrentBranch(_ context.Context) (string, error) {
+	return r.branch, nil
+}
+
+func TestBranchTicketRule(t *testing.T) {
+	tests := []struct {
+		name             string
+		branch           string
+		pattern          string
+		message          string
+		requireReference bool
+		expectedCode     domain.ValidationErrorCode
+		expectedMessage  string
+	}{
+		{
+			name:    "matching ticket",
+			branch:  "feature/PROJ-123-retry-uploads",
+			message: "Retry uploads\n\nRefs PROJ-123",
+		},
+		{
+			name:    "lowercase branch ticket",
+			branch:  "feature/proj-123-retry-uploads",
+			message: "PROJ-123: retry uploads",
+		},
+		{
+			name:    "matching ticket among others",
+			branch:  "feature/PROJ-123",
+			message: "Retry uploads\n\nRefs PROJ-99, PROJ-123",
+		},
+		{
+			name:            "wrong ticket",
+			branch:          "feature/PROJ-123-retry-uploads",
+			message:         "Retry uploads\n\nRefs PROJ-132",
+			expectedCode:    domain.ErrBranchTicketMismatch,
+			expectedMessage: "Commit references PROJ-132 but branch 'feature/PROJ-123-retry-uploads' belongs to PROJ-123",
+		},
+		{
+			name:         "ticket of a configured project",
+			branch:       "feature/PROJ-123",
+			message:      "Retry uploads\n\nRefs OPS-7",
+			expectedCode: domain.ErrBranchTicketMismatch,
+		},
+		{
+			name:    "words that look like tickets",
+			branch:  "feature/PROJ-123",
+			message: "Decode uploads as UTF-8\n\nHash them with SHA-256.",
+		},
+		{
+			name:    "no reference",
+			branch:  "feature/PROJ-123",
+			message: "Retry uploads",
+		},
+		{
+			name:             "no reference required",
+			branch:           "feature/PROJ-123",
+			message:          "Retry uploads",
+			requireReference: true,
+			expectedCode:     domain.ErrBranchTicketMissing,
+		},
+		{
+			name:             "branch without ticket",
+			branch:           "main",
+			message:          "Retry uploads",
+			requireReference: true,
+		},
+		{
+			name:    "numeric ticket",
+			branch:  "fix/42-crash",
+			pattern: `^[a-z]+/([0-9]+)-`,
+			message: "Fix crash\n\nFixes #42",
+		},
+		{
+			name:         "wrong numeric ticket",
+			branch:       "fix/42-crash",
+			pattern:      `^[a-z]+/([0-9]+)-`,
+			message:      "Fix crash\n\nFixes #24 and octo/tools#42",
+			expectedCode: domain.ErrBranchTicketMismatch,
+		},
+		{
+			name:             "detached head",
+			branch:           "",
+			message:          "Retry uploads",
+			requireReference: true,
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			cfg := config.NewDefault()
+			cfg.Jira.ProjectPrefixes = []string{"PROJ", "OPS"}
+			cfg.BranchTicket.RequireReference = testCase.requireReference
+
+			if testCase.pattern != "" {
+				cfg.BranchTicket.Pattern = testCase.pattern
+			}
+
+			commit := domain.NewCommit("abc123", testCase.message, "Dev Eloper", "dev@example.com", "", "", false)
+
+			rule := rules.NewBranchTicketRule(cfg)
+			errors := rule.Validate(commit, currentBranchRepository{branch: testCase.branch}, cfg)
+
+			if testCase.expectedCode == "" {
+				require.Empty(t, errors)
+
+				return
+			}
+
+			require.Len(t, errors, 1)
+			require.Equal(t, string(testCase.expectedCode), errors[0].Code)
+
+			if testCase.expectedMessage != "" {
+				require.Equal(t, testCase.expectedMessage, errors[0].Message)
+			}
+		})
+	}
+}
+
+func TestBranchTicketRule_WithoutBranchResolver(t *testing.T) {
+	cfg := config.NewDefault()
+	cfg.BranchTicket.RequireReference = true
+
+	rule := rules.NewBranchTicketRule(cfg)
+	require.Empty(t, rule.Validate(domain.Commit{Subject: "Retry uploads"}, branchRepository{branch: "PROJ-1"}, cfg))
+}
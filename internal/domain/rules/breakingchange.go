@@ -0,0 +1,200 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package rules
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/itiquette/gommitlint/internal/domain"
+	"github.com/itiquette/gommitlint/internal/domain/config"
+)
+
+// breakingFooterPattern finds the BREAKING CHANGE footer of Conventional Commits.
+var breakingFooterPattern = regexp.MustCompile(`(?m)^BREAKING[ -]CHANGE:`)
+
+// migrationPattern matches a paragraph that starts with a "Migration:" label and says more.
+var migrationPattern = regexp.MustCompile(`(?is)^migration:\s*\S`)
+
+// BreakingChangeRule holds breaking changes, marked with '!' in a conventional subject or
+// with a BREAKING CHANGE footer, to more than other commits: an explanation of some
+// length, migration instructions, the branches they may land on and approval trailers.
+type BreakingChangeRule struct {
+	minBodyLength    int
+	requireMigration bool
+	branches         []string
+	requiredTrailers []string
+}
+
+// NewBreakingChangeRule creates a new BreakingChangeRule from config.
+func NewBreakingChangeRule(cfg config.Config) BreakingChangeRule {
+	return BreakingChangeRule{
+		minBodyLength:    cfg.BreakingChange.MinBodyLength,
+		requireMigration: cfg.BreakingChange.RequireMigration,
+		branches:         cfg.BreakingChange.Branches,
+		requiredTrailers: cfg.BreakingChange.RequiredTrailers,
+	}
+}
+
+// Name returns the rule name.
+func (r BreakingChangeRule) Name() string {
+	return "BreakingChange"
+}
+
+// Metadata returns the documentation of the rule.
+func (r BreakingChangeRule) Metadata() domain.RuleMetadata {
+	return domain.RuleMetadata{
+		ID:       "breakingchange",
+		Name:     r.Name(),
+		Category: domain.CategoryMessage,
+		Severity: domain.SeverityError,
+		Summary:  "Extra requirements for breaking changes",
+		Description: "Applies to commits marked as breaking with '!' after the type or scope, or " +
+			"with a BREAKING CHANGE footer. breaking_change.min_body_length sets the minimum " +
+			"length of the explanation, the body without its trailers plus the BREAKING CHANGE " +
+			"footer. breaking_change.require_migration asks for a paragraph starting with " +
+			"'Migration:', breaking_change.branches limits the target branches breaking changes " +
+			"may land on and breaking_change.required_trailers lists trailers they must carry, " +
+			"such as Approved-by. Other commits are not checked.",
+		ErrorCodes: []domain.ValidationErrorCode{
+			domain.ErrBreakingChangeBodyTooShort, domain.ErrMissingMigration,
+			domain.ErrBreakingChangeBranch, domain.ErrMissingBreakingChangeTrailer,
+		},
+		ConfigKeys: []string{
+			"breaking_change.min_body_length", "breaking_change.require_migration",
+			"breaking_change.branches", "breaking_change.required_trailers",
+		},
+		Examples: []domain.RuleExample{
+			{
+				Message: "feat(api)!: drop the v1 endpoints\n\nThe v1 endpoints were deprecated a year ago.\n\n" +
+					"Migration: call the v2 endpoints, which take the same parameters.\n\nApproved-by: Jane Doe <jane@example.com>",
+				Valid: true,
+				Note:  "with require_migration and required_trailers: [Approved-by]",
+			},
+			{Message: "feat(api)!: drop the v1 endpoints", Note: "no migration section"},
+		},
+	}
+}
+
+// ChecksMessage marks the rule as a domain.MessageRule.
+func (r BreakingChangeRule) ChecksMessage() {}
+
+// Validate checks a breaking change against the configured requirements. The target
+// branch is resolved through the repository; the branch requirement is not checked when
+// it cannot be resolved.
+func (r BreakingChangeRule) Validate(commit domain.Commit, repo domain.Repository, _ config.Config) []domain.ValidationError {
+	if commit.IsMergeCommit || !isBreakingChange(commit) {
+		return nil
+	}
+
+	paragraphs := descriptionParagraphs(commit.Body)
+	trailers := commit.MessageTrailers()
+
+	// The explanation may be written in the BREAKING CHANGE footer instead of the body
+	explanation := slices.Concat(paragraphs, domain.TrailerValues(trailers, "BREAKING CHANGE"),
+		domain.TrailerValues(trailers, "BREAKING-CHANGE"))
+
+	var errors []domain.ValidationError
+
+	if length := utf8.RuneCountInString(strings.Join(explanation, "\n\n")); r.minBodyLength > 0 && length < r.minBodyLength {
+		errors = append(errors,
+			domain.New(r.Name(), domain.ErrBreakingChangeBodyTooShort,
+				fmt.Sprintf("Breaking change explained in too few characters (%d/%d)", length, r.minBodyLength)).
+				WithContextMap(map[string]string{
+					"actual":   strconv.Itoa(length),
+					"expected": fmt.Sprintf("min %d", r.minBodyLength),
+				}).
+				WithHelp(fmt.Sprintf("Explain in at least %d characters what breaks and why", r.minBodyLength)))
+	}
+
+	if r.requireMigration && !hasMigrationSection(paragraphs) {
+		errors = append(errors,
+			domain.New(r.Name(), domain.ErrMissingMigration, "Breaking change has no migration section").
+				WithContextMap(map[string]string{
+					"expected": "Migration: paragraph",
+				}).
+				WithHelp("Add a paragraph starting with 'Migration:' that tells users how to adapt"))
+	}
+
+	errors = append(errors, r.validateBranch(repo)...)
+
+	for _, key := range r.requiredTrailers {
+		if len(domain.TrailerValues(trailers, key)) > 0 {
+			continue
+		}
+
+		errors = append(errors,
+			domain.New(r.Name(), domain.ErrMissingBreakingChangeTrailer,
+				fmt.Sprintf("Breaking change has no %s trailer", key)).
+				WithContextMap(map[string]string{
+					"expected": key,
+					"trailer":  key,
+				}).
+				WithHelp(fmt.Sprintf("Add a '%s:' trailer to the end of the message", key)))
+	}
+
+	return errors
+}
+
+// validateBranch checks that the target branch is one breaking changes may land on.
+func (r BreakingChangeRule) validateBranch(repo domain.Repository) []domain.ValidationError {
+	if len(r.branches) == 0 {
+		return nil
+	}
+
+	resolver, ok := repo.(domain.BranchResolver)
+	if !ok {
+		return nil
+	}
+
+	// Detached and unborn heads have no target branch
+	branch, err := resolver.GetTargetBranch(context.Background())
+	if err != nil || branch == "" {
+		return nil
+	}
+
+	for _, pattern := range r.branches {
+		if matched, err := path.Match(pattern, branch); err == nil && matched {
+			return nil
+		}
+	}
+
+	expected := strings.Join(r.branches, ", ")
+
+	return []domain.ValidationError{
+		domain.New(r.Name(), domain.ErrBreakingChangeBranch,
+			fmt.Sprintf("Breaking changes may not land on '%s'", branch)).
+			WithContextMap(map[string]string{
+				"actual":   branch,
+				"expected": expected,
+				"branch":   branch,
+			}).
+			WithHelp("Target one of the branches that take breaking changes: " + expected),
+	}
+}
+
+// isBreakingChange reports whether commit is marked as a breaking change, with '!' in
+// its conventional subject or with a BREAKING CHANGE footer.
+func isBreakingChange(commit domain.Commit) bool {
+	return domain.ParseConventionalCommit(commit.Subject).Breaking ||
+		breakingFooterPattern.MatchString(strings.ReplaceAll(commit.Body, "\r\n", "\n"))
+}
+
+// hasMigrationSection reports whether one of paragraphs is a migration section.
+func hasMigrationSection(paragraphs []string) bool {
+	for _, paragraph := range paragraphs {
+		if migrationPattern.MatchString(paragraph) {
+			return true
+		}
+	}
+
+	return false
+}
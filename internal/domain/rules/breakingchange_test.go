@@ -0,0 +1,119 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package rules_test
+
+import (
+	"testing"
+
+	"github.com/itiquette/gommitlint/internal/domain"
+	"github.com/itiquette/gommitlint/internal/domain/config"
+	"github.com/itiquette/gommitlint/internal/domain/rules"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBreakingChangeRule(t *testing.T) {
+	const (
+		explanation = "The v1 endpoints were deprecated a year ago and are removed."
+		migration   = "Migration: call the v2 endpoints, which take the same parameters."
+		approved    = "Approved-by: Jane Doe <jane@example.com>"
+	)
+
+	policy := config.BreakingChangeConfig{
+		MinBodyLength:    40,
+		RequireMigration: true,
+		Branches:         []string{"main", "next/*"},
+		RequiredTrailers: []string{"Approved-by"},
+	}
+
+	tests := []struct {
+		name          string
+		policy        config.BreakingChangeConfig
+		subject       string
+		body          string
+		branch        string
+		expectedCodes []domain.ValidationErrorCode
+	}{
+		{
+			name:    "breaking change meeting every requirement",
+			policy:  policy,
+			subject: "feat(api)!: drop the v1 endpoints",
+			body:    explanation + "\n\n" + migration + "\n\n" + approved,
+			branch:  "main",
+		},
+		{
+			name:    "branch matching a glob",
+			policy:  policy,
+			subject: "feat(api)!: drop the v1 endpoints",
+			body:    explanation + "\n\n" + migration + "\n\n" + approved,
+			branch:  "next/2.0",
+		},
+		{
+			name:    "commit that is not a breaking change",
+			policy:  policy,
+			subject: "feat(api): add the v2 endpoints",
+			branch:  "feature/v2",
+		},
+		{
+			name:    "breaking change without anything",
+			policy:  policy,
+			subject: "feat(api)!: drop the v1 endpoints",
+			branch:  "feature/v2",
+			expectedCodes: []domain.ValidationErrorCode{
+				domain.ErrBreakingChangeBodyTooShort, domain.ErrMissingMigration,
+				domain.ErrBreakingChangeBranch, domain.ErrMissingBreakingChangeTrailer,
+			},
+		},
+		{
+			name:          "footer marks a breaking change",
+			policy:        policy,
+			subject:       "feat(api): drop the v1 endpoints",
+			body:          migration + "\n\nBREAKING CHANGE: the v1 endpoints are removed",
+			branch:        "main",
+			expectedCodes: []domain.ValidationErrorCode{domain.ErrMissingBreakingChangeTrailer},
+		},
+		{
+			name:          "explanation in the footer counts",
+			policy:        config.BreakingChangeConfig{MinBodyLength: 40},
+			subject:       "refactor: rename the configuration file",
+			body:          "BREAKING CHANGE: .tool.yaml is now read from .config/tool.yaml instead",
+			expectedCodes: nil,
+		},
+		{
+			name:          "trailers do not count as explanation",
+			policy:        config.BreakingChangeConfig{MinBodyLength: 40},
+			subject:       "refactor!: rename the configuration file",
+			body:          approved,
+			expectedCodes: []domain.ValidationErrorCode{domain.ErrBreakingChangeBodyTooShort},
+		},
+		{
+			name:          "migration label without instructions",
+			policy:        config.BreakingChangeConfig{RequireMigration: true},
+			subject:       "feat!: drop the v1 endpoints",
+			body:          explanation + "\n\nMigration:",
+			expectedCodes: []domain.ValidationErrorCode{domain.ErrMissingMigration},
+		},
+		{
+			name:    "unresolved branch is not checked",
+			policy:  config.BreakingChangeConfig{Branches: []string{"main"}},
+			subject: "feat!: drop the v1 endpoints",
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			cfg := config.Config{BreakingChange: testCase.policy}
+			commit := domain.Commit{Subject: testCase.subject, Body: testCase.body}
+
+			errors := rules.NewBreakingChangeRule(cfg).Validate(commit, branchRepository{branch: testCase.branch}, cfg)
+
+			codes := make([]domain.ValidationErrorCode, 0, len(errors))
+			for _, err := range errors {
+				codes = append(codes, domain.ValidationErrorCode(err.Code))
+			}
+
+			require.ElementsMatch(t, testCase.expectedCodes, codes)
+		})
+	}
+}
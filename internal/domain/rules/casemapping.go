@@ -0,0 +1,71 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package rules
+
+import (
+	"strings"
+	"unicode"
+)
+
+// germanCase maps ß to the capital sharp s ẞ, which Unicode simple case mapping
+// leaves without an upper case form.
+var germanCase = unicode.SpecialCase{
+	unicode.CaseRange{Lo: 'ß', Hi: 'ß', Delta: [unicode.MaxCase]rune{'ẞ' - 'ß', 0, 'ẞ' - 'ß'}},
+}
+
+// caseMapping maps letters between upper and lower case, by the rules of a locale
+// where they differ from Unicode simple case mapping.
+type caseMapping struct {
+	special unicode.SpecialCase // nil for Unicode simple case mapping
+}
+
+// newCaseMapping returns the case mapping of locale, a language tag such as "tr" or
+// "tr-TR". Turkish and Azerbaijani map i to İ and I to ı; German maps ß to ẞ. Other
+// locales use Unicode simple case mapping.
+func newCaseMapping(locale string) caseMapping {
+	language, _, _ := strings.Cut(strings.ToLower(locale), "-")
+	language, _, _ = strings.Cut(language, "_")
+
+	switch language {
+	case "tr":
+		return caseMapping{special: unicode.TurkishCase}
+	case "az":
+		return caseMapping{special: unicode.AzeriCase}
+	case "de":
+		return caseMapping{special: germanCase}
+	default:
+		return caseMapping{}
+	}
+}
+
+// toUpper maps letter to upper case.
+func (m caseMapping) toUpper(letter rune) rune {
+	if m.special == nil {
+		return unicode.ToUpper(letter)
+	}
+
+	return m.special.ToUpper(letter)
+}
+
+// toLower maps letter to lower case.
+func (m caseMapping) toLower(letter rune) rune {
+	if m.special == nil {
+		return unicode.ToLower(letter)
+	}
+
+	return m.special.ToLower(letter)
+}
+
+// isUpper reports whether letter is in upper case, which letters without an upper
+// case form, such as ß without a German locale or letters of scripts without case, are.
+func (m caseMapping) isUpper(letter rune) bool {
+	return m.toUpper(letter) == letter
+}
+
+// isLower reports whether letter is in lower case, which letters without a lower
+// case form are.
+func (m caseMapping) isLower(letter rune) bool {
+	return m.toLower(letter) == letter
+}
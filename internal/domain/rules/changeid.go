@@ -0,0 +1,125 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package rules
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/itiquette/gommitlint/internal/domain"
+	"github.com/itiquette/gommitlint/internal/domain/config"
+)
+
+// changeIDPattern matches a Change-Id as the commit-msg hook of Gerrit writes it: an I
+// followed by the 40 hexadecimal digits of a SHA-1.
+var changeIDPattern = regexp.MustCompile(`^I[0-9a-f]{40}$`)
+
+// ChangeIDRule checks the Change-Id trailer Gerrit identifies changes by: that commits
+// have exactly one valid Change-Id, or, for repositories outside Gerrit, none at all.
+type ChangeIDRule struct {
+	forbid bool
+}
+
+// NewChangeIDRule creates a new ChangeIDRule from config.
+func NewChangeIDRule(cfg config.Config) ChangeIDRule {
+	return ChangeIDRule{forbid: cfg.ChangeID.Mode == "forbid"}
+}
+
+// Name returns the rule name.
+func (r ChangeIDRule) Name() string {
+	return "ChangeId"
+}
+
+// Metadata returns the documentation of the rule.
+func (r ChangeIDRule) Metadata() domain.RuleMetadata {
+	return domain.RuleMetadata{
+		ID:       "changeid",
+		Name:     r.Name(),
+		Category: domain.CategoryMessage,
+		Severity: domain.SeverityError,
+		Summary:  "Gerrit Change-Id trailer required or forbidden",
+		Description: "With change_id.mode require, the default, the trailer block must hold exactly " +
+			"one Change-Id of an I and 40 lowercase hexadecimal digits, as Gerrit accepts it. A " +
+			"Change-Id above the trailer block is reported as missing, since Gerrit does not find " +
+			"it there. With change_id.mode forbid no Change-Id trailer is allowed, for projects " +
+			"that moved away from Gerrit or mirror commits out of it.",
+		ErrorCodes: []domain.ValidationErrorCode{
+			domain.ErrMissingChangeID, domain.ErrInvalidChangeID, domain.ErrMultipleChangeIDs,
+			domain.ErrForbiddenChangeID,
+		},
+		ConfigKeys: []string{"change_id.mode"},
+		Examples: []domain.RuleExample{
+			{Message: "fix: retry uploads\n\nChange-Id: I8473b95934b5732ac55d26311a706c9c2bde9940", Valid: true},
+			{Message: "fix: retry uploads\n\nChange-Id: 8473b959", Note: "not an I and 40 hexadecimal digits"},
+			{Message: "fix: retry uploads", Note: "no Change-Id"},
+		},
+	}
+}
+
+// Validate checks the Change-Id trailers of the commit.
+func (r ChangeIDRule) Validate(commit domain.Commit, _ config.Config) []domain.ValidationError {
+	changeIDs := domain.TrailerValues(commit.MessageTrailers(), domain.ChangeIDTrailer)
+
+	if r.forbid {
+		if len(changeIDs) == 0 {
+			return nil
+		}
+
+		return []domain.ValidationError{
+			domain.New(r.Name(), domain.ErrForbiddenChangeID, "Commit message has a Change-Id trailer").
+				WithContextMap(map[string]string{"actual": changeIDs[0]}).
+				WithHelp("Remove the Change-Id line; change_id.mode forbids Change-Id trailers. " +
+					"Remove the commit-msg hook of Gerrit from .git/hooks if it adds them"),
+		}
+	}
+
+	switch len(changeIDs) {
+	case 0:
+		actual := "none"
+		if r.hasChangeIDLine(commit) {
+			actual = "Change-Id above the trailer block"
+		}
+
+		return []domain.ValidationError{
+			domain.New(r.Name(), domain.ErrMissingChangeID, "Commit message has no Change-Id trailer").
+				WithContextMap(map[string]string{"actual": actual, "expected": "Change-Id: I<40 hexadecimal digits>"}).
+				WithHelp("Install the commit-msg hook of Gerrit, which adds a Change-Id, and amend the commit " +
+					"(git commit --amend --no-edit). A Change-Id must be in the last paragraph of the message"),
+		}
+	case 1:
+	default:
+		return []domain.ValidationError{
+			domain.New(r.Name(), domain.ErrMultipleChangeIDs,
+				fmt.Sprintf("Commit message has %d Change-Id trailers", len(changeIDs))).
+				WithContextMap(map[string]string{"actual": strconv.Itoa(len(changeIDs)), "expected": "1"}).
+				WithHelp("Keep only the Change-Id of the change this commit belongs to"),
+		}
+	}
+
+	if !changeIDPattern.MatchString(changeIDs[0]) {
+		return []domain.ValidationError{
+			domain.New(r.Name(), domain.ErrInvalidChangeID, fmt.Sprintf("Invalid Change-Id '%s'", changeIDs[0])).
+				WithContextMap(map[string]string{"actual": changeIDs[0], "expected": "I<40 hexadecimal digits>"}).
+				WithHelp("Remove the Change-Id line and let the commit-msg hook of Gerrit add a new one " +
+					"(git commit --amend --no-edit)"),
+		}
+	}
+
+	return nil
+}
+
+// hasChangeIDLine reports whether a line of the message starts with a Change-Id, which
+// outside the trailer block Gerrit does not take as the Change-Id of the commit.
+func (r ChangeIDRule) hasChangeIDLine(commit domain.Commit) bool {
+	for _, line := range strings.Split(commit.FullMessage(), "\n") {
+		if key, _, found := strings.Cut(line, ":"); found && strings.EqualFold(key, domain.ChangeIDTrailer) {
+			return true
+		}
+	}
+
+	return false
+}
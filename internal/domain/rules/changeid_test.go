@@ -0,0 +1,95 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package rules_test
+
+import (
+	"testing"
+
+	"github.com/itiquette/gommitlint/internal/domain"
+	"github.com/itiquette/gommitlint/internal/domain/config"
+	"github.com/itiquette/gommitlint/internal/domain/rules"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChangeIDRule(t *testing.T) {
+	const changeID = "Change-Id: I8473b95934b5732ac55d26311a706c9c2bde9940"
+
+	tests := []struct {
+		name           string
+		message        string
+		mode           string
+		expectedCode   domain.ValidationErrorCode
+		expectedActual string
+	}{
+		{
+			name:    "valid Change-Id",
+			message: "fix: retry uploads\n\nUploads fail on timeouts.\n\n" + changeID + "\nSigned-off-by: Dev <dev@example.com>",
+		},
+		{
+			name:           "missing",
+			message:        "fix: retry uploads\n\nSigned-off-by: Dev <dev@example.com>",
+			expectedCode:   domain.ErrMissingChangeID,
+			expectedActual: "none",
+		},
+		{
+			name:           "above the trailer block",
+			message:        "fix: retry uploads\n\n" + changeID + "\n\nUploads fail on timeouts.",
+			expectedCode:   domain.ErrMissingChangeID,
+			expectedActual: "Change-Id above the trailer block",
+		},
+		{
+			name:           "invalid",
+			message:        "fix: retry uploads\n\nChange-Id: 8473b959",
+			expectedCode:   domain.ErrInvalidChangeID,
+			expectedActual: "8473b959",
+		},
+		{
+			name:           "uppercase digits",
+			message:        "fix: retry uploads\n\nChange-Id: I8473B95934B5732AC55D26311A706C9C2BDE9940",
+			expectedCode:   domain.ErrInvalidChangeID,
+			expectedActual: "I8473B95934B5732AC55D26311A706C9C2BDE9940",
+		},
+		{
+			name:           "multiple",
+			message:        "fix: retry uploads\n\n" + changeID + "\nChange-Id: I0000000000000000000000000000000000000000",
+			expectedCode:   domain.ErrMultipleChangeIDs,
+			expectedActual: "2",
+		},
+		{
+			name:    "forbidden and absent",
+			message: "fix: retry uploads\n\nSigned-off-by: Dev <dev@example.com>",
+			mode:    "forbid",
+		},
+		{
+			name:           "forbidden",
+			message:        "fix: retry uploads\n\n" + changeID,
+			mode:           "forbid",
+			expectedCode:   domain.ErrForbiddenChangeID,
+			expectedActual: "I8473b95934b5732ac55d26311a706c9c2bde9940",
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			cfg := config.NewDefault()
+			if testCase.mode != "" {
+				cfg.ChangeID.Mode = testCase.mode
+			}
+
+			commit := domain.NewCommit("abc123", testCase.message, "Dev", "dev@example.com", "", "", false)
+			errors := rules.NewChangeIDRule(cfg).Validate(commit, cfg)
+
+			if testCase.expectedCode == "" {
+				require.Empty(t, errors)
+
+				return
+			}
+
+			require.Len(t, errors, 1)
+			require.Equal(t, string(testCase.expectedCode), errors[0].Code)
+			require.Equal(t, testCase.expectedActual, errors[0].Context["actual"])
+		})
+	}
+}
@@ -0,0 +1,196 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package rules
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/itiquette/gommitlint/internal/domain"
+	"github.com/itiquette/gommitlint/internal/domain/config"
+)
+
+// maxCharacterErrors caps the failures reported for one message, so that a message
+// pasted in a foreign script does not bury the report.
+const maxCharacterErrors = 10
+
+// zeroWidthCharacters are invisible characters that can hide text differences.
+var zeroWidthCharacters = map[rune]bool{
+	'\u200B': true, // Zero width space
+	'\u200C': true, // Zero width non-joiner
+	'\u200D': true, // Zero width joiner, allowed inside emoji sequences
+	'\u2060': true, // Word joiner
+	'\u180E': true, // Mongolian vowel separator
+	'\uFEFF': true, // Zero width no-break space (byte order mark)
+}
+
+// bidiCharacters are the directional formatting characters of Trojan Source attacks,
+// which make reviewers see text in a different order than it is stored.
+var bidiCharacters = map[rune]bool{
+	'\u202A': true, '\u202B': true, '\u202C': true, '\u202D': true, '\u202E': true, // Embeddings and overrides
+	'\u2066': true, '\u2067': true, '\u2068': true, '\u2069': true, // Isolates
+	'\u200E': true, '\u200F': true, '\u061C': true, // Directional marks
+}
+
+// CharactersRule forbids control, zero-width and bidirectional formatting characters in
+// commit messages, and optionally restricts them to ASCII or to letters of some scripts.
+type CharactersRule struct {
+	allowControl   bool
+	allowZeroWidth bool
+	allowBidi      bool
+	asciiOnly      bool
+	scripts        []string
+}
+
+// NewCharactersRule creates a new CharactersRule from config.
+func NewCharactersRule(cfg config.Config) CharactersRule {
+	return CharactersRule{
+		allowControl:   cfg.Characters.AllowControl,
+		allowZeroWidth: cfg.Characters.AllowZeroWidth,
+		allowBidi:      cfg.Characters.AllowBidi,
+		asciiOnly:      cfg.Characters.ASCIIOnly,
+		scripts:        cfg.Characters.AllowedScripts,
+	}
+}
+
+// Name returns the rule name.
+func (r CharactersRule) Name() string {
+	return "Characters"
+}
+
+// Metadata returns the documentation of the rule.
+func (r CharactersRule) Metadata() domain.RuleMetadata {
+	return domain.RuleMetadata{
+		ID:       "characters",
+		Name:     r.Name(),
+		Category: domain.CategoryMessage,
+		Severity: domain.SeverityError,
+		Summary:  "Control, invisible and bidirectional characters, optionally non-ASCII",
+		Description: "Checks every character of the subject and body. Control characters other than " +
+			"tab, zero-width characters and the bidirectional formatting characters used in Trojan " +
+			"Source attacks are reported unless characters.allow_control, allow_zero_width or " +
+			"allow_bidi is set. A zero width joiner between emoji is allowed. With " +
+			"characters.ascii_only every non-ASCII character is reported, and with " +
+			"characters.allowed_scripts letters must belong to one of the listed Unicode scripts. " +
+			"Failures name the line and column of the character.",
+		ErrorCodes: []domain.ValidationErrorCode{
+			domain.ErrControlCharacter, domain.ErrZeroWidthCharacter, domain.ErrBidiCharacter,
+			domain.ErrNonASCIICharacter, domain.ErrDisallowedScript,
+		},
+		ConfigKeys: []string{
+			"characters.allow_control", "characters.allow_zero_width", "characters.allow_bidi",
+			"characters.ascii_only", "characters.allowed_scripts",
+		},
+		Examples: []domain.RuleExample{
+			{Message: "fix: handle na\u00EFve dates", Valid: true},
+			{Message: "fix: check access\u202E // admin only", Note: "right-to-left override"},
+			{Message: "fix: trim\u200Bwhitespace", Note: "zero width space"},
+		},
+	}
+}
+
+// Validate checks every character of the commit message.
+func (r CharactersRule) Validate(commit domain.Commit, _ config.Config) []domain.ValidationError {
+	message := commit.FullMessage()
+
+	var errors []domain.ValidationError
+
+	for lineIndex, line := range strings.Split(message, "\n") {
+		runes := []rune(line)
+
+		for column, char := range runes {
+			code, description := r.classify(runes, column)
+			if code == "" {
+				continue
+			}
+
+			if len(errors) == maxCharacterErrors {
+				return errors
+			}
+
+			errors = append(errors, r.characterError(code, description, char, lineIndex+1, column+1))
+		}
+	}
+
+	return errors
+}
+
+// classify returns the error code and description of the character at index, or an
+// empty code when the character is allowed.
+func (r CharactersRule) classify(runes []rune, index int) (domain.ValidationErrorCode, string) {
+	char := runes[index]
+
+	switch {
+	case !r.allowBidi && bidiCharacters[char]:
+		return domain.ErrBidiCharacter, "Bidirectional formatting character"
+	case !r.allowZeroWidth && zeroWidthCharacters[char] && !isEmojiJoiner(runes, index):
+		return domain.ErrZeroWidthCharacter, "Zero-width character"
+	case !r.allowControl && unicode.IsControl(char) && char != '\t':
+		return domain.ErrControlCharacter, "Control character"
+	case r.asciiOnly && char > unicode.MaxASCII:
+		return domain.ErrNonASCIICharacter, "Non-ASCII character"
+	case len(r.scripts) > 0 && unicode.IsLetter(char) && !r.inAllowedScript(char):
+		return domain.ErrDisallowedScript, "Letter outside the allowed scripts"
+	}
+
+	return "", ""
+}
+
+// inAllowedScript reports whether char belongs to one of the allowed scripts.
+func (r CharactersRule) inAllowedScript(char rune) bool {
+	for _, script := range r.scripts {
+		if table, ok := unicode.Scripts[script]; ok && unicode.Is(table, char) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// characterError builds the failure for char at the 1-based line and column.
+func (r CharactersRule) characterError(code domain.ValidationErrorCode, description string, char rune, line, column int) domain.ValidationError {
+	codePoint := fmt.Sprintf("U+%04X", char)
+
+	part := "subject"
+	if line > 1 {
+		part = "body"
+	}
+
+	help := "Remove the character; invisible and directional characters can make reviewers read different text than git stores"
+
+	switch code {
+	case domain.ErrNonASCIICharacter:
+		help = "Write the message in ASCII, or turn off characters.ascii_only"
+	case domain.ErrDisallowedScript:
+		help = "Use letters of " + strings.Join(r.scripts, ", ") + ", or add the script to characters.allowed_scripts"
+	}
+
+	return domain.New(r.Name(), code,
+		fmt.Sprintf("%s %s at line %d, column %d", description, codePoint, line, column)).
+		WithContextMap(map[string]string{
+			"actual": codePoint,
+			"part":   part,
+		}).
+		WithPosition(line, column).
+		WithHelp(help)
+}
+
+// isEmojiJoiner reports whether the character at index is a zero width joiner between
+// two symbols, as in the emoji sequence of a family.
+func isEmojiJoiner(runes []rune, index int) bool {
+	if runes[index] != '\u200D' || index == 0 || index == len(runes)-1 {
+		return false
+	}
+
+	previous, next := runes[index-1], runes[index+1]
+
+	// Skin tones and variation selectors may sit between the emoji and the joiner
+	isEmojiPart := func(char rune) bool {
+		return unicode.Is(unicode.So, char) || unicode.Is(unicode.Sk, char) || unicode.Is(unicode.Variation_Selector, char)
+	}
+
+	return isEmojiPart(previous) && unicode.Is(unicode.So, next)
+}
@@ -0,0 +1,137 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package rules_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/itiquette/gommitlint/internal/domain"
+	"github.com/itiquette/gommitlint/internal/domain/config"
+	"github.com/itiquette/gommitlint/internal/domain/rules"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCharactersRule(t *testing.T) {
+	tests := []struct {
+		name           string
+		message        string
+		configure      func(cfg *config.CharactersConfig)
+		expectedCodes  []domain.ValidationErrorCode
+		expectedActual string
+		expectedLine   string
+		expectedColumn string
+	}{
+		{
+			name:    "plain text with accents and tabs",
+			message: "fix: handle na\u00EFve dates\n\n\tIndented body line.",
+		},
+		{
+			name:           "right-to-left override",
+			message:        "fix: check access\u202E // admin only",
+			expectedCodes:  []domain.ValidationErrorCode{domain.ErrBidiCharacter},
+			expectedActual: "U+202E",
+			expectedLine:   "1",
+			expectedColumn: "18",
+		},
+		{
+			name:           "zero width space in body",
+			message:        "fix: trim input\n\nTrim the \u00FCser\u200Binput.",
+			expectedCodes:  []domain.ValidationErrorCode{domain.ErrZeroWidthCharacter},
+			expectedActual: "U+200B",
+			expectedLine:   "3",
+			expectedColumn: "14",
+		},
+		{
+			name:    "joiner inside emoji sequence",
+			message: "docs: welcome the team \U0001F468\u200D\U0001F469\u200D\U0001F467",
+		},
+		{
+			name:          "joiner between letters",
+			message:       "fix: join\u200Dwords",
+			expectedCodes: []domain.ValidationErrorCode{domain.ErrZeroWidthCharacter},
+		},
+		{
+			name:           "control character",
+			message:        "fix: ring the bell\u0007",
+			expectedCodes:  []domain.ValidationErrorCode{domain.ErrControlCharacter},
+			expectedActual: "U+0007",
+			expectedColumn: "19",
+		},
+		{
+			name:    "characters explicitly allowed",
+			message: "fix: check access\u202E\u200B\u0007",
+			configure: func(cfg *config.CharactersConfig) {
+				cfg.AllowBidi, cfg.AllowZeroWidth, cfg.AllowControl = true, true, true
+			},
+		},
+		{
+			name:           "ascii only",
+			message:        "fix: handle na\u00EFve dates",
+			configure:      func(cfg *config.CharactersConfig) { cfg.ASCIIOnly = true },
+			expectedCodes:  []domain.ValidationErrorCode{domain.ErrNonASCIICharacter},
+			expectedActual: "U+00EF",
+			expectedColumn: "15",
+		},
+		{
+			name:           "letter outside allowed scripts",
+			message:        "fix: handle \u0430ccess \U0001F389",
+			configure:      func(cfg *config.CharactersConfig) { cfg.AllowedScripts = []string{"Latin"} },
+			expectedCodes:  []domain.ValidationErrorCode{domain.ErrDisallowedScript},
+			expectedActual: "U+0430",
+			expectedColumn: "13",
+		},
+		{
+			name:      "letters in allowed scripts",
+			message:   "fix: handle \u0430ccess",
+			configure: func(cfg *config.CharactersConfig) { cfg.AllowedScripts = []string{"Latin", "Cyrillic"} },
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			cfg := config.NewDefault()
+			if testCase.configure != nil {
+				testCase.configure(&cfg.Characters)
+			}
+
+			errors := rules.NewCharactersRule(cfg).Validate(domain.ParseCommitMessage(testCase.message), cfg)
+
+			codes := make([]domain.ValidationErrorCode, 0, len(errors))
+			for _, err := range errors {
+				codes = append(codes, domain.ValidationErrorCode(err.Code))
+			}
+
+			if len(testCase.expectedCodes) == 0 {
+				require.Empty(t, codes)
+
+				return
+			}
+
+			require.Equal(t, testCase.expectedCodes, codes)
+
+			if testCase.expectedActual != "" {
+				require.Equal(t, testCase.expectedActual, errors[0].Context["actual"])
+			}
+
+			if testCase.expectedLine != "" {
+				require.Equal(t, testCase.expectedLine, errors[0].Context["line"])
+			}
+
+			if testCase.expectedColumn != "" {
+				require.Equal(t, testCase.expectedColumn, errors[0].Context["column"])
+			}
+		})
+	}
+}
+
+func TestCharactersRule_LimitsReportedCharacters(t *testing.T) {
+	cfg := config.NewDefault()
+	message := "fix: trim" + strings.Repeat("\u200B", 25)
+
+	errors := rules.NewCharactersRule(cfg).Validate(domain.ParseCommitMessage(message), cfg)
+	require.Len(t, errors, 10)
+	require.Equal(t, "19", errors[9].Context["column"])
+}
@@ -0,0 +1,162 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package rules
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/itiquette/gommitlint/internal/domain"
+	"github.com/itiquette/gommitlint/internal/domain/config"
+)
+
+// CLARegistry answers whether contributors have signed the contributor license agreement.
+type CLARegistry interface {
+	HasSigned(identity domain.Identity) (bool, error)
+}
+
+// CLARule checks that the contributors signing off a commit have signed the contributor
+// license agreement.
+type CLARule struct {
+	registry         CLARegistry
+	contributorsFile string
+	signURL          string
+	offline          string
+}
+
+// NewCLARule creates a new CLARule looking up contributors in registry, which is nil
+// when neither a contributors file nor a CLA service is configured.
+func NewCLARule(registry CLARegistry, cfg config.Config) CLARule {
+	return CLARule{
+		registry:         registry,
+		contributorsFile: cfg.CLA.ContributorsFile,
+		signURL:          cfg.CLA.SignURL,
+		offline:          cfg.CLA.Offline,
+	}
+}
+
+// Name returns the rule name.
+func (r CLARule) Name() string {
+	return "CLA"
+}
+
+// Metadata returns the documentation of the rule.
+func (r CLARule) Metadata() domain.RuleMetadata {
+	return domain.RuleMetadata{
+		ID:       "cla",
+		Name:     r.Name(),
+		Category: domain.CategorySignature,
+		Severity: domain.SeverityError,
+		Summary:  "Signed-off-by identities have signed the CLA",
+		Description: "Looks up every Signed-off-by identity, or the author of commits without " +
+			"sign-off, after applying the mailmap. Contributors listed in cla.contributors_file " +
+			"have signed; others are asked about at the CLA service of cla.url. When the list " +
+			"cannot be read or the service cannot be reached, cla.offline decides whether the " +
+			"lookup is reported as a warning (warn), an error (fail) or not at all (allow).",
+		ErrorCodes: []domain.ValidationErrorCode{domain.ErrCLANotSigned, domain.ErrCLALookupFailed},
+		ConfigKeys: []string{
+			"cla.contributors_file", "cla.url", "cla.sign_url", "cla.offline", "cla.timeout", "cla.cache_ttl",
+		},
+		Examples: []domain.RuleExample{
+			{Message: "fix: retry uploads\n\nSigned-off-by: Jane Doe <jane@example.com>", Valid: true, Note: "when jane@example.com has signed"},
+			{Message: "fix: retry uploads\n\nSigned-off-by: John Doe <john@example.com>", Note: "when john@example.com has not signed"},
+		},
+	}
+}
+
+// Validate checks that the identities signing off the commit have signed.
+func (r CLARule) Validate(commit domain.Commit, _ config.Config) []domain.ValidationError {
+	identities := r.contributors(commit)
+	if len(identities) == 0 {
+		return nil
+	}
+
+	if r.registry == nil {
+		return []domain.ValidationError{
+			domain.New(r.Name(), domain.ErrCLALookupFailed,
+				fmt.Sprintf("Could not check the CLA of %s: no contributors file or CLA service configured", identities[0])).
+				WithContextMap(map[string]string{"actual": identities[0].String()}).
+				WithSeverity(domain.SeverityWarning).
+				WithHelp("Set cla.contributors_file or cla.url"),
+		}
+	}
+
+	var errors []domain.ValidationError
+
+	for _, identity := range identities {
+		signed, err := r.registry.HasSigned(identity)
+		if err != nil {
+			if r.offline == "allow" {
+				return errors
+			}
+
+			lookupErr := domain.New(r.Name(), domain.ErrCLALookupFailed,
+				fmt.Sprintf("Could not check the CLA of %s: %v", identity, err)).
+				WithContextMap(map[string]string{"actual": identity.String()}).
+				WithHelp("Check cla.contributors_file, cla.url, CLA_API_TOKEN and the network; " +
+					"cla.offline sets how failed lookups are reported")
+
+			if r.offline != "fail" {
+				lookupErr = lookupErr.WithSeverity(domain.SeverityWarning)
+			}
+
+			return append(errors, lookupErr)
+		}
+
+		if !signed {
+			errors = append(errors,
+				domain.New(r.Name(), domain.ErrCLANotSigned,
+					fmt.Sprintf("%s has not signed the contributor license agreement", identity)).
+					WithContextMap(map[string]string{"actual": identity.String()}).
+					WithHelp(r.signHelp()))
+		}
+	}
+
+	return errors
+}
+
+// contributors returns the distinct identities signing off the commit, or its author
+// when nobody signed off, as the mailmap resolves them.
+func (r CLARule) contributors(commit domain.Commit) []domain.Identity {
+	candidates := []domain.Identity{}
+
+	for _, value := range domain.TrailerValues(commit.MessageTrailers(), "Signed-off-by") {
+		candidates = append(candidates, domain.NewIdentityFromString(value))
+	}
+
+	if len(candidates) == 0 {
+		candidates = append(candidates, domain.NewIdentity(commit.Author, commit.AuthorEmail))
+	}
+
+	var identities []domain.Identity
+
+	seen := make(map[string]bool)
+
+	for _, candidate := range candidates {
+		identity := commit.Mailmap.Resolve(candidate)
+
+		email := strings.ToLower(identity.Email())
+		if email == "" || seen[email] {
+			continue
+		}
+
+		seen[email] = true
+		identities = append(identities, identity)
+	}
+
+	return identities
+}
+
+// signHelp tells a contributor who has not signed how to sign.
+func (r CLARule) signHelp() string {
+	switch {
+	case r.signURL != "":
+		return "Sign the contributor license agreement at " + r.signURL + ", or sign off with an email that has signed"
+	case r.contributorsFile != "":
+		return "Sign the contributor license agreement and ask a maintainer to add you to " + r.contributorsFile
+	default:
+		return "Sign the contributor license agreement, or sign off with an email that has signed"
+	}
+}
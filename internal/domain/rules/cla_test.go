@@ -0,0 +1,159 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package rules_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/itiquette/gommitlint/internal/domain"
+	"github.com/itiquette/gommitlint/internal/domain/config"
+	"github.com/itiquette/gommitlint/internal/domain/rules"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeCLARegistry answers from a set of signed emails and records the lookups.
+type fakeCLARegistry struct {
+	signed  map[string]bool
+	err     error
+	lookups []string
+}
+
+func (f *fakeCLARegistry) HasSigned(identity domain.Identity) (bool, error) {
+	f.lookups = append(f.lookups, identity.Email())
+
+	return f.signed[identity.Email()], f.err
+}
+
+func TestCLARule(t *testing.T) {
+	registry := &fakeCLARegistry{signed: map[string]bool{"jane@example.com": true, "ann@example.com": true}}
+
+	tests := []struct {
+		name           string
+		commit         domain.Commit
+		expectedCodes  []domain.ValidationErrorCode
+		expectedLookup []string
+	}{
+		{
+			name:           "signed sign-off",
+			commit:         domain.Commit{Body: "Signed-off-by: Jane Doe <jane@example.com>", AuthorEmail: "john@example.com"},
+			expectedLookup: []string{"jane@example.com"},
+		},
+		{
+			name:           "every sign-off is checked once",
+			commit:         domain.Commit{Body: "Signed-off-by: Jane Doe <jane@example.com>\nSigned-off-by: John Doe <john@example.com>\nSigned-off-by: Jane Doe <JANE@example.com>"},
+			expectedCodes:  []domain.ValidationErrorCode{domain.ErrCLANotSigned},
+			expectedLookup: []string{"jane@example.com", "john@example.com"},
+		},
+		{
+			name:           "author without sign-off",
+			commit:         domain.Commit{Author: "John Doe", AuthorEmail: "john@example.com"},
+			expectedCodes:  []domain.ValidationErrorCode{domain.ErrCLANotSigned},
+			expectedLookup: []string{"john@example.com"},
+		},
+		{
+			name: "mailmap resolves the sign-off",
+			commit: domain.Commit{
+				Body:    "Signed-off-by: Ann <ann@old.example.com>",
+				Mailmap: domain.ParseMailmap("Ann <ann@example.com> <ann@old.example.com>\n"),
+			},
+			expectedLookup: []string{"ann@example.com"},
+		},
+		{
+			name:   "no identity",
+			commit: domain.Commit{Subject: "fix: retry uploads"},
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			registry.lookups = nil
+
+			cfg := config.NewDefault()
+
+			failures := rules.NewCLARule(registry, cfg).Validate(testCase.commit, cfg)
+
+			codes := make([]domain.ValidationErrorCode, 0, len(failures))
+			for _, err := range failures {
+				codes = append(codes, domain.ValidationErrorCode(err.Code))
+			}
+
+			require.ElementsMatch(t, testCase.expectedCodes, codes)
+			require.Equal(t, testCase.expectedLookup, registry.lookups)
+		})
+	}
+}
+
+func TestCLARule_SignHelp(t *testing.T) {
+	cfg := config.NewDefault()
+	cfg.CLA.SignURL = "https://cla.example.com/sign"
+
+	failures := rules.NewCLARule(&fakeCLARegistry{}, cfg).Validate(domain.Commit{AuthorEmail: "john@example.com"}, cfg)
+	require.Len(t, failures, 1)
+	require.Contains(t, failures[0].Help, "https://cla.example.com/sign")
+	require.True(t, failures[0].IsBlocking())
+}
+
+func TestCLARule_Offline(t *testing.T) {
+	commit := domain.Commit{Body: "Signed-off-by: Jane Doe <jane@example.com>"}
+	registry := &fakeCLARegistry{err: errors.New("connection refused")}
+
+	tests := []struct {
+		offline  string
+		failures int
+		blocking bool
+	}{
+		{offline: "warn", failures: 1},
+		{offline: "fail", failures: 1, blocking: true},
+		{offline: "allow"},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.offline, func(t *testing.T) {
+			cfg := config.NewDefault()
+			cfg.CLA.Offline = testCase.offline
+
+			failures := rules.NewCLARule(registry, cfg).Validate(commit, cfg)
+			require.Len(t, failures, testCase.failures)
+
+			if testCase.failures > 0 {
+				require.Equal(t, string(domain.ErrCLALookupFailed), failures[0].Code)
+				require.Equal(t, testCase.blocking, failures[0].IsBlocking())
+			}
+		})
+	}
+
+	// Without a contributors file or service there is nothing to look the identities up in
+	cfg := config.NewDefault()
+
+	failures := rules.NewCLARule(nil, cfg).Validate(commit, cfg)
+	require.Len(t, failures, 1)
+	require.Contains(t, failures[0].Message, "no contributors file or CLA service configured")
+	require.False(t, failures[0].IsBlocking())
+}
+
+func TestCLARule_InjectedRegistry(t *testing.T) {
+	registry := &fakeCLARegistry{}
+	cfg := config.NewDefault()
+	cfg.Rules.Enabled = append(cfg.Rules.Enabled, "cla")
+	cfg.CLA.ContributorsFile = "CONTRIBUTORS"
+
+	var claConfig config.CLAConfig
+
+	services := rules.Services{CLARegistry: func(cfg config.CLAConfig) rules.CLARegistry {
+		claConfig = cfg
+
+		return registry
+	}}
+
+	created := domain.SelectCommitRules(rules.CreateCommitRules(cfg, services), []string{"cla"}, nil)
+	require.Len(t, created, 1)
+	require.Equal(t, "CONTRIBUTORS", claConfig.ContributorsFile, "the registry is created for the CLA configuration")
+
+	failures := created[0].Validate(domain.Commit{AuthorEmail: "john@example.com"}, cfg)
+	require.Len(t, failures, 1)
+	require.Equal(t, string(domain.ErrCLANotSigned), failures[0].Code)
+	require.NotEmpty(t, registry.lookups)
+}
@@ -7,6 +7,7 @@ package rules
 import (
 	"fmt"
 	"regexp"
+	"slices"
 	"strconv"
 	"strings"
 
@@ -16,17 +17,25 @@ import (
 
 // CommitBodyRule validates commit message bodies.
 type CommitBodyRule struct {
-	required         bool
-	minLength        int
-	allowSignOffOnly bool
+	required              bool
+	minLength             int
+	allowSignOffOnly      bool
+	minWords              int
+	minParagraphs         int
+	minParagraphsTypes    []string
+	forbidRepeatedSubject bool
 }
 
 // NewCommitBodyRule creates a new CommitBodyRule from config.
 func NewCommitBodyRule(cfg config.Config) CommitBodyRule {
 	return CommitBodyRule{
-		required:         cfg.Message.Body.Required,
-		minLength:        cfg.Message.Body.MinLength,
-		allowSignOffOnly: cfg.Message.Body.AllowSignoffOnly,
+		required:              cfg.Message.Body.Required,
+		minLength:             cfg.Message.Body.MinLength,
+		allowSignOffOnly:      cfg.Message.Body.AllowSignoffOnly,
+		minWords:              cfg.Message.Body.MinWords,
+		minParagraphs:         cfg.Message.Body.MinParagraphs,
+		minParagraphsTypes:    cfg.Message.Body.MinParagraphsTypes,
+		forbidRepeatedSubject: cfg.Message.Body.ForbidRepeatedSubject,
 	}
 }
 
@@ -35,6 +44,38 @@ func (r CommitBodyRule) Name() string {
 	return "CommitBody"
 }
 
+// Metadata returns the documentation of the rule.
+func (r CommitBodyRule) Metadata() domain.RuleMetadata {
+	return domain.RuleMetadata{
+		ID:       "commitbody",
+		Name:     r.Name(),
+		Category: domain.CategoryMessage,
+		Severity: domain.SeverityError,
+		Summary:  "Commit body presence, length and structure",
+		Description: "Requires a blank line between the subject and the body, and sign-off lines to " +
+			"come last, followed by trailers only. With message.body.required the body must be " +
+			"present, at least message.body.min_length characters long, and more than sign-off " +
+			"lines unless message.body.allow_signoff_only is set. A body can also be required to " +
+			"have message.body.min_words words and, for the types in message.body.min_paragraphs_types, " +
+			"message.body.min_paragraphs paragraphs, and with message.body.forbid_repeated_subject " +
+			"no line may repeat the subject.",
+		ErrorCodes: []domain.ValidationErrorCode{
+			domain.ErrInvalidStructure, domain.ErrMissingBlankLine, domain.ErrMissingBody,
+			domain.ErrBodyTooShort, domain.ErrMisplacedSignoff, domain.ErrInvalidBody,
+			domain.ErrBodyTooFewWords, domain.ErrTooFewParagraphs, domain.ErrRepeatedSubject,
+		},
+		ConfigKeys: []string{
+			"message.body.required", "message.body.min_length", "message.body.allow_signoff_only",
+			"message.body.min_words", "message.body.min_paragraphs", "message.body.min_paragraphs_types",
+			"message.body.forbid_repeated_subject",
+		},
+		Examples: []domain.RuleExample{
+			{Message: "fix: handle empty input\n\nThe parser crashed on empty files.", Valid: true},
+			{Message: "fix: handle empty input\nThe parser crashed on empty files.", Note: "no blank line after the subject"},
+		},
+	}
+}
+
 // Validate checks if a commit's body meets the required criteria.
 func (r CommitBodyRule) Validate(commit domain.Commit, _ config.Config) []domain.ValidationError {
 	// Skip merge commits
@@ -50,6 +91,8 @@ func (r CommitBodyRule) Validate(commit domain.Commit, _ config.Config) []domain
 	errors = append(errors, r.validateStructure(commit)...)
 	errors = append(errors, r.validateLength(trimmedBody)...)
 	errors = append(errors, r.validateSignOffRules(trimmedBody)...)
+	errors = append(errors, r.validateParagraphs(commit)...)
+	errors = append(errors, r.validateRepeatedSubject(commit)...)
 
 	return errors
 }
@@ -118,7 +161,106 @@ func (r CommitBodyRule) validateLength(trimmedBody string) []domain.ValidationEr
 	return nil
 }
 
-// validateLines is no longer used - removed minLines configuration.
+// validateParagraphs validates the word and paragraph counts of the description in the body.
+func (r CommitBodyRule) validateParagraphs(commit domain.Commit) []domain.ValidationError {
+	paragraphs := descriptionParagraphs(commit.Body)
+
+	var errors []domain.ValidationError
+
+	if words := len(strings.Fields(strings.Join(paragraphs, " "))); r.minWords > 0 && len(paragraphs) > 0 && words < r.minWords {
+		errors = append(errors, domain.New(r.Name(), domain.ErrBodyTooFewWords,
+			fmt.Sprintf("Too few words (%d/%d)", words, r.minWords)).
+			WithContextMap(map[string]string{
+				"actual":   strconv.Itoa(words),
+				"expected": fmt.Sprintf("min %d", r.minWords),
+			}).
+			WithHelp("Explain why the change is needed and what it affects"))
+	}
+
+	if r.minParagraphs > 0 && len(paragraphs) < r.minParagraphs && r.requiresParagraphs(commit.Subject) {
+		errors = append(errors, domain.New(r.Name(), domain.ErrTooFewParagraphs,
+			fmt.Sprintf("Too few paragraphs (%d/%d)", len(paragraphs), r.minParagraphs)).
+			WithContextMap(map[string]string{
+				"actual":   strconv.Itoa(len(paragraphs)),
+				"expected": fmt.Sprintf("min %d", r.minParagraphs),
+			}).
+			WithHelp(fmt.Sprintf("Describe the change in at least %d paragraphs separated by blank lines", r.minParagraphs)))
+	}
+
+	return errors
+}
+
+// requiresParagraphs reports whether min_paragraphs applies to a commit with subject.
+func (r CommitBodyRule) requiresParagraphs(subject string) bool {
+	if len(r.minParagraphsTypes) == 0 {
+		return true
+	}
+
+	commitType := domain.ParseConventionalCommit(subject).Type
+
+	for _, requiredType := range r.minParagraphsTypes {
+		if commitType != "" && strings.EqualFold(requiredType, commitType) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// validateRepeatedSubject rejects body lines that say nothing but the subject again.
+func (r CommitBodyRule) validateRepeatedSubject(commit domain.Commit) []domain.ValidationError {
+	if !r.forbidRepeatedSubject {
+		return nil
+	}
+
+	subjects := []string{normalizeSubjectLine(commit.Subject)}
+	if description := domain.ExtractDescriptionFromConventional(commit.Subject); description != "" {
+		subjects = append(subjects, normalizeSubjectLine(description))
+	}
+
+	lines := strings.Split(strings.ReplaceAll(commit.Message, "\r\n", "\n"), "\n")
+
+	for index := 1; index < len(lines); index++ {
+		normalized := normalizeSubjectLine(lines[index])
+		if normalized == "" || !slices.Contains(subjects, normalized) {
+			continue
+		}
+
+		return []domain.ValidationError{
+			domain.New(r.Name(), domain.ErrRepeatedSubject, "Body repeats the subject").
+				WithContextMap(map[string]string{"actual": strings.TrimSpace(lines[index])}).
+				WithPosition(index+1, 1).
+				WithHelp("Use the body to explain what the subject does not: why and how"),
+		}
+	}
+
+	return nil
+}
+
+// normalizeSubjectLine returns line in lower case without surrounding whitespace and
+// ending punctuation, for comparing body lines with the subject.
+func normalizeSubjectLine(line string) string {
+	return strings.ToLower(strings.TrimRight(strings.TrimSpace(line), ".!?"))
+}
+
+// descriptionParagraphs returns the paragraphs of body, without the trailer block.
+func descriptionParagraphs(body string) []string {
+	body = strings.ReplaceAll(body, "\r\n", "\n")
+
+	var paragraphs []string
+
+	for _, paragraph := range regexp.MustCompile(`\n[ \t]*\n`).Split(strings.TrimSpace(body), -1) {
+		if paragraph = strings.TrimSpace(paragraph); paragraph != "" {
+			paragraphs = append(paragraphs, paragraph)
+		}
+	}
+
+	if len(paragraphs) > 0 && len(domain.ParseTrailers(body)) > 0 {
+		paragraphs = paragraphs[:len(paragraphs)-1]
+	}
+
+	return paragraphs
+}
 
 // validateSignOffRules validates sign-off positioning and content rules.
 func (r CommitBodyRule) validateSignOffRules(trimmedBody string) []domain.ValidationError {
@@ -508,3 +508,86 @@ Additional content after sign-off`
 		require.True(t, foundStructureError, "should detect invalid structure (missing blank line)")
 	})
 }
+
+func TestCommitBodyRule_ParagraphStructure(t *testing.T) {
+	twoParagraphs := "feat: add login\n\nUsers could not sign in with SSO.\n\nAdd an OIDC flow behind a flag.\n\nSigned-off-by: Dev <dev@example.com>"
+
+	tests := []struct {
+		name          string
+		body          config.BodyConfig
+		message       string
+		expectedCodes []string
+		expectedLine  string
+	}{
+		{
+			name:    "enough words and paragraphs",
+			body:    config.BodyConfig{MinWords: 10, MinParagraphs: 2},
+			message: twoParagraphs,
+		},
+		{
+			name:          "trailers do not count as words",
+			body:          config.BodyConfig{MinWords: 10},
+			message:       "fix: handle nil\n\nGuard the parser.\n\nSigned-off-by: Dev <dev@example.com>\nReviewed-by: Other Dev <other@example.com>",
+			expectedCodes: []string{"body_too_few_words"},
+		},
+		{
+			name:    "no body is not checked for words",
+			body:    config.BodyConfig{MinWords: 10},
+			message: "fix: handle nil",
+		},
+		{
+			name:          "trailers do not count as a paragraph",
+			body:          config.BodyConfig{MinParagraphs: 3},
+			message:       twoParagraphs,
+			expectedCodes: []string{"too_few_paragraphs"},
+		},
+		{
+			name:          "paragraphs required for listed type",
+			body:          config.BodyConfig{MinParagraphs: 1, MinParagraphsTypes: []string{"feat", "fix"}},
+			message:       "fix: handle nil",
+			expectedCodes: []string{"too_few_paragraphs"},
+		},
+		{
+			name:    "paragraphs not required for other types",
+			body:    config.BodyConfig{MinParagraphs: 1, MinParagraphsTypes: []string{"feat", "fix"}},
+			message: "docs: fix typo",
+		},
+		{
+			name:          "body repeats the subject",
+			body:          config.BodyConfig{ForbidRepeatedSubject: true},
+			message:       "fix: handle nil input\n\nHandle nil input.",
+			expectedCodes: []string{"repeated_subject"},
+			expectedLine:  "3",
+		},
+		{
+			name:    "body mentions the subject",
+			body:    config.BodyConfig{ForbidRepeatedSubject: true},
+			message: "fix: handle nil input\n\nHandle nil input from the cache, which returns nil on a miss.",
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			rule := NewCommitBodyRule(config.Config{Message: config.MessageConfig{Body: testCase.body}})
+
+			errors := rule.Validate(domain.ParseCommitMessage(testCase.message), config.Config{})
+
+			codes := make([]string, 0, len(errors))
+			for _, err := range errors {
+				codes = append(codes, err.Code)
+			}
+
+			if len(testCase.expectedCodes) == 0 {
+				require.Empty(t, codes)
+
+				return
+			}
+
+			require.Equal(t, testCase.expectedCodes, codes)
+
+			if testCase.expectedLine != "" {
+				require.Equal(t, testCase.expectedLine, errors[0].Context["line"])
+			}
+		})
+	}
+}
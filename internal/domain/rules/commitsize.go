@@ -0,0 +1,107 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package rules
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/itiquette/gommitlint/internal/domain"
+	"github.com/itiquette/gommitlint/internal/domain/config"
+)
+
+// CommitSizeRule limits the lines and files a commit changes, measured by its diffstat,
+// so that changes stay small enough to review.
+type CommitSizeRule struct {
+	maxLines int
+	maxFiles int
+}
+
+// NewCommitSizeRule creates a new CommitSizeRule from config.
+func NewCommitSizeRule(cfg config.Config) CommitSizeRule {
+	return CommitSizeRule{
+		maxLines: cfg.CommitSize.MaxLines,
+		maxFiles: cfg.CommitSize.MaxFiles,
+	}
+}
+
+// Name returns the rule name.
+func (r CommitSizeRule) Name() string {
+	return "CommitSize"
+}
+
+// Metadata returns the documentation of the rule.
+func (r CommitSizeRule) Metadata() domain.RuleMetadata {
+	return domain.RuleMetadata{
+		ID:       "commitsize",
+		Name:     r.Name(),
+		Category: domain.CategoryHistory,
+		Severity: domain.SeverityError,
+		Summary:  "Commits change a limited number of lines and files",
+		Description: "Measures each commit as git diff --stat does against its first parent, or " +
+			"by the diff of a patch validated with --patch, and rejects commits inserting and " +
+			"deleting more than commit_size.max_lines lines together or changing more than " +
+			"commit_size.max_files files. Binary files count as files without lines. Merge " +
+			"commits and message files are not measured.",
+		ErrorCodes: []domain.ValidationErrorCode{domain.ErrTooManyChangedLines, domain.ErrTooManyChangedFiles},
+		ConfigKeys: []string{"commit_size.max_lines", "commit_size.max_files"},
+	}
+}
+
+// Validate checks the diffstat of a commit against the limits.
+func (r CommitSizeRule) Validate(commit domain.Commit, repo domain.Repository, _ config.Config) []domain.ValidationError {
+	// Message files and the repository-level pass have no changes to measure
+	if commit.Hash == "" || commit.IsMergeCommit || (r.maxLines <= 0 && r.maxFiles <= 0) {
+		return nil
+	}
+
+	resolver, ok := repo.(domain.DiffStatResolver)
+	if !ok {
+		return nil
+	}
+
+	stat, err := resolver.GetDiffStat(context.Background(), commit.Hash)
+	if err != nil {
+		return []domain.ValidationError{
+			domain.New(r.Name(), domain.ErrGitOperationFailed,
+				"Failed to measure the changes of commit "+domain.ShortHash(commit.Hash)).
+				WithContextMap(map[string]string{
+					"actual":   err.Error(),
+					"expected": "readable diff",
+				}).
+				WithSeverity(domain.SeverityWarning),
+		}
+	}
+
+	var errors []domain.ValidationError
+
+	if r.maxLines > 0 && stat.Lines() > r.maxLines {
+		errors = append(errors,
+			domain.New(r.Name(), domain.ErrTooManyChangedLines,
+				fmt.Sprintf("Commit changes %d lines (%d insertions, %d deletions), more than the maximum of %d",
+					stat.Lines(), stat.Insertions, stat.Deletions, r.maxLines)).
+				WithContextMap(map[string]string{
+					"actual":     strconv.Itoa(stat.Lines()),
+					"expected":   strconv.Itoa(r.maxLines),
+					"insertions": strconv.Itoa(stat.Insertions),
+					"deletions":  strconv.Itoa(stat.Deletions),
+				}).
+				WithHelp("Split the commit into smaller commits that can be reviewed one at a time"))
+	}
+
+	if r.maxFiles > 0 && stat.Files > r.maxFiles {
+		errors = append(errors,
+			domain.New(r.Name(), domain.ErrTooManyChangedFiles,
+				fmt.Sprintf("Commit changes %d files, more than the maximum of %d", stat.Files, r.maxFiles)).
+				WithContextMap(map[string]string{
+					"actual":   strconv.Itoa(stat.Files),
+					"expected": strconv.Itoa(r.maxFiles),
+				}).
+				WithHelp("Split the commit by the parts of the code it changes"))
+	}
+
+	return errors
+}
@@ -0,0 +1,110 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package rules_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/itiquette/gommitlint/internal/domain"
+	"github.com/itiquette/gommitlint/internal/domain/config"
+	"github.com/itiquette/gommitlint/internal/domain/rules"
+	"github.com/stretchr/testify/require"
+)
+
+// diffStatRepository is a repository stub that reports a fixed diffstat for every commit.
+type diffStatRepository struct {
+	domain.Repository
+
+	stat domain.DiffStat
+	err  error
+}
+
+func (r diffStatRepository) GetDiffStat(_ context.Context, _ string) (domain.DiffStat, error) {
+	return r.stat, r.err
+}
+
+func TestCommitSizeRule(t *testing.T) {
+	commit := domain.Commit{Hash: "1a2b3c4d5e6f", Subject: "feat: add login page"}
+
+	tests := []struct {
+		name          string
+		maxLines      int
+		maxFiles      int
+		commit        domain.Commit
+		repo          domain.Repository
+		expectedCodes []domain.ValidationErrorCode
+	}{
+		{
+			name:     "within limits",
+			maxLines: 500,
+			maxFiles: 10,
+			commit:   commit,
+			repo:     diffStatRepository{stat: domain.DiffStat{Files: 3, Insertions: 250, Deletions: 250}},
+		},
+		{
+			name:          "too many lines",
+			maxLines:      500,
+			commit:        commit,
+			repo:          diffStatRepository{stat: domain.DiffStat{Files: 3, Insertions: 400, Deletions: 101}},
+			expectedCodes: []domain.ValidationErrorCode{domain.ErrTooManyChangedLines},
+		},
+		{
+			name:          "too many files and lines",
+			maxLines:      500,
+			maxFiles:      10,
+			commit:        commit,
+			repo:          diffStatRepository{stat: domain.DiffStat{Files: 11, Insertions: 600}},
+			expectedCodes: []domain.ValidationErrorCode{domain.ErrTooManyChangedLines, domain.ErrTooManyChangedFiles},
+		},
+		{
+			name:     "files not limited by default",
+			maxLines: 500,
+			commit:   commit,
+			repo:     diffStatRepository{stat: domain.DiffStat{Files: 200, Insertions: 200}},
+		},
+		{
+			name:     "merge commit",
+			maxLines: 500,
+			commit:   domain.Commit{Hash: "abcdefabcdef", IsMergeCommit: true},
+			repo:     diffStatRepository{stat: domain.DiffStat{Files: 20, Insertions: 5000}},
+		},
+		{
+			name:     "message file",
+			maxLines: 500,
+			commit:   domain.Commit{Subject: "feat: add login page"},
+			repo:     diffStatRepository{stat: domain.DiffStat{Files: 20, Insertions: 5000}},
+		},
+		{
+			name:     "repository without diffstats",
+			maxLines: 500,
+			commit:   commit,
+			repo:     branchRepository{},
+		},
+		{
+			name:          "diff not readable",
+			maxLines:      500,
+			commit:        commit,
+			repo:          diffStatRepository{err: errors.New("object not found")},
+			expectedCodes: []domain.ValidationErrorCode{domain.ErrGitOperationFailed},
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			cfg := config.Config{CommitSize: config.CommitSizeConfig{MaxLines: testCase.maxLines, MaxFiles: testCase.maxFiles}}
+
+			errors := rules.NewCommitSizeRule(cfg).Validate(testCase.commit, testCase.repo, cfg)
+
+			codes := make([]domain.ValidationErrorCode, 0, len(errors))
+			for _, err := range errors {
+				codes = append(codes, domain.ValidationErrorCode(err.Code))
+			}
+
+			require.ElementsMatch(t, testCase.expectedCodes, codes)
+		})
+	}
+}
@@ -6,9 +6,12 @@ package rules
 import (
 	"errors"
 	"fmt"
+	"regexp"
 	"slices"
 	"strconv"
 	"strings"
+	"unicode"
+	"unicode/utf8"
 
 	"github.com/itiquette/gommitlint/internal/domain"
 	"github.com/itiquette/gommitlint/internal/domain/config"
@@ -46,6 +49,19 @@ type ConventionalCommitRule struct {
 	validateBreaking bool
 	maxDescLength    int
 	allowMultiScope  bool // Enable multi-scope support
+	scopeCase        string
+	maxScopeLength   int
+	scopeCharacters  string
+	scopeCharacter   *regexp.Regexp // Matches one allowed scope character, nil allows all
+	maxScopes        int
+	typeAliases      map[string]string // Alias to the allowed type it stands for
+	aliasMode        string            // accept, warn or reject
+}
+
+// scopeCasePatterns are the scope cases of conventional.scope_case other than lower.
+var scopeCasePatterns = map[string]*regexp.Regexp{
+	"kebab": regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)*$`),
+	"camel": regexp.MustCompile(`^[a-z][a-zA-Z0-9]*$`),
 }
 
 // NewConventionalCommitRule creates a new rule for validating conventional commits from config.
@@ -62,6 +78,12 @@ func NewConventionalCommitRule(cfg config.Config) ConventionalCommitRule {
 		maxDescLength = 72
 	}
 
+	// An invalid character set, which configuration validation reports, allows all characters
+	var scopeCharacter *regexp.Regexp
+	if cfg.Conventional.ScopeCharacters != "" {
+		scopeCharacter, _ = regexp.Compile("^[" + cfg.Conventional.ScopeCharacters + "]$")
+	}
+
 	return ConventionalCommitRule{
 		allowedTypes:     allowedTypes,
 		allowedScopes:    cfg.Conventional.Scopes,
@@ -69,6 +91,13 @@ func NewConventionalCommitRule(cfg config.Config) ConventionalCommitRule {
 		validateBreaking: cfg.Conventional.AllowBreaking,
 		maxDescLength:    maxDescLength,
 		allowMultiScope:  true, // Enable multi-scope support by default
+		scopeCase:        cfg.Conventional.ScopeCase,
+		maxScopeLength:   cfg.Conventional.MaxScopeLength,
+		scopeCharacters:  cfg.Conventional.ScopeCharacters,
+		scopeCharacter:   scopeCharacter,
+		maxScopes:        cfg.Conventional.MaxScopes,
+		typeAliases:      cfg.Conventional.TypeAliases,
+		aliasMode:        cfg.Conventional.AliasMode,
 	}
 }
 
@@ -77,6 +106,44 @@ func (r ConventionalCommitRule) Name() string {
 	return "ConventionalCommit"
 }
 
+// Metadata returns the documentation of the rule.
+func (r ConventionalCommitRule) Metadata() domain.RuleMetadata {
+	return domain.RuleMetadata{
+		ID:       "conventional",
+		Name:     r.Name(),
+		Category: domain.CategoryMessage,
+		Severity: domain.SeverityError,
+		Summary:  "Conventional Commits format",
+		Description: "Requires subjects of the form 'type(scope): description'. The type must be " +
+			"listed in conventional.types and, when conventional.scopes is set, every scope in it. " +
+			"conventional.require_scope makes the scope mandatory, conventional.allow_breaking " +
+			"permits the '!' breaking change marker, and the description may not be longer than " +
+			"conventional.max_description_length characters. Scopes can be held to a case, a " +
+			"length, a set of characters and, in multi-scope commits, a number of scopes. Aliases " +
+			"of types in conventional.type_aliases are accepted, warned about or rejected " +
+			"depending on conventional.alias_mode.",
+		ErrorCodes: []domain.ValidationErrorCode{
+			domain.ErrInvalidConventionalFormat, domain.ErrInvalidConventionalType,
+			domain.ErrInvalidConventionalScope, domain.ErrMissingConventionalScope, domain.ErrInvalidMultiScope,
+			domain.ErrInvalidSpacing, domain.ErrEmptyConventionalDesc, domain.ErrConventionalDescTooLong,
+			domain.ErrConventionalScopeCase, domain.ErrConventionalScopeTooLong, domain.ErrConventionalScopeChars,
+			domain.ErrTooManyConventionalScopes, domain.ErrConventionalTypeAlias,
+		},
+		ConfigKeys: []string{
+			"conventional.types", "conventional.scopes", "conventional.require_scope",
+			"conventional.allow_breaking", "conventional.max_description_length",
+			"conventional.scope_case", "conventional.max_scope_length", "conventional.scope_characters",
+			"conventional.max_scopes", "conventional.type_aliases", "conventional.alias_mode",
+		},
+		Examples: []domain.RuleExample{
+			{Message: "fix(parser): handle empty input", Valid: true},
+			{Message: "feat!: drop support for old configuration files", Valid: true, Note: "'!' marks a breaking change"},
+			{Message: "feature: add login page", Note: "'feature' is not an allowed type"},
+			{Message: "add login page", Note: "no type"},
+		},
+	}
+}
+
 // Validate validates a commit against the conventional commit rules.
 func (r ConventionalCommitRule) Validate(commit domain.Commit, _ config.Config) []domain.ValidationError {
 	var failures []domain.ValidationError
@@ -104,16 +171,8 @@ func (r ConventionalCommitRule) Validate(commit domain.Commit, _ config.Config)
 	failures = append(failures, descriptionErrors...)
 
 	// Validate type - enforce case-sensitive validation per conventional commit spec
-	if !isValidType(parts.Type, r.allowedTypes) {
-		failures = append(failures,
-			domain.New(r.Name(), domain.ErrInvalidConventionalType,
-				fmt.Sprintf("Invalid type '%s'", parts.Type)).
-				WithContextMap(map[string]string{
-					"actual":   parts.Type,
-					"expected": strings.Join(r.allowedTypes, ", "),
-				}).
-				WithHelp("Use one of: "+strings.Join(r.allowedTypes, ", ")))
-	}
+	typeErrors := r.validateType(parts)
+	failures = append(failures, typeErrors...)
 
 	// Validate scope requirements
 	scopeErrors := r.validateScopes(parts)
@@ -134,6 +193,59 @@ func (r ConventionalCommitRule) Validate(commit domain.Commit, _ config.Config)
 	return failures
 }
 
+// validateType validates the type against the allowed types. An alias of an allowed type
+// is accepted, reported as a warning or rejected depending on the alias mode.
+func (r ConventionalCommitRule) validateType(parts conventionalParts) []domain.ValidationError {
+	if isValidType(parts.Type, r.allowedTypes) {
+		return nil
+	}
+
+	canonical, isAlias := r.typeAliases[parts.Type]
+
+	switch {
+	case isAlias && r.aliasMode == "accept":
+		return nil
+	case isAlias && r.aliasMode != "reject":
+		return []domain.ValidationError{
+			domain.New(r.Name(), domain.ErrConventionalTypeAlias,
+				fmt.Sprintf("Type '%s' is an alias of '%s'", parts.Type, canonical)).
+				WithSeverity(domain.SeverityWarning).
+				WithContextMap(map[string]string{
+					"actual":    parts.Type,
+					"expected":  canonical,
+					"canonical": canonical,
+				}).
+				WithHelp(fmt.Sprintf("Use the type '%s' instead of its alias '%s'", canonical, parts.Type)).
+				WithSuggestion(canonical),
+		}
+	}
+
+	typeError := domain.New(r.Name(), domain.ErrInvalidConventionalType,
+		fmt.Sprintf("Invalid type '%s'", parts.Type)).
+		WithContextMap(map[string]string{
+			"actual":   parts.Type,
+			"expected": strings.Join(r.allowedTypes, ", "),
+		}).
+		WithHelp("Use one of: " + strings.Join(r.allowedTypes, ", "))
+
+	if isAlias {
+		return []domain.ValidationError{
+			typeError.WithContextMap(map[string]string{"canonical": canonical}).WithSuggestion(canonical),
+		}
+	}
+
+	// Types are case-sensitive, so "Feat" is only a case away from "feat"
+	for _, allowed := range r.allowedTypes {
+		if strings.EqualFold(parts.Type, allowed) {
+			typeError = typeError.WithSuggestion(allowed)
+
+			break
+		}
+	}
+
+	return []domain.ValidationError{typeError}
+}
+
 // parseConventionalFormat parses a commit subject into conventional commit parts.
 func (r ConventionalCommitRule) parseConventionalFormat(subject string) (conventionalParts, error) {
 	// Use shared conventional commit parser for consistency
@@ -260,6 +372,23 @@ func (r ConventionalCommitRule) validateScopes(parts conventionalParts) []domain
 		}
 	}
 
+	// Validate the number of scopes of multi-scope commits
+	if r.maxScopes > 0 && len(parts.Scopes) > r.maxScopes {
+		failures = append(failures,
+			domain.New(r.Name(), domain.ErrTooManyConventionalScopes,
+				fmt.Sprintf("Too many scopes (%d > %d)", len(parts.Scopes), r.maxScopes)).
+				WithContextMap(map[string]string{
+					"actual":   strconv.Itoa(len(parts.Scopes)),
+					"expected": fmt.Sprintf("max %d", r.maxScopes),
+					"scope":    parts.RawScope,
+				}).
+				WithHelp(fmt.Sprintf("Use at most %d scopes, or split the commit", r.maxScopes)))
+	}
+
+	for _, scope := range parts.Scopes {
+		failures = append(failures, r.validateScopeFormat(scope)...)
+	}
+
 	// Validate multi-scope format if enabled and scopes contain commas (regardless of scope restrictions)
 	if r.allowMultiScope && parts.RawScope != "" && strings.Contains(parts.RawScope, ",") {
 		// Check for proper comma separation format
@@ -277,6 +406,135 @@ func (r ConventionalCommitRule) validateScopes(parts conventionalParts) []domain
 	return failures
 }
 
+// validateScopeFormat validates the characters, case and length of one scope.
+func (r ConventionalCommitRule) validateScopeFormat(scope string) []domain.ValidationError {
+	var failures []domain.ValidationError
+
+	if scope == "" {
+		return nil
+	}
+
+	if disallowed := r.disallowedScopeCharacters(scope); disallowed != "" {
+		failures = append(failures,
+			domain.New(r.Name(), domain.ErrConventionalScopeChars,
+				fmt.Sprintf("Scope '%s' contains disallowed characters '%s'", scope, disallowed)).
+				WithContextMap(map[string]string{
+					"actual":     scope,
+					"characters": disallowed,
+					"expected":   "[" + r.scopeCharacters + "]",
+				}).
+				WithHelp(fmt.Sprintf("Use only the characters [%s] in scopes", r.scopeCharacters)))
+	}
+
+	if r.scopeCase != "" && !matchesScopeCase(scope, r.scopeCase) {
+		scopeError := domain.New(r.Name(), domain.ErrConventionalScopeCase,
+			fmt.Sprintf("Scope '%s' is not in %s case", scope, r.scopeCase)).
+			WithContextMap(map[string]string{
+				"actual":   scope,
+				"expected": r.scopeCase,
+			}).
+			WithHelp(fmt.Sprintf("Write scopes in %s case", r.scopeCase))
+
+		if suggestion := toScopeCase(scope, r.scopeCase); suggestion != "" && suggestion != scope {
+			scopeError = scopeError.WithSuggestion(suggestion)
+		}
+
+		failures = append(failures, scopeError)
+	}
+
+	if length := utf8.RuneCountInString(scope); r.maxScopeLength > 0 && length > r.maxScopeLength {
+		failures = append(failures,
+			domain.New(r.Name(), domain.ErrConventionalScopeTooLong,
+				fmt.Sprintf("Scope '%s' too long (%d > %d)", scope, length, r.maxScopeLength)).
+				WithContextMap(map[string]string{
+					"actual":   strconv.Itoa(length),
+					"expected": fmt.Sprintf("max %d", r.maxScopeLength),
+					"scope":    scope,
+				}).
+				WithHelp(fmt.Sprintf("Keep scopes to at most %d characters", r.maxScopeLength)))
+	}
+
+	return failures
+}
+
+// disallowedScopeCharacters returns the distinct characters of scope outside the allowed
+// scope characters, in order of appearance.
+func (r ConventionalCommitRule) disallowedScopeCharacters(scope string) string {
+	if r.scopeCharacter == nil {
+		return ""
+	}
+
+	var disallowed []rune
+
+	for _, char := range scope {
+		if !r.scopeCharacter.MatchString(string(char)) && !slices.Contains(disallowed, char) {
+			disallowed = append(disallowed, char)
+		}
+	}
+
+	return string(disallowed)
+}
+
+// matchesScopeCase reports whether scope is written in scopeCase.
+func matchesScopeCase(scope, scopeCase string) bool {
+	if pattern, found := scopeCasePatterns[scopeCase]; found {
+		return pattern.MatchString(scope)
+	}
+
+	return scope == strings.ToLower(scope)
+}
+
+// toScopeCase converts scope to scopeCase, splitting it into words at characters other
+// than letters and digits and where a lowercase letter is followed by an uppercase one.
+func toScopeCase(scope, scopeCase string) string {
+	if scopeCase == "lower" {
+		return strings.ToLower(scope)
+	}
+
+	var (
+		words   []string
+		current []rune
+		prev    rune
+	)
+
+	for _, char := range scope {
+		switch {
+		case !unicode.IsLetter(char) && !unicode.IsDigit(char):
+			if len(current) > 0 {
+				words = append(words, string(current))
+			}
+
+			current = nil
+		case unicode.IsUpper(char) && unicode.IsLower(prev) && len(current) > 0:
+			words = append(words, string(current))
+			current = []rune{char}
+		default:
+			current = append(current, char)
+		}
+
+		prev = char
+	}
+
+	if len(current) > 0 {
+		words = append(words, string(current))
+	}
+
+	for i, word := range words {
+		words[i] = strings.ToLower(word)
+
+		if scopeCase == "camel" && i > 0 {
+			runes := []rune(words[i])
+			words[i] = string(unicode.ToUpper(runes[0])) + string(runes[1:])
+		}
+	}
+
+	if scopeCase == "camel" {
+		return strings.Join(words, "")
+	}
+
+	return strings.Join(words, "-")
+}
+
 // isValidScope checks if the commit scope is in the list of allowed scopes.
 func isValidScope(scope string, allowedScopes []string) bool {
 	// If no allowed scopes are specified, all scopes are allowed
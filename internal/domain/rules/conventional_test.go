@@ -330,6 +330,31 @@ func TestConventionalCommitRuleErrorMessages(t *testing.T) {
 	}
 }
 
+func TestConventionalCommitRule_TypeSuggestion(t *testing.T) {
+	tests := []struct {
+		name       string
+		subject    string
+		suggestion string
+	}{
+		{name: "type differing in case", subject: "Feat: add feature", suggestion: "feat"},
+		{name: "unknown type", subject: "feature: add feature"},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			commit := createConventionalTestCommit()
+			commit.Subject = testCase.subject
+
+			cfg := config.NewDefault()
+			failures := rules.NewConventionalCommitRule(cfg).Validate(commit, cfg)
+
+			require.Len(t, failures, 1)
+			require.Equal(t, string(domain.ErrInvalidConventionalType), failures[0].Code)
+			require.Equal(t, testCase.suggestion, failures[0].Suggestion())
+		})
+	}
+}
+
 // Additional edge case tests.
 func TestConventionalCommitRuleEdgeCases(t *testing.T) {
 	tests := []struct {
@@ -680,6 +705,188 @@ func TestConventionalCommitRule_EnhancedScopeValidation(t *testing.T) {
 	}
 }
 
+func TestConventionalCommitRule_ScopeFormat(t *testing.T) {
+	tests := []struct {
+		name           string
+		conventional   config.ConventionalConfig
+		subject        string
+		wantCodes      []domain.ValidationErrorCode
+		wantSuggestion string
+	}{
+		{
+			name:         "kebab case scope",
+			conventional: config.ConventionalConfig{ScopeCase: "kebab"},
+			subject:      "feat(user-profile): add avatar",
+		},
+		{
+			name:           "camel case scope where kebab is required",
+			conventional:   config.ConventionalConfig{ScopeCase: "kebab"},
+			subject:        "feat(userProfile): add avatar",
+			wantCodes:      []domain.ValidationErrorCode{domain.ErrConventionalScopeCase},
+			wantSuggestion: "user-profile",
+		},
+		{
+			name:         "camel case scope",
+			conventional: config.ConventionalConfig{ScopeCase: "camel"},
+			subject:      "feat(userProfile): add avatar",
+		},
+		{
+			name:           "kebab case scope where camel is required",
+			conventional:   config.ConventionalConfig{ScopeCase: "camel"},
+			subject:        "feat(user-profile): add avatar",
+			wantCodes:      []domain.ValidationErrorCode{domain.ErrConventionalScopeCase},
+			wantSuggestion: "userProfile",
+		},
+		{
+			name:         "lower case scope with other characters",
+			conventional: config.ConventionalConfig{ScopeCase: "lower"},
+			subject:      "feat(api/v2): add endpoint",
+		},
+		{
+			name:           "upper case letters where lower is required",
+			conventional:   config.ConventionalConfig{ScopeCase: "lower"},
+			subject:        "feat(API): add endpoint",
+			wantCodes:      []domain.ValidationErrorCode{domain.ErrConventionalScopeCase},
+			wantSuggestion: "api",
+		},
+		{
+			name:         "scope within length",
+			conventional: config.ConventionalConfig{MaxScopeLength: 4},
+			subject:      "feat(auth): add login",
+		},
+		{
+			name:         "scope too long",
+			conventional: config.ConventionalConfig{MaxScopeLength: 4},
+			subject:      "feat(authentication): add login",
+			wantCodes:    []domain.ValidationErrorCode{domain.ErrConventionalScopeTooLong},
+		},
+		{
+			name:         "allowed characters",
+			conventional: config.ConventionalConfig{ScopeCharacters: "a-z0-9/-"},
+			subject:      "feat(api/v2): add endpoint",
+		},
+		{
+			name:         "disallowed characters",
+			conventional: config.ConventionalConfig{ScopeCharacters: "a-z0-9-"},
+			subject:      "feat(api_v2.1): add endpoint",
+			wantCodes:    []domain.ValidationErrorCode{domain.ErrConventionalScopeChars},
+		},
+		{
+			name:         "scopes within limit",
+			conventional: config.ConventionalConfig{MaxScopes: 2},
+			subject:      "feat(ui,api): add login",
+		},
+		{
+			name:         "too many scopes",
+			conventional: config.ConventionalConfig{MaxScopes: 2},
+			subject:      "feat(ui,api,db): add login",
+			wantCodes:    []domain.ValidationErrorCode{domain.ErrTooManyConventionalScopes},
+		},
+		{
+			name:         "each scope checked",
+			conventional: config.ConventionalConfig{ScopeCase: "kebab", MaxScopeLength: 5},
+			subject:      "feat(ui,Backend): add login",
+			wantCodes:    []domain.ValidationErrorCode{domain.ErrConventionalScopeCase, domain.ErrConventionalScopeTooLong},
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			cfg := config.Config{Conventional: testCase.conventional}
+
+			commit := createConventionalTestCommit()
+			commit.Subject = testCase.subject
+
+			errors := rules.NewConventionalCommitRule(cfg).Validate(commit, cfg)
+
+			codes := make([]domain.ValidationErrorCode, 0, len(errors))
+			for _, err := range errors {
+				codes = append(codes, domain.ValidationErrorCode(err.Code))
+			}
+
+			require.ElementsMatch(t, testCase.wantCodes, codes)
+
+			if testCase.wantSuggestion != "" {
+				require.Equal(t, testCase.wantSuggestion, errors[0].Suggestion())
+			}
+		})
+	}
+}
+
+func TestConventionalCommitRule_TypeAliases(t *testing.T) {
+	aliases := map[string]string{"feature": "feat", "bugfix": "fix"}
+
+	tests := []struct {
+		name           string
+		aliasMode      string
+		subject        string
+		wantCode       domain.ValidationErrorCode
+		wantSeverity   domain.SeverityLevel
+		wantSuggestion string
+	}{
+		{
+			name:      "alias accepted",
+			aliasMode: "accept",
+			subject:   "feature: add login",
+		},
+		{
+			name:           "alias warned about",
+			aliasMode:      "warn",
+			subject:        "bugfix(auth): handle expired tokens",
+			wantCode:       domain.ErrConventionalTypeAlias,
+			wantSeverity:   domain.SeverityWarning,
+			wantSuggestion: "fix",
+		},
+		{
+			name:           "alias warned about by default",
+			subject:        "feature: add login",
+			wantCode:       domain.ErrConventionalTypeAlias,
+			wantSeverity:   domain.SeverityWarning,
+			wantSuggestion: "feat",
+		},
+		{
+			name:           "alias rejected",
+			aliasMode:      "reject",
+			subject:        "feature: add login",
+			wantCode:       domain.ErrInvalidConventionalType,
+			wantSuggestion: "feat",
+		},
+		{
+			name:      "canonical type",
+			aliasMode: "reject",
+			subject:   "feat: add login",
+		},
+		{
+			name:      "unknown type",
+			aliasMode: "accept",
+			subject:   "enhancement: add login",
+			wantCode:  domain.ErrInvalidConventionalType,
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			cfg := config.Config{Conventional: config.ConventionalConfig{TypeAliases: aliases, AliasMode: testCase.aliasMode}}
+
+			commit := createConventionalTestCommit()
+			commit.Subject = testCase.subject
+
+			errors := rules.NewConventionalCommitRule(cfg).Validate(commit, cfg)
+
+			if testCase.wantCode == "" {
+				require.Empty(t, errors)
+
+				return
+			}
+
+			require.Len(t, errors, 1)
+			require.Equal(t, string(testCase.wantCode), errors[0].Code)
+			require.Equal(t, testCase.wantSeverity, errors[0].Severity)
+			require.Equal(t, testCase.wantSuggestion, errors[0].Suggestion())
+		})
+	}
+}
+
 func TestConventionalCommitRule_BackwardCompatibility(t *testing.T) {
 	// Test that all existing functionality still works
 	tests := []struct {
@@ -0,0 +1,142 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package rules
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/itiquette/gommitlint/internal/domain"
+	"github.com/itiquette/gommitlint/internal/domain/config"
+)
+
+// Custom rule targets.
+const (
+	TargetSubject = "subject"
+	TargetBody    = "body"
+	TargetMessage = "message"
+)
+
+// CustomRule is a regex rule declared in the custom_rules configuration section.
+type CustomRule struct {
+	name     string
+	pattern  *regexp.Regexp
+	source   string
+	target   string
+	forbid   bool
+	message  string
+	severity domain.SeverityLevel
+	err      error
+}
+
+// NewCustomRule creates a CustomRule from its declaration.
+// An invalid pattern is reported as a validation error when the rule runs.
+func NewCustomRule(ruleCfg config.CustomRuleConfig) CustomRule {
+	pattern, err := regexp.Compile(ruleCfg.Pattern)
+
+	target := ruleCfg.Target
+	if target == "" {
+		target = TargetSubject
+	}
+
+	severity := domain.SeverityError
+	if ruleCfg.Severity == string(domain.SeverityWarning) {
+		severity = domain.SeverityWarning
+	}
+
+	return CustomRule{
+		name:     ruleCfg.Name,
+		pattern:  pattern,
+		source:   ruleCfg.Pattern,
+		target:   target,
+		forbid:   ruleCfg.Forbid,
+		message:  ruleCfg.Message,
+		severity: severity,
+		err:      err,
+	}
+}
+
+// CreateCustomRules synthesizes the active custom rules of the configuration.
+// Custom rules run once declared unless listed in rules.disabled.
+func CreateCustomRules(cfg config.Config) []domain.CommitRule {
+	rules := make([]domain.CommitRule, 0, len(cfg.CustomRules))
+
+	for _, ruleCfg := range cfg.CustomRules {
+		if !IsRuleActive(ruleCfg.Name, cfg.Rules.Enabled, cfg.Rules.Disabled) {
+			continue
+		}
+
+		rules = append(rules, domain.WithCommitCondition(NewCustomRule(ruleCfg), cfg.Rules.Conditions, ruleCfg.Name))
+	}
+
+	return rules
+}
+
+// Name returns the rule name.
+func (r CustomRule) Name() string {
+	return r.name
+}
+
+// Validate checks the configured part of the commit message against the pattern.
+func (r CustomRule) Validate(commit domain.Commit, _ config.Config) []domain.ValidationError {
+	if r.err != nil {
+		return []domain.ValidationError{
+			domain.New(r.Name(), domain.ErrInvalidConfig, "Invalid pattern: "+r.err.Error()).
+				WithContextMap(map[string]string{
+					"pattern": r.source,
+				}).
+				WithHelp("Fix the regular expression in the custom_rules section of your configuration"),
+		}
+	}
+
+	// Skip merge commits
+	if commit.IsMergeCommit {
+		return nil
+	}
+
+	text := r.targetText(commit)
+	matched := r.pattern.MatchString(text)
+
+	if matched != r.forbid {
+		return nil
+	}
+
+	code := domain.ErrPatternMismatch
+	message := fmt.Sprintf("%s does not match required pattern %s", r.target, r.source)
+	help := fmt.Sprintf("Change the commit %s so that it matches %s", r.target, r.source)
+
+	if r.forbid {
+		code = domain.ErrForbiddenPattern
+		message = fmt.Sprintf("%s matches forbidden pattern %s", r.target, r.source)
+		help = fmt.Sprintf("Remove the text matching %s from the commit %s", r.source, r.target)
+	}
+
+	if r.message != "" {
+		message = r.message
+	}
+
+	return []domain.ValidationError{
+		domain.New(r.Name(), code, message).
+			WithContextMap(map[string]string{
+				"pattern": r.source,
+				"target":  r.target,
+				"actual":  text,
+			}).
+			WithHelp(help).
+			WithSeverity(r.severity),
+	}
+}
+
+// targetText returns the part of the commit message the rule applies to.
+func (r CustomRule) targetText(commit domain.Commit) string {
+	switch r.target {
+	case TargetBody:
+		return commit.Body
+	case TargetMessage:
+		return commit.Message
+	default:
+		return commit.Subject
+	}
+}
@@ -0,0 +1,123 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package rules
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/itiquette/gommitlint/internal/domain"
+	"github.com/itiquette/gommitlint/internal/domain/config"
+)
+
+func TestCustomRule_Validate(t *testing.T) {
+	tests := []struct {
+		name             string
+		ruleCfg          config.CustomRuleConfig
+		message          string
+		expectedCode     string
+		expectedMessage  string
+		expectedSeverity domain.SeverityLevel
+	}{
+		{
+			name:    "Required subject pattern matches",
+			ruleCfg: config.CustomRuleConfig{Name: "Ticket", Pattern: `^\[[A-Z]+-\d+\]`},
+			message: "[ABC-123] Add feature",
+		},
+		{
+			name:             "Required subject pattern missing",
+			ruleCfg:          config.CustomRuleConfig{Name: "Ticket", Pattern: `^\[[A-Z]+-\d+\]`},
+			message:          "Add feature",
+			expectedCode:     string(domain.ErrPatternMismatch),
+			expectedMessage:  `subject does not match required pattern ^\[[A-Z]+-\d+\]`,
+			expectedSeverity: domain.SeverityError,
+		},
+		{
+			name:             "Forbidden subject pattern with custom message",
+			ruleCfg:          config.CustomRuleConfig{Name: "NoWIP", Pattern: `(?i)\bwip\b`, Forbid: true, Message: "Do not commit work in progress"},
+			message:          "WIP add feature",
+			expectedCode:     string(domain.ErrForbiddenPattern),
+			expectedMessage:  "Do not commit work in progress",
+			expectedSeverity: domain.SeverityError,
+		},
+		{
+			name:    "Forbidden pattern absent",
+			ruleCfg: config.CustomRuleConfig{Name: "NoWIP", Pattern: `(?i)\bwip\b`, Forbid: true},
+			message: "Add feature",
+		},
+		{
+			name:             "Body target with warning severity",
+			ruleCfg:          config.CustomRuleConfig{Name: "NoTodo", Pattern: `TODO`, Target: "body", Forbid: true, Severity: "warning"},
+			message:          "Add feature\n\nTODO: write docs",
+			expectedCode:     string(domain.ErrForbiddenPattern),
+			expectedMessage:  "body matches forbidden pattern TODO",
+			expectedSeverity: domain.SeverityWarning,
+		},
+		{
+			name:    "Body target ignores subject",
+			ruleCfg: config.CustomRuleConfig{Name: "NoTodo", Pattern: `TODO`, Target: "body", Forbid: true},
+			message: "TODO add feature\n\nDetails",
+		},
+		{
+			name:             "Message target spans subject and body",
+			ruleCfg:          config.CustomRuleConfig{Name: "Refs", Pattern: `(?m)^Refs: #\d+$`, Target: "message"},
+			message:          "Add feature\n\nNo reference here",
+			expectedCode:     string(domain.ErrPatternMismatch),
+			expectedMessage:  `message does not match required pattern (?m)^Refs: #\d+$`,
+			expectedSeverity: domain.SeverityError,
+		},
+		{
+			name:             "Invalid pattern",
+			ruleCfg:          config.CustomRuleConfig{Name: "Broken", Pattern: `(`},
+			message:          "Add feature",
+			expectedCode:     string(domain.ErrInvalidConfig),
+			expectedMessage:  "Invalid pattern: error parsing regexp: missing closing ): `(`",
+			expectedSeverity: "",
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			rule := NewCustomRule(testCase.ruleCfg)
+			require.Equal(t, testCase.ruleCfg.Name, rule.Name())
+
+			errors := rule.Validate(domain.ParseCommitMessage(testCase.message), config.Config{})
+
+			if testCase.expectedCode == "" {
+				require.Empty(t, errors)
+
+				return
+			}
+
+			require.Len(t, errors, 1)
+			require.Equal(t, testCase.ruleCfg.Name, errors[0].Rule)
+			require.Equal(t, testCase.expectedCode, errors[0].Code)
+			require.Equal(t, testCase.expectedMessage, errors[0].Message)
+			require.Equal(t, testCase.expectedSeverity, errors[0].Severity)
+		})
+	}
+}
+
+func TestCreateCustomRules(t *testing.T) {
+	cfg := config.NewDefault()
+	cfg.CustomRules = []config.CustomRuleConfig{
+		{Name: "Ticket", Pattern: `^\[`},
+		{Name: "NoWIP", Pattern: `WIP`, Forbid: true},
+	}
+	cfg.Rules.Disabled = []string{"nowip"}
+
+	created := CreateCustomRules(cfg)
+	require.Len(t, created, 1)
+	require.Equal(t, "Ticket", created[0].Name())
+
+	names := make([]string, 0)
+	for _, rule := range CreateCommitRules(cfg, Services{}) {
+		names = append(names, rule.Name())
+	}
+
+	require.Contains(t, names, "Ticket")
+	require.NotContains(t, names, "NoWIP")
+}
@@ -97,8 +97,14 @@ The package provides these validation rules:
   - SignOffRule: Validates Developer Certificate of Origin
   - SpellRule: Validates spelling in commit messages
   - SubjectRule: Validates subject length, case, suffix, and imperative mood (consolidated rule)
+  - TrailersRule: Validates trailer keys, capitalization, duplicates and ordering
+  - ReviewRule: Validates Reviewed-by and Acked-by trailers on commits targeting protected branches
+  - LinearHistoryRule: Validates that a range is a single chain of commits without merges
 
 Each rule focuses on a specific aspect of commit message validation and can be
 independently enabled, disabled, and configured.
+
+Each rule also documents itself through a Metadata method listing its error codes,
+configuration keys and example messages, which the explain command renders.
 */
 package rules
@@ -0,0 +1,56 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package rules
+
+import (
+	"github.com/itiquette/gommitlint/internal/domain"
+	"github.com/itiquette/gommitlint/internal/domain/config"
+)
+
+// EncodingRule reports commits whose message could not be transcoded to UTF-8 from the
+// encoding their encoding header declares.
+type EncodingRule struct{}
+
+// NewEncodingRule creates a new EncodingRule.
+func NewEncodingRule() EncodingRule {
+	return EncodingRule{}
+}
+
+// Name returns the rule name.
+func (r EncodingRule) Name() string {
+	return "Encoding"
+}
+
+// Metadata returns the documentation of the rule.
+func (r EncodingRule) Metadata() domain.RuleMetadata {
+	return domain.RuleMetadata{
+		ID:       "encoding",
+		Name:     r.Name(),
+		Category: domain.CategoryMessage,
+		Severity: domain.SeverityError,
+		Summary:  "Messages decode from the encoding their commit declares",
+		Description: "Commits written with i18n.commitEncoding set carry an encoding header, and " +
+			"their messages are transcoded from it to UTF-8 before the other rules run, as git log " +
+			"does. The rule reports commits whose header names an encoding that is not known, or " +
+			"whose message holds bytes that are not valid in it. The other rules then see the " +
+			"message as it is stored.",
+		ErrorCodes: []domain.ValidationErrorCode{domain.ErrUndecodableMessage},
+	}
+}
+
+// Validate reports the encoding error of the commit, if any.
+func (r EncodingRule) Validate(commit domain.Commit, _ config.Config) []domain.ValidationError {
+	if commit.EncodingError == "" {
+		return nil
+	}
+
+	return []domain.ValidationError{
+		domain.New(r.Name(), domain.ErrUndecodableMessage,
+			"Message cannot be decoded with its encoding header: "+commit.EncodingError).
+			WithContextMap(map[string]string{"actual": commit.Encoding}).
+			WithHelp("Reword the commit with the message in UTF-8 and without i18n.commitEncoding, " +
+				"or set i18n.commitEncoding to the encoding the message is written in"),
+	}
+}
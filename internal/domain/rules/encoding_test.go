@@ -0,0 +1,31 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package rules_test
+
+import (
+	"testing"
+
+	"github.com/itiquette/gommitlint/internal/domain"
+	"github.com/itiquette/gommitlint/internal/domain/config"
+	"github.com/itiquette/gommitlint/internal/domain/rules"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncodingRule(t *testing.T) {
+	rule := rules.NewEncodingRule()
+	cfg := config.NewDefault()
+
+	require.Empty(t, rule.Validate(domain.Commit{Subject: "fix: räkna om", Encoding: "ISO-8859-1"}, cfg))
+
+	errors := rule.Validate(domain.Commit{
+		Subject:       "fix: r\xe4kna om",
+		Encoding:      "klingon",
+		EncodingError: "unknown encoding 'klingon'",
+	}, cfg)
+	require.Len(t, errors, 1)
+	require.Equal(t, string(domain.ErrUndecodableMessage), errors[0].Code)
+	require.Equal(t, "klingon", errors[0].Context["actual"])
+	require.Contains(t, errors[0].Message, "unknown encoding 'klingon'")
+}
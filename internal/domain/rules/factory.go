@@ -5,73 +5,158 @@
 package rules
 
 import (
+	"slices"
+	"sort"
 	"strings"
 
-	"github.com/itiquette/gommitlint/internal/adapters/spell"
 	"github.com/itiquette/gommitlint/internal/domain"
 	"github.com/itiquette/gommitlint/internal/domain/config"
 )
 
-// CreateCommitRules creates commit rules based on configuration.
-func CreateCommitRules(cfg config.Config) []domain.CommitRule {
-	// Map of rule constructors - explicit, type-safe, no string magic
-	ruleConstructors := map[string]func(config.Config) domain.CommitRule{
-		"subject":       func(c config.Config) domain.CommitRule { return NewSubjectRule(c) },
-		"conventional":  func(c config.Config) domain.CommitRule { return NewConventionalCommitRule(c) },
-		"commitbody":    func(c config.Config) domain.CommitRule { return NewCommitBodyRule(c) },
-		"jirareference": func(c config.Config) domain.CommitRule { return NewJiraReferenceRule(c) },
-		"signoff":       func(c config.Config) domain.CommitRule { return NewSignOffRule(c) },
-		"signature":     func(c config.Config) domain.CommitRule { return NewSignatureRule(c) },
-		"identity":      func(c config.Config) domain.CommitRule { return NewIdentityRule(c) },
-		"spell": func(c config.Config) domain.CommitRule {
-			checker := spell.NewMisspellAdapter(c.Spell.Locale)
+// CreateCommitRules creates commit rules based on configuration, consulting services.
+func CreateCommitRules(cfg config.Config, services Services) []domain.CommitRule {
+	constructors := make(map[string]func(config.Config, Services) domain.CommitRule)
 
-			return NewSpellRule(checker, c)
-		},
+	for _, entry := range registrations() {
+		if entry.Kind == KindCommit {
+			constructors[entry.ID] = entry.newCommitRule
+		}
 	}
 
-	// Default enabled rules - explicit list, no magic strings scattered
-	defaultEnabled := []string{"subject", "conventional", "signoff", "signature", "spell"}
-
 	var rules []domain.CommitRule
 
 	// Determine which rules to create
-	enabledRules := determineEnabledRules(defaultEnabled, cfg.Rules)
+	enabledRules := determineEnabledRules(defaultEnabledRules(KindCommit), cfg.Rules)
 
-	// Create only enabled rules
+	// Create only enabled rules, restricted by their configured conditions
 	for _, ruleName := range enabledRules {
-		if constructor, exists := ruleConstructors[ruleName]; exists {
-			rules = append(rules, constructor(cfg))
+		if constructor, exists := constructors[ruleName]; exists {
+			rule := constructor(cfg, services)
+			rules = append(rules, domain.WithCommitCondition(rule, cfg.Rules.Conditions, ruleName, rule.Name()))
 		}
 	}
 
+	// Key scopes are identity rules limited to the commits of their authors and paths
+	if slices.Contains(enabledRules, "identity") {
+		rules = append(rules, createKeyScopeRules(cfg, services)...)
+	}
+
+	// Rules declared in the custom_rules section
+	rules = append(rules, CreateCustomRules(cfg)...)
+
 	return rules
 }
 
-// CreateRepositoryRules creates repository rules based on configuration.
-func CreateRepositoryRules(cfg config.Config) []domain.RepositoryRule {
-	// Map of rule constructors - type-safe
-	ruleConstructors := map[string]func(config.Config) domain.RepositoryRule{
-		"branchahead": func(c config.Config) domain.RepositoryRule { return NewBranchAheadRule(c) },
+// createJiraReferenceRule creates the JIRA rule, looking up the referenced tickets when
+// the online mode is enabled.
+func createJiraReferenceRule(cfg config.Config, services Services) JiraReferenceRule {
+	rule := NewJiraReferenceRule(cfg)
+	if cfg.Jira.Online.Enabled && services.JiraTracker != nil {
+		rule = rule.WithTracker(services.JiraTracker(cfg.Jira.Online))
 	}
 
-	// Default enabled rules
-	defaultEnabled := []string{"branchahead"}
+	return rule
+}
 
-	return buildRepositoryRules(ruleConstructors, defaultEnabled, cfg)
+// createSignatureRule creates the signature rule, verifying signatures against the trusted
+// keys when key directories are configured and services can verify them.
+func createSignatureRule(cfg config.Config, services Services) SignatureRule {
+	rule := NewSignatureRule(cfg)
+	if cfg.Signature.HasTrustedKeys() && services.SignatureVerifier != nil {
+		rule = rule.WithVerifier(services.SignatureVerifier(cfg.Signature), keyDirectoriesOrNone(cfg.Signature))
+	}
+
+	return rule
+}
+
+// keyDirectoriesOrNone returns the trusted key directories, or a single empty directory
+// when only the ssh-agent or known signers are trusted, which verifiers search with
+// every directory.
+func keyDirectoriesOrNone(signature config.SignatureConfig) []string {
+	if keyDirs := signature.TrustedKeyDirectories(); len(keyDirs) > 0 {
+		return keyDirs
+	}
+
+	return []string{""}
+}
+
+// createKeyScopeRules creates an identity rule for each key scope, applying to the commits
+// the scope matches. Key scopes need services to verify signatures.
+func createKeyScopeRules(cfg config.Config, services Services) []domain.CommitRule {
+	if len(cfg.Identity.KeyScopes) == 0 || services.SignatureVerifier == nil {
+		return nil
+	}
+
+	verifier := services.SignatureVerifier(cfg.Signature)
+	rules := make([]domain.CommitRule, 0, len(cfg.Identity.KeyScopes))
+
+	for _, scope := range cfg.Identity.KeyScopes {
+		rules = append(rules, domain.ConditionalCommitRule{
+			CommitRule: NewKeyScopeIdentityRule(scope, verifier),
+			Condition:  KeyScopeCondition(scope),
+		})
+	}
+
+	return rules
+}
+
+// createSubjectEchoRule creates the subject echo rule, comparing subjects with ticket
+// titles when the JIRA online mode is enabled.
+func createSubjectEchoRule(cfg config.Config, services Services) SubjectEchoRule {
+	rule := NewSubjectEchoRule(cfg)
+	if cfg.Jira.Online.Enabled && services.JiraTracker != nil {
+		rule = rule.WithTracker(services.JiraTracker(cfg.Jira.Online))
+	}
+
+	return rule
+}
+
+// createIssueReferenceRule creates the GitHub issue rule with a tracker for the configured
+// repository, when services can reach it.
+func createIssueReferenceRule(cfg config.Config, services Services) IssueReferenceRule {
+	if services.IssueTracker == nil {
+		return NewIssueReferenceRule(nil, cfg)
+	}
+
+	return NewIssueReferenceRule(services.IssueTracker(cfg.Issues), cfg)
+}
+
+// createCLARule creates the CLA rule with a registry for the configured contributors
+// file and CLA service, when services can reach them.
+func createCLARule(cfg config.Config, services Services) CLARule {
+	if services.CLARegistry == nil {
+		return NewCLARule(nil, cfg)
+	}
+
+	return NewCLARule(services.CLARegistry(cfg.CLA), cfg)
+}
+
+// CreateRepositoryRules creates repository rules based on configuration, consulting services.
+func CreateRepositoryRules(cfg config.Config, services Services) []domain.RepositoryRule {
+	constructors := make(map[string]func(config.Config, Services) domain.RepositoryRule)
+
+	for _, entry := range registrations() {
+		if entry.Kind == KindRepository {
+			constructors[entry.ID] = entry.newRepositoryRule
+		}
+	}
+
+	return buildRepositoryRules(constructors, defaultEnabledRules(KindRepository), cfg, services)
 }
 
 // buildRepositoryRules creates repository rules based on constructor map and configuration.
-func buildRepositoryRules(constructors map[string]func(config.Config) domain.RepositoryRule, defaultEnabled []string, cfg config.Config) []domain.RepositoryRule {
+func buildRepositoryRules(constructors map[string]func(config.Config, Services) domain.RepositoryRule, defaultEnabled []string,
+	cfg config.Config, services Services) []domain.RepositoryRule {
 	var rules []domain.RepositoryRule
 
 	// Determine which rules to create
 	enabledRules := determineEnabledRules(defaultEnabled, cfg.Rules)
 
-	// Create only enabled rules
+	// Create only enabled rules, restricted by their configured conditions
 	for _, ruleName := range enabledRules {
 		if constructor, exists := constructors[ruleName]; exists {
-			rules = append(rules, constructor(cfg))
+			rule := constructor(cfg, services)
+			rules = append(rules, domain.WithRepositoryCondition(rule, cfg.Rules.Conditions, ruleName, rule.Name()))
 		}
 	}
 
@@ -109,5 +194,8 @@ func determineEnabledRules(defaultEnabled []string, rulesConfig config.RulesConf
 		result = append(result, rule)
 	}
 
+	// Rules not named in rules.order run in this order
+	sort.Strings(result)
+
 	return result
 }
@@ -0,0 +1,181 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package rules
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/itiquette/gommitlint/internal/domain"
+	"github.com/itiquette/gommitlint/internal/domain/config"
+)
+
+// fixupPrefixes start the subjects 'git commit --fixup' and '--squash' write.
+var fixupPrefixes = []string{"fixup! ", "squash! ", "amend! "}
+
+// FixupRule checks fixup!, squash! and amend! commits: within a validated range they
+// must name a commit of the range, so that 'git rebase --autosquash' folds them in, and
+// they may be kept off protected branches.
+type FixupRule struct {
+	protectedBranches []string
+}
+
+// NewFixupRule creates a new FixupRule from config.
+func NewFixupRule(cfg config.Config) FixupRule {
+	return FixupRule{protectedBranches: cfg.Fixup.ProtectedBranches}
+}
+
+// Name returns the rule name.
+func (r FixupRule) Name() string {
+	return "Fixup"
+}
+
+// Metadata returns the documentation of the rule.
+func (r FixupRule) Metadata() domain.RuleMetadata {
+	return domain.RuleMetadata{
+		ID:       "fixup",
+		Name:     r.Name(),
+		Category: domain.CategoryHistory,
+		Severity: domain.SeverityError,
+		Summary:  "Fixup and squash commits have a target and stay off protected branches",
+		Description: "Applies to commits whose subject starts with 'fixup! ', 'squash! ' or " +
+			"'amend! '. In a validated range, the text after the prefix must start the subject " +
+			"of another commit of the range or be the start of its hash, as 'git rebase " +
+			"--autosquash' requires. Commits targeting a branch in fixup.protected_branches are " +
+			"reported wherever they are validated.",
+		ErrorCodes: []domain.ValidationErrorCode{domain.ErrFixupTargetNotFound, domain.ErrFixupOnProtectedBranch},
+		ConfigKeys: []string{"fixup.protected_branches"},
+		Examples: []domain.RuleExample{
+			{Message: "fixup! feat: add login page", Valid: true, Note: "with 'feat: add login page' in the range"},
+			{Message: "fixup! feat: add logon page", Note: "no commit of the range has that subject"},
+		},
+	}
+}
+
+// ChecksMessage marks the rule as a domain.MessageRule.
+func (r FixupRule) ChecksMessage() {}
+
+// Validate reports a fixup commit targeting a protected branch. The target branch is
+// resolved through the repository; commits are not checked when it cannot be resolved.
+func (r FixupRule) Validate(commit domain.Commit, repo domain.Repository, _ config.Config) []domain.ValidationError {
+	if len(r.protectedBranches) == 0 {
+		return nil
+	}
+
+	prefix, _, isFixup := fixupTarget(commit.Subject)
+	if !isFixup {
+		return nil
+	}
+
+	resolver, ok := repo.(domain.BranchResolver)
+	if !ok {
+		return nil
+	}
+
+	// Detached and unborn heads have no target branch
+	branch, err := resolver.GetTargetBranch(context.Background())
+	if err != nil || branch == "" {
+		return nil
+	}
+
+	for _, pattern := range r.protectedBranches {
+		if matched, err := path.Match(pattern, branch); err == nil && matched {
+			return []domain.ValidationError{
+				domain.New(r.Name(), domain.ErrFixupOnProtectedBranch,
+					fmt.Sprintf("%s commit on protected branch '%s'", strings.TrimSpace(prefix), branch)).
+					WithContextMap(map[string]string{
+						"actual":  commit.Subject,
+						"branch":  branch,
+						"subject": commit.Subject,
+					}).
+					WithHelp("Squash the commit into its target with 'git rebase -i --autosquash' before it lands on " + branch),
+			}
+		}
+	}
+
+	return nil
+}
+
+// ValidateRange reports the fixup commits of the range whose target is not in the range.
+func (r FixupRule) ValidateRange(commits []domain.Commit, _ config.Config) []domain.ValidationError {
+	var errors []domain.ValidationError
+
+	for _, commit := range commits {
+		_, target, isFixup := fixupTarget(commit.Subject)
+		if !isFixup || hasFixupTarget(target, commit.Hash, commits) {
+			continue
+		}
+
+		errors = append(errors,
+			domain.New(r.Name(), domain.ErrFixupTargetNotFound,
+				fmt.Sprintf("Commit %s targets '%s', which is not in the range", domain.ShortHash(commit.Hash), target)).
+				WithContextMap(map[string]string{
+					"actual":  target,
+					"commit":  domain.ShortHash(commit.Hash),
+					"subject": commit.Subject,
+				}).
+				WithHelp("Change the subject to 'fixup! ' followed by the subject of a commit in the range, or squash the commit by hand"))
+	}
+
+	return errors
+}
+
+// fixupTarget returns the first prefix of a fixup commit's subject and the text that
+// remains after removing all prefixes, as a fixup of a fixup repeats them.
+func fixupTarget(subject string) (string, string, bool) {
+	var first string
+
+	for {
+		prefix, found := findFixupPrefix(subject)
+		if !found {
+			break
+		}
+
+		if first == "" {
+			first = prefix
+		}
+
+		subject = strings.TrimPrefix(subject, prefix)
+	}
+
+	return first, strings.TrimSpace(subject), first != ""
+}
+
+// findFixupPrefix returns the fixup prefix subject starts with.
+func findFixupPrefix(subject string) (string, bool) {
+	for _, prefix := range fixupPrefixes {
+		if strings.HasPrefix(subject, prefix) {
+			return prefix, true
+		}
+	}
+
+	return "", false
+}
+
+// hasFixupTarget reports whether a commit of commits other than the fixup itself has a
+// subject starting with target or a hash starting with it.
+func hasFixupTarget(target, fixupHash string, commits []domain.Commit) bool {
+	if target == "" {
+		return false
+	}
+
+	for _, candidate := range commits {
+		if candidate.Hash == fixupHash {
+			continue
+		}
+
+		if _, _, isFixup := fixupTarget(candidate.Subject); !isFixup && strings.HasPrefix(candidate.Subject, target) {
+			return true
+		}
+
+		if len(target) >= 4 && strings.HasPrefix(candidate.Hash, strings.ToLower(target)) {
+			return true
+		}
+	}
+
+	return false
+}
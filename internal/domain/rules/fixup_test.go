@@ -0,0 +1,141 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package rules_test
+
+import (
+	"testing"
+
+	"github.com/itiquette/gommitlint/internal/domain"
+	"github.com/itiquette/gommitlint/internal/domain/config"
+	"github.com/itiquette/gommitlint/internal/domain/rules"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFixupRule_ValidateRange(t *testing.T) {
+	target := domain.Commit{Hash: "1a2b3c4d5e6f", Subject: "feat: add login page"}
+
+	tests := []struct {
+		name          string
+		commits       []domain.Commit
+		expectedCodes []domain.ValidationErrorCode
+	}{
+		{
+			name:    "range without fixup commits",
+			commits: []domain.Commit{target},
+		},
+		{
+			name: "fixup of a commit in the range",
+			commits: []domain.Commit{
+				{Hash: "f1", Subject: "fixup! feat: add login page"},
+				target,
+			},
+		},
+		{
+			name: "squash of a subject prefix",
+			commits: []domain.Commit{
+				{Hash: "f1", Subject: "squash! feat: add login"},
+				target,
+			},
+		},
+		{
+			name: "fixup of a fixup",
+			commits: []domain.Commit{
+				{Hash: "f2", Subject: "fixup! fixup! feat: add login page"},
+				{Hash: "f1", Subject: "fixup! feat: add login page"},
+				target,
+			},
+		},
+		{
+			name: "fixup naming a hash",
+			commits: []domain.Commit{
+				{Hash: "f1", Subject: "amend! 1a2b3c4"},
+				target,
+			},
+		},
+		{
+			name: "fixup of a commit outside the range",
+			commits: []domain.Commit{
+				{Hash: "f1", Subject: "fixup! feat: add logon page"},
+				target,
+			},
+			expectedCodes: []domain.ValidationErrorCode{domain.ErrFixupTargetNotFound},
+		},
+		{
+			name: "fixups do not target each other",
+			commits: []domain.Commit{
+				{Hash: "f2", Subject: "fixup! fixup! feat: add logon page"},
+				{Hash: "f1", Subject: "fixup! feat: add logon page"},
+			},
+			expectedCodes: []domain.ValidationErrorCode{domain.ErrFixupTargetNotFound, domain.ErrFixupTargetNotFound},
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			cfg := config.Config{}
+
+			errors := rules.NewFixupRule(cfg).ValidateRange(testCase.commits, cfg)
+
+			codes := make([]domain.ValidationErrorCode, 0, len(errors))
+			for _, err := range errors {
+				codes = append(codes, domain.ValidationErrorCode(err.Code))
+			}
+
+			require.ElementsMatch(t, testCase.expectedCodes, codes)
+		})
+	}
+}
+
+func TestFixupRule_ProtectedBranches(t *testing.T) {
+	tests := []struct {
+		name          string
+		subject       string
+		branch        string
+		expectedCodes []domain.ValidationErrorCode
+	}{
+		{
+			name:          "fixup on a protected branch",
+			subject:       "fixup! feat: add login page",
+			branch:        "main",
+			expectedCodes: []domain.ValidationErrorCode{domain.ErrFixupOnProtectedBranch},
+		},
+		{
+			name:          "squash on a branch matching a glob",
+			subject:       "squash! feat: add login page",
+			branch:        "release/1.0",
+			expectedCodes: []domain.ValidationErrorCode{domain.ErrFixupOnProtectedBranch},
+		},
+		{
+			name:    "fixup on a feature branch",
+			subject: "fixup! feat: add login page",
+			branch:  "feature/login",
+		},
+		{
+			name:    "commit that is not a fixup",
+			subject: "feat: add login page",
+			branch:  "main",
+		},
+		{
+			name:    "unresolved branch is not checked",
+			subject: "fixup! feat: add login page",
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			cfg := config.Config{Fixup: config.FixupConfig{ProtectedBranches: []string{"main", "release/*"}}}
+			commit := domain.Commit{Subject: testCase.subject}
+
+			errors := rules.NewFixupRule(cfg).Validate(commit, branchRepository{branch: testCase.branch}, cfg)
+
+			codes := make([]domain.ValidationErrorCode, 0, len(errors))
+			for _, err := range errors {
+				codes = append(codes, domain.ValidationErrorCode(err.Code))
+			}
+
+			require.ElementsMatch(t, testCase.expectedCodes, codes)
+		})
+	}
+}
@@ -0,0 +1,145 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package rules
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"strconv"
+
+	"github.com/itiquette/gommitlint/internal/domain"
+	"github.com/itiquette/gommitlint/internal/domain/config"
+)
+
+// ForcePushRule warns when HEAD has diverged from its remote tracking branch, so that
+// pushing it needs a force push, and names the published commits it rewrites.
+type ForcePushRule struct {
+	upstream string
+	branches []string
+}
+
+// NewForcePushRule creates a new ForcePushRule from config.
+func NewForcePushRule(cfg config.Config) ForcePushRule {
+	return ForcePushRule{
+		upstream: cfg.ForcePush.Upstream,
+		branches: cfg.ForcePush.Branches,
+	}
+}
+
+// Name returns the rule name.
+func (r ForcePushRule) Name() string {
+	return "ForcePush"
+}
+
+// Metadata returns the documentation of the rule.
+func (r ForcePushRule) Metadata() domain.RuleMetadata {
+	return domain.RuleMetadata{
+		ID:       "forcepush",
+		Name:     r.Name(),
+		Category: domain.CategoryHistory,
+		Severity: domain.SeverityError,
+		Summary:  "Pushing HEAD needs no force push and rewrites no published commits",
+		Description: "Compares HEAD with force_push.upstream, a remote tracking branch such as " +
+			"origin/main, or with the upstream of the checked out branch. Warns when the " +
+			"upstream has commits HEAD does not contain, so that pushing needs a force push, " +
+			"and for each of them HEAD carries in rewritten form, found by author and author " +
+			"date. force_push.branches limits the check to target branches such as release/*.",
+		ErrorCodes: []domain.ValidationErrorCode{domain.ErrForcePushRequired, domain.ErrPublishedCommitRewritten},
+		ConfigKeys: []string{"force_push.upstream", "force_push.branches"},
+	}
+}
+
+// Validate compares HEAD with the upstream once per validation, in the repository-level
+// pass. Branches without an upstream are not checked.
+func (r ForcePushRule) Validate(commit domain.Commit, repo domain.Repository, _ config.Config) []domain.ValidationError {
+	// Divergence is a property of HEAD, not of the validated commits
+	if commit.Subject != "" || !r.appliesTo(repo) {
+		return nil
+	}
+
+	resolver, ok := repo.(domain.UpstreamResolver)
+	if !ok {
+		return nil
+	}
+
+	divergence, err := resolver.GetUpstreamDivergence(context.Background(), r.upstream)
+	if err != nil {
+		upstream := "its upstream"
+		if r.upstream != "" {
+			upstream = "'" + r.upstream + "'"
+		}
+
+		return []domain.ValidationError{
+			domain.New(r.Name(), domain.ErrGitOperationFailed,
+				"Failed to compare HEAD with "+upstream).
+				WithContextMap(map[string]string{
+					"actual":   err.Error(),
+					"expected": "resolvable upstream",
+				}).
+				WithHelp("Fetch the upstream, or set force_push.upstream to a remote tracking branch such as origin/main").
+				WithSeverity(domain.SeverityWarning),
+		}
+	}
+
+	if !divergence.RequiresForcePush() {
+		return nil
+	}
+
+	errors := []domain.ValidationError{
+		domain.New(r.Name(), domain.ErrForcePushRequired,
+			fmt.Sprintf("Pushing HEAD to %s requires a force push: %d published commits are not in HEAD",
+				divergence.Upstream, len(divergence.Behind))).
+			WithContextMap(map[string]string{
+				"actual":   strconv.Itoa(len(divergence.Behind)),
+				"expected": "0",
+				"upstream": divergence.Upstream,
+			}).
+			WithHelp(fmt.Sprintf("Rebase onto %s instead of rewriting it, or coordinate the force push with everyone using the branch", divergence.Upstream)).
+			WithSeverity(domain.SeverityWarning),
+	}
+
+	for _, rewritten := range divergence.RewrittenCommits() {
+		errors = append(errors,
+			domain.New(r.Name(), domain.ErrPublishedCommitRewritten,
+				fmt.Sprintf("Published commit %s '%s' is rewritten as %s",
+					domain.ShortHash(rewritten.Published.Hash), rewritten.Published.Subject, domain.ShortHash(rewritten.Rewrite.Hash))).
+				WithContextMap(map[string]string{
+					"actual":   domain.ShortHash(rewritten.Rewrite.Hash),
+					"commit":   domain.ShortHash(rewritten.Published.Hash),
+					"subject":  rewritten.Published.Subject,
+					"upstream": divergence.Upstream,
+				}).
+				WithHelp("Leave published commits as they are and add new commits on top").
+				WithSeverity(domain.SeverityWarning))
+	}
+
+	return errors
+}
+
+// appliesTo reports whether the target branch of repo is one the rule checks.
+func (r ForcePushRule) appliesTo(repo domain.Repository) bool {
+	if len(r.branches) == 0 {
+		return true
+	}
+
+	resolver, ok := repo.(domain.BranchResolver)
+	if !ok {
+		return false
+	}
+
+	branch, err := resolver.GetTargetBranch(context.Background())
+	if err != nil || branch == "" {
+		return false
+	}
+
+	for _, pattern := range r.branches {
+		if matched, err := path.Match(pattern, branch); err == nil && matched {
+			return true
+		}
+	}
+
+	return false
+}
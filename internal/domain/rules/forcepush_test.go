@@ -0,0 +1,101 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package rules_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/itiquette/gommitlint/internal/domain"
+	"github.com/itiquette/gommitlint/internal/domain/config"
+	"github.com/itiquette/gommitlint/internal/domain/rules"
+	"github.com/stretchr/testify/require"
+)
+
+// upstreamRepository is a repository stub that reports a fixed divergence from its upstream.
+type upstreamRepository struct {
+	branchRepository
+
+	divergence domain.UpstreamDivergence
+}
+
+func (r upstreamRepository) GetUpstreamDivergence(_ context.Context, _ string) (domain.UpstreamDivergence, error) {
+	return r.divergence, nil
+}
+
+func TestForcePushRule(t *testing.T) {
+	published := domain.Commit{
+		Hash: "1a2b3c4d5e6f", Subject: "feat: add login page",
+		AuthorEmail: "jane@example.com", CommitDate: "2025-01-02T10:00:00Z",
+	}
+	rewrite := published
+	rewrite.Hash = "9f8e7d6c5b4a"
+
+	unrelated := domain.Commit{
+		Hash: "abcdefabcdef", Subject: "fix: handle empty input",
+		AuthorEmail: "jane@example.com", CommitDate: "2025-01-03T10:00:00Z",
+	}
+
+	tests := []struct {
+		name          string
+		branches      []string
+		branch        string
+		divergence    domain.UpstreamDivergence
+		expectedCodes []domain.ValidationErrorCode
+	}{
+		{
+			name:       "fast-forward",
+			branch:     "main",
+			divergence: domain.UpstreamDivergence{Upstream: "origin/main", Ahead: []domain.Commit{unrelated}},
+		},
+		{
+			name:          "upstream has commits HEAD does not contain",
+			branch:        "main",
+			divergence:    domain.UpstreamDivergence{Upstream: "origin/main", Ahead: []domain.Commit{unrelated}, Behind: []domain.Commit{published}},
+			expectedCodes: []domain.ValidationErrorCode{domain.ErrForcePushRequired},
+		},
+		{
+			name:       "published commit rewritten",
+			branch:     "main",
+			divergence: domain.UpstreamDivergence{Upstream: "origin/main", Ahead: []domain.Commit{rewrite}, Behind: []domain.Commit{published}},
+			expectedCodes: []domain.ValidationErrorCode{
+				domain.ErrForcePushRequired, domain.ErrPublishedCommitRewritten,
+			},
+		},
+		{
+			name:          "target branch matching a glob",
+			branches:      []string{"release/*"},
+			branch:        "release/2.x",
+			divergence:    domain.UpstreamDivergence{Upstream: "origin/release/2.x", Behind: []domain.Commit{published}},
+			expectedCodes: []domain.ValidationErrorCode{domain.ErrForcePushRequired},
+		},
+		{
+			name:       "target branch not checked",
+			branches:   []string{"release/*"},
+			branch:     "feature/login",
+			divergence: domain.UpstreamDivergence{Upstream: "origin/feature/login", Behind: []domain.Commit{published}},
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			cfg := config.Config{ForcePush: config.ForcePushConfig{Branches: testCase.branches}}
+			repo := upstreamRepository{branchRepository: branchRepository{branch: testCase.branch}, divergence: testCase.divergence}
+
+			errors := rules.NewForcePushRule(cfg).Validate(domain.Commit{}, repo, cfg)
+
+			codes := make([]domain.ValidationErrorCode, 0, len(errors))
+			for _, err := range errors {
+				codes = append(codes, domain.ValidationErrorCode(err.Code))
+				require.Equal(t, domain.SeverityWarning, err.Severity)
+			}
+
+			require.ElementsMatch(t, testCase.expectedCodes, codes)
+
+			// Validated commits do not repeat the comparison
+			require.Empty(t, rules.NewForcePushRule(cfg).Validate(published, repo, cfg))
+		})
+	}
+}
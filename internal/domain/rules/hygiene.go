@@ -0,0 +1,135 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package rules
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/itiquette/gommitlint/internal/domain"
+	"github.com/itiquette/gommitlint/internal/domain/config"
+)
+
+// diffLinePattern finds the lines git diff writes before the changes of a file.
+var diffLinePattern = regexp.MustCompile(`^(diff --git a/|\+\+\+ b/|--- a/|@@ -[0-9]+(,[0-9]+)? \+[0-9]+(,[0-9]+)? @@)`)
+
+// conflictMarkerPattern finds the markers git writes around the sides of a conflict.
+var conflictMarkerPattern = regexp.MustCompile(`^(<{7}|\|{7}|>{7})( |$)`)
+
+// HygieneRule catches paste accidents in message bodies: bodies of whitespace only, and
+// bodies holding a diff or the markers of a merge conflict.
+type HygieneRule struct{}
+
+// NewHygieneRule creates a new HygieneRule.
+func NewHygieneRule(_ config.Config) HygieneRule {
+	return HygieneRule{}
+}
+
+// Name returns the rule name.
+func (r HygieneRule) Name() string {
+	return "Hygiene"
+}
+
+// Metadata returns the documentation of the rule.
+func (r HygieneRule) Metadata() domain.RuleMetadata {
+	return domain.RuleMetadata{
+		ID:       "hygiene",
+		Name:     r.Name(),
+		Category: domain.CategoryMessage,
+		Severity: domain.SeverityError,
+		Summary:  "No whitespace-only bodies, pasted diffs or conflict markers",
+		Description: "Reports a body of only spaces and tabs, as left by --cleanup=verbatim, a body " +
+			"with lines git diff writes (diff --git, --- a/, +++ b/ or a hunk header) at the start " +
+			"of a line, and the <<<<<<<, ||||||| and >>>>>>> markers of a merge conflict. Indented " +
+			"diffs are taken as quoted on purpose. In message files, lines starting with # and " +
+			"everything below the scissors line of git commit --verbose are ignored, as git " +
+			"removes them.",
+		ErrorCodes: []domain.ValidationErrorCode{
+			domain.ErrWhitespaceOnlyBody, domain.ErrDiffInBody, domain.ErrConflictMarkers,
+		},
+		Examples: []domain.RuleExample{
+			{Message: "fix: retry uploads\n\nUploads fail on flaky networks.", Valid: true},
+			{Message: "fix: retry uploads\n\ndiff --git a/upload.go b/upload.go", Note: "pasted diff"},
+			{Message: "fix: retry uploads\n\n<<<<<<< HEAD", Note: "conflict marker"},
+		},
+	}
+}
+
+// Validate checks the body of the commit message.
+func (r HygieneRule) Validate(commit domain.Commit, _ config.Config) []domain.ValidationError {
+	lines := bodyLines(commit)
+
+	body := strings.Join(lines, "\n")
+	if strings.TrimSpace(body) == "" {
+		if strings.Trim(body, "\n") == "" {
+			return nil
+		}
+
+		return []domain.ValidationError{
+			domain.New(r.Name(), domain.ErrWhitespaceOnlyBody, "Body consists only of whitespace").
+				WithContextMap(map[string]string{"actual": fmt.Sprintf("%q", body)}).
+				WithHelp("Remove the whitespace below the subject, or write a body"),
+		}
+	}
+
+	var (
+		errors       []domain.ValidationError
+		diffFound    bool
+		markersFound bool
+	)
+
+	// The lines below the subject start at line 2
+	for index, line := range lines {
+		lineNumber := index + 2
+
+		switch {
+		case !diffFound && diffLinePattern.MatchString(line):
+			diffFound = true
+
+			errors = append(errors,
+				domain.New(r.Name(), domain.ErrDiffInBody,
+					fmt.Sprintf("Body contains a diff at line %d: %s", lineNumber, line)).
+					WithContextMap(map[string]string{"actual": line}).
+					WithPosition(lineNumber, 1).
+					WithHelp("Remove the pasted diff; the commit already holds the changes. "+
+						"Indent a diff that is quoted on purpose"))
+		case !markersFound && conflictMarkerPattern.MatchString(line):
+			markersFound = true
+
+			errors = append(errors,
+				domain.New(r.Name(), domain.ErrConflictMarkers,
+					fmt.Sprintf("Body contains a conflict marker at line %d: %s", lineNumber, line)).
+					WithContextMap(map[string]string{"actual": line}).
+					WithPosition(lineNumber, 1).
+					WithHelp("Remove the conflict markers left from resolving a merge or rebase"))
+		}
+	}
+
+	return errors
+}
+
+// bodyLines returns the lines below the subject of the message of commit. The comments
+// git removes from message files are returned as empty lines, keeping the line numbers.
+func bodyLines(commit domain.Commit) []string {
+	_, rest, _ := strings.Cut(commit.FullMessage(), "\n")
+	messageFile := commit.Hash == ""
+
+	var lines []string
+
+	for _, line := range strings.Split(rest, "\n") {
+		if messageFile && line == scissorsLine {
+			break
+		}
+
+		if messageFile && isCommentLine(line) {
+			line = ""
+		}
+
+		lines = append(lines, line)
+	}
+
+	return lines
+}
@@ -0,0 +1,88 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package rules_test
+
+import (
+	"testing"
+
+	"github.com/itiquette/gommitlint/internal/domain"
+	"github.com/itiquette/gommitlint/internal/domain/config"
+	"github.com/itiquette/gommitlint/internal/domain/rules"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHygieneRule(t *testing.T) {
+	tests := []struct {
+		name            string
+		message         string
+		messageFile     bool
+		expectedCodes   []domain.ValidationErrorCode
+		expectedMessage string
+	}{
+		{name: "subject only", message: "fix: retry uploads\n"},
+		{name: "body", message: "fix: retry uploads\n\nUploads fail on flaky networks.\n\n    --- a/quoted.go\n"},
+		{
+			name:          "whitespace-only body",
+			message:       "fix: retry uploads\n\n  \t\n \n",
+			expectedCodes: []domain.ValidationErrorCode{domain.ErrWhitespaceOnlyBody},
+		},
+		{
+			name:            "pasted diff",
+			message:         "fix: retry uploads\n\nRetry them.\ndiff --git a/upload.go b/upload.go\n--- a/upload.go\n+++ b/upload.go",
+			expectedCodes:   []domain.ValidationErrorCode{domain.ErrDiffInBody},
+			expectedMessage: "Body contains a diff at line 4: diff --git a/upload.go b/upload.go",
+		},
+		{
+			name:          "hunk",
+			message:       "fix: retry uploads\n\n@@ -10,3 +10,4 @@ func upload() {",
+			expectedCodes: []domain.ValidationErrorCode{domain.ErrDiffInBody},
+		},
+		{
+			name:            "conflict markers",
+			message:         "fix: retry uploads\n\n<<<<<<< HEAD\nRetry them.\n=======\nRetry them twice.\n>>>>>>> topic",
+			expectedCodes:   []domain.ValidationErrorCode{domain.ErrConflictMarkers},
+			expectedMessage: "Body contains a conflict marker at line 3: <<<<<<< HEAD",
+		},
+		{
+			name:          "diff and conflict markers",
+			message:       "fix: retry uploads\n\n+++ b/upload.go\n>>>>>>> topic",
+			expectedCodes: []domain.ValidationErrorCode{domain.ErrDiffInBody, domain.ErrConflictMarkers},
+		},
+		{
+			name:        "verbose message file",
+			message:     "fix: retry uploads\n\n# Please enter the commit message for your changes.\n# ------------------------ >8 ------------------------\ndiff --git a/upload.go b/upload.go\n",
+			messageFile: true,
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			cfg := config.NewDefault()
+
+			hash := "abc123"
+			if testCase.messageFile {
+				hash = ""
+			}
+
+			commit := domain.NewCommit(hash, testCase.message, "Dev Eloper", "dev@example.com", "", "", false)
+			errors := rules.NewHygieneRule(cfg).Validate(commit, cfg)
+
+			codes := make([]domain.ValidationErrorCode, 0, len(errors))
+			for _, err := range errors {
+				codes = append(codes, domain.ValidationErrorCode(err.Code))
+			}
+
+			if testCase.expectedCodes == nil {
+				testCase.expectedCodes = []domain.ValidationErrorCode{}
+			}
+
+			require.Equal(t, testCase.expectedCodes, codes)
+
+			if testCase.expectedMessage != "" {
+				require.Equal(t, testCase.expectedMessage, errors[0].Message)
+			}
+		})
+	}
+}
@@ -5,6 +5,8 @@
 package rules
 
 import (
+	"context"
+	"fmt"
 	"regexp"
 	"strings"
 
@@ -12,9 +14,12 @@ import (
 	"github.com/itiquette/gommitlint/internal/domain/config"
 )
 
-// IdentityRule validates that commit authors are in the allowed list.
+// IdentityRule validates that commit authors are in the allowed list or, for a key scope,
+// that the commits in the scope are signed by one of its keys.
 type IdentityRule struct {
 	allowedAuthors []string
+	scope          config.KeyScopeConfig
+	verifier       domain.SignatureVerifier
 }
 
 // NewIdentityRule creates a new rule for validating author identity from config.
@@ -24,8 +29,25 @@ func NewIdentityRule(cfg config.Config) IdentityRule {
 	}
 }
 
+// NewKeyScopeIdentityRule creates the rule requiring commits to be signed by a key in the
+// directory of scope, verified with verifier. The rule checks every commit it is given;
+// KeyScopeCondition selects the commits in the scope.
+func NewKeyScopeIdentityRule(scope config.KeyScopeConfig, verifier domain.SignatureVerifier) IdentityRule {
+	return IdentityRule{scope: scope, verifier: verifier}
+}
+
+// KeyScopeCondition returns the condition matching the commits in scope: those of its
+// authors, changing its paths, or both when both are set.
+func KeyScopeCondition(scope config.KeyScopeConfig) config.RuleCondition {
+	return config.RuleCondition{Authors: scope.Authors, Paths: scope.Paths}
+}
+
 // Validate validates that commit authors are in the allowed authors list.
 func (r IdentityRule) Validate(commit domain.Commit, _ config.Config) []domain.ValidationError {
+	if r.verifier != nil {
+		return r.validateKeyScope(commit)
+	}
+
 	// If no allowed authors configured, allow all authors
 	if len(r.allowedAuthors) == 0 {
 		return nil
@@ -34,31 +56,102 @@ func (r IdentityRule) Validate(commit domain.Commit, _ config.Config) []domain.V
 	return r.validateAuthorIdentity(commit)
 }
 
-// Name returns the rule name.
+// Name returns the rule name, followed by the name of the key scope for key scopes.
 func (r IdentityRule) Name() string {
+	if r.scope.Name != "" {
+		return "Identity (" + r.scope.Name + ")"
+	}
+
 	return "Identity"
 }
 
+// Metadata returns the documentation of the rule.
+func (r IdentityRule) Metadata() domain.RuleMetadata {
+	return domain.RuleMetadata{
+		ID:       "identity",
+		Name:     r.Name(),
+		Category: domain.CategorySignature,
+		Severity: domain.SeverityError,
+		Summary:  "Allowed commit authors",
+		Description: "Requires the commit author, after mapping through .mailmap, to be listed " +
+			"in identity.allowed_authors. Nothing is checked while the list is empty. The author " +
+			"and the entry it matched are reported as details of the rule. Each of " +
+			"identity.key_scopes adds a rule named after the scope, which requires the commits " +
+			"of its authors or changing its paths to be signed by a key in its directory.",
+		ErrorCodes: []domain.ValidationErrorCode{domain.ErrKeyNotTrusted, domain.ErrMissingSignature},
+		ConfigKeys: []string{"identity.allowed_authors", "identity.key_scopes"},
+	}
+}
+
 // validateAuthorIdentity checks if commit author is in allowed list.
 func (r IdentityRule) validateAuthorIdentity(commit domain.Commit) []domain.ValidationError {
 	authorString := commit.Author + " <" + commit.AuthorEmail + ">"
 
 	for _, allowedAuthor := range r.allowedAuthors {
 		if r.matchesAuthor(allowedAuthor, authorString, commit.AuthorEmail) {
-			return nil
+			return []domain.ValidationError{
+				domain.NewDetails(r.Name(), map[string]string{
+					"author":         authorString,
+					"allowed_author": allowedAuthor,
+					"identity_match": "true",
+				}),
+			}
 		}
 	}
 
 	return []domain.ValidationError{
 		domain.New(r.Name(), domain.ErrKeyNotTrusted, "Author not authorized").
 			WithContextMap(map[string]string{
-				"actual":   commit.AuthorEmail,
-				"expected": strings.Join(r.allowedAuthors, ", "),
+				"actual":         commit.AuthorEmail,
+				"expected":       strings.Join(r.allowedAuthors, ", "),
+				"author":         authorString,
+				"identity_match": "false",
 			}).
 			WithHelp("Use an authorized identity or add this author to the allowed authors list"),
 	}
 }
 
+// validateKeyScope checks that commit is signed by a key in the directory of the scope.
+func (r IdentityRule) validateKeyScope(commit domain.Commit) []domain.ValidationError {
+	// A message being written is signed when git creates its commit
+	if commit.Hash == "" {
+		return nil
+	}
+
+	expected := "signed by a key in " + r.scope.Directory
+
+	if !commit.IsSigned() {
+		return []domain.ValidationError{
+			domain.New(r.Name(), domain.ErrMissingSignature,
+				fmt.Sprintf("Commit in key scope %s is not signed", r.scope.Name)).
+				WithContextMap(map[string]string{
+					"actual":    "no signature",
+					"expected":  expected,
+					"key_scope": r.scope.Name,
+				}).
+				WithHelp("Sign the commit with a key whose public key is in " + r.scope.Directory),
+		}
+	}
+
+	result := r.verifier.VerifyCommit(context.Background(), commit, r.scope.Directory)
+
+	details := domain.VerificationContext(commit, result)
+	details["key_scope"] = r.scope.Name
+
+	if result.IsVerified() {
+		return []domain.ValidationError{domain.NewDetails(r.Name(), details)}
+	}
+
+	details["expected"] = expected
+
+	return []domain.ValidationError{
+		domain.New(r.Name(), domain.ErrKeyNotTrusted,
+			fmt.Sprintf("Commit in key scope %s is not signed by a key of the scope: %s", r.scope.Name, result.ErrorMessage())).
+			WithContextMap(details).
+			WithHelp("Sign the commit with a key whose public key is in " + r.scope.Directory),
+	}
+}
+
 // matchesAuthor checks if an allowed author matches the commit author.
 func (r IdentityRule) matchesAuthor(allowedAuthor, authorString, authorEmail string) bool {
 	// Exact match for full format
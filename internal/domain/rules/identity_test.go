@@ -174,7 +174,9 @@ func TestIdentityRule_AllowedSigners(t *testing.T) {
 
 			// Verify results
 			if testCase.expectedValid {
-				require.Empty(t, failures, "Expected no validation errors but got: %v", failures)
+				require.Len(t, failures, 1, "Expected only the details of the rule but got: %v", failures)
+				require.True(t, failures[0].IsDetails())
+				require.Equal(t, "true", failures[0].Context["identity_match"])
 			} else {
 				require.NotEmpty(t, failures, "Expected validation errors but got none")
 			}
@@ -237,7 +239,9 @@ func TestIdentityRule_RuleDisabled(t *testing.T) {
 
 			// Verify results
 			if testCase.expectedValid {
-				require.Empty(t, failures, "Expected no validation errors but got: %v", failures)
+				require.Len(t, failures, 1, "Expected only the details of the rule but got: %v", failures)
+				require.True(t, failures[0].IsDetails())
+				require.Equal(t, "true", failures[0].Context["identity_match"])
 			} else {
 				require.NotEmpty(t, failures, "Expected validation errors but got none")
 			}
@@ -363,3 +367,58 @@ func TestIdentityRule_IdentityMatching(t *testing.T) {
 		})
 	}
 }
+
+func TestIdentityRule_KeyScope(t *testing.T) {
+	scope := config.KeyScopeConfig{Name: "payments", Directory: "keys/payments", Paths: []string{"payments/**"}}
+	signature := domain.NewSignature(validSSHSignature)
+	cfg := config.NewDefault()
+
+	t.Run("signed by a key of the scope", func(t *testing.T) {
+		verifier := &fakeVerifier{result: domain.NewVerificationResult(domain.VerificationStatusVerified,
+			domain.NewIdentity("Dev", "dev@example.com"), signature)}
+		rule := rules.NewKeyScopeIdentityRule(scope, verifier)
+
+		failures := rule.Validate(createIdentityTestCommit("Dev", "dev@example.com", validSSHSignature), cfg)
+
+		require.Equal(t, "Identity (payments)", rule.Name())
+		require.Equal(t, "identity", rule.Metadata().ID)
+		require.Equal(t, "keys/payments", verifier.keyDir)
+		require.Len(t, failures, 1)
+		require.True(t, failures[0].IsDetails())
+		require.Equal(t, "payments", failures[0].Context["key_scope"])
+	})
+
+	t.Run("signed by a key outside the scope", func(t *testing.T) {
+		verifier := &fakeVerifier{result: domain.NewVerificationResult(domain.VerificationStatusNoKey,
+			domain.Identity{}, signature).WithError(string(domain.ErrNoKeyDir), "no key verifies the signature")}
+
+		failures := rules.NewKeyScopeIdentityRule(scope, verifier).
+			Validate(createIdentityTestCommit("Dev", "dev@example.com", validSSHSignature), cfg)
+
+		require.Len(t, failures, 1)
+		require.Equal(t, string(domain.ErrKeyNotTrusted), failures[0].Code)
+		require.Contains(t, failures[0].Message, "not signed by a key of the scope")
+	})
+
+	t.Run("unsigned commit", func(t *testing.T) {
+		failures := rules.NewKeyScopeIdentityRule(scope, &fakeVerifier{}).
+			Validate(createIdentityTestCommit("Dev", "dev@example.com", ""), cfg)
+
+		require.Len(t, failures, 1)
+		require.Equal(t, string(domain.ErrMissingSignature), failures[0].Code)
+	})
+
+	t.Run("message file", func(t *testing.T) {
+		commit := createIdentityTestCommit("Dev", "dev@example.com", "")
+		commit.Hash = ""
+
+		require.Empty(t, rules.NewKeyScopeIdentityRule(scope, &fakeVerifier{}).Validate(commit, cfg))
+	})
+
+	t.Run("condition selects the commits of the scope", func(t *testing.T) {
+		condition := rules.KeyScopeCondition(scope)
+
+		require.Equal(t, []string{"payments/**"}, condition.Paths)
+		require.Empty(t, condition.Authors)
+	})
+}
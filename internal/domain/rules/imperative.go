@@ -8,19 +8,72 @@ import (
 	"fmt"
 	"regexp"
 	"strings"
+	"unicode"
 
 	"github.com/kljensen/snowball"
 
 	"github.com/itiquette/gommitlint/internal/domain"
+	"github.com/itiquette/gommitlint/internal/domain/config"
 )
 
 // ImperativeValidator provides sophisticated imperative mood validation using Snowball stemming.
 // This is a modular component that can be used by any rule that needs imperative validation.
-type ImperativeValidator struct{}
+type ImperativeValidator struct {
+	verbs            []string        // Verbs accepted in allowlist mode, nil in heuristic mode
+	allowedVerbs     map[string]bool // Lowercase verbs
+	language         string          // ImperativeEnglish or a key of imperativeLanguages
+	skipNonASCII     bool            // Accept first words with letters outside ASCII
+	customExceptions map[string]bool // Words accepted as imperative verbs
+	customNonVerbs   map[string]bool // Words rejected as non-imperative starters
+}
+
+// defaultImperativeVerbs are the verbs accepted in allowlist mode when none are configured.
+var defaultImperativeVerbs = []string{
+	"add", "fix", "update", "remove", "refactor", "allow", "avoid", "bump", "change", "clean",
+	"configure", "correct", "create", "deprecate", "disable", "document", "drop", "enable",
+	"ensure", "extract", "handle", "implement", "improve", "include", "initialize", "introduce",
+	"make", "merge", "migrate", "move", "optimize", "prepare", "prevent", "reformat", "release",
+	"rename", "reorder", "replace", "require", "restore", "revert", "rework", "rewrite", "set",
+	"simplify", "skip", "split", "support", "switch", "test", "tidy", "upgrade", "use", "validate",
+}
+
+// NewImperativeValidator creates a new imperative validator for the mode and language of
+// cfg, recognizing English verbs by their form when they are empty or unknown. The custom
+// exceptions are accepted as imperative verbs and the custom non-verbs rejected, in
+// addition to the built-in lists; all words are matched ignoring case.
+func NewImperativeValidator(cfg config.ImperativeConfig) *ImperativeValidator {
+	language := cfg.Language
+	if _, known := imperativeLanguages[language]; !known {
+		language = ImperativeEnglish
+	}
+
+	var verbs []string
+
+	if cfg.Mode == "allowlist" {
+		verbs = cfg.Verbs
+		if len(verbs) == 0 {
+			verbs = defaultImperativeVerbs
+		}
+	}
+
+	return &ImperativeValidator{
+		verbs:            verbs,
+		allowedVerbs:     lowerWordSet(verbs),
+		language:         language,
+		skipNonASCII:     cfg.SkipNonASCII,
+		customExceptions: lowerWordSet(cfg.CustomExceptions),
+		customNonVerbs:   lowerWordSet(cfg.CustomNonVerbs),
+	}
+}
+
+// lowerWordSet returns the words as a set of lowercase words.
+func lowerWordSet(words []string) map[string]bool {
+	set := make(map[string]bool, len(words))
+	for _, word := range words {
+		set[strings.ToLower(strings.TrimSpace(word))] = true
+	}
 
-// NewImperativeValidator creates a new imperative validator.
-func NewImperativeValidator() *ImperativeValidator {
-	return &ImperativeValidator{}
+	return set
 }
 
 // ValidateImperative performs sophisticated imperative mood validation using linguistic analysis.
@@ -85,8 +138,8 @@ func (v *ImperativeValidator) extractValidationText(subject string, isConvention
 
 // extractFirstWord extracts the first word from the text to validate.
 func (v *ImperativeValidator) extractFirstWord(text, ruleName string) (string, *domain.ValidationError) {
-	// Use regex to extract first word (alphanumeric characters)
-	firstWordRegex := regexp.MustCompile(`^\s*([a-zA-Z0-9]+)`)
+	// Use regex to extract first word (letters and digits of any script)
+	firstWordRegex := regexp.MustCompile(`^\s*([\p{L}\p{N}]+)`)
 	matches := firstWordRegex.FindStringSubmatch(text)
 
 	if len(matches) < 2 {
@@ -107,8 +160,23 @@ func (v *ImperativeValidator) extractFirstWord(text, ruleName string) (string, *
 func (v *ImperativeValidator) analyzeWord(word, subject, ruleName string) []domain.ValidationError {
 	wordLower := strings.ToLower(word)
 
+	// Configured exceptions are imperative verbs whatever they look like
+	if v.customExceptions[wordLower] {
+		return nil
+	}
+
+	// Words in another script or with accents are rarely in the language checked
+	if v.skipNonASCII && !isASCII(word) {
+		return nil
+	}
+
 	// Check for non-imperative starters (articles, pronouns, etc.)
 	if v.isNonImperativeStarter(wordLower) {
+		help := "Start with an imperative verb like: add, fix, update, remove, implement"
+		if v.language != ImperativeEnglish {
+			help = "Start with a verb in the imperative or the infinitive"
+		}
+
 		return []domain.ValidationError{
 			domain.New(ruleName, domain.ErrNonVerb,
 				fmt.Sprintf("'%s' is not a verb", word)).
@@ -117,10 +185,18 @@ func (v *ImperativeValidator) analyzeWord(word, subject, ruleName string) []doma
 					"type":    "non_verb",
 					"subject": subject,
 				}).
-				WithHelp("Start with an imperative verb like: add, fix, update, remove, implement"),
+				WithHelp(help),
 		}
 	}
 
+	if v.verbs != nil {
+		return v.validateAllowed(wordLower, word, subject, ruleName)
+	}
+
+	if language, found := imperativeLanguages[v.language]; found {
+		return v.validateInflection(language, wordLower, word, subject, ruleName)
+	}
+
 	// Use Snowball stemming for sophisticated analysis
 	stem, err := snowball.Stem(wordLower, "english", true)
 	if err != nil {
@@ -132,6 +208,67 @@ func (v *ImperativeValidator) analyzeWord(word, subject, ruleName string) []doma
 	return v.validateWithStemming(wordLower, word, stem, subject, ruleName)
 }
 
+// validateAllowed validates that a word is one of the verbs of allowlist mode, suggesting
+// the closest allowed verb when there is one.
+func (v *ImperativeValidator) validateAllowed(wordLower, originalWord, subject, ruleName string) []domain.ValidationError {
+	if v.allowedVerbs[wordLower] {
+		return nil
+	}
+
+	examples := v.verbs[:min(len(v.verbs), 5)]
+	help := "Start with one of the allowed verbs, such as: " + strings.Join(examples, ", ")
+
+	validationErr := domain.New(ruleName, domain.ErrVerbNotAllowed,
+		fmt.Sprintf("'%s' is not an allowed verb", originalWord)).
+		WithContextMap(map[string]string{
+			"actual":   originalWord,
+			"expected": strings.Join(v.verbs, ", "),
+			"type":     "verb_not_allowed",
+			"subject":  subject,
+		})
+
+	if closest := domain.ClosestMatch(wordLower, v.verbs); closest != "" {
+		suggestion := matchCapitalization(closest, originalWord)
+		validationErr = validationErr.WithSuggestion(suggestion)
+		help = fmt.Sprintf("Replace '%s' with the allowed verb '%s'", originalWord, suggestion)
+	}
+
+	return []domain.ValidationError{validationErr.WithHelp(help)}
+}
+
+// matchCapitalization returns word with its first letter in the case of the first letter of model.
+func matchCapitalization(word, model string) string {
+	wordRunes := []rune(strings.ToLower(word))
+	modelRunes := []rune(model)
+
+	if len(wordRunes) > 0 && len(modelRunes) > 0 && unicode.IsUpper(modelRunes[0]) {
+		wordRunes[0] = unicode.ToUpper(wordRunes[0])
+	}
+
+	return string(wordRunes)
+}
+
+// validateInflection validates a word of a language other than English by its ending.
+func (v *ImperativeValidator) validateInflection(language imperativeLanguage, wordLower, originalWord, subject, ruleName string) []domain.ValidationError {
+	form, found := language.findInflection(wordLower)
+	if !found {
+		return nil
+	}
+
+	return []domain.ValidationError{
+		domain.New(ruleName, domain.ErrNonImperative,
+			fmt.Sprintf("'%s' looks like a %s, not an imperative", originalWord, form.form)).
+			WithContextMap(map[string]string{
+				"actual":   originalWord,
+				"type":     "non_imperative",
+				"form":     form.form,
+				"language": v.language,
+				"subject":  subject,
+			}).
+			WithHelp(fmt.Sprintf("Start with a verb in the imperative or the infinitive instead of the %s '%s'", form.form, originalWord)),
+	}
+}
+
 // validateWithStemming validates using Snowball stemming results.
 func (v *ImperativeValidator) validateWithStemming(wordLower, originalWord, stem, subject, ruleName string) []domain.ValidationError {
 	// Check for past tense (word ends with 'ed' and stem is different)
@@ -145,11 +282,11 @@ func (v *ImperativeValidator) validateWithStemming(wordLower, originalWord, stem
 				domain.New(ruleName, domain.ErrPastTense,
 					fmt.Sprintf("'%s' is past tense", originalWord)).
 					WithContextMap(map[string]string{
-						"actual":     originalWord,
-						"subject":    subject,
-						"suggestion": suggestion,
-						"type":       "past_tense",
+						"actual":  originalWord,
+						"subject": subject,
+						"type":    "past_tense",
 					}).
+					WithSuggestion(suggestion).
 					WithHelp(fmt.Sprintf("Use the base form '%s' instead of past tense '%s'", suggestion, originalWord)),
 			}
 		}
@@ -163,11 +300,11 @@ func (v *ImperativeValidator) validateWithStemming(wordLower, originalWord, stem
 			domain.New(ruleName, domain.ErrGerund,
 				fmt.Sprintf("'%s' is a gerund (present participle)", originalWord)).
 				WithContextMap(map[string]string{
-					"actual":     originalWord,
-					"subject":    subject,
-					"suggestion": suggestion,
-					"type":       "gerund",
+					"actual":  originalWord,
+					"subject": subject,
+					"type":    "gerund",
 				}).
+				WithSuggestion(suggestion).
 				WithHelp(fmt.Sprintf("Use the base form '%s' instead of gerund '%s'", suggestion, originalWord)),
 		}
 	}
@@ -182,11 +319,11 @@ func (v *ImperativeValidator) validateWithStemming(wordLower, originalWord, stem
 				domain.New(ruleName, domain.ErrThirdPerson,
 					fmt.Sprintf("'%s' is third person singular", originalWord)).
 					WithContextMap(map[string]string{
-						"actual":     originalWord,
-						"subject":    subject,
-						"suggestion": suggestion,
-						"type":       "third_person",
+						"actual":  originalWord,
+						"subject": subject,
+						"type":    "third_person",
 					}).
+					WithSuggestion(suggestion).
 					WithHelp(fmt.Sprintf("Use the base form '%s' instead of third person '%s'", suggestion, originalWord)),
 			}
 		}
@@ -207,11 +344,11 @@ func (v *ImperativeValidator) validateWithSimpleRules(wordLower, originalWord, s
 			domain.New(ruleName, domain.ErrPastTense,
 				fmt.Sprintf("'%s' appears to be past tense", originalWord)).
 				WithContextMap(map[string]string{
-					"actual":     originalWord,
-					"type":       "past_tense",
-					"subject":    subject,
-					"suggestion": suggestion,
+					"actual":  originalWord,
+					"type":    "past_tense",
+					"subject": subject,
 				}).
+				WithSuggestion(suggestion).
 				WithHelp(fmt.Sprintf("Try using '%s' instead of '%s'", suggestion, originalWord)),
 		}
 	}
@@ -223,11 +360,11 @@ func (v *ImperativeValidator) validateWithSimpleRules(wordLower, originalWord, s
 			domain.New(ruleName, domain.ErrGerund,
 				fmt.Sprintf("'%s' appears to be a gerund", originalWord)).
 				WithContextMap(map[string]string{
-					"actual":     originalWord,
-					"type":       "gerund",
-					"subject":    subject,
-					"suggestion": suggestion,
+					"actual":  originalWord,
+					"type":    "gerund",
+					"subject": subject,
 				}).
+				WithSuggestion(suggestion).
 				WithHelp(fmt.Sprintf("Try using '%s' instead of '%s'", suggestion, originalWord)),
 		}
 	}
@@ -239,11 +376,11 @@ func (v *ImperativeValidator) validateWithSimpleRules(wordLower, originalWord, s
 			domain.New(ruleName, domain.ErrThirdPerson,
 				fmt.Sprintf("'%s' appears to be third person", originalWord)).
 				WithContextMap(map[string]string{
-					"actual":     originalWord,
-					"type":       "third_person",
-					"subject":    subject,
-					"suggestion": suggestion,
+					"actual":  originalWord,
+					"type":    "third_person",
+					"subject": subject,
 				}).
+				WithSuggestion(suggestion).
 				WithHelp(fmt.Sprintf("Try using '%s' instead of '%s'", suggestion, originalWord)),
 		}
 	}
@@ -284,7 +421,22 @@ func (v *ImperativeValidator) isNonImperativeStarter(word string) bool {
 		"all": true, "some": true, "every": true, "no": true, "any": true,
 	}
 
-	return nonImperativeStarters[word]
+	if language, found := imperativeLanguages[v.language]; found {
+		nonImperativeStarters = language.nonVerbs
+	}
+
+	return nonImperativeStarters[word] || v.customNonVerbs[word]
+}
+
+// isASCII reports whether word has only ASCII characters.
+func isASCII(word string) bool {
+	for _, char := range word {
+		if char > unicode.MaxASCII {
+			return false
+		}
+	}
+
+	return true
 }
 
 // isBaseFormEndingWithED checks if a word ending with 'ed' is actually a base form.
@@ -0,0 +1,116 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package rules
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/kljensen/snowball"
+)
+
+// Languages of the imperative mood check, by message.subject.imperative.language.
+const (
+	ImperativeEnglish = "en"
+	ImperativeFrench  = "fr"
+	ImperativeSpanish = "es"
+	ImperativeSwedish = "sv"
+)
+
+// imperativeLanguage describes how subjects written in a language other than English are
+// checked. Commits in these languages start with an imperative or an infinitive, so
+// only endings of other verb forms are reported.
+type imperativeLanguage struct {
+	stemmer     string          // Snowball stemmer confirming that an ending is an inflection
+	nonVerbs    map[string]bool // Pronouns, articles and determiners
+	inflections []inflection    // Endings of verb forms that are not imperative
+}
+
+// inflection is the ending of a verb form that does not start an imperative subject.
+type inflection struct {
+	suffix string
+	form   string // Name of the verb form in messages
+}
+
+// imperativeLanguages holds the languages other than English, which is checked with the
+// English-specific rules of ImperativeValidator.
+var imperativeLanguages = map[string]imperativeLanguage{
+	ImperativeFrench: {
+		stemmer: "french",
+		nonVerbs: wordSet("je", "nous", "il", "elle", "ils", "elles", "on",
+			"le", "la", "les", "un", "une", "des", "du",
+			"ce", "cet", "cette", "ces", "mon", "ma", "mes", "notre", "nos", "tout", "tous"),
+		inflections: sortedInflections(
+			inflection{"é", "past participle"}, inflection{"ée", "past participle"},
+			inflection{"és", "past participle"}, inflection{"ées", "past participle"},
+			inflection{"ant", "present participle"}, inflection{"ait", "imperfect"},
+		),
+	},
+	ImperativeSpanish: {
+		stemmer: "spanish",
+		nonVerbs: wordSet("yo", "nosotros", "ellos", "ellas",
+			"el", "la", "los", "las", "un", "una", "unos", "unas",
+			"este", "esta", "estos", "estas", "ese", "esa", "mi", "mis", "su", "sus", "nuestro", "nuestra", "todo", "todos"),
+		inflections: sortedInflections(
+			inflection{"ado", "past participle"}, inflection{"ada", "past participle"},
+			inflection{"ados", "past participle"}, inflection{"adas", "past participle"},
+			inflection{"ido", "past participle"}, inflection{"ida", "past participle"},
+			inflection{"idos", "past participle"}, inflection{"idas", "past participle"},
+			inflection{"ó", "preterite"}, inflection{"aron", "preterite"}, inflection{"ieron", "preterite"},
+			inflection{"aba", "imperfect"},
+			inflection{"ando", "gerund"}, inflection{"iendo", "gerund"}, inflection{"yendo", "gerund"},
+		),
+	},
+	ImperativeSwedish: {
+		stemmer: "swedish",
+		nonVerbs: wordSet("jag", "vi", "de", "han", "hon", "den", "det", "en", "ett",
+			"denna", "detta", "dessa", "min", "mitt", "mina", "vår", "vårt", "våra", "alla", "några"),
+		inflections: sortedInflections(
+			inflection{"ade", "past tense"}, inflection{"de", "past tense"}, inflection{"te", "past tense"},
+			inflection{"at", "supine"},
+			inflection{"ar", "present tense"}, inflection{"er", "present tense"},
+		),
+	},
+}
+
+// findInflection returns the verb form of word, which must be lowercase, when it ends
+// like a form that is not imperative and the stemmer agrees that the ending is an
+// inflection rather than part of the stem.
+func (l imperativeLanguage) findInflection(word string) (inflection, bool) {
+	for _, candidate := range l.inflections {
+		// Leave a stem of at least two letters, so short words are not mistaken for inflections
+		if !strings.HasSuffix(word, candidate.suffix) || len([]rune(word))-len([]rune(candidate.suffix)) < 2 {
+			continue
+		}
+
+		if stem, err := snowball.Stem(word, l.stemmer, true); err == nil && stem == word {
+			continue
+		}
+
+		return candidate, true
+	}
+
+	return inflection{}, false
+}
+
+// wordSet returns the words as a set.
+func wordSet(words ...string) map[string]bool {
+	set := make(map[string]bool, len(words))
+	for _, word := range words {
+		set[word] = true
+	}
+
+	return set
+}
+
+// sortedInflections returns the inflections with the longest endings first, so that the
+// most specific ending is reported.
+func sortedInflections(inflections ...inflection) []inflection {
+	sort.SliceStable(inflections, func(i, j int) bool {
+		return len([]rune(inflections[i].suffix)) > len([]rune(inflections[j].suffix))
+	})
+
+	return inflections
+}
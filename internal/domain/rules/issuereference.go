@@ -0,0 +1,126 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package rules
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/itiquette/gommitlint/internal/domain"
+	"github.com/itiquette/gommitlint/internal/domain/config"
+)
+
+// issueReferencePattern finds #123 and owner/repo#123 after a space or an opening
+// punctuation mark, so that anchors of URLs and words such as C#1 are not references.
+var issueReferencePattern = regexp.MustCompile(`(?:^|[\s(\[,;:])((?:[A-Za-z0-9][A-Za-z0-9-]*/[A-Za-z0-9._-]+)?#[0-9]+)\b`)
+
+// IssueReferenceRule checks that the GitHub issues referenced by a commit message exist
+// and, optionally, are open.
+type IssueReferenceRule struct {
+	tracker     TicketTracker
+	requireOpen bool
+}
+
+// NewIssueReferenceRule creates a new IssueReferenceRule looking up issues in tracker,
+// which is nil when no repository is known.
+func NewIssueReferenceRule(tracker TicketTracker, cfg config.Config) IssueReferenceRule {
+	return IssueReferenceRule{
+		tracker:     tracker,
+		requireOpen: cfg.Issues.RequireOpen,
+	}
+}
+
+// Name returns the rule name.
+func (r IssueReferenceRule) Name() string {
+	return "IssueReference"
+}
+
+// Metadata returns the documentation of the rule.
+func (r IssueReferenceRule) Metadata() domain.RuleMetadata {
+	return domain.RuleMetadata{
+		ID:       "issuereference",
+		Name:     r.Name(),
+		Category: domain.CategoryReference,
+		Severity: domain.SeverityError,
+		Summary:  "Referenced GitHub issues exist, optionally open",
+		Description: "Looks up every #123 reference of the message in the GitHub repository " +
+			"issues.repository, or GITHUB_REPOSITORY when that is not set, and owner/repo#123 " +
+			"references in their own repository. Pull requests count as issues. With " +
+			"issues.require_open closed issues are reported too. A lookup that fails, for " +
+			"example when the rate limit is used up and the issue is not cached, is reported " +
+			"as a warning.",
+		ErrorCodes: []domain.ValidationErrorCode{
+			domain.ErrIssueNotFound, domain.ErrIssueClosed, domain.ErrIssueLookupFailed,
+		},
+		ConfigKeys: []string{"issues.repository", "issues.require_open", "issues.timeout", "issues.cache_ttl"},
+		Examples: []domain.RuleExample{
+			{Message: "fix: retry uploads\n\nFixes #42", Valid: true, Note: "when issue 42 exists"},
+			{Message: "fix: retry uploads\n\nFixes #4200", Note: "when issue 4200 does not exist"},
+		},
+	}
+}
+
+// Validate looks up the issues referenced by the commit message.
+func (r IssueReferenceRule) Validate(commit domain.Commit, _ config.Config) []domain.ValidationError {
+	references := issueReferences(commit.Subject + "\n" + commit.Body)
+	if len(references) == 0 {
+		return nil
+	}
+
+	if r.tracker == nil {
+		return []domain.ValidationError{
+			domain.New(r.Name(), domain.ErrIssueLookupFailed,
+				fmt.Sprintf("Could not look up %s: no GitHub repository configured", references[0])).
+				WithContextMap(map[string]string{"actual": references[0]}).
+				WithSeverity(domain.SeverityWarning).
+				WithHelp("Set issues.repository to owner/repo, or run where GITHUB_REPOSITORY is set"),
+		}
+	}
+
+	var errors []domain.ValidationError
+
+	for _, reference := range references {
+		ticket, err := r.tracker.LookupTicket(reference)
+		if err != nil {
+			return append(errors,
+				domain.New(r.Name(), domain.ErrIssueLookupFailed,
+					fmt.Sprintf("Could not look up %s on GitHub: %v", reference, err)).
+					WithContextMap(map[string]string{"actual": reference}).
+					WithSeverity(domain.SeverityWarning).
+					WithHelp("Check issues.repository, GITHUB_TOKEN and the network"))
+		}
+
+		switch {
+		case !ticket.Exists:
+			errors = append(errors,
+				domain.New(r.Name(), domain.ErrIssueNotFound, fmt.Sprintf("Issue %s does not exist in %s", reference, ticket.Project)).
+					WithContextMap(map[string]string{"actual": reference, "repository": ticket.Project}).
+					WithHelp("Check the issue number and issues.repository"))
+		case r.requireOpen && ticket.Closed:
+			errors = append(errors,
+				domain.New(r.Name(), domain.ErrIssueClosed, fmt.Sprintf("Issue %s is closed", reference)).
+					WithContextMap(map[string]string{"actual": reference}).
+					WithHelp("Reference an open issue, or reopen the issue"))
+		}
+	}
+
+	return errors
+}
+
+// issueReferences returns the distinct issue references in text, in order.
+func issueReferences(text string) []string {
+	var references []string
+
+	seen := make(map[string]bool)
+
+	for _, match := range issueReferencePattern.FindAllStringSubmatch(text, -1) {
+		if !seen[match[1]] {
+			seen[match[1]] = true
+			references = append(references, match[1])
+		}
+	}
+
+	return references
+}
@@ -0,0 +1,117 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package rules_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/itiquette/gommitlint/internal/domain"
+	"github.com/itiquette/gommitlint/internal/domain/config"
+	"github.com/itiquette/gommitlint/internal/domain/rules"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIssueReferenceRule(t *testing.T) {
+	tracker := &fakeTracker{tickets: map[string]domain.Ticket{
+		"#1":           {Key: "o/r#1", Exists: true, Project: "o/r", Status: "open"},
+		"#2":           {Key: "o/r#2", Exists: true, Project: "o/r", Status: "closed", Closed: true},
+		"octo/tools#7": {Key: "octo/tools#7", Exists: true, Project: "octo/tools", Status: "open"},
+	}}
+
+	tests := []struct {
+		name           string
+		message        string
+		requireOpen    bool
+		expectedCodes  []domain.ValidationErrorCode
+		expectedLookup []string
+	}{
+		{
+			name:           "existing issues",
+			message:        "fix: retry uploads (#1)\n\nFixes #1, octo/tools#7.",
+			expectedLookup: []string{"#1", "octo/tools#7"},
+		},
+		{
+			name:           "closed issue",
+			message:        "fix: retry uploads\n\nFixes #2",
+			expectedLookup: []string{"#2"},
+		},
+		{
+			name:           "closed issue must be open",
+			message:        "fix: retry uploads\n\nFixes #2",
+			requireOpen:    true,
+			expectedCodes:  []domain.ValidationErrorCode{domain.ErrIssueClosed},
+			expectedLookup: []string{"#2"},
+		},
+		{
+			name:           "missing issue",
+			message:        "fix: retry uploads\n\nFixes #4200",
+			expectedCodes:  []domain.ValidationErrorCode{domain.ErrIssueNotFound},
+			expectedLookup: []string{"#4200"},
+		},
+		{
+			name:    "anchors and words are not references",
+			message: "fix: port the C#1 sample\n\nSee https://example.com/docs#3 and issue#4.",
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			tracker.lookups = nil
+
+			cfg := config.NewDefault()
+			cfg.Issues.RequireOpen = testCase.requireOpen
+
+			failures := rules.NewIssueReferenceRule(tracker, cfg).Validate(domain.ParseCommitMessage(testCase.message), cfg)
+
+			codes := make([]domain.ValidationErrorCode, 0, len(failures))
+			for _, err := range failures {
+				codes = append(codes, domain.ValidationErrorCode(err.Code))
+			}
+
+			require.ElementsMatch(t, testCase.expectedCodes, codes)
+			require.Equal(t, testCase.expectedLookup, tracker.lookups)
+		})
+	}
+}
+
+func TestIssueReferenceRule_LookupFailure(t *testing.T) {
+	cfg := config.NewDefault()
+	commit := domain.ParseCommitMessage("fix: retry uploads\n\nFixes #1 and #2")
+
+	failures := rules.NewIssueReferenceRule(&fakeTracker{err: errors.New("rate limit exceeded")}, cfg).Validate(commit, cfg)
+	require.Len(t, failures, 1)
+	require.Equal(t, string(domain.ErrIssueLookupFailed), failures[0].Code)
+	require.False(t, failures[0].IsBlocking())
+
+	// Without a repository there is nothing to look the references up in
+	failures = rules.NewIssueReferenceRule(nil, cfg).Validate(commit, cfg)
+	require.Len(t, failures, 1)
+	require.Contains(t, failures[0].Message, "no GitHub repository configured")
+}
+
+func TestIssueReferenceRule_InjectedTracker(t *testing.T) {
+	tracker := &fakeTracker{tickets: map[string]domain.Ticket{}}
+	cfg := config.NewDefault()
+	cfg.Rules.Enabled = append(cfg.Rules.Enabled, "issuereference")
+	cfg.Issues.Repository = "octo/app"
+
+	var issues config.IssuesConfig
+
+	services := rules.Services{IssueTracker: func(cfg config.IssuesConfig) rules.TicketTracker {
+		issues = cfg
+
+		return tracker
+	}}
+
+	created := domain.SelectCommitRules(rules.CreateCommitRules(cfg, services), []string{"issuereference"}, nil)
+	require.Len(t, created, 1)
+	require.Equal(t, "octo/app", issues.Repository, "the tracker is created for the issues configuration")
+
+	failures := created[0].Validate(domain.ParseCommitMessage("fix: retry uploads\n\nFixes #9"), cfg)
+	require.Len(t, failures, 1)
+	require.Equal(t, string(domain.ErrIssueNotFound), failures[0].Code)
+	require.Equal(t, []string{"#9"}, tracker.lookups)
+}
@@ -6,12 +6,18 @@ package rules
 import (
 	"fmt"
 	"regexp"
+	"slices"
 	"strings"
 
 	"github.com/itiquette/gommitlint/internal/domain"
 	"github.com/itiquette/gommitlint/internal/domain/config"
 )
 
+// TicketTracker looks up the tickets referenced by commit messages.
+type TicketTracker interface {
+	LookupTicket(key string) (domain.Ticket, error)
+}
+
 // JiraReferenceRule validates that commit messages reference JIRA issues.
 type JiraReferenceRule struct {
 	pattern               string
@@ -22,6 +28,8 @@ type JiraReferenceRule struct {
 	ignoreTicketPatterns  []string
 	checkConventionalOnly bool
 	requiredForTypes      []string
+	tracker               TicketTracker
+	sprint                string
 }
 
 // Name returns the rule name.
@@ -29,6 +37,42 @@ func (r JiraReferenceRule) Name() string {
 	return "JiraReference"
 }
 
+// Metadata returns the documentation of the rule.
+func (r JiraReferenceRule) Metadata() domain.RuleMetadata {
+	return domain.RuleMetadata{
+		ID:       "jirareference",
+		Name:     r.Name(),
+		Category: domain.CategoryReference,
+		Severity: domain.SeverityError,
+		Summary:  "JIRA issue references",
+		Description: "Requires a JIRA issue key such as PROJ-123 in the message. With " +
+			"jira.require_in_subject the key must end the subject, with jira.require_in_body it " +
+			"must appear on a 'Refs:' line of the body. Keys must belong to jira.project_prefixes " +
+			"when that list is set, and keys matching jira.ignore_ticket_patterns do not count. " +
+			"Commits of the docs, chore, style, refactor and test types are not checked. With " +
+			"jira.online.enabled every referenced key is looked up in the JIRA REST API and must " +
+			"exist, not be closed, belong to a listed project and, when jira.online.sprint is set, " +
+			"be planned in that sprint. A lookup that fails is reported as a warning.",
+		ErrorCodes: []domain.ValidationErrorCode{
+			domain.ErrMissingJira, domain.ErrInvalidProject, domain.ErrMissingJiraKeySubject,
+			domain.ErrJiraKeyNotAtEnd, domain.ErrMissingJiraKeyBody, domain.ErrInvalidRefsFormat,
+			domain.ErrRefsAfterSignoff, domain.ErrInvalidKeyFormat, domain.ErrEmptySubject,
+			domain.ErrJiraTicketNotFound, domain.ErrJiraTicketClosed, domain.ErrJiraTicketProject,
+			domain.ErrJiraTicketSprint, domain.ErrJiraLookupFailed,
+		},
+		ConfigKeys: []string{
+			"jira.project_prefixes", "jira.require_in_subject", "jira.require_in_body",
+			"jira.ignore_ticket_patterns", "jira.online.enabled", "jira.online.url", "jira.online.sprint",
+			"jira.online.sprint_field", "jira.online.timeout", "jira.online.cache_ttl",
+		},
+		Examples: []domain.RuleExample{
+			{Message: "feat: add login page PROJ-123", Valid: true},
+			{Message: "feat: add login page", Note: "no issue key"},
+			{Message: "docs: fix typo in README", Valid: true, Note: "docs commits are not checked"},
+		},
+	}
+}
+
 // NewJiraReferenceRule creates a new rule for validating JIRA references from config.
 func NewJiraReferenceRule(cfg config.Config) JiraReferenceRule {
 	// Always use general pattern to catch all JIRA-like references
@@ -36,7 +80,7 @@ func NewJiraReferenceRule(cfg config.Config) JiraReferenceRule {
 	pattern := `[A-Z]+-\d+`
 
 	// Check if conventional commit is enabled
-	isConventionalEnabled := domain.IsRuleActive("conventional", cfg.Rules.Enabled, cfg.Rules.Disabled)
+	isConventionalEnabled := IsRuleActive("conventional", cfg.Rules.Enabled, cfg.Rules.Disabled)
 
 	return JiraReferenceRule{
 		pattern:               pattern,
@@ -47,9 +91,17 @@ func NewJiraReferenceRule(cfg config.Config) JiraReferenceRule {
 		ignoreTicketPatterns:  cfg.Jira.IgnoreTicketPatterns,
 		checkConventionalOnly: isConventionalEnabled,
 		requiredForTypes:      []string{},
+		sprint:                cfg.Jira.Online.Sprint,
 	}
 }
 
+// WithTracker returns the rule looking up the referenced tickets in tracker.
+func (r JiraReferenceRule) WithTracker(tracker TicketTracker) JiraReferenceRule {
+	r.tracker = tracker
+
+	return r
+}
+
 // Validate checks a commit for Jira reference compliance.
 func (r JiraReferenceRule) Validate(commit domain.Commit, _ config.Config) []domain.ValidationError {
 	// Check if this commit type should be excluded from JIRA validation
@@ -129,6 +181,90 @@ func (r JiraReferenceRule) Validate(commit domain.Commit, _ config.Config) []dom
 		}
 	}
 
+	if r.tracker != nil {
+		errors = append(errors, r.validateTickets(commit)...)
+	}
+
+	return errors
+}
+
+// validateTickets looks up the well-formed references of the allowed projects, which are
+// the ones not reported already, in the ticket tracker.
+func (r JiraReferenceRule) validateTickets(commit domain.Commit) []domain.ValidationError {
+	references := r.filterIgnoredPatterns(r.extractJiraReferences(commit.Subject + "\n" + commit.Body))
+
+	var errors []domain.ValidationError
+
+	for _, reference := range references {
+		if !r.isValidJiraFormat(reference) ||
+			(len(r.prefixes) > 0 && !r.isValidProject(r.extractProjectFromReference(reference))) {
+			continue
+		}
+
+		ticket, err := r.tracker.LookupTicket(reference)
+		if err != nil {
+			// An unreachable tracker must not block commits, and stays unreachable for the others
+			return append(errors,
+				domain.New(r.Name(), domain.ErrJiraLookupFailed,
+					fmt.Sprintf("Could not look up %s in JIRA: %v", reference, err)).
+					WithContextMap(map[string]string{"ticket": reference}).
+					WithSeverity(domain.SeverityWarning).
+					WithHelp("Check jira.online.url, JIRA_API_TOKEN and the network"))
+		}
+
+		errors = append(errors, r.validateTicket(reference, ticket)...)
+	}
+
+	return errors
+}
+
+// validateTicket checks the ticket looked up for reference.
+func (r JiraReferenceRule) validateTicket(reference string, ticket domain.Ticket) []domain.ValidationError {
+	if !ticket.Exists {
+		return []domain.ValidationError{
+			domain.New(r.Name(), domain.ErrJiraTicketNotFound, fmt.Sprintf("Ticket %s does not exist in JIRA", reference)).
+				WithContextMap(map[string]string{"actual": reference, "ticket": reference}).
+				WithHelp("Check the issue key"),
+		}
+	}
+
+	var errors []domain.ValidationError
+
+	if ticket.Closed {
+		errors = append(errors,
+			domain.New(r.Name(), domain.ErrJiraTicketClosed, fmt.Sprintf("Ticket %s is closed (%s)", reference, ticket.Status)).
+				WithContextMap(map[string]string{"actual": ticket.Status, "ticket": reference}).
+				WithHelp("Reference an open ticket, or reopen the ticket"))
+	}
+
+	// A ticket moved to another project keeps answering to its old key
+	if len(r.prefixes) > 0 && ticket.Project != "" && !r.isValidProject(ticket.Project) {
+		message := fmt.Sprintf("Ticket %s belongs to project %s", reference, ticket.Project)
+		if ticket.Key != "" && ticket.Key != reference {
+			message += " as " + ticket.Key
+		}
+
+		errors = append(errors,
+			domain.New(r.Name(), domain.ErrJiraTicketProject, message).
+				WithContextMap(map[string]string{
+					"actual":   ticket.Project,
+					"expected": strings.Join(r.prefixes, ", "),
+					"ticket":   reference,
+				}).
+				WithHelp("Reference a ticket of one of these projects: "+strings.Join(r.prefixes, ", ")))
+	}
+
+	if r.sprint != "" && !slices.Contains(ticket.Sprints, r.sprint) {
+		errors = append(errors,
+			domain.New(r.Name(), domain.ErrJiraTicketSprint, fmt.Sprintf("Ticket %s is not planned in sprint %s", reference, r.sprint)).
+				WithContextMap(map[string]string{
+					"actual":   strings.Join(ticket.Sprints, ", "),
+					"expected": r.sprint,
+					"ticket":   reference,
+				}).
+				WithHelp("Plan the ticket in "+r.sprint+", or reference another ticket"))
+	}
+
 	return errors
 }
 
@@ -4,6 +4,7 @@
 package rules_test
 
 import (
+	"errors"
 	"testing"
 	"time"
 
@@ -934,3 +935,117 @@ Refs: PROJ-123`,
 		})
 	}
 }
+
+// fakeTracker answers ticket lookups from a map.
+type fakeTracker struct {
+	tickets map[string]domain.Ticket
+	err     error
+	lookups []string
+}
+
+func (f *fakeTracker) LookupTicket(key string) (domain.Ticket, error) {
+	f.lookups = append(f.lookups, key)
+	if f.err != nil {
+		return domain.Ticket{}, f.err
+	}
+
+	ticket, found := f.tickets[key]
+	if !found {
+		return domain.Ticket{Key: key}, nil
+	}
+
+	return ticket, nil
+}
+
+func TestJiraReferenceRule_OnlineTickets(t *testing.T) {
+	tracker := &fakeTracker{tickets: map[string]domain.Ticket{
+		"PROJ-1": {Key: "PROJ-1", Exists: true, Project: "PROJ", Status: "In Progress", Sprints: []string{"Sprint 7"}},
+		"PROJ-2": {Key: "PROJ-2", Exists: true, Project: "PROJ", Status: "Done", Closed: true, Sprints: []string{"Sprint 7"}},
+		"PROJ-3": {Key: "OPS-3", Exists: true, Project: "OPS", Status: "Open", Sprints: []string{"Sprint 6"}},
+	}}
+
+	tests := []struct {
+		name          string
+		subject       string
+		expectedCodes []domain.ValidationErrorCode
+	}{
+		{name: "open ticket in the sprint", subject: "feat: add login page PROJ-1"},
+		{name: "closed ticket", subject: "feat: add login page PROJ-2", expectedCodes: []domain.ValidationErrorCode{domain.ErrJiraTicketClosed}},
+		{
+			name:          "ticket moved to another project and sprint",
+			subject:       "feat: add login page PROJ-3",
+			expectedCodes: []domain.ValidationErrorCode{domain.ErrJiraTicketProject, domain.ErrJiraTicketSprint},
+		},
+		{name: "unknown ticket", subject: "feat: add login page PROJ-4", expectedCodes: []domain.ValidationErrorCode{domain.ErrJiraTicketNotFound}},
+		{name: "invalid project is not looked up", subject: "feat: add login page TEAM-1", expectedCodes: []domain.ValidationErrorCode{domain.ErrInvalidProject}},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			cfg := newConfigBuilder().WithJiraProjects([]string{"PROJ"}).Build()
+			cfg.Jira.Online.Sprint = "Sprint 7"
+
+			commit := createJiraTestCommit()
+			commit.Subject = testCase.subject
+
+			failures := rules.NewJiraReferenceRule(cfg).WithTracker(tracker).Validate(commit, cfg)
+
+			codes := make([]domain.ValidationErrorCode, 0, len(failures))
+			for _, err := range failures {
+				codes = append(codes, domain.ValidationErrorCode(err.Code))
+			}
+
+			require.ElementsMatch(t, testCase.expectedCodes, codes)
+		})
+	}
+
+	require.NotContains(t, tracker.lookups, "TEAM-1")
+}
+
+func TestJiraReferenceRule_OnlineLookupFailure(t *testing.T) {
+	tracker := &fakeTracker{err: errors.New("connection refused")}
+	cfg := newConfigBuilder().Build()
+
+	commit := createJiraTestCommit()
+	commit.Subject = "feat: add login page PROJ-1"
+	commit.Body = "Refs: PROJ-2"
+
+	failures := rules.NewJiraReferenceRule(cfg).WithTracker(tracker).Validate(commit, cfg)
+
+	require.Len(t, failures, 1)
+	require.Equal(t, string(domain.ErrJiraLookupFailed), failures[0].Code)
+	require.False(t, failures[0].IsBlocking())
+	require.Equal(t, []string{"PROJ-1"}, tracker.lookups)
+}
+
+func TestJiraReferenceRule_InjectedTracker(t *testing.T) {
+	tracker := &fakeTracker{tickets: map[string]domain.Ticket{
+		"PROJ-1": {Key: "PROJ-1", Exists: true, Project: "PROJ", Status: "Done", Closed: true},
+	}}
+	cfg := newConfigBuilder().Build()
+	cfg.Rules.Enabled = append(cfg.Rules.Enabled, "jirareference")
+	cfg.Jira.Online.Enabled = true
+
+	commit := createJiraTestCommit()
+	commit.Subject = "feat: add login page PROJ-1"
+
+	var online config.JiraOnlineConfig
+
+	services := rules.Services{JiraTracker: func(cfg config.JiraOnlineConfig) rules.TicketTracker {
+		online = cfg
+
+		return tracker
+	}}
+
+	created := domain.SelectCommitRules(rules.CreateCommitRules(cfg, services), []string{"jirareference"}, nil)
+	require.Len(t, created, 1)
+	require.Equal(t, cfg.Jira.Online, online, "the tracker is created for the online configuration")
+
+	failures := created[0].Validate(commit, cfg)
+	require.Len(t, failures, 1)
+	require.Equal(t, string(domain.ErrJiraTicketClosed), failures[0].Code)
+
+	// Without the service the tickets are not looked up
+	created = domain.SelectCommitRules(rules.CreateCommitRules(cfg, rules.Services{}), []string{"jirareference"}, nil)
+	require.Empty(t, created[0].Validate(commit, cfg))
+}
@@ -0,0 +1,279 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package rules
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"slices"
+	"strings"
+	"sync"
+	"unicode"
+
+	"github.com/itiquette/gommitlint/internal/domain"
+	"github.com/itiquette/gommitlint/internal/domain/config"
+)
+
+// languageMargin is how much more likely, per trigram, a message must be in a language
+// that is not allowed than in the best allowed one before it is reported. Short
+// messages and messages in related languages often score close to each other.
+const languageMargin = 0.1
+
+// languageNoise matches inline code and URLs, which are not written in any language.
+var languageNoise = regexp.MustCompile("`[^`]*`|[a-zA-Z][a-zA-Z0-9+.-]*://\\S+")
+
+// trigramProfile holds the log probabilities of the trigrams of a language.
+type trigramProfile struct {
+	logProbabilities map[string]float64
+	unseen           float64 // Log probability of trigrams missing from the sample
+}
+
+// languageProfiles builds the trigram profile of each language from its sample once.
+var languageProfiles = sync.OnceValue(func() map[string]trigramProfile {
+	counts := make(map[string]map[string]int, len(languageSamples))
+	vocabulary := make(map[string]bool)
+
+	for language, sample := range languageSamples {
+		counts[language] = make(map[string]int)
+
+		for _, trigram := range trigrams(languageWords(sample)) {
+			counts[language][trigram]++
+			vocabulary[trigram] = true
+		}
+	}
+
+	// Add-one smoothing over the trigrams of all samples
+	profiles := make(map[string]trigramProfile, len(counts))
+
+	for language, languageCounts := range counts {
+		total := 0
+		for _, count := range languageCounts {
+			total += count
+		}
+
+		denominator := float64(total + len(vocabulary) + 1)
+		profile := trigramProfile{
+			logProbabilities: make(map[string]float64, len(languageCounts)),
+			unseen:           math.Log(1 / denominator),
+		}
+
+		for trigram, count := range languageCounts {
+			profile.logProbabilities[trigram] = math.Log(float64(count+1) / denominator)
+		}
+
+		profiles[language] = profile
+	}
+
+	return profiles
+})
+
+// LanguageRule reports messages written in another natural language than those agreed
+// on, so that the history of international teams can be read by everyone.
+type LanguageRule struct {
+	languages []string
+	minLength int
+}
+
+// NewLanguageRule creates a new LanguageRule from config. Unknown languages, which
+// configuration validation reports, are left out.
+func NewLanguageRule(cfg config.Config) LanguageRule {
+	rule := LanguageRule{minLength: cfg.Language.MinLength}
+
+	for _, language := range cfg.Language.Languages {
+		if _, known := languageSamples[language]; known {
+			rule.languages = append(rule.languages, language)
+		}
+	}
+
+	return rule
+}
+
+// Name returns the rule name.
+func (r LanguageRule) Name() string {
+	return "Language"
+}
+
+// Metadata returns the documentation of the rule.
+func (r LanguageRule) Metadata() domain.RuleMetadata {
+	return domain.RuleMetadata{
+		ID:       "language",
+		Name:     r.Name(),
+		Category: domain.CategoryMessage,
+		Severity: domain.SeverityError,
+		Summary:  "Message written in one of the agreed natural languages",
+		Description: "Detects the language of the subject description and body from their " +
+			"character trigrams and reports messages clearly written in another language than " +
+			"those in language.languages. German, English, Spanish, French, Italian, Dutch, " +
+			"Portuguese and Swedish are known. Inline code, URLs, quoted and indented lines, " +
+			"trailers and words that look like identifiers are left out, and messages with " +
+			"fewer letters than language.min_length are not judged. Merge commits are skipped.",
+		ErrorCodes: []domain.ValidationErrorCode{domain.ErrWrongLanguage},
+		ConfigKeys: []string{"language.languages", "language.min_length"},
+		Examples: []domain.RuleExample{
+			{Message: "fix: keep the cursor position when the file is reloaded", Valid: true},
+			{Message: "fix: behåll markörens position när filen läses in på nytt", Note: "Swedish where English is agreed"},
+		},
+	}
+}
+
+// Validate reports the message when it is written in a language that is not allowed.
+func (r LanguageRule) Validate(commit domain.Commit, _ config.Config) []domain.ValidationError {
+	if commit.IsMergeCommit || len(r.languages) == 0 {
+		return nil
+	}
+
+	words := languageWords(languageText(commit))
+
+	letters := 0
+	for _, word := range words {
+		letters += len([]rune(word))
+	}
+
+	if letters < r.minLength {
+		return nil
+	}
+
+	scores := languageScores(trigrams(words))
+
+	detected := ""
+	for language, score := range scores {
+		if detected == "" || score > scores[detected] || score == scores[detected] && language < detected {
+			detected = language
+		}
+	}
+
+	if slices.Contains(r.languages, detected) {
+		return nil
+	}
+
+	bestAllowed := math.Inf(-1)
+	for _, language := range r.languages {
+		bestAllowed = max(bestAllowed, scores[language])
+	}
+
+	if scores[detected]-bestAllowed < languageMargin {
+		return nil
+	}
+
+	expected := make([]string, 0, len(r.languages))
+	for _, language := range r.languages {
+		expected = append(expected, languageNames[language])
+	}
+
+	return []domain.ValidationError{
+		domain.New(r.Name(), domain.ErrWrongLanguage,
+			fmt.Sprintf("Message appears to be written in %s, not %s", languageNames[detected], strings.Join(expected, " or "))).
+			WithContextMap(map[string]string{
+				"actual":   detected,
+				"expected": strings.Join(r.languages, ", "),
+				"language": languageNames[detected],
+			}).
+			WithHelp("Write the message in " + strings.Join(expected, " or ")),
+	}
+}
+
+// languageText returns the prose of the message: the subject without its conventional
+// commit prefix and the body paragraphs without the trailer block, leaving out fenced,
+// indented and quoted lines.
+func languageText(commit domain.Commit) string {
+	lines := []string{domain.ExtractDescriptionFromConventional(commit.Subject)}
+	fenced := false
+
+	for _, paragraph := range descriptionParagraphs(commit.Body) {
+		for _, line := range strings.Split(paragraph, "\n") {
+			if strings.HasPrefix(strings.TrimSpace(line), "```") {
+				fenced = !fenced
+
+				continue
+			}
+
+			if fenced || strings.HasPrefix(line, "\t") || strings.HasPrefix(line, "    ") ||
+				strings.HasPrefix(strings.TrimSpace(line), ">") {
+				continue
+			}
+
+			lines = append(lines, line)
+		}
+	}
+
+	return languageNoise.ReplaceAllString(strings.Join(lines, "\n"), " ")
+}
+
+// languageWords returns the words of text in lower case. Tokens holding digits or the
+// punctuation of paths and identifiers, and words in camel case, are left out.
+func languageWords(text string) []string {
+	var words []string
+
+	for _, token := range strings.Fields(text) {
+		token = strings.TrimFunc(token, func(r rune) bool { return !unicode.IsLetter(r) })
+		if token == "" || strings.ContainsAny(token, "0123456789_./\\:@#=<>{}[]()") || isCamelCase(token) {
+			continue
+		}
+
+		for _, word := range strings.FieldsFunc(token, func(r rune) bool { return !unicode.IsLetter(r) }) {
+			words = append(words, strings.ToLower(word))
+		}
+	}
+
+	return words
+}
+
+// isCamelCase reports whether word has an upper case letter after a lower case one,
+// like the names of functions and types.
+func isCamelCase(word string) bool {
+	lower := false
+
+	for _, r := range word {
+		if unicode.IsUpper(r) && lower {
+			return true
+		}
+
+		lower = unicode.IsLower(r)
+	}
+
+	return false
+}
+
+// trigrams returns the character trigrams of words, each padded with a space on both
+// sides so that beginnings and endings of words count.
+func trigrams(words []string) []string {
+	var result []string
+
+	for _, word := range words {
+		runes := []rune(" " + word + " ")
+		for index := 0; index+3 <= len(runes); index++ {
+			result = append(result, string(runes[index:index+3]))
+		}
+	}
+
+	return result
+}
+
+// languageScores returns, by language, the mean log probability of the trigrams.
+func languageScores(grams []string) map[string]float64 {
+	profiles := languageProfiles()
+	scores := make(map[string]float64, len(profiles))
+
+	if len(grams) == 0 {
+		return scores
+	}
+
+	for language, profile := range profiles {
+		sum := 0.0
+
+		for _, gram := range grams {
+			if logProbability, found := profile.logProbabilities[gram]; found {
+				sum += logProbability
+			} else {
+				sum += profile.unseen
+			}
+		}
+
+		scores[language] = sum / float64(len(grams))
+	}
+
+	return scores
+}
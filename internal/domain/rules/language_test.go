@@ -0,0 +1,84 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package rules_test
+
+import (
+	"testing"
+
+	"github.com/itiquette/gommitlint/internal/domain"
+	"github.com/itiquette/gommitlint/internal/domain/config"
+	"github.com/itiquette/gommitlint/internal/domain/rules"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLanguageRule(t *testing.T) {
+	tests := []struct {
+		name            string
+		message         string
+		languages       []string
+		merge           bool
+		expectedMessage string
+	}{
+		{
+			name:    "English message",
+			message: "fix: keep the cursor position when the file is reloaded\n\nThe editor jumped to the top after every save.",
+		},
+		{
+			name:    "identifiers, code and URLs are ignored",
+			message: "Use bytes.Buffer in encodeValue\n\nSee https://example.com/artikel/uebersicht and run `go test ./...`\nbefore sending the request to the server.",
+		},
+		{
+			name:            "Swedish message",
+			message:         "fix: behåll markörens position när filen läses in på nytt",
+			expectedMessage: "Message appears to be written in Swedish, not English",
+		},
+		{
+			name:            "German message",
+			message:         "Korrigiert die Berechnung der Mehrwertsteuer bei Rechnungen im Ausland",
+			languages:       []string{"en", "fr"},
+			expectedMessage: "Message appears to be written in German, not English or French",
+		},
+		{
+			name:      "allowed second language",
+			message:   "Corrige le calcul de la taxe pour les factures à l'étranger",
+			languages: []string{"en", "fr"},
+		},
+		{
+			name:    "too short to judge",
+			message: "Rätta stavfel",
+		},
+		{
+			name:    "trailers are ignored",
+			message: "Handle timeouts in the client and retry the requests\n\nGranskad-av: Anna Andersson <anna@example.com>\nRefs: PROJ-12",
+		},
+		{
+			name:    "merge commit",
+			message: "Slå ihop grenen för utgåvan med huvudgrenen efter frysningen",
+			merge:   true,
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			cfg := config.NewDefault()
+			if testCase.languages != nil {
+				cfg.Language.Languages = testCase.languages
+			}
+
+			commit := domain.NewCommit("abc123", testCase.message, "Dev Eloper", "dev@example.com", "", "", testCase.merge)
+			errors := rules.NewLanguageRule(cfg).Validate(commit, cfg)
+
+			if testCase.expectedMessage == "" {
+				require.Empty(t, errors)
+
+				return
+			}
+
+			require.Len(t, errors, 1)
+			require.Equal(t, string(domain.ErrWrongLanguage), errors[0].Code)
+			require.Equal(t, testCase.expectedMessage, errors[0].Message)
+		})
+	}
+}
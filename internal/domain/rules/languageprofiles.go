@@ -0,0 +1,127 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package rules
+
+// languageSamples holds, by ISO 639-1 code, the text the trigram profile of each
+// language detected by the language rule is built from. The samples are written like
+// commit messages, mixing the words of changes to code with everyday sentences, so
+// that the profiles match what the rule reads.
+var languageSamples = map[string]string{
+	"de": `Behebt einen Fehler beim Lesen der Konfiguration, wenn die Datei leer ist.
+Die Funktion gibt jetzt eine verständliche Meldung zurück, anstatt abzustürzen.
+Fügt eine neue Option hinzu, mit der die Ausgabe in einer Datei gespeichert werden kann.
+Entfernt veraltete Abhängigkeiten und aktualisiert die Dokumentation für die Installation.
+Der Test schlägt nicht mehr fehl, wenn das Verzeichnis noch nicht existiert.
+Wir prüfen die Eingabe des Benutzers, bevor sie an den Server geschickt wird, damit
+ungültige Werte früh erkannt werden. Die Änderung verbessert auch die Geschwindigkeit
+beim Start, weil die Daten nur einmal geladen werden und nicht bei jeder Anfrage.
+Verschiebt die Hilfsfunktionen in ein eigenes Paket und benennt die Klasse um.
+Es ist wichtig, dass die Schnittstelle gleich bleibt, auch wenn sich die interne
+Struktur ändert. Diese Version unterstützt außerdem Zeichen mit Umlauten in Namen.
+Ergänze die fehlende Übersetzung und korrigiere die Rechtschreibung in der Anleitung.`,
+
+	"en": `Fix a crash when reading the configuration if the file is empty.
+The function now returns a clear error message instead of panicking.
+Add a new option that lets the output be written to a file.
+Remove outdated dependencies and update the documentation for the installation.
+The test no longer fails when the directory does not exist yet.
+We check the input of the user before it is sent to the server, so that invalid
+values are caught early. This change also improves the speed at startup, because
+the data is loaded only once and not for every request.
+Move the helper functions into their own package and rename the class.
+It is important that the interface stays the same, even when the internal
+structure changes. This version also supports characters with accents in names.
+Add the missing translation and correct the spelling in the guide.`,
+
+	"es": `Corrige un fallo al leer la configuración cuando el archivo está vacío.
+La función ahora devuelve un mensaje de error claro en lugar de detenerse.
+Añade una nueva opción que permite guardar la salida en un archivo.
+Elimina dependencias obsoletas y actualiza la documentación de la instalación.
+La prueba ya no falla cuando el directorio todavía no existe.
+Comprobamos la entrada del usuario antes de enviarla al servidor, para que los
+valores no válidos se detecten pronto. Este cambio también mejora la velocidad al
+iniciar, porque los datos se cargan una sola vez y no en cada petición.
+Mueve las funciones auxiliares a su propio paquete y cambia el nombre de la clase.
+Es importante que la interfaz siga siendo la misma, aunque cambie la estructura
+interna. Esta versión también admite caracteres con acentos en los nombres.
+Agrega la traducción que faltaba y corrige la ortografía de la guía.`,
+
+	"fr": `Corrige un plantage lors de la lecture de la configuration quand le fichier est vide.
+La fonction renvoie maintenant un message d'erreur clair au lieu de s'arrêter.
+Ajoute une nouvelle option qui permet d'enregistrer la sortie dans un fichier.
+Supprime les dépendances obsolètes et met à jour la documentation de l'installation.
+Le test n'échoue plus lorsque le répertoire n'existe pas encore.
+Nous vérifions la saisie de l'utilisateur avant de l'envoyer au serveur, afin que les
+valeurs invalides soient détectées tôt. Ce changement améliore aussi la vitesse au
+démarrage, parce que les données ne sont chargées qu'une seule fois et non à chaque requête.
+Déplace les fonctions utilitaires dans leur propre paquet et renomme la classe.
+Il est important que l'interface reste la même, même si la structure interne change.
+Cette version prend aussi en charge les caractères accentués dans les noms.
+Ajoute la traduction manquante et corrige l'orthographe du guide.`,
+
+	"it": `Corregge un errore durante la lettura della configurazione quando il file è vuoto.
+La funzione ora restituisce un messaggio di errore chiaro invece di bloccarsi.
+Aggiunge una nuova opzione che permette di salvare l'output in un file.
+Rimuove le dipendenze obsolete e aggiorna la documentazione per l'installazione.
+Il test non fallisce più quando la cartella non esiste ancora.
+Controlliamo l'input dell'utente prima di inviarlo al server, in modo che i valori
+non validi vengano riconosciuti subito. Questa modifica migliora anche la velocità
+all'avvio, perché i dati vengono caricati una sola volta e non a ogni richiesta.
+Sposta le funzioni di supporto in un pacchetto separato e rinomina la classe.
+È importante che l'interfaccia resti la stessa, anche quando cambia la struttura
+interna. Questa versione supporta anche i caratteri accentati nei nomi.
+Aggiungi la traduzione mancante e correggi l'ortografia della guida.`,
+
+	"nl": `Verhelpt een fout bij het lezen van de configuratie als het bestand leeg is.
+De functie geeft nu een duidelijke foutmelding terug in plaats van te crashen.
+Voegt een nieuwe optie toe waarmee de uitvoer in een bestand kan worden opgeslagen.
+Verwijdert verouderde afhankelijkheden en werkt de documentatie voor de installatie bij.
+De test faalt niet meer wanneer de map nog niet bestaat.
+We controleren de invoer van de gebruiker voordat die naar de server wordt gestuurd,
+zodat ongeldige waarden vroeg worden herkend. Deze wijziging verbetert ook de snelheid
+bij het opstarten, omdat de gegevens maar één keer worden geladen en niet bij elk verzoek.
+Verplaatst de hulpfuncties naar een eigen pakket en hernoemt de klasse.
+Het is belangrijk dat de interface hetzelfde blijft, ook als de interne structuur
+verandert. Deze versie ondersteunt ook tekens met accenten in namen.
+Voeg de ontbrekende vertaling toe en verbeter de spelling in de handleiding.`,
+
+	"pt": `Corrige uma falha ao ler a configuração quando o arquivo está vazio.
+A função agora devolve uma mensagem de erro clara em vez de parar.
+Adiciona uma nova opção que permite gravar a saída num arquivo.
+Remove dependências obsoletas e atualiza a documentação da instalação.
+O teste já não falha quando o diretório ainda não existe.
+Verificamos a entrada do usuário antes de enviá-la ao servidor, para que os valores
+inválidos sejam detectados cedo. Esta alteração também melhora a velocidade ao
+iniciar, porque os dados são carregados uma única vez e não em cada pedido.
+Move as funções auxiliares para o seu próprio pacote e renomeia a classe.
+É importante que a interface continue igual, mesmo quando a estrutura interna muda.
+Esta versão também suporta caracteres com acentos nos nomes.
+Acrescenta a tradução que faltava e corrige a ortografia do guia.`,
+
+	"sv": `Rättar en krasch vid läsning av konfigurationen när filen är tom.
+Funktionen returnerar nu ett tydligt felmeddelande i stället för att krascha.
+Lägger till ett nytt alternativ som gör att utdata kan sparas i en fil.
+Tar bort föråldrade beroenden och uppdaterar dokumentationen för installationen.
+Testet misslyckas inte längre när katalogen ännu inte finns.
+Vi kontrollerar användarens indata innan de skickas till servern, så att ogiltiga
+värden upptäcks tidigt. Ändringen förbättrar också hastigheten vid start, eftersom
+data bara läses in en gång och inte vid varje anrop.
+Flyttar hjälpfunktionerna till ett eget paket och byter namn på klassen.
+Det är viktigt att gränssnittet förblir detsamma, även när den interna strukturen
+ändras. Den här versionen stöder också tecken med accenter i namn.
+Lägg till den saknade översättningen och rätta stavningen i guiden.`,
+}
+
+// languageNames are the English names of the languages in languageSamples, for messages.
+var languageNames = map[string]string{
+	"de": "German",
+	"en": "English",
+	"es": "Spanish",
+	"fr": "French",
+	"it": "Italian",
+	"nl": "Dutch",
+	"pt": "Portuguese",
+	"sv": "Swedish",
+}
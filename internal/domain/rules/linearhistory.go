@@ -0,0 +1,116 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package rules
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/itiquette/gommitlint/internal/domain"
+	"github.com/itiquette/gommitlint/internal/domain/config"
+)
+
+// LinearHistoryRule requires the validated range to be a single chain of commits without
+// merges, as left by rebase-and-fast-forward workflows.
+type LinearHistoryRule struct{}
+
+// NewLinearHistoryRule creates a new LinearHistoryRule from config.
+func NewLinearHistoryRule(_ config.Config) LinearHistoryRule {
+	return LinearHistoryRule{}
+}
+
+// Name returns the rule name.
+func (r LinearHistoryRule) Name() string {
+	return "LinearHistory"
+}
+
+// Metadata returns the documentation of the rule.
+func (r LinearHistoryRule) Metadata() domain.RuleMetadata {
+	return domain.RuleMetadata{
+		ID:       "linearhistory",
+		Name:     r.Name(),
+		Category: domain.CategoryHistory,
+		Severity: domain.SeverityError,
+		Summary:  "Linear history without merge commits",
+		Description: "Checks validated ranges as a whole: every merge commit in the range is " +
+			"reported, and the other commits must form a single chain in which each commit is " +
+			"the parent of the next. Single commits and messages are not checked.",
+		ErrorCodes: []domain.ValidationErrorCode{domain.ErrMergeCommit, domain.ErrNonLinearHistory},
+	}
+}
+
+// Validate does nothing for single commits; the history is checked by ValidateRange.
+func (r LinearHistoryRule) Validate(_ domain.Commit, _ domain.Repository, _ config.Config) []domain.ValidationError {
+	return nil
+}
+
+// ValidateRange reports every merge commit of the range, and fails when the other commits
+// do not form one chain in which each commit is the parent of the next.
+func (r LinearHistoryRule) ValidateRange(commits []domain.Commit, _ config.Config) []domain.ValidationError {
+	var errors []domain.ValidationError
+
+	for _, commit := range commits {
+		if commit.IsMergeCommit {
+			errors = append(errors,
+				domain.New(r.Name(), domain.ErrMergeCommit,
+					fmt.Sprintf("Merge commit %s in a history that must be linear", domain.ShortHash(commit.Hash))).
+					WithContextMap(map[string]string{
+						"commit":  domain.ShortHash(commit.Hash),
+						"subject": commit.Subject,
+					}).
+					WithHelp("Rebase the branch instead of merging it, for example with 'git pull --rebase'"))
+		}
+	}
+
+	if len(errors) > 0 {
+		return errors
+	}
+
+	if chains := countChains(commits); chains > 1 {
+		errors = append(errors,
+			domain.New(r.Name(), domain.ErrNonLinearHistory,
+				fmt.Sprintf("Commits form %d separate chains instead of one", chains)).
+				WithContextMap(map[string]string{
+					"actual":   strconv.Itoa(chains),
+					"expected": "1",
+				}).
+				WithHelp("Rebase the commits so that each one builds on the one before it"))
+	}
+
+	return errors
+}
+
+// countChains counts the chains formed by commits without merges: the number of commits
+// whose parent is outside the range, or the number of commits no other commit builds on,
+// whichever is larger.
+func countChains(commits []domain.Commit) int {
+	inRange := make(map[string]bool, len(commits))
+	for _, commit := range commits {
+		inRange[commit.Hash] = true
+	}
+
+	roots := 0
+	children := make(map[string]int, len(commits))
+
+	for _, commit := range commits {
+		if len(commit.ParentHashes) == 0 || !inRange[commit.ParentHashes[0]] {
+			roots++
+
+			continue
+		}
+
+		children[commit.ParentHashes[0]]++
+	}
+
+	tips := 0
+
+	for _, commit := range commits {
+		if children[commit.Hash] == 0 {
+			tips++
+		}
+	}
+
+	return max(roots, tips)
+}
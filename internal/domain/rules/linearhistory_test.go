@@ -0,0 +1,152 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package rules_test
+
+import (
+	"testing"
+
+	"github.com/itiquette/gommitlint/internal/domain"
+	"github.com/itiquette/gommitlint/internal/domain/config"
+	"github.com/itiquette/gommitlint/internal/domain/rules"
+	"github.com/stretchr/testify/require"
+)
+
+// chainCommit creates a commit with the given parents.
+func chainCommit(hash string, parents ...string) domain.Commit {
+	return domain.Commit{
+		Hash:          hash,
+		Subject:       "feat: commit " + hash,
+		ParentHashes:  parents,
+		IsMergeCommit: len(parents) > 1,
+	}
+}
+
+func TestLinearHistoryRule(t *testing.T) {
+	tests := []struct {
+		name          string
+		commits       []domain.Commit
+		expectedCodes []string
+	}{
+		{
+			name:    "empty range",
+			commits: nil,
+		},
+		{
+			name:    "single commit",
+			commits: []domain.Commit{chainCommit("c1", "base")},
+		},
+		{
+			name: "linear chain newest first",
+			commits: []domain.Commit{
+				chainCommit("c3", "c2"),
+				chainCommit("c2", "c1"),
+				chainCommit("c1", "base"),
+			},
+		},
+		{
+			name: "linear chain from a root commit",
+			commits: []domain.Commit{
+				chainCommit("c1"),
+				chainCommit("c2", "c1"),
+			},
+		},
+		{
+			name: "merge commit",
+			commits: []domain.Commit{
+				chainCommit("m1", "c1", "side"),
+				chainCommit("side", "base"),
+				chainCommit("c1", "base"),
+			},
+			expectedCodes: []string{string(domain.ErrMergeCommit)},
+		},
+		{
+			name: "two merge commits",
+			commits: []domain.Commit{
+				chainCommit("m2", "m1", "other"),
+				chainCommit("m1", "c1", "side"),
+				chainCommit("c1", "base"),
+			},
+			expectedCodes: []string{string(domain.ErrMergeCommit), string(domain.ErrMergeCommit)},
+		},
+		{
+			name: "two separate chains",
+			commits: []domain.Commit{
+				chainCommit("a2", "a1"),
+				chainCommit("a1", "base"),
+				chainCommit("b1", "base"),
+			},
+			expectedCodes: []string{string(domain.ErrNonLinearHistory)},
+		},
+		{
+			name: "branching chain",
+			commits: []domain.Commit{
+				chainCommit("c2", "c1"),
+				chainCommit("c3", "c1"),
+				chainCommit("c1", "base"),
+			},
+			expectedCodes: []string{string(domain.ErrNonLinearHistory)},
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			rule := rules.NewLinearHistoryRule(config.Config{})
+
+			errors := rule.ValidateRange(testCase.commits, config.Config{})
+
+			codes := make([]string, 0, len(errors))
+			for _, err := range errors {
+				require.Equal(t, "LinearHistory", err.Rule)
+				codes = append(codes, err.Code)
+			}
+
+			if len(testCase.expectedCodes) == 0 {
+				require.Empty(t, codes)
+
+				return
+			}
+
+			require.Equal(t, testCase.expectedCodes, codes)
+		})
+	}
+}
+
+func TestLinearHistoryRule_Messages(t *testing.T) {
+	rule := rules.NewLinearHistoryRule(config.Config{})
+
+	errors := rule.ValidateRange([]domain.Commit{
+		chainCommit("0123456789abcdef", "c1", "side"),
+	}, config.Config{})
+	require.Len(t, errors, 1)
+	require.Equal(t, "Merge commit 0123456 in a history that must be linear", errors[0].Message)
+	require.Equal(t, "0123456", errors[0].Context["commit"])
+
+	errors = rule.ValidateRange([]domain.Commit{
+		chainCommit("a1", "base"),
+		chainCommit("b1", "base"),
+	}, config.Config{})
+	require.Len(t, errors, 1)
+	require.Equal(t, "Commits form 2 separate chains instead of one", errors[0].Message)
+}
+
+func TestLinearHistoryRule_SingleCommit(t *testing.T) {
+	rule := rules.NewLinearHistoryRule(config.Config{})
+
+	require.Empty(t, rule.Validate(chainCommit("m1", "c1", "side"), nil, config.Config{}))
+}
+
+func TestValidateRange_LinearHistory(t *testing.T) {
+	repoRules := []domain.RepositoryRule{
+		rules.NewLinearHistoryRule(config.Config{}),
+		rules.NewBranchAheadRule(config.Config{}),
+	}
+
+	errors := domain.ValidateRange([]domain.Commit{
+		chainCommit("m1", "c1", "side"),
+		chainCommit("c1", "base"),
+	}, repoRules, nil, config.Config{})
+	require.Len(t, errors, 1)
+	require.Equal(t, string(domain.ErrMergeCommit), errors[0].Code)
+}
@@ -0,0 +1,176 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package rules
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/itiquette/gommitlint/internal/domain"
+	"github.com/itiquette/gommitlint/internal/domain/config"
+)
+
+// urlPattern finds URLs by their scheme, up to the first space, angle bracket or quote.
+var urlPattern = regexp.MustCompile(`(?i)\b[a-z][a-z0-9+.-]*://[^\s<>"']+`)
+
+// LinksRule checks the URLs in the commit body: that they are well formed, optionally
+// that they use HTTPS and point to allowed domains, or that there are none at all.
+type LinksRule struct {
+	requireHTTPS   bool
+	allowedDomains []string
+	forbid         bool
+}
+
+// NewLinksRule creates a new LinksRule from config.
+func NewLinksRule(cfg config.Config) LinksRule {
+	domains := make([]string, 0, len(cfg.Links.AllowedDomains))
+	for _, domain := range cfg.Links.AllowedDomains {
+		domains = append(domains, strings.ToLower(strings.TrimPrefix(domain, "*.")))
+	}
+
+	return LinksRule{
+		requireHTTPS:   cfg.Links.RequireHTTPS,
+		allowedDomains: domains,
+		forbid:         cfg.Links.Forbid,
+	}
+}
+
+// Name returns the rule name.
+func (r LinksRule) Name() string {
+	return "Links"
+}
+
+// Metadata returns the documentation of the rule.
+func (r LinksRule) Metadata() domain.RuleMetadata {
+	return domain.RuleMetadata{
+		ID:       "links",
+		Name:     r.Name(),
+		Category: domain.CategoryMessage,
+		Severity: domain.SeverityError,
+		Summary:  "Well-formed URLs in the body, optionally HTTPS to allowed domains only",
+		Description: "Finds the URLs in the body by their scheme and reports those without a host. " +
+			"With links.require_https a URL with another scheme than https is reported, and with " +
+			"links.allowed_domains its host must be one of the listed domains or a subdomain of " +
+			"one. links.forbid reports every URL, for public repositories that must not link to " +
+			"internal systems. Each offending URL is reported separately with its line and column.",
+		ErrorCodes: []domain.ValidationErrorCode{
+			domain.ErrInvalidURL, domain.ErrInsecureURL, domain.ErrDisallowedURLDomain, domain.ErrForbiddenURL,
+		},
+		ConfigKeys: []string{"links.require_https", "links.allowed_domains", "links.forbid"},
+		Examples: []domain.RuleExample{
+			{Message: "fix: retry uploads\n\nSee https://tracker.example.com/PROJ-123.", Valid: true},
+			{Message: "fix: retry uploads\n\nSee http://tracker.example.com/PROJ-123.", Note: "with links.require_https"},
+			{Message: "fix: retry uploads\n\nSee https:///PROJ-123.", Note: "no host"},
+		},
+	}
+}
+
+// Validate checks every URL in the body of the commit message.
+func (r LinksRule) Validate(commit domain.Commit, _ config.Config) []domain.ValidationError {
+	message := commit.FullMessage()
+
+	var errors []domain.ValidationError
+
+	// The subject is the first line; URLs there are left to the subject rules
+	for lineIndex, line := range strings.Split(message, "\n") {
+		if lineIndex == 0 {
+			continue
+		}
+
+		for _, match := range urlPattern.FindAllStringIndex(line, -1) {
+			rawURL := trimURL(line[match[0]:match[1]])
+			column := utf8.RuneCountInString(line[:match[0]]) + 1
+
+			if err, found := r.checkURL(rawURL, lineIndex+1, column); found {
+				errors = append(errors, err)
+			}
+		}
+	}
+
+	return errors
+}
+
+// checkURL returns the failure of rawURL at the 1-based line and column, if it has one.
+func (r LinksRule) checkURL(rawURL string, line, column int) (domain.ValidationError, bool) {
+	context := map[string]string{"actual": rawURL}
+
+	var (
+		code        domain.ValidationErrorCode
+		description string
+		help        string
+		suggestion  string
+	)
+
+	parsed, err := url.Parse(rawURL)
+
+	switch {
+	case err != nil || parsed.Hostname() == "":
+		code, description = domain.ErrInvalidURL, "Invalid URL"
+		help = "Fix the address so that it has a scheme and a host, such as https://example.com/page"
+	case r.forbid:
+		code, description = domain.ErrForbiddenURL, "URL"
+		help = "Remove the link; links.forbid allows no URLs in commit messages"
+	case r.requireHTTPS && !strings.EqualFold(parsed.Scheme, "https"):
+		code, description = domain.ErrInsecureURL, "URL without HTTPS"
+		help = "Link with https://"
+		suggestion = "https" + rawURL[len(parsed.Scheme):]
+		context["expected"] = "https"
+	case len(r.allowedDomains) > 0 && !r.allowedHost(parsed.Hostname()):
+		allowed := strings.Join(r.allowedDomains, ", ")
+		code, description = domain.ErrDisallowedURLDomain, "URL to a domain that is not allowed"
+		help = "Link only to " + allowed + ", or add the domain to links.allowed_domains"
+		context["expected"] = allowed
+	default:
+		return domain.ValidationError{}, false
+	}
+
+	failure := domain.New(r.Name(), code,
+		fmt.Sprintf("%s %s at line %d, column %d", description, rawURL, line, column)).
+		WithContextMap(context).
+		WithPosition(line, column).
+		WithHelp(help)
+
+	if suggestion != "" {
+		failure = failure.WithSuggestion(suggestion)
+	}
+
+	return failure, true
+}
+
+// allowedHost reports whether host is one of the allowed domains or a subdomain of one.
+func (r LinksRule) allowedHost(host string) bool {
+	host = strings.ToLower(host)
+
+	for _, allowed := range r.allowedDomains {
+		if host == allowed || strings.HasSuffix(host, "."+allowed) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// trimURL drops the punctuation that ends the sentence around a URL, and closing
+// brackets that the URL does not open, as in "(see https://example.com)".
+func trimURL(rawURL string) string {
+	for rawURL != "" {
+		last := rawURL[len(rawURL)-1]
+
+		switch {
+		case strings.IndexByte(".,;:!?", last) >= 0:
+			rawURL = rawURL[:len(rawURL)-1]
+		case last == ')' && strings.Count(rawURL, "(") < strings.Count(rawURL, ")"),
+			last == ']' && strings.Count(rawURL, "[") < strings.Count(rawURL, "]"):
+			rawURL = rawURL[:len(rawURL)-1]
+		default:
+			return rawURL
+		}
+	}
+
+	return rawURL
+}
@@ -0,0 +1,109 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package rules_test
+
+import (
+	"testing"
+
+	"github.com/itiquette/gommitlint/internal/domain"
+	"github.com/itiquette/gommitlint/internal/domain/config"
+	"github.com/itiquette/gommitlint/internal/domain/rules"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLinksRule(t *testing.T) {
+	tests := []struct {
+		name               string
+		message            string
+		configure          func(cfg *config.LinksConfig)
+		expectedCodes      []domain.ValidationErrorCode
+		expectedActual     []string
+		expectedColumn     string
+		expectedSuggestion string
+	}{
+		{
+			name:    "well-formed links",
+			message: "fix: retry uploads\n\nSee https://tracker.example.com/PROJ-123 and (http://example.org/a_(b)).",
+		},
+		{
+			name:    "links in the subject are ignored",
+			message: "fix: retry https:///uploads",
+		},
+		{
+			name:           "link without host",
+			message:        "fix: retry uploads\n\nSee https:///PROJ-123.",
+			expectedCodes:  []domain.ValidationErrorCode{domain.ErrInvalidURL},
+			expectedActual: []string{"https:///PROJ-123"},
+			expectedColumn: "5",
+		},
+		{
+			name:               "http with https required",
+			message:            "fix: retry uploads\n\nSee <http://tracker.example.com/PROJ-123>.",
+			configure:          func(cfg *config.LinksConfig) { cfg.RequireHTTPS = true },
+			expectedCodes:      []domain.ValidationErrorCode{domain.ErrInsecureURL},
+			expectedActual:     []string{"http://tracker.example.com/PROJ-123"},
+			expectedColumn:     "6",
+			expectedSuggestion: "https://tracker.example.com/PROJ-123",
+		},
+		{
+			name:    "allowed domains and subdomains",
+			message: "fix: retry uploads\n\nSee https://Tracker.Example.com/PROJ-123\nand https://example.com.",
+			configure: func(cfg *config.LinksConfig) {
+				cfg.AllowedDomains = []string{"*.example.com"}
+			},
+		},
+		{
+			name:    "each disallowed link is reported",
+			message: "fix: retry uploads\n\nSee https://example.com.evil.org/x, https://notexample.com\nand https://example.com.",
+			configure: func(cfg *config.LinksConfig) {
+				cfg.AllowedDomains = []string{"example.com"}
+			},
+			expectedCodes:  []domain.ValidationErrorCode{domain.ErrDisallowedURLDomain, domain.ErrDisallowedURLDomain},
+			expectedActual: []string{"https://example.com.evil.org/x", "https://notexample.com"},
+		},
+		{
+			name:           "links forbidden",
+			message:        "fix: retry uploads\n\nSee https://example.com\nand ftp://files.example.com/dump.",
+			configure:      func(cfg *config.LinksConfig) { cfg.Forbid = true },
+			expectedCodes:  []domain.ValidationErrorCode{domain.ErrForbiddenURL, domain.ErrForbiddenURL},
+			expectedActual: []string{"https://example.com", "ftp://files.example.com/dump"},
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			cfg := config.NewDefault()
+			if testCase.configure != nil {
+				testCase.configure(&cfg.Links)
+			}
+
+			errors := rules.NewLinksRule(cfg).Validate(domain.ParseCommitMessage(testCase.message), cfg)
+
+			codes := make([]domain.ValidationErrorCode, 0, len(errors))
+			actual := make([]string, 0, len(errors))
+
+			for _, err := range errors {
+				codes = append(codes, domain.ValidationErrorCode(err.Code))
+				actual = append(actual, err.Actual())
+			}
+
+			if len(testCase.expectedCodes) == 0 {
+				require.Empty(t, codes)
+
+				return
+			}
+
+			require.Equal(t, testCase.expectedCodes, codes)
+			require.Equal(t, testCase.expectedActual, actual)
+			require.Equal(t, "3", errors[0].Context["line"])
+
+			if testCase.expectedColumn != "" {
+				require.Equal(t, testCase.expectedColumn, errors[0].Context["column"])
+			}
+
+			require.Equal(t, testCase.expectedSuggestion, errors[0].Suggestion())
+		})
+	}
+}
@@ -0,0 +1,87 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package rules
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/itiquette/gommitlint/internal/domain"
+	"github.com/itiquette/gommitlint/internal/domain/config"
+)
+
+// MergeSubjectRule requires the subjects of merge commits to match one of the configured
+// patterns, so that repositories keeping merges keep them consistent. While the rule is
+// active, merge commits are validated instead of skipped, by this rule only.
+type MergeSubjectRule struct {
+	sources  []string
+	patterns []*regexp.Regexp
+}
+
+// NewMergeSubjectRule creates a new MergeSubjectRule from config. Invalid patterns,
+// which configuration validation reports, are left out.
+func NewMergeSubjectRule(cfg config.Config) MergeSubjectRule {
+	rule := MergeSubjectRule{sources: cfg.MergeSubject.Patterns}
+
+	for _, source := range cfg.MergeSubject.Patterns {
+		if pattern, err := regexp.Compile(source); err == nil {
+			rule.patterns = append(rule.patterns, pattern)
+		}
+	}
+
+	return rule
+}
+
+// Name returns the rule name.
+func (r MergeSubjectRule) Name() string {
+	return "MergeSubject"
+}
+
+// Metadata returns the documentation of the rule.
+func (r MergeSubjectRule) Metadata() domain.RuleMetadata {
+	return domain.RuleMetadata{
+		ID:       "mergesubject",
+		Name:     r.Name(),
+		Category: domain.CategoryHistory,
+		Severity: domain.SeverityError,
+		Summary:  "Merge commit subjects follow a configured format",
+		Description: "Merge commits are skipped by default. Enabling this rule validates them " +
+			"instead, by this rule alone: the subject must match one of merge_subject.patterns, " +
+			"which default to the subjects git writes for merged branches and tags and GitHub " +
+			"writes for pull requests. The other rules are not applicable to merge commits, as " +
+			"their changes were validated in the commits they merge.",
+		ErrorCodes: []domain.ValidationErrorCode{domain.ErrMergeSubjectMismatch},
+		ConfigKeys: []string{"merge_subject.patterns"},
+		Examples: []domain.RuleExample{
+			{Message: "Merge pull request #42 from octo/retry-uploads", Valid: true},
+			{Message: "Merge branch 'main' into feature/retry", Valid: true},
+			{Message: "merged main", Note: "matches no merge subject pattern"},
+		},
+	}
+}
+
+// Validate checks the subject of a merge commit against the patterns. Other commits pass.
+func (r MergeSubjectRule) Validate(commit domain.Commit, _ config.Config) []domain.ValidationError {
+	if !commit.IsMergeCommit || len(r.patterns) == 0 {
+		return nil
+	}
+
+	for _, pattern := range r.patterns {
+		if pattern.MatchString(commit.Subject) {
+			return nil
+		}
+	}
+
+	expected := strings.Join(r.sources, ", ")
+
+	return []domain.ValidationError{
+		domain.New(r.Name(), domain.ErrMergeSubjectMismatch,
+			fmt.Sprintf("Merge commit subject '%s' matches none of the merge subject patterns", commit.Subject)).
+			WithContextMap(map[string]string{"actual": commit.Subject, "expected": expected}).
+			WithHelp("Keep the subject git or the forge writes for the merge, or one matching " +
+				"merge_subject.patterns: " + expected),
+	}
+}
@@ -0,0 +1,62 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package rules_test
+
+import (
+	"testing"
+
+	"github.com/itiquette/gommitlint/internal/domain"
+	"github.com/itiquette/gommitlint/internal/domain/config"
+	"github.com/itiquette/gommitlint/internal/domain/rules"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMergeSubjectRule(t *testing.T) {
+	tests := []struct {
+		name     string
+		subject  string
+		merge    bool
+		patterns []string
+		valid    bool
+	}{
+		{name: "merged branch", subject: "Merge branch 'main' into feature/retry", merge: true, valid: true},
+		{name: "merged remote branch", subject: "Merge branch 'main' of https://github.com/octo/tools", merge: true, valid: true},
+		{name: "octopus merge", subject: "Merge branches 'a', 'b' and 'c'", merge: true, valid: true},
+		{name: "remote-tracking branch", subject: "Merge remote-tracking branch 'origin/main'", merge: true, valid: true},
+		{name: "merged tag", subject: "Merge tag 'v1.2.0'", merge: true, valid: true},
+		{name: "pull request", subject: "Merge pull request #42 from octo/retry-uploads", merge: true, valid: true},
+		{name: "GitLab merge request", subject: "Merge branch 'retry' into 'main'", merge: true, valid: true},
+		{name: "free-form merge", subject: "merged main", merge: true},
+		{name: "not a merge", subject: "merged main", valid: true},
+		{
+			name:     "configured patterns",
+			subject:  "Merge branch 'main' into feature/retry",
+			merge:    true,
+			patterns: []string{`^Merge pull request #[0-9]+ from \S+$`},
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			cfg := config.NewDefault()
+			if testCase.patterns != nil {
+				cfg.MergeSubject.Patterns = testCase.patterns
+			}
+
+			commit := domain.Commit{Hash: "abc123", Subject: testCase.subject, IsMergeCommit: testCase.merge}
+			errors := rules.NewMergeSubjectRule(cfg).Validate(commit, cfg)
+
+			if testCase.valid {
+				require.Empty(t, errors)
+
+				return
+			}
+
+			require.Len(t, errors, 1)
+			require.Equal(t, string(domain.ErrMergeSubjectMismatch), errors[0].Code)
+			require.Equal(t, testCase.subject, errors[0].Context["actual"])
+		})
+	}
+}
@@ -0,0 +1,126 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package rules_test
+
+import (
+	"testing"
+
+	"github.com/itiquette/gommitlint/internal/adapters/spell"
+	"github.com/itiquette/gommitlint/internal/domain"
+	"github.com/itiquette/gommitlint/internal/domain/config"
+	"github.com/itiquette/gommitlint/internal/domain/rules"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAllRuleMetadata(t *testing.T) {
+	metadata := rules.AllRuleMetadata()
+
+	ids := make([]string, 0, len(metadata))
+	for _, rule := range metadata {
+		ids = append(ids, rule.ID)
+
+		require.NotEmpty(t, rule.Name, rule.ID)
+		require.NotEmpty(t, rule.Summary, rule.ID)
+		require.NotEmpty(t, rule.Description, rule.ID)
+		require.Equal(t, domain.SeverityError, rule.Severity, rule.ID)
+		require.NotEmpty(t, rule.ErrorCodes, rule.ID)
+	}
+
+	require.Equal(t, rules.AvailableRuleNames(), ids, "every rule documents itself")
+}
+
+func TestFindRuleMetadata(t *testing.T) {
+	byID, found := rules.FindRuleMetadata("jirareference")
+	require.True(t, found)
+	require.Equal(t, "JiraReference", byID.Name)
+
+	byName, found := rules.FindRuleMetadata("JiraReference")
+	require.True(t, found)
+	require.Equal(t, byID, byName)
+
+	_, found = rules.FindRuleMetadata("unknown")
+	require.False(t, found)
+}
+
+func TestRegistry(t *testing.T) {
+	categories := []string{domain.CategoryMessage, domain.CategoryReference, domain.CategorySignature, domain.CategoryHistory}
+
+	cfg := config.NewDefault()
+	cfg.Rules.Enabled = rules.AvailableRuleNames()
+
+	created := make(map[string]string)
+	for _, rule := range rules.CreateCommitRules(cfg, rules.Services{}) {
+		created[rule.Name()] = rules.KindCommit
+	}
+
+	for _, rule := range rules.CreateRepositoryRules(cfg, rules.Services{}) {
+		created[rule.Name()] = rules.KindRepository
+	}
+
+	defaults := make(map[string]bool)
+	for _, rule := range rules.CreateCommitRules(config.NewDefault(), rules.Services{}) {
+		defaults[rule.Name()] = true
+	}
+
+	for _, rule := range rules.CreateRepositoryRules(config.NewDefault(), rules.Services{}) {
+		defaults[rule.Name()] = true
+	}
+
+	registry := rules.Registry()
+	require.Len(t, created, len(registry))
+
+	for _, entry := range registry {
+		require.Contains(t, categories, entry.Category, entry.ID)
+		require.Equal(t, entry.Kind, created[entry.Name], "%s creates a rule reporting as %s", entry.ID, entry.Name)
+		require.Equal(t, entry.DefaultEnabled, defaults[entry.Name], entry.ID)
+
+		found, ok := rules.FindRule(entry.Name)
+		require.True(t, ok, entry.ID)
+		require.Equal(t, entry.ID, found.ID)
+	}
+}
+
+func TestIsRuleActive(t *testing.T) {
+	// Built-in rules run by their registered default
+	for _, entry := range rules.Registry() {
+		require.Equal(t, entry.DefaultEnabled, rules.IsRuleActive(entry.ID, nil, nil), entry.ID)
+	}
+
+	require.True(t, rules.IsRuleActive("my-plugin", nil, nil), "custom and plugin rules run unless disabled")
+	require.False(t, rules.IsRuleActive("my-plugin", nil, []string{"My-Plugin"}))
+	require.True(t, rules.IsRuleActive("linearhistory", []string{"linearhistory"}, nil))
+	require.True(t, rules.IsRuleActive("conventional", []string{"conventional"}, []string{"conventional"}), "enabling wins")
+	require.False(t, rules.IsRuleActive("conventional", nil, []string{"conventional"}))
+}
+
+// TestRuleMetadataExamples keeps the documented examples honest by validating them.
+func TestRuleMetadataExamples(t *testing.T) {
+	cfg := config.NewDefault()
+	cfg.Message.Body.MinSignoffCount = 1 // The sign-off examples assume one is required
+
+	for _, constructor := range []func(config.Config) domain.CommitRule{
+		func(c config.Config) domain.CommitRule { return rules.NewSubjectRule(c) },
+		func(c config.Config) domain.CommitRule { return rules.NewConventionalCommitRule(c) },
+		func(c config.Config) domain.CommitRule { return rules.NewCommitBodyRule(c) },
+		func(c config.Config) domain.CommitRule { return rules.NewJiraReferenceRule(c) },
+		func(c config.Config) domain.CommitRule { return rules.NewSignOffRule(c) },
+		func(c config.Config) domain.CommitRule { return rules.NewTrailersRule(c) },
+		func(c config.Config) domain.CommitRule {
+			return rules.NewSpellRule(spell.NewMisspellAdapter(c.Spell.Locale), c)
+		},
+	} {
+		rule := constructor(cfg)
+
+		described, ok := rule.(domain.DescribedRule)
+		require.True(t, ok, rule.Name())
+
+		for _, example := range described.Metadata().Examples {
+			t.Run(rule.Name()+"/"+example.Message, func(t *testing.T) {
+				errors := rule.Validate(domain.ParseCommitMessage(example.Message), cfg)
+				require.Equal(t, example.Valid, len(errors) == 0, "%v", errors)
+			})
+		}
+	}
+}
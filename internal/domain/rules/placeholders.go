@@ -0,0 +1,143 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package rules
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/itiquette/gommitlint/internal/domain"
+	"github.com/itiquette/gommitlint/internal/domain/config"
+)
+
+// placeholderPattern finds the placeholders of commit templates, such as
+// "<type>(<scope>): <subject>" and "TODO: describe the change".
+var placeholderPattern = regexp.MustCompile(
+	`(?i)<(type|scope|subject|summary|description|body|footer|issue|ticket|ticket[ -]id|reason|breaking[ -]change)>` +
+		`|\bTODO\b:?\s+(describe|explain|write|fill in)\b`)
+
+// scissorsLine is the line below which git removes everything from a message it
+// cleans up, as written by git commit --verbose.
+const scissorsLine = "# ------------------------ >8 ------------------------"
+
+// PlaceholdersRule rejects messages that still hold the placeholders or instructions
+// of a commit template, which slip through with git commit -F on an unedited template.
+type PlaceholdersRule struct {
+	patterns []*regexp.Regexp
+}
+
+// NewPlaceholdersRule creates a new PlaceholdersRule from config. Invalid patterns,
+// which configuration validation reports, are left out.
+func NewPlaceholdersRule(cfg config.Config) PlaceholdersRule {
+	rule := PlaceholdersRule{patterns: []*regexp.Regexp{placeholderPattern}}
+
+	for _, source := range cfg.Placeholders.Patterns {
+		if pattern, err := regexp.Compile(source); err == nil {
+			rule.patterns = append(rule.patterns, pattern)
+		}
+	}
+
+	return rule
+}
+
+// Name returns the rule name.
+func (r PlaceholdersRule) Name() string {
+	return "Placeholders"
+}
+
+// Metadata returns the documentation of the rule.
+func (r PlaceholdersRule) Metadata() domain.RuleMetadata {
+	return domain.RuleMetadata{
+		ID:       "placeholders",
+		Name:     r.Name(),
+		Category: domain.CategoryMessage,
+		Severity: domain.SeverityError,
+		Summary:  "No template placeholders or instructions left in the message",
+		Description: "Reports lines holding placeholders of commit templates, such as <type>, " +
+			"<scope> or 'TODO: describe', or matching one of placeholders.patterns, HTML " +
+			"comments opened with <!--, and lines starting with #. Message files are checked " +
+			"as git leaves them after cleaning up an edited message: lines starting with # and " +
+			"everything below the scissors line of git commit --verbose are ignored there. " +
+			"Lines starting with an issue number such as #123 are not comments.",
+		ErrorCodes: []domain.ValidationErrorCode{domain.ErrTemplatePlaceholder, domain.ErrTemplateComment},
+		ConfigKeys: []string{"placeholders.patterns"},
+		Examples: []domain.RuleExample{
+			{Message: "feat(upload): retry failed uploads", Valid: true},
+			{Message: "<type>(<scope>): <subject>", Note: "unedited template"},
+			{Message: "feat: retry uploads\n\nTODO: describe the change", Note: "instruction left in the body"},
+		},
+	}
+}
+
+// Validate reports every line of the message that holds template text.
+func (r PlaceholdersRule) Validate(commit domain.Commit, _ config.Config) []domain.ValidationError {
+	message := commit.FullMessage()
+
+	// Git removes the comments of a message file after the commit-msg hook has run
+	messageFile := commit.Hash == ""
+
+	var errors []domain.ValidationError
+
+	for lineIndex, line := range strings.Split(message, "\n") {
+		if messageFile && line == scissorsLine {
+			break
+		}
+
+		if isCommentLine(line) {
+			if !messageFile {
+				errors = append(errors, r.commentError(line, lineIndex+1, 1))
+			}
+
+			continue
+		}
+
+		if column := strings.Index(line, "<!--"); column >= 0 {
+			errors = append(errors, r.commentError(line, lineIndex+1, utf8.RuneCountInString(line[:column])+1))
+
+			continue
+		}
+
+		for _, pattern := range r.patterns {
+			if match := pattern.FindStringIndex(line); match != nil {
+				errors = append(errors, r.placeholderError(line[match[0]:match[1]], lineIndex+1,
+					utf8.RuneCountInString(line[:match[0]])+1))
+
+				break
+			}
+		}
+	}
+
+	return errors
+}
+
+// commentError reports the template comment on line, at the 1-based line and column.
+func (r PlaceholdersRule) commentError(line string, lineNumber, column int) domain.ValidationError {
+	return domain.New(r.Name(), domain.ErrTemplateComment,
+		fmt.Sprintf("Template comment at line %d: %s", lineNumber, line)).
+		WithContextMap(map[string]string{"actual": line}).
+		WithPosition(lineNumber, column).
+		WithHelp("Remove the instructions of the commit template from the message")
+}
+
+// placeholderError reports placeholder, at the 1-based line and column.
+func (r PlaceholdersRule) placeholderError(placeholder string, lineNumber, column int) domain.ValidationError {
+	return domain.New(r.Name(), domain.ErrTemplatePlaceholder,
+		fmt.Sprintf("Template placeholder '%s' at line %d, column %d", placeholder, lineNumber, column)).
+		WithContextMap(map[string]string{"actual": placeholder}).
+		WithPosition(lineNumber, column).
+		WithHelp("Replace '" + placeholder + "' with the text it stands for")
+}
+
+// isCommentLine reports whether line is a comment of git, which starts with #, and not
+// an issue reference such as "#123 is fixed by this".
+func isCommentLine(line string) bool {
+	if !strings.HasPrefix(line, "#") {
+		return false
+	}
+
+	return len(line) == 1 || line[1] < '0' || line[1] > '9'
+}
@@ -0,0 +1,95 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package rules_test
+
+import (
+	"testing"
+
+	"github.com/itiquette/gommitlint/internal/domain"
+	"github.com/itiquette/gommitlint/internal/domain/config"
+	"github.com/itiquette/gommitlint/internal/domain/rules"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPlaceholdersRule(t *testing.T) {
+	tests := []struct {
+		name             string
+		message          string
+		messageFile      bool
+		patterns         []string
+		expectedCodes    []domain.ValidationErrorCode
+		expectedMessages []string
+	}{
+		{
+			name:    "edited message",
+			message: "feat(upload): retry failed uploads\n\nUploads fail on flaky networks.\n#123 is fixed by this.",
+		},
+		{
+			name:             "unedited template",
+			message:          "<type>(<scope>): <subject>\n\n<body>",
+			expectedCodes:    []domain.ValidationErrorCode{domain.ErrTemplatePlaceholder, domain.ErrTemplatePlaceholder},
+			expectedMessages: []string{"Template placeholder '<type>' at line 1, column 1", "Template placeholder '<body>' at line 3, column 1"},
+		},
+		{
+			name:             "instruction in the body",
+			message:          "feat: retry uploads\n\nTODO: describe why",
+			expectedCodes:    []domain.ValidationErrorCode{domain.ErrTemplatePlaceholder},
+			expectedMessages: []string{"Template placeholder 'TODO: describe' at line 3, column 1"},
+		},
+		{
+			name:          "comment lines",
+			message:       "feat: retry uploads\n\n# Explain why the change is needed\nUploads fail.\n<!-- Link the ticket -->",
+			expectedCodes: []domain.ValidationErrorCode{domain.ErrTemplateComment, domain.ErrTemplateComment},
+		},
+		{
+			name:        "comments of a message file",
+			message:     "feat: retry uploads\n\n# Please enter the commit message for your changes.\n# ------------------------ >8 ------------------------\n+<type> in the diff",
+			messageFile: true,
+		},
+		{
+			name:          "placeholder in a message file",
+			message:       "feat: <description>\n\n# Please enter the commit message for your changes.",
+			messageFile:   true,
+			expectedCodes: []domain.ValidationErrorCode{domain.ErrTemplatePlaceholder},
+		},
+		{
+			name:             "configured pattern",
+			message:          "feat: retry uploads\n\nRefs PROJ-XXX",
+			patterns:         []string{`PROJ-X+\b`},
+			expectedCodes:    []domain.ValidationErrorCode{domain.ErrTemplatePlaceholder},
+			expectedMessages: []string{"Template placeholder 'PROJ-XXX' at line 3, column 6"},
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			cfg := config.NewDefault()
+			cfg.Placeholders.Patterns = testCase.patterns
+
+			hash := "abc123"
+			if testCase.messageFile {
+				hash = ""
+			}
+
+			commit := domain.NewCommit(hash, testCase.message, "Dev Eloper", "dev@example.com", "", "", false)
+			errors := rules.NewPlaceholdersRule(cfg).Validate(commit, cfg)
+
+			codes := make([]domain.ValidationErrorCode, 0, len(errors))
+			for _, err := range errors {
+				codes = append(codes, domain.ValidationErrorCode(err.Code))
+			}
+
+			if testCase.expectedCodes == nil {
+				testCase.expectedCodes = []domain.ValidationErrorCode{}
+			}
+
+			require.Equal(t, testCase.expectedCodes, codes)
+
+			for i, expected := range testCase.expectedMessages {
+				require.Equal(t, expected, errors[i].Message)
+			}
+		})
+	}
+}
@@ -0,0 +1,222 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package rules
+
+import (
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/itiquette/gommitlint/internal/adapters/spell"
+	"github.com/itiquette/gommitlint/internal/domain"
+	"github.com/itiquette/gommitlint/internal/domain/config"
+)
+
+// Kinds of rules, by what they validate.
+const (
+	KindCommit     = "commit"     // Validates a commit on its own
+	KindRepository = "repository" // Validates a commit within its repository
+)
+
+// Registration describes a built-in rule: its documentation, whether it runs without
+// being enabled and how it is created.
+type Registration struct {
+	domain.RuleMetadata
+
+	Kind           string // KindCommit or KindRepository
+	DefaultEnabled bool   // Runs unless named in rules.disabled
+
+	newCommitRule     func(config.Config, Services) domain.CommitRule
+	newRepositoryRule func(config.Config, Services) domain.RepositoryRule
+}
+
+// registrations lists the built-in rules by configuration name. Their metadata is
+// filled in by Registry from the rules themselves.
+func registrations() []Registration {
+	return []Registration{
+		commitRule("subject", true, func(c config.Config) domain.CommitRule { return NewSubjectRule(c) }),
+		commitRule("conventional", true, func(c config.Config) domain.CommitRule { return NewConventionalCommitRule(c) }),
+		commitRule("encoding", true, func(config.Config) domain.CommitRule { return NewEncodingRule() }),
+		commitRule("commitbody", false, func(c config.Config) domain.CommitRule { return NewCommitBodyRule(c) }),
+		serviceCommitRule("jirareference", false, func(c config.Config, s Services) domain.CommitRule { return createJiraReferenceRule(c, s) }),
+		commitRule("signoff", true, func(c config.Config) domain.CommitRule { return NewSignOffRule(c) }),
+		serviceCommitRule("signature", true, func(c config.Config, s Services) domain.CommitRule { return createSignatureRule(c, s) }),
+		commitRule("identity", false, func(c config.Config) domain.CommitRule { return NewIdentityRule(c) }),
+		commitRule("trailers", false, func(c config.Config) domain.CommitRule { return NewTrailersRule(c) }),
+		commitRule("characters", false, func(c config.Config) domain.CommitRule { return NewCharactersRule(c) }),
+		commitRule("subjectpattern", false, func(c config.Config) domain.CommitRule { return NewSubjectPatternRule(c) }),
+		commitRule("mergesubject", false, func(c config.Config) domain.CommitRule { return NewMergeSubjectRule(c) }),
+		commitRule("placeholders", false, func(c config.Config) domain.CommitRule { return NewPlaceholdersRule(c) }),
+		commitRule("language", false, func(c config.Config) domain.CommitRule { return NewLanguageRule(c) }),
+		commitRule("hygiene", false, func(c config.Config) domain.CommitRule { return NewHygieneRule(c) }),
+		commitRule("links", false, func(c config.Config) domain.CommitRule { return NewLinksRule(c) }),
+		serviceCommitRule("issuereference", false, func(c config.Config, s Services) domain.CommitRule { return createIssueReferenceRule(c, s) }),
+		commitRule("changeid", false, func(c config.Config) domain.CommitRule { return NewChangeIDRule(c) }),
+		serviceCommitRule("cla", false, func(c config.Config, s Services) domain.CommitRule { return createCLARule(c, s) }),
+		// Spell is disabled by the application defaults in rules.disabled instead
+		commitRule("spell", true, func(c config.Config) domain.CommitRule {
+			return NewSpellRule(spell.NewMisspellAdapter(c.Spell.Locale), c)
+		}),
+		repositoryRule("branchahead", true, func(c config.Config) domain.RepositoryRule { return NewBranchAheadRule(c) }),
+		repositoryRule("review", false, func(c config.Config) domain.RepositoryRule { return NewReviewRule(c) }),
+		repositoryRule("linearhistory", false, func(c config.Config) domain.RepositoryRule { return NewLinearHistoryRule(c) }),
+		repositoryRule("branchticket", false, func(c config.Config) domain.RepositoryRule { return NewBranchTicketRule(c) }),
+		serviceRepositoryRule("subjectecho", false, func(c config.Config, s Services) domain.RepositoryRule { return createSubjectEchoRule(c, s) }),
+		repositoryRule("breakingchange", false, func(c config.Config) domain.RepositoryRule { return NewBreakingChangeRule(c) }),
+		repositoryRule("revert", false, func(c config.Config) domain.RepositoryRule { return NewRevertRule(c) }),
+		repositoryRule("fixup", false, func(c config.Config) domain.RepositoryRule { return NewFixupRule(c) }),
+		repositoryRule("forcepush", false, func(c config.Config) domain.RepositoryRule { return NewForcePushRule(c) }),
+		repositoryRule("commitsize", false, func(c config.Config) domain.RepositoryRule { return NewCommitSizeRule(c) }),
+	}
+}
+
+// commitRule registers a commit rule.
+func commitRule(id string, defaultEnabled bool, create func(config.Config) domain.CommitRule) Registration {
+	return serviceCommitRule(id, defaultEnabled, func(c config.Config, _ Services) domain.CommitRule { return create(c) })
+}
+
+// serviceCommitRule registers a commit rule consulting external services.
+func serviceCommitRule(id string, defaultEnabled bool, create func(config.Config, Services) domain.CommitRule) Registration {
+	return Registration{
+		RuleMetadata:   domain.RuleMetadata{ID: id},
+		Kind:           KindCommit,
+		DefaultEnabled: defaultEnabled,
+		newCommitRule:  create,
+	}
+}
+
+// repositoryRule registers a repository rule.
+func repositoryRule(id string, defaultEnabled bool, create func(config.Config) domain.RepositoryRule) Registration {
+	return serviceRepositoryRule(id, defaultEnabled, func(c config.Config, _ Services) domain.RepositoryRule { return create(c) })
+}
+
+// serviceRepositoryRule registers a repository rule consulting external services.
+func serviceRepositoryRule(id string, defaultEnabled bool, create func(config.Config, Services) domain.RepositoryRule) Registration {
+	return Registration{
+		RuleMetadata:      domain.RuleMetadata{ID: id},
+		Kind:              KindRepository,
+		DefaultEnabled:    defaultEnabled,
+		newRepositoryRule: create,
+	}
+}
+
+// registry holds the registrations with their metadata, which does not change at run time.
+// The rules are created without services, which their metadata does not depend on.
+var registry = sync.OnceValue(func() []Registration {
+	cfg := config.NewDefault()
+	entries := registrations()
+
+	for i, entry := range entries {
+		var rule any
+		if entry.newCommitRule != nil {
+			rule = entry.newCommitRule(cfg, Services{})
+		} else {
+			rule = entry.newRepositoryRule(cfg, Services{})
+		}
+
+		if described, ok := rule.(domain.DescribedRule); ok {
+			entries[i].RuleMetadata = described.Metadata()
+		}
+	}
+
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].ID < entries[j].ID
+	})
+
+	return entries
+})
+
+// Registry returns the built-in rules sorted by configuration name.
+func Registry() []Registration {
+	return slices.Clone(registry())
+}
+
+// FindRule returns the built-in rule known by name, which is either its configuration
+// name or its name in reports, ignoring case.
+func FindRule(name string) (Registration, bool) {
+	cleanName := domain.CleanRuleName(name)
+
+	for _, entry := range registry() {
+		if entry.ID == cleanName || strings.ToLower(entry.Name) == cleanName {
+			return entry, true
+		}
+	}
+
+	return Registration{}, false
+}
+
+// AvailableRuleNames returns the configuration names of all known rules, sorted alphabetically.
+func AvailableRuleNames() []string {
+	entries := registry()
+
+	names := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		names = append(names, entry.ID)
+	}
+
+	return names
+}
+
+// AllRuleMetadata returns the documentation of all known rules, sorted by configuration name.
+func AllRuleMetadata() []domain.RuleMetadata {
+	entries := registry()
+
+	metadata := make([]domain.RuleMetadata, 0, len(entries))
+	for _, entry := range entries {
+		metadata = append(metadata, entry.RuleMetadata)
+	}
+
+	return metadata
+}
+
+// FindRuleMetadata returns the documentation of the rule known by name, which is either its
+// configuration name or its name in reports, ignoring case.
+func FindRuleMetadata(name string) (domain.RuleMetadata, bool) {
+	entry, found := FindRule(name)
+
+	return entry.RuleMetadata, found
+}
+
+// IsRuleActive determines if a rule should run based on configuration.
+// Priority: 1) Explicit enable wins, 2) Explicit disable, 3) the default of the built-in
+// rule, 4) other rules, such as custom and plugin rules, run.
+func IsRuleActive(ruleName string, enabled, disabled []string) bool {
+	cleanName := domain.CleanRuleName(ruleName)
+	named := func(name string) bool { return domain.CleanRuleName(name) == cleanName }
+
+	// Priority 1: Explicitly enabled always runs
+	if slices.ContainsFunc(enabled, named) {
+		return true
+	}
+
+	// Priority 2: Explicitly disabled never runs
+	if slices.ContainsFunc(disabled, named) {
+		return false
+	}
+
+	// Priority 3: Default behavior; the registrations, as rules ask while they are created
+	for _, entry := range registrations() {
+		if entry.ID == cleanName {
+			return entry.DefaultEnabled
+		}
+	}
+
+	return true
+}
+
+// defaultEnabledRules returns the configuration names of the rules of kind that run
+// without being enabled.
+func defaultEnabledRules(kind string) []string {
+	var names []string
+
+	for _, entry := range registrations() {
+		if entry.Kind == kind && entry.DefaultEnabled {
+			names = append(names, entry.ID)
+		}
+	}
+
+	return names
+}
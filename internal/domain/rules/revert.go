@@ -0,0 +1,142 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package rules
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/itiquette/gommitlint/internal/domain"
+	"github.com/itiquette/gommitlint/internal/domain/config"
+)
+
+// Subject formats of reverts, by revert.format.
+const (
+	RevertFormatAny          = "any"
+	RevertFormatGit          = domain.RevertSubjectGit
+	RevertFormatConventional = domain.RevertSubjectConventional
+)
+
+// RevertRule validates reverts: they must name the commits they revert, those commits
+// must exist and the subject must have the configured form.
+type RevertRule struct {
+	format string
+}
+
+// NewRevertRule creates a new RevertRule from config.
+func NewRevertRule(cfg config.Config) RevertRule {
+	return RevertRule{format: cfg.Revert.Format}
+}
+
+// Name returns the rule name.
+func (r RevertRule) Name() string {
+	return "Revert"
+}
+
+// Metadata returns the documentation of the rule.
+func (r RevertRule) Metadata() domain.RuleMetadata {
+	return domain.RuleMetadata{
+		ID:       "revert",
+		Name:     r.Name(),
+		Category: domain.CategoryHistory,
+		Severity: domain.SeverityError,
+		Summary:  "Reverts name existing commits and have the configured subject",
+		Description: "Applies to reverts: commits with a subject such as 'Revert \"...\"' or " +
+			"'revert: ...', or with a 'This reverts commit <hash>' line. The line is required " +
+			"and every commit it names must exist in the repository. revert.format requires " +
+			"the subject git writes ('git'), a conventional revert ('conventional') or accepts " +
+			"either ('any'). revert.exempt_rules lists commit rules that do not check reverts.",
+		ErrorCodes: []domain.ValidationErrorCode{
+			domain.ErrMissingRevertReference, domain.ErrRevertedCommitNotFound, domain.ErrInvalidRevertFormat,
+		},
+		ConfigKeys: []string{"revert.format", "revert.exempt_rules"},
+		Examples: []domain.RuleExample{
+			{Message: "Revert \"feat: add login page\"\n\nThis reverts commit 1a2b3c4d5e6f.", Valid: true},
+			{Message: "revert: add login page", Note: "does not name the reverted commit"},
+		},
+	}
+}
+
+// ChecksMessage marks the rule as a domain.MessageRule.
+func (r RevertRule) ChecksMessage() {}
+
+// Validate checks a revert. Commits that are not reverts are not checked.
+func (r RevertRule) Validate(commit domain.Commit, repo domain.Repository, _ config.Config) []domain.ValidationError {
+	if commit.IsMergeCommit {
+		return nil
+	}
+
+	revert, isRevert := domain.ParseRevert(commit)
+	if !isRevert {
+		return nil
+	}
+
+	var errors []domain.ValidationError
+
+	if len(revert.Hashes) == 0 {
+		errors = append(errors,
+			domain.New(r.Name(), domain.ErrMissingRevertReference, "Revert does not name the reverted commit").
+				WithContextMap(map[string]string{
+					"expected": "This reverts commit <hash>.",
+				}).
+				WithHelp("Add 'This reverts commit <hash>.' to the body, as 'git revert' does"))
+	}
+
+	for _, hash := range revert.Hashes {
+		if repo == nil {
+			break
+		}
+
+		if _, err := repo.GetCommit(context.Background(), hash); err != nil {
+			errors = append(errors,
+				domain.New(r.Name(), domain.ErrRevertedCommitNotFound,
+					fmt.Sprintf("Reverted commit %s does not exist", hash)).
+					WithContextMap(map[string]string{
+						"actual": hash,
+					}).
+					WithHelp("Name the hash of a commit in this repository, or fetch the commit"))
+		}
+	}
+
+	if formatError, found := r.validateFormat(commit.Subject, revert); found {
+		errors = append(errors, formatError)
+	}
+
+	return errors
+}
+
+// validateFormat checks the subject of a revert against the configured format.
+func (r RevertRule) validateFormat(subject string, revert domain.Revert) (domain.ValidationError, bool) {
+	reverted := revert.RevertedSubject
+	if reverted == "" {
+		reverted = subject
+	}
+
+	var expected, suggestion string
+
+	switch r.format {
+	case RevertFormatGit:
+		expected = `Revert "<subject>"`
+		suggestion = `Revert "` + reverted + `"`
+	case RevertFormatConventional:
+		expected = "revert: <subject>"
+		suggestion = "revert: " + domain.ExtractDescriptionFromConventional(reverted)
+	default:
+		return domain.ValidationError{}, false
+	}
+
+	if revert.SubjectForm == r.format {
+		return domain.ValidationError{}, false
+	}
+
+	return domain.New(r.Name(), domain.ErrInvalidRevertFormat,
+		fmt.Sprintf("Revert subject must have the form %s", expected)).
+		WithContextMap(map[string]string{
+			"actual":   subject,
+			"expected": expected,
+		}).
+		WithHelp("Write the subject of reverts as " + expected).
+		WithSuggestion(suggestion), true
+}
@@ -0,0 +1,111 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package rules_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/itiquette/gommitlint/internal/domain"
+	"github.com/itiquette/gommitlint/internal/domain/config"
+	"github.com/itiquette/gommitlint/internal/domain/rules"
+	"github.com/stretchr/testify/require"
+)
+
+// commitsRepository is a repository stub that only knows the commits with the given hashes.
+type commitsRepository struct {
+	domain.Repository
+
+	hashes []string
+}
+
+func (r commitsRepository) GetCommit(_ context.Context, ref string) (domain.Commit, error) {
+	for _, hash := range r.hashes {
+		if strings.HasPrefix(hash, ref) {
+			return domain.Commit{Hash: hash}, nil
+		}
+	}
+
+	return domain.Commit{}, errors.New("object not found")
+}
+
+func TestRevertRule(t *testing.T) {
+	const known = "1a2b3c4d5e6f7a8b9c0d1e2f3a4b5c6d7e8f9a0b"
+
+	tests := []struct {
+		name               string
+		format             string
+		subject            string
+		body               string
+		expectedCodes      []domain.ValidationErrorCode
+		expectedSuggestion string
+	}{
+		{
+			name:    "git revert of an existing commit",
+			subject: `Revert "feat: add login page"`,
+			body:    "This reverts commit " + known + ".",
+		},
+		{
+			name:    "abbreviated hash",
+			format:  rules.RevertFormatConventional,
+			subject: "revert: add login page",
+			body:    "This reverts commit 1a2b3c4.",
+		},
+		{
+			name:    "commit that is not a revert",
+			format:  rules.RevertFormatGit,
+			subject: "feat: add login page",
+		},
+		{
+			name:          "revert without reference",
+			subject:       "revert: add login page",
+			expectedCodes: []domain.ValidationErrorCode{domain.ErrMissingRevertReference},
+		},
+		{
+			name:          "reverted commit not in the repository",
+			subject:       `Revert "feat: add login page"`,
+			body:          "This reverts commit 9f8e7d6c.",
+			expectedCodes: []domain.ValidationErrorCode{domain.ErrRevertedCommitNotFound},
+		},
+		{
+			name:               "conventional revert where git form is required",
+			format:             rules.RevertFormatGit,
+			subject:            "revert: add login page",
+			body:               "This reverts commit 1a2b3c4.",
+			expectedCodes:      []domain.ValidationErrorCode{domain.ErrInvalidRevertFormat},
+			expectedSuggestion: `Revert "add login page"`,
+		},
+		{
+			name:               "git revert where conventional form is required",
+			format:             rules.RevertFormatConventional,
+			subject:            `Revert "feat: add login page"`,
+			body:               "This reverts commit 1a2b3c4.",
+			expectedCodes:      []domain.ValidationErrorCode{domain.ErrInvalidRevertFormat},
+			expectedSuggestion: "revert: add login page",
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			cfg := config.Config{Revert: config.RevertConfig{Format: testCase.format}}
+			commit := domain.Commit{Subject: testCase.subject, Body: testCase.body}
+
+			errors := rules.NewRevertRule(cfg).Validate(commit, commitsRepository{hashes: []string{known}}, cfg)
+
+			codes := make([]domain.ValidationErrorCode, 0, len(errors))
+			for _, err := range errors {
+				codes = append(codes, domain.ValidationErrorCode(err.Code))
+			}
+
+			require.ElementsMatch(t, testCase.expectedCodes, codes)
+
+			if testCase.expectedSuggestion != "" {
+				require.Equal(t, testCase.expectedSuggestion, errors[0].Suggestion())
+			}
+		})
+	}
+}
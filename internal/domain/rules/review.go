@@ -0,0 +1,173 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package rules
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"strconv"
+	"strings"
+
+	"github.com/itiquette/gommitlint/internal/domain"
+	"github.com/itiquette/gommitlint/internal/domain/config"
+)
+
+// ReviewRule requires Reviewed-by and Acked-by trailers from valid reviewers on commits
+// targeting protected branches, as in kernel-style maintainer workflows.
+type ReviewRule struct {
+	branches  []config.ReviewBranchConfig
+	reviewers []domain.Identity
+}
+
+// NewReviewRule creates a new ReviewRule from config.
+func NewReviewRule(cfg config.Config) ReviewRule {
+	reviewers := make([]domain.Identity, 0, len(cfg.Review.Reviewers))
+	for _, reviewer := range cfg.Review.Reviewers {
+		reviewers = append(reviewers, domain.NewIdentityFromString(reviewer))
+	}
+
+	return ReviewRule{
+		branches:  cfg.Review.Branches,
+		reviewers: reviewers,
+	}
+}
+
+// Name returns the rule name.
+func (r ReviewRule) Name() string {
+	return "Review"
+}
+
+// Metadata returns the documentation of the rule.
+func (r ReviewRule) Metadata() domain.RuleMetadata {
+	return domain.RuleMetadata{
+		ID:       "review",
+		Name:     r.Name(),
+		Category: domain.CategoryHistory,
+		Severity: domain.SeverityError,
+		Summary:  "Reviewed-by and Acked-by trailers on protected branches",
+		Description: "Requires Reviewed-by and Acked-by trailers on commits targeting a branch in " +
+			"review.branches, as many as the first matching entry sets. A reviewer counts once, " +
+			"must not be the author, and must be listed in review.reviewers when that is set.",
+		ErrorCodes: []domain.ValidationErrorCode{domain.ErrMissingReview, domain.ErrInvalidReviewer},
+		ConfigKeys: []string{"review.branches", "review.reviewers"},
+	}
+}
+
+// ChecksMessage marks the rule as a domain.MessageRule.
+func (r ReviewRule) ChecksMessage() {}
+
+// Validate checks the review trailers of a commit against the requirements of the branch
+// it targets. The target branch is resolved through the repository; commits are not checked
+// when it cannot be resolved or no configured branch matches it.
+func (r ReviewRule) Validate(commit domain.Commit, repo domain.Repository, _ config.Config) []domain.ValidationError {
+	if len(r.branches) == 0 || commit.IsMergeCommit {
+		return nil
+	}
+
+	resolver, ok := repo.(domain.BranchResolver)
+	if !ok {
+		return nil
+	}
+
+	// Detached and unborn heads have no target branch
+	branch, err := resolver.GetTargetBranch(context.Background())
+	if err != nil || branch == "" {
+		return nil
+	}
+
+	requirement, found := r.requirementFor(branch)
+	if !found {
+		return nil
+	}
+
+	trailers := commit.MessageTrailers()
+
+	reviewedBy, errors := r.countReviewers(commit, trailers, "Reviewed-by")
+	ackedBy, ackErrors := r.countReviewers(commit, trailers, "Acked-by")
+	errors = append(errors, ackErrors...)
+
+	if reviewedBy < requirement.MinReviewedBy {
+		errors = append(errors, r.missingReviewError("Reviewed-by", branch, reviewedBy, requirement.MinReviewedBy))
+	}
+
+	if ackedBy < requirement.MinAckedBy {
+		errors = append(errors, r.missingReviewError("Acked-by", branch, ackedBy, requirement.MinAckedBy))
+	}
+
+	return errors
+}
+
+// requirementFor returns the first configured requirement whose branch name or glob matches branch.
+func (r ReviewRule) requirementFor(branch string) (config.ReviewBranchConfig, bool) {
+	for _, requirement := range r.branches {
+		if matched, err := path.Match(requirement.Branch, branch); err == nil && matched {
+			return requirement, true
+		}
+	}
+
+	return config.ReviewBranchConfig{}, false
+}
+
+// countReviewers counts the distinct valid reviewers in the trailers with the given key.
+// A reviewer must be a "Name <email>" identity other than the commit author and, when
+// reviewers are configured, one of them. Identities are resolved through the .mailmap.
+func (r ReviewRule) countReviewers(commit domain.Commit, trailers []domain.Trailer, key string) (int, []domain.ValidationError) {
+	var errors []domain.ValidationError
+
+	author := commit.Mailmap.Resolve(domain.NewIdentity(commit.Author, commit.AuthorEmail))
+	seen := make(map[string]bool)
+
+	for _, value := range domain.TrailerValues(trailers, key) {
+		reviewer := domain.NewIdentityFromString(value)
+
+		switch {
+		case reviewer.Name() == "" || !strings.Contains(reviewer.Email(), "@"):
+			errors = append(errors, r.invalidReviewerError(key, value, "is not a 'Name <email>' identity",
+				"Write the trailer as '"+key+": Full Name <email@example.com>'"))
+
+			continue
+		case commit.Mailmap.Resolve(reviewer).Matches(author):
+			errors = append(errors, r.invalidReviewerError(key, value, "is the commit author",
+				"A review must come from someone other than the author"))
+
+			continue
+		case len(r.reviewers) > 0 && !commit.Mailmap.Resolve(reviewer).MatchesAny(r.reviewers):
+			errors = append(errors, r.invalidReviewerError(key, value, "is not an accepted reviewer",
+				"Ask a reviewer listed in review.reviewers, or add them to the list"))
+
+			continue
+		}
+
+		seen[strings.ToLower(commit.Mailmap.Resolve(reviewer).Email())] = true
+	}
+
+	return len(seen), errors
+}
+
+// missingReviewError creates an error for too few review trailers of one kind.
+func (r ReviewRule) missingReviewError(key, branch string, actual, expected int) domain.ValidationError {
+	return domain.New(r.Name(), domain.ErrMissingReview,
+		fmt.Sprintf("Commits to '%s' need %d %s trailer(s), found %d", branch, expected, key, actual)).
+		WithContextMap(map[string]string{
+			"actual":   strconv.Itoa(actual),
+			"expected": strconv.Itoa(expected),
+			"trailer":  key,
+			"branch":   branch,
+		}).
+		WithHelp(fmt.Sprintf("Add '%s: Name <email>' trailers from reviewers before the commit lands on '%s'", key, branch))
+}
+
+// invalidReviewerError creates an error for a review trailer that does not count.
+func (r ReviewRule) invalidReviewerError(key, value, reason, help string) domain.ValidationError {
+	return domain.New(r.Name(), domain.ErrInvalidReviewer,
+		fmt.Sprintf("%s '%s' %s", key, value, reason)).
+		WithContextMap(map[string]string{
+			"actual":   value,
+			"expected": "valid reviewer identity",
+			"trailer":  key,
+		}).
+		WithHelp(help)
+}
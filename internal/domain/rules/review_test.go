@@ -0,0 +1,156 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package rules_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/itiquette/gommitlint/internal/domain"
+	"github.com/itiquette/gommitlint/internal/domain/config"
+	"github.com/itiquette/gommitlint/internal/domain/rules"
+	"github.com/stretchr/testify/require"
+)
+
+// branchRepository is a repository stub that only resolves the target branch.
+type branchRepository struct {
+	domain.Repository
+
+	branch string
+}
+
+func (r branchRepository) GetTargetBranch(_ context.Context) (string, error) {
+	return r.branch, nil
+}
+
+func TestReviewRule(t *testing.T) {
+	const (
+		alice = "Reviewed-by: Alice Reviewer <alice@example.com>"
+		bob   = "Reviewed-by: Bob Reviewer <bob@example.com>"
+	)
+
+	tests := []struct {
+		name          string
+		branch        string
+		trailers      string
+		reviewers     []string
+		mailmap       string
+		expectedCodes []domain.ValidationErrorCode
+	}{
+		{
+			name:     "enough reviews on protected branch",
+			branch:   "main",
+			trailers: alice + "\n" + bob,
+		},
+		{
+			name:          "too few reviews on protected branch",
+			branch:        "main",
+			trailers:      alice,
+			expectedCodes: []domain.ValidationErrorCode{domain.ErrMissingReview},
+		},
+		{
+			name:   "unprotected branch",
+			branch: "feature/login",
+		},
+		{
+			name:          "glob pattern requires ack",
+			branch:        "release/1.0",
+			trailers:      alice,
+			expectedCodes: []domain.ValidationErrorCode{domain.ErrMissingReview},
+		},
+		{
+			name:     "glob pattern satisfied",
+			branch:   "release/1.0",
+			trailers: "Acked-by: Bob Reviewer <bob@example.com>\n" + alice,
+		},
+		{
+			name:          "same reviewer counted once",
+			branch:        "main",
+			trailers:      alice + "\nReviewed-by: Alice R. <ALICE@example.com>",
+			expectedCodes: []domain.ValidationErrorCode{domain.ErrMissingReview},
+		},
+		{
+			name:          "self review",
+			branch:        "main",
+			trailers:      alice + "\nReviewed-by: Dev Eloper <dev@example.com>",
+			expectedCodes: []domain.ValidationErrorCode{domain.ErrInvalidReviewer, domain.ErrMissingReview},
+		},
+		{
+			name:          "self review through mailmap",
+			branch:        "main",
+			trailers:      alice + "\nReviewed-by: Dev Eloper <dev@home.example>",
+			mailmap:       "Dev Eloper <dev@example.com> <dev@home.example>",
+			expectedCodes: []domain.ValidationErrorCode{domain.ErrInvalidReviewer, domain.ErrMissingReview},
+		},
+		{
+			name:          "malformed identity",
+			branch:        "main",
+			trailers:      alice + "\nReviewed-by: bob",
+			expectedCodes: []domain.ValidationErrorCode{domain.ErrInvalidReviewer, domain.ErrMissingReview},
+		},
+		{
+			name:          "reviewer not accepted",
+			branch:        "main",
+			trailers:      alice + "\n" + bob,
+			reviewers:     []string{"alice@example.com", "Carol <carol@example.com>"},
+			expectedCodes: []domain.ValidationErrorCode{domain.ErrInvalidReviewer, domain.ErrMissingReview},
+		},
+		{
+			name:   "detached head",
+			branch: "",
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			cfg := config.NewDefault()
+			cfg.Review.Branches = []config.ReviewBranchConfig{
+				{Branch: "main", MinReviewedBy: 2},
+				{Branch: "release/*", MinReviewedBy: 1, MinAckedBy: 1},
+			}
+			cfg.Review.Reviewers = testCase.reviewers
+
+			commit := domain.Commit{
+				Subject:     "Add feature",
+				Body:        "Explain the change.\n\n" + testCase.trailers,
+				Author:      "Dev Eloper",
+				AuthorEmail: "dev@example.com",
+				Mailmap:     domain.ParseMailmap(testCase.mailmap),
+			}
+
+			rule := rules.NewReviewRule(cfg)
+			errors := rule.Validate(commit, branchRepository{branch: testCase.branch}, cfg)
+
+			codes := make([]domain.ValidationErrorCode, 0, len(errors))
+			for _, err := range errors {
+				codes = append(codes, domain.ValidationErrorCode(err.Code))
+			}
+
+			if len(testCase.expectedCodes) == 0 {
+				require.Empty(t, codes)
+
+				return
+			}
+
+			require.Equal(t, testCase.expectedCodes, codes)
+		})
+	}
+}
+
+func TestReviewRule_WithoutBranchResolver(t *testing.T) {
+	cfg := config.NewDefault()
+	cfg.Review.Branches = []config.ReviewBranchConfig{{Branch: "*", MinReviewedBy: 1}}
+
+	rule := rules.NewReviewRule(cfg)
+	require.Empty(t, rule.Validate(domain.Commit{Subject: "Add feature"}, nil, cfg))
+}
+
+func TestReviewRule_RepositoryPass(t *testing.T) {
+	cfg := config.NewDefault()
+	cfg.Review.Branches = []config.ReviewBranchConfig{{Branch: "main", MinReviewedBy: 1}}
+
+	rule := rules.NewReviewRule(cfg)
+	require.Empty(t, domain.ValidateRepository([]domain.RepositoryRule{rule}, branchRepository{branch: "main"}, cfg))
+}
@@ -0,0 +1,29 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package rules
+
+import (
+	"github.com/itiquette/gommitlint/internal/domain"
+	"github.com/itiquette/gommitlint/internal/domain/config"
+)
+
+// Services creates the clients of the external services that rules consult, from the
+// configuration of the rules. The composition root provides them, so that rules know
+// nothing of how a service is reached; rules whose service is missing run without it.
+type Services struct {
+	// JiraTracker returns the tracker looking up tickets in the JIRA online mode
+	JiraTracker func(cfg config.JiraOnlineConfig) TicketTracker
+
+	// IssueTracker returns the tracker looking up GitHub issues, or nil when the
+	// repository of the issues is unknown
+	IssueTracker func(cfg config.IssuesConfig) TicketTracker
+
+	// CLARegistry returns the registry of the contributors who signed the CLA, or nil
+	// when neither a contributors file nor a CLA service is configured
+	CLARegistry func(cfg config.CLAConfig) CLARegistry
+
+	// SignatureVerifier returns the verifier of signatures against the trusted keys
+	SignatureVerifier func(cfg config.SignatureConfig) domain.SignatureVerifier
+}
@@ -5,6 +5,7 @@
 package rules
 
 import (
+	"context"
 	"strings"
 
 	"github.com/itiquette/gommitlint/internal/domain"
@@ -16,6 +17,8 @@ type SignatureRule struct {
 	requireSignature bool
 	verifyFormat     bool
 	allowedSigners   []string
+	verifier         domain.SignatureVerifier
+	keyDirectories   []string
 }
 
 // NewSignatureRule creates a new rule for validating commit signatures from config.
@@ -27,6 +30,15 @@ func NewSignatureRule(cfg config.Config) SignatureRule {
 	}
 }
 
+// WithVerifier returns the rule verifying signatures with verifier against the trusted
+// keys in keyDirectories.
+func (r SignatureRule) WithVerifier(verifier domain.SignatureVerifier, keyDirectories []string) SignatureRule {
+	r.verifier = verifier
+	r.keyDirectories = keyDirectories
+
+	return r
+}
+
 // Validate checks if a commit has the required cryptographic signature.
 func (r SignatureRule) Validate(commit domain.Commit, _ config.Config) []domain.ValidationError {
 	// Skip if signature not required
@@ -42,6 +54,7 @@ func (r SignatureRule) Validate(commit domain.Commit, _ config.Config) []domain.
 	if commit.Signature != "" {
 		errors = append(errors, r.validateFormat(commit)...)
 		errors = append(errors, r.validateSigners(commit)...)
+		errors = append(errors, r.verifySignature(commit)...)
 	}
 
 	return errors
@@ -52,6 +65,35 @@ func (r SignatureRule) Name() string {
 	return "Signature"
 }
 
+// Metadata returns the documentation of the rule.
+func (r SignatureRule) Metadata() domain.RuleMetadata {
+	return domain.RuleMetadata{
+		ID:       "signature",
+		Name:     r.Name(),
+		Category: domain.CategorySignature,
+		Severity: domain.SeverityError,
+		Summary:  "GPG and SSH commit signatures",
+		Description: "With signature.required every commit must carry a GPG or SSH signature. " +
+			"signature.verify_format also checks that the signature is complete and of a known " +
+			"format, and signature.allowed_signers restricts who may sign. Signatures are verified " +
+			"against the public keys in signature.key_directory and signature.key_directories, " +
+			"which must meet the key policy of signature.min_rsa_bits, signature.min_ec_bits, " +
+			"signature.allowed_algorithms and signature.allow_sha1. The fingerprint, algorithm and dates of the signing key, the " +
+			"signer and whether it matches the committer are reported as details of the rule, " +
+			"and in the context of verification failures.",
+		ErrorCodes: []domain.ValidationErrorCode{
+			domain.ErrMissingSignature, domain.ErrIncompleteGPGSig, domain.ErrIncompleteSSHSig,
+			domain.ErrUnknownSigFormat, domain.ErrVerificationFailed, domain.ErrKeyNotTrusted,
+			domain.ErrWeakKey,
+		},
+		ConfigKeys: []string{
+			"signature.required", "signature.verify_format", "signature.allowed_signers",
+			"signature.key_directory", "signature.key_directories", "signature.min_rsa_bits", "signature.min_ec_bits",
+			"signature.allowed_algorithms", "signature.allow_sha1",
+		},
+	}
+}
+
 // validatePresence checks if signature is present when required.
 func (r SignatureRule) validatePresence(commit domain.Commit) []domain.ValidationError {
 	signature := strings.TrimSpace(commit.Signature)
@@ -157,6 +199,48 @@ func (r SignatureRule) validateSigners(commit domain.Commit) []domain.Validation
 	}
 }
 
+// verifySignature verifies the signature of commit against the trusted keys, when the
+// rule has a verifier. The signing key is reported as details when the signature verifies,
+// and in the context of the error otherwise.
+func (r SignatureRule) verifySignature(commit domain.Commit) []domain.ValidationError {
+	if r.verifier == nil {
+		return nil
+	}
+
+	result := domain.VerifyWithKeyDirectories(r.keyDirectories, func(keyDir string) domain.VerificationResult {
+		return r.verifier.VerifyCommit(context.Background(), commit, keyDir)
+	})
+	details := domain.VerificationContext(commit, result)
+
+	if result.IsVerified() {
+		return []domain.ValidationError{domain.NewDetails(r.Name(), details)}
+	}
+
+	message := "Signature not verified with a trusted key"
+	if result.ErrorMessage() != "" {
+		message = "Signature not verified: " + result.ErrorMessage()
+	}
+
+	if result.ErrorCode() == string(domain.ErrWeakKey) {
+		return []domain.ValidationError{
+			domain.New(r.Name(), domain.ErrWeakKey, message).
+				WithContextMap(details).
+				WithHelp("Sign with a key meeting the key policy of the signature configuration"),
+		}
+	}
+
+	help := "Sign with a key whose public key is in " + strings.Join(r.keyDirectories, ", ")
+	if strings.Join(r.keyDirectories, "") == "" {
+		help = "Sign with a key held by your ssh-agent or listed in signature.known_signers"
+	}
+
+	return []domain.ValidationError{
+		domain.New(r.Name(), domain.ErrVerificationFailed, message).
+			WithContextMap(details).
+			WithHelp(help),
+	}
+}
+
 // isCompleteGPGSignature checks if a GPG signature has the required components.
 func isCompleteGPGSignature(signature string) bool {
 	return strings.Contains(signature, "-----BEGIN PGP SIGNATURE-----") &&
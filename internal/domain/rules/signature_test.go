@@ -14,8 +14,10 @@
 package rules_test
 
 import (
+	"context"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/itiquette/gommitlint/internal/domain"
 	"github.com/itiquette/gommitlint/internal/domain/config"
@@ -641,3 +643,125 @@ func TestSignatureRule_BackwardCompatibility(t *testing.T) {
 		})
 	}
 }
+
+// fakeVerifier returns result for every commit it verifies.
+type fakeVerifier struct {
+	result domain.VerificationResult
+	keyDir string
+}
+
+func (v *fakeVerifier) VerifyCommit(_ context.Context, _ domain.Commit, keyDir string) domain.VerificationResult {
+	v.keyDir = keyDir
+
+	return v.result
+}
+
+func (v *fakeVerifier) VerifyTag(_ context.Context, _ domain.Tag, _ string) domain.VerificationResult {
+	return v.result
+}
+
+func TestSignatureRule_VerificationDetails(t *testing.T) {
+	created := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	signature := domain.NewSignature(validSSHSignature)
+	commit := domain.Commit{
+		Hash:           "abc123",
+		Author:         "Dev",
+		AuthorEmail:    "dev@example.com",
+		Committer:      "Dev",
+		CommitterEmail: "dev@example.com",
+		Signature:      validSSHSignature,
+	}
+
+	cfg := config.NewDefault()
+	cfg.Signature.Required = true
+
+	t.Run("verified signature reports the key as details", func(t *testing.T) {
+		verifier := &fakeVerifier{result: domain.NewVerificationResult(domain.VerificationStatusVerified,
+			domain.NewIdentity("Dev", "dev@example.com"), signature).
+			WithFingerprint("SHA256:abc").
+			WithKeyDetails("ed25519", created, created.AddDate(2, 0, 0))}
+
+		failures := rules.NewSignatureRule(cfg).WithVerifier(verifier, []string{"keys"}).Validate(commit, cfg)
+
+		require.Equal(t, "keys", verifier.keyDir)
+		require.Len(t, failures, 1)
+		require.True(t, failures[0].IsDetails())
+		require.False(t, failures[0].IsBlocking())
+		require.Equal(t, map[string]string{
+			"signature_type": "ssh",
+			"status":         "verified",
+			"fingerprint":    "SHA256:abc",
+			"algorithm":      "ed25519",
+			"key_created":    "2024-03-01T12:00:00Z",
+			"key_expires":    "2026-03-01T12:00:00Z",
+			"signer":         "Dev <dev@example.com>",
+			"identity_match": "true",
+		}, failures[0].Context)
+	})
+
+	t.Run("failed verification reports the key in the context", func(t *testing.T) {
+		verifier := &fakeVerifier{result: domain.NewVerificationResult(domain.VerificationStatusFailed,
+			domain.NewIdentity("", ""), signature).
+			WithFingerprint("SHA256:abc").
+			WithKeyDetails("rsa", created, time.Time{}).
+			WithError("weak_key", "SSH signing key rejected: rsa key is below the minimum key size")}
+
+		failures := rules.NewSignatureRule(cfg).WithVerifier(verifier, []string{"keys"}).Validate(commit, cfg)
+
+		require.Len(t, failures, 1)
+		require.Equal(t, string(domain.ErrWeakKey), failures[0].Code)
+		require.Equal(t, "Signature not verified: SSH signing key rejected: rsa key is below the minimum key size",
+			failures[0].Message)
+		require.Equal(t, "SHA256:abc", failures[0].Context["fingerprint"])
+		require.Equal(t, "rsa", failures[0].Context["algorithm"])
+		require.Equal(t, "never", failures[0].Context["key_expires"])
+		require.Equal(t, "failed", failures[0].Context["status"])
+		require.NotContains(t, failures[0].Context, "identity_match")
+	})
+
+	t.Run("unsigned commits are not verified", func(t *testing.T) {
+		verifier := &fakeVerifier{}
+
+		failures := rules.NewSignatureRule(cfg).WithVerifier(verifier, []string{"keys"}).Validate(createCommit(""), cfg)
+
+		require.Len(t, failures, 1)
+		require.Equal(t, string(domain.ErrMissingSignature), failures[0].Code)
+		require.Empty(t, verifier.keyDir)
+	})
+}
+
+func TestSignatureRule_InjectedVerifier(t *testing.T) {
+	signature := domain.NewSignature(validSSHSignature)
+	commit := domain.Commit{Hash: "abc123", Author: "Dev", AuthorEmail: "dev@example.com", Signature: validSSHSignature}
+	verifier := &fakeVerifier{result: domain.NewVerificationResult(domain.VerificationStatusFailed,
+		domain.NewIdentity("", ""), signature).WithError("key_not_trusted", "no trusted key")}
+
+	cfg := config.NewDefault()
+	cfg.Signature.Required = true
+	cfg.Signature.KeyDirectory = "keys"
+	cfg.Rules.Enabled = append(cfg.Rules.Enabled, "identity")
+	cfg.Identity.KeyScopes = []config.KeyScopeConfig{{Name: "release", Directory: "release-keys"}}
+
+	var signatureConfig config.SignatureConfig
+
+	services := rules.Services{SignatureVerifier: func(cfg config.SignatureConfig) domain.SignatureVerifier {
+		signatureConfig = cfg
+
+		return verifier
+	}}
+
+	created := domain.SelectCommitRules(rules.CreateCommitRules(cfg, services), []string{"signature"}, nil)
+	require.Len(t, created, 1)
+	require.Equal(t, "keys", signatureConfig.KeyDirectory, "the verifier is created for the signature configuration")
+
+	failures := created[0].Validate(commit, cfg)
+	require.NotEmpty(t, failures)
+	require.Equal(t, "keys", verifier.keyDir, "signatures are verified against the trusted keys")
+
+	scopes := domain.SelectCommitRules(rules.CreateCommitRules(cfg, services), []string{"identity"}, nil)
+	require.Len(t, scopes, 2, "the identity rule and its key scope")
+
+	// Without the service key scopes cannot be verified and are not created
+	scopes = domain.SelectCommitRules(rules.CreateCommitRules(cfg, rules.Services{}), []string{"identity"}, nil)
+	require.Len(t, scopes, 1)
+}
@@ -22,19 +22,23 @@ func signOffRegex() *regexp.Regexp {
 // SignOffRule validates that commit messages include a sign-off line.
 type SignOffRule struct {
 	minSignoffCount int
+	matchAuthor     bool
+	allowCommitter  bool
 }
 
 // NewSignOffRule creates a new rule for validating commit sign-offs from config.
 func NewSignOffRule(cfg config.Config) SignOffRule {
 	return SignOffRule{
 		minSignoffCount: cfg.Message.Body.MinSignoffCount,
+		matchAuthor:     cfg.Message.Body.SignoffMatchAuthor,
+		allowCommitter:  cfg.Message.Body.SignoffAllowCommitter,
 	}
 }
 
 // Validate checks for the presence and format of a Developer Certificate of Origin sign-off.
 func (r SignOffRule) Validate(commit domain.Commit, _ config.Config) []domain.ValidationError {
 	// Skip validation if no sign-offs required
-	if r.minSignoffCount == 0 {
+	if r.minSignoffCount == 0 && !r.matchAuthor {
 		return nil
 	}
 
@@ -67,6 +71,11 @@ func (r SignOffRule) Validate(commit domain.Commit, _ config.Config) []domain.Va
 		}
 	}
 
+	// Validate that the author signed off
+	if r.matchAuthor {
+		errors = append(errors, r.validateAuthorSignoff(commit, signoffs)...)
+	}
+
 	return errors
 }
 
@@ -75,6 +84,38 @@ func (r SignOffRule) Name() string {
 	return "SignOff"
 }
 
+// Metadata returns the documentation of the rule.
+func (r SignOffRule) Metadata() domain.RuleMetadata {
+	return domain.RuleMetadata{
+		ID:       "signoff",
+		Name:     r.Name(),
+		Category: domain.CategorySignature,
+		Severity: domain.SeverityError,
+		Summary:  "Developer Certificate of Origin sign-off",
+		Description: "Requires at least message.body.min_signoff_count 'Signed-off-by: Name <email>' " +
+			"lines at the end of the body, from distinct signers when more than one is required. " +
+			"With message.body.signoff_match_author one of them must be by the commit author, or " +
+			"by the committer when message.body.signoff_allow_committer is set. Nothing is " +
+			"checked while the count is 0 and the author match is off.",
+		ErrorCodes: []domain.ValidationErrorCode{
+			domain.ErrMissingSignoff, domain.ErrInsufficientSignoffs, domain.ErrInvalidSignoffFormat,
+			domain.ErrMisplacedSignoff, domain.ErrSignoffNotAuthor,
+		},
+		ConfigKeys: []string{
+			"message.body.min_signoff_count", "message.body.signoff_match_author",
+			"message.body.signoff_allow_committer",
+		},
+		Examples: []domain.RuleExample{
+			{
+				Message: "fix: handle empty input\n\nSigned-off-by: Dev <dev@example.com>",
+				Valid:   true,
+				Note:    "with message.body.min_signoff_count: 1",
+			},
+			{Message: "fix: handle empty input", Note: "with message.body.min_signoff_count: 1"},
+		},
+	}
+}
+
 // extractSignoffs extracts all valid sign-off lines from the commit body.
 // Returns a slice of sign-off strings found in the body.
 func (r SignOffRule) extractSignoffs(body string) []string {
@@ -226,6 +267,50 @@ func (r SignOffRule) validateUniqueSigners(signoffs []string) []domain.Validatio
 	return nil
 }
 
+// validateAuthorSignoff validates that a sign-off was made by the commit author, or by
+// the committer when allowed. Identities are compared by email after resolving them
+// through the repository's .mailmap. Commits without a recorded author, such as a
+// message being written, are not checked.
+func (r SignOffRule) validateAuthorSignoff(commit domain.Commit, signoffs []string) []domain.ValidationError {
+	if commit.AuthorEmail == "" {
+		return nil
+	}
+
+	accepted := []domain.Identity{commit.Mailmap.Resolve(domain.NewIdentity(commit.Author, commit.AuthorEmail))}
+	if r.allowCommitter && commit.CommitterEmail != "" {
+		accepted = append(accepted, commit.Mailmap.Resolve(domain.NewIdentity(commit.Committer, commit.CommitterEmail)))
+	}
+
+	for _, signoff := range signoffs {
+		signer := domain.NewIdentityFromString(strings.TrimSpace(strings.TrimPrefix(signoff, "Signed-off-by:")))
+		if commit.Mailmap.Resolve(signer).MatchesAny(accepted) {
+			return nil
+		}
+	}
+
+	expected := accepted[0].String()
+	help := "Add a sign-off by the commit author: 'Signed-off-by: " + expected + "' (git commit -s)"
+
+	if len(accepted) > 1 {
+		expected += " or " + accepted[1].String()
+		help = "Add a sign-off by the commit author or committer (git commit -s)"
+	}
+
+	actual := "no sign-off"
+	if len(signoffs) > 0 {
+		actual = strconv.Itoa(len(signoffs)) + " sign-off(s) by others"
+	}
+
+	err := domain.New(r.Name(), domain.ErrSignoffNotAuthor, "No sign-off by the commit author")
+	err = err.WithContextMap(map[string]string{
+		"actual":   actual,
+		"expected": expected,
+	})
+	err = err.WithHelp(help)
+
+	return []domain.ValidationError{err}
+}
+
 // extractEmailFromSignoff extracts the email address from a sign-off line.
 func (r SignOffRule) extractEmailFromSignoff(signoff string) string {
 	// Match email in angle brackets
@@ -1140,3 +1140,91 @@ SIGNED-OFF-BY: Alice <alice@example.com>`,
 		})
 	}
 }
+
+func TestSignOffRule_MatchAuthor(t *testing.T) {
+	mailmap := domain.ParseMailmap("Test User <test@example.com> <old@example.com>\n")
+
+	tests := []struct {
+		name           string
+		signoff        string
+		committer      string
+		mailmap        domain.Mailmap
+		allowCommitter bool
+		noAuthor       bool
+		expectedCode   string
+	}{
+		{
+			name:    "author signed off",
+			signoff: "Signed-off-by: Test User <test@example.com>",
+		},
+		{
+			name:    "email compared case-insensitively",
+			signoff: "Signed-off-by: Test User <Test@Example.com>",
+		},
+		{
+			name:         "sign-off by someone else",
+			signoff:      "Signed-off-by: Dev Eloper <dev@example.com>",
+			expectedCode: string(domain.ErrSignoffNotAuthor),
+		},
+		{
+			name:         "no sign-off",
+			expectedCode: string(domain.ErrSignoffNotAuthor),
+		},
+		{
+			name:    "old email mapped by mailmap",
+			signoff: "Signed-off-by: Test User <old@example.com>",
+			mailmap: mailmap,
+		},
+		{
+			name:         "committer not accepted by default",
+			signoff:      "Signed-off-by: Main Tainer <maintainer@example.com>",
+			committer:    "maintainer@example.com",
+			expectedCode: string(domain.ErrSignoffNotAuthor),
+		},
+		{
+			name:           "committer accepted when allowed",
+			signoff:        "Signed-off-by: Main Tainer <maintainer@example.com>",
+			committer:      "maintainer@example.com",
+			allowCommitter: true,
+		},
+		{
+			name:     "message without author is not checked",
+			signoff:  "Signed-off-by: Dev Eloper <dev@example.com>",
+			noAuthor: true,
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			message := "Add feature\n\nDescribe the feature."
+			if testCase.signoff != "" {
+				message += "\n\n" + testCase.signoff
+			}
+
+			commit := createSignoffTestCommit(message)
+			commit.Mailmap = testCase.mailmap
+			commit.Committer = "Main Tainer"
+			commit.CommitterEmail = testCase.committer
+
+			if testCase.noAuthor {
+				commit.Author, commit.AuthorEmail = "", ""
+			}
+
+			cfg := config.Config{}
+			cfg.Message.Body.SignoffMatchAuthor = true
+			cfg.Message.Body.SignoffAllowCommitter = testCase.allowCommitter
+
+			errors := rules.NewSignOffRule(cfg).Validate(commit, cfg)
+
+			if testCase.expectedCode == "" {
+				require.Empty(t, errors)
+
+				return
+			}
+
+			require.Len(t, errors, 1)
+			require.Equal(t, testCase.expectedCode, errors[0].Code)
+			require.Contains(t, errors[0].Context["expected"], "test@example.com")
+		})
+	}
+}
@@ -36,6 +36,25 @@ func (r SpellRule) Name() string {
 	return "Spell"
 }
 
+// Metadata returns the documentation of the rule.
+func (r SpellRule) Metadata() domain.RuleMetadata {
+	return domain.RuleMetadata{
+		ID:       "spell",
+		Name:     r.Name(),
+		Category: domain.CategoryMessage,
+		Severity: domain.SeverityError,
+		Summary:  "Common misspellings",
+		Description: "Reports commonly misspelled words in the message, with their correction, " +
+			"using the spelling of spell.locale. Words in spell.ignore_words are not reported.",
+		ErrorCodes: []domain.ValidationErrorCode{domain.ErrMisspelledWord},
+		ConfigKeys: []string{"spell.locale", "spell.ignore_words"},
+		Examples: []domain.RuleExample{
+			{Message: "fix: handle the received message", Valid: true},
+			{Message: "fix: handle the recieved message", Note: "'recieved' is misspelled"},
+		},
+	}
+}
+
 // Validate checks spelling in the commit message using functional composition.
 func (r SpellRule) Validate(commit domain.Commit, _ config.Config) []domain.ValidationError {
 	// Functional composition approach
@@ -144,6 +163,10 @@ func buildSpellErrors(misspellings []domain.Misspelling, ruleName string) []doma
 			WithContextMap(contextMap).
 			WithHelp(helpText)
 
+		if misspelling.Suggestion != "" {
+			err = err.WithSuggestion(misspelling.Suggestion)
+		}
+
 		errors = append(errors, err)
 	}
 
@@ -7,6 +7,7 @@ package rules
 import (
 	"errors"
 	"fmt"
+	"regexp"
 	"strconv"
 	"strings"
 	"unicode"
@@ -19,12 +20,15 @@ import (
 // SubjectRule validates commit subject length, case, suffix, and imperative mood.
 type SubjectRule struct {
 	maxLength           int
+	lengthMode          string
 	caseChoice          string
+	caseMapping         caseMapping
 	invalidSuffixes     string
 	checkCommit         bool
 	allowNonAlpha       bool
 	requireImperative   bool
 	imperativeValidator *ImperativeValidator // Modular imperative validation
+	excludedPrefixes    []*regexp.Regexp     // Prefixes left out of the length and case checks
 }
 
 // NewSubjectRule creates a new SubjectRule from config.
@@ -56,21 +60,32 @@ func NewSubjectRule(cfg config.Config) SubjectRule {
 		}
 	}
 
-	isConventionalEnabled := domain.IsRuleActive("conventional", cfg.Rules.Enabled, cfg.Rules.Disabled)
+	isConventionalEnabled := IsRuleActive("conventional", cfg.Rules.Enabled, cfg.Rules.Disabled)
 
 	var imperativeValidator *ImperativeValidator
 	if cfg.Message.Subject.RequireImperative {
-		imperativeValidator = NewImperativeValidator()
+		imperativeValidator = NewImperativeValidator(cfg.Message.Subject.Imperative)
+	}
+
+	var excludedPrefixes []*regexp.Regexp
+
+	for _, source := range cfg.Message.Subject.ExcludedPrefixes {
+		if pattern, err := regexp.Compile(`^(?:` + source + `)`); err == nil {
+			excludedPrefixes = append(excludedPrefixes, pattern)
+		}
 	}
 
 	return SubjectRule{
 		maxLength:           maxLength,
+		lengthMode:          cfg.Message.Subject.LengthMode,
 		caseChoice:          caseChoice,
+		caseMapping:         newCaseMapping(cfg.Message.Subject.CaseLocale),
 		invalidSuffixes:     invalidSuffixes,
 		checkCommit:         isConventionalEnabled,
 		allowNonAlpha:       false,
 		requireImperative:   cfg.Message.Subject.RequireImperative,
 		imperativeValidator: imperativeValidator,
+		excludedPrefixes:    excludedPrefixes,
 	}
 }
 
@@ -79,6 +94,46 @@ func (r SubjectRule) Name() string {
 	return "Subject"
 }
 
+// Metadata returns the documentation of the rule.
+func (r SubjectRule) Metadata() domain.RuleMetadata {
+	return domain.RuleMetadata{
+		ID:       "subject",
+		Name:     r.Name(),
+		Category: domain.CategoryMessage,
+		Severity: domain.SeverityError,
+		Summary:  "Subject line length, case, ending and imperative mood",
+		Description: "Checks the first line of the message. It must not be empty or longer than " +
+			"message.subject.max_length characters, counted as message.subject.length_mode, must " +
+			"start with the configured case (after the type and scope when the conventional rule " +
+			"is active), must not end with one of message.subject.forbid_endings, and with " +
+			"message.subject.require_imperative it must start with a verb in the imperative mood, " +
+			"recognized by its form or, with message.subject.imperative.mode allowlist, by being " +
+			"one of message.subject.imperative.verbs. Prefixes matching " +
+			"message.subject.excluded_prefixes, such as a gitmoji or a ticket, are left out of the " +
+			"length and case checks.",
+		ErrorCodes: []domain.ValidationErrorCode{
+			domain.ErrEmptySubject, domain.ErrSubjectTooLong, domain.ErrWrongCaseLower,
+			domain.ErrWrongCaseUpper, domain.ErrSubjectSuffix, domain.ErrInvalidFormat, domain.ErrInvalidUTF8,
+			domain.ErrMissingConventionalSubject, domain.ErrInvalidConventionalFormat, domain.ErrNoFirstWord,
+			domain.ErrNonVerb, domain.ErrPastTense, domain.ErrThirdPerson, domain.ErrGerund, domain.ErrNonImperative,
+			domain.ErrVerbNotAllowed,
+		},
+		ConfigKeys: []string{
+			"message.subject.max_length", "message.subject.length_mode", "message.subject.case",
+			"message.subject.case_locale",
+			"message.subject.forbid_endings", "message.subject.excluded_prefixes", "message.subject.require_imperative",
+			"message.subject.imperative.mode", "message.subject.imperative.verbs",
+			"message.subject.imperative.language", "message.subject.imperative.skip_non_ascii",
+			"message.subject.imperative.custom_exceptions", "message.subject.imperative.custom_non_verbs",
+		},
+		Examples: []domain.RuleExample{
+			{Message: "feat: add login page", Valid: true},
+			{Message: "feat: add login page.", Note: "ends with a forbidden '.'"},
+			{Message: "feat: " + strings.Repeat("add a very long description ", 3), Note: "longer than 72 characters"},
+		},
+	}
+}
+
 // Validate performs pure commit validation.
 func (r SubjectRule) Validate(commit domain.Commit, _ config.Config) []domain.ValidationError {
 	var errors []domain.ValidationError
@@ -95,26 +150,41 @@ func (r SubjectRule) Validate(commit domain.Commit, _ config.Config) []domain.Va
 		}
 	}
 
-	// Length validation with UTF-8 character counting
-	subjectLength := utf8.RuneCountInString(commit.Subject)
+	prefix, checkedSubject := r.cutExcludedPrefix(commit.Subject)
+
+	// Length validation counting runes, grapheme clusters or terminal columns
+	rawLength := domain.TextLength(commit.Subject, r.lengthMode)
+
+	subjectLength := domain.TextLength(checkedSubject, r.lengthMode)
 	if subjectLength > r.maxLength {
 		// Calculate how much over the limit
 		excess := subjectLength - r.maxLength
 
+		message := fmt.Sprintf("Subject too long: %d characters (maximum allowed: %d)", subjectLength, r.maxLength)
+		context := map[string]string{
+			"actual":           strconv.Itoa(subjectLength),
+			"expected":         fmt.Sprintf("max %d", r.maxLength),
+			"subject":          commit.Subject,
+			"length_mode":      r.lengthMode,
+			"raw_length":       strconv.Itoa(rawLength),
+			"effective_length": strconv.Itoa(subjectLength),
+		}
+
+		if prefix != "" {
+			message = fmt.Sprintf("Subject too long: %d characters without the prefix %q, %d with it (maximum allowed: %d)",
+				subjectLength, prefix, rawLength, r.maxLength)
+			context["excluded_prefix"] = prefix
+		}
+
 		errors = append(errors,
-			domain.New(r.Name(), domain.ErrSubjectTooLong,
-				fmt.Sprintf("Subject too long: %d characters (maximum allowed: %d)", subjectLength, r.maxLength)).
-				WithContextMap(map[string]string{
-					"actual":   strconv.Itoa(subjectLength),
-					"expected": fmt.Sprintf("max %d", r.maxLength),
-					"subject":  commit.Subject,
-				}).
+			domain.New(r.Name(), domain.ErrSubjectTooLong, message).
+				WithContextMap(context).
 				WithHelp(fmt.Sprintf("Shorten your commit message subject line by %d characters. "+
 					"A good subject should be brief but descriptive, ideally under 50 characters.", excess)))
 	}
 
 	// Case validation
-	if caseErrors := r.validateCase(commit.Subject); len(caseErrors) > 0 {
+	if caseErrors := r.validateCase(checkedSubject); len(caseErrors) > 0 {
 		errors = append(errors, caseErrors...)
 	}
 
@@ -164,9 +234,9 @@ func (r SubjectRule) validateCase(subject string) []domain.ValidationError {
 
 	switch r.caseChoice {
 	case "upper":
-		isValid = unicode.IsUpper(firstLetter)
+		isValid = r.caseMapping.isUpper(firstLetter)
 	case "lower":
-		isValid = unicode.IsLower(firstLetter)
+		isValid = r.caseMapping.isLower(firstLetter)
 	case "ignore":
 		isValid = true // Always valid when ignoring case
 	}
@@ -175,9 +245,9 @@ func (r SubjectRule) validateCase(subject string) []domain.ValidationError {
 		// Get expected letter
 		var expectedLetter rune
 		if r.caseChoice == "upper" {
-			expectedLetter = unicode.ToUpper(firstLetter)
+			expectedLetter = r.caseMapping.toUpper(firstLetter)
 		} else {
-			expectedLetter = unicode.ToLower(firstLetter)
+			expectedLetter = r.caseMapping.toLower(firstLetter)
 		}
 
 		// Extract first word for display
@@ -190,18 +260,9 @@ func (r SubjectRule) validateCase(subject string) []domain.ValidationError {
 
 		expectedWord := firstWord
 
-		if r.caseChoice == "upper" {
-			runes := []rune(firstWord)
-			if len(runes) > 0 {
-				runes[0] = unicode.ToUpper(runes[0])
-				expectedWord = string(runes)
-			}
-		} else {
-			runes := []rune(firstWord)
-			if len(runes) > 0 {
-				runes[0] = unicode.ToLower(runes[0])
-				expectedWord = string(runes)
-			}
+		if runes := []rune(firstWord); len(runes) > 0 {
+			runes[0] = expectedLetter
+			expectedWord = string(runes)
 		}
 
 		var errorCode domain.ValidationErrorCode
@@ -226,6 +287,7 @@ func (r SubjectRule) validateCase(subject string) []domain.ValidationError {
 					"subject":       subject,
 					"expected":      r.caseChoice,
 				}).
+				WithSuggestion(expectedWord).
 				WithHelp(helpMessage),
 		}
 	}
@@ -271,6 +333,7 @@ func (r SubjectRule) validateSuffix(subject string) []domain.ValidationError {
 					"actual":   string(lastChar),
 					"expected": cleanSubject,
 				}).
+				WithSuggestion(cleanSubject).
 				WithHelp(fmt.Sprintf("Remove the punctuation or special character from the end of your subject line. "+
 					"The subject should end with a letter or number, not punctuation like: %s",
 					strings.Join(suffixList, ", "))),
@@ -325,6 +388,32 @@ func (r SubjectRule) extractFirstLetterWithConventionalHandling(subject string)
 	return 0, "", errors.New("no letter found")
 }
 
+// cutExcludedPrefix returns the prefixes at the start of subject that match
+// message.subject.excluded_prefixes, in any order, and the subject after them without
+// leading spaces. A prefix is only cut when some of the subject remains.
+func (r SubjectRule) cutExcludedPrefix(subject string) (string, string) {
+	rest := subject
+
+	for cut := true; cut; {
+		cut = false
+
+		for _, pattern := range r.excludedPrefixes {
+			end := pattern.FindStringIndex(rest)
+			if end == nil || end[1] == 0 {
+				continue
+			}
+
+			if remaining := strings.TrimLeftFunc(rest[end[1]:], unicode.IsSpace); remaining != "" {
+				rest, cut = remaining, true
+
+				break
+			}
+		}
+	}
+
+	return strings.TrimRightFunc(subject[:len(subject)-len(rest)], unicode.IsSpace), rest
+}
+
 // validateImperative validates that the subject uses imperative mood using the modular validator.
 func (r SubjectRule) validateImperative(subject string) []domain.ValidationError {
 	if !r.requireImperative || r.imperativeValidator == nil {
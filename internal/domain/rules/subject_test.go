@@ -367,11 +367,208 @@ func TestSubjectRule_ImperativeValidation(t *testing.T) {
 	}
 }
 
+func TestSubjectRule_ImperativeCustomWords(t *testing.T) {
+	tests := []struct {
+		name        string
+		subject     string
+		imperative  config.ImperativeConfig
+		wantErrCode string
+	}{
+		{
+			name:        "base form ending with s is flagged by default",
+			subject:     "Alias the old flag",
+			wantErrCode: string(domain.ErrThirdPerson),
+		},
+		{
+			name:       "custom exception accepted",
+			subject:    "Alias the old flag",
+			imperative: config.ImperativeConfig{CustomExceptions: []string{"alias"}},
+		},
+		{
+			name:       "custom exception matched ignoring case",
+			subject:    "alias the old flag",
+			imperative: config.ImperativeConfig{CustomExceptions: []string{"Alias"}},
+		},
+		{
+			name:       "starter accepted by default",
+			subject:    "Misc cleanups",
+			imperative: config.ImperativeConfig{},
+		},
+		{
+			name:        "custom non-verb rejected",
+			subject:     "Misc cleanups",
+			imperative:  config.ImperativeConfig{CustomNonVerbs: []string{"misc"}},
+			wantErrCode: string(domain.ErrNonVerb),
+		},
+		{
+			name:        "built-in non-verbs still rejected",
+			subject:     "The login page",
+			imperative:  config.ImperativeConfig{CustomNonVerbs: []string{"misc"}},
+			wantErrCode: string(domain.ErrNonVerb),
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			cfg := config.Config{
+				Message: config.MessageConfig{
+					Subject: config.SubjectConfig{
+						MaxLength:         72,
+						Case:              "ignore",
+						RequireImperative: true,
+						Imperative:        testCase.imperative,
+					},
+				},
+			}
+
+			errors := NewSubjectRule(cfg).Validate(domain.Commit{Subject: testCase.subject}, cfg)
+
+			if testCase.wantErrCode == "" {
+				require.Empty(t, errors)
+
+				return
+			}
+
+			require.Len(t, errors, 1)
+			require.Equal(t, testCase.wantErrCode, errors[0].Code)
+		})
+	}
+}
+
+func TestSubjectRule_ImperativeLanguages(t *testing.T) {
+	tests := []struct {
+		name        string
+		subject     string
+		imperative  config.ImperativeConfig
+		wantErrCode string
+	}{
+		{name: "french infinitive", subject: "Ajouter la page de connexion", imperative: config.ImperativeConfig{Language: "fr"}},
+		{name: "french imperative", subject: "Corrige le calcul", imperative: config.ImperativeConfig{Language: "fr"}},
+		{name: "french past participle", subject: "Corrigé le calcul", imperative: config.ImperativeConfig{Language: "fr"}, wantErrCode: string(domain.ErrNonImperative)},
+		{name: "french article", subject: "Le calcul", imperative: config.ImperativeConfig{Language: "fr"}, wantErrCode: string(domain.ErrNonVerb)},
+		{name: "spanish imperative", subject: "Añade la página de inicio", imperative: config.ImperativeConfig{Language: "es"}},
+		{name: "spanish infinitive", subject: "Actualizar dependencias", imperative: config.ImperativeConfig{Language: "es"}},
+		{name: "spanish past participle", subject: "Actualizado dependencias", imperative: config.ImperativeConfig{Language: "es"}, wantErrCode: string(domain.ErrNonImperative)},
+		{name: "spanish gerund", subject: "Corrigiendo el cálculo", imperative: config.ImperativeConfig{Language: "es"}, wantErrCode: string(domain.ErrNonImperative)},
+		{name: "swedish imperative", subject: "Lägg till inloggningssida", imperative: config.ImperativeConfig{Language: "sv"}},
+		{name: "swedish imperative of first conjugation", subject: "Uppdatera beroenden", imperative: config.ImperativeConfig{Language: "sv"}},
+		{name: "swedish past tense", subject: "Uppdaterade beroenden", imperative: config.ImperativeConfig{Language: "sv"}, wantErrCode: string(domain.ErrNonImperative)},
+		{name: "swedish present tense", subject: "Fixar beräkningen", imperative: config.ImperativeConfig{Language: "sv"}, wantErrCode: string(domain.ErrNonImperative)},
+		{name: "english rules skipped in other languages", subject: "Process data", imperative: config.ImperativeConfig{Language: "sv"}},
+		{name: "non-ascii word checked by default", subject: "Lösningars bugg", wantErrCode: string(domain.ErrThirdPerson)},
+		{name: "non-ascii word skipped", subject: "Lösningars bugg", imperative: config.ImperativeConfig{SkipNonASCII: true}},
+		{name: "ascii word still checked when skipping non-ascii", subject: "Added login", imperative: config.ImperativeConfig{SkipNonASCII: true}, wantErrCode: string(domain.ErrPastTense)},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			cfg := config.Config{
+				Message: config.MessageConfig{
+					Subject: config.SubjectConfig{
+						MaxLength:         72,
+						Case:              "ignore",
+						RequireImperative: true,
+						Imperative:        testCase.imperative,
+					},
+				},
+			}
+
+			errors := NewSubjectRule(cfg).Validate(domain.Commit{Subject: testCase.subject}, cfg)
+
+			if testCase.wantErrCode == "" {
+				require.Empty(t, errors)
+
+				return
+			}
+
+			require.Len(t, errors, 1)
+			require.Equal(t, testCase.wantErrCode, errors[0].Code)
+		})
+	}
+}
+
+func TestSubjectRule_ImperativeAllowlist(t *testing.T) {
+	tests := []struct {
+		name           string
+		subject        string
+		imperative     config.ImperativeConfig
+		wantErrCode    string
+		wantSuggestion string
+	}{
+		{name: "bundled verb", subject: "Add login page", imperative: config.ImperativeConfig{Mode: "allowlist"}},
+		{name: "bundled verb ignoring case", subject: "add login page", imperative: config.ImperativeConfig{Mode: "allowlist"}},
+		{
+			name:           "verb close to a bundled verb",
+			subject:        "Fixed login page",
+			imperative:     config.ImperativeConfig{Mode: "allowlist"},
+			wantErrCode:    string(domain.ErrVerbNotAllowed),
+			wantSuggestion: "Fix",
+		},
+		{
+			name:        "imperative verb not listed",
+			subject:     "Overhaul login page",
+			imperative:  config.ImperativeConfig{Mode: "allowlist"},
+			wantErrCode: string(domain.ErrVerbNotAllowed),
+		},
+		{
+			name:       "configured verbs replace the bundled list",
+			subject:    "add login page",
+			imperative: config.ImperativeConfig{Mode: "allowlist", Verbs: []string{"Ajouter", "Add"}},
+		},
+		{
+			name:           "suggestion from configured verbs",
+			subject:        "ajoute la page",
+			imperative:     config.ImperativeConfig{Mode: "allowlist", Verbs: []string{"Ajouter", "Corriger"}},
+			wantErrCode:    string(domain.ErrVerbNotAllowed),
+			wantSuggestion: "ajouter",
+		},
+		{
+			name:       "custom exception accepted",
+			subject:    "Overhaul login page",
+			imperative: config.ImperativeConfig{Mode: "allowlist", CustomExceptions: []string{"overhaul"}},
+		},
+		{
+			name:        "custom non-verb rejected",
+			subject:     "Add login page",
+			imperative:  config.ImperativeConfig{Mode: "allowlist", CustomNonVerbs: []string{"add"}},
+			wantErrCode: string(domain.ErrNonVerb),
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			cfg := config.Config{
+				Message: config.MessageConfig{
+					Subject: config.SubjectConfig{
+						MaxLength:         72,
+						Case:              "ignore",
+						RequireImperative: true,
+						Imperative:        testCase.imperative,
+					},
+				},
+			}
+
+			errors := NewSubjectRule(cfg).Validate(domain.Commit{Subject: testCase.subject}, cfg)
+
+			if testCase.wantErrCode == "" {
+				require.Empty(t, errors)
+
+				return
+			}
+
+			require.Len(t, errors, 1)
+			require.Equal(t, testCase.wantErrCode, errors[0].Code)
+			require.Equal(t, testCase.wantSuggestion, errors[0].Suggestion())
+		})
+	}
+}
+
 func TestSubjectRule_EnhancedUTF8LengthValidation(t *testing.T) {
 	tests := []struct {
 		name         string
 		subject      string
 		maxLength    int
+		lengthMode   string
 		wantErrCount int
 		wantErrCode  string
 	}{
@@ -408,6 +605,36 @@ func TestSubjectRule_EnhancedUTF8LengthValidation(t *testing.T) {
 			wantErrCount: 1,
 			wantErrCode:  string(domain.ErrSubjectTooLong),
 		},
+		{
+			name:         "ZWJ emoji sequence counted as runes",
+			subject:      "Add 👨‍👩‍👧 support",
+			maxLength:    14,
+			lengthMode:   "runes",
+			wantErrCount: 1,
+			wantErrCode:  string(domain.ErrSubjectTooLong),
+		},
+		{
+			name:         "ZWJ emoji sequence counted as one grapheme",
+			subject:      "Add 👨‍👩‍👧 support",
+			maxLength:    14,
+			lengthMode:   "graphemes",
+			wantErrCount: 0,
+		},
+		{
+			name:         "Combining accent counted as one grapheme",
+			subject:      "Fix cafe\u0301 menu",
+			maxLength:    13,
+			lengthMode:   "graphemes",
+			wantErrCount: 0,
+		},
+		{
+			name:         "Wide characters counted by display width",
+			subject:      "测试中文",
+			maxLength:    6,
+			lengthMode:   "display-width",
+			wantErrCount: 1,
+			wantErrCode:  string(domain.ErrSubjectTooLong),
+		},
 	}
 
 	for _, testCase := range tests {
@@ -415,8 +642,9 @@ func TestSubjectRule_EnhancedUTF8LengthValidation(t *testing.T) {
 			cfg := config.Config{
 				Message: config.MessageConfig{
 					Subject: config.SubjectConfig{
-						MaxLength: testCase.maxLength,
-						Case:      "ignore", // Focus on length testing
+						MaxLength:  testCase.maxLength,
+						LengthMode: testCase.lengthMode,
+						Case:       "ignore", // Focus on length testing
 					},
 				},
 			}
@@ -635,6 +863,91 @@ func TestSubjectRule_EnhancedCaseValidationWithUTF8(t *testing.T) {
 	}
 }
 
+func TestSubjectRule_CaseLocale(t *testing.T) {
+	tests := []struct {
+		name           string
+		subject        string
+		caseChoice     string
+		locale         string
+		wantSuggestion string // Empty when the subject passes
+	}{
+		{name: "dotless I lowers to i by default", subject: "Işık ekle", caseChoice: "lower", wantSuggestion: "işık"},
+		{name: "dotless I lowers to ı in Turkish", subject: "Işık ekle", caseChoice: "lower", locale: "tr", wantSuggestion: "ışık"},
+		{name: "dotted İ lowers to i in Turkish", subject: "İzin ekle", caseChoice: "lower", locale: "tr-TR", wantSuggestion: "izin"},
+		{name: "i uppers to I by default", subject: "izin ekle", caseChoice: "upper", wantSuggestion: "Izin"},
+		{name: "i uppers to İ in Turkish", subject: "izin ekle", caseChoice: "upper", locale: "tr", wantSuggestion: "İzin"},
+		{name: "i uppers to İ in Azerbaijani", subject: "izin ekle", caseChoice: "upper", locale: "az_AZ", wantSuggestion: "İzin"},
+		{name: "dotless ı is lower case in Turkish", subject: "ışık ekle", caseChoice: "lower", locale: "tr"},
+		{name: "ß has no simple upper case", subject: "ßtraße hinzufügen", caseChoice: "upper"},
+		{name: "ß uppers to ẞ in German", subject: "ßtraße hinzufügen", caseChoice: "upper", locale: "de", wantSuggestion: "ẞtraße"},
+		{name: "ẞ lowers to ß", subject: "ẞtraße hinzufügen", caseChoice: "lower", locale: "de", wantSuggestion: "ßtraße"},
+		{name: "letters without case pass", subject: "添加 登录页面", caseChoice: "lower"},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			cfg := config.Config{
+				Message: config.MessageConfig{
+					Subject: config.SubjectConfig{MaxLength: 100, Case: testCase.caseChoice, CaseLocale: testCase.locale},
+				},
+			}
+
+			errors := NewSubjectRule(cfg).Validate(domain.Commit{Subject: testCase.subject}, cfg)
+
+			if testCase.wantSuggestion == "" {
+				require.Empty(t, errors)
+
+				return
+			}
+
+			require.Len(t, errors, 1)
+			require.Equal(t, testCase.wantSuggestion, errors[0].Suggestion())
+		})
+	}
+}
+
+func TestSubjectRule_ExcludedPrefixes(t *testing.T) {
+	cfg := config.Config{
+		Message: config.MessageConfig{
+			Subject: config.SubjectConfig{
+				MaxLength:        20,
+				Case:             "lower",
+				ExcludedPrefixes: []string{`\p{So}\x{FE0F}?`, `\[[A-Z]+-[0-9]+\]`},
+			},
+		},
+	}
+	rule := NewSubjectRule(cfg)
+
+	require.Empty(t, rule.Validate(domain.Commit{Subject: "✨ [PROJ-12] feat: add login"}, cfg),
+		"prefixes in any order are left out of length and case")
+	require.Empty(t, rule.Validate(domain.Commit{Subject: "[PROJ-12] ✨ feat: add login"}, cfg))
+
+	errors := rule.Validate(domain.Commit{Subject: "✨ feat: add a login page for users"}, cfg)
+	require.Len(t, errors, 1)
+	require.Equal(t, string(domain.ErrSubjectTooLong), errors[0].Code)
+	require.Equal(t, "32", errors[0].Context["actual"])
+	require.Equal(t, "34", errors[0].Context["raw_length"])
+	require.Equal(t, "32", errors[0].Context["effective_length"])
+	require.Equal(t, "✨", errors[0].Context["excluded_prefix"])
+
+	errors = rule.Validate(domain.Commit{Subject: "[PROJ-12] feat: Add login"}, cfg)
+	require.Len(t, errors, 1)
+	require.Equal(t, "add", errors[0].Suggestion())
+
+	// A prefix that is the whole subject is checked as the subject
+	errors = rule.Validate(domain.Commit{Subject: "[PROJ-12]"}, cfg)
+	require.Len(t, errors, 1)
+	require.Equal(t, string(domain.ErrWrongCaseLower), errors[0].Code)
+
+	// Without excluded prefixes both lengths are the subject length
+	errors = NewSubjectRule(config.Config{Message: config.MessageConfig{Subject: config.SubjectConfig{MaxLength: 20}}}).
+		Validate(domain.Commit{Subject: "feat: add a login page for users"}, cfg)
+	require.Len(t, errors, 1)
+	require.Equal(t, "32", errors[0].Context["raw_length"])
+	require.Equal(t, "32", errors[0].Context["effective_length"])
+	require.NotContains(t, errors[0].Context, "excluded_prefix")
+}
+
 func TestSubjectRule_EnhancedConventionalCommitParsing(t *testing.T) {
 	tests := []struct {
 		name                string
@@ -0,0 +1,175 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package rules
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"slices"
+	"strings"
+	"unicode"
+
+	"github.com/itiquette/gommitlint/internal/domain"
+	"github.com/itiquette/gommitlint/internal/domain/config"
+)
+
+// SubjectEchoRule rejects subjects that only repeat the name of the branch or the title
+// of the ticket, which say what the task was rather than what the commit changes.
+type SubjectEchoRule struct {
+	projects []string
+	tracker  TicketTracker
+}
+
+// NewSubjectEchoRule creates a new SubjectEchoRule from config.
+func NewSubjectEchoRule(cfg config.Config) SubjectEchoRule {
+	return SubjectEchoRule{projects: cfg.Jira.ProjectPrefixes}
+}
+
+// WithTracker returns the rule comparing subjects with the titles of the tickets in tracker.
+func (r SubjectEchoRule) WithTracker(tracker TicketTracker) SubjectEchoRule {
+	r.tracker = tracker
+
+	return r
+}
+
+// Name returns the rule name.
+func (r SubjectEchoRule) Name() string {
+	return "SubjectEcho"
+}
+
+// Metadata returns the documentation of the rule.
+func (r SubjectEchoRule) Metadata() domain.RuleMetadata {
+	return domain.RuleMetadata{
+		ID:       "subjectecho",
+		Name:     r.Name(),
+		Category: domain.CategoryMessage,
+		Severity: domain.SeverityError,
+		Summary:  "Subject does not just repeat the branch name or ticket title",
+		Description: "Compares the words of the subject, with and without its conventional " +
+			"commit type, to those of the name of the checked out branch and of its last " +
+			"path segment, ignoring case and punctuation, so that 'feature proj 123 add login' " +
+			"on feature/PROJ-123-add-login fails. With jira.online.enabled the subject, without " +
+			"ticket keys, must also differ from the title of the tickets it or the branch " +
+			"references, limited to jira.project_prefixes when that list is set. Merge commits " +
+			"are not checked, and tickets that cannot be looked up are not compared.",
+		ErrorCodes: []domain.ValidationErrorCode{domain.ErrSubjectEchoesBranch, domain.ErrSubjectEchoesTicket},
+		ConfigKeys: []string{"jira.online.enabled", "jira.project_prefixes"},
+		Examples: []domain.RuleExample{
+			{Message: "feat: retry failed uploads with backoff", Valid: true, Note: "on feature/PROJ-123-retry-uploads"},
+			{Message: "feature proj 123 retry uploads", Note: "on feature/PROJ-123-retry-uploads"},
+		},
+	}
+}
+
+// ChecksMessage marks the rule as a domain.MessageRule.
+func (r SubjectEchoRule) ChecksMessage() {}
+
+// Validate compares the subject of a commit with the current branch and, when a tracker
+// is set, with the titles of the referenced tickets.
+func (r SubjectEchoRule) Validate(commit domain.Commit, repo domain.Repository, _ config.Config) []domain.ValidationError {
+	if commit.IsMergeCommit {
+		return nil
+	}
+
+	subjects := [][]string{
+		echoWords(commit.Subject),
+		echoWords(domain.ExtractDescriptionFromConventional(commit.Subject)),
+	}
+
+	branch := currentBranch(repo)
+	if branch != "" && echoesAny(subjects, echoWords(branch), echoWords(path.Base(branch))) {
+		return []domain.ValidationError{
+			domain.New(r.Name(), domain.ErrSubjectEchoesBranch,
+				fmt.Sprintf("Subject only repeats the branch name '%s'", branch)).
+				WithContextMap(map[string]string{"actual": commit.Subject, "branch": branch}).
+				WithHelp("Describe what the commit changes, not the task the branch was made for"),
+		}
+	}
+
+	if r.tracker == nil {
+		return nil
+	}
+
+	// Ticket keys are left out, as in "PROJ-123: Add login page"
+	withoutKeys := ticketKeyPattern.ReplaceAllString(commit.Subject, " ")
+	subjects = append(subjects,
+		echoWords(withoutKeys),
+		echoWords(domain.ExtractDescriptionFromConventional(strings.TrimSpace(withoutKeys))))
+
+	for _, key := range r.referencedTickets(commit.Subject+"\n"+commit.Body, branch) {
+		ticket, err := r.tracker.LookupTicket(key)
+		if err != nil || !ticket.Exists || ticket.Title == "" {
+			continue
+		}
+
+		if echoesAny(subjects, echoWords(ticket.Title)) {
+			return []domain.ValidationError{
+				domain.New(r.Name(), domain.ErrSubjectEchoesTicket,
+					fmt.Sprintf("Subject only repeats the title of ticket %s", key)).
+					WithContextMap(map[string]string{"actual": commit.Subject, "ticket": key, "title": ticket.Title}).
+					WithHelp("Describe what this commit changes; the ticket title names the whole task"),
+			}
+		}
+	}
+
+	return nil
+}
+
+// referencedTickets returns the distinct ticket keys in text and in branch, of the
+// configured projects when there are any.
+func (r SubjectEchoRule) referencedTickets(text, branch string) []string {
+	var keys []string
+
+	for _, key := range ticketKeyPattern.FindAllString(text+"\n"+strings.ToUpper(branch), -1) {
+		project, _, _ := strings.Cut(key, "-")
+		if len(r.projects) > 0 && !slices.Contains(r.projects, project) {
+			continue
+		}
+
+		if !slices.Contains(keys, key) {
+			keys = append(keys, key)
+		}
+	}
+
+	return keys
+}
+
+// currentBranch returns the branch checked out in repo, or an empty string when it
+// cannot be resolved.
+func currentBranch(repo domain.Repository) string {
+	resolver, ok := repo.(domain.CurrentBranchResolver)
+	if !ok {
+		return ""
+	}
+
+	branch, err := resolver.GetCurrentBranch(context.Background())
+	if err != nil {
+		return ""
+	}
+
+	return branch
+}
+
+// echoWords returns the lowercased words of text, split at everything but letters and
+// digits, so that "feature/PROJ-123-add-login" and "Feature: proj 123 add login" agree.
+func echoWords(text string) []string {
+	return strings.FieldsFunc(strings.ToLower(text), func(char rune) bool {
+		return !unicode.IsLetter(char) && !unicode.IsDigit(char)
+	})
+}
+
+// echoesAny reports whether one of subjects has the same words as one of names.
+func echoesAny(subjects [][]string, names ...[]string) bool {
+	for _, subject := range subjects {
+		for _, name := range names {
+			if len(subject) > 0 && slices.Equal(subject, name) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
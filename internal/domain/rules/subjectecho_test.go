@@ -0,0 +1,119 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package rules_test
+
+import (
+	"testing"
+
+	"github.com/itiquette/gommitlint/internal/domain"
+	"github.com/itiquette/gommitlint/internal/domain/config"
+	"github.com/itiquette/gommitlint/internal/domain/rules"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSubjectEchoRule(t *testing.T) {
+	tracker := &fakeTracker{tickets: map[string]domain.Ticket{
+		"PROJ-123": {Key: "PROJ-123", Exists: true, Project: "PROJ", Title: "Add login page"},
+		"OPS-7":    {Key: "OPS-7", Exists: true, Project: "OPS", Title: "Rotate certificates"},
+	}}
+
+	tests := []struct {
+		name            string
+		branch          string
+		message         string
+		tracker         bool
+		expectedCode    domain.ValidationErrorCode
+		expectedMessage string
+	}{
+		{
+			name:            "branch name with spaces",
+			branch:          "feature/PROJ-123-add-login",
+			message:         "feature proj 123 add login",
+			expectedCode:    domain.ErrSubjectEchoesBranch,
+			expectedMessage: "Subject only repeats the branch name 'feature/PROJ-123-add-login'",
+		},
+		{
+			name:         "last path segment",
+			branch:       "feature/PROJ-123-add-login",
+			message:      "PROJ-123 Add login",
+			expectedCode: domain.ErrSubjectEchoesBranch,
+		},
+		{
+			name:         "conventional description",
+			branch:       "fix/retry-uploads",
+			message:      "fix(upload): Retry uploads",
+			expectedCode: domain.ErrSubjectEchoesBranch,
+		},
+		{
+			name:    "describes the change",
+			branch:  "feature/PROJ-123-add-login",
+			message: "feat: add login form with remember-me option",
+		},
+		{
+			name:    "no branch",
+			message: "feature proj 123 add login",
+		},
+		{
+			name:            "ticket title",
+			branch:          "feature/PROJ-123",
+			message:         "PROJ-123: Add login page",
+			tracker:         true,
+			expectedCode:    domain.ErrSubjectEchoesTicket,
+			expectedMessage: "Subject only repeats the title of ticket PROJ-123",
+		},
+		{
+			name:         "ticket referenced in the body",
+			message:      "feat: rotate certificates\n\nRefs OPS-7",
+			tracker:      true,
+			expectedCode: domain.ErrSubjectEchoesTicket,
+		},
+		{
+			name:    "ticket title without online mode",
+			branch:  "feature/PROJ-123",
+			message: "PROJ-123: Add login page",
+		},
+		{
+			name:    "differs from the ticket title",
+			branch:  "feature/PROJ-123",
+			message: "Add login page route and session cookie",
+			tracker: true,
+		},
+		{
+			name:    "ticket of another project",
+			message: "Archive reports\n\nRefs DATA-1",
+			tracker: true,
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			cfg := config.NewDefault()
+			cfg.Jira.ProjectPrefixes = []string{"PROJ", "OPS"}
+
+			rule := rules.NewSubjectEchoRule(cfg)
+			if testCase.tracker {
+				rule = rule.WithTracker(tracker)
+			}
+
+			commit := domain.NewCommit("abc123", testCase.message, "Dev Eloper", "dev@example.com", "", "", false)
+			errors := rule.Validate(commit, currentBranchRepository{branch: testCase.branch}, cfg)
+
+			if testCase.expectedCode == "" {
+				require.Empty(t, errors)
+
+				return
+			}
+
+			require.Len(t, errors, 1)
+			require.Equal(t, string(testCase.expectedCode), errors[0].Code)
+
+			if testCase.expectedMessage != "" {
+				require.Equal(t, testCase.expectedMessage, errors[0].Message)
+			}
+		})
+	}
+
+	require.NotContains(t, tracker.lookups, "DATA-1", "keys of other projects are not looked up")
+}
@@ -0,0 +1,183 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package rules
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"regexp/syntax"
+
+	"github.com/itiquette/gommitlint/internal/domain"
+	"github.com/itiquette/gommitlint/internal/domain/config"
+)
+
+// SubjectPatternRule requires the subject to match a configured regular expression, for
+// subject formats other than conventional commits such as "[MODULE] Description (#123)".
+// The named groups of the expression locate which part of a subject is wrong.
+type SubjectPatternRule struct {
+	source  string
+	pattern *regexp.Regexp
+	message string
+	groups  map[string]string
+	err     error
+}
+
+// NewSubjectPatternRule creates a new SubjectPatternRule from config. A missing or
+// invalid pattern is reported as a validation error when the rule runs.
+func NewSubjectPatternRule(cfg config.Config) SubjectPatternRule {
+	source := cfg.SubjectPattern.Pattern
+
+	var (
+		pattern *regexp.Regexp
+		err     error
+	)
+
+	if source == "" {
+		err = errors.New("subject_pattern.pattern is not set")
+	} else {
+		pattern, err = regexp.Compile(source)
+	}
+
+	return SubjectPatternRule{
+		source:  source,
+		pattern: pattern,
+		message: cfg.SubjectPattern.Message,
+		groups:  cfg.SubjectPattern.Groups,
+		err:     err,
+	}
+}
+
+// Name returns the rule name.
+func (r SubjectPatternRule) Name() string {
+	return "SubjectPattern"
+}
+
+// Metadata returns the documentation of the rule.
+func (r SubjectPatternRule) Metadata() domain.RuleMetadata {
+	return domain.RuleMetadata{
+		ID:       "subjectpattern",
+		Name:     r.Name(),
+		Category: domain.CategoryMessage,
+		Severity: domain.SeverityError,
+		Summary:  "Subject matches a configured regular expression",
+		Description: "Requires the subject to match subject_pattern.pattern, for teams with their own " +
+			"subject format. When it does not match, each named group of the pattern is in turn " +
+			"allowed to hold any text; the first group that makes the subject match is reported " +
+			"with its message from subject_pattern.groups, and otherwise the whole subject with " +
+			"subject_pattern.message. Merge commits are not checked.",
+		ErrorCodes: []domain.ValidationErrorCode{
+			domain.ErrSubjectPatternMismatch, domain.ErrSubjectPatternGroup, domain.ErrInvalidConfig,
+		},
+		ConfigKeys: []string{"subject_pattern.pattern", "subject_pattern.message", "subject_pattern.groups"},
+		Examples: []domain.RuleExample{
+			{Message: "[API] Retry uploads (#123)", Valid: true, Note: `with pattern ^\[(?P<module>[A-Z]+)\] (?P<description>.+) \(#(?P<issue>[0-9]+)\)$`},
+			{Message: "[api] Retry uploads (#123)", Note: "module group does not match"},
+		},
+	}
+}
+
+// Validate checks the subject against the pattern.
+func (r SubjectPatternRule) Validate(commit domain.Commit, _ config.Config) []domain.ValidationError {
+	if r.err != nil {
+		return []domain.ValidationError{
+			domain.New(r.Name(), domain.ErrInvalidConfig, "Invalid subject pattern: "+r.err.Error()).
+				WithContextMap(map[string]string{
+					"pattern": r.source,
+				}).
+				WithHelp("Set subject_pattern.pattern to a valid regular expression"),
+		}
+	}
+
+	if commit.IsMergeCommit || r.pattern.MatchString(commit.Subject) {
+		return nil
+	}
+
+	if name, part, found := r.mismatchedGroup(commit.Subject); found {
+		message := r.groups[name]
+		if message == "" {
+			message = fmt.Sprintf("The %s part of the subject is wrong", name)
+		}
+
+		return []domain.ValidationError{
+			domain.New(r.Name(), domain.ErrSubjectPatternGroup, message).
+				WithContextMap(map[string]string{
+					"group":   name,
+					"actual":  part,
+					"pattern": r.source,
+					"subject": commit.Subject,
+				}).
+				WithHelp(fmt.Sprintf("Change '%s' so that the %s part of the subject matches %s", part, name, r.source)),
+		}
+	}
+
+	message := r.message
+	if message == "" {
+		message = "Subject does not match pattern " + r.source
+	}
+
+	return []domain.ValidationError{
+		domain.New(r.Name(), domain.ErrSubjectPatternMismatch, message).
+			WithContextMap(map[string]string{
+				"actual":   commit.Subject,
+				"expected": r.source,
+				"pattern":  r.source,
+			}).
+			WithHelp("Change the subject so that it matches " + r.source),
+	}
+}
+
+// mismatchedGroup returns the first named group of the pattern that, when it may hold
+// any text, makes subject match, and the text it then holds.
+func (r SubjectPatternRule) mismatchedGroup(subject string) (string, string, bool) {
+	for index, name := range r.pattern.SubexpNames() {
+		if name == "" {
+			continue
+		}
+
+		relaxed, err := relaxGroup(r.source, name)
+		if err != nil {
+			continue
+		}
+
+		if match := relaxed.FindStringSubmatch(subject); match != nil {
+			return name, match[index], true
+		}
+	}
+
+	return "", "", false
+}
+
+// relaxGroup compiles pattern with the named group replaced by one matching any text.
+// The group keeps its number, as only groups within or after it can be renumbered.
+func relaxGroup(pattern, name string) (*regexp.Regexp, error) {
+	tree, err := syntax.Parse(pattern, syntax.Perl)
+	if err != nil {
+		return nil, err
+	}
+
+	anyText, err := syntax.Parse(`(?s:.*?)`, syntax.Perl)
+	if err != nil {
+		return nil, err
+	}
+
+	var relax func(node *syntax.Regexp)
+
+	relax = func(node *syntax.Regexp) {
+		if node.Op == syntax.OpCapture && node.Name == name {
+			node.Sub[0] = anyText
+
+			return
+		}
+
+		for _, sub := range node.Sub {
+			relax(sub)
+		}
+	}
+
+	relax(tree)
+
+	return regexp.Compile(tree.String())
+}
@@ -0,0 +1,130 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package rules_test
+
+import (
+	"testing"
+
+	"github.com/itiquette/gommitlint/internal/domain"
+	"github.com/itiquette/gommitlint/internal/domain/config"
+	"github.com/itiquette/gommitlint/internal/domain/rules"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSubjectPatternRule(t *testing.T) {
+	const modulePattern = `^\[(?P<module>[A-Z]+)\] (?P<description>[A-Z].*[^.]) \(#(?P<issue>[0-9]+)\)$`
+
+	tests := []struct {
+		name            string
+		pattern         string
+		message         string
+		groups          map[string]string
+		subject         string
+		isMerge         bool
+		expectedCode    domain.ValidationErrorCode
+		expectedMessage string
+		expectedGroup   string
+		expectedActual  string
+	}{
+		{
+			name:    "matching subject",
+			pattern: modulePattern,
+			subject: "[API] Retry uploads (#123)",
+		},
+		{
+			name:            "wrong module",
+			pattern:         modulePattern,
+			groups:          map[string]string{"module": "Start with the module in capitals, such as [API]"},
+			subject:         "[api] Retry uploads (#123)",
+			expectedCode:    domain.ErrSubjectPatternGroup,
+			expectedMessage: "Start with the module in capitals, such as [API]",
+			expectedGroup:   "module",
+			expectedActual:  "api",
+		},
+		{
+			name:            "wrong issue without group message",
+			pattern:         modulePattern,
+			subject:         "[API] Retry uploads (#12a)",
+			expectedCode:    domain.ErrSubjectPatternGroup,
+			expectedMessage: "The issue part of the subject is wrong",
+			expectedGroup:   "issue",
+			expectedActual:  "12a",
+		},
+		{
+			name:            "description ending with a period",
+			pattern:         modulePattern,
+			groups:          map[string]string{"description": "Describe the change without a trailing period"},
+			subject:         "[API] Retry uploads. (#123)",
+			expectedCode:    domain.ErrSubjectPatternGroup,
+			expectedMessage: "Describe the change without a trailing period",
+			expectedGroup:   "description",
+			expectedActual:  "Retry uploads.",
+		},
+		{
+			name:            "several parts wrong",
+			pattern:         modulePattern,
+			message:         "Use the format '[MODULE] Description (#123)'",
+			subject:         "[api] Retry uploads",
+			expectedCode:    domain.ErrSubjectPatternMismatch,
+			expectedMessage: "Use the format '[MODULE] Description (#123)'",
+		},
+		{
+			name:            "pattern without groups",
+			pattern:         `^[A-Z]+-[0-9]+ `,
+			subject:         "Retry uploads",
+			expectedCode:    domain.ErrSubjectPatternMismatch,
+			expectedMessage: "Subject does not match pattern ^[A-Z]+-[0-9]+ ",
+		},
+		{
+			name:    "merge commit skipped",
+			pattern: modulePattern,
+			subject: "Merge branch 'main'",
+			isMerge: true,
+		},
+		{
+			name:         "missing pattern",
+			subject:      "[API] Retry uploads (#123)",
+			expectedCode: domain.ErrInvalidConfig,
+		},
+		{
+			name:         "invalid pattern",
+			pattern:      `^[A-Z`,
+			subject:      "[API] Retry uploads (#123)",
+			expectedCode: domain.ErrInvalidConfig,
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			cfg := config.NewDefault()
+			cfg.SubjectPattern = config.SubjectPatternConfig{
+				Pattern: testCase.pattern,
+				Message: testCase.message,
+				Groups:  testCase.groups,
+			}
+
+			rule := rules.NewSubjectPatternRule(cfg)
+			errors := rule.Validate(domain.Commit{Subject: testCase.subject, IsMergeCommit: testCase.isMerge}, cfg)
+
+			if testCase.expectedCode == "" {
+				require.Empty(t, errors)
+
+				return
+			}
+
+			require.Len(t, errors, 1)
+			require.Equal(t, string(testCase.expectedCode), errors[0].Code)
+
+			if testCase.expectedMessage != "" {
+				require.Equal(t, testCase.expectedMessage, errors[0].Message)
+			}
+
+			if testCase.expectedGroup != "" {
+				require.Equal(t, testCase.expectedGroup, errors[0].Context["group"])
+				require.Equal(t, testCase.expectedActual, errors[0].Context["actual"])
+			}
+		})
+	}
+}
@@ -0,0 +1,321 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package rules
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/itiquette/gommitlint/internal/domain"
+	"github.com/itiquette/gommitlint/internal/domain/config"
+)
+
+// TrailersRule validates the git trailers at the end of commit messages:
+// known keys, canonical capitalization, duplicates, ordering, the keys commits of a
+// conventional type must carry and the format of values.
+type TrailersRule struct {
+	canonical   map[string]string // Lowercased key to its canonical spelling
+	anyKey      bool
+	unique      map[string]bool
+	order       map[string]int
+	orderKeys   []string
+	required    map[string][]string       // Lowercased conventional type to the keys it requires
+	patterns    map[string]*regexp.Regexp // Lowercased key to the pattern of its values
+	typeAliases map[string]string
+}
+
+// NewTrailersRule creates a new TrailersRule from config.
+func NewTrailersRule(cfg config.Config) TrailersRule {
+	rule := TrailersRule{
+		canonical:   make(map[string]string),
+		unique:      make(map[string]bool),
+		order:       make(map[string]int),
+		required:    make(map[string][]string),
+		patterns:    make(map[string]*regexp.Regexp),
+		typeAliases: cfg.Conventional.TypeAliases,
+	}
+
+	for _, key := range cfg.Trailers.Allowed {
+		if key == "*" {
+			rule.anyKey = true
+
+			continue
+		}
+
+		rule.canonical[normalizeTrailerKey(key)] = key
+	}
+
+	for _, key := range cfg.Trailers.Unique {
+		rule.unique[normalizeTrailerKey(key)] = true
+	}
+
+	for index, key := range cfg.Trailers.Order {
+		rule.order[normalizeTrailerKey(key)] = index
+		rule.orderKeys = append(rule.orderKeys, key)
+	}
+
+	// Keys that are required or given a pattern are known keys too
+	for commitType, keys := range cfg.Trailers.RequiredByType {
+		rule.required[strings.ToLower(commitType)] = keys
+
+		for _, key := range keys {
+			rule.addKnownKey(key)
+		}
+	}
+
+	for key, pattern := range cfg.Trailers.Patterns {
+		// Invalid patterns are reported when the configuration is validated
+		if compiled, err := regexp.Compile(pattern); err == nil {
+			rule.patterns[normalizeTrailerKey(key)] = compiled
+			rule.addKnownKey(key)
+		}
+	}
+
+	return rule
+}
+
+// addKnownKey adds key to the known keys, unless it is known in another spelling.
+func (r TrailersRule) addKnownKey(key string) {
+	if _, known := r.canonical[normalizeTrailerKey(key)]; !known {
+		r.canonical[normalizeTrailerKey(key)] = key
+	}
+}
+
+// Name returns the rule name.
+func (r TrailersRule) Name() string {
+	return "Trailers"
+}
+
+// Metadata returns the documentation of the rule.
+func (r TrailersRule) Metadata() domain.RuleMetadata {
+	return domain.RuleMetadata{
+		ID:       "trailers",
+		Name:     r.Name(),
+		Category: domain.CategoryMessage,
+		Severity: domain.SeverityError,
+		Summary:  "Trailer keys, capitalization, duplicates, order and required trailers",
+		Description: "Checks the trailer block, the last paragraph of the message when it consists " +
+			"of 'Key: value' lines. Keys must be listed in trailers.allowed, where \"*\" allows any " +
+			"key, and spelled as listed. Identical trailers may not repeat, keys in trailers.unique " +
+			"may appear only once, and keys in trailers.order must appear in that order. " +
+			"trailers.required_by_type lists the keys commits of a conventional type must carry, " +
+			"with type aliases resolved, and trailers.patterns the regular expressions the values " +
+			"of a key must match. Required keys and keys with a pattern count as allowed.",
+		ErrorCodes: []domain.ValidationErrorCode{
+			domain.ErrUnknownTrailer, domain.ErrTrailerCase, domain.ErrDuplicateTrailer, domain.ErrTrailerOrder,
+			domain.ErrMissingTrailer, domain.ErrTrailerValue,
+		},
+		ConfigKeys: []string{
+			"trailers.allowed", "trailers.unique", "trailers.order", "trailers.required_by_type", "trailers.patterns",
+		},
+		Examples: []domain.RuleExample{
+			{Message: "fix: handle empty input\n\nFixes: #12\nSigned-off-by: Dev <dev@example.com>", Valid: true},
+			{Message: "fix: handle empty input\n\nSigned-Off-By: Dev <dev@example.com>", Note: "wrong capitalization of Signed-off-by"},
+			{Message: "fix: handle empty input\n\nChange-Id: I1\nChange-Id: I2", Note: "Change-Id may appear only once"},
+			{
+				Message: "fix: handle empty input\n\nRisk: none",
+				Note:    "with required_by_type fix: [Refs, Risk] and a Risk pattern of ^(low|medium|high)$",
+			},
+		},
+	}
+}
+
+// Validate checks the trailers of a commit message.
+func (r TrailersRule) Validate(commit domain.Commit, _ config.Config) []domain.ValidationError {
+	if commit.IsMergeCommit {
+		return nil
+	}
+
+	trailers := commit.MessageTrailers()
+
+	var errors []domain.ValidationError
+
+	errors = append(errors, r.validateKeys(trailers)...)
+	errors = append(errors, r.validateDuplicates(trailers)...)
+	errors = append(errors, r.validateOrder(trailers)...)
+	errors = append(errors, r.validateRequired(commit, trailers)...)
+	errors = append(errors, r.validateValues(trailers)...)
+
+	return errors
+}
+
+// validateRequired checks that the commit carries the keys its conventional type
+// requires. Commits that are not conventional commits require none.
+func (r TrailersRule) validateRequired(commit domain.Commit, trailers []domain.Trailer) []domain.ValidationError {
+	commitType := strings.ToLower(domain.CanonicalConventionalType(commit.Subject, r.typeAliases))
+	if commitType == "" {
+		return nil
+	}
+
+	var errors []domain.ValidationError
+
+	for _, key := range r.required[commitType] {
+		if slices.ContainsFunc(trailers, func(trailer domain.Trailer) bool {
+			return normalizeTrailerKey(trailer.Key) == normalizeTrailerKey(key)
+		}) {
+			continue
+		}
+
+		help := fmt.Sprintf("Add a '%s:' trailer to the end of the message", key)
+		if pattern, found := r.patterns[normalizeTrailerKey(key)]; found {
+			help += fmt.Sprintf(", with a value matching %s", pattern)
+		}
+
+		errors = append(errors,
+			domain.New(r.Name(), domain.ErrMissingTrailer, fmt.Sprintf("Commit of type %s has no %s trailer", commitType, key)).
+				WithContextMap(map[string]string{
+					"actual":   "none",
+					"expected": key,
+					"trailer":  key,
+					"type":     commitType,
+				}).
+				WithHelp(help))
+	}
+
+	return errors
+}
+
+// validateValues checks the values of the keys given a pattern.
+func (r TrailersRule) validateValues(trailers []domain.Trailer) []domain.ValidationError {
+	var errors []domain.ValidationError
+
+	for _, trailer := range trailers {
+		pattern, found := r.patterns[normalizeTrailerKey(trailer.Key)]
+		if !found || pattern.MatchString(trailer.Value) {
+			continue
+		}
+
+		errors = append(errors,
+			domain.New(r.Name(), domain.ErrTrailerValue, fmt.Sprintf("%s trailer value does not match its pattern", trailer.Key)).
+				WithContextMap(map[string]string{
+					"actual":   trailer.Value,
+					"expected": pattern.String(),
+					"trailer":  trailer.Key,
+				}).
+				WithHelp(fmt.Sprintf("Write the %s trailer with a value matching %s", trailer.Key, pattern)))
+	}
+
+	return errors
+}
+
+// validateKeys checks that trailer keys are known and canonically capitalized.
+func (r TrailersRule) validateKeys(trailers []domain.Trailer) []domain.ValidationError {
+	var errors []domain.ValidationError
+
+	for _, trailer := range trailers {
+		canonical, known := r.canonical[normalizeTrailerKey(trailer.Key)]
+
+		switch {
+		case known && trailer.Key != canonical && trailer.Key != "BREAKING-CHANGE":
+			errors = append(errors, domain.New(r.Name(), domain.ErrTrailerCase, "Trailer key is not canonically capitalized").
+				WithContextMap(map[string]string{
+					"actual":   trailer.Key,
+					"expected": canonical,
+				}).
+				WithHelp("Write the trailer as '"+canonical+": "+trailer.Value+"'"))
+		case !known && !r.anyKey:
+			errors = append(errors, domain.New(r.Name(), domain.ErrUnknownTrailer, "Unknown trailer key").
+				WithContextMap(map[string]string{
+					"actual":   trailer.Key,
+					"expected": strings.Join(r.allowedKeys(), ", "),
+				}).
+				WithHelp("Use a known trailer key or add '"+trailer.Key+"' to trailers.allowed"))
+		}
+	}
+
+	return errors
+}
+
+// validateDuplicates reports repeated identical trailers and repeated unique keys.
+func (r TrailersRule) validateDuplicates(trailers []domain.Trailer) []domain.ValidationError {
+	var errors []domain.ValidationError
+
+	seen := make(map[string]bool)
+	counts := make(map[string]int)
+
+	for _, trailer := range trailers {
+		key := normalizeTrailerKey(trailer.Key)
+		line := key + ":" + strings.ToLower(trailer.Value)
+		counts[key]++
+
+		if seen[line] {
+			errors = append(errors, domain.New(r.Name(), domain.ErrDuplicateTrailer, "Duplicate trailer").
+				WithContextMap(map[string]string{
+					"actual":   trailer.Key + ": " + trailer.Value,
+					"expected": "each trailer once",
+				}).
+				WithHelp("Remove the repeated trailer line"))
+
+			continue
+		}
+
+		seen[line] = true
+
+		if r.unique[key] && counts[key] == 2 {
+			errors = append(errors, domain.New(r.Name(), domain.ErrDuplicateTrailer, "Trailer may appear only once").
+				WithContextMap(map[string]string{
+					"actual":   trailer.Key,
+					"expected": "at most one " + trailer.Key + " trailer",
+				}).
+				WithHelp("Keep a single "+trailer.Key+" trailer"))
+		}
+	}
+
+	return errors
+}
+
+// validateOrder checks that keys listed in trailers.order appear in that order.
+func (r TrailersRule) validateOrder(trailers []domain.Trailer) []domain.ValidationError {
+	latest := -1
+	latestKey := ""
+
+	for _, trailer := range trailers {
+		index, ordered := r.order[normalizeTrailerKey(trailer.Key)]
+		if !ordered {
+			continue
+		}
+
+		if index < latest {
+			return []domain.ValidationError{
+				domain.New(r.Name(), domain.ErrTrailerOrder, "Trailers are out of order").
+					WithContextMap(map[string]string{
+						"actual":   trailer.Key + " after " + latestKey,
+						"expected": strings.Join(r.orderKeys, ", "),
+					}).
+					WithHelp("Move " + trailer.Key + " before " + latestKey),
+			}
+		}
+
+		latest = index
+		latestKey = trailer.Key
+	}
+
+	return nil
+}
+
+// allowedKeys returns the configured trailer keys in canonical spelling.
+func (r TrailersRule) allowedKeys() []string {
+	keys := make([]string, 0, len(r.canonical))
+	for _, key := range r.canonical {
+		keys = append(keys, key)
+	}
+
+	slices.Sort(keys)
+
+	return keys
+}
+
+// normalizeTrailerKey returns the case-insensitive form of a trailer key.
+// BREAKING-CHANGE is a synonym of BREAKING CHANGE in Conventional Commits.
+func normalizeTrailerKey(key string) string {
+	key = strings.ToLower(key)
+	if key == "breaking-change" {
+		return "breaking change"
+	}
+
+	return key
+}
@@ -0,0 +1,184 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package rules_test
+
+import (
+	"testing"
+
+	"github.com/itiquette/gommitlint/internal/domain"
+	"github.com/itiquette/gommitlint/internal/domain/config"
+	"github.com/itiquette/gommitlint/internal/domain/rules"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTrailersRule(t *testing.T) {
+	const signoff = "Signed-off-by: Dev Eloper <dev@example.com>"
+
+	tests := []struct {
+		name          string
+		trailers      string
+		configure     func(cfg *config.TrailersConfig)
+		expectedCodes []domain.ValidationErrorCode
+		expectedValue string
+	}{
+		{
+			name:     "known trailers",
+			trailers: "Refs: #12\nCo-authored-by: Jane Doe <jane@example.com>\n" + signoff,
+		},
+		{
+			name:          "unknown key",
+			trailers:      "Sponsored-by: ACME\n" + signoff,
+			expectedCodes: []domain.ValidationErrorCode{domain.ErrUnknownTrailer},
+			expectedValue: "Sponsored-by",
+		},
+		{
+			name:      "any key allowed",
+			trailers:  "Sponsored-by: ACME\n" + signoff,
+			configure: func(cfg *config.TrailersConfig) { cfg.Allowed = append(cfg.Allowed, "*") },
+		},
+		{
+			name:          "non-canonical capitalization",
+			trailers:      "Signed-Off-By: Dev Eloper <dev@example.com>",
+			expectedCodes: []domain.ValidationErrorCode{domain.ErrTrailerCase},
+			expectedValue: "Signed-Off-By",
+		},
+		{
+			name:     "breaking change synonym",
+			trailers: "BREAKING-CHANGE: drop the v1 API",
+		},
+		{
+			name:          "identical trailer twice",
+			trailers:      signoff + "\n" + signoff,
+			expectedCodes: []domain.ValidationErrorCode{domain.ErrDuplicateTrailer},
+		},
+		{
+			name:          "unique key repeated",
+			trailers:      "Change-Id: I1234\nChange-Id: I5678",
+			expectedCodes: []domain.ValidationErrorCode{domain.ErrDuplicateTrailer},
+			expectedValue: "Change-Id",
+		},
+		{
+			name:     "repeatable key with different values",
+			trailers: "Reviewed-by: A <a@example.com>\nReviewed-by: B <b@example.com>",
+		},
+		{
+			name:          "out of order",
+			trailers:      signoff + "\nRefs: #12",
+			configure:     func(cfg *config.TrailersConfig) { cfg.Order = []string{"Refs", "Signed-off-by"} },
+			expectedCodes: []domain.ValidationErrorCode{domain.ErrTrailerOrder},
+			expectedValue: "Refs after Signed-off-by",
+		},
+		{
+			name:      "in order",
+			trailers:  "Refs: #12\n" + signoff,
+			configure: func(cfg *config.TrailersConfig) { cfg.Order = []string{"Refs", "Signed-off-by"} },
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			cfg := config.NewDefault()
+			if testCase.configure != nil {
+				testCase.configure(&cfg.Trailers)
+			}
+
+			commit := domain.ParseCommitMessage("Add feature\n\nDescribe the feature.\n\n" + testCase.trailers)
+			errors := rules.NewTrailersRule(cfg).Validate(commit, cfg)
+
+			codes := make([]domain.ValidationErrorCode, 0, len(errors))
+			for _, err := range errors {
+				codes = append(codes, domain.ValidationErrorCode(err.Code))
+			}
+
+			if len(testCase.expectedCodes) == 0 {
+				require.Empty(t, codes)
+
+				return
+			}
+
+			require.Equal(t, testCase.expectedCodes, codes)
+
+			if testCase.expectedValue != "" {
+				require.Equal(t, testCase.expectedValue, errors[0].Context["actual"])
+			}
+		})
+	}
+}
+
+func TestTrailersRule_RequiredByType(t *testing.T) {
+	tests := []struct {
+		name          string
+		message       string
+		expectedCodes []domain.ValidationErrorCode
+		expectedValue string
+	}{
+		{
+			name:    "required trailers present",
+			message: "fix: handle empty input\n\nRefs: #12\nRisk: low",
+		},
+		{
+			name:          "required trailer missing",
+			message:       "fix: handle empty input\n\nRefs: #12",
+			expectedCodes: []domain.ValidationErrorCode{domain.ErrMissingTrailer},
+			expectedValue: "none",
+		},
+		{
+			name:          "no trailers",
+			message:       "feat: add export",
+			expectedCodes: []domain.ValidationErrorCode{domain.ErrMissingTrailer},
+		},
+		{
+			name:    "type alias",
+			message: "feature: add export\n\nDocs: https://docs.example.com/export",
+		},
+		{
+			name:    "type without requirements",
+			message: "chore: bump dependencies",
+		},
+		{
+			name:    "not a conventional commit",
+			message: "Handle empty input",
+		},
+		{
+			name:          "value not matching its pattern",
+			message:       "fix: handle empty input\n\nRefs: #12\nRisk: none",
+			expectedCodes: []domain.ValidationErrorCode{domain.ErrTrailerValue},
+			expectedValue: "none",
+		},
+		{
+			name:          "pattern checked on any commit",
+			message:       "chore: bump dependencies\n\nRisk: huge",
+			expectedCodes: []domain.ValidationErrorCode{domain.ErrTrailerValue},
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			cfg := config.NewDefault()
+			cfg.Conventional.TypeAliases = map[string]string{"feature": "feat"}
+			cfg.Trailers.RequiredByType = map[string][]string{"fix": {"Refs", "Risk"}, "feat": {"Docs"}}
+			cfg.Trailers.Patterns = map[string]string{"Risk": "^(low|medium|high)$"}
+
+			errors := rules.NewTrailersRule(cfg).Validate(domain.ParseCommitMessage(testCase.message), cfg)
+
+			codes := make([]domain.ValidationErrorCode, 0, len(errors))
+			for _, err := range errors {
+				codes = append(codes, domain.ValidationErrorCode(err.Code))
+			}
+
+			if len(testCase.expectedCodes) == 0 {
+				require.Empty(t, codes, "%v", errors)
+
+				return
+			}
+
+			require.Equal(t, testCase.expectedCodes, codes)
+
+			if testCase.expectedValue != "" {
+				require.Equal(t, testCase.expectedValue, errors[0].Context["actual"])
+			}
+		})
+	}
+}
@@ -0,0 +1,92 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package domain
+
+import "sort"
+
+// MaxMessageScore is the score of a message without violations.
+const MaxMessageScore = 100
+
+// Points a violation costs by severity. Informational findings, such as waived
+// failures, cost nothing.
+const (
+	errorPenalty   = 20
+	warningPenalty = 5
+)
+
+// MessageScore grades a commit message instead of passing or failing it.
+type MessageScore struct {
+	Score        int
+	RulesChecked int
+	RulesPassed  int
+	Improvements []Improvement
+}
+
+// Improvement is a violation to fix, with the points fixing it would gain.
+type Improvement struct {
+	Rule     string
+	Code     string
+	Message  string
+	Help     string
+	Severity SeverityLevel
+	Penalty  int
+}
+
+// NewMessageScore scores the violations found by rulesChecked rules. Each violation
+// costs points by its severity, and the improvements are ranked by the points they
+// would gain, highest first.
+func NewMessageScore(errors []ValidationError, rulesChecked int) MessageScore {
+	score := MessageScore{
+		Score:        MaxMessageScore,
+		RulesChecked: rulesChecked,
+		Improvements: make([]Improvement, 0, len(errors)),
+	}
+
+	failedRules := make(map[string]bool)
+
+	for _, err := range errors {
+		penalty := severityPenalty(err.Severity)
+		if penalty == 0 {
+			continue
+		}
+
+		severity := err.Severity
+		if severity == "" {
+			severity = SeverityError
+		}
+
+		failedRules[err.Rule] = true
+		score.Score -= penalty
+		score.Improvements = append(score.Improvements, Improvement{
+			Rule:     err.Rule,
+			Code:     err.Code,
+			Message:  err.Message,
+			Help:     err.Help,
+			Severity: severity,
+			Penalty:  penalty,
+		})
+	}
+
+	score.Score = max(score.Score, 0)
+	score.RulesPassed = max(rulesChecked-len(failedRules), 0)
+
+	sort.SliceStable(score.Improvements, func(i, j int) bool {
+		return score.Improvements[i].Penalty > score.Improvements[j].Penalty
+	})
+
+	return score
+}
+
+// severityPenalty returns the points a violation of severity costs.
+func severityPenalty(severity SeverityLevel) int {
+	switch severity {
+	case SeverityInfo:
+		return 0
+	case SeverityWarning:
+		return warningPenalty
+	default:
+		return errorPenalty
+	}
+}
@@ -0,0 +1,82 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package domain_test
+
+import (
+	"testing"
+
+	"github.com/itiquette/gommitlint/internal/domain"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewMessageScore(t *testing.T) {
+	warning := domain.New("NoWIP", "custom_rule_failed", "WIP marker").WithSeverity(domain.SeverityWarning)
+	subjectLength := domain.New("Subject", domain.ErrSubjectTooLong, "subject too long").WithHelp("Shorten it")
+	subjectEnding := domain.New("Subject", domain.ErrSubjectSuffix, "subject ends with a period")
+	waived := domain.New("SignOff", domain.ErrMissingSignoff, "missing sign-off").WithSeverity(domain.SeverityInfo)
+
+	tests := []struct {
+		name         string
+		errors       []domain.ValidationError
+		wantScore    int
+		wantPassed   int
+		wantRanking  []string
+		wantSeverity domain.SeverityLevel
+	}{
+		{
+			name:        "no violations",
+			wantScore:   domain.MaxMessageScore,
+			wantPassed:  5,
+			wantRanking: []string{},
+		},
+		{
+			name:         "errors cost more than warnings",
+			errors:       []domain.ValidationError{warning, subjectLength},
+			wantScore:    75,
+			wantPassed:   3,
+			wantRanking:  []string{"subject too long", "WIP marker"},
+			wantSeverity: domain.SeverityError,
+		},
+		{
+			name:         "waived failures cost nothing",
+			errors:       []domain.ValidationError{waived, subjectEnding},
+			wantScore:    80,
+			wantPassed:   4,
+			wantRanking:  []string{"subject ends with a period"},
+			wantSeverity: domain.SeverityError,
+		},
+		{
+			name: "score does not go below zero",
+			errors: []domain.ValidationError{
+				subjectLength, subjectEnding, subjectLength, subjectEnding, subjectLength, subjectEnding,
+			},
+			wantScore:    0,
+			wantPassed:   4,
+			wantRanking:  []string{"subject too long", "subject ends with a period", "subject too long", "subject ends with a period", "subject too long", "subject ends with a period"},
+			wantSeverity: domain.SeverityError,
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			score := domain.NewMessageScore(testCase.errors, 5)
+
+			require.Equal(t, testCase.wantScore, score.Score)
+			require.Equal(t, 5, score.RulesChecked)
+			require.Equal(t, testCase.wantPassed, score.RulesPassed)
+
+			ranking := make([]string, 0, len(score.Improvements))
+			for _, improvement := range score.Improvements {
+				ranking = append(ranking, improvement.Message)
+			}
+
+			require.Equal(t, testCase.wantRanking, ranking)
+
+			if len(score.Improvements) > 0 {
+				require.Equal(t, testCase.wantSeverity, score.Improvements[0].Severity)
+			}
+		})
+	}
+}
@@ -0,0 +1,54 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package domain
+
+import "strings"
+
+// ClosestMatch returns the candidate closest to value ignoring case, or empty if none
+// is reasonably close.
+func ClosestMatch(value string, candidates []string) string {
+	best := ""
+	bestDistance := len(value)/3 + 2
+
+	for _, candidate := range candidates {
+		distance := EditDistance(strings.ToLower(value), strings.ToLower(candidate))
+		if distance < bestDistance {
+			best = candidate
+			bestDistance = distance
+		}
+	}
+
+	return best
+}
+
+// EditDistance computes the Levenshtein distance between two strings.
+func EditDistance(left, right string) int {
+	leftRunes := []rune(left)
+	rightRunes := []rune(right)
+
+	previous := make([]int, len(rightRunes)+1)
+	current := make([]int, len(rightRunes)+1)
+
+	for j := range previous {
+		previous[j] = j
+	}
+
+	for i := 1; i <= len(leftRunes); i++ {
+		current[0] = i
+
+		for j := 1; j <= len(rightRunes); j++ {
+			cost := 1
+			if leftRunes[i-1] == rightRunes[j-1] {
+				cost = 0
+			}
+
+			current[j] = min(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+
+		previous, current = current, previous
+	}
+
+	return previous[len(rightRunes)]
+}
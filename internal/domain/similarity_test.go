@@ -0,0 +1,40 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package domain_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/itiquette/gommitlint/internal/domain"
+)
+
+func TestEditDistance(t *testing.T) {
+	tests := []struct {
+		left     string
+		right    string
+		expected int
+	}{
+		{left: "", right: "abc", expected: 3},
+		{left: "subject", right: "subject", expected: 0},
+		{left: "subjct", right: "subject", expected: 1},
+		{left: "kitten", right: "sitting", expected: 3},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.left+"-"+testCase.right, func(t *testing.T) {
+			require.Equal(t, testCase.expected, domain.EditDistance(testCase.left, testCase.right))
+		})
+	}
+}
+
+func TestClosestMatch(t *testing.T) {
+	candidates := []string{"Add", "Fix", "Remove", "Update"}
+
+	require.Equal(t, "Remove", domain.ClosestMatch("remvoe", candidates))
+	require.Equal(t, "Fix", domain.ClosestMatch("FIx", candidates))
+	require.Empty(t, domain.ClosestMatch("document", candidates))
+}
@@ -0,0 +1,33 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package domain
+
+import "context"
+
+// SubmoduleUpdate describes a submodule pointer change introduced by a commit.
+type SubmoduleUpdate struct {
+	// Path is the submodule path relative to the superproject root.
+	Path string
+
+	// OldHash is the previously referenced submodule commit, empty when the submodule was added.
+	OldHash string
+
+	// NewHash is the submodule commit referenced after the change.
+	NewHash string
+}
+
+// IsAddition returns true if the update introduces a new submodule.
+func (u SubmoduleUpdate) IsAddition() bool {
+	return u.OldHash == ""
+}
+
+// SubmoduleResolver defines the contract for discovering and opening submodules.
+type SubmoduleResolver interface {
+	// GetSubmoduleUpdates returns the submodule pointer changes introduced by the commit.
+	GetSubmoduleUpdates(ctx context.Context, ref string) ([]SubmoduleUpdate, error)
+
+	// OpenSubmodule opens the repository of the submodule checked out at path.
+	OpenSubmodule(ctx context.Context, path string) (Repository, error)
+}
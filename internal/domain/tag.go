@@ -0,0 +1,123 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package domain
+
+import (
+	"context"
+	"strings"
+)
+
+// TagSignatureRule is the rule name reported for tag signature failures.
+const TagSignatureRule = "TagSignature"
+
+// Tag represents a git tag and, for annotated tags, the signed tag object.
+type Tag struct {
+	Name        string // Short tag name such as v1.2.0
+	Hash        string // Hash of the tag object, empty for lightweight tags
+	Target      string // Hash of the tagged object
+	Tagger      string // Tagger name
+	TaggerEmail string // Tagger email
+	Message     string // Tag message
+	Signature   string // GPG or SSH signature of the tag object
+	Payload     string // Tag object without its signature, the data that was signed
+}
+
+// IsAnnotated returns true if the tag has its own tag object.
+func (t Tag) IsAnnotated() bool {
+	return t.Hash != ""
+}
+
+// TagResolver defines the contract for listing tags.
+type TagResolver interface {
+	// GetTags returns the tags matching selector, sorted by name. The selector is a tag
+	// name, a glob such as "v1.*" or a commit range "from..to" selecting the tags
+	// pointing at the commits in the range. An empty selector returns every tag.
+	GetTags(ctx context.Context, selector string) ([]Tag, error)
+}
+
+// ValidateTagSignature turns the verification result of a tag into validation errors.
+// A verified signature must also belong to one of allowedSigners when the list is set.
+func ValidateTagSignature(tag Tag, result VerificationResult, allowedSigners []string) []ValidationError {
+	if !tag.IsAnnotated() {
+		return []ValidationError{
+			New(TagSignatureRule, ErrLightweightTag, "Lightweight tag cannot be signed").
+				WithContextMap(map[string]string{
+					"tag":      tag.Name,
+					"expected": "signed annotated tag",
+				}).
+				WithHelp("Recreate the tag with 'git tag -s' to sign it"),
+		}
+	}
+
+	if strings.TrimSpace(tag.Signature) == "" {
+		return []ValidationError{
+			New(TagSignatureRule, ErrMissingSignature, "Missing cryptographic signature").
+				WithContextMap(map[string]string{
+					"tag":      tag.Name,
+					"actual":   "no signature",
+					"expected": "signed tag",
+				}).
+				WithHelp("Sign your tags using 'git tag -s' for GPG or configure SSH signing"),
+		}
+	}
+
+	if !result.IsVerified() {
+		code, help := unverifiedSignatureCode(result, "Add the tagger's public key to the trusted key directory")
+
+		return []ValidationError{
+			New(TagSignatureRule, code, result.ErrorMessage()).
+				WithContextMap(map[string]string{
+					"tag":    tag.Name,
+					"actual": result.ErrorCode(),
+				}).
+				WithHelp(help),
+		}
+	}
+
+	if len(allowedSigners) == 0 || isAllowedSigner(result.Identity(), allowedSigners) {
+		return nil
+	}
+
+	return []ValidationError{
+		New(TagSignatureRule, ErrKeyNotTrusted, "Signer not in allowed signers list").
+			WithContextMap(map[string]string{
+				"tag":      tag.Name,
+				"actual":   result.Identity().String(),
+				"expected": strings.Join(allowedSigners, ", "),
+			}).
+			WithHelp("Contact your repository administrator to add your signing key"),
+	}
+}
+
+// unverifiedSignatureCode returns the error code and help for a signature that did not
+// verify, with keyHelp as the help when no trusted key verified it.
+func unverifiedSignatureCode(result VerificationResult, keyHelp string) (ValidationErrorCode, string) {
+	switch {
+	case result.Status() == VerificationStatusNoKey:
+		return ErrKeyNotTrusted, keyHelp
+	case result.Status() == VerificationStatusUnsupported:
+		return ErrUnknownSigFormat, keyHelp
+	case result.ErrorCode() == string(ErrWeakKey):
+		return ErrWeakKey, "Sign with a key allowed by signature.min_rsa_bits, min_ec_bits and allowed_algorithms"
+	case result.ErrorCode() == string(ErrTimeout):
+		return ErrTimeout, "Raise --timeout or verify fewer signatures at a time"
+	default:
+		return ErrVerificationFailed, keyHelp
+	}
+}
+
+// isAllowedSigner reports whether the verified identity matches an allowed signer, given
+// as an email or as "Name <email>". Identities without an email, such as SSH keys named
+// after their file, match by name.
+func isAllowedSigner(identity Identity, allowedSigners []string) bool {
+	for _, allowed := range allowedSigners {
+		signer := NewIdentityFromString(allowed)
+		if identity.Matches(signer) || (identity.Email() == "" && identity.Name() == signer.Name()) {
+			return true
+		}
+	}
+
+	return false
+}
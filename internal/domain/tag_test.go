@@ -0,0 +1,92 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package domain_test
+
+import (
+	"testing"
+
+	"github.com/itiquette/gommitlint/internal/domain"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateTagSignature(t *testing.T) {
+	signed := domain.Tag{Name: "v1.0.0", Hash: "abc123", Signature: "-----BEGIN SSH SIGNATURE-----"}
+	verified := domain.NewVerificationResult(domain.VerificationStatusVerified,
+		domain.NewIdentity("Dev", "dev@example.com"), domain.NewSignature(signed.Signature))
+
+	tests := []struct {
+		name           string
+		tag            domain.Tag
+		result         domain.VerificationResult
+		allowedSigners []string
+		expectedCode   domain.ValidationErrorCode
+	}{
+		{
+			name:   "verified signature",
+			tag:    signed,
+			result: verified,
+		},
+		{
+			name:           "allowed signer by identity",
+			tag:            signed,
+			result:         verified,
+			allowedSigners: []string{"Someone <DEV@example.com>"},
+		},
+		{
+			name:           "signer not allowed",
+			tag:            signed,
+			result:         verified,
+			allowedSigners: []string{"release@example.com"},
+			expectedCode:   domain.ErrKeyNotTrusted,
+		},
+		{
+			name:         "lightweight tag",
+			tag:          domain.Tag{Name: "v1.0.0", Target: "def456"},
+			expectedCode: domain.ErrLightweightTag,
+		},
+		{
+			name:         "unsigned annotated tag",
+			tag:          domain.Tag{Name: "v1.0.0", Hash: "abc123"},
+			expectedCode: domain.ErrMissingSignature,
+		},
+		{
+			name: "verification failed",
+			tag:  signed,
+			result: domain.NewVerificationResult(domain.VerificationStatusFailed, domain.Identity{}, domain.Signature{}).
+				WithError("verification_failed", "SSH signature not verified with any trusted key"),
+			expectedCode: domain.ErrVerificationFailed,
+		},
+		{
+			name: "no trusted keys",
+			tag:  signed,
+			result: domain.NewVerificationResult(domain.VerificationStatusNoKey, domain.Identity{}, domain.Signature{}).
+				WithError("no_keys", "No SSH key files found in keys"),
+			expectedCode: domain.ErrKeyNotTrusted,
+		},
+		{
+			name: "verification timed out",
+			tag:  signed,
+			result: domain.NewVerificationResult(domain.VerificationStatusFailed, domain.Identity{}, domain.Signature{}).
+				WithError("timeout", "Signature verification stopped: context deadline exceeded"),
+			expectedCode: domain.ErrTimeout,
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			errors := domain.ValidateTagSignature(testCase.tag, testCase.result, testCase.allowedSigners)
+
+			if testCase.expectedCode == "" {
+				require.Empty(t, errors)
+
+				return
+			}
+
+			require.Len(t, errors, 1)
+			require.Equal(t, string(testCase.expectedCode), errors[0].Code)
+			require.Equal(t, domain.TagSignatureRule, errors[0].Rule)
+		})
+	}
+}
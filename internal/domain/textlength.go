@@ -0,0 +1,86 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package domain
+
+import (
+	"unicode/utf8"
+
+	"github.com/rivo/uniseg"
+)
+
+// Length modes of message.subject.length_mode.
+const (
+	// LengthModeRunes counts Unicode code points, so an emoji with a skin tone or a letter
+	// with a combining accent counts as two.
+	LengthModeRunes = "runes"
+
+	// LengthModeGraphemes counts user-perceived characters (grapheme clusters).
+	LengthModeGraphemes = "graphemes"
+
+	// LengthModeDisplayWidth counts terminal columns, two for wide East Asian characters
+	// and most emoji.
+	LengthModeDisplayWidth = "display-width"
+)
+
+// LengthModes lists the supported length modes.
+func LengthModes() []string {
+	return []string{LengthModeRunes, LengthModeGraphemes, LengthModeDisplayWidth}
+}
+
+// TextLength returns the length of text counted in mode. Unknown modes count runes.
+func TextLength(text, mode string) int {
+	switch mode {
+	case LengthModeGraphemes:
+		return uniseg.GraphemeClusterCount(text)
+	case LengthModeDisplayWidth:
+		return uniseg.StringWidth(text)
+	default:
+		return utf8.RuneCountInString(text)
+	}
+}
+
+// TruncateToLength returns the longest prefix of text whose length counted in mode is
+// at most maxLength. Grapheme clusters are never split.
+func TruncateToLength(text string, maxLength int, mode string) string {
+	if mode != LengthModeGraphemes && mode != LengthModeDisplayWidth {
+		count := 0
+
+		for index := range text {
+			if count == maxLength {
+				return text[:index]
+			}
+
+			count++
+		}
+
+		return text
+	}
+
+	length := 0
+	rest := text
+	state := -1
+
+	for rest != "" {
+		var (
+			cluster string
+			width   int
+		)
+
+		cluster, rest, width, state = uniseg.FirstGraphemeClusterInString(rest, state)
+
+		size := 1
+		if mode == LengthModeDisplayWidth {
+			size = width
+		}
+
+		if length+size > maxLength {
+			return text[:len(text)-len(rest)-len(cluster)]
+		}
+
+		length += size
+	}
+
+	return text
+}
@@ -0,0 +1,80 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package domain_test
+
+import (
+	"testing"
+
+	"github.com/itiquette/gommitlint/internal/domain"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTextLength(t *testing.T) {
+	tests := []struct {
+		name      string
+		text      string
+		mode      string
+		expected  int
+		truncated string
+		maxLength int
+	}{
+		{
+			name:      "ascii in every mode",
+			text:      "Add feature",
+			mode:      domain.LengthModeDisplayWidth,
+			expected:  11,
+			maxLength: 3,
+			truncated: "Add",
+		},
+		{
+			name:      "emoji with skin tone as runes",
+			text:      "Wave 👋🏽 hi",
+			mode:      domain.LengthModeRunes,
+			expected:  10,
+			maxLength: 6,
+			truncated: "Wave 👋",
+		},
+		{
+			name:      "emoji with skin tone as grapheme",
+			text:      "Wave 👋🏽 hi",
+			mode:      domain.LengthModeGraphemes,
+			expected:  9,
+			maxLength: 6,
+			truncated: "Wave 👋🏽",
+		},
+		{
+			name:      "combining accent as grapheme",
+			text:      "cafés",
+			mode:      domain.LengthModeGraphemes,
+			expected:  5,
+			maxLength: 4,
+			truncated: "café",
+		},
+		{
+			name:      "wide characters by display width",
+			text:      "修复错误",
+			mode:      domain.LengthModeDisplayWidth,
+			expected:  8,
+			maxLength: 5,
+			truncated: "修复",
+		},
+		{
+			name:      "unknown mode counts runes",
+			text:      "修复错误",
+			mode:      "bytes",
+			expected:  4,
+			maxLength: 2,
+			truncated: "修复",
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			require.Equal(t, testCase.expected, domain.TextLength(testCase.text, testCase.mode))
+			require.Equal(t, testCase.truncated, domain.TruncateToLength(testCase.text, testCase.maxLength, testCase.mode))
+			require.Equal(t, testCase.text, domain.TruncateToLength(testCase.text, testCase.expected, testCase.mode))
+		})
+	}
+}
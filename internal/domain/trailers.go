@@ -0,0 +1,68 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package domain
+
+import (
+	"regexp"
+	"strings"
+)
+
+// Trailer is a "Key: value" line in the trailer block at the end of a commit message.
+type Trailer struct {
+	Key   string
+	Value string
+}
+
+// ChangeIDTrailer is the key of the trailer Gerrit identifies changes by.
+const ChangeIDTrailer = "Change-Id"
+
+// trailerLineRegex matches a trailer line. Besides git's "Key: value" it accepts the
+// "Key #value" form and the BREAKING CHANGE key of the Conventional Commits footer.
+var trailerLineRegex = regexp.MustCompile(`^(BREAKING[ -]CHANGE|[A-Za-z0-9][A-Za-z0-9-]*)(?::[ \t]*| #)(.*)$`)
+
+// ParseTrailers returns the trailers of a commit body: the lines of its last paragraph
+// when every line there is a trailer or an indented continuation of the previous one.
+func ParseTrailers(body string) []Trailer {
+	paragraphs := strings.Split(strings.TrimSpace(strings.ReplaceAll(body, "\r\n", "\n")), "\n\n")
+	block := strings.TrimSpace(paragraphs[len(paragraphs)-1])
+
+	if block == "" {
+		return nil
+	}
+
+	var trailers []Trailer
+
+	for _, line := range strings.Split(block, "\n") {
+		if (strings.HasPrefix(line, " ") || strings.HasPrefix(line, "\t")) && len(trailers) > 0 {
+			last := &trailers[len(trailers)-1]
+			last.Value += " " + strings.TrimSpace(line)
+
+			continue
+		}
+
+		// A URL at the start of a line is not a trailer
+		matches := trailerLineRegex.FindStringSubmatch(strings.TrimRight(line, " \t"))
+		if matches == nil || strings.HasPrefix(matches[2], "//") {
+			return nil
+		}
+
+		trailers = append(trailers, Trailer{Key: matches[1], Value: strings.TrimSpace(matches[2])})
+	}
+
+	return trailers
+}
+
+// TrailerValues returns the values of the trailers with the given key, compared case-insensitively.
+func TrailerValues(trailers []Trailer, key string) []string {
+	var values []string
+
+	for _, trailer := range trailers {
+		if strings.EqualFold(trailer.Key, key) {
+			values = append(values, trailer.Value)
+		}
+	}
+
+	return values
+}
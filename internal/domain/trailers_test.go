@@ -0,0 +1,58 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package domain_test
+
+import (
+	"testing"
+
+	"github.com/itiquette/gommitlint/internal/domain"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseTrailers(t *testing.T) {
+	tests := []struct {
+		name     string
+		body     string
+		expected []domain.Trailer
+	}{
+		{
+			name: "trailer block after text",
+			body: "Explain the change.\n\nRefs: #12\nSigned-off-by: Dev Eloper <dev@example.com>",
+			expected: []domain.Trailer{
+				{Key: "Refs", Value: "#12"},
+				{Key: "Signed-off-by", Value: "Dev Eloper <dev@example.com>"},
+			},
+		},
+		{
+			name: "continuation line and hash separator",
+			body: "BREAKING CHANGE: the config\n  format changed\nFixes #42",
+			expected: []domain.Trailer{
+				{Key: "BREAKING CHANGE", Value: "the config format changed"},
+				{Key: "Fixes", Value: "42"},
+			},
+		},
+		{
+			name: "last paragraph with prose is not a trailer block",
+			body: "Refs: #12\n\nThis explains: why the change is needed\nacross two lines.",
+		},
+		{
+			name: "url is not a trailer",
+			body: "See the discussion.\n\nhttps://example.com/issue/1",
+		},
+		{
+			name: "empty body",
+			body: "",
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			require.Equal(t, testCase.expected, domain.ParseTrailers(testCase.body))
+		})
+	}
+
+	trailers := domain.ParseTrailers("Reviewed-by: A <a@example.com>\nreviewed-by: B <b@example.com>\nAcked-by: C <c@example.com>")
+	require.Equal(t, []string{"A <a@example.com>", "B <b@example.com>"}, domain.TrailerValues(trailers, "Reviewed-by"))
+}
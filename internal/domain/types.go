@@ -17,8 +17,14 @@ const (
 	// StatusFailed indicates the rule failed validation.
 	StatusFailed ValidationStatus = "failed"
 
+	// StatusWarning indicates the rule reported only non-blocking violations.
+	StatusWarning ValidationStatus = "warning"
+
 	// StatusSkipped indicates the rule was skipped for some reason.
 	StatusSkipped ValidationStatus = "skipped"
+
+	// StatusNotApplicable indicates the condition of the rule does not match the commit.
+	StatusNotApplicable ValidationStatus = "not_applicable"
 )
 
 // SeverityLevel represents the severity of a rule violation.
@@ -35,19 +41,60 @@ const (
 	SeverityInfo SeverityLevel = "info"
 )
 
+// Rule categories group related rules in listings and documentation.
+const (
+	CategoryMessage   = "message"   // Form and content of the commit message
+	CategoryReference = "reference" // References to tickets and issues
+	CategorySignature = "signature" // Sign-offs, signatures and signing identities
+	CategoryHistory   = "history"   // Branches and the shape of the history
+)
+
 // RuleMetadata provides information about a validation rule.
 type RuleMetadata struct {
-	// ID is the unique identifier for the rule.
+	// ID is the unique identifier for the rule, the name it is configured by.
 	ID string
 
 	// Name is the human-readable name of the rule.
 	Name string
 
+	// Category groups the rule with related rules, such as CategoryMessage.
+	Category string
+
+	// Summary is a one-line description of the rule.
+	Summary string
+
 	// Description is a detailed description of what the rule validates.
 	Description string
 
 	// Severity indicates how severe violations of this rule are.
 	Severity SeverityLevel
+
+	// ErrorCodes are the codes of the failures the rule can report.
+	ErrorCodes []ValidationErrorCode
+
+	// ConfigKeys are the configuration keys affecting the rule, as dotted paths.
+	ConfigKeys []string
+
+	// Examples are commit messages the rule accepts or rejects.
+	Examples []RuleExample
+}
+
+// RuleExample is a commit message illustrating a rule.
+type RuleExample struct {
+	// Message is the commit message.
+	Message string
+
+	// Valid indicates whether the rule accepts the message.
+	Valid bool
+
+	// Note explains why the message passes or fails, and any setting it depends on.
+	Note string
+}
+
+// DescribedRule is implemented by rules that carry their own documentation.
+type DescribedRule interface {
+	// Metadata returns the documentation of the rule.
+	Metadata() RuleMetadata
 }
 
 // ReportOptions defines options for report generation.
@@ -62,6 +109,14 @@ type ReportOptions struct {
 	UseColor bool
 	// Writer is the output writer.
 	Writer io.Writer
+	// Deduplicate drops failures that repeat a failure of another rule instead of marking them.
+	Deduplicate bool
+	// TypeAliases maps aliases of conventional commit types to the types they stand for,
+	// to report the canonical type of each commit.
+	TypeAliases map[string]string
+	// SummaryOnly keeps only the counts of the summary, without a report per commit, so
+	// that the report does not grow with the number of commits.
+	SummaryOnly bool
 }
 
 // Misspelling represents a detected spelling error.
@@ -70,3 +125,22 @@ type Misspelling struct {
 	Position   int
 	Suggestion string
 }
+
+// Ticket is an issue as an issue tracker reports it.
+type Ticket struct {
+	// Key is the current key of the issue, which differs from the looked up key when the
+	// issue was moved to another project.
+	Key string
+	// Exists is false when the tracker has no issue with the key.
+	Exists bool
+	// Project is the key of the project the issue belongs to.
+	Project string
+	// Title is the summary line of the issue.
+	Title string
+	// Status is the name of the workflow status, such as "In Progress".
+	Status string
+	// Closed is true when the status belongs to the done category of the workflow.
+	Closed bool
+	// Sprints are the names of the sprints the issue was planned in.
+	Sprints []string
+}
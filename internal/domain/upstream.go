@@ -0,0 +1,62 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package domain
+
+import "context"
+
+// UpstreamDivergence describes how HEAD and a remote tracking branch have diverged.
+type UpstreamDivergence struct {
+	// Upstream is the remote tracking branch HEAD was compared with, such as origin/main.
+	// It is empty when there was nothing to compare with.
+	Upstream string
+
+	// Ahead are the commits of HEAD the upstream does not have, newest first.
+	Ahead []Commit
+
+	// Behind are the published commits of the upstream HEAD does not contain, newest first.
+	Behind []Commit
+}
+
+// RequiresForcePush returns true if pushing HEAD to the upstream is not a fast-forward.
+func (d UpstreamDivergence) RequiresForcePush() bool {
+	return len(d.Behind) > 0
+}
+
+// RewrittenCommit pairs a published commit with the commit of HEAD that replaces it.
+type RewrittenCommit struct {
+	Published Commit
+	Rewrite   Commit
+}
+
+// RewrittenCommits returns the published commits HEAD carries in rewritten form, as after
+// a rebase or an amend. Rewriting keeps the author and the author date, so a commit ahead
+// with the same author and author date as a commit behind is taken for its rewrite.
+func (d UpstreamDivergence) RewrittenCommits() []RewrittenCommit {
+	var rewritten []RewrittenCommit
+
+	for _, published := range d.Behind {
+		if published.AuthorEmail == "" || published.CommitDate == "" {
+			continue
+		}
+
+		for _, commit := range d.Ahead {
+			if commit.AuthorEmail == published.AuthorEmail && commit.CommitDate == published.CommitDate {
+				rewritten = append(rewritten, RewrittenCommit{Published: published, Rewrite: commit})
+
+				break
+			}
+		}
+	}
+
+	return rewritten
+}
+
+// UpstreamResolver defines the contract for comparing HEAD with a remote tracking branch.
+type UpstreamResolver interface {
+	// GetUpstreamDivergence compares HEAD with upstream, a remote tracking branch such as
+	// origin/main. An empty upstream selects the upstream of the checked out branch; the
+	// result is empty when there is none.
+	GetUpstreamDivergence(ctx context.Context, upstream string) (UpstreamDivergence, error)
+}
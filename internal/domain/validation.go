@@ -6,22 +6,90 @@ package domain
 
 import (
 	"errors"
+	"slices"
 	"strings"
 
 	"github.com/itiquette/gommitlint/internal/domain/config"
 )
 
 // ValidateCommit validates a single commit against both commit and repository rules.
+// Rules with a condition the commit does not match are skipped, and failures of rules
+// waived for the commit are downgraded to informational findings.
+//
+// Commit rules run in their given order; when one listed in rules.blocking fails, the
+// commit rules after it are skipped. Repository rules always run.
 func ValidateCommit(commit Commit, commitRules []CommitRule, repoRules []RepositoryRule, repo Repository, cfg config.Config) ValidationResult {
-	var errors []ValidationError
+	commit = parseMessage(commit, cfg)
+	applicable, repoRules, warnings := applicableRules(commit, commitRules, repoRules, repo)
+	waivers := commitWaivers(commit, repo)
 
 	// Validate commit-only rules
-	errors = append(errors, ValidateCommitRules(commit, commitRules, cfg)...)
+	errors, skipped, details := runCommitRules(commit, applicable, cfg, waivers)
+	errors = append(errors, warnings...)
 
 	// Validate repository-dependent rules
 	errors = append(errors, ValidateRepositoryRules(commit, repoRules, repo, cfg)...)
 
-	return ValidationResult{Commit: commit, Errors: errors}
+	return ValidationResult{
+		Commit:        commit,
+		Errors:        ApplyWaivers(errors, waivers),
+		Skipped:       skipped,
+		NotApplicable: notApplicable(commitRules, applicable),
+		Details:       details,
+	}
+}
+
+// notApplicable returns the names of the rules that are not among the applicable rules.
+func notApplicable(rules, applicable []CommitRule) map[string]bool {
+	if len(rules) == len(applicable) {
+		return nil
+	}
+
+	names := make(map[string]bool, len(rules)-len(applicable))
+	for _, rule := range rules {
+		names[rule.Name()] = true
+	}
+
+	for _, rule := range applicable {
+		delete(names, rule.Name())
+	}
+
+	return names
+}
+
+// mergeRuleNames are the configuration names of the commit rules that validate merge
+// commits. Merge commits are skipped unless one of them is active.
+var mergeRuleNames = []string{"mergesubject"}
+
+// ValidatesMergeCommits reports whether any of commitRules validates merge commits.
+func ValidatesMergeCommits(commitRules []CommitRule) bool {
+	return slices.ContainsFunc(commitRules, func(rule CommitRule) bool {
+		return matchesRuleName(rule, mergeRuleNames)
+	})
+}
+
+// ValidateMergeCommit validates a merge commit with the rules that validate merge commits.
+// The changes of a merge were validated in the commits it merges, so the other rules are
+// reported as not applicable.
+func ValidateMergeCommit(commit Commit, commitRules []CommitRule, repo Repository, cfg config.Config) ValidationResult {
+	var mergeRules []CommitRule
+
+	for _, rule := range commitRules {
+		if matchesRuleName(rule, mergeRuleNames) {
+			mergeRules = append(mergeRules, rule)
+		}
+	}
+
+	result := ValidateCommit(commit, mergeRules, nil, repo, cfg)
+	if notRun := notApplicable(commitRules, mergeRules); notRun != nil {
+		for name := range result.NotApplicable {
+			notRun[name] = true
+		}
+
+		result.NotApplicable = notRun
+	}
+
+	return result
 }
 
 // ValidateCommits validates multiple commits against both rule types.
@@ -34,31 +102,100 @@ func ValidateCommits(commits []Commit, commitRules []CommitRule, repoRules []Rep
 	return results
 }
 
-// ValidateRepository runs repository-level rules.
+// ValidateRepository runs repository-level rules. MessageRules, which need a commit, are
+// skipped; ValidateCommit runs them for each commit.
 func ValidateRepository(rules []RepositoryRule, repo Repository, cfg config.Config) []ValidationError {
 	var errors []ValidationError
 
 	// Repository rules don't need commit data
 	emptyCommit := Commit{}
+	_, rules = ApplicableRules(emptyCommit, nil, rules, repo)
+
 	for _, rule := range rules {
+		if checksMessage(rule) {
+			continue
+		}
+
 		errors = append(errors, rule.Validate(emptyCommit, repo, cfg)...)
 	}
 
-	return errors
+	return ApplyMessageTemplates(ApplyRuleWarnings(errors, cfg.Rules.Warnings), cfg.Messages)
+}
+
+// ValidateRange runs the repository rules that check a range as a whole. Commits are
+// the unfiltered commits of the range, including merge commits.
+func ValidateRange(commits []Commit, rules []RepositoryRule, repo Repository, cfg config.Config) []ValidationError {
+	var errors []ValidationError
+
+	for _, rangeRule := range rangeRules(rules, repo) {
+		errors = append(errors, rangeRule.ValidateRange(commits, cfg)...)
+	}
+
+	return ApplyMessageTemplates(ApplyRuleWarnings(errors, cfg.Rules.Warnings), cfg.Messages)
+}
+
+// HasRangeRules reports whether ValidateRange runs any of rules in repo. Without range
+// rules, the commits of a range need not be kept for it.
+func HasRangeRules(rules []RepositoryRule, repo Repository) bool {
+	return len(rangeRules(rules, repo)) > 0
+}
+
+// rangeRules returns the range rules of rules that apply in repo.
+func rangeRules(rules []RepositoryRule, repo Repository) []RangeRule {
+	var result []RangeRule
+
+	// Like repository-level rules, range rules are restricted by the branch conditions only
+	_, rules = ApplicableRules(Commit{}, nil, rules, repo)
+
+	for _, rule := range rules {
+		if conditional, ok := rule.(ConditionalRepositoryRule); ok {
+			rule = conditional.RepositoryRule
+		}
+
+		if rangeRule, ok := rule.(RangeRule); ok {
+			result = append(result, rangeRule)
+		}
+	}
+
+	return result
+}
+
+// parseMessage splits the message of commit with the parser of message.parser. Commits
+// are split as git does when they are read, so only the other parsers split them again.
+func parseMessage(commit Commit, cfg config.Config) Commit {
+	if cfg.Message.Parser == "" || cfg.Message.Parser == ParserGit {
+		return commit
+	}
+
+	return commit.ParsedWith(NewMessageParser(cfg.Message.Parser))
 }
 
 // ValidateMessage validates a commit message string without repository context.
 func ValidateMessage(message string, rules []CommitRule, cfg config.Config) (ValidationResult, error) {
+	return ValidateMessageInRepository(message, rules, nil, cfg)
+}
+
+// ValidateMessageInRepository validates a commit message being written in repo.
+// The repository is only used to evaluate rule conditions on the branch and staged files.
+func ValidateMessageInRepository(message string, rules []CommitRule, repo Repository, cfg config.Config) (ValidationResult, error) {
 	// Trim whitespace
 	message = strings.TrimSpace(message)
 	if message == "" {
 		return ValidationResult{}, errors.New("empty commit message")
 	}
 
-	commit := ParseCommitMessage(message)
-	errors := ValidateCommitRules(commit, rules, cfg)
-
-	return ValidationResult{Commit: commit, Errors: errors}, nil
+	commit := parseMessage(ParseCommitMessage(message), cfg)
+	applicable, _, warnings := applicableRules(commit, rules, nil, repo)
+	errors, skipped, details := runCommitRules(commit, applicable, cfg, nil)
+	errors = append(errors, warnings...)
+
+	return ValidationResult{
+		Commit:        commit,
+		Errors:        errors,
+		Skipped:       skipped,
+		NotApplicable: notApplicable(rules, applicable),
+		Details:       details,
+	}, nil
 }
 
 // FullValidation represents both commit and repository validation results.
@@ -0,0 +1,317 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package domain_test
+
+import (
+	"testing"
+
+	"github.com/itiquette/gommitlint/internal/domain"
+	"github.com/itiquette/gommitlint/internal/domain/config"
+	"github.com/stretchr/testify/require"
+)
+
+// scriptedRule fails with errors and records the order rules ran in.
+type scriptedRule struct {
+	name   string
+	errors []domain.ValidationError
+	ran    *[]string
+}
+
+func (r scriptedRule) Name() string { return r.name }
+
+func (r scriptedRule) Validate(_ domain.Commit, _ config.Config) []domain.ValidationError {
+	*r.ran = append(*r.ran, r.name)
+
+	return r.errors
+}
+
+func TestValidateCommit_OrderAndBlocking(t *testing.T) {
+	emptySubject := domain.New("Subject", domain.ErrEmptySubject, "Subject is empty")
+	warning := domain.New("Subject", domain.ErrSubjectTooLong, "too long").WithSeverity(domain.SeverityWarning)
+
+	tests := []struct {
+		name            string
+		subjectErrors   []domain.ValidationError
+		order           []string
+		blocking        []string
+		expectedRan     []string
+		expectedSkipped map[string]string
+	}{
+		{
+			name:          "rules run in the given order",
+			subjectErrors: []domain.ValidationError{emptySubject},
+			expectedRan:   []string{"Spell", "SignOff", "Subject"},
+		},
+		{
+			name:          "ordered rules run first",
+			subjectErrors: []domain.ValidationError{emptySubject},
+			order:         []string{"subject", "SignOff"},
+			expectedRan:   []string{"Subject", "SignOff", "Spell"},
+		},
+		{
+			name:            "failed blocking rule skips the rest",
+			subjectErrors:   []domain.ValidationError{emptySubject},
+			order:           []string{"Subject"},
+			blocking:        []string{"subject"},
+			expectedRan:     []string{"Subject"},
+			expectedSkipped: map[string]string{"Spell": "Subject", "SignOff": "Subject"},
+		},
+		{
+			name:        "passed blocking rule skips nothing",
+			order:       []string{"Subject"},
+			blocking:    []string{"Subject"},
+			expectedRan: []string{"Subject", "Spell", "SignOff"},
+		},
+		{
+			name:          "warnings do not block",
+			subjectErrors: []domain.ValidationError{warning},
+			order:         []string{"Subject"},
+			blocking:      []string{"Subject"},
+			expectedRan:   []string{"Subject", "Spell", "SignOff"},
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			var ran []string
+
+			commitRules := []domain.CommitRule{
+				scriptedRule{name: "Spell", ran: &ran},
+				scriptedRule{name: "SignOff", ran: &ran},
+				scriptedRule{name: "Subject", errors: testCase.subjectErrors, ran: &ran},
+			}
+
+			cfg := config.NewDefault()
+			cfg.Rules.Order = testCase.order
+			cfg.Rules.Blocking = testCase.blocking
+
+			result := domain.ValidateCommit(domain.Commit{Hash: "a"}, commitRules, nil, nil, cfg)
+			require.Equal(t, testCase.expectedRan, ran)
+			require.Equal(t, testCase.expectedSkipped, result.Skipped)
+
+			report := domain.BuildReport([]domain.ValidationResult{result}, nil, commitRules, nil, domain.ReportOptions{})
+			for _, ruleReport := range report.Commits[0].RuleResults {
+				if blockedBy, skipped := testCase.expectedSkipped[ruleReport.Name]; skipped {
+					require.Equal(t, domain.StatusSkipped, ruleReport.Status)
+					require.Equal(t, blockedBy, ruleReport.BlockedBy)
+				} else {
+					require.NotEqual(t, domain.StatusSkipped, ruleReport.Status)
+				}
+			}
+		})
+	}
+}
+
+// describedRule is a scriptedRule known by a different configuration name.
+type describedRule struct {
+	scriptedRule
+
+	id string
+}
+
+func (r describedRule) Metadata() domain.RuleMetadata {
+	return domain.RuleMetadata{ID: r.id, Name: r.name}
+}
+
+func TestSelectCommitRules(t *testing.T) {
+	commitRules := []domain.CommitRule{
+		scriptedRule{name: "Subject"},
+		domain.WithCommitCondition(describedRule{scriptedRule: scriptedRule{name: "ConventionalCommit"}, id: "conventional"},
+			map[string]config.RuleCondition{"conventional": {}}, "conventional"),
+		scriptedRule{name: "SignOff"},
+	}
+
+	names := func(selected []domain.CommitRule) []string {
+		var result []string
+		for _, rule := range selected {
+			result = append(result, rule.Name())
+		}
+
+		return result
+	}
+
+	require.Equal(t, []string{"Subject", "ConventionalCommit", "SignOff"}, names(domain.SelectCommitRules(commitRules, nil, nil)))
+	require.Equal(t, []string{"ConventionalCommit"}, names(domain.SelectCommitRules(commitRules, []string{"conventional"}, nil)),
+		"rules are selected by configuration name through conditions")
+	require.Equal(t, []string{"Subject", "SignOff"}, names(domain.SelectCommitRules(commitRules, nil, []string{"Conventional"})))
+	require.Equal(t, []string{"SignOff"}, names(domain.SelectCommitRules(commitRules, []string{"signoff", "subject"}, []string{"subject"})),
+		"skipping wins over selecting")
+}
+
+func TestValidateCommit_BlockingByConfigurationName(t *testing.T) {
+	var ran []string
+
+	failure := domain.New("ConventionalCommit", domain.ErrInvalidFormat, "not conventional")
+	conventional := describedRule{
+		scriptedRule: scriptedRule{name: "ConventionalCommit", errors: []domain.ValidationError{failure}, ran: &ran},
+		id:           "conventional",
+	}
+	commitRules := []domain.CommitRule{
+		scriptedRule{name: "Subject", ran: &ran},
+		domain.WithCommitCondition(conventional, map[string]config.RuleCondition{"conventional": {}}, "conventional"),
+	}
+
+	cfg := config.NewDefault()
+	cfg.Rules.Order = []string{"conventional"}
+	cfg.Rules.Blocking = []string{"conventional"}
+
+	result := domain.ValidateCommit(domain.Commit{Hash: "a"}, commitRules, nil, nil, cfg)
+	require.Equal(t, []string{"ConventionalCommit"}, ran)
+	require.Equal(t, map[string]string{"Subject": "ConventionalCommit"}, result.Skipped)
+}
+
+func TestValidateCommit_Warnings(t *testing.T) {
+	var ran []string
+
+	commitRules := []domain.CommitRule{
+		scriptedRule{name: "ConventionalCommit", errors: []domain.ValidationError{domain.New("ConventionalCommit", domain.ErrInvalidType, "Invalid type")}, ran: &ran},
+		scriptedRule{name: "Spell", errors: []domain.ValidationError{domain.New("Spell", domain.ErrMisspelledWord, "Misspelled word")}, ran: &ran},
+		scriptedRule{name: "Subject", ran: &ran},
+	}
+
+	cfg := config.NewDefault()
+	cfg.Rules.Blocking = []string{"conventional"}
+	cfg.Rules.Warnings = []string{"conventional", "spell"}
+
+	result := domain.ValidateCommit(domain.Commit{Hash: "a"}, commitRules, nil, nil, cfg)
+	require.Equal(t, []string{"ConventionalCommit", "Spell", "Subject"}, ran, "a warning does not block")
+	require.Len(t, result.Errors, 2)
+	require.Equal(t, domain.SeverityWarning, result.Errors[0].Severity, "warnings may name a rule by its configuration name")
+	require.Equal(t, domain.SeverityWarning, result.Errors[1].Severity)
+	require.False(t, result.HasFailures())
+}
+
+func TestValidateCommit_Details(t *testing.T) {
+	var ran []string
+
+	signatureDetails := domain.NewDetails("Signature", map[string]string{"fingerprint": "SHA256:abc"})
+	identityDetails := domain.NewDetails("Identity", map[string]string{"author": "Dev <dev@example.com>"})
+	failure := domain.New("Identity", domain.ErrKeyNotTrusted, "Author not authorized")
+	commitRules := []domain.CommitRule{
+		scriptedRule{name: "Signature", errors: []domain.ValidationError{signatureDetails}, ran: &ran},
+		scriptedRule{name: "Identity", errors: []domain.ValidationError{failure, identityDetails}, ran: &ran},
+		scriptedRule{name: "Subject", ran: &ran},
+	}
+
+	result := domain.ValidateCommit(domain.Commit{Hash: "a"}, commitRules, nil, nil, config.NewDefault())
+	require.Equal(t, []domain.ValidationError{failure}, result.Errors, "details are not findings")
+	require.Equal(t, map[string]map[string]string{
+		"Signature": {"fingerprint": "SHA256:abc"},
+		"Identity":  {"author": "Dev <dev@example.com>"},
+	}, result.Details)
+
+	report := domain.BuildReport([]domain.ValidationResult{result}, nil, commitRules, nil, domain.ReportOptions{})
+	ruleResults := report.Commits[0].RuleResults
+	require.Equal(t, domain.StatusPassed, ruleResults[0].Status)
+	require.Equal(t, map[string]string{"fingerprint": "SHA256:abc"}, ruleResults[0].Details)
+	require.Equal(t, domain.StatusFailed, ruleResults[1].Status)
+	require.Equal(t, map[string]string{"author": "Dev <dev@example.com>"}, ruleResults[1].Details)
+	require.Nil(t, ruleResults[2].Details)
+}
+
+func TestValidateCommit_RevertExemptRules(t *testing.T) {
+	tests := []struct {
+		name        string
+		commit      domain.Commit
+		expectedRan []string
+	}{
+		{
+			name:        "revert skips exempt rules",
+			commit:      domain.Commit{Subject: `Revert "feat: add login page"`, Body: "This reverts commit 1a2b3c4d5e6f."},
+			expectedRan: []string{"SignOff"},
+		},
+		{
+			name:        "conventional revert skips exempt rules",
+			commit:      domain.Commit{Subject: "revert: add login page"},
+			expectedRan: []string{"SignOff"},
+		},
+		{
+			name:        "other commits run every rule",
+			commit:      domain.Commit{Subject: "feat: add login page"},
+			expectedRan: []string{"ConventionalCommit", "SignOff"},
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			var ran []string
+
+			commitRules := []domain.CommitRule{
+				describedRule{scriptedRule: scriptedRule{name: "ConventionalCommit", ran: &ran}, id: "conventional"},
+				scriptedRule{name: "SignOff", ran: &ran},
+			}
+
+			cfg := config.NewDefault()
+			cfg.Revert.ExemptRules = []string{"conventional"}
+
+			domain.ValidateCommit(testCase.commit, commitRules, nil, nil, cfg)
+			require.Equal(t, testCase.expectedRan, ran)
+		})
+	}
+}
+
+func TestValidateMergeCommit(t *testing.T) {
+	var ran []string
+
+	failure := domain.New("MergeSubject", domain.ErrMergeSubjectMismatch, "no pattern matches")
+	mergeSubject := describedRule{
+		scriptedRule: scriptedRule{name: "MergeSubject", errors: []domain.ValidationError{failure}, ran: &ran},
+		id:           "mergesubject",
+	}
+	commitRules := []domain.CommitRule{scriptedRule{name: "Subject", ran: &ran}, scriptedRule{name: "SignOff", ran: &ran}}
+
+	require.False(t, domain.ValidatesMergeCommits(commitRules))
+
+	commitRules = append(commitRules, mergeSubject)
+	require.True(t, domain.ValidatesMergeCommits(commitRules))
+
+	commit := domain.Commit{Hash: "a", Subject: "merged main", IsMergeCommit: true}
+	result := domain.ValidateMergeCommit(commit, commitRules, nil, config.NewDefault())
+
+	require.Equal(t, []string{"MergeSubject"}, ran, "only the merge rules run")
+	require.Equal(t, []domain.ValidationError{failure}, result.Errors)
+	require.Equal(t, map[string]bool{"Subject": true, "SignOff": true}, result.NotApplicable)
+}
+
+// scriptedRepositoryRule records the subjects of the commits it validated.
+type scriptedRepositoryRule struct {
+	name     string
+	subjects *[]string
+}
+
+func (r scriptedRepositoryRule) Name() string { return r.name }
+
+func (r scriptedRepositoryRule) Validate(commit domain.Commit, _ domain.Repository, _ config.Config) []domain.ValidationError {
+	*r.subjects = append(*r.subjects, r.name+":"+commit.Subject)
+
+	return nil
+}
+
+// scriptedMessageRule is a scriptedRepositoryRule checking the commit message.
+type scriptedMessageRule struct {
+	scriptedRepositoryRule
+}
+
+func (scriptedMessageRule) ChecksMessage() {}
+
+func TestValidateRepository_SkipsMessageRules(t *testing.T) {
+	var subjects []string
+
+	repoRules := []domain.RepositoryRule{
+		scriptedRepositoryRule{name: "BranchName", subjects: &subjects},
+		scriptedMessageRule{scriptedRepositoryRule{name: "Revert", subjects: &subjects}},
+		domain.ConditionalRepositoryRule{RepositoryRule: scriptedMessageRule{scriptedRepositoryRule{name: "Review", subjects: &subjects}}},
+	}
+	cfg := config.NewDefault()
+
+	domain.ValidateRepository(repoRules, nil, cfg)
+	require.Equal(t, []string{"BranchName:"}, subjects, "the repository-level pass has no message to check")
+
+	// Commits are checked by every rule, even with an empty subject
+	subjects = nil
+
+	domain.ValidateCommit(domain.Commit{Hash: "a"}, nil, repoRules, nil, cfg)
+	require.Equal(t, []string{"BranchName:", "Revert:", "Review:"}, subjects)
+}
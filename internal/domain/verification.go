@@ -6,12 +6,49 @@ package domain
 
 import (
 	"context"
+	"time"
 )
 
 // SignatureVerifier defines the interface for signature verification.
 type SignatureVerifier interface {
 	// VerifyCommit verifies a commit's signature and returns verification result.
 	VerifyCommit(ctx context.Context, commit Commit, keyDir string) VerificationResult
+
+	// VerifyTag verifies an annotated tag's signature and returns verification result.
+	VerifyTag(ctx context.Context, tag Tag, keyDir string) VerificationResult
+}
+
+// VerifyWithKeyDirectories runs verify with each of keyDirs in turn and returns the first
+// result that verifies. When none does, it returns the result of the first directory that
+// holds the signing key but rejected it for the key policy, or else of the first directory.
+func VerifyWithKeyDirectories(keyDirs []string, verify func(keyDir string) VerificationResult) VerificationResult {
+	if len(keyDirs) == 0 {
+		return NewVerificationResult(VerificationStatusNoKey, NewIdentity("", ""), NewSignature("")).
+			WithError(string(ErrNoKeyDir), "No key directory configured")
+	}
+
+	var failed, rejected VerificationResult
+
+	for index, keyDir := range keyDirs {
+		result := verify(keyDir)
+		if result.IsVerified() {
+			return result
+		}
+
+		if index == 0 {
+			failed = result
+		}
+
+		if result.ErrorCode() == string(ErrWeakKey) && !rejected.HasError() {
+			rejected = result
+		}
+	}
+
+	if rejected.HasError() {
+		return rejected
+	}
+
+	return failed
 }
 
 // VerificationStatus represents the status of signature verification.
@@ -33,11 +70,15 @@ const (
 
 // VerificationResult represents the result of signature verification.
 type VerificationResult struct {
-	status    VerificationStatus
-	identity  Identity
-	signature Signature
-	errorCode string
-	errorMsg  string
+	status      VerificationStatus
+	identity    Identity
+	signature   Signature
+	fingerprint string
+	algorithm   string
+	keyCreated  time.Time
+	keyExpires  time.Time
+	errorCode   string
+	errorMsg    string
 }
 
 // NewVerificationResult creates a new verification result.
@@ -64,6 +105,28 @@ func (r VerificationResult) Signature() Signature {
 	return r.signature
 }
 
+// Fingerprint returns the fingerprint of the signing key, empty when it is unknown.
+func (r VerificationResult) Fingerprint() string {
+	return r.fingerprint
+}
+
+// KeyAlgorithm returns the algorithm of the signing key, such as "rsa" or "ed25519",
+// empty when it is unknown.
+func (r VerificationResult) KeyAlgorithm() string {
+	return r.algorithm
+}
+
+// KeyCreated returns when the signing key was created, zero when it is unknown.
+func (r VerificationResult) KeyCreated() time.Time {
+	return r.keyCreated
+}
+
+// KeyExpires returns when the signing key expires, zero when it does not expire or
+// the key has no dates, as SSH keys.
+func (r VerificationResult) KeyExpires() time.Time {
+	return r.keyExpires
+}
+
 // IsVerified returns true if the signature was successfully verified.
 func (r VerificationResult) IsVerified() bool {
 	return r.status == VerificationStatusVerified
@@ -92,3 +155,22 @@ func (r VerificationResult) WithError(code, message string) VerificationResult {
 
 	return result
 }
+
+// WithFingerprint returns a new VerificationResult with the signing key fingerprint.
+func (r VerificationResult) WithFingerprint(fingerprint string) VerificationResult {
+	result := r // Copy
+	result.fingerprint = fingerprint
+
+	return result
+}
+
+// WithKeyDetails returns a new VerificationResult with the algorithm and the creation and
+// expiry dates of the signing key. Zero dates are unknown or, for expires, never.
+func (r VerificationResult) WithKeyDetails(algorithm string, created, expires time.Time) VerificationResult {
+	result := r // Copy
+	result.algorithm = algorithm
+	result.keyCreated = created
+	result.keyExpires = expires
+
+	return result
+}
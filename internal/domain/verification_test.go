@@ -61,3 +61,34 @@ func TestVerificationResult(t *testing.T) {
 	require.Equal(t, domain.VerificationStatusUnsupported, unsupportedVerification.Status())
 	require.False(t, unsupportedVerification.IsVerified())
 }
+
+func TestVerifyWithKeyDirectories(t *testing.T) {
+	signature := domain.NewSignature("test-signature")
+	results := map[string]domain.VerificationResult{
+		"keys/team":   domain.NewVerificationResult(domain.VerificationStatusNoKey, domain.Identity{}, signature),
+		"keys/weak":   domain.NewVerificationResult(domain.VerificationStatusFailed, domain.Identity{}, signature).WithError(string(domain.ErrWeakKey), "RSA key of 1024 bits"),
+		"keys/shared": domain.NewVerificationResult(domain.VerificationStatusVerified, domain.NewIdentity("Dev", "dev@example.com"), signature),
+	}
+
+	var searched []string
+
+	verify := func(keyDir string) domain.VerificationResult {
+		searched = append(searched, keyDir)
+
+		return results[keyDir]
+	}
+
+	result := domain.VerifyWithKeyDirectories([]string{"keys/team", "keys/shared", "keys/weak"}, verify)
+	require.True(t, result.IsVerified())
+	require.Equal(t, []string{"keys/team", "keys/shared"}, searched, "the search stops at the verifying directory")
+
+	result = domain.VerifyWithKeyDirectories([]string{"keys/team", "keys/weak"}, verify)
+	require.Equal(t, string(domain.ErrWeakKey), result.ErrorCode(), "a weak key is reported over a missing one")
+
+	result = domain.VerifyWithKeyDirectories([]string{"keys/team"}, verify)
+	require.Equal(t, domain.VerificationStatusNoKey, result.Status())
+
+	result = domain.VerifyWithKeyDirectories(nil, verify)
+	require.Equal(t, domain.VerificationStatusNoKey, result.Status())
+	require.Equal(t, string(domain.ErrNoKeyDir), result.ErrorCode())
+}
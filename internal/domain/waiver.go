@@ -0,0 +1,157 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package domain
+
+import (
+	"context"
+	"errors"
+	"strings"
+)
+
+// Waiver keys as written in a waiver note.
+const (
+	waiverRuleKey     = "Waive"
+	waiverReasonKey   = "Reason"
+	waiverApproverKey = "Approved-by"
+)
+
+// Waiver exempts one commit from a rule, with the reason and who approved it.
+type Waiver struct {
+	Rule     string
+	Reason   string
+	Approver string
+}
+
+// Validate returns an error if a field the waiver needs is missing.
+func (w Waiver) Validate() error {
+	switch {
+	case strings.TrimSpace(w.Rule) == "":
+		return errors.New("a waiver needs the rule it waives")
+	case strings.TrimSpace(w.Reason) == "":
+		return errors.New("a waiver needs a reason")
+	case strings.TrimSpace(w.Approver) == "":
+		return errors.New("a waiver needs an approver")
+	}
+
+	return nil
+}
+
+// String formats the waiver as a block of a waiver note.
+func (w Waiver) String() string {
+	return waiverRuleKey + ": " + oneLine(w.Rule) + "\n" +
+		waiverReasonKey + ": " + oneLine(w.Reason) + "\n" +
+		waiverApproverKey + ": " + oneLine(w.Approver) + "\n"
+}
+
+// oneLine joins the lines of a field value so it fits a single note line.
+func oneLine(value string) string {
+	return strings.Join(strings.Fields(value), " ")
+}
+
+// ParseWaivers parses a waiver note: blocks of "Waive", "Reason" and "Approved-by"
+// lines separated by blank lines. Blocks without a rule are ignored, and so are
+// other lines, which leaves room for free-form annotations.
+func ParseWaivers(note string) []Waiver {
+	var (
+		waivers []Waiver
+		current Waiver
+	)
+
+	flush := func() {
+		if current.Rule != "" {
+			waivers = append(waivers, current)
+		}
+
+		current = Waiver{}
+	}
+
+	for _, line := range strings.Split(note, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			flush()
+
+			continue
+		}
+
+		key, value, found := strings.Cut(line, ":")
+		if !found {
+			continue
+		}
+
+		value = strings.TrimSpace(value)
+
+		switch {
+		case strings.EqualFold(key, waiverRuleKey):
+			current.Rule = value
+		case strings.EqualFold(key, waiverReasonKey):
+			current.Reason = value
+		case strings.EqualFold(key, waiverApproverKey):
+			current.Approver = value
+		}
+	}
+
+	flush()
+
+	return waivers
+}
+
+// WaiverResolver defines the contract for reading and recording waivers of commits.
+type WaiverResolver interface {
+	// GetWaivers returns the waivers recorded for the commit.
+	GetWaivers(ctx context.Context, ref string) ([]Waiver, error)
+
+	// AddWaiver records a waiver for the commit, keeping the waivers already recorded.
+	AddWaiver(ctx context.Context, ref string, waiver Waiver) error
+}
+
+// ApplyWaivers turns the blocking errors of waived rules into informational findings
+// that carry the waiver, so they are still reported but no longer fail validation.
+func ApplyWaivers(errs []ValidationError, waivers []Waiver) []ValidationError {
+	if len(waivers) == 0 {
+		return errs
+	}
+
+	result := make([]ValidationError, 0, len(errs))
+
+	for _, err := range errs {
+		if waiver, found := findWaiver(waivers, err.Rule); found && err.IsBlocking() {
+			err = err.WithSeverity(SeverityInfo).WithContextMap(map[string]string{
+				"waiver_reason":   waiver.Reason,
+				"waiver_approver": waiver.Approver,
+			})
+		}
+
+		result = append(result, err)
+	}
+
+	return result
+}
+
+// findWaiver returns the waiver for rule, matching names the way rule names are configured.
+func findWaiver(waivers []Waiver, rule string) (Waiver, bool) {
+	for _, waiver := range waivers {
+		if CleanRuleName(waiver.Rule) == CleanRuleName(rule) {
+			return waiver, true
+		}
+	}
+
+	return Waiver{}, false
+}
+
+// commitWaivers returns the waivers recorded for commit in repo. Waivers that cannot
+// be read are treated as absent so that a broken note never hides a failure.
+func commitWaivers(commit Commit, repo Repository) []Waiver {
+	resolver, ok := repo.(WaiverResolver)
+	if !ok || commit.Hash == "" {
+		return nil
+	}
+
+	waivers, err := resolver.GetWaivers(context.Background(), commit.Hash)
+	if err != nil {
+		return nil
+	}
+
+	return waivers
+}
@@ -0,0 +1,117 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package domain_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/itiquette/gommitlint/internal/domain"
+	"github.com/itiquette/gommitlint/internal/domain/config"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseWaivers(t *testing.T) {
+	tests := []struct {
+		name     string
+		note     string
+		expected []domain.Waiver
+	}{
+		{
+			name: "empty note",
+		},
+		{
+			name: "several waivers",
+			note: "Waive: Subject\nReason: Imported from upstream\nApproved-by: Jane <jane@example.com>\n\n" +
+				"waive: Signature\nreason: Signed out of band\n",
+			expected: []domain.Waiver{
+				{Rule: "Subject", Reason: "Imported from upstream", Approver: "Jane <jane@example.com>"},
+				{Rule: "Signature", Reason: "Signed out of band"},
+			},
+		},
+		{
+			name: "annotations without a rule are ignored",
+			note: "Reviewed in the release meeting\nTicket: ABC-1\n\nWaive: Spell\nReason: Product names",
+			expected: []domain.Waiver{
+				{Rule: "Spell", Reason: "Product names"},
+			},
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			require.Equal(t, testCase.expected, domain.ParseWaivers(testCase.note))
+		})
+	}
+}
+
+func TestWaiver_String(t *testing.T) {
+	waiver := domain.Waiver{Rule: "Subject", Reason: "Imported\nfrom  upstream", Approver: "Jane <jane@example.com>"}
+
+	require.Equal(t, "Waive: Subject\nReason: Imported from upstream\nApproved-by: Jane <jane@example.com>\n", waiver.String())
+	require.Equal(t, []domain.Waiver{{Rule: "Subject", Reason: "Imported from upstream", Approver: "Jane <jane@example.com>"}},
+		domain.ParseWaivers(waiver.String()))
+
+	require.NoError(t, waiver.Validate())
+	require.EqualError(t, domain.Waiver{Rule: "Subject", Approver: "Jane"}.Validate(), "a waiver needs a reason")
+	require.EqualError(t, domain.Waiver{Rule: "Subject", Reason: "Why"}.Validate(), "a waiver needs an approver")
+}
+
+func TestApplyWaivers(t *testing.T) {
+	errs := []domain.ValidationError{
+		domain.New("Subject", domain.ErrSubjectTooLong, "too long"),
+		domain.New("Spell", domain.ErrMisspelledWord, "misspelled").WithSeverity(domain.SeverityWarning),
+		domain.New("Signature", domain.ErrMissingSignature, "unsigned"),
+	}
+
+	waivers := []domain.Waiver{
+		{Rule: "subject", Reason: "Imported", Approver: "Jane"},
+		{Rule: "Spell", Reason: "Product names", Approver: "Jane"},
+	}
+
+	result := domain.ApplyWaivers(errs, waivers)
+	require.Len(t, result, 3)
+
+	require.False(t, result[0].IsBlocking())
+	require.Equal(t, "Imported", result[0].Context["waiver_reason"])
+	require.Equal(t, "Jane", result[0].Context["waiver_approver"])
+
+	require.Equal(t, domain.SeverityWarning, result[1].Severity, "non-blocking findings are left as they are")
+	require.True(t, result[2].IsBlocking())
+
+	require.Equal(t, errs, domain.ApplyWaivers(errs, nil))
+}
+
+// waiverRepository serves waivers recorded per commit hash.
+type waiverRepository struct {
+	domain.Repository
+
+	waivers map[string][]domain.Waiver
+}
+
+func (r waiverRepository) GetWaivers(_ context.Context, ref string) ([]domain.Waiver, error) {
+	return r.waivers[ref], nil
+}
+
+func (r waiverRepository) AddWaiver(_ context.Context, _ string, _ domain.Waiver) error {
+	return nil
+}
+
+func TestValidateCommit_Waivers(t *testing.T) {
+	repo := waiverRepository{waivers: map[string][]domain.Waiver{
+		"abc123": {{Rule: "Subject", Reason: "Imported", Approver: "Jane"}},
+	}}
+	rules := []domain.CommitRule{failingRule{name: "Subject"}, failingRule{name: "Jira"}}
+
+	waived := domain.NewCommit("abc123", "feat: add", "Dev", "dev@example.com", "", "", false)
+	result := domain.ValidateCommit(waived, rules, nil, repo, config.NewDefault())
+	require.Len(t, result.Errors, 2)
+	require.False(t, result.Errors[0].IsBlocking())
+	require.True(t, result.Errors[1].IsBlocking())
+
+	other := domain.NewCommit("def456", "feat: add", "Dev", "dev@example.com", "", "", false)
+	result = domain.ValidateCommit(other, rules, nil, repo, config.NewDefault())
+	require.True(t, result.Errors[0].IsBlocking())
+}
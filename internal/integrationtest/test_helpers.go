@@ -16,6 +16,7 @@ import (
 	"github.com/go-git/go-git/v5/plumbing/object"
 	"github.com/stretchr/testify/require"
 
+	"github.com/itiquette/gommitlint/internal/adapters/cli"
 	"github.com/itiquette/gommitlint/internal/adapters/git"
 	"github.com/itiquette/gommitlint/internal/domain"
 	"github.com/itiquette/gommitlint/internal/domain/config"
@@ -40,8 +41,8 @@ func TestValidation(t *testing.T, repoPath string, config config.Config) Validat
 	require.NoError(t, err, "Failed to create git repository")
 
 	// Create validation rules
-	commitRules := rules.CreateCommitRules(config)
-	repoRules := rules.CreateRepositoryRules(config)
+	commitRules := rules.CreateCommitRules(config, cli.RuleServices())
+	repoRules := rules.CreateRepositoryRules(config, cli.RuleServices())
 
 	// Get the latest commit (HEAD)
 	commits, err := gitRepo.GetHeadCommits(ctx, 1)
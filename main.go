@@ -6,11 +6,14 @@ package main
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os"
 
 	"github.com/itiquette/gommitlint/internal/adapters/cli/commands"
 	logadapter "github.com/itiquette/gommitlint/internal/adapters/logging"
+	"github.com/itiquette/gommitlint/internal/adapters/tracing"
+	"github.com/itiquette/gommitlint/internal/domain"
 	"github.com/urfave/cli/v3"
 )
 
@@ -21,6 +24,10 @@ var (
 	date    = "unknown"
 )
 
+// exitTimeout is the exit status of commands stopped by --timeout, telling a hung
+// repository or key lookup apart from failed validation.
+const exitTimeout = 3
+
 // preprocessVerboseArgs converts -vv to -v -v to work with the CLI library.
 func preprocessVerboseArgs(args []string) []string {
 	var processed []string
@@ -42,11 +49,17 @@ func main() {
 	ctx := context.Background()
 
 	// Initialize logger early in the application flow
-	ctx = logadapter.InitLogger(ctx, "text", false, "info") // Basic logger setup
+	ctx = logadapter.InitLogger(ctx, logadapter.Options{Format: "text", Level: "info"}) // Basic logger setup
 
 	// Preprocess arguments to handle -vv flag
 	args := preprocessVerboseArgs(os.Args)
 
+	// Set up by the Before hook when tracing or a timeout is enabled
+	var (
+		shutdownTracing func(context.Context) error
+		cancelTimeout   context.CancelFunc
+	)
+
 	app := &cli.Command{
 		Name:  "gommitlint",
 		Usage: "Git commit message validator",
@@ -55,6 +68,7 @@ func main() {
 Examples:
   gommitlint validate                        # Validate HEAD commit
   gommitlint validate --base-branch=main     # Validate branch commits
+  gommitlint watch                           # Lint the message being written live
   gommitlint install-hook                    # Install commit-msg hook
   gommitlint config show --format=yaml > .gommitlint.yaml # Generate config file`,
 		Version: fmt.Sprintf("%s (Commit: %s, Build date: %s)", version, commit, date),
@@ -78,6 +92,12 @@ Examples:
 				Usage:    "ignore config files",
 				Category: "Configuration",
 			},
+			&cli.StringFlag{
+				Name:     "profile",
+				Usage:    "apply the settings of configuration profile `NAME`",
+				Sources:  cli.EnvVars("GOMMITLINT_PROFILE"),
+				Category: "Configuration",
+			},
 
 			// Repository flags
 			&cli.StringFlag{
@@ -85,12 +105,25 @@ Examples:
 				Usage:    "repository `PATH` (defaults to current directory)",
 				Category: "Repository",
 			},
+			&cli.DurationFlag{
+				Name:     "timeout",
+				Usage:    "stop git and signature operations after `DURATION` (e.g. 30s, 2m; default: no limit)",
+				Sources:  cli.EnvVars("GOMMITLINT_TIMEOUT"),
+				Category: "Repository",
+			},
+			&cli.StringFlag{
+				Name:     "git-backend",
+				Value:    commands.GitBackendAuto,
+				Usage:    "read repositories with `BACKEND`: go-git, exec (the git binary), or auto to fall back to exec when go-git fails",
+				Sources:  cli.EnvVars("GOMMITLINT_GIT_BACKEND"),
+				Category: "Repository",
+			},
 
 			// Output flags
 			&cli.StringFlag{
 				Name:     "format",
 				Value:    "text",
-				Usage:    "output `FORMAT` (text, json, github, gitlab)",
+				Usage:    "output `FORMAT` (text, json, github, gitlab, html, csv, tsv, patchwork, gerrit)",
 				Category: "Output",
 			},
 			&cli.StringFlag{
@@ -99,10 +132,35 @@ Examples:
 				Usage:    "color `MODE` (auto, always, never)",
 				Category: "Output",
 			},
+			&cli.StringFlag{
+				Name:     "theme",
+				Usage:    "color `THEME` of text output (default, high-contrast, monochrome; default: display.theme)",
+				Category: "Output",
+			},
+			&cli.BoolFlag{
+				Name:     "ascii",
+				Usage:    "use ASCII symbols instead of ✓, ✗ and ⚠ in text output",
+				Category: "Output",
+			},
+			&cli.StringFlag{
+				Name:     "link-template",
+				Usage:    "link commit hashes in text, HTML and markdown reports to the URL `TEMPLATE` with {hash}, or auto to derive it from the origin remote (default: display.link_template)",
+				Category: "Output",
+			},
 			&cli.StringFlag{
 				Name:     "log-level",
 				Value:    "info",
-				Usage:    "log `LEVEL` (error, warn, info, debug, trace)",
+				Usage:    "log `LEVEL` (error, warn, info, debug, trace), with overrides by component, e.g. info,validation=debug",
+				Category: "Output",
+			},
+			&cli.StringFlag{
+				Name:     "log-format",
+				Usage:    "log `FORMAT` (text, json; default: json with --format json, otherwise text)",
+				Category: "Output",
+			},
+			&cli.UintFlag{
+				Name:     "log-sample",
+				Usage:    "after 10 repetitions, log only every `N`th repetition of a debug line",
 				Category: "Output",
 			},
 			&cli.BoolFlag{
@@ -111,6 +169,12 @@ Examples:
 				Usage:    "suppress all output except errors",
 				Category: "Output",
 			},
+			&cli.BoolFlag{
+				Name:     "tracing",
+				Usage:    "export OpenTelemetry traces through OTLP, configured by the OTEL_EXPORTER_OTLP_* environment",
+				Sources:  cli.EnvVars("GOMMITLINT_TRACING"),
+				Category: "Output",
+			},
 			&cli.BoolFlag{
 				Name:     "debug",
 				Usage:    "enable debug output with source locations",
@@ -121,14 +185,63 @@ Examples:
 		// Before hook for global setup
 		Before: func(ctx context.Context, cmd *cli.Command) (context.Context, error) {
 			// Setup logging based on flags
-			output := cmd.String("format")
-			debug := cmd.Bool("debug")
-			logLevel := cmd.String("log-level")
-			ctx = logadapter.InitLogger(ctx, output, debug, logLevel)
+			logFormat := cmd.String("log-format")
+			if logFormat == "" {
+				logFormat = "text"
+				if cmd.String("format") == "json" {
+					logFormat = "json"
+				}
+			}
+
+			if err := logadapter.ValidateFormat(logFormat); err != nil {
+				return ctx, err
+			}
+
+			ctx = logadapter.InitLogger(ctx, logadapter.Options{
+				Format:      logFormat,
+				Level:       cmd.String("log-level"),
+				Debug:       cmd.Bool("debug"),
+				SampleEvery: cmd.Uint("log-sample"),
+			})
+
+			if err := commands.ValidateGitBackend(cmd.String("git-backend")); err != nil {
+				return ctx, err
+			}
+
+			timeout := cmd.Duration("timeout")
+			if timeout < 0 {
+				return ctx, fmt.Errorf("invalid timeout %s, must not be negative", timeout)
+			}
+
+			if timeout > 0 {
+				ctx, cancelTimeout = context.WithTimeout(ctx, timeout)
+			}
+
+			if cmd.Bool("tracing") {
+				tracingCtx, shutdown, err := tracing.Setup(ctx, version)
+				if err != nil {
+					return ctx, err
+				}
+
+				ctx, shutdownTracing = tracingCtx, shutdown
+			}
 
 			return ctx, nil
 		},
 
+		// After hook releasing the timeout and exporting the remaining spans
+		After: func(ctx context.Context, _ *cli.Command) error {
+			if cancelTimeout != nil {
+				defer cancelTimeout()
+			}
+
+			if shutdownTracing == nil {
+				return nil
+			}
+
+			return shutdownTracing(ctx)
+		},
+
 		Action: func(_ context.Context, cmd *cli.Command) error {
 			// If no subcommand, show help
 			return cli.ShowAppHelp(cmd)
@@ -136,16 +249,34 @@ Examples:
 
 		Commands: []*cli.Command{
 			commands.NewValidateCommand(),
+			commands.NewWatchCommand(),
+			commands.NewDaemonCommand(),
+			commands.NewLSPCommand(),
 			commands.NewConfigCommand(),
 			commands.NewInstallHookCommand(),
 			commands.NewRemoveHookCommand(),
+			commands.NewWaiveCommand(),
+			commands.NewVerifyTagCommand(),
+			commands.NewAuditCommand(),
+			commands.NewExplainCommand(),
+			commands.NewRulesCommand(),
+			commands.NewErrorsCommand(),
+			commands.NewScoreCommand(),
 		},
 	}
 
+	// Complete flag values such as rule names and branches, not only commands and flags
+	commands.EnableValueCompletion(app)
+
 	if err := app.Run(ctx, args); err != nil {
 		// Get logger from context and handle error
-		zerologLogger := logadapter.GetLogger(ctx)
-		logger := logadapter.NewDomainLogger(zerologLogger)
+		logger := logadapter.ComponentLogger(ctx, logadapter.ComponentCLI)
+
+		if errors.Is(err, context.DeadlineExceeded) {
+			logger.Error("Command timed out", "error", err, "code", domain.ErrTimeout.String())
+			os.Exit(exitTimeout)
+		}
+
 		logger.Error("Command execution failed", "error", err)
 		os.Exit(1)
 	}